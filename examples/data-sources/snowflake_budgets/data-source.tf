@@ -0,0 +1,48 @@
+# Simple usage
+data "snowflake_budgets" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_budgets.simple.budgets
+}
+
+# Filtering (like)
+data "snowflake_budgets" "like" {
+  like = "budget-name"
+}
+
+output "like_output" {
+  value = data.snowflake_budgets.like.budgets
+}
+
+# Filtering (in)
+data "snowflake_budgets" "in_database" {
+  in {
+    database = "<database_name>"
+  }
+}
+
+data "snowflake_budgets" "in_schema" {
+  in {
+    schema = "\"<database_name>\".\"<schema_name>\""
+  }
+}
+
+output "in_filtered" {
+  value = {
+    "database" : data.snowflake_budgets.in_database.budgets,
+    "schema" : data.snowflake_budgets.in_schema.budgets,
+  }
+}
+
+# Ensure the number of budgets is equal to exactly one element (with the use of check block)
+check "budget_check" {
+  data "snowflake_budgets" "assert_with_check_block" {
+    like = "budget-name"
+  }
+
+  assert {
+    condition     = length(data.snowflake_budgets.assert_with_check_block.budgets) == 1
+    error_message = "budgets filtered by '${data.snowflake_budgets.assert_with_check_block.like}' returned ${length(data.snowflake_budgets.assert_with_check_block.budgets)} budgets where one was expected"
+  }
+}
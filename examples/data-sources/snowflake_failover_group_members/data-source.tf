@@ -0,0 +1,11 @@
+data "snowflake_failover_group_members" "example" {
+  failover_group = snowflake_failover_group.example.name
+}
+
+output "failover_group_databases" {
+  value = data.snowflake_failover_group_members.example.databases
+}
+
+output "failover_group_shares" {
+  value = data.snowflake_failover_group_members.example.shares
+}
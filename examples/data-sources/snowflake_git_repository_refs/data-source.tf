@@ -0,0 +1,28 @@
+# Simple usage
+data "snowflake_git_repository_refs" "simple" {
+  git_repository = snowflake_git_repository.example.fully_qualified_name
+}
+
+output "simple_output" {
+  value = data.snowflake_git_repository_refs.simple
+}
+
+# Filtering (like)
+data "snowflake_git_repository_refs" "like" {
+  git_repository = snowflake_git_repository.example.fully_qualified_name
+  like           = "release%"
+}
+
+# Pinning downstream objects to the commit of a branch; changing fetch_trigger fetches the repository during the apply
+resource "snowflake_git_repository" "example" {
+  database        = "database"
+  schema          = "schema"
+  name            = "git_repository"
+  origin          = "https://github.com/user/repo"
+  api_integration = "api_integration"
+  fetch_trigger   = var.release_branch
+}
+
+locals {
+  release_commit = one([for branch in data.snowflake_git_repository_refs.simple.branches : branch.commit_hash if branch.name == var.release_branch])
+}
@@ -0,0 +1,28 @@
+# Simple usage
+data "snowflake_organization_accounts" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_organization_accounts.simple.organization_accounts
+}
+
+# Filtering (like)
+data "snowflake_organization_accounts" "like" {
+  like = "organization-account-name"
+}
+
+output "like_output" {
+  value = data.snowflake_organization_accounts.like.organization_accounts
+}
+
+# Ensure the number of organization accounts is equal to exactly one element (with the use of check block)
+check "organization_account_check" {
+  data "snowflake_organization_accounts" "assert_with_check_block" {
+    like = "organization-account-name"
+  }
+
+  assert {
+    condition     = length(data.snowflake_organization_accounts.assert_with_check_block.organization_accounts) == 1
+    error_message = "organization accounts filtered by '${data.snowflake_organization_accounts.assert_with_check_block.like}' returned ${length(data.snowflake_organization_accounts.assert_with_check_block.organization_accounts)} organization accounts where one was expected"
+  }
+}
@@ -0,0 +1,20 @@
+# policies attached to the given object
+data "snowflake_policy_references" "for_object" {
+  ref_entity_name   = snowflake_table.example.fully_qualified_name
+  ref_entity_domain = "TABLE"
+}
+
+# policies attached to an account-level object
+data "snowflake_policy_references" "for_user" {
+  ref_entity_name   = snowflake_user.example.name
+  ref_entity_domain = "USER"
+}
+
+# objects the given policy is attached to
+data "snowflake_policy_references" "for_policy" {
+  policy_name = snowflake_masking_policy.example.fully_qualified_name
+}
+
+output "policy_references" {
+  value = data.snowflake_policy_references.for_object.policy_references
+}
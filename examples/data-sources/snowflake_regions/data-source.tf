@@ -0,0 +1,16 @@
+# Simple usage
+data "snowflake_regions" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_regions.simple.regions
+}
+
+# Filtering (like)
+data "snowflake_regions" "like" {
+  like = "AWS_%"
+}
+
+output "like_output" {
+  value = data.snowflake_regions.like.regions
+}
@@ -0,0 +1,6 @@
+data "snowflake_replication_accounts" "all" {
+}
+
+output "replication_accounts" {
+  value = data.snowflake_replication_accounts.all.replication_accounts
+}
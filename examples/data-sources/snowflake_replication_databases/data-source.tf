@@ -0,0 +1,25 @@
+# Simple usage
+data "snowflake_replication_databases" "simple" {
+}
+
+output "simple_output" {
+  value = data.snowflake_replication_databases.simple.replication_databases
+}
+
+# Filtering (like)
+data "snowflake_replication_databases" "like" {
+  like = "database-name"
+}
+
+output "like_output" {
+  value = data.snowflake_replication_databases.like.replication_databases
+}
+
+# Filtering (with_primary) - the primary database and all its secondary databases
+data "snowflake_replication_databases" "with_primary" {
+  with_primary = "\"organization_name\".\"account_name\".\"database_name\""
+}
+
+output "with_primary_output" {
+  value = data.snowflake_replication_databases.with_primary.replication_databases
+}
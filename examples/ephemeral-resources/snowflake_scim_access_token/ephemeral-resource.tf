@@ -0,0 +1,10 @@
+resource "snowflake_scim_integration" "okta" {
+  name        = "OKTA_PROVISIONING"
+  enabled     = true
+  scim_client = "OKTA"
+  run_as_role = "OKTA_PROVISIONER"
+}
+
+ephemeral "snowflake_scim_access_token" "okta" {
+  integration_name = snowflake_scim_integration.okta.name
+}
@@ -0,0 +1,25 @@
+# basic ephemeral resource
+ephemeral "snowflake_user_programmatic_access_token" "basic" {
+  user = "USER"
+  name = "TOKEN"
+}
+
+# complete ephemeral resource
+ephemeral "snowflake_user_programmatic_access_token" "complete" {
+  user                                      = "USER"
+  name                                      = "TOKEN"
+  role_restriction                          = "ROLE"
+  days_to_expiry                            = 1
+  mins_to_bypass_network_policy_requirement = 10
+  comment                                   = "COMMENT"
+}
+
+# Use the token to configure another provider; it is removed at the end of the run.
+provider "snowflake" {
+  alias             = "pat"
+  organization_name = "ORGANIZATION"
+  account_name      = "ACCOUNT"
+  user              = "USER"
+  authenticator     = "PROGRAMMATIC_ACCESS_TOKEN"
+  token             = ephemeral.snowflake_user_programmatic_access_token.complete.token
+}
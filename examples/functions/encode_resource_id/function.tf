@@ -0,0 +1,12 @@
+import {
+  to = snowflake_grant_ownership.schema
+  # ToAccountRole|"ROLE"|COPY|OnObject|SCHEMA|"DATABASE"."SCHEMA"
+  id = provider::snowflake::encode_resource_id(
+    "ToAccountRole",
+    provider::snowflake::fully_qualified_name("ROLE"),
+    "COPY",
+    "OnObject",
+    "SCHEMA",
+    provider::snowflake::fully_qualified_name("DATABASE", "SCHEMA"),
+  )
+}
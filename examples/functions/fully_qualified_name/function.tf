@@ -0,0 +1,4 @@
+output "table_fully_qualified_name" {
+  # "DATABASE"."SCHEMA"."TABLE"
+  value = provider::snowflake::fully_qualified_name("DATABASE", "SCHEMA", "TABLE")
+}
@@ -0,0 +1,13 @@
+locals {
+  function = provider::snowflake::parse_function_signature("\"DATABASE\".\"SCHEMA\".\"FUNCTION\"(NAME VARCHAR, AGE NUMBER)")
+}
+
+output "function_argument_data_types" {
+  # ["VARCHAR", "NUMBER"]
+  value = local.function.argument_data_types
+}
+
+output "function_fully_qualified_name" {
+  # "DATABASE"."SCHEMA"."FUNCTION"(VARCHAR, NUMBER)
+  value = local.function.fully_qualified_name
+}
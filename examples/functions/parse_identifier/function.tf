@@ -0,0 +1,8 @@
+locals {
+  table = provider::snowflake::parse_identifier("\"DATABASE\".\"SCHEMA.WITH.DOTS\".\"TABLE\"")
+}
+
+output "schema_name" {
+  # SCHEMA.WITH.DOTS
+  value = local.table.schema
+}
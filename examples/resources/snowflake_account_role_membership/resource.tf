@@ -0,0 +1,10 @@
+resource "snowflake_account_role_membership" "example" {
+  role_name         = snowflake_account_role.analyst.name
+  parent_role_names = [snowflake_account_role.data_team.name, snowflake_account_role.sysadmin.name]
+  user_names        = [snowflake_user.alice.name]
+}
+
+## role granted to no one; every existing grant of the role is revoked
+resource "snowflake_account_role_membership" "locked" {
+  role_name = snowflake_account_role.locked.name
+}
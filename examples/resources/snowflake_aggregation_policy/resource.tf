@@ -0,0 +1,16 @@
+# basic resource
+resource "snowflake_aggregation_policy" "basic" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "BASIC"
+  body     = "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)"
+}
+
+# complete resource
+resource "snowflake_aggregation_policy" "complete" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "COMPLETE"
+  body     = "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 10)"
+  comment  = "An example aggregation policy"
+}
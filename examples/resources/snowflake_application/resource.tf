@@ -0,0 +1,29 @@
+# basic resource - uses the version specified by the release directive of the application package
+resource "snowflake_application" "basic" {
+  name                     = "BASIC"
+  from_application_package = snowflake_application_package.example.fully_qualified_name
+}
+
+# resource created from a specific version of the application package
+resource "snowflake_application" "with_version" {
+  name                     = "WITH_VERSION"
+  from_application_package = snowflake_application_package.example.fully_qualified_name
+  version                  = "V1"
+  patch                    = 0
+  debug_mode               = "true"
+  comment                  = "An example application"
+}
+
+# resource created in development mode from the files on a stage
+resource "snowflake_application" "development" {
+  name                     = "DEVELOPMENT"
+  from_application_package = snowflake_application_package.example.fully_qualified_name
+  version_directory        = "@DATABASE.SCHEMA.STAGE/dev"
+}
+
+# resource created from a listing
+resource "snowflake_application" "from_listing" {
+  name         = "FROM_LISTING"
+  from_listing = "LISTING_NAME"
+  comment      = "An example application installed from a listing"
+}
@@ -0,0 +1 @@
+terraform import snowflake_application_package.example '"<application_package_name>"'
@@ -0,0 +1,36 @@
+# basic resource
+resource "snowflake_application_package" "basic" {
+  name = "BASIC"
+}
+
+# complete resource
+resource "snowflake_application_package" "complete" {
+  name                        = "COMPLETE"
+  enable_release_channels     = "false"
+  distribution                = "INTERNAL"
+  data_retention_time_in_days = 1
+  comment                     = "An example application package"
+
+  version {
+    name  = "V1"
+    using = "@DATABASE.SCHEMA.STAGE/v1"
+    label = "First version"
+  }
+
+  version {
+    name  = "V2"
+    using = "@DATABASE.SCHEMA.STAGE/v2"
+  }
+
+  default_release_directive {
+    version = "V2"
+    patch   = 0
+  }
+
+  release_directive {
+    name     = "EARLY_ACCESS"
+    accounts = ["ORGANIZATION.ACCOUNT"]
+    version  = "V1"
+    patch    = 0
+  }
+}
@@ -0,0 +1,18 @@
+# basic resource
+resource "snowflake_backup_policy" "basic" {
+  database          = "DATABASE"
+  schema            = "SCHEMA"
+  name              = "BASIC"
+  expire_after_days = 7
+}
+
+# complete resource
+resource "snowflake_backup_policy" "complete" {
+  database            = "DATABASE"
+  schema              = "SCHEMA"
+  name                = "COMPLETE"
+  schedule            = "60 MINUTE"
+  expire_after_days   = 30
+  with_retention_lock = false
+  comment             = "An example backup policy"
+}
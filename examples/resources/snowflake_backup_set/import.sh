@@ -0,0 +1 @@
+terraform import snowflake_backup_set.example '"<database_name>"."<schema_name>"."<backup_set_name>"'
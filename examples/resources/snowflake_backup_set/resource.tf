@@ -0,0 +1,19 @@
+# basic resource
+resource "snowflake_backup_set" "basic" {
+  database    = "DATABASE"
+  schema      = "SCHEMA"
+  name        = "BASIC"
+  object_type = "TABLE"
+  object_name = snowflake_table.example.fully_qualified_name
+}
+
+# complete resource
+resource "snowflake_backup_set" "complete" {
+  database      = "DATABASE"
+  schema        = "SCHEMA"
+  name          = "COMPLETE"
+  object_type   = "SCHEMA"
+  object_name   = snowflake_schema.example.fully_qualified_name
+  backup_policy = snowflake_backup_policy.example.fully_qualified_name
+  comment       = "An example backup set"
+}
@@ -0,0 +1 @@
+terraform import snowflake_budget.example '"<database_name>"."<schema_name>"."<budget_name>"'
@@ -0,0 +1,22 @@
+## Minimal
+resource "snowflake_budget" "basic" {
+  database       = "database_name"
+  schema         = "schema_name"
+  name           = "budget_name"
+  spending_limit = 100
+}
+
+## Complete
+resource "snowflake_budget" "complete" {
+  database                 = "database_name"
+  schema                   = "schema_name"
+  name                     = "budget_name"
+  spending_limit           = 100
+  notification_integration = snowflake_email_notification_integration.example.name
+  email_recipients         = ["first@example.com", "second@example.com"]
+  cycle_start_action {
+    procedure = "\"database_name\".\"schema_name\".\"procedure_name\"(VARCHAR)"
+    arguments = ["argument"]
+  }
+  comment = "My budget"
+}
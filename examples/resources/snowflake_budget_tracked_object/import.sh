@@ -0,0 +1,2 @@
+# format is <budget_fqn>|<object_type>|<object_fqn>
+terraform import snowflake_budget_tracked_object.example '"<database_name>"."<schema_name>"."<budget_name>"|TABLE|"<database_name>"."<schema_name>"."<table_name>"'
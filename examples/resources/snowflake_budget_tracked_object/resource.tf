@@ -0,0 +1,11 @@
+resource "snowflake_budget_tracked_object" "table" {
+  budget_name = snowflake_budget.example.fully_qualified_name
+  object_type = "TABLE"
+  object_name = snowflake_table.example.fully_qualified_name
+}
+
+resource "snowflake_budget_tracked_object" "warehouse" {
+  budget_name = snowflake_budget.example.fully_qualified_name
+  object_type = "WAREHOUSE"
+  object_name = snowflake_warehouse.example.fully_qualified_name
+}
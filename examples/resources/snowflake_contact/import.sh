@@ -0,0 +1 @@
+terraform import snowflake_contact.example '"<database_name>"."<schema_name>"."<contact_name>"'
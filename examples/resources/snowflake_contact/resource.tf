@@ -0,0 +1,24 @@
+# contact reached through Snowflake users
+resource "snowflake_contact" "users" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "DATA_STEWARDS"
+  users    = ["JOHN", "JANE"]
+}
+
+# contact reached through an email distribution list
+resource "snowflake_contact" "email" {
+  database                = "DATABASE"
+  schema                  = "SCHEMA"
+  name                    = "SUPPORT"
+  email_distribution_list = "support@example.com"
+}
+
+# complete resource
+resource "snowflake_contact" "complete" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "ACCESS_APPROVERS"
+  url      = "https://example.com/access-requests"
+  comment  = "An example contact"
+}
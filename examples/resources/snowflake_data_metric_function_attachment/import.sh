@@ -0,0 +1,2 @@
+# format is <object_type>|<object_fqn>|<data_metric_function_fqn>|<column>[|<column>...]
+terraform import snowflake_data_metric_function_attachment.example 'TABLE|"<database_name>"."<schema_name>"."<table_name>"|"SNOWFLAKE"."CORE"."NULL_COUNT"|<column_name>'
@@ -0,0 +1,24 @@
+# system data metric function with a cron schedule
+resource "snowflake_data_metric_function_attachment" "table" {
+  object_type          = "TABLE"
+  object_name          = snowflake_table.example.fully_qualified_name
+  data_metric_function = "SNOWFLAKE.CORE.NULL_COUNT"
+  on                   = ["ID"]
+
+  data_metric_schedule {
+    using_cron = "0 8 * * * UTC"
+  }
+}
+
+# custom data metric function running after each DML operation, suspended
+resource "snowflake_data_metric_function_attachment" "dynamic_table" {
+  object_type          = "DYNAMIC TABLE"
+  object_name          = snowflake_dynamic_table.example.fully_qualified_name
+  data_metric_function = "\"<database_name>\".\"<schema_name>\".\"<data_metric_function_name>\""
+  on                   = ["ID", "NAME"]
+  schedule_status      = "SUSPENDED"
+
+  data_metric_schedule {
+    trigger_on_changes = true
+  }
+}
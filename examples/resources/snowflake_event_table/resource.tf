@@ -0,0 +1,31 @@
+# basic resource
+resource "snowflake_event_table" "basic" {
+  database = "database"
+  schema   = "schema"
+  name     = "event_table"
+}
+
+# complete resource
+resource "snowflake_event_table" "complete" {
+  database                        = "database"
+  schema                          = "schema"
+  name                            = "event_table"
+  cluster_by                      = ["TIMESTAMP"]
+  change_tracking                 = "true"
+  default_ddl_collation           = "en_US"
+  data_retention_time_in_days     = 1
+  max_data_extension_time_in_days = 7
+  comment                         = "event table comment"
+}
+
+# collect the telemetry data of the whole account in the event table
+resource "snowflake_account_parameter" "event_table" {
+  key   = "EVENT_TABLE"
+  value = snowflake_event_table.complete.fully_qualified_name
+}
+
+# collect the telemetry data of the objects in a single database in the event table
+resource "snowflake_database" "with_event_table" {
+  name        = "database_with_event_table"
+  event_table = snowflake_event_table.complete.fully_qualified_name
+}
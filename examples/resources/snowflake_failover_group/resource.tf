@@ -32,3 +32,17 @@ resource "snowflake_failover_group" "target_failover_group" {
     name                = snowflake_failover_group.source_failover_group.name
   }
 }
+
+# to fail over to account2, set primary_account to "<org_name>.<account2_name>" in both configurations;
+# the secondary failover group in account2 is refreshed and then promoted during the apply
+resource "snowflake_failover_group" "target_failover_group_with_failover" {
+  provider = snowflake.account2
+  name     = "FG2"
+  from_replica {
+    organization_name   = "..."
+    source_account_name = "..."
+    name                = "FG2"
+  }
+  primary_account     = "<org_name>.<source_account_name>"
+  refresh_after_apply = true
+}
@@ -16,4 +16,6 @@ resource "snowflake_git_repository" "complete" {
   api_integration = "API_INTEGRATION"
   git_credentials = snowflake_secret_with_basic_authentication.secret_name.fully_qualified_name
   comment         = "comment"
+  # fetches the repository whenever the value changes
+  fetch_trigger = "release/1.2.0"
 }
\ No newline at end of file
@@ -0,0 +1,56 @@
+##################################
+### caller privileges on object
+##################################
+
+resource "snowflake_grant_caller_privileges" "example" {
+  account_role_name = snowflake_account_role.restricted_caller.name
+  privileges        = ["SELECT", "INSERT"]
+  on_object {
+    object_type = "TABLE"
+    object_name = snowflake_table.my_table.fully_qualified_name # note this is a fully qualified name!
+  }
+}
+
+## all caller privileges on object
+resource "snowflake_grant_caller_privileges" "example" {
+  account_role_name = snowflake_account_role.restricted_caller.name
+  all_privileges    = true
+  on_object {
+    object_type = "VIEW"
+    object_name = snowflake_view.my_view.fully_qualified_name # note this is a fully qualified name!
+  }
+}
+
+##################################
+### inherited caller privileges
+##################################
+
+## in account
+resource "snowflake_grant_caller_privileges" "example" {
+  account_role_name = snowflake_account_role.restricted_caller.name
+  privileges        = ["SELECT"]
+  inherited {
+    object_type_plural = "TABLES"
+    in_account         = true
+  }
+}
+
+## in database, granted to a database role
+resource "snowflake_grant_caller_privileges" "example" {
+  database_role_name = snowflake_database_role.restricted_caller.fully_qualified_name
+  privileges         = ["SELECT"]
+  inherited {
+    object_type_plural = "VIEWS"
+    in_database        = snowflake_database.db.name
+  }
+}
+
+## in schema
+resource "snowflake_grant_caller_privileges" "example" {
+  account_role_name = snowflake_account_role.restricted_caller.name
+  privileges        = ["SELECT"]
+  inherited {
+    object_type_plural = "TABLES"
+    in_schema          = snowflake_schema.my_schema.fully_qualified_name # note this is a fully qualified name!
+  }
+}
@@ -0,0 +1,40 @@
+resource "snowflake_grant_privileges_matrix" "example" {
+  entry {
+    account_role_name = snowflake_account_role.analyst.name
+    privileges        = ["USAGE"]
+    object_type       = "DATABASE"
+    object_name       = snowflake_database.db.name
+  }
+
+  entry {
+    account_role_name = snowflake_account_role.analyst.name
+    privileges        = ["USAGE", "CREATE TABLE"]
+    object_type       = "SCHEMA"
+    object_name       = snowflake_schema.my_schema.fully_qualified_name # note this is a fully qualified name!
+  }
+
+  entry {
+    account_role_name = snowflake_account_role.loader.name
+    privileges        = ["SELECT", "INSERT"]
+    object_type       = "TABLE"
+    object_name       = snowflake_table.my_table.fully_qualified_name # note this is a fully qualified name!
+  }
+}
+
+## entries generated from a list of roles and objects
+locals {
+  readers = ["ANALYST", "REPORTING"]
+  tables  = [snowflake_table.orders.fully_qualified_name, snowflake_table.customers.fully_qualified_name]
+}
+
+resource "snowflake_grant_privileges_matrix" "readers" {
+  dynamic "entry" {
+    for_each = { for pair in setproduct(local.readers, local.tables) : "${pair[0]}|${pair[1]}" => pair }
+    content {
+      account_role_name = entry.value[0]
+      privileges        = ["SELECT"]
+      object_type       = "TABLE"
+      object_name       = entry.value[1]
+    }
+  }
+}
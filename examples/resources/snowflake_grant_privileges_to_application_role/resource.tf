@@ -0,0 +1,54 @@
+locals {
+  application_role_name = "\"${snowflake_application.app.name}\".\"app_role\""
+}
+
+##################################
+### on account object privileges
+##################################
+
+resource "snowflake_grant_privileges_to_application_role" "example" {
+  privileges            = ["USAGE"]
+  application_role_name = local.application_role_name
+  on_account_object {
+    object_type = "DATABASE"
+    object_name = snowflake_database.db.name
+  }
+}
+
+##################################
+### schema privileges
+##################################
+
+resource "snowflake_grant_privileges_to_application_role" "example" {
+  privileges            = ["USAGE"]
+  application_role_name = local.application_role_name
+  on_schema {
+    schema_name = snowflake_schema.my_schema.fully_qualified_name # note this is a fully qualified name!
+  }
+}
+
+##################################
+### schema object privileges
+##################################
+
+# on object
+resource "snowflake_grant_privileges_to_application_role" "example" {
+  privileges            = ["SELECT", "INSERT"]
+  application_role_name = local.application_role_name
+  on_schema_object {
+    object_type = "TABLE"
+    object_name = snowflake_table.my_table.fully_qualified_name # note this is a fully qualified name!
+  }
+}
+
+# on future
+resource "snowflake_grant_privileges_to_application_role" "example" {
+  privileges            = ["SELECT"]
+  application_role_name = local.application_role_name
+  on_schema_object {
+    future {
+      object_type_plural = "TABLES"
+      in_schema          = snowflake_schema.my_schema.fully_qualified_name # note this is a fully qualified name!
+    }
+  }
+}
@@ -0,0 +1,16 @@
+# basic resource
+resource "snowflake_join_policy" "basic" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "BASIC"
+  body     = "JOIN_CONSTRAINT(JOIN_REQUIRED => true)"
+}
+
+# complete resource
+resource "snowflake_join_policy" "complete" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "COMPLETE"
+  body     = "JOIN_CONSTRAINT(JOIN_REQUIRED => false)"
+  comment  = "An example join policy"
+}
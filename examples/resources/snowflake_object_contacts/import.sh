@@ -0,0 +1,2 @@
+# format is <object_type>|<object_fqn>
+terraform import snowflake_object_contacts.example 'SCHEMA|"<database_name>"."<schema_name>"'
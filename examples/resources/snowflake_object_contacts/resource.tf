@@ -0,0 +1,12 @@
+resource "snowflake_object_contacts" "database" {
+  object_type = "DATABASE"
+  object_name = snowflake_database.example.fully_qualified_name
+  steward     = snowflake_contact.users.fully_qualified_name
+  support     = snowflake_contact.email.fully_qualified_name
+}
+
+resource "snowflake_object_contacts" "table" {
+  object_type     = "TABLE"
+  object_name     = snowflake_table.example.fully_qualified_name
+  access_approval = snowflake_contact.complete.fully_qualified_name
+}
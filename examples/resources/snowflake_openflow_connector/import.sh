@@ -0,0 +1 @@
+terraform import snowflake_openflow_connector.example '"<database_name>"."<schema_name>"."<openflow_connector_name>"'
@@ -0,0 +1,29 @@
+# basic resource
+resource "snowflake_openflow_connector" "basic" {
+  database   = "database"
+  schema     = "schema"
+  name       = "my_openflow_connector"
+  runtime    = snowflake_openflow_runtime.example.fully_qualified_name
+  definition = "my_connector_definition"
+}
+
+# complete resource created from files in a stage
+resource "snowflake_openflow_connector" "complete" {
+  database = "database"
+  schema   = "schema"
+  name     = "my_openflow_connector_complete"
+  runtime  = snowflake_openflow_runtime.example.fully_qualified_name
+  from {
+    stage = snowflake_stage.example.fully_qualified_name
+    path  = "connectors/my_connector"
+  }
+  display_name = "My Openflow connector"
+  comment      = "My Openflow connector"
+
+  # Openflow objects are provisioned asynchronously; adjust the timeouts to your environment
+  timeouts {
+    create = "90m"
+    update = "90m"
+    delete = "90m"
+  }
+}
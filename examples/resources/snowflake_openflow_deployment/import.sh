@@ -0,0 +1 @@
+terraform import snowflake_openflow_deployment.example '"<openflow_deployment_name>"'
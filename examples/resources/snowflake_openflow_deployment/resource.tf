@@ -0,0 +1,24 @@
+# basic resource
+resource "snowflake_openflow_deployment" "basic" {
+  name            = "my_openflow_deployment"
+  deployment_type = "SNOWFLAKE"
+}
+
+# complete resource
+resource "snowflake_openflow_deployment" "complete" {
+  name                           = "my_openflow_deployment_complete"
+  deployment_type                = "SNOWFLAKE"
+  vpc_type                       = "MANAGED"
+  use_private_link               = "false"
+  use_user_auth_over_privatelink = "false"
+  event_table                    = snowflake_event_table.example.fully_qualified_name
+  display_name                   = "My Openflow deployment"
+  comment                        = "My Openflow deployment"
+
+  # Openflow objects are provisioned asynchronously; adjust the timeouts to your environment
+  timeouts {
+    create = "90m"
+    update = "90m"
+    delete = "90m"
+  }
+}
@@ -0,0 +1 @@
+terraform import snowflake_openflow_runtime.example '"<database_name>"."<schema_name>"."<openflow_runtime_name>"'
@@ -0,0 +1,33 @@
+# basic resource
+resource "snowflake_openflow_runtime" "basic" {
+  database        = "database"
+  schema          = "schema"
+  name            = "my_openflow_runtime"
+  deployment      = snowflake_openflow_deployment.example.name
+  execute_as_role = "my_role"
+  node_type       = "SMALL"
+  min_nodes       = 1
+  max_nodes       = 1
+}
+
+# complete resource
+resource "snowflake_openflow_runtime" "complete" {
+  database                     = "database"
+  schema                       = "schema"
+  name                         = "my_openflow_runtime_complete"
+  deployment                   = snowflake_openflow_deployment.example.name
+  execute_as_role              = "my_role"
+  node_type                    = "MEDIUM"
+  min_nodes                    = 1
+  max_nodes                    = 3
+  external_access_integrations = [snowflake_external_access_integration.example.name]
+  display_name                 = "My Openflow runtime"
+  comment                      = "My Openflow runtime"
+
+  # Openflow objects are provisioned asynchronously; adjust the timeouts to your environment
+  timeouts {
+    create = "90m"
+    update = "90m"
+    delete = "90m"
+  }
+}
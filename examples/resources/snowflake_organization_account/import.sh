@@ -0,0 +1 @@
+terraform import snowflake_organization_account.example '"<organization_account_name>"'
@@ -0,0 +1,56 @@
+## Minimal
+resource "snowflake_organization_account" "minimal" {
+  name           = "ORGANIZATION_ACCOUNT_NAME"
+  admin_name     = var.admin_name
+  admin_password = var.admin_password
+  email          = var.email
+  edition        = "ENTERPRISE"
+}
+
+## Complete (with every optional set)
+resource "snowflake_organization_account" "complete" {
+  name                 = "ORGANIZATION_ACCOUNT_NAME"
+  admin_name           = var.admin_name
+  admin_rsa_public_key = "<public_key>"
+  first_name           = var.first_name
+  last_name            = var.last_name
+  email                = var.email
+  must_change_password = "false"
+  edition              = "ENTERPRISE"
+  region_group         = "PUBLIC"
+  region               = "AWS_US_WEST_2"
+  comment              = "some comment"
+
+  resource_monitor = snowflake_resource_monitor.example.fully_qualified_name
+  password_policy  = snowflake_password_policy.example.fully_qualified_name
+  session_policy   = snowflake_session_policy.example.fully_qualified_name
+
+  # account parameters
+  timezone                     = "Europe/Warsaw"
+  statement_timeout_in_seconds = 3600
+}
+
+variable "admin_name" {
+  type      = string
+  sensitive = true
+}
+
+variable "admin_password" {
+  type      = string
+  sensitive = true
+}
+
+variable "email" {
+  type      = string
+  sensitive = true
+}
+
+variable "first_name" {
+  type      = string
+  sensitive = true
+}
+
+variable "last_name" {
+  type      = string
+  sensitive = true
+}
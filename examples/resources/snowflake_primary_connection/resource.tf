@@ -10,4 +10,6 @@ resource "snowflake_primary_connection" "complete" {
   enable_failover_to_accounts = [
     "\"<secondary_account_organization_name>\".\"<secondary_account_name>\""
   ]
+  # set to "<secondary_account_organization_name>.<secondary_account_name>" after the failover to keep the resource in the state
+  primary_account = "<organization_name>.<account_name>"
 }
@@ -0,0 +1,16 @@
+# basic resource
+resource "snowflake_projection_policy" "basic" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "BASIC"
+  body     = "PROJECTION_CONSTRAINT(ALLOW => false)"
+}
+
+# complete resource
+resource "snowflake_projection_policy" "complete" {
+  database = "DATABASE"
+  schema   = "SCHEMA"
+  name     = "COMPLETE"
+  body     = "PROJECTION_CONSTRAINT(ALLOW => true)"
+  comment  = "An example projection policy"
+}
@@ -9,4 +9,6 @@ resource "snowflake_secondary_connection" "complete" {
   name          = "connection_name"
   as_replica_of = "\"<organization_name>\".\"<account_name>\".\"<connection_name>\""
   comment       = "my complete secondary connection"
+  # set to "<organization_name>.<current_account_name>" to promote this connection to primary
+  primary_account = "<organization_name>.<account_name>"
 }
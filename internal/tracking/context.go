@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/ephemeralresources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 )
 
@@ -17,6 +18,10 @@ type key struct{}
 
 var metadataContextKey key
 
+type resourceIdKey struct{}
+
+var resourceIdContextKey resourceIdKey
+
 type Operation string
 
 const (
@@ -26,14 +31,18 @@ const (
 	DeleteOperation     Operation = "delete"
 	ImportOperation     Operation = "import"
 	CustomDiffOperation Operation = "custom_diff"
+	OpenOperation       Operation = "open"
+	CloseOperation      Operation = "close"
 )
 
 type Metadata struct {
-	SchemaVersion string    `json:"json_schema_version,omitempty"`
-	Version       string    `json:"version,omitempty"`
-	Resource      string    `json:"resource,omitempty"`
-	Datasource    string    `json:"datasource,omitempty"`
-	Operation     Operation `json:"operation,omitempty"`
+	SchemaVersion string `json:"json_schema_version,omitempty"`
+	Version       string `json:"version,omitempty"`
+	Resource      string `json:"resource,omitempty"`
+	Datasource    string `json:"datasource,omitempty"`
+	// EphemeralResource is set for the operations of the ephemeral resources served by the plugin framework provider.
+	EphemeralResource string    `json:"ephemeral_resource,omitempty"`
+	Operation         Operation `json:"operation,omitempty"`
 }
 
 func (m Metadata) validate() error {
@@ -44,8 +53,8 @@ func (m Metadata) validate() error {
 	if m.Version == "" {
 		errs = append(errs, errors.New("provider version for metadata should not be empty"))
 	}
-	if m.Resource == "" && m.Datasource == "" {
-		errs = append(errs, errors.New("either resource or data source name for metadata should be specified"))
+	if m.Resource == "" && m.Datasource == "" && m.EphemeralResource == "" {
+		errs = append(errs, errors.New("either resource, data source, or ephemeral resource name for metadata should be specified"))
 	}
 	if m.Operation == "" {
 		errs = append(errs, errors.New("operation for metadata should not be empty"))
@@ -81,6 +90,15 @@ func NewVersionedDatasourceMetadata(datasource datasources.Datasource) Metadata
 	}
 }
 
+func NewVersionedEphemeralResourceMetadata(ephemeralResource ephemeralresources.EphemeralResource, operation Operation) Metadata {
+	return Metadata{
+		SchemaVersion:     CurrentSchemaVersion,
+		Version:           ProviderVersion,
+		EphemeralResource: ephemeralResource.String(),
+		Operation:         operation,
+	}
+}
+
 func NewContext(ctx context.Context, metadata Metadata) context.Context {
 	return context.WithValue(ctx, metadataContextKey, metadata)
 }
@@ -89,3 +107,19 @@ func FromContext(ctx context.Context) (Metadata, bool) {
 	metadata, ok := ctx.Value(metadataContextKey).(Metadata)
 	return metadata, ok
 }
+
+// NewResourceIdContext returns the context carrying the ID of the resource instance the operation is run for.
+// The ID is resolved every time it is retrieved, so the statements run after the ID is set (e.g., in create) are also attributed to the instance.
+func NewResourceIdContext(ctx context.Context, resourceId func() string) context.Context {
+	return context.WithValue(ctx, resourceIdContextKey, resourceId)
+}
+
+// ResourceIdFromContext returns the ID of the resource instance; it returns false if it is not present or not set yet.
+func ResourceIdFromContext(ctx context.Context) (string, bool) {
+	resourceId, ok := ctx.Value(resourceIdContextKey).(func() string)
+	if !ok {
+		return "", false
+	}
+	id := resourceId()
+	return id, id != ""
+}
@@ -45,3 +45,21 @@ func Test_Context(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, newMetadata, retrievedMetadata)
 }
+
+func Test_ResourceIdContext(t *testing.T) {
+	ctx := context.Background()
+
+	_, ok := ResourceIdFromContext(ctx)
+	require.False(t, ok)
+
+	id := ""
+	ctx = NewResourceIdContext(ctx, func() string { return id })
+
+	_, ok = ResourceIdFromContext(ctx)
+	require.False(t, ok)
+
+	id = "DATABASE"
+	retrievedId, ok := ResourceIdFromContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, "DATABASE", retrievedId)
+}
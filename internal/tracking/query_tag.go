@@ -0,0 +1,61 @@
+package tracking
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MaxQueryTagLength is the maximum length of the QUERY_TAG parameter value accepted by Snowflake.
+const MaxQueryTagLength = 2000
+
+// QueryTag is the QUERY_TAG set on the statements when the provider is configured to include the usage metadata in the query tag.
+// It combines the query tag set in the provider configuration with the resource (or data source) and operation from Metadata.
+type QueryTag struct {
+	QueryTag          string    `json:"query_tag,omitempty"`
+	Workspace         string    `json:"workspace,omitempty"`
+	Resource          string    `json:"resource,omitempty"`
+	Datasource        string    `json:"datasource,omitempty"`
+	EphemeralResource string    `json:"ephemeral_resource,omitempty"`
+	Operation         Operation `json:"operation,omitempty"`
+	Version           string    `json:"version,omitempty"`
+}
+
+func NewQueryTag(queryTag string, workspace string, metadata Metadata) QueryTag {
+	return QueryTag{
+		QueryTag:          queryTag,
+		Workspace:         workspace,
+		Resource:          metadata.Resource,
+		Datasource:        metadata.Datasource,
+		EphemeralResource: metadata.EphemeralResource,
+		Operation:         metadata.Operation,
+		Version:           metadata.Version,
+	}
+}
+
+// Render returns the JSON representation of the query tag.
+// If it exceeds MaxQueryTagLength, the metadata fields are omitted, so that the values set in the provider configuration are kept.
+func (t QueryTag) Render() (string, error) {
+	rendered, err := t.render()
+	if err != nil {
+		return "", err
+	}
+	if len(rendered) <= MaxQueryTagLength {
+		return rendered, nil
+	}
+	rendered, err = QueryTag{QueryTag: t.QueryTag, Workspace: t.Workspace}.render()
+	if err != nil {
+		return "", err
+	}
+	if len(rendered) > MaxQueryTagLength {
+		return "", fmt.Errorf("query tag %s exceeds the maximum length of %d characters", rendered, MaxQueryTagLength)
+	}
+	return rendered, nil
+}
+
+func (t QueryTag) render() (string, error) {
+	bytes, err := json.Marshal(t)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the query tag: %w", err)
+	}
+	return string(bytes), nil
+}
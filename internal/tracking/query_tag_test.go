@@ -0,0 +1,48 @@
+package tracking
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryTag_Render(t *testing.T) {
+	t.Run("resource metadata", func(t *testing.T) {
+		queryTag := NewQueryTag("finops", "prod", newTestMetadata("123", resources.Database, CreateOperation))
+
+		rendered, err := queryTag.Render()
+
+		require.NoError(t, err)
+		require.JSONEq(t, `{"query_tag":"finops","workspace":"prod","resource":"snowflake_database","operation":"create","version":"123"}`, rendered)
+	})
+
+	t.Run("data source metadata without query tag and workspace", func(t *testing.T) {
+		queryTag := NewQueryTag("", "", NewVersionedDatasourceMetadata(datasources.Databases))
+
+		rendered, err := queryTag.Render()
+
+		require.NoError(t, err)
+		require.JSONEq(t, `{"datasource":"snowflake_databases","operation":"read","version":"dev"}`, rendered)
+	})
+
+	t.Run("metadata omitted when too long", func(t *testing.T) {
+		longQueryTag := strings.Repeat("a", MaxQueryTagLength-50)
+		queryTag := NewQueryTag(longQueryTag, "prod", newTestMetadata("123", resources.Database, CreateOperation))
+
+		rendered, err := queryTag.Render()
+
+		require.NoError(t, err)
+		require.JSONEq(t, `{"query_tag":"`+longQueryTag+`","workspace":"prod"}`, rendered)
+	})
+
+	t.Run("too long", func(t *testing.T) {
+		queryTag := NewQueryTag(strings.Repeat("a", MaxQueryTagLength), "", newTestMetadata("123", resources.Database, CreateOperation))
+
+		_, err := queryTag.Render()
+
+		require.ErrorContains(t, err, "exceeds the maximum length of 2000 characters")
+	})
+}
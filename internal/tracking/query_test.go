@@ -77,7 +77,7 @@ func TestParseInvalidMetadataKeys(t *testing.T) {
 	parsedMetadata, err := ParseMetadata(sql)
 	require.ErrorContains(t, err, "schema version for metadata should not be empty")
 	require.ErrorContains(t, err, "provider version for metadata should not be empty")
-	require.ErrorContains(t, err, "either resource or data source name for metadata should be specified")
+	require.ErrorContains(t, err, "either resource, data source, or ephemeral resource name for metadata should be specified")
 	require.ErrorContains(t, err, "operation for metadata should not be empty")
 	require.Equal(t, Metadata{}, parsedMetadata)
 }
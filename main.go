@@ -6,12 +6,11 @@ import (
 	"log"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/frameworkprovider"
 	oldprovider "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
-	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
-	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 )
 
 var version string = "dev" // goreleaser can pass other information to the main package, such as the specific commit
@@ -27,23 +26,7 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	upgradedSdkServer, err := tf5to6server.UpgradeServer(
-		ctx,
-		oldprovider.Provider().GRPCProvider,
-	)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	providers := []func() tfprotov6.ProviderServer{
-		// disabled until ready to start using
-		//	providerserver.NewProtocol6(provider.New(version)()),
-		func() tfprotov6.ProviderServer {
-			return upgradedSdkServer
-		},
-	}
-
-	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	muxServer, err := frameworkprovider.NewMuxServer(ctx, version, oldprovider.Provider())
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -56,7 +39,9 @@ func main() {
 
 	err = tf6server.Serve(
 		"registry.terraform.io/snowflakedb/snowflake",
-		muxServer.ProviderServer,
+		func() tfprotov6.ProviderServer {
+			return muxServer
+		},
 		serveOpts...,
 	)
 	if err != nil {
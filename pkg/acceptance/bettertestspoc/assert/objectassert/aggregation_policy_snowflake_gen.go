@@ -0,0 +1,231 @@
+// Code generated by object assertions generator (v0.1.0); DO NOT EDIT.
+
+package objectassert
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+type AggregationPolicyAssert struct {
+	*assert.SnowflakeObjectAssert[sdk.AggregationPolicy, sdk.SchemaObjectIdentifier]
+}
+
+func AggregationPolicy(t *testing.T, id sdk.SchemaObjectIdentifier) *AggregationPolicyAssert {
+	t.Helper()
+	return &AggregationPolicyAssert{
+		assert.NewSnowflakeObjectAssertWithTestClientObjectProvider(sdk.ObjectTypeAggregationPolicy, id, func(testClient *helpers.TestClient) assert.ObjectProvider[sdk.AggregationPolicy, sdk.SchemaObjectIdentifier] {
+			return testClient.AggregationPolicy.Show
+		}),
+	}
+}
+
+func AggregationPolicyFromObject(t *testing.T, aggregationPolicy *sdk.AggregationPolicy) *AggregationPolicyAssert {
+	t.Helper()
+	return &AggregationPolicyAssert{
+		assert.NewSnowflakeObjectAssertWithObject(sdk.ObjectTypeAggregationPolicy, aggregationPolicy.ID(), aggregationPolicy),
+	}
+}
+
+func (a *AggregationPolicyAssert) HasCreatedOn(expected time.Time) *AggregationPolicyAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.AggregationPolicy) error {
+		t.Helper()
+		if o.CreatedOn != expected {
+			return fmt.Errorf("expected created on: %v; got: %v", expected, o.CreatedOn)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *AggregationPolicyAssert) HasCreatedOnNotEmpty() *AggregationPolicyAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.AggregationPolicy) error {
+		t.Helper()
+		if o.CreatedOn.IsZero() {
+			return fmt.Errorf("expected created on to be set; got zero value")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *AggregationPolicyAssert) HasName(expected string) *AggregationPolicyAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.AggregationPolicy) error {
+		t.Helper()
+		if o.Name != expected {
+			return fmt.Errorf("expected name: %v; got: %v", expected, o.Name)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *AggregationPolicyAssert) HasNameNotEmpty() *AggregationPolicyAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.AggregationPolicy) error {
+		t.Helper()
+		if o.Name == "" {
+			return fmt.Errorf("expected name to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *AggregationPolicyAssert) HasDatabaseName(expected string) *AggregationPolicyAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.AggregationPolicy) error {
+		t.Helper()
+		if o.DatabaseName != expected {
+			return fmt.Errorf("expected database name: %v; got: %v", expected, o.DatabaseName)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *AggregationPolicyAssert) HasDatabaseNameNotEmpty() *AggregationPolicyAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.AggregationPolicy) error {
+		t.Helper()
+		if o.DatabaseName == "" {
+			return fmt.Errorf("expected database name to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *AggregationPolicyAssert) HasSchemaName(expected string) *AggregationPolicyAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.AggregationPolicy) error {
+		t.Helper()
+		if o.SchemaName != expected {
+			return fmt.Errorf("expected schema name: %v; got: %v", expected, o.SchemaName)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *AggregationPolicyAssert) HasSchemaNameNotEmpty() *AggregationPolicyAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.AggregationPolicy) error {
+		t.Helper()
+		if o.SchemaName == "" {
+			return fmt.Errorf("expected schema name to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *AggregationPolicyAssert) HasKind(expected string) *AggregationPolicyAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.AggregationPolicy) error {
+		t.Helper()
+		if o.Kind != expected {
+			return fmt.Errorf("expected kind: %v; got: %v", expected, o.Kind)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *AggregationPolicyAssert) HasKindNotEmpty() *AggregationPolicyAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.AggregationPolicy) error {
+		t.Helper()
+		if o.Kind == "" {
+			return fmt.Errorf("expected kind to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *AggregationPolicyAssert) HasOwner(expected string) *AggregationPolicyAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.AggregationPolicy) error {
+		t.Helper()
+		if o.Owner != expected {
+			return fmt.Errorf("expected owner: %v; got: %v", expected, o.Owner)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *AggregationPolicyAssert) HasOwnerNotEmpty() *AggregationPolicyAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.AggregationPolicy) error {
+		t.Helper()
+		if o.Owner == "" {
+			return fmt.Errorf("expected owner to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *AggregationPolicyAssert) HasComment(expected string) *AggregationPolicyAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.AggregationPolicy) error {
+		t.Helper()
+		if o.Comment != expected {
+			return fmt.Errorf("expected comment: %v; got: %v", expected, o.Comment)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *AggregationPolicyAssert) HasCommentNotEmpty() *AggregationPolicyAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.AggregationPolicy) error {
+		t.Helper()
+		if o.Comment == "" {
+			return fmt.Errorf("expected comment to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *AggregationPolicyAssert) HasOptions(expected string) *AggregationPolicyAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.AggregationPolicy) error {
+		t.Helper()
+		if o.Options != expected {
+			return fmt.Errorf("expected options: %v; got: %v", expected, o.Options)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *AggregationPolicyAssert) HasOptionsNotEmpty() *AggregationPolicyAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.AggregationPolicy) error {
+		t.Helper()
+		if o.Options == "" {
+			return fmt.Errorf("expected options to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *AggregationPolicyAssert) HasOwnerRoleType(expected string) *AggregationPolicyAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.AggregationPolicy) error {
+		t.Helper()
+		if o.OwnerRoleType != expected {
+			return fmt.Errorf("expected owner role type: %v; got: %v", expected, o.OwnerRoleType)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *AggregationPolicyAssert) HasOwnerRoleTypeNotEmpty() *AggregationPolicyAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.AggregationPolicy) error {
+		t.Helper()
+		if o.OwnerRoleType == "" {
+			return fmt.Errorf("expected owner role type to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
@@ -0,0 +1,242 @@
+// Code generated by object assertions generator (v0.1.0); DO NOT EDIT.
+
+package objectassert
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+type ApplicationPackageAssert struct {
+	*assert.SnowflakeObjectAssert[sdk.ApplicationPackage, sdk.AccountObjectIdentifier]
+}
+
+func ApplicationPackage(t *testing.T, id sdk.AccountObjectIdentifier) *ApplicationPackageAssert {
+	t.Helper()
+	return &ApplicationPackageAssert{
+		assert.NewSnowflakeObjectAssertWithTestClientObjectProvider(sdk.ObjectTypeApplicationPackage, id, func(testClient *helpers.TestClient) assert.ObjectProvider[sdk.ApplicationPackage, sdk.AccountObjectIdentifier] {
+			return testClient.ApplicationPackage.Show
+		}),
+	}
+}
+
+func ApplicationPackageFromObject(t *testing.T, applicationPackage *sdk.ApplicationPackage) *ApplicationPackageAssert {
+	t.Helper()
+	return &ApplicationPackageAssert{
+		assert.NewSnowflakeObjectAssertWithObject(sdk.ObjectTypeApplicationPackage, applicationPackage.ID(), applicationPackage),
+	}
+}
+
+func (a *ApplicationPackageAssert) HasCreatedOn(expected time.Time) *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.CreatedOn != expected {
+			return fmt.Errorf("expected created on: %v; got: %v", expected, o.CreatedOn)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationPackageAssert) HasCreatedOnNotEmpty() *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.CreatedOn.IsZero() {
+			return fmt.Errorf("expected created on to be set; got zero value")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationPackageAssert) HasName(expected string) *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.Name != expected {
+			return fmt.Errorf("expected name: %v; got: %v", expected, o.Name)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationPackageAssert) HasNameNotEmpty() *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.Name == "" {
+			return fmt.Errorf("expected name to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationPackageAssert) HasIsDefault(expected bool) *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.IsDefault != expected {
+			return fmt.Errorf("expected is default: %v; got: %v", expected, o.IsDefault)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationPackageAssert) HasIsCurrent(expected bool) *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.IsCurrent != expected {
+			return fmt.Errorf("expected is current: %v; got: %v", expected, o.IsCurrent)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationPackageAssert) HasDistribution(expected string) *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.Distribution != expected {
+			return fmt.Errorf("expected distribution: %v; got: %v", expected, o.Distribution)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationPackageAssert) HasDistributionNotEmpty() *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.Distribution == "" {
+			return fmt.Errorf("expected distribution to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationPackageAssert) HasOwner(expected string) *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.Owner != expected {
+			return fmt.Errorf("expected owner: %v; got: %v", expected, o.Owner)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationPackageAssert) HasOwnerNotEmpty() *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.Owner == "" {
+			return fmt.Errorf("expected owner to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationPackageAssert) HasComment(expected string) *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.Comment != expected {
+			return fmt.Errorf("expected comment: %v; got: %v", expected, o.Comment)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationPackageAssert) HasCommentNotEmpty() *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.Comment == "" {
+			return fmt.Errorf("expected comment to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationPackageAssert) HasRetentionTime(expected int) *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.RetentionTime != expected {
+			return fmt.Errorf("expected retention time: %v; got: %v", expected, o.RetentionTime)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationPackageAssert) HasOptions(expected string) *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.Options != expected {
+			return fmt.Errorf("expected options: %v; got: %v", expected, o.Options)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationPackageAssert) HasOptionsNotEmpty() *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.Options == "" {
+			return fmt.Errorf("expected options to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationPackageAssert) HasDroppedOn(expected string) *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.DroppedOn != expected {
+			return fmt.Errorf("expected dropped on: %v; got: %v", expected, o.DroppedOn)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationPackageAssert) HasDroppedOnNotEmpty() *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.DroppedOn == "" {
+			return fmt.Errorf("expected dropped on to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationPackageAssert) HasApplicationClass(expected string) *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.ApplicationClass != expected {
+			return fmt.Errorf("expected application class: %v; got: %v", expected, o.ApplicationClass)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationPackageAssert) HasApplicationClassNotEmpty() *ApplicationPackageAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.ApplicationPackage) error {
+		t.Helper()
+		if o.ApplicationClass == "" {
+			return fmt.Errorf("expected application class to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
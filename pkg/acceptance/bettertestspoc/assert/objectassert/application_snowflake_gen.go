@@ -0,0 +1,275 @@
+// Code generated by object assertions generator (v0.1.0); DO NOT EDIT.
+
+package objectassert
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+type ApplicationAssert struct {
+	*assert.SnowflakeObjectAssert[sdk.Application, sdk.AccountObjectIdentifier]
+}
+
+func Application(t *testing.T, id sdk.AccountObjectIdentifier) *ApplicationAssert {
+	t.Helper()
+	return &ApplicationAssert{
+		assert.NewSnowflakeObjectAssertWithTestClientObjectProvider(sdk.ObjectTypeApplication, id, func(testClient *helpers.TestClient) assert.ObjectProvider[sdk.Application, sdk.AccountObjectIdentifier] {
+			return testClient.Application.Show
+		}),
+	}
+}
+
+func ApplicationFromObject(t *testing.T, application *sdk.Application) *ApplicationAssert {
+	t.Helper()
+	return &ApplicationAssert{
+		assert.NewSnowflakeObjectAssertWithObject(sdk.ObjectTypeApplication, application.ID(), application),
+	}
+}
+
+func (a *ApplicationAssert) HasCreatedOn(expected time.Time) *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.CreatedOn != expected {
+			return fmt.Errorf("expected created on: %v; got: %v", expected, o.CreatedOn)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasCreatedOnNotEmpty() *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.CreatedOn.IsZero() {
+			return fmt.Errorf("expected created on to be set; got zero value")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasName(expected string) *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.Name != expected {
+			return fmt.Errorf("expected name: %v; got: %v", expected, o.Name)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasNameNotEmpty() *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.Name == "" {
+			return fmt.Errorf("expected name to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasIsDefault(expected bool) *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.IsDefault != expected {
+			return fmt.Errorf("expected is default: %v; got: %v", expected, o.IsDefault)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasIsCurrent(expected bool) *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.IsCurrent != expected {
+			return fmt.Errorf("expected is current: %v; got: %v", expected, o.IsCurrent)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasSourceType(expected string) *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.SourceType != expected {
+			return fmt.Errorf("expected source type: %v; got: %v", expected, o.SourceType)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasSourceTypeNotEmpty() *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.SourceType == "" {
+			return fmt.Errorf("expected source type to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasSource(expected string) *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.Source != expected {
+			return fmt.Errorf("expected source: %v; got: %v", expected, o.Source)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasSourceNotEmpty() *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.Source == "" {
+			return fmt.Errorf("expected source to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasOwner(expected string) *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.Owner != expected {
+			return fmt.Errorf("expected owner: %v; got: %v", expected, o.Owner)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasOwnerNotEmpty() *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.Owner == "" {
+			return fmt.Errorf("expected owner to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasComment(expected string) *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.Comment != expected {
+			return fmt.Errorf("expected comment: %v; got: %v", expected, o.Comment)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasCommentNotEmpty() *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.Comment == "" {
+			return fmt.Errorf("expected comment to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasVersion(expected string) *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.Version != expected {
+			return fmt.Errorf("expected version: %v; got: %v", expected, o.Version)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasVersionNotEmpty() *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.Version == "" {
+			return fmt.Errorf("expected version to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasLabel(expected string) *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.Label != expected {
+			return fmt.Errorf("expected label: %v; got: %v", expected, o.Label)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasLabelNotEmpty() *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.Label == "" {
+			return fmt.Errorf("expected label to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasPatch(expected int) *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.Patch != expected {
+			return fmt.Errorf("expected patch: %v; got: %v", expected, o.Patch)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasOptions(expected string) *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.Options != expected {
+			return fmt.Errorf("expected options: %v; got: %v", expected, o.Options)
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasOptionsNotEmpty() *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.Options == "" {
+			return fmt.Errorf("expected options to be non-empty")
+		}
+		return nil
+	})
+	return a
+}
+
+func (a *ApplicationAssert) HasRetentionTime(expected int) *ApplicationAssert {
+	a.AddAssertion(func(t *testing.T, o *sdk.Application) error {
+		t.Helper()
+		if o.RetentionTime != expected {
+			return fmt.Errorf("expected retention time: %v; got: %v", expected, o.RetentionTime)
+		}
+		return nil
+	})
+	return a
+}
@@ -0,0 +1,251 @@
+// Code generated by object assertions generator (v0.1.0); DO NOT EDIT.
+
+package objectassert
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+type BackupPolicyDetailsAssert struct {
+	*assert.SnowflakeObjectAssert[sdk.BackupPolicyDetails, sdk.SchemaObjectIdentifier]
+}
+
+func BackupPolicyDetails(t *testing.T, id sdk.SchemaObjectIdentifier) *BackupPolicyDetailsAssert {
+	t.Helper()
+	return &BackupPolicyDetailsAssert{
+		assert.NewSnowflakeObjectAssertWithTestClientObjectProvider(sdk.ObjectType("BackupPolicyDetails"), id, func(testClient *helpers.TestClient) assert.ObjectProvider[sdk.BackupPolicyDetails, sdk.SchemaObjectIdentifier] {
+			return testClient.BackupPolicy.Describe
+		}),
+	}
+}
+
+func BackupPolicyDetailsFromObject(t *testing.T, backupPolicyDetails *sdk.BackupPolicyDetails) *BackupPolicyDetailsAssert {
+	t.Helper()
+	return &BackupPolicyDetailsAssert{
+		assert.NewSnowflakeObjectAssertWithObject(sdk.ObjectType("BackupPolicyDetails"), backupPolicyDetails.ID(), backupPolicyDetails),
+	}
+}
+
+func (b *BackupPolicyDetailsAssert) HasCreatedOn(expected time.Time) *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.CreatedOn != expected {
+			return fmt.Errorf("expected created on: %v; got: %v", expected, o.CreatedOn)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyDetailsAssert) HasCreatedOnNotEmpty() *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.CreatedOn.IsZero() {
+			return fmt.Errorf("expected created on to be set; got zero value")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyDetailsAssert) HasName(expected string) *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.Name != expected {
+			return fmt.Errorf("expected name: %v; got: %v", expected, o.Name)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyDetailsAssert) HasNameNotEmpty() *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.Name == "" {
+			return fmt.Errorf("expected name to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyDetailsAssert) HasDatabaseName(expected string) *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.DatabaseName != expected {
+			return fmt.Errorf("expected database name: %v; got: %v", expected, o.DatabaseName)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyDetailsAssert) HasDatabaseNameNotEmpty() *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.DatabaseName == "" {
+			return fmt.Errorf("expected database name to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyDetailsAssert) HasSchemaName(expected string) *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.SchemaName != expected {
+			return fmt.Errorf("expected schema name: %v; got: %v", expected, o.SchemaName)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyDetailsAssert) HasSchemaNameNotEmpty() *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.SchemaName == "" {
+			return fmt.Errorf("expected schema name to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyDetailsAssert) HasOwner(expected string) *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.Owner != expected {
+			return fmt.Errorf("expected owner: %v; got: %v", expected, o.Owner)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyDetailsAssert) HasOwnerNotEmpty() *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.Owner == "" {
+			return fmt.Errorf("expected owner to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyDetailsAssert) HasOwnerRoleType(expected string) *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.OwnerRoleType != expected {
+			return fmt.Errorf("expected owner role type: %v; got: %v", expected, o.OwnerRoleType)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyDetailsAssert) HasOwnerRoleTypeNotEmpty() *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.OwnerRoleType == "" {
+			return fmt.Errorf("expected owner role type to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyDetailsAssert) HasComment(expected string) *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.Comment == nil {
+			return fmt.Errorf("expected comment to have value; got: nil")
+		}
+		if *o.Comment != expected {
+			return fmt.Errorf("expected comment: %v; got: %v", expected, *o.Comment)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyDetailsAssert) HasNoComment() *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.Comment != nil {
+			return fmt.Errorf("expected comment to be nil; got: %v", *o.Comment)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyDetailsAssert) HasSchedule(expected string) *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.Schedule == nil {
+			return fmt.Errorf("expected schedule to have value; got: nil")
+		}
+		if *o.Schedule != expected {
+			return fmt.Errorf("expected schedule: %v; got: %v", expected, *o.Schedule)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyDetailsAssert) HasNoSchedule() *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.Schedule != nil {
+			return fmt.Errorf("expected schedule to be nil; got: %v", *o.Schedule)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyDetailsAssert) HasExpireAfterDays(expected int) *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.ExpireAfterDays == nil {
+			return fmt.Errorf("expected expire after days to have value; got: nil")
+		}
+		if *o.ExpireAfterDays != expected {
+			return fmt.Errorf("expected expire after days: %v; got: %v", expected, *o.ExpireAfterDays)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyDetailsAssert) HasNoExpireAfterDays() *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.ExpireAfterDays != nil {
+			return fmt.Errorf("expected expire after days to be nil; got: %v", *o.ExpireAfterDays)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyDetailsAssert) HasHasRetentionLock(expected bool) *BackupPolicyDetailsAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicyDetails) error {
+		t.Helper()
+		if o.HasRetentionLock != expected {
+			return fmt.Errorf("expected has retention lock: %v; got: %v", expected, o.HasRetentionLock)
+		}
+		return nil
+	})
+	return b
+}
@@ -0,0 +1,251 @@
+// Code generated by object assertions generator (v0.1.0); DO NOT EDIT.
+
+package objectassert
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+type BackupPolicyAssert struct {
+	*assert.SnowflakeObjectAssert[sdk.BackupPolicy, sdk.SchemaObjectIdentifier]
+}
+
+func BackupPolicy(t *testing.T, id sdk.SchemaObjectIdentifier) *BackupPolicyAssert {
+	t.Helper()
+	return &BackupPolicyAssert{
+		assert.NewSnowflakeObjectAssertWithTestClientObjectProvider(sdk.ObjectTypeBackupPolicy, id, func(testClient *helpers.TestClient) assert.ObjectProvider[sdk.BackupPolicy, sdk.SchemaObjectIdentifier] {
+			return testClient.BackupPolicy.Show
+		}),
+	}
+}
+
+func BackupPolicyFromObject(t *testing.T, backupPolicy *sdk.BackupPolicy) *BackupPolicyAssert {
+	t.Helper()
+	return &BackupPolicyAssert{
+		assert.NewSnowflakeObjectAssertWithObject(sdk.ObjectTypeBackupPolicy, backupPolicy.ID(), backupPolicy),
+	}
+}
+
+func (b *BackupPolicyAssert) HasCreatedOn(expected time.Time) *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.CreatedOn != expected {
+			return fmt.Errorf("expected created on: %v; got: %v", expected, o.CreatedOn)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyAssert) HasCreatedOnNotEmpty() *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.CreatedOn.IsZero() {
+			return fmt.Errorf("expected created on to be set; got zero value")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyAssert) HasName(expected string) *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.Name != expected {
+			return fmt.Errorf("expected name: %v; got: %v", expected, o.Name)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyAssert) HasNameNotEmpty() *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.Name == "" {
+			return fmt.Errorf("expected name to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyAssert) HasDatabaseName(expected string) *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.DatabaseName != expected {
+			return fmt.Errorf("expected database name: %v; got: %v", expected, o.DatabaseName)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyAssert) HasDatabaseNameNotEmpty() *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.DatabaseName == "" {
+			return fmt.Errorf("expected database name to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyAssert) HasSchemaName(expected string) *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.SchemaName != expected {
+			return fmt.Errorf("expected schema name: %v; got: %v", expected, o.SchemaName)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyAssert) HasSchemaNameNotEmpty() *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.SchemaName == "" {
+			return fmt.Errorf("expected schema name to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyAssert) HasOwner(expected string) *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.Owner != expected {
+			return fmt.Errorf("expected owner: %v; got: %v", expected, o.Owner)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyAssert) HasOwnerNotEmpty() *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.Owner == "" {
+			return fmt.Errorf("expected owner to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyAssert) HasOwnerRoleType(expected string) *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.OwnerRoleType != expected {
+			return fmt.Errorf("expected owner role type: %v; got: %v", expected, o.OwnerRoleType)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyAssert) HasOwnerRoleTypeNotEmpty() *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.OwnerRoleType == "" {
+			return fmt.Errorf("expected owner role type to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyAssert) HasComment(expected string) *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.Comment == nil {
+			return fmt.Errorf("expected comment to have value; got: nil")
+		}
+		if *o.Comment != expected {
+			return fmt.Errorf("expected comment: %v; got: %v", expected, *o.Comment)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyAssert) HasNoComment() *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.Comment != nil {
+			return fmt.Errorf("expected comment to be nil; got: %v", *o.Comment)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyAssert) HasSchedule(expected string) *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.Schedule == nil {
+			return fmt.Errorf("expected schedule to have value; got: nil")
+		}
+		if *o.Schedule != expected {
+			return fmt.Errorf("expected schedule: %v; got: %v", expected, *o.Schedule)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyAssert) HasNoSchedule() *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.Schedule != nil {
+			return fmt.Errorf("expected schedule to be nil; got: %v", *o.Schedule)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyAssert) HasExpireAfterDays(expected int) *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.ExpireAfterDays == nil {
+			return fmt.Errorf("expected expire after days to have value; got: nil")
+		}
+		if *o.ExpireAfterDays != expected {
+			return fmt.Errorf("expected expire after days: %v; got: %v", expected, *o.ExpireAfterDays)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyAssert) HasNoExpireAfterDays() *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.ExpireAfterDays != nil {
+			return fmt.Errorf("expected expire after days to be nil; got: %v", *o.ExpireAfterDays)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupPolicyAssert) HasHasRetentionLock(expected bool) *BackupPolicyAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupPolicy) error {
+		t.Helper()
+		if o.HasRetentionLock != expected {
+			return fmt.Errorf("expected has retention lock: %v; got: %v", expected, o.HasRetentionLock)
+		}
+		return nil
+	})
+	return b
+}
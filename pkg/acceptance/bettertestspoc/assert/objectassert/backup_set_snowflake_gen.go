@@ -0,0 +1,309 @@
+// Code generated by object assertions generator (v0.1.0); DO NOT EDIT.
+
+package objectassert
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+type BackupSetAssert struct {
+	*assert.SnowflakeObjectAssert[sdk.BackupSet, sdk.SchemaObjectIdentifier]
+}
+
+func BackupSet(t *testing.T, id sdk.SchemaObjectIdentifier) *BackupSetAssert {
+	t.Helper()
+	return &BackupSetAssert{
+		assert.NewSnowflakeObjectAssertWithTestClientObjectProvider(sdk.ObjectTypeBackupSet, id, func(testClient *helpers.TestClient) assert.ObjectProvider[sdk.BackupSet, sdk.SchemaObjectIdentifier] {
+			return testClient.BackupSet.Show
+		}),
+	}
+}
+
+func BackupSetFromObject(t *testing.T, backupSet *sdk.BackupSet) *BackupSetAssert {
+	t.Helper()
+	return &BackupSetAssert{
+		assert.NewSnowflakeObjectAssertWithObject(sdk.ObjectTypeBackupSet, backupSet.ID(), backupSet),
+	}
+}
+
+func (b *BackupSetAssert) HasCreatedOn(expected time.Time) *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.CreatedOn != expected {
+			return fmt.Errorf("expected created on: %v; got: %v", expected, o.CreatedOn)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasCreatedOnNotEmpty() *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.CreatedOn.IsZero() {
+			return fmt.Errorf("expected created on to be set; got zero value")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasName(expected string) *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.Name != expected {
+			return fmt.Errorf("expected name: %v; got: %v", expected, o.Name)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasNameNotEmpty() *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.Name == "" {
+			return fmt.Errorf("expected name to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasDatabaseName(expected string) *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.DatabaseName != expected {
+			return fmt.Errorf("expected database name: %v; got: %v", expected, o.DatabaseName)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasDatabaseNameNotEmpty() *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.DatabaseName == "" {
+			return fmt.Errorf("expected database name to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasSchemaName(expected string) *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.SchemaName != expected {
+			return fmt.Errorf("expected schema name: %v; got: %v", expected, o.SchemaName)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasSchemaNameNotEmpty() *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.SchemaName == "" {
+			return fmt.Errorf("expected schema name to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasComment(expected string) *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.Comment == nil {
+			return fmt.Errorf("expected comment to have value; got: nil")
+		}
+		if *o.Comment != expected {
+			return fmt.Errorf("expected comment: %v; got: %v", expected, *o.Comment)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasNoComment() *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.Comment != nil {
+			return fmt.Errorf("expected comment to be nil; got: %v", *o.Comment)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasObjectKind(expected string) *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.ObjectKind != expected {
+			return fmt.Errorf("expected object kind: %v; got: %v", expected, o.ObjectKind)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasObjectKindNotEmpty() *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.ObjectKind == "" {
+			return fmt.Errorf("expected object kind to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasObjectName(expected string) *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.ObjectName != expected {
+			return fmt.Errorf("expected object name: %v; got: %v", expected, o.ObjectName)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasObjectNameNotEmpty() *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.ObjectName == "" {
+			return fmt.Errorf("expected object name to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasObjectDatabaseName(expected string) *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.ObjectDatabaseName == nil {
+			return fmt.Errorf("expected object database name to have value; got: nil")
+		}
+		if *o.ObjectDatabaseName != expected {
+			return fmt.Errorf("expected object database name: %v; got: %v", expected, *o.ObjectDatabaseName)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasNoObjectDatabaseName() *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.ObjectDatabaseName != nil {
+			return fmt.Errorf("expected object database name to be nil; got: %v", *o.ObjectDatabaseName)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasObjectSchemaName(expected string) *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.ObjectSchemaName == nil {
+			return fmt.Errorf("expected object schema name to have value; got: nil")
+		}
+		if *o.ObjectSchemaName != expected {
+			return fmt.Errorf("expected object schema name: %v; got: %v", expected, *o.ObjectSchemaName)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasNoObjectSchemaName() *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.ObjectSchemaName != nil {
+			return fmt.Errorf("expected object schema name to be nil; got: %v", *o.ObjectSchemaName)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasBackupPolicyName(expected string) *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.BackupPolicyName == nil {
+			return fmt.Errorf("expected backup policy name to have value; got: nil")
+		}
+		if *o.BackupPolicyName != expected {
+			return fmt.Errorf("expected backup policy name: %v; got: %v", expected, *o.BackupPolicyName)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasNoBackupPolicyName() *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.BackupPolicyName != nil {
+			return fmt.Errorf("expected backup policy name to be nil; got: %v", *o.BackupPolicyName)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasOwner(expected string) *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.Owner != expected {
+			return fmt.Errorf("expected owner: %v; got: %v", expected, o.Owner)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasOwnerNotEmpty() *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.Owner == "" {
+			return fmt.Errorf("expected owner to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasOwnerRoleType(expected string) *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.OwnerRoleType != expected {
+			return fmt.Errorf("expected owner role type: %v; got: %v", expected, o.OwnerRoleType)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BackupSetAssert) HasOwnerRoleTypeNotEmpty() *BackupSetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.BackupSet) error {
+		t.Helper()
+		if o.OwnerRoleType == "" {
+			return fmt.Errorf("expected owner role type to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
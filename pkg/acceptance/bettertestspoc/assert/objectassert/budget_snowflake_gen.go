@@ -0,0 +1,212 @@
+// Code generated by object assertions generator (v0.1.0); DO NOT EDIT.
+
+package objectassert
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+type BudgetAssert struct {
+	*assert.SnowflakeObjectAssert[sdk.Budget, sdk.SchemaObjectIdentifier]
+}
+
+func Budget(t *testing.T, id sdk.SchemaObjectIdentifier) *BudgetAssert {
+	t.Helper()
+	return &BudgetAssert{
+		assert.NewSnowflakeObjectAssertWithTestClientObjectProvider(sdk.ObjectTypeBudget, id, func(testClient *helpers.TestClient) assert.ObjectProvider[sdk.Budget, sdk.SchemaObjectIdentifier] {
+			return testClient.Budget.Show
+		}),
+	}
+}
+
+func BudgetFromObject(t *testing.T, budget *sdk.Budget) *BudgetAssert {
+	t.Helper()
+	return &BudgetAssert{
+		assert.NewSnowflakeObjectAssertWithObject(sdk.ObjectTypeBudget, budget.ID(), budget),
+	}
+}
+
+func (b *BudgetAssert) HasCreatedOn(expected time.Time) *BudgetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.Budget) error {
+		t.Helper()
+		if o.CreatedOn != expected {
+			return fmt.Errorf("expected created on: %v; got: %v", expected, o.CreatedOn)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BudgetAssert) HasCreatedOnNotEmpty() *BudgetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.Budget) error {
+		t.Helper()
+		if o.CreatedOn.IsZero() {
+			return fmt.Errorf("expected created on to be set; got zero value")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BudgetAssert) HasName(expected string) *BudgetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.Budget) error {
+		t.Helper()
+		if o.Name != expected {
+			return fmt.Errorf("expected name: %v; got: %v", expected, o.Name)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BudgetAssert) HasNameNotEmpty() *BudgetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.Budget) error {
+		t.Helper()
+		if o.Name == "" {
+			return fmt.Errorf("expected name to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BudgetAssert) HasDatabaseName(expected string) *BudgetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.Budget) error {
+		t.Helper()
+		if o.DatabaseName != expected {
+			return fmt.Errorf("expected database name: %v; got: %v", expected, o.DatabaseName)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BudgetAssert) HasDatabaseNameNotEmpty() *BudgetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.Budget) error {
+		t.Helper()
+		if o.DatabaseName == "" {
+			return fmt.Errorf("expected database name to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BudgetAssert) HasSchemaName(expected string) *BudgetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.Budget) error {
+		t.Helper()
+		if o.SchemaName != expected {
+			return fmt.Errorf("expected schema name: %v; got: %v", expected, o.SchemaName)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BudgetAssert) HasSchemaNameNotEmpty() *BudgetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.Budget) error {
+		t.Helper()
+		if o.SchemaName == "" {
+			return fmt.Errorf("expected schema name to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BudgetAssert) HasCurrentVersion(expected string) *BudgetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.Budget) error {
+		t.Helper()
+		if o.CurrentVersion != expected {
+			return fmt.Errorf("expected current version: %v; got: %v", expected, o.CurrentVersion)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BudgetAssert) HasCurrentVersionNotEmpty() *BudgetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.Budget) error {
+		t.Helper()
+		if o.CurrentVersion == "" {
+			return fmt.Errorf("expected current version to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BudgetAssert) HasComment(expected string) *BudgetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.Budget) error {
+		t.Helper()
+		if o.Comment == nil {
+			return fmt.Errorf("expected comment to have value; got: nil")
+		}
+		if *o.Comment != expected {
+			return fmt.Errorf("expected comment: %v; got: %v", expected, *o.Comment)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BudgetAssert) HasNoComment() *BudgetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.Budget) error {
+		t.Helper()
+		if o.Comment != nil {
+			return fmt.Errorf("expected comment to be nil; got: %v", *o.Comment)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BudgetAssert) HasOwner(expected string) *BudgetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.Budget) error {
+		t.Helper()
+		if o.Owner != expected {
+			return fmt.Errorf("expected owner: %v; got: %v", expected, o.Owner)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BudgetAssert) HasOwnerNotEmpty() *BudgetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.Budget) error {
+		t.Helper()
+		if o.Owner == "" {
+			return fmt.Errorf("expected owner to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BudgetAssert) HasOwnerRoleType(expected string) *BudgetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.Budget) error {
+		t.Helper()
+		if o.OwnerRoleType != expected {
+			return fmt.Errorf("expected owner role type: %v; got: %v", expected, o.OwnerRoleType)
+		}
+		return nil
+	})
+	return b
+}
+
+func (b *BudgetAssert) HasOwnerRoleTypeNotEmpty() *BudgetAssert {
+	b.AddAssertion(func(t *testing.T, o *sdk.Budget) error {
+		t.Helper()
+		if o.OwnerRoleType == "" {
+			return fmt.Errorf("expected owner role type to be non-empty")
+		}
+		return nil
+	})
+	return b
+}
@@ -0,0 +1,263 @@
+// Code generated by object assertions generator (v0.1.0); DO NOT EDIT.
+
+package objectassert
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+type ContactAssert struct {
+	*assert.SnowflakeObjectAssert[sdk.Contact, sdk.SchemaObjectIdentifier]
+}
+
+func Contact(t *testing.T, id sdk.SchemaObjectIdentifier) *ContactAssert {
+	t.Helper()
+	return &ContactAssert{
+		assert.NewSnowflakeObjectAssertWithTestClientObjectProvider(sdk.ObjectTypeContact, id, func(testClient *helpers.TestClient) assert.ObjectProvider[sdk.Contact, sdk.SchemaObjectIdentifier] {
+			return testClient.Contact.Show
+		}),
+	}
+}
+
+func ContactFromObject(t *testing.T, contact *sdk.Contact) *ContactAssert {
+	t.Helper()
+	return &ContactAssert{
+		assert.NewSnowflakeObjectAssertWithObject(sdk.ObjectTypeContact, contact.ID(), contact),
+	}
+}
+
+func (c *ContactAssert) HasCreatedOn(expected time.Time) *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if o.CreatedOn != expected {
+			return fmt.Errorf("expected created on: %v; got: %v", expected, o.CreatedOn)
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasCreatedOnNotEmpty() *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if o.CreatedOn.IsZero() {
+			return fmt.Errorf("expected created on to be set; got zero value")
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasName(expected string) *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if o.Name != expected {
+			return fmt.Errorf("expected name: %v; got: %v", expected, o.Name)
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasNameNotEmpty() *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if o.Name == "" {
+			return fmt.Errorf("expected name to be non-empty")
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasDatabaseName(expected string) *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if o.DatabaseName != expected {
+			return fmt.Errorf("expected database name: %v; got: %v", expected, o.DatabaseName)
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasDatabaseNameNotEmpty() *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if o.DatabaseName == "" {
+			return fmt.Errorf("expected database name to be non-empty")
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasSchemaName(expected string) *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if o.SchemaName != expected {
+			return fmt.Errorf("expected schema name: %v; got: %v", expected, o.SchemaName)
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasSchemaNameNotEmpty() *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if o.SchemaName == "" {
+			return fmt.Errorf("expected schema name to be non-empty")
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasOwner(expected string) *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if o.Owner != expected {
+			return fmt.Errorf("expected owner: %v; got: %v", expected, o.Owner)
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasOwnerNotEmpty() *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if o.Owner == "" {
+			return fmt.Errorf("expected owner to be non-empty")
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasComment(expected string) *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if o.Comment != expected {
+			return fmt.Errorf("expected comment: %v; got: %v", expected, o.Comment)
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasCommentNotEmpty() *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if o.Comment == "" {
+			return fmt.Errorf("expected comment to be non-empty")
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasUsers(expected ...string) *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		mapped := collections.Map(o.Users, func(item string) any { return item })
+		mappedExpected := collections.Map(expected, func(item string) any { return item })
+		if !slices.Equal(mapped, mappedExpected) {
+			return fmt.Errorf("expected users: %v; got: %v", expected, o.Users)
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasNoUsers() *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if len(o.Users) > 0 {
+			return fmt.Errorf("expected users to be empty; got: %v", o.Users)
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasEmailDistributionList(expected string) *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if o.EmailDistributionList == nil {
+			return fmt.Errorf("expected email distribution list to have value; got: nil")
+		}
+		if *o.EmailDistributionList != expected {
+			return fmt.Errorf("expected email distribution list: %v; got: %v", expected, *o.EmailDistributionList)
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasNoEmailDistributionList() *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if o.EmailDistributionList != nil {
+			return fmt.Errorf("expected email distribution list to be nil; got: %v", *o.EmailDistributionList)
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasUrl(expected string) *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if o.Url == nil {
+			return fmt.Errorf("expected url to have value; got: nil")
+		}
+		if *o.Url != expected {
+			return fmt.Errorf("expected url: %v; got: %v", expected, *o.Url)
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasNoUrl() *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if o.Url != nil {
+			return fmt.Errorf("expected url to be nil; got: %v", *o.Url)
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasOwnerRoleType(expected string) *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if o.OwnerRoleType != expected {
+			return fmt.Errorf("expected owner role type: %v; got: %v", expected, o.OwnerRoleType)
+		}
+		return nil
+	})
+	return c
+}
+
+func (c *ContactAssert) HasOwnerRoleTypeNotEmpty() *ContactAssert {
+	c.AddAssertion(func(t *testing.T, o *sdk.Contact) error {
+		t.Helper()
+		if o.OwnerRoleType == "" {
+			return fmt.Errorf("expected owner role type to be non-empty")
+		}
+		return nil
+	})
+	return c
+}
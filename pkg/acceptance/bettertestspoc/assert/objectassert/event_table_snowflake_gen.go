@@ -0,0 +1,187 @@
+// Code generated by object assertions generator (v0.1.0); DO NOT EDIT.
+
+package objectassert
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+type EventTableAssert struct {
+	*assert.SnowflakeObjectAssert[sdk.EventTable, sdk.SchemaObjectIdentifier]
+}
+
+func EventTable(t *testing.T, id sdk.SchemaObjectIdentifier) *EventTableAssert {
+	t.Helper()
+	return &EventTableAssert{
+		assert.NewSnowflakeObjectAssertWithTestClientObjectProvider(sdk.ObjectTypeEventTable, id, func(testClient *helpers.TestClient) assert.ObjectProvider[sdk.EventTable, sdk.SchemaObjectIdentifier] {
+			return testClient.EventTable.Show
+		}),
+	}
+}
+
+func EventTableFromObject(t *testing.T, eventTable *sdk.EventTable) *EventTableAssert {
+	t.Helper()
+	return &EventTableAssert{
+		assert.NewSnowflakeObjectAssertWithObject(sdk.ObjectTypeEventTable, eventTable.ID(), eventTable),
+	}
+}
+
+func (e *EventTableAssert) HasCreatedOn(expected time.Time) *EventTableAssert {
+	e.AddAssertion(func(t *testing.T, o *sdk.EventTable) error {
+		t.Helper()
+		if o.CreatedOn != expected {
+			return fmt.Errorf("expected created on: %v; got: %v", expected, o.CreatedOn)
+		}
+		return nil
+	})
+	return e
+}
+
+func (e *EventTableAssert) HasCreatedOnNotEmpty() *EventTableAssert {
+	e.AddAssertion(func(t *testing.T, o *sdk.EventTable) error {
+		t.Helper()
+		if o.CreatedOn.IsZero() {
+			return fmt.Errorf("expected created on to be set; got zero value")
+		}
+		return nil
+	})
+	return e
+}
+
+func (e *EventTableAssert) HasName(expected string) *EventTableAssert {
+	e.AddAssertion(func(t *testing.T, o *sdk.EventTable) error {
+		t.Helper()
+		if o.Name != expected {
+			return fmt.Errorf("expected name: %v; got: %v", expected, o.Name)
+		}
+		return nil
+	})
+	return e
+}
+
+func (e *EventTableAssert) HasNameNotEmpty() *EventTableAssert {
+	e.AddAssertion(func(t *testing.T, o *sdk.EventTable) error {
+		t.Helper()
+		if o.Name == "" {
+			return fmt.Errorf("expected name to be non-empty")
+		}
+		return nil
+	})
+	return e
+}
+
+func (e *EventTableAssert) HasDatabaseName(expected string) *EventTableAssert {
+	e.AddAssertion(func(t *testing.T, o *sdk.EventTable) error {
+		t.Helper()
+		if o.DatabaseName != expected {
+			return fmt.Errorf("expected database name: %v; got: %v", expected, o.DatabaseName)
+		}
+		return nil
+	})
+	return e
+}
+
+func (e *EventTableAssert) HasDatabaseNameNotEmpty() *EventTableAssert {
+	e.AddAssertion(func(t *testing.T, o *sdk.EventTable) error {
+		t.Helper()
+		if o.DatabaseName == "" {
+			return fmt.Errorf("expected database name to be non-empty")
+		}
+		return nil
+	})
+	return e
+}
+
+func (e *EventTableAssert) HasSchemaName(expected string) *EventTableAssert {
+	e.AddAssertion(func(t *testing.T, o *sdk.EventTable) error {
+		t.Helper()
+		if o.SchemaName != expected {
+			return fmt.Errorf("expected schema name: %v; got: %v", expected, o.SchemaName)
+		}
+		return nil
+	})
+	return e
+}
+
+func (e *EventTableAssert) HasSchemaNameNotEmpty() *EventTableAssert {
+	e.AddAssertion(func(t *testing.T, o *sdk.EventTable) error {
+		t.Helper()
+		if o.SchemaName == "" {
+			return fmt.Errorf("expected schema name to be non-empty")
+		}
+		return nil
+	})
+	return e
+}
+
+func (e *EventTableAssert) HasOwner(expected string) *EventTableAssert {
+	e.AddAssertion(func(t *testing.T, o *sdk.EventTable) error {
+		t.Helper()
+		if o.Owner != expected {
+			return fmt.Errorf("expected owner: %v; got: %v", expected, o.Owner)
+		}
+		return nil
+	})
+	return e
+}
+
+func (e *EventTableAssert) HasOwnerNotEmpty() *EventTableAssert {
+	e.AddAssertion(func(t *testing.T, o *sdk.EventTable) error {
+		t.Helper()
+		if o.Owner == "" {
+			return fmt.Errorf("expected owner to be non-empty")
+		}
+		return nil
+	})
+	return e
+}
+
+func (e *EventTableAssert) HasComment(expected string) *EventTableAssert {
+	e.AddAssertion(func(t *testing.T, o *sdk.EventTable) error {
+		t.Helper()
+		if o.Comment != expected {
+			return fmt.Errorf("expected comment: %v; got: %v", expected, o.Comment)
+		}
+		return nil
+	})
+	return e
+}
+
+func (e *EventTableAssert) HasCommentNotEmpty() *EventTableAssert {
+	e.AddAssertion(func(t *testing.T, o *sdk.EventTable) error {
+		t.Helper()
+		if o.Comment == "" {
+			return fmt.Errorf("expected comment to be non-empty")
+		}
+		return nil
+	})
+	return e
+}
+
+func (e *EventTableAssert) HasOwnerRoleType(expected string) *EventTableAssert {
+	e.AddAssertion(func(t *testing.T, o *sdk.EventTable) error {
+		t.Helper()
+		if o.OwnerRoleType != expected {
+			return fmt.Errorf("expected owner role type: %v; got: %v", expected, o.OwnerRoleType)
+		}
+		return nil
+	})
+	return e
+}
+
+func (e *EventTableAssert) HasOwnerRoleTypeNotEmpty() *EventTableAssert {
+	e.AddAssertion(func(t *testing.T, o *sdk.EventTable) error {
+		t.Helper()
+		if o.OwnerRoleType == "" {
+			return fmt.Errorf("expected owner role type to be non-empty")
+		}
+		return nil
+	})
+	return e
+}
@@ -0,0 +1,231 @@
+// Code generated by object assertions generator (v0.1.0); DO NOT EDIT.
+
+package objectassert
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+type JoinPolicyAssert struct {
+	*assert.SnowflakeObjectAssert[sdk.JoinPolicy, sdk.SchemaObjectIdentifier]
+}
+
+func JoinPolicy(t *testing.T, id sdk.SchemaObjectIdentifier) *JoinPolicyAssert {
+	t.Helper()
+	return &JoinPolicyAssert{
+		assert.NewSnowflakeObjectAssertWithTestClientObjectProvider(sdk.ObjectTypeJoinPolicy, id, func(testClient *helpers.TestClient) assert.ObjectProvider[sdk.JoinPolicy, sdk.SchemaObjectIdentifier] {
+			return testClient.JoinPolicy.Show
+		}),
+	}
+}
+
+func JoinPolicyFromObject(t *testing.T, joinPolicy *sdk.JoinPolicy) *JoinPolicyAssert {
+	t.Helper()
+	return &JoinPolicyAssert{
+		assert.NewSnowflakeObjectAssertWithObject(sdk.ObjectTypeJoinPolicy, joinPolicy.ID(), joinPolicy),
+	}
+}
+
+func (j *JoinPolicyAssert) HasCreatedOn(expected time.Time) *JoinPolicyAssert {
+	j.AddAssertion(func(t *testing.T, o *sdk.JoinPolicy) error {
+		t.Helper()
+		if o.CreatedOn != expected {
+			return fmt.Errorf("expected created on: %v; got: %v", expected, o.CreatedOn)
+		}
+		return nil
+	})
+	return j
+}
+
+func (j *JoinPolicyAssert) HasCreatedOnNotEmpty() *JoinPolicyAssert {
+	j.AddAssertion(func(t *testing.T, o *sdk.JoinPolicy) error {
+		t.Helper()
+		if o.CreatedOn.IsZero() {
+			return fmt.Errorf("expected created on to be set; got zero value")
+		}
+		return nil
+	})
+	return j
+}
+
+func (j *JoinPolicyAssert) HasName(expected string) *JoinPolicyAssert {
+	j.AddAssertion(func(t *testing.T, o *sdk.JoinPolicy) error {
+		t.Helper()
+		if o.Name != expected {
+			return fmt.Errorf("expected name: %v; got: %v", expected, o.Name)
+		}
+		return nil
+	})
+	return j
+}
+
+func (j *JoinPolicyAssert) HasNameNotEmpty() *JoinPolicyAssert {
+	j.AddAssertion(func(t *testing.T, o *sdk.JoinPolicy) error {
+		t.Helper()
+		if o.Name == "" {
+			return fmt.Errorf("expected name to be non-empty")
+		}
+		return nil
+	})
+	return j
+}
+
+func (j *JoinPolicyAssert) HasDatabaseName(expected string) *JoinPolicyAssert {
+	j.AddAssertion(func(t *testing.T, o *sdk.JoinPolicy) error {
+		t.Helper()
+		if o.DatabaseName != expected {
+			return fmt.Errorf("expected database name: %v; got: %v", expected, o.DatabaseName)
+		}
+		return nil
+	})
+	return j
+}
+
+func (j *JoinPolicyAssert) HasDatabaseNameNotEmpty() *JoinPolicyAssert {
+	j.AddAssertion(func(t *testing.T, o *sdk.JoinPolicy) error {
+		t.Helper()
+		if o.DatabaseName == "" {
+			return fmt.Errorf("expected database name to be non-empty")
+		}
+		return nil
+	})
+	return j
+}
+
+func (j *JoinPolicyAssert) HasSchemaName(expected string) *JoinPolicyAssert {
+	j.AddAssertion(func(t *testing.T, o *sdk.JoinPolicy) error {
+		t.Helper()
+		if o.SchemaName != expected {
+			return fmt.Errorf("expected schema name: %v; got: %v", expected, o.SchemaName)
+		}
+		return nil
+	})
+	return j
+}
+
+func (j *JoinPolicyAssert) HasSchemaNameNotEmpty() *JoinPolicyAssert {
+	j.AddAssertion(func(t *testing.T, o *sdk.JoinPolicy) error {
+		t.Helper()
+		if o.SchemaName == "" {
+			return fmt.Errorf("expected schema name to be non-empty")
+		}
+		return nil
+	})
+	return j
+}
+
+func (j *JoinPolicyAssert) HasKind(expected string) *JoinPolicyAssert {
+	j.AddAssertion(func(t *testing.T, o *sdk.JoinPolicy) error {
+		t.Helper()
+		if o.Kind != expected {
+			return fmt.Errorf("expected kind: %v; got: %v", expected, o.Kind)
+		}
+		return nil
+	})
+	return j
+}
+
+func (j *JoinPolicyAssert) HasKindNotEmpty() *JoinPolicyAssert {
+	j.AddAssertion(func(t *testing.T, o *sdk.JoinPolicy) error {
+		t.Helper()
+		if o.Kind == "" {
+			return fmt.Errorf("expected kind to be non-empty")
+		}
+		return nil
+	})
+	return j
+}
+
+func (j *JoinPolicyAssert) HasOwner(expected string) *JoinPolicyAssert {
+	j.AddAssertion(func(t *testing.T, o *sdk.JoinPolicy) error {
+		t.Helper()
+		if o.Owner != expected {
+			return fmt.Errorf("expected owner: %v; got: %v", expected, o.Owner)
+		}
+		return nil
+	})
+	return j
+}
+
+func (j *JoinPolicyAssert) HasOwnerNotEmpty() *JoinPolicyAssert {
+	j.AddAssertion(func(t *testing.T, o *sdk.JoinPolicy) error {
+		t.Helper()
+		if o.Owner == "" {
+			return fmt.Errorf("expected owner to be non-empty")
+		}
+		return nil
+	})
+	return j
+}
+
+func (j *JoinPolicyAssert) HasComment(expected string) *JoinPolicyAssert {
+	j.AddAssertion(func(t *testing.T, o *sdk.JoinPolicy) error {
+		t.Helper()
+		if o.Comment != expected {
+			return fmt.Errorf("expected comment: %v; got: %v", expected, o.Comment)
+		}
+		return nil
+	})
+	return j
+}
+
+func (j *JoinPolicyAssert) HasCommentNotEmpty() *JoinPolicyAssert {
+	j.AddAssertion(func(t *testing.T, o *sdk.JoinPolicy) error {
+		t.Helper()
+		if o.Comment == "" {
+			return fmt.Errorf("expected comment to be non-empty")
+		}
+		return nil
+	})
+	return j
+}
+
+func (j *JoinPolicyAssert) HasOptions(expected string) *JoinPolicyAssert {
+	j.AddAssertion(func(t *testing.T, o *sdk.JoinPolicy) error {
+		t.Helper()
+		if o.Options != expected {
+			return fmt.Errorf("expected options: %v; got: %v", expected, o.Options)
+		}
+		return nil
+	})
+	return j
+}
+
+func (j *JoinPolicyAssert) HasOptionsNotEmpty() *JoinPolicyAssert {
+	j.AddAssertion(func(t *testing.T, o *sdk.JoinPolicy) error {
+		t.Helper()
+		if o.Options == "" {
+			return fmt.Errorf("expected options to be non-empty")
+		}
+		return nil
+	})
+	return j
+}
+
+func (j *JoinPolicyAssert) HasOwnerRoleType(expected string) *JoinPolicyAssert {
+	j.AddAssertion(func(t *testing.T, o *sdk.JoinPolicy) error {
+		t.Helper()
+		if o.OwnerRoleType != expected {
+			return fmt.Errorf("expected owner role type: %v; got: %v", expected, o.OwnerRoleType)
+		}
+		return nil
+	})
+	return j
+}
+
+func (j *JoinPolicyAssert) HasOwnerRoleTypeNotEmpty() *JoinPolicyAssert {
+	j.AddAssertion(func(t *testing.T, o *sdk.JoinPolicy) error {
+		t.Helper()
+		if o.OwnerRoleType == "" {
+			return fmt.Errorf("expected owner role type to be non-empty")
+		}
+		return nil
+	})
+	return j
+}
@@ -0,0 +1,231 @@
+// Code generated by object assertions generator (v0.1.0); DO NOT EDIT.
+
+package objectassert
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+type ProjectionPolicyAssert struct {
+	*assert.SnowflakeObjectAssert[sdk.ProjectionPolicy, sdk.SchemaObjectIdentifier]
+}
+
+func ProjectionPolicy(t *testing.T, id sdk.SchemaObjectIdentifier) *ProjectionPolicyAssert {
+	t.Helper()
+	return &ProjectionPolicyAssert{
+		assert.NewSnowflakeObjectAssertWithTestClientObjectProvider(sdk.ObjectTypeProjectionPolicy, id, func(testClient *helpers.TestClient) assert.ObjectProvider[sdk.ProjectionPolicy, sdk.SchemaObjectIdentifier] {
+			return testClient.ProjectionPolicy.Show
+		}),
+	}
+}
+
+func ProjectionPolicyFromObject(t *testing.T, projectionPolicy *sdk.ProjectionPolicy) *ProjectionPolicyAssert {
+	t.Helper()
+	return &ProjectionPolicyAssert{
+		assert.NewSnowflakeObjectAssertWithObject(sdk.ObjectTypeProjectionPolicy, projectionPolicy.ID(), projectionPolicy),
+	}
+}
+
+func (p *ProjectionPolicyAssert) HasCreatedOn(expected time.Time) *ProjectionPolicyAssert {
+	p.AddAssertion(func(t *testing.T, o *sdk.ProjectionPolicy) error {
+		t.Helper()
+		if o.CreatedOn != expected {
+			return fmt.Errorf("expected created on: %v; got: %v", expected, o.CreatedOn)
+		}
+		return nil
+	})
+	return p
+}
+
+func (p *ProjectionPolicyAssert) HasCreatedOnNotEmpty() *ProjectionPolicyAssert {
+	p.AddAssertion(func(t *testing.T, o *sdk.ProjectionPolicy) error {
+		t.Helper()
+		if o.CreatedOn.IsZero() {
+			return fmt.Errorf("expected created on to be set; got zero value")
+		}
+		return nil
+	})
+	return p
+}
+
+func (p *ProjectionPolicyAssert) HasName(expected string) *ProjectionPolicyAssert {
+	p.AddAssertion(func(t *testing.T, o *sdk.ProjectionPolicy) error {
+		t.Helper()
+		if o.Name != expected {
+			return fmt.Errorf("expected name: %v; got: %v", expected, o.Name)
+		}
+		return nil
+	})
+	return p
+}
+
+func (p *ProjectionPolicyAssert) HasNameNotEmpty() *ProjectionPolicyAssert {
+	p.AddAssertion(func(t *testing.T, o *sdk.ProjectionPolicy) error {
+		t.Helper()
+		if o.Name == "" {
+			return fmt.Errorf("expected name to be non-empty")
+		}
+		return nil
+	})
+	return p
+}
+
+func (p *ProjectionPolicyAssert) HasDatabaseName(expected string) *ProjectionPolicyAssert {
+	p.AddAssertion(func(t *testing.T, o *sdk.ProjectionPolicy) error {
+		t.Helper()
+		if o.DatabaseName != expected {
+			return fmt.Errorf("expected database name: %v; got: %v", expected, o.DatabaseName)
+		}
+		return nil
+	})
+	return p
+}
+
+func (p *ProjectionPolicyAssert) HasDatabaseNameNotEmpty() *ProjectionPolicyAssert {
+	p.AddAssertion(func(t *testing.T, o *sdk.ProjectionPolicy) error {
+		t.Helper()
+		if o.DatabaseName == "" {
+			return fmt.Errorf("expected database name to be non-empty")
+		}
+		return nil
+	})
+	return p
+}
+
+func (p *ProjectionPolicyAssert) HasSchemaName(expected string) *ProjectionPolicyAssert {
+	p.AddAssertion(func(t *testing.T, o *sdk.ProjectionPolicy) error {
+		t.Helper()
+		if o.SchemaName != expected {
+			return fmt.Errorf("expected schema name: %v; got: %v", expected, o.SchemaName)
+		}
+		return nil
+	})
+	return p
+}
+
+func (p *ProjectionPolicyAssert) HasSchemaNameNotEmpty() *ProjectionPolicyAssert {
+	p.AddAssertion(func(t *testing.T, o *sdk.ProjectionPolicy) error {
+		t.Helper()
+		if o.SchemaName == "" {
+			return fmt.Errorf("expected schema name to be non-empty")
+		}
+		return nil
+	})
+	return p
+}
+
+func (p *ProjectionPolicyAssert) HasKind(expected string) *ProjectionPolicyAssert {
+	p.AddAssertion(func(t *testing.T, o *sdk.ProjectionPolicy) error {
+		t.Helper()
+		if o.Kind != expected {
+			return fmt.Errorf("expected kind: %v; got: %v", expected, o.Kind)
+		}
+		return nil
+	})
+	return p
+}
+
+func (p *ProjectionPolicyAssert) HasKindNotEmpty() *ProjectionPolicyAssert {
+	p.AddAssertion(func(t *testing.T, o *sdk.ProjectionPolicy) error {
+		t.Helper()
+		if o.Kind == "" {
+			return fmt.Errorf("expected kind to be non-empty")
+		}
+		return nil
+	})
+	return p
+}
+
+func (p *ProjectionPolicyAssert) HasOwner(expected string) *ProjectionPolicyAssert {
+	p.AddAssertion(func(t *testing.T, o *sdk.ProjectionPolicy) error {
+		t.Helper()
+		if o.Owner != expected {
+			return fmt.Errorf("expected owner: %v; got: %v", expected, o.Owner)
+		}
+		return nil
+	})
+	return p
+}
+
+func (p *ProjectionPolicyAssert) HasOwnerNotEmpty() *ProjectionPolicyAssert {
+	p.AddAssertion(func(t *testing.T, o *sdk.ProjectionPolicy) error {
+		t.Helper()
+		if o.Owner == "" {
+			return fmt.Errorf("expected owner to be non-empty")
+		}
+		return nil
+	})
+	return p
+}
+
+func (p *ProjectionPolicyAssert) HasComment(expected string) *ProjectionPolicyAssert {
+	p.AddAssertion(func(t *testing.T, o *sdk.ProjectionPolicy) error {
+		t.Helper()
+		if o.Comment != expected {
+			return fmt.Errorf("expected comment: %v; got: %v", expected, o.Comment)
+		}
+		return nil
+	})
+	return p
+}
+
+func (p *ProjectionPolicyAssert) HasCommentNotEmpty() *ProjectionPolicyAssert {
+	p.AddAssertion(func(t *testing.T, o *sdk.ProjectionPolicy) error {
+		t.Helper()
+		if o.Comment == "" {
+			return fmt.Errorf("expected comment to be non-empty")
+		}
+		return nil
+	})
+	return p
+}
+
+func (p *ProjectionPolicyAssert) HasOptions(expected string) *ProjectionPolicyAssert {
+	p.AddAssertion(func(t *testing.T, o *sdk.ProjectionPolicy) error {
+		t.Helper()
+		if o.Options != expected {
+			return fmt.Errorf("expected options: %v; got: %v", expected, o.Options)
+		}
+		return nil
+	})
+	return p
+}
+
+func (p *ProjectionPolicyAssert) HasOptionsNotEmpty() *ProjectionPolicyAssert {
+	p.AddAssertion(func(t *testing.T, o *sdk.ProjectionPolicy) error {
+		t.Helper()
+		if o.Options == "" {
+			return fmt.Errorf("expected options to be non-empty")
+		}
+		return nil
+	})
+	return p
+}
+
+func (p *ProjectionPolicyAssert) HasOwnerRoleType(expected string) *ProjectionPolicyAssert {
+	p.AddAssertion(func(t *testing.T, o *sdk.ProjectionPolicy) error {
+		t.Helper()
+		if o.OwnerRoleType != expected {
+			return fmt.Errorf("expected owner role type: %v; got: %v", expected, o.OwnerRoleType)
+		}
+		return nil
+	})
+	return p
+}
+
+func (p *ProjectionPolicyAssert) HasOwnerRoleTypeNotEmpty() *ProjectionPolicyAssert {
+	p.AddAssertion(func(t *testing.T, o *sdk.ProjectionPolicy) error {
+		t.Helper()
+		if o.OwnerRoleType == "" {
+			return fmt.Errorf("expected owner role type to be non-empty")
+		}
+		return nil
+	})
+	return p
+}
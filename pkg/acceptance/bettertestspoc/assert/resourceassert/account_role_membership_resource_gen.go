@@ -0,0 +1,89 @@
+// Code generated by resource assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type AccountRoleMembershipResourceAssert struct {
+	*assert.ResourceAssert
+}
+
+func AccountRoleMembershipResource(t *testing.T, name string) *AccountRoleMembershipResourceAssert {
+	t.Helper()
+
+	return &AccountRoleMembershipResourceAssert{
+		ResourceAssert: assert.NewResourceAssert(name),
+	}
+}
+
+func ImportedAccountRoleMembershipResource(t *testing.T, id string) *AccountRoleMembershipResourceAssert {
+	t.Helper()
+
+	return &AccountRoleMembershipResourceAssert{
+		ResourceAssert: assert.NewImportedResourceAssert(id),
+	}
+}
+
+//////////////////////////////////
+// Attribute typed value checks //
+//////////////////////////////////
+
+func (a *AccountRoleMembershipResourceAssert) HasParentRoleNames(expected ...string) *AccountRoleMembershipResourceAssert {
+	a.SetContainsExactlyStringValues("parent_role_names", expected...)
+	return a
+}
+
+func (a *AccountRoleMembershipResourceAssert) HasRoleName(expected string) *AccountRoleMembershipResourceAssert {
+	a.StringValueSet("role_name", expected)
+	return a
+}
+
+func (a *AccountRoleMembershipResourceAssert) HasUserNames(expected ...string) *AccountRoleMembershipResourceAssert {
+	a.SetContainsExactlyStringValues("user_names", expected...)
+	return a
+}
+
+///////////////////////////////////
+// Attribute value string checks //
+///////////////////////////////////
+
+func (a *AccountRoleMembershipResourceAssert) HasRoleNameString(expected string) *AccountRoleMembershipResourceAssert {
+	a.ValueSet("role_name", expected)
+	return a
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (a *AccountRoleMembershipResourceAssert) HasNoRoleName() *AccountRoleMembershipResourceAssert {
+	a.ValueNotSet("role_name")
+	return a
+}
+
+////////////////////////////
+// Attribute empty checks //
+////////////////////////////
+
+func (a *AccountRoleMembershipResourceAssert) HasParentRoleNamesEmpty() *AccountRoleMembershipResourceAssert {
+	a.ValueSet("parent_role_names.#", "0")
+	return a
+}
+
+func (a *AccountRoleMembershipResourceAssert) HasUserNamesEmpty() *AccountRoleMembershipResourceAssert {
+	a.ValueSet("user_names.#", "0")
+	return a
+}
+
+///////////////////////////////
+// Attribute presence checks //
+///////////////////////////////
+
+func (a *AccountRoleMembershipResourceAssert) HasRoleNameNotEmpty() *AccountRoleMembershipResourceAssert {
+	a.ValuePresent("role_name")
+	return a
+}
@@ -47,6 +47,10 @@ func (a *AccountRoleResourceAssert) HasFullyQualifiedName(expected string) *Acco
 	return a
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -66,6 +70,16 @@ func (a *AccountRoleResourceAssert) HasFullyQualifiedNameString(expected string)
 	return a
 }
 
+func (a *AccountRoleResourceAssert) HasTagsString(expected string) *AccountRoleResourceAssert {
+	a.ValueSet("tags", expected)
+	return a
+}
+
+func (a *AccountRoleResourceAssert) HasTagsAllString(expected string) *AccountRoleResourceAssert {
+	a.ValueSet("tags_all", expected)
+	return a
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -85,6 +99,16 @@ func (a *AccountRoleResourceAssert) HasNoFullyQualifiedName() *AccountRoleResour
 	return a
 }
 
+func (a *AccountRoleResourceAssert) HasNoTags() *AccountRoleResourceAssert {
+	a.ValueNotSet("tags")
+	return a
+}
+
+func (a *AccountRoleResourceAssert) HasNoTagsAll() *AccountRoleResourceAssert {
+	a.ValueNotSet("tags_all")
+	return a
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -99,6 +123,16 @@ func (a *AccountRoleResourceAssert) HasFullyQualifiedNameEmpty() *AccountRoleRes
 	return a
 }
 
+func (a *AccountRoleResourceAssert) HasTagsEmpty() *AccountRoleResourceAssert {
+	a.ValueSet("tags", "")
+	return a
+}
+
+func (a *AccountRoleResourceAssert) HasTagsAllEmpty() *AccountRoleResourceAssert {
+	a.ValueSet("tags_all", "")
+	return a
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -117,3 +151,13 @@ func (a *AccountRoleResourceAssert) HasFullyQualifiedNameNotEmpty() *AccountRole
 	a.ValuePresent("fully_qualified_name")
 	return a
 }
+
+func (a *AccountRoleResourceAssert) HasTagsNotEmpty() *AccountRoleResourceAssert {
+	a.ValuePresent("tags")
+	return a
+}
+
+func (a *AccountRoleResourceAssert) HasTagsAllNotEmpty() *AccountRoleResourceAssert {
+	a.ValuePresent("tags_all")
+	return a
+}
@@ -0,0 +1,179 @@
+// Code generated by resource assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type AggregationPolicyResourceAssert struct {
+	*assert.ResourceAssert
+}
+
+func AggregationPolicyResource(t *testing.T, name string) *AggregationPolicyResourceAssert {
+	t.Helper()
+
+	return &AggregationPolicyResourceAssert{
+		ResourceAssert: assert.NewResourceAssert(name),
+	}
+}
+
+func ImportedAggregationPolicyResource(t *testing.T, id string) *AggregationPolicyResourceAssert {
+	t.Helper()
+
+	return &AggregationPolicyResourceAssert{
+		ResourceAssert: assert.NewImportedResourceAssert(id),
+	}
+}
+
+//////////////////////////////////
+// Attribute typed value checks //
+//////////////////////////////////
+
+func (a *AggregationPolicyResourceAssert) HasDatabase(expected string) *AggregationPolicyResourceAssert {
+	a.StringValueSet("database", expected)
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasSchema(expected string) *AggregationPolicyResourceAssert {
+	a.StringValueSet("schema", expected)
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasName(expected string) *AggregationPolicyResourceAssert {
+	a.StringValueSet("name", expected)
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasBody(expected string) *AggregationPolicyResourceAssert {
+	a.StringValueSet("body", expected)
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasComment(expected string) *AggregationPolicyResourceAssert {
+	a.StringValueSet("comment", expected)
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasFullyQualifiedName(expected string) *AggregationPolicyResourceAssert {
+	a.StringValueSet("fully_qualified_name", expected)
+	return a
+}
+
+///////////////////////////////////
+// Attribute value string checks //
+///////////////////////////////////
+
+func (a *AggregationPolicyResourceAssert) HasDatabaseString(expected string) *AggregationPolicyResourceAssert {
+	a.ValueSet("database", expected)
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasSchemaString(expected string) *AggregationPolicyResourceAssert {
+	a.ValueSet("schema", expected)
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasNameString(expected string) *AggregationPolicyResourceAssert {
+	a.ValueSet("name", expected)
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasBodyString(expected string) *AggregationPolicyResourceAssert {
+	a.ValueSet("body", expected)
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasCommentString(expected string) *AggregationPolicyResourceAssert {
+	a.ValueSet("comment", expected)
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasFullyQualifiedNameString(expected string) *AggregationPolicyResourceAssert {
+	a.ValueSet("fully_qualified_name", expected)
+	return a
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (a *AggregationPolicyResourceAssert) HasNoDatabase() *AggregationPolicyResourceAssert {
+	a.ValueNotSet("database")
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasNoSchema() *AggregationPolicyResourceAssert {
+	a.ValueNotSet("schema")
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasNoName() *AggregationPolicyResourceAssert {
+	a.ValueNotSet("name")
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasNoBody() *AggregationPolicyResourceAssert {
+	a.ValueNotSet("body")
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasNoComment() *AggregationPolicyResourceAssert {
+	a.ValueNotSet("comment")
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasNoFullyQualifiedName() *AggregationPolicyResourceAssert {
+	a.ValueNotSet("fully_qualified_name")
+	return a
+}
+
+////////////////////////////
+// Attribute empty checks //
+////////////////////////////
+
+func (a *AggregationPolicyResourceAssert) HasCommentEmpty() *AggregationPolicyResourceAssert {
+	a.ValueSet("comment", "")
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasFullyQualifiedNameEmpty() *AggregationPolicyResourceAssert {
+	a.ValueSet("fully_qualified_name", "")
+	return a
+}
+
+///////////////////////////////
+// Attribute presence checks //
+///////////////////////////////
+
+func (a *AggregationPolicyResourceAssert) HasDatabaseNotEmpty() *AggregationPolicyResourceAssert {
+	a.ValuePresent("database")
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasSchemaNotEmpty() *AggregationPolicyResourceAssert {
+	a.ValuePresent("schema")
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasNameNotEmpty() *AggregationPolicyResourceAssert {
+	a.ValuePresent("name")
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasBodyNotEmpty() *AggregationPolicyResourceAssert {
+	a.ValuePresent("body")
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasCommentNotEmpty() *AggregationPolicyResourceAssert {
+	a.ValuePresent("comment")
+	return a
+}
+
+func (a *AggregationPolicyResourceAssert) HasFullyQualifiedNameNotEmpty() *AggregationPolicyResourceAssert {
+	a.ValuePresent("fully_qualified_name")
+	return a
+}
@@ -92,6 +92,10 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) H
 	return a
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -151,6 +155,16 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) H
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasTagsString(expected string) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.ValueSet("tags", expected)
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasTagsAllString(expected string) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.ValueSet("tags_all", expected)
+	return a
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -210,6 +224,16 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) H
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasNoTags() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.ValueNotSet("tags")
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasNoTagsAll() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.ValueNotSet("tags_all")
+	return a
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -254,6 +278,16 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) H
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasTagsEmpty() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.ValueSet("tags", "")
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasTagsAllEmpty() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.ValueSet("tags_all", "")
+	return a
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -312,3 +346,13 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) H
 	a.ValuePresent("oauth_token_endpoint")
 	return a
 }
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasTagsNotEmpty() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.ValuePresent("tags")
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert) HasTagsAllNotEmpty() *ApiAuthenticationIntegrationWithAuthorizationCodeGrantResourceAssert {
+	a.ValuePresent("tags_all")
+	return a
+}
@@ -87,6 +87,10 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOau
 	return a
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -141,6 +145,16 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOau
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasTagsString(expected string) *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.ValueSet("tags", expected)
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasTagsAllString(expected string) *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.ValueSet("tags_all", expected)
+	return a
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -195,6 +209,16 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasNoO
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasNoTags() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.ValueNotSet("tags")
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasNoTagsAll() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.ValueNotSet("tags_all")
+	return a
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -234,6 +258,16 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOau
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasTagsEmpty() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.ValueSet("tags", "")
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasTagsAllEmpty() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.ValueSet("tags_all", "")
+	return a
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -287,3 +321,13 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasOau
 	a.ValuePresent("oauth_token_endpoint")
 	return a
 }
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasTagsNotEmpty() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.ValuePresent("tags")
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert) HasTagsAllNotEmpty() *ApiAuthenticationIntegrationWithClientCredentialsResourceAssert {
+	a.ValuePresent("tags_all")
+	return a
+}
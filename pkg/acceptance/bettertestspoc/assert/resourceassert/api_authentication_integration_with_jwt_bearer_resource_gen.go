@@ -92,6 +92,10 @@ func (a *ApiAuthenticationIntegrationWithJwtBearerResourceAssert) HasOauthTokenE
 	return a
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -156,6 +160,16 @@ func (a *ApiAuthenticationIntegrationWithJwtBearerResourceAssert) HasOauthTokenE
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithJwtBearerResourceAssert) HasTagsString(expected string) *ApiAuthenticationIntegrationWithJwtBearerResourceAssert {
+	a.ValueSet("tags", expected)
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithJwtBearerResourceAssert) HasTagsAllString(expected string) *ApiAuthenticationIntegrationWithJwtBearerResourceAssert {
+	a.ValueSet("tags_all", expected)
+	return a
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -220,6 +234,16 @@ func (a *ApiAuthenticationIntegrationWithJwtBearerResourceAssert) HasNoOauthToke
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithJwtBearerResourceAssert) HasNoTags() *ApiAuthenticationIntegrationWithJwtBearerResourceAssert {
+	a.ValueNotSet("tags")
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithJwtBearerResourceAssert) HasNoTagsAll() *ApiAuthenticationIntegrationWithJwtBearerResourceAssert {
+	a.ValueNotSet("tags_all")
+	return a
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -259,6 +283,16 @@ func (a *ApiAuthenticationIntegrationWithJwtBearerResourceAssert) HasOauthTokenE
 	return a
 }
 
+func (a *ApiAuthenticationIntegrationWithJwtBearerResourceAssert) HasTagsEmpty() *ApiAuthenticationIntegrationWithJwtBearerResourceAssert {
+	a.ValueSet("tags", "")
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithJwtBearerResourceAssert) HasTagsAllEmpty() *ApiAuthenticationIntegrationWithJwtBearerResourceAssert {
+	a.ValueSet("tags_all", "")
+	return a
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -322,3 +356,13 @@ func (a *ApiAuthenticationIntegrationWithJwtBearerResourceAssert) HasOauthTokenE
 	a.ValuePresent("oauth_token_endpoint")
 	return a
 }
+
+func (a *ApiAuthenticationIntegrationWithJwtBearerResourceAssert) HasTagsNotEmpty() *ApiAuthenticationIntegrationWithJwtBearerResourceAssert {
+	a.ValuePresent("tags")
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithJwtBearerResourceAssert) HasTagsAllNotEmpty() *ApiAuthenticationIntegrationWithJwtBearerResourceAssert {
+	a.ValuePresent("tags_all")
+	return a
+}
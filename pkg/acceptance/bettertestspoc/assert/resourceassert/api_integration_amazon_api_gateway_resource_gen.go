@@ -77,6 +77,10 @@ func (a *ApiIntegrationAmazonApiGatewayResourceAssert) HasFullyQualifiedName(exp
 	return a
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -116,6 +120,16 @@ func (a *ApiIntegrationAmazonApiGatewayResourceAssert) HasFullyQualifiedNameStri
 	return a
 }
 
+func (a *ApiIntegrationAmazonApiGatewayResourceAssert) HasTagsString(expected string) *ApiIntegrationAmazonApiGatewayResourceAssert {
+	a.ValueSet("tags", expected)
+	return a
+}
+
+func (a *ApiIntegrationAmazonApiGatewayResourceAssert) HasTagsAllString(expected string) *ApiIntegrationAmazonApiGatewayResourceAssert {
+	a.ValueSet("tags_all", expected)
+	return a
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -155,6 +169,16 @@ func (a *ApiIntegrationAmazonApiGatewayResourceAssert) HasNoFullyQualifiedName()
 	return a
 }
 
+func (a *ApiIntegrationAmazonApiGatewayResourceAssert) HasNoTags() *ApiIntegrationAmazonApiGatewayResourceAssert {
+	a.ValueNotSet("tags")
+	return a
+}
+
+func (a *ApiIntegrationAmazonApiGatewayResourceAssert) HasNoTagsAll() *ApiIntegrationAmazonApiGatewayResourceAssert {
+	a.ValueNotSet("tags_all")
+	return a
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -179,6 +203,16 @@ func (a *ApiIntegrationAmazonApiGatewayResourceAssert) HasFullyQualifiedNameEmpt
 	return a
 }
 
+func (a *ApiIntegrationAmazonApiGatewayResourceAssert) HasTagsEmpty() *ApiIntegrationAmazonApiGatewayResourceAssert {
+	a.ValueSet("tags", "")
+	return a
+}
+
+func (a *ApiIntegrationAmazonApiGatewayResourceAssert) HasTagsAllEmpty() *ApiIntegrationAmazonApiGatewayResourceAssert {
+	a.ValueSet("tags_all", "")
+	return a
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -217,3 +251,13 @@ func (a *ApiIntegrationAmazonApiGatewayResourceAssert) HasFullyQualifiedNameNotE
 	a.ValuePresent("fully_qualified_name")
 	return a
 }
+
+func (a *ApiIntegrationAmazonApiGatewayResourceAssert) HasTagsNotEmpty() *ApiIntegrationAmazonApiGatewayResourceAssert {
+	a.ValuePresent("tags")
+	return a
+}
+
+func (a *ApiIntegrationAmazonApiGatewayResourceAssert) HasTagsAllNotEmpty() *ApiIntegrationAmazonApiGatewayResourceAssert {
+	a.ValuePresent("tags_all")
+	return a
+}
@@ -77,6 +77,10 @@ func (a *ApiIntegrationAzureApiManagementResourceAssert) HasFullyQualifiedName(e
 	return a
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -116,6 +120,16 @@ func (a *ApiIntegrationAzureApiManagementResourceAssert) HasFullyQualifiedNameSt
 	return a
 }
 
+func (a *ApiIntegrationAzureApiManagementResourceAssert) HasTagsString(expected string) *ApiIntegrationAzureApiManagementResourceAssert {
+	a.ValueSet("tags", expected)
+	return a
+}
+
+func (a *ApiIntegrationAzureApiManagementResourceAssert) HasTagsAllString(expected string) *ApiIntegrationAzureApiManagementResourceAssert {
+	a.ValueSet("tags_all", expected)
+	return a
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -155,6 +169,16 @@ func (a *ApiIntegrationAzureApiManagementResourceAssert) HasNoFullyQualifiedName
 	return a
 }
 
+func (a *ApiIntegrationAzureApiManagementResourceAssert) HasNoTags() *ApiIntegrationAzureApiManagementResourceAssert {
+	a.ValueNotSet("tags")
+	return a
+}
+
+func (a *ApiIntegrationAzureApiManagementResourceAssert) HasNoTagsAll() *ApiIntegrationAzureApiManagementResourceAssert {
+	a.ValueNotSet("tags_all")
+	return a
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -179,6 +203,16 @@ func (a *ApiIntegrationAzureApiManagementResourceAssert) HasFullyQualifiedNameEm
 	return a
 }
 
+func (a *ApiIntegrationAzureApiManagementResourceAssert) HasTagsEmpty() *ApiIntegrationAzureApiManagementResourceAssert {
+	a.ValueSet("tags", "")
+	return a
+}
+
+func (a *ApiIntegrationAzureApiManagementResourceAssert) HasTagsAllEmpty() *ApiIntegrationAzureApiManagementResourceAssert {
+	a.ValueSet("tags_all", "")
+	return a
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -217,3 +251,13 @@ func (a *ApiIntegrationAzureApiManagementResourceAssert) HasFullyQualifiedNameNo
 	a.ValuePresent("fully_qualified_name")
 	return a
 }
+
+func (a *ApiIntegrationAzureApiManagementResourceAssert) HasTagsNotEmpty() *ApiIntegrationAzureApiManagementResourceAssert {
+	a.ValuePresent("tags")
+	return a
+}
+
+func (a *ApiIntegrationAzureApiManagementResourceAssert) HasTagsAllNotEmpty() *ApiIntegrationAzureApiManagementResourceAssert {
+	a.ValuePresent("tags_all")
+	return a
+}
@@ -67,6 +67,10 @@ func (a *ApiIntegrationExternalMcpDynamicClientResourceAssert) HasOauthResourceU
 	return a
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -96,6 +100,16 @@ func (a *ApiIntegrationExternalMcpDynamicClientResourceAssert) HasOauthResourceU
 	return a
 }
 
+func (a *ApiIntegrationExternalMcpDynamicClientResourceAssert) HasTagsString(expected string) *ApiIntegrationExternalMcpDynamicClientResourceAssert {
+	a.ValueSet("tags", expected)
+	return a
+}
+
+func (a *ApiIntegrationExternalMcpDynamicClientResourceAssert) HasTagsAllString(expected string) *ApiIntegrationExternalMcpDynamicClientResourceAssert {
+	a.ValueSet("tags_all", expected)
+	return a
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -125,6 +139,16 @@ func (a *ApiIntegrationExternalMcpDynamicClientResourceAssert) HasNoOauthResourc
 	return a
 }
 
+func (a *ApiIntegrationExternalMcpDynamicClientResourceAssert) HasNoTags() *ApiIntegrationExternalMcpDynamicClientResourceAssert {
+	a.ValueNotSet("tags")
+	return a
+}
+
+func (a *ApiIntegrationExternalMcpDynamicClientResourceAssert) HasNoTagsAll() *ApiIntegrationExternalMcpDynamicClientResourceAssert {
+	a.ValueNotSet("tags_all")
+	return a
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -144,6 +168,16 @@ func (a *ApiIntegrationExternalMcpDynamicClientResourceAssert) HasFullyQualified
 	return a
 }
 
+func (a *ApiIntegrationExternalMcpDynamicClientResourceAssert) HasTagsEmpty() *ApiIntegrationExternalMcpDynamicClientResourceAssert {
+	a.ValueSet("tags", "")
+	return a
+}
+
+func (a *ApiIntegrationExternalMcpDynamicClientResourceAssert) HasTagsAllEmpty() *ApiIntegrationExternalMcpDynamicClientResourceAssert {
+	a.ValueSet("tags_all", "")
+	return a
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -172,3 +206,13 @@ func (a *ApiIntegrationExternalMcpDynamicClientResourceAssert) HasOauthResourceU
 	a.ValuePresent("oauth_resource_url")
 	return a
 }
+
+func (a *ApiIntegrationExternalMcpDynamicClientResourceAssert) HasTagsNotEmpty() *ApiIntegrationExternalMcpDynamicClientResourceAssert {
+	a.ValuePresent("tags")
+	return a
+}
+
+func (a *ApiIntegrationExternalMcpDynamicClientResourceAssert) HasTagsAllNotEmpty() *ApiIntegrationExternalMcpDynamicClientResourceAssert {
+	a.ValuePresent("tags_all")
+	return a
+}
@@ -92,6 +92,10 @@ func (a *ApiIntegrationExternalMcpOAuth2ResourceAssert) HasOauthTokenEndpoint(ex
 	return a
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -146,6 +150,16 @@ func (a *ApiIntegrationExternalMcpOAuth2ResourceAssert) HasOauthTokenEndpointStr
 	return a
 }
 
+func (a *ApiIntegrationExternalMcpOAuth2ResourceAssert) HasTagsString(expected string) *ApiIntegrationExternalMcpOAuth2ResourceAssert {
+	a.ValueSet("tags", expected)
+	return a
+}
+
+func (a *ApiIntegrationExternalMcpOAuth2ResourceAssert) HasTagsAllString(expected string) *ApiIntegrationExternalMcpOAuth2ResourceAssert {
+	a.ValueSet("tags_all", expected)
+	return a
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -200,6 +214,16 @@ func (a *ApiIntegrationExternalMcpOAuth2ResourceAssert) HasNoOauthTokenEndpoint(
 	return a
 }
 
+func (a *ApiIntegrationExternalMcpOAuth2ResourceAssert) HasNoTags() *ApiIntegrationExternalMcpOAuth2ResourceAssert {
+	a.ValueNotSet("tags")
+	return a
+}
+
+func (a *ApiIntegrationExternalMcpOAuth2ResourceAssert) HasNoTagsAll() *ApiIntegrationExternalMcpOAuth2ResourceAssert {
+	a.ValueNotSet("tags_all")
+	return a
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -229,6 +253,16 @@ func (a *ApiIntegrationExternalMcpOAuth2ResourceAssert) HasOauthRefreshTokenVali
 	return a
 }
 
+func (a *ApiIntegrationExternalMcpOAuth2ResourceAssert) HasTagsEmpty() *ApiIntegrationExternalMcpOAuth2ResourceAssert {
+	a.ValueSet("tags", "")
+	return a
+}
+
+func (a *ApiIntegrationExternalMcpOAuth2ResourceAssert) HasTagsAllEmpty() *ApiIntegrationExternalMcpOAuth2ResourceAssert {
+	a.ValueSet("tags_all", "")
+	return a
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -282,3 +316,13 @@ func (a *ApiIntegrationExternalMcpOAuth2ResourceAssert) HasOauthTokenEndpointNot
 	a.ValuePresent("oauth_token_endpoint")
 	return a
 }
+
+func (a *ApiIntegrationExternalMcpOAuth2ResourceAssert) HasTagsNotEmpty() *ApiIntegrationExternalMcpOAuth2ResourceAssert {
+	a.ValuePresent("tags")
+	return a
+}
+
+func (a *ApiIntegrationExternalMcpOAuth2ResourceAssert) HasTagsAllNotEmpty() *ApiIntegrationExternalMcpOAuth2ResourceAssert {
+	a.ValuePresent("tags_all")
+	return a
+}
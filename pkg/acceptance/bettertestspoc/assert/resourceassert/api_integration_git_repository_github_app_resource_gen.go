@@ -62,6 +62,10 @@ func (a *ApiIntegrationGitRepositoryGithubAppResourceAssert) HasFullyQualifiedNa
 	return a
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -86,6 +90,16 @@ func (a *ApiIntegrationGitRepositoryGithubAppResourceAssert) HasFullyQualifiedNa
 	return a
 }
 
+func (a *ApiIntegrationGitRepositoryGithubAppResourceAssert) HasTagsString(expected string) *ApiIntegrationGitRepositoryGithubAppResourceAssert {
+	a.ValueSet("tags", expected)
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryGithubAppResourceAssert) HasTagsAllString(expected string) *ApiIntegrationGitRepositoryGithubAppResourceAssert {
+	a.ValueSet("tags_all", expected)
+	return a
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -110,6 +124,16 @@ func (a *ApiIntegrationGitRepositoryGithubAppResourceAssert) HasNoFullyQualified
 	return a
 }
 
+func (a *ApiIntegrationGitRepositoryGithubAppResourceAssert) HasNoTags() *ApiIntegrationGitRepositoryGithubAppResourceAssert {
+	a.ValueNotSet("tags")
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryGithubAppResourceAssert) HasNoTagsAll() *ApiIntegrationGitRepositoryGithubAppResourceAssert {
+	a.ValueNotSet("tags_all")
+	return a
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -129,6 +153,16 @@ func (a *ApiIntegrationGitRepositoryGithubAppResourceAssert) HasFullyQualifiedNa
 	return a
 }
 
+func (a *ApiIntegrationGitRepositoryGithubAppResourceAssert) HasTagsEmpty() *ApiIntegrationGitRepositoryGithubAppResourceAssert {
+	a.ValueSet("tags", "")
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryGithubAppResourceAssert) HasTagsAllEmpty() *ApiIntegrationGitRepositoryGithubAppResourceAssert {
+	a.ValueSet("tags_all", "")
+	return a
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -152,3 +186,13 @@ func (a *ApiIntegrationGitRepositoryGithubAppResourceAssert) HasFullyQualifiedNa
 	a.ValuePresent("fully_qualified_name")
 	return a
 }
+
+func (a *ApiIntegrationGitRepositoryGithubAppResourceAssert) HasTagsNotEmpty() *ApiIntegrationGitRepositoryGithubAppResourceAssert {
+	a.ValuePresent("tags")
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryGithubAppResourceAssert) HasTagsAllNotEmpty() *ApiIntegrationGitRepositoryGithubAppResourceAssert {
+	a.ValuePresent("tags_all")
+	return a
+}
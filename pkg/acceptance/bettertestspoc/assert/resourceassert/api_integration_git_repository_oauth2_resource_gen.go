@@ -102,6 +102,10 @@ func (a *ApiIntegrationGitRepositoryOauth2ResourceAssert) HasOauthUsername(expec
 	return a
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -161,6 +165,16 @@ func (a *ApiIntegrationGitRepositoryOauth2ResourceAssert) HasOauthUsernameString
 	return a
 }
 
+func (a *ApiIntegrationGitRepositoryOauth2ResourceAssert) HasTagsString(expected string) *ApiIntegrationGitRepositoryOauth2ResourceAssert {
+	a.ValueSet("tags", expected)
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryOauth2ResourceAssert) HasTagsAllString(expected string) *ApiIntegrationGitRepositoryOauth2ResourceAssert {
+	a.ValueSet("tags_all", expected)
+	return a
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -220,6 +234,16 @@ func (a *ApiIntegrationGitRepositoryOauth2ResourceAssert) HasNoOauthUsername() *
 	return a
 }
 
+func (a *ApiIntegrationGitRepositoryOauth2ResourceAssert) HasNoTags() *ApiIntegrationGitRepositoryOauth2ResourceAssert {
+	a.ValueNotSet("tags")
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryOauth2ResourceAssert) HasNoTagsAll() *ApiIntegrationGitRepositoryOauth2ResourceAssert {
+	a.ValueNotSet("tags_all")
+	return a
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -259,6 +283,16 @@ func (a *ApiIntegrationGitRepositoryOauth2ResourceAssert) HasOauthUsernameEmpty(
 	return a
 }
 
+func (a *ApiIntegrationGitRepositoryOauth2ResourceAssert) HasTagsEmpty() *ApiIntegrationGitRepositoryOauth2ResourceAssert {
+	a.ValueSet("tags", "")
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryOauth2ResourceAssert) HasTagsAllEmpty() *ApiIntegrationGitRepositoryOauth2ResourceAssert {
+	a.ValueSet("tags_all", "")
+	return a
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -317,3 +351,13 @@ func (a *ApiIntegrationGitRepositoryOauth2ResourceAssert) HasOauthUsernameNotEmp
 	a.ValuePresent("oauth_username")
 	return a
 }
+
+func (a *ApiIntegrationGitRepositoryOauth2ResourceAssert) HasTagsNotEmpty() *ApiIntegrationGitRepositoryOauth2ResourceAssert {
+	a.ValuePresent("tags")
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryOauth2ResourceAssert) HasTagsAllNotEmpty() *ApiIntegrationGitRepositoryOauth2ResourceAssert {
+	a.ValuePresent("tags_all")
+	return a
+}
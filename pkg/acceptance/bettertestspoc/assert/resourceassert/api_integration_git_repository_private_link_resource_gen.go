@@ -77,6 +77,10 @@ func (a *ApiIntegrationGitRepositoryPrivateLinkResourceAssert) HasNoAllowedAuthe
 	return a
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (a *ApiIntegrationGitRepositoryPrivateLinkResourceAssert) HasTlsTrustedCertificates(expected ...string) *ApiIntegrationGitRepositoryPrivateLinkResourceAssert {
 	a.ListContainsExactlyStringValuesInOrder("tls_trusted_certificates", expected...)
 	return a
@@ -121,6 +125,16 @@ func (a *ApiIntegrationGitRepositoryPrivateLinkResourceAssert) HasNoAllowedAuthe
 	return a
 }
 
+func (a *ApiIntegrationGitRepositoryPrivateLinkResourceAssert) HasTagsString(expected string) *ApiIntegrationGitRepositoryPrivateLinkResourceAssert {
+	a.ValueSet("tags", expected)
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryPrivateLinkResourceAssert) HasTagsAllString(expected string) *ApiIntegrationGitRepositoryPrivateLinkResourceAssert {
+	a.ValueSet("tags_all", expected)
+	return a
+}
+
 func (a *ApiIntegrationGitRepositoryPrivateLinkResourceAssert) HasUsePrivatelinkEndpointString(expected string) *ApiIntegrationGitRepositoryPrivateLinkResourceAssert {
 	a.ValueSet("use_privatelink_endpoint", expected)
 	return a
@@ -160,6 +174,16 @@ func (a *ApiIntegrationGitRepositoryPrivateLinkResourceAssert) HasNoNoAllowedAut
 	return a
 }
 
+func (a *ApiIntegrationGitRepositoryPrivateLinkResourceAssert) HasNoTags() *ApiIntegrationGitRepositoryPrivateLinkResourceAssert {
+	a.ValueNotSet("tags")
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryPrivateLinkResourceAssert) HasNoTagsAll() *ApiIntegrationGitRepositoryPrivateLinkResourceAssert {
+	a.ValueNotSet("tags_all")
+	return a
+}
+
 func (a *ApiIntegrationGitRepositoryPrivateLinkResourceAssert) HasNoUsePrivatelinkEndpoint() *ApiIntegrationGitRepositoryPrivateLinkResourceAssert {
 	a.ValueNotSet("use_privatelink_endpoint")
 	return a
@@ -199,6 +223,16 @@ func (a *ApiIntegrationGitRepositoryPrivateLinkResourceAssert) HasNoAllowedAuthe
 	return a
 }
 
+func (a *ApiIntegrationGitRepositoryPrivateLinkResourceAssert) HasTagsEmpty() *ApiIntegrationGitRepositoryPrivateLinkResourceAssert {
+	a.ValueSet("tags", "")
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryPrivateLinkResourceAssert) HasTagsAllEmpty() *ApiIntegrationGitRepositoryPrivateLinkResourceAssert {
+	a.ValueSet("tags_all", "")
+	return a
+}
+
 func (a *ApiIntegrationGitRepositoryPrivateLinkResourceAssert) HasTlsTrustedCertificatesEmpty() *ApiIntegrationGitRepositoryPrivateLinkResourceAssert {
 	a.ValueSet("tls_trusted_certificates.#", "0")
 	return a
@@ -238,6 +272,16 @@ func (a *ApiIntegrationGitRepositoryPrivateLinkResourceAssert) HasNoAllowedAuthe
 	return a
 }
 
+func (a *ApiIntegrationGitRepositoryPrivateLinkResourceAssert) HasTagsNotEmpty() *ApiIntegrationGitRepositoryPrivateLinkResourceAssert {
+	a.ValuePresent("tags")
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryPrivateLinkResourceAssert) HasTagsAllNotEmpty() *ApiIntegrationGitRepositoryPrivateLinkResourceAssert {
+	a.ValuePresent("tags_all")
+	return a
+}
+
 func (a *ApiIntegrationGitRepositoryPrivateLinkResourceAssert) HasUsePrivatelinkEndpointNotEmpty() *ApiIntegrationGitRepositoryPrivateLinkResourceAssert {
 	a.ValuePresent("use_privatelink_endpoint")
 	return a
@@ -77,6 +77,10 @@ func (a *ApiIntegrationGitRepositoryTokenResourceAssert) HasNoAllowedAuthenticat
 	return a
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -111,6 +115,16 @@ func (a *ApiIntegrationGitRepositoryTokenResourceAssert) HasNoAllowedAuthenticat
 	return a
 }
 
+func (a *ApiIntegrationGitRepositoryTokenResourceAssert) HasTagsString(expected string) *ApiIntegrationGitRepositoryTokenResourceAssert {
+	a.ValueSet("tags", expected)
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryTokenResourceAssert) HasTagsAllString(expected string) *ApiIntegrationGitRepositoryTokenResourceAssert {
+	a.ValueSet("tags_all", expected)
+	return a
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -145,6 +159,16 @@ func (a *ApiIntegrationGitRepositoryTokenResourceAssert) HasNoNoAllowedAuthentic
 	return a
 }
 
+func (a *ApiIntegrationGitRepositoryTokenResourceAssert) HasNoTags() *ApiIntegrationGitRepositoryTokenResourceAssert {
+	a.ValueNotSet("tags")
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryTokenResourceAssert) HasNoTagsAll() *ApiIntegrationGitRepositoryTokenResourceAssert {
+	a.ValueNotSet("tags_all")
+	return a
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -179,6 +203,16 @@ func (a *ApiIntegrationGitRepositoryTokenResourceAssert) HasNoAllowedAuthenticat
 	return a
 }
 
+func (a *ApiIntegrationGitRepositoryTokenResourceAssert) HasTagsEmpty() *ApiIntegrationGitRepositoryTokenResourceAssert {
+	a.ValueSet("tags", "")
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryTokenResourceAssert) HasTagsAllEmpty() *ApiIntegrationGitRepositoryTokenResourceAssert {
+	a.ValueSet("tags_all", "")
+	return a
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -212,3 +246,13 @@ func (a *ApiIntegrationGitRepositoryTokenResourceAssert) HasNoAllowedAuthenticat
 	a.ValuePresent("no_allowed_authentication_secrets")
 	return a
 }
+
+func (a *ApiIntegrationGitRepositoryTokenResourceAssert) HasTagsNotEmpty() *ApiIntegrationGitRepositoryTokenResourceAssert {
+	a.ValuePresent("tags")
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryTokenResourceAssert) HasTagsAllNotEmpty() *ApiIntegrationGitRepositoryTokenResourceAssert {
+	a.ValuePresent("tags_all")
+	return a
+}
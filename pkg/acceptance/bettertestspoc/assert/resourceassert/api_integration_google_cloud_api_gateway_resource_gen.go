@@ -67,6 +67,10 @@ func (a *ApiIntegrationGoogleCloudApiGatewayResourceAssert) HasGoogleAudience(ex
 	return a
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -96,6 +100,16 @@ func (a *ApiIntegrationGoogleCloudApiGatewayResourceAssert) HasGoogleAudienceStr
 	return a
 }
 
+func (a *ApiIntegrationGoogleCloudApiGatewayResourceAssert) HasTagsString(expected string) *ApiIntegrationGoogleCloudApiGatewayResourceAssert {
+	a.ValueSet("tags", expected)
+	return a
+}
+
+func (a *ApiIntegrationGoogleCloudApiGatewayResourceAssert) HasTagsAllString(expected string) *ApiIntegrationGoogleCloudApiGatewayResourceAssert {
+	a.ValueSet("tags_all", expected)
+	return a
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -125,6 +139,16 @@ func (a *ApiIntegrationGoogleCloudApiGatewayResourceAssert) HasNoGoogleAudience(
 	return a
 }
 
+func (a *ApiIntegrationGoogleCloudApiGatewayResourceAssert) HasNoTags() *ApiIntegrationGoogleCloudApiGatewayResourceAssert {
+	a.ValueNotSet("tags")
+	return a
+}
+
+func (a *ApiIntegrationGoogleCloudApiGatewayResourceAssert) HasNoTagsAll() *ApiIntegrationGoogleCloudApiGatewayResourceAssert {
+	a.ValueNotSet("tags_all")
+	return a
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -144,6 +168,16 @@ func (a *ApiIntegrationGoogleCloudApiGatewayResourceAssert) HasFullyQualifiedNam
 	return a
 }
 
+func (a *ApiIntegrationGoogleCloudApiGatewayResourceAssert) HasTagsEmpty() *ApiIntegrationGoogleCloudApiGatewayResourceAssert {
+	a.ValueSet("tags", "")
+	return a
+}
+
+func (a *ApiIntegrationGoogleCloudApiGatewayResourceAssert) HasTagsAllEmpty() *ApiIntegrationGoogleCloudApiGatewayResourceAssert {
+	a.ValueSet("tags_all", "")
+	return a
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -172,3 +206,13 @@ func (a *ApiIntegrationGoogleCloudApiGatewayResourceAssert) HasGoogleAudienceNot
 	a.ValuePresent("google_audience")
 	return a
 }
+
+func (a *ApiIntegrationGoogleCloudApiGatewayResourceAssert) HasTagsNotEmpty() *ApiIntegrationGoogleCloudApiGatewayResourceAssert {
+	a.ValuePresent("tags")
+	return a
+}
+
+func (a *ApiIntegrationGoogleCloudApiGatewayResourceAssert) HasTagsAllNotEmpty() *ApiIntegrationGoogleCloudApiGatewayResourceAssert {
+	a.ValuePresent("tags_all")
+	return a
+}
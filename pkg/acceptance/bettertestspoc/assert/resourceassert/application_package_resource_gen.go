@@ -0,0 +1,215 @@
+// Code generated by resource assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type ApplicationPackageResourceAssert struct {
+	*assert.ResourceAssert
+}
+
+func ApplicationPackageResource(t *testing.T, name string) *ApplicationPackageResourceAssert {
+	t.Helper()
+
+	return &ApplicationPackageResourceAssert{
+		ResourceAssert: assert.NewResourceAssert(name),
+	}
+}
+
+func ImportedApplicationPackageResource(t *testing.T, id string) *ApplicationPackageResourceAssert {
+	t.Helper()
+
+	return &ApplicationPackageResourceAssert{
+		ResourceAssert: assert.NewImportedResourceAssert(id),
+	}
+}
+
+//////////////////////////////////
+// Attribute typed value checks //
+//////////////////////////////////
+
+func (a *ApplicationPackageResourceAssert) HasName(expected string) *ApplicationPackageResourceAssert {
+	a.StringValueSet("name", expected)
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasComment(expected string) *ApplicationPackageResourceAssert {
+	a.StringValueSet("comment", expected)
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasDataRetentionTimeInDays(expected int) *ApplicationPackageResourceAssert {
+	a.IntValueSet("data_retention_time_in_days", expected)
+	return a
+}
+
+// typed assert for "default_release_directive" (type: List, subtype: Map) is not currently supported
+
+func (a *ApplicationPackageResourceAssert) HasDistribution(expected string) *ApplicationPackageResourceAssert {
+	a.StringValueSet("distribution", expected)
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasEnableReleaseChannels(expected string) *ApplicationPackageResourceAssert {
+	a.StringValueSet("enable_release_channels", expected)
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasFullyQualifiedName(expected string) *ApplicationPackageResourceAssert {
+	a.StringValueSet("fully_qualified_name", expected)
+	return a
+}
+
+// typed assert for "release_directive" (type: Set, subtype: Map) is not currently supported
+
+// typed assert for "version" (type: Set, subtype: Map) is not currently supported
+
+///////////////////////////////////
+// Attribute value string checks //
+///////////////////////////////////
+
+func (a *ApplicationPackageResourceAssert) HasNameString(expected string) *ApplicationPackageResourceAssert {
+	a.ValueSet("name", expected)
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasCommentString(expected string) *ApplicationPackageResourceAssert {
+	a.ValueSet("comment", expected)
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasDataRetentionTimeInDaysString(expected string) *ApplicationPackageResourceAssert {
+	a.ValueSet("data_retention_time_in_days", expected)
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasDistributionString(expected string) *ApplicationPackageResourceAssert {
+	a.ValueSet("distribution", expected)
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasEnableReleaseChannelsString(expected string) *ApplicationPackageResourceAssert {
+	a.ValueSet("enable_release_channels", expected)
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasFullyQualifiedNameString(expected string) *ApplicationPackageResourceAssert {
+	a.ValueSet("fully_qualified_name", expected)
+	return a
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (a *ApplicationPackageResourceAssert) HasNoName() *ApplicationPackageResourceAssert {
+	a.ValueNotSet("name")
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasNoComment() *ApplicationPackageResourceAssert {
+	a.ValueNotSet("comment")
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasNoDataRetentionTimeInDays() *ApplicationPackageResourceAssert {
+	a.ValueNotSet("data_retention_time_in_days")
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasNoDistribution() *ApplicationPackageResourceAssert {
+	a.ValueNotSet("distribution")
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasNoEnableReleaseChannels() *ApplicationPackageResourceAssert {
+	a.ValueNotSet("enable_release_channels")
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasNoFullyQualifiedName() *ApplicationPackageResourceAssert {
+	a.ValueNotSet("fully_qualified_name")
+	return a
+}
+
+////////////////////////////
+// Attribute empty checks //
+////////////////////////////
+
+func (a *ApplicationPackageResourceAssert) HasCommentEmpty() *ApplicationPackageResourceAssert {
+	a.ValueSet("comment", "")
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasDataRetentionTimeInDaysEmpty() *ApplicationPackageResourceAssert {
+	a.ValueSet("data_retention_time_in_days", "")
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasDefaultReleaseDirectiveEmpty() *ApplicationPackageResourceAssert {
+	a.ValueSet("default_release_directive.#", "0")
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasDistributionEmpty() *ApplicationPackageResourceAssert {
+	a.ValueSet("distribution", "")
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasEnableReleaseChannelsEmpty() *ApplicationPackageResourceAssert {
+	a.ValueSet("enable_release_channels", "")
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasFullyQualifiedNameEmpty() *ApplicationPackageResourceAssert {
+	a.ValueSet("fully_qualified_name", "")
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasReleaseDirectiveEmpty() *ApplicationPackageResourceAssert {
+	a.ValueSet("release_directive.#", "0")
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasVersionEmpty() *ApplicationPackageResourceAssert {
+	a.ValueSet("version.#", "0")
+	return a
+}
+
+///////////////////////////////
+// Attribute presence checks //
+///////////////////////////////
+
+func (a *ApplicationPackageResourceAssert) HasNameNotEmpty() *ApplicationPackageResourceAssert {
+	a.ValuePresent("name")
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasCommentNotEmpty() *ApplicationPackageResourceAssert {
+	a.ValuePresent("comment")
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasDataRetentionTimeInDaysNotEmpty() *ApplicationPackageResourceAssert {
+	a.ValuePresent("data_retention_time_in_days")
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasDistributionNotEmpty() *ApplicationPackageResourceAssert {
+	a.ValuePresent("distribution")
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasEnableReleaseChannelsNotEmpty() *ApplicationPackageResourceAssert {
+	a.ValuePresent("enable_release_channels")
+	return a
+}
+
+func (a *ApplicationPackageResourceAssert) HasFullyQualifiedNameNotEmpty() *ApplicationPackageResourceAssert {
+	a.ValuePresent("fully_qualified_name")
+	return a
+}
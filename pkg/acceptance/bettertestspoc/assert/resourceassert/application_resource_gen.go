@@ -0,0 +1,269 @@
+// Code generated by resource assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type ApplicationResourceAssert struct {
+	*assert.ResourceAssert
+}
+
+func ApplicationResource(t *testing.T, name string) *ApplicationResourceAssert {
+	t.Helper()
+
+	return &ApplicationResourceAssert{
+		ResourceAssert: assert.NewResourceAssert(name),
+	}
+}
+
+func ImportedApplicationResource(t *testing.T, id string) *ApplicationResourceAssert {
+	t.Helper()
+
+	return &ApplicationResourceAssert{
+		ResourceAssert: assert.NewImportedResourceAssert(id),
+	}
+}
+
+//////////////////////////////////
+// Attribute typed value checks //
+//////////////////////////////////
+
+func (a *ApplicationResourceAssert) HasName(expected string) *ApplicationResourceAssert {
+	a.StringValueSet("name", expected)
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasComment(expected string) *ApplicationResourceAssert {
+	a.StringValueSet("comment", expected)
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasDebugMode(expected string) *ApplicationResourceAssert {
+	a.StringValueSet("debug_mode", expected)
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasFromApplicationPackage(expected string) *ApplicationResourceAssert {
+	a.StringValueSet("from_application_package", expected)
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasFromListing(expected string) *ApplicationResourceAssert {
+	a.StringValueSet("from_listing", expected)
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasFullyQualifiedName(expected string) *ApplicationResourceAssert {
+	a.StringValueSet("fully_qualified_name", expected)
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasPatch(expected int) *ApplicationResourceAssert {
+	a.IntValueSet("patch", expected)
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasVersion(expected string) *ApplicationResourceAssert {
+	a.StringValueSet("version", expected)
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasVersionDirectory(expected string) *ApplicationResourceAssert {
+	a.StringValueSet("version_directory", expected)
+	return a
+}
+
+///////////////////////////////////
+// Attribute value string checks //
+///////////////////////////////////
+
+func (a *ApplicationResourceAssert) HasNameString(expected string) *ApplicationResourceAssert {
+	a.ValueSet("name", expected)
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasCommentString(expected string) *ApplicationResourceAssert {
+	a.ValueSet("comment", expected)
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasDebugModeString(expected string) *ApplicationResourceAssert {
+	a.ValueSet("debug_mode", expected)
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasFromApplicationPackageString(expected string) *ApplicationResourceAssert {
+	a.ValueSet("from_application_package", expected)
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasFromListingString(expected string) *ApplicationResourceAssert {
+	a.ValueSet("from_listing", expected)
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasFullyQualifiedNameString(expected string) *ApplicationResourceAssert {
+	a.ValueSet("fully_qualified_name", expected)
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasPatchString(expected string) *ApplicationResourceAssert {
+	a.ValueSet("patch", expected)
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasVersionString(expected string) *ApplicationResourceAssert {
+	a.ValueSet("version", expected)
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasVersionDirectoryString(expected string) *ApplicationResourceAssert {
+	a.ValueSet("version_directory", expected)
+	return a
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (a *ApplicationResourceAssert) HasNoName() *ApplicationResourceAssert {
+	a.ValueNotSet("name")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasNoComment() *ApplicationResourceAssert {
+	a.ValueNotSet("comment")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasNoDebugMode() *ApplicationResourceAssert {
+	a.ValueNotSet("debug_mode")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasNoFromApplicationPackage() *ApplicationResourceAssert {
+	a.ValueNotSet("from_application_package")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasNoFromListing() *ApplicationResourceAssert {
+	a.ValueNotSet("from_listing")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasNoFullyQualifiedName() *ApplicationResourceAssert {
+	a.ValueNotSet("fully_qualified_name")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasNoPatch() *ApplicationResourceAssert {
+	a.ValueNotSet("patch")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasNoVersion() *ApplicationResourceAssert {
+	a.ValueNotSet("version")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasNoVersionDirectory() *ApplicationResourceAssert {
+	a.ValueNotSet("version_directory")
+	return a
+}
+
+////////////////////////////
+// Attribute empty checks //
+////////////////////////////
+
+func (a *ApplicationResourceAssert) HasCommentEmpty() *ApplicationResourceAssert {
+	a.ValueSet("comment", "")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasDebugModeEmpty() *ApplicationResourceAssert {
+	a.ValueSet("debug_mode", "")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasFromApplicationPackageEmpty() *ApplicationResourceAssert {
+	a.ValueSet("from_application_package", "")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasFromListingEmpty() *ApplicationResourceAssert {
+	a.ValueSet("from_listing", "")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasFullyQualifiedNameEmpty() *ApplicationResourceAssert {
+	a.ValueSet("fully_qualified_name", "")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasPatchEmpty() *ApplicationResourceAssert {
+	a.ValueSet("patch", "")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasVersionEmpty() *ApplicationResourceAssert {
+	a.ValueSet("version", "")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasVersionDirectoryEmpty() *ApplicationResourceAssert {
+	a.ValueSet("version_directory", "")
+	return a
+}
+
+///////////////////////////////
+// Attribute presence checks //
+///////////////////////////////
+
+func (a *ApplicationResourceAssert) HasNameNotEmpty() *ApplicationResourceAssert {
+	a.ValuePresent("name")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasCommentNotEmpty() *ApplicationResourceAssert {
+	a.ValuePresent("comment")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasDebugModeNotEmpty() *ApplicationResourceAssert {
+	a.ValuePresent("debug_mode")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasFromApplicationPackageNotEmpty() *ApplicationResourceAssert {
+	a.ValuePresent("from_application_package")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasFromListingNotEmpty() *ApplicationResourceAssert {
+	a.ValuePresent("from_listing")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasFullyQualifiedNameNotEmpty() *ApplicationResourceAssert {
+	a.ValuePresent("fully_qualified_name")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasPatchNotEmpty() *ApplicationResourceAssert {
+	a.ValuePresent("patch")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasVersionNotEmpty() *ApplicationResourceAssert {
+	a.ValuePresent("version")
+	return a
+}
+
+func (a *ApplicationResourceAssert) HasVersionDirectoryNotEmpty() *ApplicationResourceAssert {
+	a.ValuePresent("version_directory")
+	return a
+}
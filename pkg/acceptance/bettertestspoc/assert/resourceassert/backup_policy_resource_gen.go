@@ -0,0 +1,234 @@
+// Code generated by resource assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type BackupPolicyResourceAssert struct {
+	*assert.ResourceAssert
+}
+
+func BackupPolicyResource(t *testing.T, name string) *BackupPolicyResourceAssert {
+	t.Helper()
+
+	return &BackupPolicyResourceAssert{
+		ResourceAssert: assert.NewResourceAssert(name),
+	}
+}
+
+func ImportedBackupPolicyResource(t *testing.T, id string) *BackupPolicyResourceAssert {
+	t.Helper()
+
+	return &BackupPolicyResourceAssert{
+		ResourceAssert: assert.NewImportedResourceAssert(id),
+	}
+}
+
+//////////////////////////////////
+// Attribute typed value checks //
+//////////////////////////////////
+
+func (b *BackupPolicyResourceAssert) HasDatabase(expected string) *BackupPolicyResourceAssert {
+	b.StringValueSet("database", expected)
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasSchema(expected string) *BackupPolicyResourceAssert {
+	b.StringValueSet("schema", expected)
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasName(expected string) *BackupPolicyResourceAssert {
+	b.StringValueSet("name", expected)
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasComment(expected string) *BackupPolicyResourceAssert {
+	b.StringValueSet("comment", expected)
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasExpireAfterDays(expected int) *BackupPolicyResourceAssert {
+	b.IntValueSet("expire_after_days", expected)
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasFullyQualifiedName(expected string) *BackupPolicyResourceAssert {
+	b.StringValueSet("fully_qualified_name", expected)
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasSchedule(expected string) *BackupPolicyResourceAssert {
+	b.StringValueSet("schedule", expected)
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasWithRetentionLock(expected bool) *BackupPolicyResourceAssert {
+	b.BoolValueSet("with_retention_lock", expected)
+	return b
+}
+
+///////////////////////////////////
+// Attribute value string checks //
+///////////////////////////////////
+
+func (b *BackupPolicyResourceAssert) HasDatabaseString(expected string) *BackupPolicyResourceAssert {
+	b.ValueSet("database", expected)
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasSchemaString(expected string) *BackupPolicyResourceAssert {
+	b.ValueSet("schema", expected)
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasNameString(expected string) *BackupPolicyResourceAssert {
+	b.ValueSet("name", expected)
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasCommentString(expected string) *BackupPolicyResourceAssert {
+	b.ValueSet("comment", expected)
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasExpireAfterDaysString(expected string) *BackupPolicyResourceAssert {
+	b.ValueSet("expire_after_days", expected)
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasFullyQualifiedNameString(expected string) *BackupPolicyResourceAssert {
+	b.ValueSet("fully_qualified_name", expected)
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasScheduleString(expected string) *BackupPolicyResourceAssert {
+	b.ValueSet("schedule", expected)
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasWithRetentionLockString(expected string) *BackupPolicyResourceAssert {
+	b.ValueSet("with_retention_lock", expected)
+	return b
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (b *BackupPolicyResourceAssert) HasNoDatabase() *BackupPolicyResourceAssert {
+	b.ValueNotSet("database")
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasNoSchema() *BackupPolicyResourceAssert {
+	b.ValueNotSet("schema")
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasNoName() *BackupPolicyResourceAssert {
+	b.ValueNotSet("name")
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasNoComment() *BackupPolicyResourceAssert {
+	b.ValueNotSet("comment")
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasNoExpireAfterDays() *BackupPolicyResourceAssert {
+	b.ValueNotSet("expire_after_days")
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasNoFullyQualifiedName() *BackupPolicyResourceAssert {
+	b.ValueNotSet("fully_qualified_name")
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasNoSchedule() *BackupPolicyResourceAssert {
+	b.ValueNotSet("schedule")
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasNoWithRetentionLock() *BackupPolicyResourceAssert {
+	b.ValueNotSet("with_retention_lock")
+	return b
+}
+
+////////////////////////////
+// Attribute empty checks //
+////////////////////////////
+
+func (b *BackupPolicyResourceAssert) HasCommentEmpty() *BackupPolicyResourceAssert {
+	b.ValueSet("comment", "")
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasExpireAfterDaysEmpty() *BackupPolicyResourceAssert {
+	b.ValueSet("expire_after_days", "")
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasFullyQualifiedNameEmpty() *BackupPolicyResourceAssert {
+	b.ValueSet("fully_qualified_name", "")
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasScheduleEmpty() *BackupPolicyResourceAssert {
+	b.ValueSet("schedule", "")
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasWithRetentionLockEmpty() *BackupPolicyResourceAssert {
+	b.ValueSet("with_retention_lock", "")
+	return b
+}
+
+///////////////////////////////
+// Attribute presence checks //
+///////////////////////////////
+
+func (b *BackupPolicyResourceAssert) HasDatabaseNotEmpty() *BackupPolicyResourceAssert {
+	b.ValuePresent("database")
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasSchemaNotEmpty() *BackupPolicyResourceAssert {
+	b.ValuePresent("schema")
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasNameNotEmpty() *BackupPolicyResourceAssert {
+	b.ValuePresent("name")
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasCommentNotEmpty() *BackupPolicyResourceAssert {
+	b.ValuePresent("comment")
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasExpireAfterDaysNotEmpty() *BackupPolicyResourceAssert {
+	b.ValuePresent("expire_after_days")
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasFullyQualifiedNameNotEmpty() *BackupPolicyResourceAssert {
+	b.ValuePresent("fully_qualified_name")
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasScheduleNotEmpty() *BackupPolicyResourceAssert {
+	b.ValuePresent("schedule")
+	return b
+}
+
+func (b *BackupPolicyResourceAssert) HasWithRetentionLockNotEmpty() *BackupPolicyResourceAssert {
+	b.ValuePresent("with_retention_lock")
+	return b
+}
@@ -0,0 +1,224 @@
+// Code generated by resource assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type BackupSetResourceAssert struct {
+	*assert.ResourceAssert
+}
+
+func BackupSetResource(t *testing.T, name string) *BackupSetResourceAssert {
+	t.Helper()
+
+	return &BackupSetResourceAssert{
+		ResourceAssert: assert.NewResourceAssert(name),
+	}
+}
+
+func ImportedBackupSetResource(t *testing.T, id string) *BackupSetResourceAssert {
+	t.Helper()
+
+	return &BackupSetResourceAssert{
+		ResourceAssert: assert.NewImportedResourceAssert(id),
+	}
+}
+
+//////////////////////////////////
+// Attribute typed value checks //
+//////////////////////////////////
+
+func (b *BackupSetResourceAssert) HasDatabase(expected string) *BackupSetResourceAssert {
+	b.StringValueSet("database", expected)
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasSchema(expected string) *BackupSetResourceAssert {
+	b.StringValueSet("schema", expected)
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasName(expected string) *BackupSetResourceAssert {
+	b.StringValueSet("name", expected)
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasBackupPolicy(expected string) *BackupSetResourceAssert {
+	b.StringValueSet("backup_policy", expected)
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasComment(expected string) *BackupSetResourceAssert {
+	b.StringValueSet("comment", expected)
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasFullyQualifiedName(expected string) *BackupSetResourceAssert {
+	b.StringValueSet("fully_qualified_name", expected)
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasObjectName(expected string) *BackupSetResourceAssert {
+	b.StringValueSet("object_name", expected)
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasObjectType(expected string) *BackupSetResourceAssert {
+	b.StringValueSet("object_type", expected)
+	return b
+}
+
+///////////////////////////////////
+// Attribute value string checks //
+///////////////////////////////////
+
+func (b *BackupSetResourceAssert) HasDatabaseString(expected string) *BackupSetResourceAssert {
+	b.ValueSet("database", expected)
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasSchemaString(expected string) *BackupSetResourceAssert {
+	b.ValueSet("schema", expected)
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasNameString(expected string) *BackupSetResourceAssert {
+	b.ValueSet("name", expected)
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasBackupPolicyString(expected string) *BackupSetResourceAssert {
+	b.ValueSet("backup_policy", expected)
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasCommentString(expected string) *BackupSetResourceAssert {
+	b.ValueSet("comment", expected)
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasFullyQualifiedNameString(expected string) *BackupSetResourceAssert {
+	b.ValueSet("fully_qualified_name", expected)
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasObjectNameString(expected string) *BackupSetResourceAssert {
+	b.ValueSet("object_name", expected)
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasObjectTypeString(expected string) *BackupSetResourceAssert {
+	b.ValueSet("object_type", expected)
+	return b
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (b *BackupSetResourceAssert) HasNoDatabase() *BackupSetResourceAssert {
+	b.ValueNotSet("database")
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasNoSchema() *BackupSetResourceAssert {
+	b.ValueNotSet("schema")
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasNoName() *BackupSetResourceAssert {
+	b.ValueNotSet("name")
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasNoBackupPolicy() *BackupSetResourceAssert {
+	b.ValueNotSet("backup_policy")
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasNoComment() *BackupSetResourceAssert {
+	b.ValueNotSet("comment")
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasNoFullyQualifiedName() *BackupSetResourceAssert {
+	b.ValueNotSet("fully_qualified_name")
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasNoObjectName() *BackupSetResourceAssert {
+	b.ValueNotSet("object_name")
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasNoObjectType() *BackupSetResourceAssert {
+	b.ValueNotSet("object_type")
+	return b
+}
+
+////////////////////////////
+// Attribute empty checks //
+////////////////////////////
+
+func (b *BackupSetResourceAssert) HasBackupPolicyEmpty() *BackupSetResourceAssert {
+	b.ValueSet("backup_policy", "")
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasCommentEmpty() *BackupSetResourceAssert {
+	b.ValueSet("comment", "")
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasFullyQualifiedNameEmpty() *BackupSetResourceAssert {
+	b.ValueSet("fully_qualified_name", "")
+	return b
+}
+
+///////////////////////////////
+// Attribute presence checks //
+///////////////////////////////
+
+func (b *BackupSetResourceAssert) HasDatabaseNotEmpty() *BackupSetResourceAssert {
+	b.ValuePresent("database")
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasSchemaNotEmpty() *BackupSetResourceAssert {
+	b.ValuePresent("schema")
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasNameNotEmpty() *BackupSetResourceAssert {
+	b.ValuePresent("name")
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasBackupPolicyNotEmpty() *BackupSetResourceAssert {
+	b.ValuePresent("backup_policy")
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasCommentNotEmpty() *BackupSetResourceAssert {
+	b.ValuePresent("comment")
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasFullyQualifiedNameNotEmpty() *BackupSetResourceAssert {
+	b.ValuePresent("fully_qualified_name")
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasObjectNameNotEmpty() *BackupSetResourceAssert {
+	b.ValuePresent("object_name")
+	return b
+}
+
+func (b *BackupSetResourceAssert) HasObjectTypeNotEmpty() *BackupSetResourceAssert {
+	b.ValuePresent("object_type")
+	return b
+}
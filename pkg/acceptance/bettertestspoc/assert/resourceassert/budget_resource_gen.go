@@ -0,0 +1,221 @@
+// Code generated by resource assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type BudgetResourceAssert struct {
+	*assert.ResourceAssert
+}
+
+func BudgetResource(t *testing.T, name string) *BudgetResourceAssert {
+	t.Helper()
+
+	return &BudgetResourceAssert{
+		ResourceAssert: assert.NewResourceAssert(name),
+	}
+}
+
+func ImportedBudgetResource(t *testing.T, id string) *BudgetResourceAssert {
+	t.Helper()
+
+	return &BudgetResourceAssert{
+		ResourceAssert: assert.NewImportedResourceAssert(id),
+	}
+}
+
+//////////////////////////////////
+// Attribute typed value checks //
+//////////////////////////////////
+
+func (b *BudgetResourceAssert) HasDatabase(expected string) *BudgetResourceAssert {
+	b.StringValueSet("database", expected)
+	return b
+}
+
+func (b *BudgetResourceAssert) HasSchema(expected string) *BudgetResourceAssert {
+	b.StringValueSet("schema", expected)
+	return b
+}
+
+func (b *BudgetResourceAssert) HasName(expected string) *BudgetResourceAssert {
+	b.StringValueSet("name", expected)
+	return b
+}
+
+func (b *BudgetResourceAssert) HasComment(expected string) *BudgetResourceAssert {
+	b.StringValueSet("comment", expected)
+	return b
+}
+
+// typed assert for "cycle_start_action" (type: List, subtype: Map) is not currently supported
+
+func (b *BudgetResourceAssert) HasEmailRecipients(expected ...string) *BudgetResourceAssert {
+	b.SetContainsExactlyStringValues("email_recipients", expected...)
+	return b
+}
+
+func (b *BudgetResourceAssert) HasFullyQualifiedName(expected string) *BudgetResourceAssert {
+	b.StringValueSet("fully_qualified_name", expected)
+	return b
+}
+
+func (b *BudgetResourceAssert) HasNotificationIntegration(expected string) *BudgetResourceAssert {
+	b.StringValueSet("notification_integration", expected)
+	return b
+}
+
+func (b *BudgetResourceAssert) HasSpendingLimit(expected int) *BudgetResourceAssert {
+	b.IntValueSet("spending_limit", expected)
+	return b
+}
+
+///////////////////////////////////
+// Attribute value string checks //
+///////////////////////////////////
+
+func (b *BudgetResourceAssert) HasDatabaseString(expected string) *BudgetResourceAssert {
+	b.ValueSet("database", expected)
+	return b
+}
+
+func (b *BudgetResourceAssert) HasSchemaString(expected string) *BudgetResourceAssert {
+	b.ValueSet("schema", expected)
+	return b
+}
+
+func (b *BudgetResourceAssert) HasNameString(expected string) *BudgetResourceAssert {
+	b.ValueSet("name", expected)
+	return b
+}
+
+func (b *BudgetResourceAssert) HasCommentString(expected string) *BudgetResourceAssert {
+	b.ValueSet("comment", expected)
+	return b
+}
+
+func (b *BudgetResourceAssert) HasFullyQualifiedNameString(expected string) *BudgetResourceAssert {
+	b.ValueSet("fully_qualified_name", expected)
+	return b
+}
+
+func (b *BudgetResourceAssert) HasNotificationIntegrationString(expected string) *BudgetResourceAssert {
+	b.ValueSet("notification_integration", expected)
+	return b
+}
+
+func (b *BudgetResourceAssert) HasSpendingLimitString(expected string) *BudgetResourceAssert {
+	b.ValueSet("spending_limit", expected)
+	return b
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (b *BudgetResourceAssert) HasNoDatabase() *BudgetResourceAssert {
+	b.ValueNotSet("database")
+	return b
+}
+
+func (b *BudgetResourceAssert) HasNoSchema() *BudgetResourceAssert {
+	b.ValueNotSet("schema")
+	return b
+}
+
+func (b *BudgetResourceAssert) HasNoName() *BudgetResourceAssert {
+	b.ValueNotSet("name")
+	return b
+}
+
+func (b *BudgetResourceAssert) HasNoComment() *BudgetResourceAssert {
+	b.ValueNotSet("comment")
+	return b
+}
+
+func (b *BudgetResourceAssert) HasNoFullyQualifiedName() *BudgetResourceAssert {
+	b.ValueNotSet("fully_qualified_name")
+	return b
+}
+
+func (b *BudgetResourceAssert) HasNoNotificationIntegration() *BudgetResourceAssert {
+	b.ValueNotSet("notification_integration")
+	return b
+}
+
+func (b *BudgetResourceAssert) HasNoSpendingLimit() *BudgetResourceAssert {
+	b.ValueNotSet("spending_limit")
+	return b
+}
+
+////////////////////////////
+// Attribute empty checks //
+////////////////////////////
+
+func (b *BudgetResourceAssert) HasCommentEmpty() *BudgetResourceAssert {
+	b.ValueSet("comment", "")
+	return b
+}
+
+func (b *BudgetResourceAssert) HasCycleStartActionEmpty() *BudgetResourceAssert {
+	b.ValueSet("cycle_start_action.#", "0")
+	return b
+}
+
+func (b *BudgetResourceAssert) HasEmailRecipientsEmpty() *BudgetResourceAssert {
+	b.ValueSet("email_recipients.#", "0")
+	return b
+}
+
+func (b *BudgetResourceAssert) HasFullyQualifiedNameEmpty() *BudgetResourceAssert {
+	b.ValueSet("fully_qualified_name", "")
+	return b
+}
+
+func (b *BudgetResourceAssert) HasNotificationIntegrationEmpty() *BudgetResourceAssert {
+	b.ValueSet("notification_integration", "")
+	return b
+}
+
+///////////////////////////////
+// Attribute presence checks //
+///////////////////////////////
+
+func (b *BudgetResourceAssert) HasDatabaseNotEmpty() *BudgetResourceAssert {
+	b.ValuePresent("database")
+	return b
+}
+
+func (b *BudgetResourceAssert) HasSchemaNotEmpty() *BudgetResourceAssert {
+	b.ValuePresent("schema")
+	return b
+}
+
+func (b *BudgetResourceAssert) HasNameNotEmpty() *BudgetResourceAssert {
+	b.ValuePresent("name")
+	return b
+}
+
+func (b *BudgetResourceAssert) HasCommentNotEmpty() *BudgetResourceAssert {
+	b.ValuePresent("comment")
+	return b
+}
+
+func (b *BudgetResourceAssert) HasFullyQualifiedNameNotEmpty() *BudgetResourceAssert {
+	b.ValuePresent("fully_qualified_name")
+	return b
+}
+
+func (b *BudgetResourceAssert) HasNotificationIntegrationNotEmpty() *BudgetResourceAssert {
+	b.ValuePresent("notification_integration")
+	return b
+}
+
+func (b *BudgetResourceAssert) HasSpendingLimitNotEmpty() *BudgetResourceAssert {
+	b.ValuePresent("spending_limit")
+	return b
+}
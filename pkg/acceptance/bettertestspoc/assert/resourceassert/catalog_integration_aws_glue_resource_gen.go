@@ -82,6 +82,10 @@ func (c *CatalogIntegrationAwsGlueResourceAssert) HasRefreshIntervalSeconds(expe
 	return c
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -136,6 +140,16 @@ func (c *CatalogIntegrationAwsGlueResourceAssert) HasRefreshIntervalSecondsStrin
 	return c
 }
 
+func (c *CatalogIntegrationAwsGlueResourceAssert) HasTagsString(expected string) *CatalogIntegrationAwsGlueResourceAssert {
+	c.ValueSet("tags", expected)
+	return c
+}
+
+func (c *CatalogIntegrationAwsGlueResourceAssert) HasTagsAllString(expected string) *CatalogIntegrationAwsGlueResourceAssert {
+	c.ValueSet("tags_all", expected)
+	return c
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -190,6 +204,16 @@ func (c *CatalogIntegrationAwsGlueResourceAssert) HasNoRefreshIntervalSeconds()
 	return c
 }
 
+func (c *CatalogIntegrationAwsGlueResourceAssert) HasNoTags() *CatalogIntegrationAwsGlueResourceAssert {
+	c.ValueNotSet("tags")
+	return c
+}
+
+func (c *CatalogIntegrationAwsGlueResourceAssert) HasNoTagsAll() *CatalogIntegrationAwsGlueResourceAssert {
+	c.ValueNotSet("tags_all")
+	return c
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -224,6 +248,16 @@ func (c *CatalogIntegrationAwsGlueResourceAssert) HasRefreshIntervalSecondsEmpty
 	return c
 }
 
+func (c *CatalogIntegrationAwsGlueResourceAssert) HasTagsEmpty() *CatalogIntegrationAwsGlueResourceAssert {
+	c.ValueSet("tags", "")
+	return c
+}
+
+func (c *CatalogIntegrationAwsGlueResourceAssert) HasTagsAllEmpty() *CatalogIntegrationAwsGlueResourceAssert {
+	c.ValueSet("tags_all", "")
+	return c
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -277,3 +311,13 @@ func (c *CatalogIntegrationAwsGlueResourceAssert) HasRefreshIntervalSecondsNotEm
 	c.ValuePresent("refresh_interval_seconds")
 	return c
 }
+
+func (c *CatalogIntegrationAwsGlueResourceAssert) HasTagsNotEmpty() *CatalogIntegrationAwsGlueResourceAssert {
+	c.ValuePresent("tags")
+	return c
+}
+
+func (c *CatalogIntegrationAwsGlueResourceAssert) HasTagsAllNotEmpty() *CatalogIntegrationAwsGlueResourceAssert {
+	c.ValuePresent("tags_all")
+	return c
+}
@@ -75,6 +75,10 @@ func (c *CatalogIntegrationIcebergRestResourceAssert) HasRefreshIntervalSeconds(
 
 // typed assert for "sigv4_rest_authentication" (type: List, subtype: Map) is not currently supported
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -114,6 +118,16 @@ func (c *CatalogIntegrationIcebergRestResourceAssert) HasRefreshIntervalSecondsS
 	return c
 }
 
+func (c *CatalogIntegrationIcebergRestResourceAssert) HasTagsString(expected string) *CatalogIntegrationIcebergRestResourceAssert {
+	c.ValueSet("tags", expected)
+	return c
+}
+
+func (c *CatalogIntegrationIcebergRestResourceAssert) HasTagsAllString(expected string) *CatalogIntegrationIcebergRestResourceAssert {
+	c.ValueSet("tags_all", expected)
+	return c
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -153,6 +167,16 @@ func (c *CatalogIntegrationIcebergRestResourceAssert) HasNoRefreshIntervalSecond
 	return c
 }
 
+func (c *CatalogIntegrationIcebergRestResourceAssert) HasNoTags() *CatalogIntegrationIcebergRestResourceAssert {
+	c.ValueNotSet("tags")
+	return c
+}
+
+func (c *CatalogIntegrationIcebergRestResourceAssert) HasNoTagsAll() *CatalogIntegrationIcebergRestResourceAssert {
+	c.ValueNotSet("tags_all")
+	return c
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -197,6 +221,16 @@ func (c *CatalogIntegrationIcebergRestResourceAssert) HasSigv4RestAuthentication
 	return c
 }
 
+func (c *CatalogIntegrationIcebergRestResourceAssert) HasTagsEmpty() *CatalogIntegrationIcebergRestResourceAssert {
+	c.ValueSet("tags", "")
+	return c
+}
+
+func (c *CatalogIntegrationIcebergRestResourceAssert) HasTagsAllEmpty() *CatalogIntegrationIcebergRestResourceAssert {
+	c.ValueSet("tags_all", "")
+	return c
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -235,3 +269,13 @@ func (c *CatalogIntegrationIcebergRestResourceAssert) HasRefreshIntervalSecondsN
 	c.ValuePresent("refresh_interval_seconds")
 	return c
 }
+
+func (c *CatalogIntegrationIcebergRestResourceAssert) HasTagsNotEmpty() *CatalogIntegrationIcebergRestResourceAssert {
+	c.ValuePresent("tags")
+	return c
+}
+
+func (c *CatalogIntegrationIcebergRestResourceAssert) HasTagsAllNotEmpty() *CatalogIntegrationIcebergRestResourceAssert {
+	c.ValuePresent("tags_all")
+	return c
+}
@@ -67,6 +67,10 @@ func (c *CatalogIntegrationObjectStorageResourceAssert) HasTableFormat(expected
 	return c
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -106,6 +110,16 @@ func (c *CatalogIntegrationObjectStorageResourceAssert) HasTableFormatString(exp
 	return c
 }
 
+func (c *CatalogIntegrationObjectStorageResourceAssert) HasTagsString(expected string) *CatalogIntegrationObjectStorageResourceAssert {
+	c.ValueSet("tags", expected)
+	return c
+}
+
+func (c *CatalogIntegrationObjectStorageResourceAssert) HasTagsAllString(expected string) *CatalogIntegrationObjectStorageResourceAssert {
+	c.ValueSet("tags_all", expected)
+	return c
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -145,6 +159,16 @@ func (c *CatalogIntegrationObjectStorageResourceAssert) HasNoTableFormat() *Cata
 	return c
 }
 
+func (c *CatalogIntegrationObjectStorageResourceAssert) HasNoTags() *CatalogIntegrationObjectStorageResourceAssert {
+	c.ValueNotSet("tags")
+	return c
+}
+
+func (c *CatalogIntegrationObjectStorageResourceAssert) HasNoTagsAll() *CatalogIntegrationObjectStorageResourceAssert {
+	c.ValueNotSet("tags_all")
+	return c
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -169,6 +193,16 @@ func (c *CatalogIntegrationObjectStorageResourceAssert) HasRefreshIntervalSecond
 	return c
 }
 
+func (c *CatalogIntegrationObjectStorageResourceAssert) HasTagsEmpty() *CatalogIntegrationObjectStorageResourceAssert {
+	c.ValueSet("tags", "")
+	return c
+}
+
+func (c *CatalogIntegrationObjectStorageResourceAssert) HasTagsAllEmpty() *CatalogIntegrationObjectStorageResourceAssert {
+	c.ValueSet("tags_all", "")
+	return c
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -207,3 +241,13 @@ func (c *CatalogIntegrationObjectStorageResourceAssert) HasTableFormatNotEmpty()
 	c.ValuePresent("table_format")
 	return c
 }
+
+func (c *CatalogIntegrationObjectStorageResourceAssert) HasTagsNotEmpty() *CatalogIntegrationObjectStorageResourceAssert {
+	c.ValuePresent("tags")
+	return c
+}
+
+func (c *CatalogIntegrationObjectStorageResourceAssert) HasTagsAllNotEmpty() *CatalogIntegrationObjectStorageResourceAssert {
+	c.ValuePresent("tags_all")
+	return c
+}
@@ -71,6 +71,10 @@ func (c *CatalogIntegrationOpenCatalogResourceAssert) HasRefreshIntervalSeconds(
 
 // typed assert for "rest_config" (type: List, subtype: Map) is not currently supported
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -110,6 +114,16 @@ func (c *CatalogIntegrationOpenCatalogResourceAssert) HasRefreshIntervalSecondsS
 	return c
 }
 
+func (c *CatalogIntegrationOpenCatalogResourceAssert) HasTagsString(expected string) *CatalogIntegrationOpenCatalogResourceAssert {
+	c.ValueSet("tags", expected)
+	return c
+}
+
+func (c *CatalogIntegrationOpenCatalogResourceAssert) HasTagsAllString(expected string) *CatalogIntegrationOpenCatalogResourceAssert {
+	c.ValueSet("tags_all", expected)
+	return c
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -149,6 +163,16 @@ func (c *CatalogIntegrationOpenCatalogResourceAssert) HasNoRefreshIntervalSecond
 	return c
 }
 
+func (c *CatalogIntegrationOpenCatalogResourceAssert) HasNoTags() *CatalogIntegrationOpenCatalogResourceAssert {
+	c.ValueNotSet("tags")
+	return c
+}
+
+func (c *CatalogIntegrationOpenCatalogResourceAssert) HasNoTagsAll() *CatalogIntegrationOpenCatalogResourceAssert {
+	c.ValueNotSet("tags_all")
+	return c
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -178,6 +202,16 @@ func (c *CatalogIntegrationOpenCatalogResourceAssert) HasRefreshIntervalSecondsE
 	return c
 }
 
+func (c *CatalogIntegrationOpenCatalogResourceAssert) HasTagsEmpty() *CatalogIntegrationOpenCatalogResourceAssert {
+	c.ValueSet("tags", "")
+	return c
+}
+
+func (c *CatalogIntegrationOpenCatalogResourceAssert) HasTagsAllEmpty() *CatalogIntegrationOpenCatalogResourceAssert {
+	c.ValueSet("tags_all", "")
+	return c
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -216,3 +250,13 @@ func (c *CatalogIntegrationOpenCatalogResourceAssert) HasRefreshIntervalSecondsN
 	c.ValuePresent("refresh_interval_seconds")
 	return c
 }
+
+func (c *CatalogIntegrationOpenCatalogResourceAssert) HasTagsNotEmpty() *CatalogIntegrationOpenCatalogResourceAssert {
+	c.ValuePresent("tags")
+	return c
+}
+
+func (c *CatalogIntegrationOpenCatalogResourceAssert) HasTagsAllNotEmpty() *CatalogIntegrationOpenCatalogResourceAssert {
+	c.ValuePresent("tags_all")
+	return c
+}
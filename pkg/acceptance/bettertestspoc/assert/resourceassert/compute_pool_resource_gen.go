@@ -87,6 +87,10 @@ func (c *ComputePoolResourceAssert) HasMinNodes(expected int) *ComputePoolResour
 	return c
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -141,6 +145,16 @@ func (c *ComputePoolResourceAssert) HasMinNodesString(expected string) *ComputeP
 	return c
 }
 
+func (c *ComputePoolResourceAssert) HasTagsString(expected string) *ComputePoolResourceAssert {
+	c.ValueSet("tags", expected)
+	return c
+}
+
+func (c *ComputePoolResourceAssert) HasTagsAllString(expected string) *ComputePoolResourceAssert {
+	c.ValueSet("tags_all", expected)
+	return c
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -195,6 +209,16 @@ func (c *ComputePoolResourceAssert) HasNoMinNodes() *ComputePoolResourceAssert {
 	return c
 }
 
+func (c *ComputePoolResourceAssert) HasNoTags() *ComputePoolResourceAssert {
+	c.ValueNotSet("tags")
+	return c
+}
+
+func (c *ComputePoolResourceAssert) HasNoTagsAll() *ComputePoolResourceAssert {
+	c.ValueNotSet("tags_all")
+	return c
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -234,6 +258,16 @@ func (c *ComputePoolResourceAssert) HasInitiallySuspendedEmpty() *ComputePoolRes
 	return c
 }
 
+func (c *ComputePoolResourceAssert) HasTagsEmpty() *ComputePoolResourceAssert {
+	c.ValueSet("tags", "")
+	return c
+}
+
+func (c *ComputePoolResourceAssert) HasTagsAllEmpty() *ComputePoolResourceAssert {
+	c.ValueSet("tags_all", "")
+	return c
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -287,3 +321,13 @@ func (c *ComputePoolResourceAssert) HasMinNodesNotEmpty() *ComputePoolResourceAs
 	c.ValuePresent("min_nodes")
 	return c
 }
+
+func (c *ComputePoolResourceAssert) HasTagsNotEmpty() *ComputePoolResourceAssert {
+	c.ValuePresent("tags")
+	return c
+}
+
+func (c *ComputePoolResourceAssert) HasTagsAllNotEmpty() *ComputePoolResourceAssert {
+	c.ValuePresent("tags_all")
+	return c
+}
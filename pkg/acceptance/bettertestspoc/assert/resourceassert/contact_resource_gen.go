@@ -0,0 +1,219 @@
+// Code generated by resource assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type ContactResourceAssert struct {
+	*assert.ResourceAssert
+}
+
+func ContactResource(t *testing.T, name string) *ContactResourceAssert {
+	t.Helper()
+
+	return &ContactResourceAssert{
+		ResourceAssert: assert.NewResourceAssert(name),
+	}
+}
+
+func ImportedContactResource(t *testing.T, id string) *ContactResourceAssert {
+	t.Helper()
+
+	return &ContactResourceAssert{
+		ResourceAssert: assert.NewImportedResourceAssert(id),
+	}
+}
+
+//////////////////////////////////
+// Attribute typed value checks //
+//////////////////////////////////
+
+func (c *ContactResourceAssert) HasDatabase(expected string) *ContactResourceAssert {
+	c.StringValueSet("database", expected)
+	return c
+}
+
+func (c *ContactResourceAssert) HasSchema(expected string) *ContactResourceAssert {
+	c.StringValueSet("schema", expected)
+	return c
+}
+
+func (c *ContactResourceAssert) HasName(expected string) *ContactResourceAssert {
+	c.StringValueSet("name", expected)
+	return c
+}
+
+func (c *ContactResourceAssert) HasComment(expected string) *ContactResourceAssert {
+	c.StringValueSet("comment", expected)
+	return c
+}
+
+func (c *ContactResourceAssert) HasEmailDistributionList(expected string) *ContactResourceAssert {
+	c.StringValueSet("email_distribution_list", expected)
+	return c
+}
+
+func (c *ContactResourceAssert) HasFullyQualifiedName(expected string) *ContactResourceAssert {
+	c.StringValueSet("fully_qualified_name", expected)
+	return c
+}
+
+func (c *ContactResourceAssert) HasUrl(expected string) *ContactResourceAssert {
+	c.StringValueSet("url", expected)
+	return c
+}
+
+func (c *ContactResourceAssert) HasUsers(expected ...string) *ContactResourceAssert {
+	c.SetContainsExactlyStringValues("users", expected...)
+	return c
+}
+
+///////////////////////////////////
+// Attribute value string checks //
+///////////////////////////////////
+
+func (c *ContactResourceAssert) HasDatabaseString(expected string) *ContactResourceAssert {
+	c.ValueSet("database", expected)
+	return c
+}
+
+func (c *ContactResourceAssert) HasSchemaString(expected string) *ContactResourceAssert {
+	c.ValueSet("schema", expected)
+	return c
+}
+
+func (c *ContactResourceAssert) HasNameString(expected string) *ContactResourceAssert {
+	c.ValueSet("name", expected)
+	return c
+}
+
+func (c *ContactResourceAssert) HasCommentString(expected string) *ContactResourceAssert {
+	c.ValueSet("comment", expected)
+	return c
+}
+
+func (c *ContactResourceAssert) HasEmailDistributionListString(expected string) *ContactResourceAssert {
+	c.ValueSet("email_distribution_list", expected)
+	return c
+}
+
+func (c *ContactResourceAssert) HasFullyQualifiedNameString(expected string) *ContactResourceAssert {
+	c.ValueSet("fully_qualified_name", expected)
+	return c
+}
+
+func (c *ContactResourceAssert) HasUrlString(expected string) *ContactResourceAssert {
+	c.ValueSet("url", expected)
+	return c
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (c *ContactResourceAssert) HasNoDatabase() *ContactResourceAssert {
+	c.ValueNotSet("database")
+	return c
+}
+
+func (c *ContactResourceAssert) HasNoSchema() *ContactResourceAssert {
+	c.ValueNotSet("schema")
+	return c
+}
+
+func (c *ContactResourceAssert) HasNoName() *ContactResourceAssert {
+	c.ValueNotSet("name")
+	return c
+}
+
+func (c *ContactResourceAssert) HasNoComment() *ContactResourceAssert {
+	c.ValueNotSet("comment")
+	return c
+}
+
+func (c *ContactResourceAssert) HasNoEmailDistributionList() *ContactResourceAssert {
+	c.ValueNotSet("email_distribution_list")
+	return c
+}
+
+func (c *ContactResourceAssert) HasNoFullyQualifiedName() *ContactResourceAssert {
+	c.ValueNotSet("fully_qualified_name")
+	return c
+}
+
+func (c *ContactResourceAssert) HasNoUrl() *ContactResourceAssert {
+	c.ValueNotSet("url")
+	return c
+}
+
+////////////////////////////
+// Attribute empty checks //
+////////////////////////////
+
+func (c *ContactResourceAssert) HasCommentEmpty() *ContactResourceAssert {
+	c.ValueSet("comment", "")
+	return c
+}
+
+func (c *ContactResourceAssert) HasEmailDistributionListEmpty() *ContactResourceAssert {
+	c.ValueSet("email_distribution_list", "")
+	return c
+}
+
+func (c *ContactResourceAssert) HasFullyQualifiedNameEmpty() *ContactResourceAssert {
+	c.ValueSet("fully_qualified_name", "")
+	return c
+}
+
+func (c *ContactResourceAssert) HasUrlEmpty() *ContactResourceAssert {
+	c.ValueSet("url", "")
+	return c
+}
+
+func (c *ContactResourceAssert) HasUsersEmpty() *ContactResourceAssert {
+	c.ValueSet("users.#", "0")
+	return c
+}
+
+///////////////////////////////
+// Attribute presence checks //
+///////////////////////////////
+
+func (c *ContactResourceAssert) HasDatabaseNotEmpty() *ContactResourceAssert {
+	c.ValuePresent("database")
+	return c
+}
+
+func (c *ContactResourceAssert) HasSchemaNotEmpty() *ContactResourceAssert {
+	c.ValuePresent("schema")
+	return c
+}
+
+func (c *ContactResourceAssert) HasNameNotEmpty() *ContactResourceAssert {
+	c.ValuePresent("name")
+	return c
+}
+
+func (c *ContactResourceAssert) HasCommentNotEmpty() *ContactResourceAssert {
+	c.ValuePresent("comment")
+	return c
+}
+
+func (c *ContactResourceAssert) HasEmailDistributionListNotEmpty() *ContactResourceAssert {
+	c.ValuePresent("email_distribution_list")
+	return c
+}
+
+func (c *ContactResourceAssert) HasFullyQualifiedNameNotEmpty() *ContactResourceAssert {
+	c.ValuePresent("fully_qualified_name")
+	return c
+}
+
+func (c *ContactResourceAssert) HasUrlNotEmpty() *ContactResourceAssert {
+	c.ValuePresent("url")
+	return c
+}
@@ -77,6 +77,11 @@ func (d *DatabaseResourceAssert) HasEnableConsoleOutput(expected bool) *Database
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasEventTable(expected string) *DatabaseResourceAssert {
+	d.StringValueSet("event_table", expected)
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasExternalVolume(expected string) *DatabaseResourceAssert {
 	d.StringValueSet("external_volume", expected)
 	return d
@@ -129,6 +134,10 @@ func (d *DatabaseResourceAssert) HasSuspendTaskAfterNumFailures(expected int) *D
 	return d
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (d *DatabaseResourceAssert) HasTaskAutoRetryAttempts(expected int) *DatabaseResourceAssert {
 	d.IntValueSet("task_auto_retry_attempts", expected)
 	return d
@@ -203,6 +212,11 @@ func (d *DatabaseResourceAssert) HasEnableConsoleOutputString(expected string) *
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasEventTableString(expected string) *DatabaseResourceAssert {
+	d.ValueSet("event_table", expected)
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasExternalVolumeString(expected string) *DatabaseResourceAssert {
 	d.ValueSet("external_volume", expected)
 	return d
@@ -253,6 +267,16 @@ func (d *DatabaseResourceAssert) HasSuspendTaskAfterNumFailuresString(expected s
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasTagsString(expected string) *DatabaseResourceAssert {
+	d.ValueSet("tags", expected)
+	return d
+}
+
+func (d *DatabaseResourceAssert) HasTagsAllString(expected string) *DatabaseResourceAssert {
+	d.ValueSet("tags_all", expected)
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasTaskAutoRetryAttemptsString(expected string) *DatabaseResourceAssert {
 	d.ValueSet("task_auto_retry_attempts", expected)
 	return d
@@ -327,6 +351,11 @@ func (d *DatabaseResourceAssert) HasNoEnableConsoleOutput() *DatabaseResourceAss
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasNoEventTable() *DatabaseResourceAssert {
+	d.ValueNotSet("event_table")
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasNoExternalVolume() *DatabaseResourceAssert {
 	d.ValueNotSet("external_volume")
 	return d
@@ -377,6 +406,16 @@ func (d *DatabaseResourceAssert) HasNoSuspendTaskAfterNumFailures() *DatabaseRes
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasNoTags() *DatabaseResourceAssert {
+	d.ValueNotSet("tags")
+	return d
+}
+
+func (d *DatabaseResourceAssert) HasNoTagsAll() *DatabaseResourceAssert {
+	d.ValueNotSet("tags_all")
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasNoTaskAutoRetryAttempts() *DatabaseResourceAssert {
 	d.ValueNotSet("task_auto_retry_attempts")
 	return d
@@ -446,6 +485,11 @@ func (d *DatabaseResourceAssert) HasEnableConsoleOutputEmpty() *DatabaseResource
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasEventTableEmpty() *DatabaseResourceAssert {
+	d.ValueSet("event_table", "")
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasExternalVolumeEmpty() *DatabaseResourceAssert {
 	d.ValueSet("external_volume", "")
 	return d
@@ -501,6 +545,16 @@ func (d *DatabaseResourceAssert) HasSuspendTaskAfterNumFailuresEmpty() *Database
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasTagsEmpty() *DatabaseResourceAssert {
+	d.ValueSet("tags", "")
+	return d
+}
+
+func (d *DatabaseResourceAssert) HasTagsAllEmpty() *DatabaseResourceAssert {
+	d.ValueSet("tags_all", "")
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasTaskAutoRetryAttemptsEmpty() *DatabaseResourceAssert {
 	d.ValueSet("task_auto_retry_attempts", "")
 	return d
@@ -575,6 +629,11 @@ func (d *DatabaseResourceAssert) HasEnableConsoleOutputNotEmpty() *DatabaseResou
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasEventTableNotEmpty() *DatabaseResourceAssert {
+	d.ValuePresent("event_table")
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasExternalVolumeNotEmpty() *DatabaseResourceAssert {
 	d.ValuePresent("external_volume")
 	return d
@@ -625,6 +684,16 @@ func (d *DatabaseResourceAssert) HasSuspendTaskAfterNumFailuresNotEmpty() *Datab
 	return d
 }
 
+func (d *DatabaseResourceAssert) HasTagsNotEmpty() *DatabaseResourceAssert {
+	d.ValuePresent("tags")
+	return d
+}
+
+func (d *DatabaseResourceAssert) HasTagsAllNotEmpty() *DatabaseResourceAssert {
+	d.ValuePresent("tags_all")
+	return d
+}
+
 func (d *DatabaseResourceAssert) HasTaskAutoRetryAttemptsNotEmpty() *DatabaseResourceAssert {
 	d.ValuePresent("task_auto_retry_attempts")
 	return d
@@ -52,6 +52,10 @@ func (d *DatabaseRoleResourceAssert) HasFullyQualifiedName(expected string) *Dat
 	return d
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -76,6 +80,16 @@ func (d *DatabaseRoleResourceAssert) HasFullyQualifiedNameString(expected string
 	return d
 }
 
+func (d *DatabaseRoleResourceAssert) HasTagsString(expected string) *DatabaseRoleResourceAssert {
+	d.ValueSet("tags", expected)
+	return d
+}
+
+func (d *DatabaseRoleResourceAssert) HasTagsAllString(expected string) *DatabaseRoleResourceAssert {
+	d.ValueSet("tags_all", expected)
+	return d
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -100,6 +114,16 @@ func (d *DatabaseRoleResourceAssert) HasNoFullyQualifiedName() *DatabaseRoleReso
 	return d
 }
 
+func (d *DatabaseRoleResourceAssert) HasNoTags() *DatabaseRoleResourceAssert {
+	d.ValueNotSet("tags")
+	return d
+}
+
+func (d *DatabaseRoleResourceAssert) HasNoTagsAll() *DatabaseRoleResourceAssert {
+	d.ValueNotSet("tags_all")
+	return d
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -114,6 +138,16 @@ func (d *DatabaseRoleResourceAssert) HasFullyQualifiedNameEmpty() *DatabaseRoleR
 	return d
 }
 
+func (d *DatabaseRoleResourceAssert) HasTagsEmpty() *DatabaseRoleResourceAssert {
+	d.ValueSet("tags", "")
+	return d
+}
+
+func (d *DatabaseRoleResourceAssert) HasTagsAllEmpty() *DatabaseRoleResourceAssert {
+	d.ValueSet("tags_all", "")
+	return d
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -137,3 +171,13 @@ func (d *DatabaseRoleResourceAssert) HasFullyQualifiedNameNotEmpty() *DatabaseRo
 	d.ValuePresent("fully_qualified_name")
 	return d
 }
+
+func (d *DatabaseRoleResourceAssert) HasTagsNotEmpty() *DatabaseRoleResourceAssert {
+	d.ValuePresent("tags")
+	return d
+}
+
+func (d *DatabaseRoleResourceAssert) HasTagsAllNotEmpty() *DatabaseRoleResourceAssert {
+	d.ValuePresent("tags_all")
+	return d
+}
@@ -137,6 +137,10 @@ func (d *DynamicTableResourceAssert) HasSchedulingState(expected string) *Dynami
 	return d
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 // typed assert for "target_lag" (type: List, subtype: Map) is not currently supported
 
 func (d *DynamicTableResourceAssert) HasWarehouse(expected string) *DynamicTableResourceAssert {
@@ -253,6 +257,16 @@ func (d *DynamicTableResourceAssert) HasSchedulingStateString(expected string) *
 	return d
 }
 
+func (d *DynamicTableResourceAssert) HasTagsString(expected string) *DynamicTableResourceAssert {
+	d.ValueSet("tags", expected)
+	return d
+}
+
+func (d *DynamicTableResourceAssert) HasTagsAllString(expected string) *DynamicTableResourceAssert {
+	d.ValueSet("tags_all", expected)
+	return d
+}
+
 func (d *DynamicTableResourceAssert) HasWarehouseString(expected string) *DynamicTableResourceAssert {
 	d.ValueSet("warehouse", expected)
 	return d
@@ -367,6 +381,16 @@ func (d *DynamicTableResourceAssert) HasNoSchedulingState() *DynamicTableResourc
 	return d
 }
 
+func (d *DynamicTableResourceAssert) HasNoTags() *DynamicTableResourceAssert {
+	d.ValueNotSet("tags")
+	return d
+}
+
+func (d *DynamicTableResourceAssert) HasNoTagsAll() *DynamicTableResourceAssert {
+	d.ValueNotSet("tags_all")
+	return d
+}
+
 func (d *DynamicTableResourceAssert) HasNoWarehouse() *DynamicTableResourceAssert {
 	d.ValueNotSet("warehouse")
 	return d
@@ -461,6 +485,16 @@ func (d *DynamicTableResourceAssert) HasSchedulingStateEmpty() *DynamicTableReso
 	return d
 }
 
+func (d *DynamicTableResourceAssert) HasTagsEmpty() *DynamicTableResourceAssert {
+	d.ValueSet("tags", "")
+	return d
+}
+
+func (d *DynamicTableResourceAssert) HasTagsAllEmpty() *DynamicTableResourceAssert {
+	d.ValueSet("tags_all", "")
+	return d
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -570,6 +604,16 @@ func (d *DynamicTableResourceAssert) HasSchedulingStateNotEmpty() *DynamicTableR
 	return d
 }
 
+func (d *DynamicTableResourceAssert) HasTagsNotEmpty() *DynamicTableResourceAssert {
+	d.ValuePresent("tags")
+	return d
+}
+
+func (d *DynamicTableResourceAssert) HasTagsAllNotEmpty() *DynamicTableResourceAssert {
+	d.ValuePresent("tags_all")
+	return d
+}
+
 func (d *DynamicTableResourceAssert) HasWarehouseNotEmpty() *DynamicTableResourceAssert {
 	d.ValuePresent("warehouse")
 	return d
@@ -0,0 +1,313 @@
+// Code generated by resource assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type EventTableResourceAssert struct {
+	*assert.ResourceAssert
+}
+
+func EventTableResource(t *testing.T, name string) *EventTableResourceAssert {
+	t.Helper()
+
+	return &EventTableResourceAssert{
+		ResourceAssert: assert.NewResourceAssert(name),
+	}
+}
+
+func ImportedEventTableResource(t *testing.T, id string) *EventTableResourceAssert {
+	t.Helper()
+
+	return &EventTableResourceAssert{
+		ResourceAssert: assert.NewImportedResourceAssert(id),
+	}
+}
+
+//////////////////////////////////
+// Attribute typed value checks //
+//////////////////////////////////
+
+func (e *EventTableResourceAssert) HasDatabase(expected string) *EventTableResourceAssert {
+	e.StringValueSet("database", expected)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasSchema(expected string) *EventTableResourceAssert {
+	e.StringValueSet("schema", expected)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasName(expected string) *EventTableResourceAssert {
+	e.StringValueSet("name", expected)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasChangeTracking(expected string) *EventTableResourceAssert {
+	e.StringValueSet("change_tracking", expected)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasClusterBy(expected ...string) *EventTableResourceAssert {
+	e.ListContainsExactlyStringValuesInOrder("cluster_by", expected...)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasComment(expected string) *EventTableResourceAssert {
+	e.StringValueSet("comment", expected)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasDataRetentionTimeInDays(expected int) *EventTableResourceAssert {
+	e.IntValueSet("data_retention_time_in_days", expected)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasDefaultDdlCollation(expected string) *EventTableResourceAssert {
+	e.StringValueSet("default_ddl_collation", expected)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasFullyQualifiedName(expected string) *EventTableResourceAssert {
+	e.StringValueSet("fully_qualified_name", expected)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasMaxDataExtensionTimeInDays(expected int) *EventTableResourceAssert {
+	e.IntValueSet("max_data_extension_time_in_days", expected)
+	return e
+}
+
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
+///////////////////////////////////
+// Attribute value string checks //
+///////////////////////////////////
+
+func (e *EventTableResourceAssert) HasDatabaseString(expected string) *EventTableResourceAssert {
+	e.ValueSet("database", expected)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasSchemaString(expected string) *EventTableResourceAssert {
+	e.ValueSet("schema", expected)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasNameString(expected string) *EventTableResourceAssert {
+	e.ValueSet("name", expected)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasChangeTrackingString(expected string) *EventTableResourceAssert {
+	e.ValueSet("change_tracking", expected)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasCommentString(expected string) *EventTableResourceAssert {
+	e.ValueSet("comment", expected)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasDataRetentionTimeInDaysString(expected string) *EventTableResourceAssert {
+	e.ValueSet("data_retention_time_in_days", expected)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasDefaultDdlCollationString(expected string) *EventTableResourceAssert {
+	e.ValueSet("default_ddl_collation", expected)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasFullyQualifiedNameString(expected string) *EventTableResourceAssert {
+	e.ValueSet("fully_qualified_name", expected)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasMaxDataExtensionTimeInDaysString(expected string) *EventTableResourceAssert {
+	e.ValueSet("max_data_extension_time_in_days", expected)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasTagsString(expected string) *EventTableResourceAssert {
+	e.ValueSet("tags", expected)
+	return e
+}
+
+func (e *EventTableResourceAssert) HasTagsAllString(expected string) *EventTableResourceAssert {
+	e.ValueSet("tags_all", expected)
+	return e
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (e *EventTableResourceAssert) HasNoDatabase() *EventTableResourceAssert {
+	e.ValueNotSet("database")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasNoSchema() *EventTableResourceAssert {
+	e.ValueNotSet("schema")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasNoName() *EventTableResourceAssert {
+	e.ValueNotSet("name")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasNoChangeTracking() *EventTableResourceAssert {
+	e.ValueNotSet("change_tracking")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasNoComment() *EventTableResourceAssert {
+	e.ValueNotSet("comment")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasNoDataRetentionTimeInDays() *EventTableResourceAssert {
+	e.ValueNotSet("data_retention_time_in_days")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasNoDefaultDdlCollation() *EventTableResourceAssert {
+	e.ValueNotSet("default_ddl_collation")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasNoFullyQualifiedName() *EventTableResourceAssert {
+	e.ValueNotSet("fully_qualified_name")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasNoMaxDataExtensionTimeInDays() *EventTableResourceAssert {
+	e.ValueNotSet("max_data_extension_time_in_days")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasNoTags() *EventTableResourceAssert {
+	e.ValueNotSet("tags")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasNoTagsAll() *EventTableResourceAssert {
+	e.ValueNotSet("tags_all")
+	return e
+}
+
+////////////////////////////
+// Attribute empty checks //
+////////////////////////////
+
+func (e *EventTableResourceAssert) HasChangeTrackingEmpty() *EventTableResourceAssert {
+	e.ValueSet("change_tracking", "")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasClusterByEmpty() *EventTableResourceAssert {
+	e.ValueSet("cluster_by.#", "0")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasCommentEmpty() *EventTableResourceAssert {
+	e.ValueSet("comment", "")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasDataRetentionTimeInDaysEmpty() *EventTableResourceAssert {
+	e.ValueSet("data_retention_time_in_days", "")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasDefaultDdlCollationEmpty() *EventTableResourceAssert {
+	e.ValueSet("default_ddl_collation", "")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasFullyQualifiedNameEmpty() *EventTableResourceAssert {
+	e.ValueSet("fully_qualified_name", "")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasMaxDataExtensionTimeInDaysEmpty() *EventTableResourceAssert {
+	e.ValueSet("max_data_extension_time_in_days", "")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasTagsEmpty() *EventTableResourceAssert {
+	e.ValueSet("tags", "")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasTagsAllEmpty() *EventTableResourceAssert {
+	e.ValueSet("tags_all", "")
+	return e
+}
+
+///////////////////////////////
+// Attribute presence checks //
+///////////////////////////////
+
+func (e *EventTableResourceAssert) HasDatabaseNotEmpty() *EventTableResourceAssert {
+	e.ValuePresent("database")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasSchemaNotEmpty() *EventTableResourceAssert {
+	e.ValuePresent("schema")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasNameNotEmpty() *EventTableResourceAssert {
+	e.ValuePresent("name")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasChangeTrackingNotEmpty() *EventTableResourceAssert {
+	e.ValuePresent("change_tracking")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasCommentNotEmpty() *EventTableResourceAssert {
+	e.ValuePresent("comment")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasDataRetentionTimeInDaysNotEmpty() *EventTableResourceAssert {
+	e.ValuePresent("data_retention_time_in_days")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasDefaultDdlCollationNotEmpty() *EventTableResourceAssert {
+	e.ValuePresent("default_ddl_collation")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasFullyQualifiedNameNotEmpty() *EventTableResourceAssert {
+	e.ValuePresent("fully_qualified_name")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasMaxDataExtensionTimeInDaysNotEmpty() *EventTableResourceAssert {
+	e.ValuePresent("max_data_extension_time_in_days")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasTagsNotEmpty() *EventTableResourceAssert {
+	e.ValuePresent("tags")
+	return e
+}
+
+func (e *EventTableResourceAssert) HasTagsAllNotEmpty() *EventTableResourceAssert {
+	e.ValuePresent("tags_all")
+	return e
+}
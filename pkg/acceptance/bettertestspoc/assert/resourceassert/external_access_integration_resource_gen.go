@@ -61,6 +61,10 @@ func (e *ExternalAccessIntegrationResourceAssert) HasFullyQualifiedName(expected
 	return e
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -85,6 +89,16 @@ func (e *ExternalAccessIntegrationResourceAssert) HasFullyQualifiedNameString(ex
 	return e
 }
 
+func (e *ExternalAccessIntegrationResourceAssert) HasTagsString(expected string) *ExternalAccessIntegrationResourceAssert {
+	e.ValueSet("tags", expected)
+	return e
+}
+
+func (e *ExternalAccessIntegrationResourceAssert) HasTagsAllString(expected string) *ExternalAccessIntegrationResourceAssert {
+	e.ValueSet("tags_all", expected)
+	return e
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -109,6 +123,16 @@ func (e *ExternalAccessIntegrationResourceAssert) HasNoFullyQualifiedName() *Ext
 	return e
 }
 
+func (e *ExternalAccessIntegrationResourceAssert) HasNoTags() *ExternalAccessIntegrationResourceAssert {
+	e.ValueNotSet("tags")
+	return e
+}
+
+func (e *ExternalAccessIntegrationResourceAssert) HasNoTagsAll() *ExternalAccessIntegrationResourceAssert {
+	e.ValueNotSet("tags_all")
+	return e
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -133,6 +157,16 @@ func (e *ExternalAccessIntegrationResourceAssert) HasFullyQualifiedNameEmpty() *
 	return e
 }
 
+func (e *ExternalAccessIntegrationResourceAssert) HasTagsEmpty() *ExternalAccessIntegrationResourceAssert {
+	e.ValueSet("tags", "")
+	return e
+}
+
+func (e *ExternalAccessIntegrationResourceAssert) HasTagsAllEmpty() *ExternalAccessIntegrationResourceAssert {
+	e.ValueSet("tags_all", "")
+	return e
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -156,3 +190,13 @@ func (e *ExternalAccessIntegrationResourceAssert) HasFullyQualifiedNameNotEmpty(
 	e.ValuePresent("fully_qualified_name")
 	return e
 }
+
+func (e *ExternalAccessIntegrationResourceAssert) HasTagsNotEmpty() *ExternalAccessIntegrationResourceAssert {
+	e.ValuePresent("tags")
+	return e
+}
+
+func (e *ExternalAccessIntegrationResourceAssert) HasTagsAllNotEmpty() *ExternalAccessIntegrationResourceAssert {
+	e.ValuePresent("tags_all")
+	return e
+}
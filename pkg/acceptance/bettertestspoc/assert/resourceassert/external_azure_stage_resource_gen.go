@@ -80,6 +80,10 @@ func (e *ExternalAzureStageResourceAssert) HasStorageIntegration(expected string
 	return e
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (e *ExternalAzureStageResourceAssert) HasUrl(expected string) *ExternalAzureStageResourceAssert {
 	e.StringValueSet("url", expected)
 	return e
@@ -134,6 +138,16 @@ func (e *ExternalAzureStageResourceAssert) HasStorageIntegrationString(expected
 	return e
 }
 
+func (e *ExternalAzureStageResourceAssert) HasTagsString(expected string) *ExternalAzureStageResourceAssert {
+	e.ValueSet("tags", expected)
+	return e
+}
+
+func (e *ExternalAzureStageResourceAssert) HasTagsAllString(expected string) *ExternalAzureStageResourceAssert {
+	e.ValueSet("tags_all", expected)
+	return e
+}
+
 func (e *ExternalAzureStageResourceAssert) HasUrlString(expected string) *ExternalAzureStageResourceAssert {
 	e.ValueSet("url", expected)
 	return e
@@ -188,6 +202,16 @@ func (e *ExternalAzureStageResourceAssert) HasNoStorageIntegration() *ExternalAz
 	return e
 }
 
+func (e *ExternalAzureStageResourceAssert) HasNoTags() *ExternalAzureStageResourceAssert {
+	e.ValueNotSet("tags")
+	return e
+}
+
+func (e *ExternalAzureStageResourceAssert) HasNoTagsAll() *ExternalAzureStageResourceAssert {
+	e.ValueNotSet("tags_all")
+	return e
+}
+
 func (e *ExternalAzureStageResourceAssert) HasNoUrl() *ExternalAzureStageResourceAssert {
 	e.ValueNotSet("url")
 	return e
@@ -247,6 +271,16 @@ func (e *ExternalAzureStageResourceAssert) HasStorageIntegrationEmpty() *Externa
 	return e
 }
 
+func (e *ExternalAzureStageResourceAssert) HasTagsEmpty() *ExternalAzureStageResourceAssert {
+	e.ValueSet("tags", "")
+	return e
+}
+
+func (e *ExternalAzureStageResourceAssert) HasTagsAllEmpty() *ExternalAzureStageResourceAssert {
+	e.ValueSet("tags_all", "")
+	return e
+}
+
 func (e *ExternalAzureStageResourceAssert) HasUsePrivatelinkEndpointEmpty() *ExternalAzureStageResourceAssert {
 	e.ValueSet("use_privatelink_endpoint", "")
 	return e
@@ -296,6 +330,16 @@ func (e *ExternalAzureStageResourceAssert) HasStorageIntegrationNotEmpty() *Exte
 	return e
 }
 
+func (e *ExternalAzureStageResourceAssert) HasTagsNotEmpty() *ExternalAzureStageResourceAssert {
+	e.ValuePresent("tags")
+	return e
+}
+
+func (e *ExternalAzureStageResourceAssert) HasTagsAllNotEmpty() *ExternalAzureStageResourceAssert {
+	e.ValuePresent("tags_all")
+	return e
+}
+
 func (e *ExternalAzureStageResourceAssert) HasUrlNotEmpty() *ExternalAzureStageResourceAssert {
 	e.ValuePresent("url")
 	return e
@@ -78,6 +78,10 @@ func (e *ExternalGcsStageResourceAssert) HasStorageIntegration(expected string)
 	return e
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (e *ExternalGcsStageResourceAssert) HasUrl(expected string) *ExternalGcsStageResourceAssert {
 	e.StringValueSet("url", expected)
 	return e
@@ -127,6 +131,16 @@ func (e *ExternalGcsStageResourceAssert) HasStorageIntegrationString(expected st
 	return e
 }
 
+func (e *ExternalGcsStageResourceAssert) HasTagsString(expected string) *ExternalGcsStageResourceAssert {
+	e.ValueSet("tags", expected)
+	return e
+}
+
+func (e *ExternalGcsStageResourceAssert) HasTagsAllString(expected string) *ExternalGcsStageResourceAssert {
+	e.ValueSet("tags_all", expected)
+	return e
+}
+
 func (e *ExternalGcsStageResourceAssert) HasUrlString(expected string) *ExternalGcsStageResourceAssert {
 	e.ValueSet("url", expected)
 	return e
@@ -176,6 +190,16 @@ func (e *ExternalGcsStageResourceAssert) HasNoStorageIntegration() *ExternalGcsS
 	return e
 }
 
+func (e *ExternalGcsStageResourceAssert) HasNoTags() *ExternalGcsStageResourceAssert {
+	e.ValueNotSet("tags")
+	return e
+}
+
+func (e *ExternalGcsStageResourceAssert) HasNoTagsAll() *ExternalGcsStageResourceAssert {
+	e.ValueNotSet("tags_all")
+	return e
+}
+
 func (e *ExternalGcsStageResourceAssert) HasNoUrl() *ExternalGcsStageResourceAssert {
 	e.ValueNotSet("url")
 	return e
@@ -220,6 +244,16 @@ func (e *ExternalGcsStageResourceAssert) HasStageTypeEmpty() *ExternalGcsStageRe
 	return e
 }
 
+func (e *ExternalGcsStageResourceAssert) HasTagsEmpty() *ExternalGcsStageResourceAssert {
+	e.ValueSet("tags", "")
+	return e
+}
+
+func (e *ExternalGcsStageResourceAssert) HasTagsAllEmpty() *ExternalGcsStageResourceAssert {
+	e.ValueSet("tags_all", "")
+	return e
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -264,6 +298,16 @@ func (e *ExternalGcsStageResourceAssert) HasStorageIntegrationNotEmpty() *Extern
 	return e
 }
 
+func (e *ExternalGcsStageResourceAssert) HasTagsNotEmpty() *ExternalGcsStageResourceAssert {
+	e.ValuePresent("tags")
+	return e
+}
+
+func (e *ExternalGcsStageResourceAssert) HasTagsAllNotEmpty() *ExternalGcsStageResourceAssert {
+	e.ValuePresent("tags_all")
+	return e
+}
+
 func (e *ExternalGcsStageResourceAssert) HasUrlNotEmpty() *ExternalGcsStageResourceAssert {
 	e.ValuePresent("url")
 	return e
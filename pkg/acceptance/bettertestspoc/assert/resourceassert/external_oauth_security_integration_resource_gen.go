@@ -119,6 +119,10 @@ func (e *ExternalOauthSecurityIntegrationResourceAssert) HasFullyQualifiedName(e
 
 // typed assert for "related_parameters" (type: List, subtype: Map) is not currently supported
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -183,6 +187,16 @@ func (e *ExternalOauthSecurityIntegrationResourceAssert) HasFullyQualifiedNameSt
 	return e
 }
 
+func (e *ExternalOauthSecurityIntegrationResourceAssert) HasTagsString(expected string) *ExternalOauthSecurityIntegrationResourceAssert {
+	e.ValueSet("tags", expected)
+	return e
+}
+
+func (e *ExternalOauthSecurityIntegrationResourceAssert) HasTagsAllString(expected string) *ExternalOauthSecurityIntegrationResourceAssert {
+	e.ValueSet("tags_all", expected)
+	return e
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -247,6 +261,16 @@ func (e *ExternalOauthSecurityIntegrationResourceAssert) HasNoFullyQualifiedName
 	return e
 }
 
+func (e *ExternalOauthSecurityIntegrationResourceAssert) HasNoTags() *ExternalOauthSecurityIntegrationResourceAssert {
+	e.ValueNotSet("tags")
+	return e
+}
+
+func (e *ExternalOauthSecurityIntegrationResourceAssert) HasNoTagsAll() *ExternalOauthSecurityIntegrationResourceAssert {
+	e.ValueNotSet("tags_all")
+	return e
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -311,6 +335,16 @@ func (e *ExternalOauthSecurityIntegrationResourceAssert) HasRelatedParametersEmp
 	return e
 }
 
+func (e *ExternalOauthSecurityIntegrationResourceAssert) HasTagsEmpty() *ExternalOauthSecurityIntegrationResourceAssert {
+	e.ValueSet("tags", "")
+	return e
+}
+
+func (e *ExternalOauthSecurityIntegrationResourceAssert) HasTagsAllEmpty() *ExternalOauthSecurityIntegrationResourceAssert {
+	e.ValueSet("tags_all", "")
+	return e
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -374,3 +408,13 @@ func (e *ExternalOauthSecurityIntegrationResourceAssert) HasFullyQualifiedNameNo
 	e.ValuePresent("fully_qualified_name")
 	return e
 }
+
+func (e *ExternalOauthSecurityIntegrationResourceAssert) HasTagsNotEmpty() *ExternalOauthSecurityIntegrationResourceAssert {
+	e.ValuePresent("tags")
+	return e
+}
+
+func (e *ExternalOauthSecurityIntegrationResourceAssert) HasTagsAllNotEmpty() *ExternalOauthSecurityIntegrationResourceAssert {
+	e.ValuePresent("tags_all")
+	return e
+}
@@ -78,6 +78,10 @@ func (e *ExternalS3CompatibleStageResourceAssert) HasStageType(expected string)
 	return e
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (e *ExternalS3CompatibleStageResourceAssert) HasUrl(expected string) *ExternalS3CompatibleStageResourceAssert {
 	e.StringValueSet("url", expected)
 	return e
@@ -127,6 +131,16 @@ func (e *ExternalS3CompatibleStageResourceAssert) HasStageTypeString(expected st
 	return e
 }
 
+func (e *ExternalS3CompatibleStageResourceAssert) HasTagsString(expected string) *ExternalS3CompatibleStageResourceAssert {
+	e.ValueSet("tags", expected)
+	return e
+}
+
+func (e *ExternalS3CompatibleStageResourceAssert) HasTagsAllString(expected string) *ExternalS3CompatibleStageResourceAssert {
+	e.ValueSet("tags_all", expected)
+	return e
+}
+
 func (e *ExternalS3CompatibleStageResourceAssert) HasUrlString(expected string) *ExternalS3CompatibleStageResourceAssert {
 	e.ValueSet("url", expected)
 	return e
@@ -176,6 +190,16 @@ func (e *ExternalS3CompatibleStageResourceAssert) HasNoStageType() *ExternalS3Co
 	return e
 }
 
+func (e *ExternalS3CompatibleStageResourceAssert) HasNoTags() *ExternalS3CompatibleStageResourceAssert {
+	e.ValueNotSet("tags")
+	return e
+}
+
+func (e *ExternalS3CompatibleStageResourceAssert) HasNoTagsAll() *ExternalS3CompatibleStageResourceAssert {
+	e.ValueNotSet("tags_all")
+	return e
+}
+
 func (e *ExternalS3CompatibleStageResourceAssert) HasNoUrl() *ExternalS3CompatibleStageResourceAssert {
 	e.ValueNotSet("url")
 	return e
@@ -220,6 +244,16 @@ func (e *ExternalS3CompatibleStageResourceAssert) HasStageTypeEmpty() *ExternalS
 	return e
 }
 
+func (e *ExternalS3CompatibleStageResourceAssert) HasTagsEmpty() *ExternalS3CompatibleStageResourceAssert {
+	e.ValueSet("tags", "")
+	return e
+}
+
+func (e *ExternalS3CompatibleStageResourceAssert) HasTagsAllEmpty() *ExternalS3CompatibleStageResourceAssert {
+	e.ValueSet("tags_all", "")
+	return e
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -264,6 +298,16 @@ func (e *ExternalS3CompatibleStageResourceAssert) HasStageTypeNotEmpty() *Extern
 	return e
 }
 
+func (e *ExternalS3CompatibleStageResourceAssert) HasTagsNotEmpty() *ExternalS3CompatibleStageResourceAssert {
+	e.ValuePresent("tags")
+	return e
+}
+
+func (e *ExternalS3CompatibleStageResourceAssert) HasTagsAllNotEmpty() *ExternalS3CompatibleStageResourceAssert {
+	e.ValuePresent("tags_all")
+	return e
+}
+
 func (e *ExternalS3CompatibleStageResourceAssert) HasUrlNotEmpty() *ExternalS3CompatibleStageResourceAssert {
 	e.ValuePresent("url")
 	return e
@@ -85,6 +85,10 @@ func (e *ExternalS3StageResourceAssert) HasStorageIntegration(expected string) *
 	return e
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (e *ExternalS3StageResourceAssert) HasUrl(expected string) *ExternalS3StageResourceAssert {
 	e.StringValueSet("url", expected)
 	return e
@@ -144,6 +148,16 @@ func (e *ExternalS3StageResourceAssert) HasStorageIntegrationString(expected str
 	return e
 }
 
+func (e *ExternalS3StageResourceAssert) HasTagsString(expected string) *ExternalS3StageResourceAssert {
+	e.ValueSet("tags", expected)
+	return e
+}
+
+func (e *ExternalS3StageResourceAssert) HasTagsAllString(expected string) *ExternalS3StageResourceAssert {
+	e.ValueSet("tags_all", expected)
+	return e
+}
+
 func (e *ExternalS3StageResourceAssert) HasUrlString(expected string) *ExternalS3StageResourceAssert {
 	e.ValueSet("url", expected)
 	return e
@@ -203,6 +217,16 @@ func (e *ExternalS3StageResourceAssert) HasNoStorageIntegration() *ExternalS3Sta
 	return e
 }
 
+func (e *ExternalS3StageResourceAssert) HasNoTags() *ExternalS3StageResourceAssert {
+	e.ValueNotSet("tags")
+	return e
+}
+
+func (e *ExternalS3StageResourceAssert) HasNoTagsAll() *ExternalS3StageResourceAssert {
+	e.ValueNotSet("tags_all")
+	return e
+}
+
 func (e *ExternalS3StageResourceAssert) HasNoUrl() *ExternalS3StageResourceAssert {
 	e.ValueNotSet("url")
 	return e
@@ -267,6 +291,16 @@ func (e *ExternalS3StageResourceAssert) HasStorageIntegrationEmpty() *ExternalS3
 	return e
 }
 
+func (e *ExternalS3StageResourceAssert) HasTagsEmpty() *ExternalS3StageResourceAssert {
+	e.ValueSet("tags", "")
+	return e
+}
+
+func (e *ExternalS3StageResourceAssert) HasTagsAllEmpty() *ExternalS3StageResourceAssert {
+	e.ValueSet("tags_all", "")
+	return e
+}
+
 func (e *ExternalS3StageResourceAssert) HasUsePrivatelinkEndpointEmpty() *ExternalS3StageResourceAssert {
 	e.ValueSet("use_privatelink_endpoint", "")
 	return e
@@ -321,6 +355,16 @@ func (e *ExternalS3StageResourceAssert) HasStorageIntegrationNotEmpty() *Externa
 	return e
 }
 
+func (e *ExternalS3StageResourceAssert) HasTagsNotEmpty() *ExternalS3StageResourceAssert {
+	e.ValuePresent("tags")
+	return e
+}
+
+func (e *ExternalS3StageResourceAssert) HasTagsAllNotEmpty() *ExternalS3StageResourceAssert {
+	e.ValuePresent("tags_all")
+	return e
+}
+
 func (e *ExternalS3StageResourceAssert) HasUrlNotEmpty() *ExternalS3StageResourceAssert {
 	e.ValuePresent("url")
 	return e
@@ -133,6 +133,10 @@ func (f *FunctionJavaResourceAssert) HasRuntimeVersion(expected string) *Functio
 
 // typed assert for "secrets" (type: Set, subtype: Map) is not currently supported
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 // typed assert for "target_path" (type: Set, subtype: Map) is not currently supported
 
 func (f *FunctionJavaResourceAssert) HasTraceLevel(expected string) *FunctionJavaResourceAssert {
@@ -229,6 +233,16 @@ func (f *FunctionJavaResourceAssert) HasRuntimeVersionString(expected string) *F
 	return f
 }
 
+func (f *FunctionJavaResourceAssert) HasTagsString(expected string) *FunctionJavaResourceAssert {
+	f.ValueSet("tags", expected)
+	return f
+}
+
+func (f *FunctionJavaResourceAssert) HasTagsAllString(expected string) *FunctionJavaResourceAssert {
+	f.ValueSet("tags_all", expected)
+	return f
+}
+
 func (f *FunctionJavaResourceAssert) HasTraceLevelString(expected string) *FunctionJavaResourceAssert {
 	f.ValueSet("trace_level", expected)
 	return f
@@ -323,6 +337,16 @@ func (f *FunctionJavaResourceAssert) HasNoRuntimeVersion() *FunctionJavaResource
 	return f
 }
 
+func (f *FunctionJavaResourceAssert) HasNoTags() *FunctionJavaResourceAssert {
+	f.ValueNotSet("tags")
+	return f
+}
+
+func (f *FunctionJavaResourceAssert) HasNoTagsAll() *FunctionJavaResourceAssert {
+	f.ValueNotSet("tags_all")
+	return f
+}
+
 func (f *FunctionJavaResourceAssert) HasNoTraceLevel() *FunctionJavaResourceAssert {
 	f.ValueNotSet("trace_level")
 	return f
@@ -417,6 +441,16 @@ func (f *FunctionJavaResourceAssert) HasSecretsEmpty() *FunctionJavaResourceAsse
 	return f
 }
 
+func (f *FunctionJavaResourceAssert) HasTagsEmpty() *FunctionJavaResourceAssert {
+	f.ValueSet("tags", "")
+	return f
+}
+
+func (f *FunctionJavaResourceAssert) HasTagsAllEmpty() *FunctionJavaResourceAssert {
+	f.ValueSet("tags_all", "")
+	return f
+}
+
 func (f *FunctionJavaResourceAssert) HasTargetPathEmpty() *FunctionJavaResourceAssert {
 	f.ValueSet("target_path.#", "0")
 	return f
@@ -516,6 +550,16 @@ func (f *FunctionJavaResourceAssert) HasRuntimeVersionNotEmpty() *FunctionJavaRe
 	return f
 }
 
+func (f *FunctionJavaResourceAssert) HasTagsNotEmpty() *FunctionJavaResourceAssert {
+	f.ValuePresent("tags")
+	return f
+}
+
+func (f *FunctionJavaResourceAssert) HasTagsAllNotEmpty() *FunctionJavaResourceAssert {
+	f.ValuePresent("tags_all")
+	return f
+}
+
 func (f *FunctionJavaResourceAssert) HasTraceLevelNotEmpty() *FunctionJavaResourceAssert {
 	f.ValuePresent("trace_level")
 	return f
@@ -109,6 +109,10 @@ func (f *FunctionJavascriptResourceAssert) HasReturnType(expected string) *Funct
 	return f
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (f *FunctionJavascriptResourceAssert) HasTraceLevel(expected string) *FunctionJavascriptResourceAssert {
 	f.StringValueSet("trace_level", expected)
 	return f
@@ -193,6 +197,16 @@ func (f *FunctionJavascriptResourceAssert) HasReturnTypeString(expected string)
 	return f
 }
 
+func (f *FunctionJavascriptResourceAssert) HasTagsString(expected string) *FunctionJavascriptResourceAssert {
+	f.ValueSet("tags", expected)
+	return f
+}
+
+func (f *FunctionJavascriptResourceAssert) HasTagsAllString(expected string) *FunctionJavascriptResourceAssert {
+	f.ValueSet("tags_all", expected)
+	return f
+}
+
 func (f *FunctionJavascriptResourceAssert) HasTraceLevelString(expected string) *FunctionJavascriptResourceAssert {
 	f.ValueSet("trace_level", expected)
 	return f
@@ -277,6 +291,16 @@ func (f *FunctionJavascriptResourceAssert) HasNoReturnType() *FunctionJavascript
 	return f
 }
 
+func (f *FunctionJavascriptResourceAssert) HasNoTags() *FunctionJavascriptResourceAssert {
+	f.ValueNotSet("tags")
+	return f
+}
+
+func (f *FunctionJavascriptResourceAssert) HasNoTagsAll() *FunctionJavascriptResourceAssert {
+	f.ValueNotSet("tags_all")
+	return f
+}
+
 func (f *FunctionJavascriptResourceAssert) HasNoTraceLevel() *FunctionJavascriptResourceAssert {
 	f.ValueNotSet("trace_level")
 	return f
@@ -341,6 +365,16 @@ func (f *FunctionJavascriptResourceAssert) HasReturnResultsBehaviorEmpty() *Func
 	return f
 }
 
+func (f *FunctionJavascriptResourceAssert) HasTagsEmpty() *FunctionJavascriptResourceAssert {
+	f.ValueSet("tags", "")
+	return f
+}
+
+func (f *FunctionJavascriptResourceAssert) HasTagsAllEmpty() *FunctionJavascriptResourceAssert {
+	f.ValueSet("tags_all", "")
+	return f
+}
+
 func (f *FunctionJavascriptResourceAssert) HasTraceLevelEmpty() *FunctionJavascriptResourceAssert {
 	f.ValueSet("trace_level", "")
 	return f
@@ -425,6 +459,16 @@ func (f *FunctionJavascriptResourceAssert) HasReturnTypeNotEmpty() *FunctionJava
 	return f
 }
 
+func (f *FunctionJavascriptResourceAssert) HasTagsNotEmpty() *FunctionJavascriptResourceAssert {
+	f.ValuePresent("tags")
+	return f
+}
+
+func (f *FunctionJavascriptResourceAssert) HasTagsAllNotEmpty() *FunctionJavascriptResourceAssert {
+	f.ValuePresent("tags_all")
+	return f
+}
+
 func (f *FunctionJavascriptResourceAssert) HasTraceLevelNotEmpty() *FunctionJavascriptResourceAssert {
 	f.ValuePresent("trace_level")
 	return f
@@ -138,6 +138,10 @@ func (f *FunctionPythonResourceAssert) HasRuntimeVersion(expected string) *Funct
 
 // typed assert for "secrets" (type: Set, subtype: Map) is not currently supported
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (f *FunctionPythonResourceAssert) HasTraceLevel(expected string) *FunctionPythonResourceAssert {
 	f.StringValueSet("trace_level", expected)
 	return f
@@ -237,6 +241,16 @@ func (f *FunctionPythonResourceAssert) HasRuntimeVersionString(expected string)
 	return f
 }
 
+func (f *FunctionPythonResourceAssert) HasTagsString(expected string) *FunctionPythonResourceAssert {
+	f.ValueSet("tags", expected)
+	return f
+}
+
+func (f *FunctionPythonResourceAssert) HasTagsAllString(expected string) *FunctionPythonResourceAssert {
+	f.ValueSet("tags_all", expected)
+	return f
+}
+
 func (f *FunctionPythonResourceAssert) HasTraceLevelString(expected string) *FunctionPythonResourceAssert {
 	f.ValueSet("trace_level", expected)
 	return f
@@ -336,6 +350,16 @@ func (f *FunctionPythonResourceAssert) HasNoRuntimeVersion() *FunctionPythonReso
 	return f
 }
 
+func (f *FunctionPythonResourceAssert) HasNoTags() *FunctionPythonResourceAssert {
+	f.ValueNotSet("tags")
+	return f
+}
+
+func (f *FunctionPythonResourceAssert) HasNoTagsAll() *FunctionPythonResourceAssert {
+	f.ValueNotSet("tags_all")
+	return f
+}
+
 func (f *FunctionPythonResourceAssert) HasNoTraceLevel() *FunctionPythonResourceAssert {
 	f.ValueNotSet("trace_level")
 	return f
@@ -430,6 +454,16 @@ func (f *FunctionPythonResourceAssert) HasSecretsEmpty() *FunctionPythonResource
 	return f
 }
 
+func (f *FunctionPythonResourceAssert) HasTagsEmpty() *FunctionPythonResourceAssert {
+	f.ValueSet("tags", "")
+	return f
+}
+
+func (f *FunctionPythonResourceAssert) HasTagsAllEmpty() *FunctionPythonResourceAssert {
+	f.ValueSet("tags_all", "")
+	return f
+}
+
 func (f *FunctionPythonResourceAssert) HasTraceLevelEmpty() *FunctionPythonResourceAssert {
 	f.ValueSet("trace_level", "")
 	return f
@@ -529,6 +563,16 @@ func (f *FunctionPythonResourceAssert) HasRuntimeVersionNotEmpty() *FunctionPyth
 	return f
 }
 
+func (f *FunctionPythonResourceAssert) HasTagsNotEmpty() *FunctionPythonResourceAssert {
+	f.ValuePresent("tags")
+	return f
+}
+
+func (f *FunctionPythonResourceAssert) HasTagsAllNotEmpty() *FunctionPythonResourceAssert {
+	f.ValuePresent("tags_all")
+	return f
+}
+
 func (f *FunctionPythonResourceAssert) HasTraceLevelNotEmpty() *FunctionPythonResourceAssert {
 	f.ValuePresent("trace_level")
 	return f
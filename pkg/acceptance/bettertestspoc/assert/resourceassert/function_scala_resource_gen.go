@@ -133,6 +133,10 @@ func (f *FunctionScalaResourceAssert) HasRuntimeVersion(expected string) *Functi
 
 // typed assert for "secrets" (type: Set, subtype: Map) is not currently supported
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 // typed assert for "target_path" (type: Set, subtype: Map) is not currently supported
 
 func (f *FunctionScalaResourceAssert) HasTraceLevel(expected string) *FunctionScalaResourceAssert {
@@ -229,6 +233,16 @@ func (f *FunctionScalaResourceAssert) HasRuntimeVersionString(expected string) *
 	return f
 }
 
+func (f *FunctionScalaResourceAssert) HasTagsString(expected string) *FunctionScalaResourceAssert {
+	f.ValueSet("tags", expected)
+	return f
+}
+
+func (f *FunctionScalaResourceAssert) HasTagsAllString(expected string) *FunctionScalaResourceAssert {
+	f.ValueSet("tags_all", expected)
+	return f
+}
+
 func (f *FunctionScalaResourceAssert) HasTraceLevelString(expected string) *FunctionScalaResourceAssert {
 	f.ValueSet("trace_level", expected)
 	return f
@@ -323,6 +337,16 @@ func (f *FunctionScalaResourceAssert) HasNoRuntimeVersion() *FunctionScalaResour
 	return f
 }
 
+func (f *FunctionScalaResourceAssert) HasNoTags() *FunctionScalaResourceAssert {
+	f.ValueNotSet("tags")
+	return f
+}
+
+func (f *FunctionScalaResourceAssert) HasNoTagsAll() *FunctionScalaResourceAssert {
+	f.ValueNotSet("tags_all")
+	return f
+}
+
 func (f *FunctionScalaResourceAssert) HasNoTraceLevel() *FunctionScalaResourceAssert {
 	f.ValueNotSet("trace_level")
 	return f
@@ -412,6 +436,16 @@ func (f *FunctionScalaResourceAssert) HasSecretsEmpty() *FunctionScalaResourceAs
 	return f
 }
 
+func (f *FunctionScalaResourceAssert) HasTagsEmpty() *FunctionScalaResourceAssert {
+	f.ValueSet("tags", "")
+	return f
+}
+
+func (f *FunctionScalaResourceAssert) HasTagsAllEmpty() *FunctionScalaResourceAssert {
+	f.ValueSet("tags_all", "")
+	return f
+}
+
 func (f *FunctionScalaResourceAssert) HasTargetPathEmpty() *FunctionScalaResourceAssert {
 	f.ValueSet("target_path.#", "0")
 	return f
@@ -511,6 +545,16 @@ func (f *FunctionScalaResourceAssert) HasRuntimeVersionNotEmpty() *FunctionScala
 	return f
 }
 
+func (f *FunctionScalaResourceAssert) HasTagsNotEmpty() *FunctionScalaResourceAssert {
+	f.ValuePresent("tags")
+	return f
+}
+
+func (f *FunctionScalaResourceAssert) HasTagsAllNotEmpty() *FunctionScalaResourceAssert {
+	f.ValuePresent("tags_all")
+	return f
+}
+
 func (f *FunctionScalaResourceAssert) HasTraceLevelNotEmpty() *FunctionScalaResourceAssert {
 	f.ValuePresent("trace_level")
 	return f
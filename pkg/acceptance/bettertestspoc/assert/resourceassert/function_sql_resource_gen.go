@@ -104,6 +104,10 @@ func (f *FunctionSqlResourceAssert) HasReturnType(expected string) *FunctionSqlR
 	return f
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (f *FunctionSqlResourceAssert) HasTraceLevel(expected string) *FunctionSqlResourceAssert {
 	f.StringValueSet("trace_level", expected)
 	return f
@@ -183,6 +187,16 @@ func (f *FunctionSqlResourceAssert) HasReturnTypeString(expected string) *Functi
 	return f
 }
 
+func (f *FunctionSqlResourceAssert) HasTagsString(expected string) *FunctionSqlResourceAssert {
+	f.ValueSet("tags", expected)
+	return f
+}
+
+func (f *FunctionSqlResourceAssert) HasTagsAllString(expected string) *FunctionSqlResourceAssert {
+	f.ValueSet("tags_all", expected)
+	return f
+}
+
 func (f *FunctionSqlResourceAssert) HasTraceLevelString(expected string) *FunctionSqlResourceAssert {
 	f.ValueSet("trace_level", expected)
 	return f
@@ -262,6 +276,16 @@ func (f *FunctionSqlResourceAssert) HasNoReturnType() *FunctionSqlResourceAssert
 	return f
 }
 
+func (f *FunctionSqlResourceAssert) HasNoTags() *FunctionSqlResourceAssert {
+	f.ValueNotSet("tags")
+	return f
+}
+
+func (f *FunctionSqlResourceAssert) HasNoTagsAll() *FunctionSqlResourceAssert {
+	f.ValueNotSet("tags_all")
+	return f
+}
+
 func (f *FunctionSqlResourceAssert) HasNoTraceLevel() *FunctionSqlResourceAssert {
 	f.ValueNotSet("trace_level")
 	return f
@@ -321,6 +345,16 @@ func (f *FunctionSqlResourceAssert) HasReturnResultsBehaviorEmpty() *FunctionSql
 	return f
 }
 
+func (f *FunctionSqlResourceAssert) HasTagsEmpty() *FunctionSqlResourceAssert {
+	f.ValueSet("tags", "")
+	return f
+}
+
+func (f *FunctionSqlResourceAssert) HasTagsAllEmpty() *FunctionSqlResourceAssert {
+	f.ValueSet("tags_all", "")
+	return f
+}
+
 func (f *FunctionSqlResourceAssert) HasTraceLevelEmpty() *FunctionSqlResourceAssert {
 	f.ValueSet("trace_level", "")
 	return f
@@ -400,6 +434,16 @@ func (f *FunctionSqlResourceAssert) HasReturnTypeNotEmpty() *FunctionSqlResource
 	return f
 }
 
+func (f *FunctionSqlResourceAssert) HasTagsNotEmpty() *FunctionSqlResourceAssert {
+	f.ValuePresent("tags")
+	return f
+}
+
+func (f *FunctionSqlResourceAssert) HasTagsAllNotEmpty() *FunctionSqlResourceAssert {
+	f.ValuePresent("tags_all")
+	return f
+}
+
 func (f *FunctionSqlResourceAssert) HasTraceLevelNotEmpty() *FunctionSqlResourceAssert {
 	f.ValuePresent("trace_level")
 	return f
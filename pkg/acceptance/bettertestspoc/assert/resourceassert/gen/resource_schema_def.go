@@ -41,6 +41,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "AccountSessionPolicyAttachment",
 		schema: resources.AccountSessionPolicyAttachment().Schema,
 	},
+	{
+		name:   "AggregationPolicy",
+		schema: resources.AggregationPolicy().Schema,
+	},
 	{
 		name:   "ApiAuthenticationIntegrationWithAuthorizationCodeGrant",
 		schema: resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant().Schema,
@@ -89,10 +93,34 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "ApiIntegrationGoogleCloudApiGateway",
 		schema: resources.ApiIntegrationGoogleCloudApiGateway().Schema,
 	},
+	{
+		name:   "Application",
+		schema: resources.Application().Schema,
+	},
+	{
+		name:   "ApplicationPackage",
+		schema: resources.ApplicationPackage().Schema,
+	},
 	{
 		name:   "AuthenticationPolicy",
 		schema: resources.AuthenticationPolicy().Schema,
 	},
+	{
+		name:   "BackupPolicy",
+		schema: resources.BackupPolicy().Schema,
+	},
+	{
+		name:   "BackupSet",
+		schema: resources.BackupSet().Schema,
+	},
+	{
+		name:   "Budget",
+		schema: resources.Budget().Schema,
+	},
+	{
+		name:   "BudgetTrackedObject",
+		schema: resources.BudgetTrackedObject().Schema,
+	},
 	{
 		name:   "CatalogIntegrationAwsGlue",
 		schema: resources.CatalogIntegrationAwsGlue().Schema,
@@ -113,6 +141,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "ComputePool",
 		schema: resources.ComputePool().Schema,
 	},
+	{
+		name:   "Contact",
+		schema: resources.Contact().Schema,
+	},
 	{
 		name:   "CortexAgent",
 		schema: resources.CortexAgent().Schema,
@@ -125,6 +157,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "CurrentOrganizationAccount",
 		schema: resources.CurrentOrganizationAccount().Schema,
 	},
+	{
+		name:   "DataMetricFunctionAttachment",
+		schema: resources.DataMetricFunctionAttachment().Schema,
+	},
 	{
 		name:   "Database",
 		schema: resources.Database().Schema,
@@ -133,6 +169,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "DatabaseRole",
 		schema: resources.DatabaseRole().Schema,
 	},
+	{
+		name:   "EventTable",
+		schema: resources.EventTable().Schema,
+	},
 	{
 		name:   "Execute",
 		schema: resources.Execute().Schema,
@@ -245,6 +285,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "JobService",
 		schema: resources.JobService().Schema,
 	},
+	{
+		name:   "JoinPolicy",
+		schema: resources.JoinPolicy().Schema,
+	},
 	{
 		name:   "LegacyServiceUser",
 		schema: resources.LegacyServiceUser().Schema,
@@ -277,6 +321,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "Notebook",
 		schema: resources.Notebook().Schema,
 	},
+	{
+		name:   "ObjectContacts",
+		schema: resources.ObjectContacts().Schema,
+	},
 	{
 		name:   "Pipe",
 		schema: resources.Pipe().Schema,
@@ -289,6 +337,22 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "OauthIntegrationForPartnerApplications",
 		schema: resources.OauthIntegrationForPartnerApplications().Schema,
 	},
+	{
+		name:   "OpenflowConnector",
+		schema: resources.OpenflowConnector().Schema,
+	},
+	{
+		name:   "OpenflowDeployment",
+		schema: resources.OpenflowDeployment().Schema,
+	},
+	{
+		name:   "OpenflowRuntime",
+		schema: resources.OpenflowRuntime().Schema,
+	},
+	{
+		name:   "OrganizationAccount",
+		schema: resources.OrganizationAccount().Schema,
+	},
 	{
 		name:   "PasswordPolicy",
 		schema: resources.PasswordPolicy().Schema,
@@ -325,6 +389,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "ProcedureSql",
 		schema: resources.ProcedureSql().Schema,
 	},
+	{
+		name:   "ProjectionPolicy",
+		schema: resources.ProjectionPolicy().Schema,
+	},
 	{
 		name:   "ResourceMonitor",
 		schema: resources.ResourceMonitor().Schema,
@@ -489,6 +557,10 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "GrantPrivilegesToAccountRole",
 		schema: resources.GrantPrivilegesToAccountRole().Schema,
 	},
+	{
+		name:   "GrantPrivilegesToApplicationRole",
+		schema: resources.GrantPrivilegesToApplicationRole().Schema,
+	},
 	{
 		name:   "GrantPrivilegesToDatabaseRole",
 		schema: resources.GrantPrivilegesToDatabaseRole().Schema,
@@ -513,6 +585,18 @@ var allResourceSchemaDefs = []ResourceSchemaDef{
 		name:   "GrantOwnership",
 		schema: resources.GrantOwnership().Schema,
 	},
+	{
+		name:   "GrantCallerPrivileges",
+		schema: resources.GrantCallerPrivileges().Schema,
+	},
+	{
+		name:   "AccountRoleMembership",
+		schema: resources.AccountRoleMembership().Schema,
+	},
+	{
+		name:   "GrantPrivilegesMatrix",
+		schema: resources.GrantPrivilegesMatrix().Schema,
+	},
 	{
 		name:   "StorageIntegration",
 		schema: resources.StorageIntegration().Schema,
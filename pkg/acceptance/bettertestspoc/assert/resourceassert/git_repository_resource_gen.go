@@ -57,6 +57,11 @@ func (g *GitRepositoryResourceAssert) HasComment(expected string) *GitRepository
 	return g
 }
 
+func (g *GitRepositoryResourceAssert) HasFetchTrigger(expected string) *GitRepositoryResourceAssert {
+	g.StringValueSet("fetch_trigger", expected)
+	return g
+}
+
 func (g *GitRepositoryResourceAssert) HasFullyQualifiedName(expected string) *GitRepositoryResourceAssert {
 	g.StringValueSet("fully_qualified_name", expected)
 	return g
@@ -101,6 +106,11 @@ func (g *GitRepositoryResourceAssert) HasCommentString(expected string) *GitRepo
 	return g
 }
 
+func (g *GitRepositoryResourceAssert) HasFetchTriggerString(expected string) *GitRepositoryResourceAssert {
+	g.ValueSet("fetch_trigger", expected)
+	return g
+}
+
 func (g *GitRepositoryResourceAssert) HasFullyQualifiedNameString(expected string) *GitRepositoryResourceAssert {
 	g.ValueSet("fully_qualified_name", expected)
 	return g
@@ -145,6 +155,11 @@ func (g *GitRepositoryResourceAssert) HasNoComment() *GitRepositoryResourceAsser
 	return g
 }
 
+func (g *GitRepositoryResourceAssert) HasNoFetchTrigger() *GitRepositoryResourceAssert {
+	g.ValueNotSet("fetch_trigger")
+	return g
+}
+
 func (g *GitRepositoryResourceAssert) HasNoFullyQualifiedName() *GitRepositoryResourceAssert {
 	g.ValueNotSet("fully_qualified_name")
 	return g
@@ -169,6 +184,11 @@ func (g *GitRepositoryResourceAssert) HasCommentEmpty() *GitRepositoryResourceAs
 	return g
 }
 
+func (g *GitRepositoryResourceAssert) HasFetchTriggerEmpty() *GitRepositoryResourceAssert {
+	g.ValueSet("fetch_trigger", "")
+	return g
+}
+
 func (g *GitRepositoryResourceAssert) HasFullyQualifiedNameEmpty() *GitRepositoryResourceAssert {
 	g.ValueSet("fully_qualified_name", "")
 	return g
@@ -208,6 +228,11 @@ func (g *GitRepositoryResourceAssert) HasCommentNotEmpty() *GitRepositoryResourc
 	return g
 }
 
+func (g *GitRepositoryResourceAssert) HasFetchTriggerNotEmpty() *GitRepositoryResourceAssert {
+	g.ValuePresent("fetch_trigger")
+	return g
+}
+
 func (g *GitRepositoryResourceAssert) HasFullyQualifiedNameNotEmpty() *GitRepositoryResourceAssert {
 	g.ValuePresent("fully_qualified_name")
 	return g
@@ -0,0 +1,51 @@
+// Code generated by resource assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type GrantPrivilegesMatrixResourceAssert struct {
+	*assert.ResourceAssert
+}
+
+func GrantPrivilegesMatrixResource(t *testing.T, name string) *GrantPrivilegesMatrixResourceAssert {
+	t.Helper()
+
+	return &GrantPrivilegesMatrixResourceAssert{
+		ResourceAssert: assert.NewResourceAssert(name),
+	}
+}
+
+func ImportedGrantPrivilegesMatrixResource(t *testing.T, id string) *GrantPrivilegesMatrixResourceAssert {
+	t.Helper()
+
+	return &GrantPrivilegesMatrixResourceAssert{
+		ResourceAssert: assert.NewImportedResourceAssert(id),
+	}
+}
+
+//////////////////////////////////
+// Attribute typed value checks //
+//////////////////////////////////
+
+// typed assert for "entry" (type: List, subtype: Map) is not currently supported
+
+///////////////////////////////////
+// Attribute value string checks //
+///////////////////////////////////
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+////////////////////////////
+// Attribute empty checks //
+////////////////////////////
+
+///////////////////////////////
+// Attribute presence checks //
+///////////////////////////////
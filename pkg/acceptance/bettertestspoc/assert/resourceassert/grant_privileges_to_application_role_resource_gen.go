@@ -0,0 +1,225 @@
+// Code generated by resource assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type GrantPrivilegesToApplicationRoleResourceAssert struct {
+	*assert.ResourceAssert
+}
+
+func GrantPrivilegesToApplicationRoleResource(t *testing.T, name string) *GrantPrivilegesToApplicationRoleResourceAssert {
+	t.Helper()
+
+	return &GrantPrivilegesToApplicationRoleResourceAssert{
+		ResourceAssert: assert.NewResourceAssert(name),
+	}
+}
+
+func ImportedGrantPrivilegesToApplicationRoleResource(t *testing.T, id string) *GrantPrivilegesToApplicationRoleResourceAssert {
+	t.Helper()
+
+	return &GrantPrivilegesToApplicationRoleResourceAssert{
+		ResourceAssert: assert.NewImportedResourceAssert(id),
+	}
+}
+
+//////////////////////////////////
+// Attribute typed value checks //
+//////////////////////////////////
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasAllPrivileges(expected bool) *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.BoolValueSet("all_privileges", expected)
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasAlwaysApply(expected bool) *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.BoolValueSet("always_apply", expected)
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasAlwaysApplyTrigger(expected string) *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.StringValueSet("always_apply_trigger", expected)
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasApplicationRoleName(expected string) *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.StringValueSet("application_role_name", expected)
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasOnAccount(expected bool) *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.BoolValueSet("on_account", expected)
+	return g
+}
+
+// typed assert for "on_account_object" (type: List, subtype: Map) is not currently supported
+
+// typed assert for "on_schema" (type: List, subtype: Map) is not currently supported
+
+// typed assert for "on_schema_object" (type: List, subtype: Map) is not currently supported
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasPrivileges(expected ...string) *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.SetContainsExactlyStringValues("privileges", expected...)
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasWithGrantOption(expected bool) *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.BoolValueSet("with_grant_option", expected)
+	return g
+}
+
+///////////////////////////////////
+// Attribute value string checks //
+///////////////////////////////////
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasAllPrivilegesString(expected string) *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueSet("all_privileges", expected)
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasAlwaysApplyString(expected string) *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueSet("always_apply", expected)
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasAlwaysApplyTriggerString(expected string) *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueSet("always_apply_trigger", expected)
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasApplicationRoleNameString(expected string) *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueSet("application_role_name", expected)
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasOnAccountString(expected string) *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueSet("on_account", expected)
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasWithGrantOptionString(expected string) *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueSet("with_grant_option", expected)
+	return g
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasNoAllPrivileges() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueNotSet("all_privileges")
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasNoAlwaysApply() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueNotSet("always_apply")
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasNoAlwaysApplyTrigger() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueNotSet("always_apply_trigger")
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasNoApplicationRoleName() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueNotSet("application_role_name")
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasNoOnAccount() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueNotSet("on_account")
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasNoWithGrantOption() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueNotSet("with_grant_option")
+	return g
+}
+
+////////////////////////////
+// Attribute empty checks //
+////////////////////////////
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasAllPrivilegesEmpty() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueSet("all_privileges", "")
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasAlwaysApplyEmpty() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueSet("always_apply", "")
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasAlwaysApplyTriggerEmpty() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueSet("always_apply_trigger", "")
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasOnAccountEmpty() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueSet("on_account", "")
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasOnAccountObjectEmpty() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueSet("on_account_object.#", "0")
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasOnSchemaEmpty() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueSet("on_schema.#", "0")
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasOnSchemaObjectEmpty() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueSet("on_schema_object.#", "0")
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasPrivilegesEmpty() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueSet("privileges.#", "0")
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasWithGrantOptionEmpty() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValueSet("with_grant_option", "")
+	return g
+}
+
+///////////////////////////////
+// Attribute presence checks //
+///////////////////////////////
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasAllPrivilegesNotEmpty() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValuePresent("all_privileges")
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasAlwaysApplyNotEmpty() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValuePresent("always_apply")
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasAlwaysApplyTriggerNotEmpty() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValuePresent("always_apply_trigger")
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasApplicationRoleNameNotEmpty() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValuePresent("application_role_name")
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasOnAccountNotEmpty() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValuePresent("on_account")
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleResourceAssert) HasWithGrantOptionNotEmpty() *GrantPrivilegesToApplicationRoleResourceAssert {
+	g.ValuePresent("with_grant_option")
+	return g
+}
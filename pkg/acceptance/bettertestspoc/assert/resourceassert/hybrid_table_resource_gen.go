@@ -75,6 +75,10 @@ func (h *HybridTableResourceAssert) HasMaxDataExtensionTimeInDays(expected int)
 
 // typed assert for "primary_key_constraint" (type: List, subtype: Map) is not currently supported
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 // typed assert for "unique_constraint" (type: Set, subtype: Map) is not currently supported
 
 ///////////////////////////////////
@@ -116,6 +120,16 @@ func (h *HybridTableResourceAssert) HasMaxDataExtensionTimeInDaysString(expected
 	return h
 }
 
+func (h *HybridTableResourceAssert) HasTagsString(expected string) *HybridTableResourceAssert {
+	h.ValueSet("tags", expected)
+	return h
+}
+
+func (h *HybridTableResourceAssert) HasTagsAllString(expected string) *HybridTableResourceAssert {
+	h.ValueSet("tags_all", expected)
+	return h
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -155,6 +169,16 @@ func (h *HybridTableResourceAssert) HasNoMaxDataExtensionTimeInDays() *HybridTab
 	return h
 }
 
+func (h *HybridTableResourceAssert) HasNoTags() *HybridTableResourceAssert {
+	h.ValueNotSet("tags")
+	return h
+}
+
+func (h *HybridTableResourceAssert) HasNoTagsAll() *HybridTableResourceAssert {
+	h.ValueNotSet("tags_all")
+	return h
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -189,6 +213,16 @@ func (h *HybridTableResourceAssert) HasMaxDataExtensionTimeInDaysEmpty() *Hybrid
 	return h
 }
 
+func (h *HybridTableResourceAssert) HasTagsEmpty() *HybridTableResourceAssert {
+	h.ValueSet("tags", "")
+	return h
+}
+
+func (h *HybridTableResourceAssert) HasTagsAllEmpty() *HybridTableResourceAssert {
+	h.ValueSet("tags_all", "")
+	return h
+}
+
 func (h *HybridTableResourceAssert) HasUniqueConstraintEmpty() *HybridTableResourceAssert {
 	h.ValueSet("unique_constraint.#", "0")
 	return h
@@ -232,3 +266,13 @@ func (h *HybridTableResourceAssert) HasMaxDataExtensionTimeInDaysNotEmpty() *Hyb
 	h.ValuePresent("max_data_extension_time_in_days")
 	return h
 }
+
+func (h *HybridTableResourceAssert) HasTagsNotEmpty() *HybridTableResourceAssert {
+	h.ValuePresent("tags")
+	return h
+}
+
+func (h *HybridTableResourceAssert) HasTagsAllNotEmpty() *HybridTableResourceAssert {
+	h.ValuePresent("tags_all")
+	return h
+}
@@ -87,6 +87,10 @@ func (i *IcebergTableFromAwsGlueResourceAssert) HasReplaceInvalidCharacters(expe
 	return i
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -146,6 +150,16 @@ func (i *IcebergTableFromAwsGlueResourceAssert) HasReplaceInvalidCharactersStrin
 	return i
 }
 
+func (i *IcebergTableFromAwsGlueResourceAssert) HasTagsString(expected string) *IcebergTableFromAwsGlueResourceAssert {
+	i.ValueSet("tags", expected)
+	return i
+}
+
+func (i *IcebergTableFromAwsGlueResourceAssert) HasTagsAllString(expected string) *IcebergTableFromAwsGlueResourceAssert {
+	i.ValueSet("tags_all", expected)
+	return i
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -205,6 +219,16 @@ func (i *IcebergTableFromAwsGlueResourceAssert) HasNoReplaceInvalidCharacters()
 	return i
 }
 
+func (i *IcebergTableFromAwsGlueResourceAssert) HasNoTags() *IcebergTableFromAwsGlueResourceAssert {
+	i.ValueNotSet("tags")
+	return i
+}
+
+func (i *IcebergTableFromAwsGlueResourceAssert) HasNoTagsAll() *IcebergTableFromAwsGlueResourceAssert {
+	i.ValueNotSet("tags_all")
+	return i
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -244,6 +268,16 @@ func (i *IcebergTableFromAwsGlueResourceAssert) HasReplaceInvalidCharactersEmpty
 	return i
 }
 
+func (i *IcebergTableFromAwsGlueResourceAssert) HasTagsEmpty() *IcebergTableFromAwsGlueResourceAssert {
+	i.ValueSet("tags", "")
+	return i
+}
+
+func (i *IcebergTableFromAwsGlueResourceAssert) HasTagsAllEmpty() *IcebergTableFromAwsGlueResourceAssert {
+	i.ValueSet("tags_all", "")
+	return i
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -302,3 +336,13 @@ func (i *IcebergTableFromAwsGlueResourceAssert) HasReplaceInvalidCharactersNotEm
 	i.ValuePresent("replace_invalid_characters")
 	return i
 }
+
+func (i *IcebergTableFromAwsGlueResourceAssert) HasTagsNotEmpty() *IcebergTableFromAwsGlueResourceAssert {
+	i.ValuePresent("tags")
+	return i
+}
+
+func (i *IcebergTableFromAwsGlueResourceAssert) HasTagsAllNotEmpty() *IcebergTableFromAwsGlueResourceAssert {
+	i.ValuePresent("tags_all")
+	return i
+}
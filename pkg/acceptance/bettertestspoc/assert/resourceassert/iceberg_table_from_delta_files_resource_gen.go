@@ -82,6 +82,10 @@ func (i *IcebergTableFromDeltaFilesResourceAssert) HasReplaceInvalidCharacters(e
 	return i
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -136,6 +140,16 @@ func (i *IcebergTableFromDeltaFilesResourceAssert) HasReplaceInvalidCharactersSt
 	return i
 }
 
+func (i *IcebergTableFromDeltaFilesResourceAssert) HasTagsString(expected string) *IcebergTableFromDeltaFilesResourceAssert {
+	i.ValueSet("tags", expected)
+	return i
+}
+
+func (i *IcebergTableFromDeltaFilesResourceAssert) HasTagsAllString(expected string) *IcebergTableFromDeltaFilesResourceAssert {
+	i.ValueSet("tags_all", expected)
+	return i
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -190,6 +204,16 @@ func (i *IcebergTableFromDeltaFilesResourceAssert) HasNoReplaceInvalidCharacters
 	return i
 }
 
+func (i *IcebergTableFromDeltaFilesResourceAssert) HasNoTags() *IcebergTableFromDeltaFilesResourceAssert {
+	i.ValueNotSet("tags")
+	return i
+}
+
+func (i *IcebergTableFromDeltaFilesResourceAssert) HasNoTagsAll() *IcebergTableFromDeltaFilesResourceAssert {
+	i.ValueNotSet("tags_all")
+	return i
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -224,6 +248,16 @@ func (i *IcebergTableFromDeltaFilesResourceAssert) HasReplaceInvalidCharactersEm
 	return i
 }
 
+func (i *IcebergTableFromDeltaFilesResourceAssert) HasTagsEmpty() *IcebergTableFromDeltaFilesResourceAssert {
+	i.ValueSet("tags", "")
+	return i
+}
+
+func (i *IcebergTableFromDeltaFilesResourceAssert) HasTagsAllEmpty() *IcebergTableFromDeltaFilesResourceAssert {
+	i.ValueSet("tags_all", "")
+	return i
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -277,3 +311,13 @@ func (i *IcebergTableFromDeltaFilesResourceAssert) HasReplaceInvalidCharactersNo
 	i.ValuePresent("replace_invalid_characters")
 	return i
 }
+
+func (i *IcebergTableFromDeltaFilesResourceAssert) HasTagsNotEmpty() *IcebergTableFromDeltaFilesResourceAssert {
+	i.ValuePresent("tags")
+	return i
+}
+
+func (i *IcebergTableFromDeltaFilesResourceAssert) HasTagsAllNotEmpty() *IcebergTableFromDeltaFilesResourceAssert {
+	i.ValuePresent("tags_all")
+	return i
+}
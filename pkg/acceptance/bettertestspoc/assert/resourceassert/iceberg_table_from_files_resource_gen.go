@@ -77,6 +77,10 @@ func (i *IcebergTableFromFilesResourceAssert) HasReplaceInvalidCharacters(expect
 	return i
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -126,6 +130,16 @@ func (i *IcebergTableFromFilesResourceAssert) HasReplaceInvalidCharactersString(
 	return i
 }
 
+func (i *IcebergTableFromFilesResourceAssert) HasTagsString(expected string) *IcebergTableFromFilesResourceAssert {
+	i.ValueSet("tags", expected)
+	return i
+}
+
+func (i *IcebergTableFromFilesResourceAssert) HasTagsAllString(expected string) *IcebergTableFromFilesResourceAssert {
+	i.ValueSet("tags_all", expected)
+	return i
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -175,6 +189,16 @@ func (i *IcebergTableFromFilesResourceAssert) HasNoReplaceInvalidCharacters() *I
 	return i
 }
 
+func (i *IcebergTableFromFilesResourceAssert) HasNoTags() *IcebergTableFromFilesResourceAssert {
+	i.ValueNotSet("tags")
+	return i
+}
+
+func (i *IcebergTableFromFilesResourceAssert) HasNoTagsAll() *IcebergTableFromFilesResourceAssert {
+	i.ValueNotSet("tags_all")
+	return i
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -204,6 +228,16 @@ func (i *IcebergTableFromFilesResourceAssert) HasReplaceInvalidCharactersEmpty()
 	return i
 }
 
+func (i *IcebergTableFromFilesResourceAssert) HasTagsEmpty() *IcebergTableFromFilesResourceAssert {
+	i.ValueSet("tags", "")
+	return i
+}
+
+func (i *IcebergTableFromFilesResourceAssert) HasTagsAllEmpty() *IcebergTableFromFilesResourceAssert {
+	i.ValueSet("tags_all", "")
+	return i
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -252,3 +286,13 @@ func (i *IcebergTableFromFilesResourceAssert) HasReplaceInvalidCharactersNotEmpt
 	i.ValuePresent("replace_invalid_characters")
 	return i
 }
+
+func (i *IcebergTableFromFilesResourceAssert) HasTagsNotEmpty() *IcebergTableFromFilesResourceAssert {
+	i.ValuePresent("tags")
+	return i
+}
+
+func (i *IcebergTableFromFilesResourceAssert) HasTagsAllNotEmpty() *IcebergTableFromFilesResourceAssert {
+	i.ValuePresent("tags_all")
+	return i
+}
@@ -107,6 +107,10 @@ func (i *IcebergTableFromRestResourceAssert) HasStorageSerializationPolicy(expec
 	return i
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (i *IcebergTableFromRestResourceAssert) HasTargetFileSize(expected string) *IcebergTableFromRestResourceAssert {
 	i.StringValueSet("target_file_size", expected)
 	return i
@@ -191,6 +195,16 @@ func (i *IcebergTableFromRestResourceAssert) HasStorageSerializationPolicyString
 	return i
 }
 
+func (i *IcebergTableFromRestResourceAssert) HasTagsString(expected string) *IcebergTableFromRestResourceAssert {
+	i.ValueSet("tags", expected)
+	return i
+}
+
+func (i *IcebergTableFromRestResourceAssert) HasTagsAllString(expected string) *IcebergTableFromRestResourceAssert {
+	i.ValueSet("tags_all", expected)
+	return i
+}
+
 func (i *IcebergTableFromRestResourceAssert) HasTargetFileSizeString(expected string) *IcebergTableFromRestResourceAssert {
 	i.ValueSet("target_file_size", expected)
 	return i
@@ -275,6 +289,16 @@ func (i *IcebergTableFromRestResourceAssert) HasNoStorageSerializationPolicy() *
 	return i
 }
 
+func (i *IcebergTableFromRestResourceAssert) HasNoTags() *IcebergTableFromRestResourceAssert {
+	i.ValueNotSet("tags")
+	return i
+}
+
+func (i *IcebergTableFromRestResourceAssert) HasNoTagsAll() *IcebergTableFromRestResourceAssert {
+	i.ValueNotSet("tags_all")
+	return i
+}
+
 func (i *IcebergTableFromRestResourceAssert) HasNoTargetFileSize() *IcebergTableFromRestResourceAssert {
 	i.ValueNotSet("target_file_size")
 	return i
@@ -339,6 +363,16 @@ func (i *IcebergTableFromRestResourceAssert) HasStorageSerializationPolicyEmpty(
 	return i
 }
 
+func (i *IcebergTableFromRestResourceAssert) HasTagsEmpty() *IcebergTableFromRestResourceAssert {
+	i.ValueSet("tags", "")
+	return i
+}
+
+func (i *IcebergTableFromRestResourceAssert) HasTagsAllEmpty() *IcebergTableFromRestResourceAssert {
+	i.ValueSet("tags_all", "")
+	return i
+}
+
 func (i *IcebergTableFromRestResourceAssert) HasTargetFileSizeEmpty() *IcebergTableFromRestResourceAssert {
 	i.ValueSet("target_file_size", "")
 	return i
@@ -423,6 +457,16 @@ func (i *IcebergTableFromRestResourceAssert) HasStorageSerializationPolicyNotEmp
 	return i
 }
 
+func (i *IcebergTableFromRestResourceAssert) HasTagsNotEmpty() *IcebergTableFromRestResourceAssert {
+	i.ValuePresent("tags")
+	return i
+}
+
+func (i *IcebergTableFromRestResourceAssert) HasTagsAllNotEmpty() *IcebergTableFromRestResourceAssert {
+	i.ValuePresent("tags_all")
+	return i
+}
+
 func (i *IcebergTableFromRestResourceAssert) HasTargetFileSizeNotEmpty() *IcebergTableFromRestResourceAssert {
 	i.ValuePresent("target_file_size")
 	return i
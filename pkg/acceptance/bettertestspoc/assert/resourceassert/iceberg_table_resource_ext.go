@@ -35,6 +35,16 @@ func (i *IcebergTableResourceAssert) HasNoAggregationPolicy() *IcebergTableResou
 	return i
 }
 
+func (i *IcebergTableResourceAssert) HasJoinPolicy(joinPolicy sdk.SchemaObjectIdentifier) *IcebergTableResourceAssert {
+	i.ValueSet("join_policy.0.policy_name", joinPolicy.FullyQualifiedName())
+	return i
+}
+
+func (i *IcebergTableResourceAssert) HasNoJoinPolicy() *IcebergTableResourceAssert {
+	i.ValueNotSet("join_policy.#")
+	return i
+}
+
 func (i *IcebergTableResourceAssert) HasPartitionByLength(expected int) *IcebergTableResourceAssert {
 	i.CollectionLength("partition_by", expected)
 	return i
@@ -120,6 +120,8 @@ func (i *IcebergTableResourceAssert) HasIcebergVersion(expected int) *IcebergTab
 	return i
 }
 
+// typed assert for "join_policy" (type: List, subtype: Map) is not currently supported
+
 func (i *IcebergTableResourceAssert) HasMaxDataExtensionTimeInDays(expected int) *IcebergTableResourceAssert {
 	i.IntValueSet("max_data_extension_time_in_days", expected)
 	return i
@@ -141,6 +143,10 @@ func (i *IcebergTableResourceAssert) HasStorageSerializationPolicy(expected stri
 	return i
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (i *IcebergTableResourceAssert) HasTargetFileSize(expected string) *IcebergTableResourceAssert {
 	i.StringValueSet("target_file_size", expected)
 	return i
@@ -242,6 +248,16 @@ func (i *IcebergTableResourceAssert) HasStorageSerializationPolicyString(expecte
 	return i
 }
 
+func (i *IcebergTableResourceAssert) HasTagsString(expected string) *IcebergTableResourceAssert {
+	i.ValueSet("tags", expected)
+	return i
+}
+
+func (i *IcebergTableResourceAssert) HasTagsAllString(expected string) *IcebergTableResourceAssert {
+	i.ValueSet("tags_all", expected)
+	return i
+}
+
 func (i *IcebergTableResourceAssert) HasTargetFileSizeString(expected string) *IcebergTableResourceAssert {
 	i.ValueSet("target_file_size", expected)
 	return i
@@ -341,6 +357,16 @@ func (i *IcebergTableResourceAssert) HasNoStorageSerializationPolicy() *IcebergT
 	return i
 }
 
+func (i *IcebergTableResourceAssert) HasNoTags() *IcebergTableResourceAssert {
+	i.ValueNotSet("tags")
+	return i
+}
+
+func (i *IcebergTableResourceAssert) HasNoTagsAll() *IcebergTableResourceAssert {
+	i.ValueNotSet("tags_all")
+	return i
+}
+
 func (i *IcebergTableResourceAssert) HasNoTargetFileSize() *IcebergTableResourceAssert {
 	i.ValueNotSet("target_file_size")
 	return i
@@ -430,6 +456,11 @@ func (i *IcebergTableResourceAssert) HasIcebergVersionEmpty() *IcebergTableResou
 	return i
 }
 
+func (i *IcebergTableResourceAssert) HasJoinPolicyEmpty() *IcebergTableResourceAssert {
+	i.ValueSet("join_policy.#", "0")
+	return i
+}
+
 func (i *IcebergTableResourceAssert) HasMaxDataExtensionTimeInDaysEmpty() *IcebergTableResourceAssert {
 	i.ValueSet("max_data_extension_time_in_days", "")
 	return i
@@ -460,6 +491,16 @@ func (i *IcebergTableResourceAssert) HasStorageSerializationPolicyEmpty() *Icebe
 	return i
 }
 
+func (i *IcebergTableResourceAssert) HasTagsEmpty() *IcebergTableResourceAssert {
+	i.ValueSet("tags", "")
+	return i
+}
+
+func (i *IcebergTableResourceAssert) HasTagsAllEmpty() *IcebergTableResourceAssert {
+	i.ValueSet("tags_all", "")
+	return i
+}
+
 func (i *IcebergTableResourceAssert) HasTargetFileSizeEmpty() *IcebergTableResourceAssert {
 	i.ValueSet("target_file_size", "")
 	return i
@@ -564,6 +605,16 @@ func (i *IcebergTableResourceAssert) HasStorageSerializationPolicyNotEmpty() *Ic
 	return i
 }
 
+func (i *IcebergTableResourceAssert) HasTagsNotEmpty() *IcebergTableResourceAssert {
+	i.ValuePresent("tags")
+	return i
+}
+
+func (i *IcebergTableResourceAssert) HasTagsAllNotEmpty() *IcebergTableResourceAssert {
+	i.ValuePresent("tags_all")
+	return i
+}
+
 func (i *IcebergTableResourceAssert) HasTargetFileSizeNotEmpty() *IcebergTableResourceAssert {
 	i.ValuePresent("target_file_size")
 	return i
@@ -68,6 +68,10 @@ func (i *InternalStageResourceAssert) HasStageType(expected string) *InternalSta
 	return i
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -102,6 +106,16 @@ func (i *InternalStageResourceAssert) HasStageTypeString(expected string) *Inter
 	return i
 }
 
+func (i *InternalStageResourceAssert) HasTagsString(expected string) *InternalStageResourceAssert {
+	i.ValueSet("tags", expected)
+	return i
+}
+
+func (i *InternalStageResourceAssert) HasTagsAllString(expected string) *InternalStageResourceAssert {
+	i.ValueSet("tags_all", expected)
+	return i
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -136,6 +150,16 @@ func (i *InternalStageResourceAssert) HasNoStageType() *InternalStageResourceAss
 	return i
 }
 
+func (i *InternalStageResourceAssert) HasNoTags() *InternalStageResourceAssert {
+	i.ValueNotSet("tags")
+	return i
+}
+
+func (i *InternalStageResourceAssert) HasNoTagsAll() *InternalStageResourceAssert {
+	i.ValueNotSet("tags_all")
+	return i
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -170,6 +194,16 @@ func (i *InternalStageResourceAssert) HasStageTypeEmpty() *InternalStageResource
 	return i
 }
 
+func (i *InternalStageResourceAssert) HasTagsEmpty() *InternalStageResourceAssert {
+	i.ValueSet("tags", "")
+	return i
+}
+
+func (i *InternalStageResourceAssert) HasTagsAllEmpty() *InternalStageResourceAssert {
+	i.ValueSet("tags_all", "")
+	return i
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -203,3 +237,13 @@ func (i *InternalStageResourceAssert) HasStageTypeNotEmpty() *InternalStageResou
 	i.ValuePresent("stage_type")
 	return i
 }
+
+func (i *InternalStageResourceAssert) HasTagsNotEmpty() *InternalStageResourceAssert {
+	i.ValuePresent("tags")
+	return i
+}
+
+func (i *InternalStageResourceAssert) HasTagsAllNotEmpty() *InternalStageResourceAssert {
+	i.ValuePresent("tags_all")
+	return i
+}
@@ -0,0 +1,179 @@
+// Code generated by resource assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type JoinPolicyResourceAssert struct {
+	*assert.ResourceAssert
+}
+
+func JoinPolicyResource(t *testing.T, name string) *JoinPolicyResourceAssert {
+	t.Helper()
+
+	return &JoinPolicyResourceAssert{
+		ResourceAssert: assert.NewResourceAssert(name),
+	}
+}
+
+func ImportedJoinPolicyResource(t *testing.T, id string) *JoinPolicyResourceAssert {
+	t.Helper()
+
+	return &JoinPolicyResourceAssert{
+		ResourceAssert: assert.NewImportedResourceAssert(id),
+	}
+}
+
+//////////////////////////////////
+// Attribute typed value checks //
+//////////////////////////////////
+
+func (j *JoinPolicyResourceAssert) HasDatabase(expected string) *JoinPolicyResourceAssert {
+	j.StringValueSet("database", expected)
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasSchema(expected string) *JoinPolicyResourceAssert {
+	j.StringValueSet("schema", expected)
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasName(expected string) *JoinPolicyResourceAssert {
+	j.StringValueSet("name", expected)
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasBody(expected string) *JoinPolicyResourceAssert {
+	j.StringValueSet("body", expected)
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasComment(expected string) *JoinPolicyResourceAssert {
+	j.StringValueSet("comment", expected)
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasFullyQualifiedName(expected string) *JoinPolicyResourceAssert {
+	j.StringValueSet("fully_qualified_name", expected)
+	return j
+}
+
+///////////////////////////////////
+// Attribute value string checks //
+///////////////////////////////////
+
+func (j *JoinPolicyResourceAssert) HasDatabaseString(expected string) *JoinPolicyResourceAssert {
+	j.ValueSet("database", expected)
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasSchemaString(expected string) *JoinPolicyResourceAssert {
+	j.ValueSet("schema", expected)
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasNameString(expected string) *JoinPolicyResourceAssert {
+	j.ValueSet("name", expected)
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasBodyString(expected string) *JoinPolicyResourceAssert {
+	j.ValueSet("body", expected)
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasCommentString(expected string) *JoinPolicyResourceAssert {
+	j.ValueSet("comment", expected)
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasFullyQualifiedNameString(expected string) *JoinPolicyResourceAssert {
+	j.ValueSet("fully_qualified_name", expected)
+	return j
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (j *JoinPolicyResourceAssert) HasNoDatabase() *JoinPolicyResourceAssert {
+	j.ValueNotSet("database")
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasNoSchema() *JoinPolicyResourceAssert {
+	j.ValueNotSet("schema")
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasNoName() *JoinPolicyResourceAssert {
+	j.ValueNotSet("name")
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasNoBody() *JoinPolicyResourceAssert {
+	j.ValueNotSet("body")
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasNoComment() *JoinPolicyResourceAssert {
+	j.ValueNotSet("comment")
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasNoFullyQualifiedName() *JoinPolicyResourceAssert {
+	j.ValueNotSet("fully_qualified_name")
+	return j
+}
+
+////////////////////////////
+// Attribute empty checks //
+////////////////////////////
+
+func (j *JoinPolicyResourceAssert) HasCommentEmpty() *JoinPolicyResourceAssert {
+	j.ValueSet("comment", "")
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasFullyQualifiedNameEmpty() *JoinPolicyResourceAssert {
+	j.ValueSet("fully_qualified_name", "")
+	return j
+}
+
+///////////////////////////////
+// Attribute presence checks //
+///////////////////////////////
+
+func (j *JoinPolicyResourceAssert) HasDatabaseNotEmpty() *JoinPolicyResourceAssert {
+	j.ValuePresent("database")
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasSchemaNotEmpty() *JoinPolicyResourceAssert {
+	j.ValuePresent("schema")
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasNameNotEmpty() *JoinPolicyResourceAssert {
+	j.ValuePresent("name")
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasBodyNotEmpty() *JoinPolicyResourceAssert {
+	j.ValuePresent("body")
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasCommentNotEmpty() *JoinPolicyResourceAssert {
+	j.ValuePresent("comment")
+	return j
+}
+
+func (j *JoinPolicyResourceAssert) HasFullyQualifiedNameNotEmpty() *JoinPolicyResourceAssert {
+	j.ValuePresent("fully_qualified_name")
+	return j
+}
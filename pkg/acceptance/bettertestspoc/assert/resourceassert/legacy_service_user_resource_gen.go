@@ -324,6 +324,10 @@ func (l *LegacyServiceUserResourceAssert) HasStrictJsonOutput(expected bool) *Le
 	return l
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (l *LegacyServiceUserResourceAssert) HasTimeInputFormat(expected string) *LegacyServiceUserResourceAssert {
 	l.StringValueSet("time_input_format", expected)
 	return l
@@ -713,6 +717,16 @@ func (l *LegacyServiceUserResourceAssert) HasStrictJsonOutputString(expected str
 	return l
 }
 
+func (l *LegacyServiceUserResourceAssert) HasTagsString(expected string) *LegacyServiceUserResourceAssert {
+	l.ValueSet("tags", expected)
+	return l
+}
+
+func (l *LegacyServiceUserResourceAssert) HasTagsAllString(expected string) *LegacyServiceUserResourceAssert {
+	l.ValueSet("tags_all", expected)
+	return l
+}
+
 func (l *LegacyServiceUserResourceAssert) HasTimeInputFormatString(expected string) *LegacyServiceUserResourceAssert {
 	l.ValueSet("time_input_format", expected)
 	return l
@@ -1102,6 +1116,16 @@ func (l *LegacyServiceUserResourceAssert) HasNoStrictJsonOutput() *LegacyService
 	return l
 }
 
+func (l *LegacyServiceUserResourceAssert) HasNoTags() *LegacyServiceUserResourceAssert {
+	l.ValueNotSet("tags")
+	return l
+}
+
+func (l *LegacyServiceUserResourceAssert) HasNoTagsAll() *LegacyServiceUserResourceAssert {
+	l.ValueNotSet("tags_all")
+	return l
+}
+
 func (l *LegacyServiceUserResourceAssert) HasNoTimeInputFormat() *LegacyServiceUserResourceAssert {
 	l.ValueNotSet("time_input_format")
 	return l
@@ -1491,6 +1515,16 @@ func (l *LegacyServiceUserResourceAssert) HasStrictJsonOutputEmpty() *LegacyServ
 	return l
 }
 
+func (l *LegacyServiceUserResourceAssert) HasTagsEmpty() *LegacyServiceUserResourceAssert {
+	l.ValueSet("tags", "")
+	return l
+}
+
+func (l *LegacyServiceUserResourceAssert) HasTagsAllEmpty() *LegacyServiceUserResourceAssert {
+	l.ValueSet("tags_all", "")
+	return l
+}
+
 func (l *LegacyServiceUserResourceAssert) HasTimeInputFormatEmpty() *LegacyServiceUserResourceAssert {
 	l.ValueSet("time_input_format", "")
 	return l
@@ -1880,6 +1914,16 @@ func (l *LegacyServiceUserResourceAssert) HasStrictJsonOutputNotEmpty() *LegacyS
 	return l
 }
 
+func (l *LegacyServiceUserResourceAssert) HasTagsNotEmpty() *LegacyServiceUserResourceAssert {
+	l.ValuePresent("tags")
+	return l
+}
+
+func (l *LegacyServiceUserResourceAssert) HasTagsAllNotEmpty() *LegacyServiceUserResourceAssert {
+	l.ValuePresent("tags_all")
+	return l
+}
+
 func (l *LegacyServiceUserResourceAssert) HasTimeInputFormatNotEmpty() *LegacyServiceUserResourceAssert {
 	l.ValuePresent("time_input_format")
 	return l
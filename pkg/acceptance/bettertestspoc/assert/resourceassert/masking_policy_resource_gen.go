@@ -74,6 +74,10 @@ func (m *MaskingPolicyResourceAssert) HasReturnDataType(expected string) *Maskin
 	return m
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -118,6 +122,16 @@ func (m *MaskingPolicyResourceAssert) HasReturnDataTypeString(expected string) *
 	return m
 }
 
+func (m *MaskingPolicyResourceAssert) HasTagsString(expected string) *MaskingPolicyResourceAssert {
+	m.ValueSet("tags", expected)
+	return m
+}
+
+func (m *MaskingPolicyResourceAssert) HasTagsAllString(expected string) *MaskingPolicyResourceAssert {
+	m.ValueSet("tags_all", expected)
+	return m
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -162,6 +176,16 @@ func (m *MaskingPolicyResourceAssert) HasNoReturnDataType() *MaskingPolicyResour
 	return m
 }
 
+func (m *MaskingPolicyResourceAssert) HasNoTags() *MaskingPolicyResourceAssert {
+	m.ValueNotSet("tags")
+	return m
+}
+
+func (m *MaskingPolicyResourceAssert) HasNoTagsAll() *MaskingPolicyResourceAssert {
+	m.ValueNotSet("tags_all")
+	return m
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -181,6 +205,16 @@ func (m *MaskingPolicyResourceAssert) HasFullyQualifiedNameEmpty() *MaskingPolic
 	return m
 }
 
+func (m *MaskingPolicyResourceAssert) HasTagsEmpty() *MaskingPolicyResourceAssert {
+	m.ValueSet("tags", "")
+	return m
+}
+
+func (m *MaskingPolicyResourceAssert) HasTagsAllEmpty() *MaskingPolicyResourceAssert {
+	m.ValueSet("tags_all", "")
+	return m
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -224,3 +258,13 @@ func (m *MaskingPolicyResourceAssert) HasReturnDataTypeNotEmpty() *MaskingPolicy
 	m.ValuePresent("return_data_type")
 	return m
 }
+
+func (m *MaskingPolicyResourceAssert) HasTagsNotEmpty() *MaskingPolicyResourceAssert {
+	m.ValuePresent("tags")
+	return m
+}
+
+func (m *MaskingPolicyResourceAssert) HasTagsAllNotEmpty() *MaskingPolicyResourceAssert {
+	m.ValuePresent("tags_all")
+	return m
+}
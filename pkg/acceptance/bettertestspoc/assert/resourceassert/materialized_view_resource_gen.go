@@ -74,6 +74,10 @@ func (m *MaterializedViewResourceAssert) HasStatement(expected string) *Material
 
 // typed assert for "tag" (type: List, subtype: Map) is not currently supported
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (m *MaterializedViewResourceAssert) HasWarehouse(expected string) *MaterializedViewResourceAssert {
 	m.StringValueSet("warehouse", expected)
 	return m
@@ -123,6 +127,16 @@ func (m *MaterializedViewResourceAssert) HasStatementString(expected string) *Ma
 	return m
 }
 
+func (m *MaterializedViewResourceAssert) HasTagsString(expected string) *MaterializedViewResourceAssert {
+	m.ValueSet("tags", expected)
+	return m
+}
+
+func (m *MaterializedViewResourceAssert) HasTagsAllString(expected string) *MaterializedViewResourceAssert {
+	m.ValueSet("tags_all", expected)
+	return m
+}
+
 func (m *MaterializedViewResourceAssert) HasWarehouseString(expected string) *MaterializedViewResourceAssert {
 	m.ValueSet("warehouse", expected)
 	return m
@@ -172,6 +186,16 @@ func (m *MaterializedViewResourceAssert) HasNoStatement() *MaterializedViewResou
 	return m
 }
 
+func (m *MaterializedViewResourceAssert) HasNoTags() *MaterializedViewResourceAssert {
+	m.ValueNotSet("tags")
+	return m
+}
+
+func (m *MaterializedViewResourceAssert) HasNoTagsAll() *MaterializedViewResourceAssert {
+	m.ValueNotSet("tags_all")
+	return m
+}
+
 func (m *MaterializedViewResourceAssert) HasNoWarehouse() *MaterializedViewResourceAssert {
 	m.ValueNotSet("warehouse")
 	return m
@@ -206,6 +230,16 @@ func (m *MaterializedViewResourceAssert) HasTagEmpty() *MaterializedViewResource
 	return m
 }
 
+func (m *MaterializedViewResourceAssert) HasTagsEmpty() *MaterializedViewResourceAssert {
+	m.ValueSet("tags", "")
+	return m
+}
+
+func (m *MaterializedViewResourceAssert) HasTagsAllEmpty() *MaterializedViewResourceAssert {
+	m.ValueSet("tags_all", "")
+	return m
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -250,6 +284,16 @@ func (m *MaterializedViewResourceAssert) HasStatementNotEmpty() *MaterializedVie
 	return m
 }
 
+func (m *MaterializedViewResourceAssert) HasTagsNotEmpty() *MaterializedViewResourceAssert {
+	m.ValuePresent("tags")
+	return m
+}
+
+func (m *MaterializedViewResourceAssert) HasTagsAllNotEmpty() *MaterializedViewResourceAssert {
+	m.ValuePresent("tags_all")
+	return m
+}
+
 func (m *MaterializedViewResourceAssert) HasWarehouseNotEmpty() *MaterializedViewResourceAssert {
 	m.ValuePresent("warehouse")
 	return m
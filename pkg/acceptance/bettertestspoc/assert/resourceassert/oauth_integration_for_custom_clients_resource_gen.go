@@ -119,6 +119,10 @@ func (o *OauthIntegrationForCustomClientsResourceAssert) HasPreAuthorizedRolesLi
 
 // typed assert for "related_parameters" (type: List, subtype: Map) is not currently supported
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -193,6 +197,16 @@ func (o *OauthIntegrationForCustomClientsResourceAssert) HasOauthUseSecondaryRol
 	return o
 }
 
+func (o *OauthIntegrationForCustomClientsResourceAssert) HasTagsString(expected string) *OauthIntegrationForCustomClientsResourceAssert {
+	o.ValueSet("tags", expected)
+	return o
+}
+
+func (o *OauthIntegrationForCustomClientsResourceAssert) HasTagsAllString(expected string) *OauthIntegrationForCustomClientsResourceAssert {
+	o.ValueSet("tags_all", expected)
+	return o
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -267,6 +281,16 @@ func (o *OauthIntegrationForCustomClientsResourceAssert) HasNoOauthUseSecondaryR
 	return o
 }
 
+func (o *OauthIntegrationForCustomClientsResourceAssert) HasNoTags() *OauthIntegrationForCustomClientsResourceAssert {
+	o.ValueNotSet("tags")
+	return o
+}
+
+func (o *OauthIntegrationForCustomClientsResourceAssert) HasNoTagsAll() *OauthIntegrationForCustomClientsResourceAssert {
+	o.ValueNotSet("tags_all")
+	return o
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -346,6 +370,16 @@ func (o *OauthIntegrationForCustomClientsResourceAssert) HasRelatedParametersEmp
 	return o
 }
 
+func (o *OauthIntegrationForCustomClientsResourceAssert) HasTagsEmpty() *OauthIntegrationForCustomClientsResourceAssert {
+	o.ValueSet("tags", "")
+	return o
+}
+
+func (o *OauthIntegrationForCustomClientsResourceAssert) HasTagsAllEmpty() *OauthIntegrationForCustomClientsResourceAssert {
+	o.ValueSet("tags_all", "")
+	return o
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -419,3 +453,13 @@ func (o *OauthIntegrationForCustomClientsResourceAssert) HasOauthUseSecondaryRol
 	o.ValuePresent("oauth_use_secondary_roles")
 	return o
 }
+
+func (o *OauthIntegrationForCustomClientsResourceAssert) HasTagsNotEmpty() *OauthIntegrationForCustomClientsResourceAssert {
+	o.ValuePresent("tags")
+	return o
+}
+
+func (o *OauthIntegrationForCustomClientsResourceAssert) HasTagsAllNotEmpty() *OauthIntegrationForCustomClientsResourceAssert {
+	o.ValuePresent("tags_all")
+	return o
+}
@@ -89,6 +89,10 @@ func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasOauthUseSecond
 
 // typed assert for "related_parameters" (type: List, subtype: Map) is not currently supported
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -138,6 +142,16 @@ func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasOauthUseSecond
 	return o
 }
 
+func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasTagsString(expected string) *OauthIntegrationForPartnerApplicationsResourceAssert {
+	o.ValueSet("tags", expected)
+	return o
+}
+
+func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasTagsAllString(expected string) *OauthIntegrationForPartnerApplicationsResourceAssert {
+	o.ValueSet("tags_all", expected)
+	return o
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -187,6 +201,16 @@ func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasNoOauthUseSeco
 	return o
 }
 
+func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasNoTags() *OauthIntegrationForPartnerApplicationsResourceAssert {
+	o.ValueNotSet("tags")
+	return o
+}
+
+func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasNoTagsAll() *OauthIntegrationForPartnerApplicationsResourceAssert {
+	o.ValueNotSet("tags_all")
+	return o
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -241,6 +265,16 @@ func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasRelatedParamet
 	return o
 }
 
+func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasTagsEmpty() *OauthIntegrationForPartnerApplicationsResourceAssert {
+	o.ValueSet("tags", "")
+	return o
+}
+
+func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasTagsAllEmpty() *OauthIntegrationForPartnerApplicationsResourceAssert {
+	o.ValueSet("tags_all", "")
+	return o
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -289,3 +323,13 @@ func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasOauthUseSecond
 	o.ValuePresent("oauth_use_secondary_roles")
 	return o
 }
+
+func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasTagsNotEmpty() *OauthIntegrationForPartnerApplicationsResourceAssert {
+	o.ValuePresent("tags")
+	return o
+}
+
+func (o *OauthIntegrationForPartnerApplicationsResourceAssert) HasTagsAllNotEmpty() *OauthIntegrationForPartnerApplicationsResourceAssert {
+	o.ValuePresent("tags_all")
+	return o
+}
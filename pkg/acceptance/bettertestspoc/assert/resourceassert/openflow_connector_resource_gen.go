@@ -0,0 +1,236 @@
+// Code generated by resource assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type OpenflowConnectorResourceAssert struct {
+	*assert.ResourceAssert
+}
+
+func OpenflowConnectorResource(t *testing.T, name string) *OpenflowConnectorResourceAssert {
+	t.Helper()
+
+	return &OpenflowConnectorResourceAssert{
+		ResourceAssert: assert.NewResourceAssert(name),
+	}
+}
+
+func ImportedOpenflowConnectorResource(t *testing.T, id string) *OpenflowConnectorResourceAssert {
+	t.Helper()
+
+	return &OpenflowConnectorResourceAssert{
+		ResourceAssert: assert.NewImportedResourceAssert(id),
+	}
+}
+
+//////////////////////////////////
+// Attribute typed value checks //
+//////////////////////////////////
+
+func (o *OpenflowConnectorResourceAssert) HasDatabase(expected string) *OpenflowConnectorResourceAssert {
+	o.StringValueSet("database", expected)
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasSchema(expected string) *OpenflowConnectorResourceAssert {
+	o.StringValueSet("schema", expected)
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasName(expected string) *OpenflowConnectorResourceAssert {
+	o.StringValueSet("name", expected)
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasComment(expected string) *OpenflowConnectorResourceAssert {
+	o.StringValueSet("comment", expected)
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasDefinition(expected string) *OpenflowConnectorResourceAssert {
+	o.StringValueSet("definition", expected)
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasDisplayName(expected string) *OpenflowConnectorResourceAssert {
+	o.StringValueSet("display_name", expected)
+	return o
+}
+
+// typed assert for "from" (type: List, subtype: Map) is not currently supported
+
+func (o *OpenflowConnectorResourceAssert) HasFullyQualifiedName(expected string) *OpenflowConnectorResourceAssert {
+	o.StringValueSet("fully_qualified_name", expected)
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasRuntime(expected string) *OpenflowConnectorResourceAssert {
+	o.StringValueSet("runtime", expected)
+	return o
+}
+
+///////////////////////////////////
+// Attribute value string checks //
+///////////////////////////////////
+
+func (o *OpenflowConnectorResourceAssert) HasDatabaseString(expected string) *OpenflowConnectorResourceAssert {
+	o.ValueSet("database", expected)
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasSchemaString(expected string) *OpenflowConnectorResourceAssert {
+	o.ValueSet("schema", expected)
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasNameString(expected string) *OpenflowConnectorResourceAssert {
+	o.ValueSet("name", expected)
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasCommentString(expected string) *OpenflowConnectorResourceAssert {
+	o.ValueSet("comment", expected)
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasDefinitionString(expected string) *OpenflowConnectorResourceAssert {
+	o.ValueSet("definition", expected)
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasDisplayNameString(expected string) *OpenflowConnectorResourceAssert {
+	o.ValueSet("display_name", expected)
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasFullyQualifiedNameString(expected string) *OpenflowConnectorResourceAssert {
+	o.ValueSet("fully_qualified_name", expected)
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasRuntimeString(expected string) *OpenflowConnectorResourceAssert {
+	o.ValueSet("runtime", expected)
+	return o
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (o *OpenflowConnectorResourceAssert) HasNoDatabase() *OpenflowConnectorResourceAssert {
+	o.ValueNotSet("database")
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasNoSchema() *OpenflowConnectorResourceAssert {
+	o.ValueNotSet("schema")
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasNoName() *OpenflowConnectorResourceAssert {
+	o.ValueNotSet("name")
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasNoComment() *OpenflowConnectorResourceAssert {
+	o.ValueNotSet("comment")
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasNoDefinition() *OpenflowConnectorResourceAssert {
+	o.ValueNotSet("definition")
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasNoDisplayName() *OpenflowConnectorResourceAssert {
+	o.ValueNotSet("display_name")
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasNoFullyQualifiedName() *OpenflowConnectorResourceAssert {
+	o.ValueNotSet("fully_qualified_name")
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasNoRuntime() *OpenflowConnectorResourceAssert {
+	o.ValueNotSet("runtime")
+	return o
+}
+
+////////////////////////////
+// Attribute empty checks //
+////////////////////////////
+
+func (o *OpenflowConnectorResourceAssert) HasCommentEmpty() *OpenflowConnectorResourceAssert {
+	o.ValueSet("comment", "")
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasDefinitionEmpty() *OpenflowConnectorResourceAssert {
+	o.ValueSet("definition", "")
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasDisplayNameEmpty() *OpenflowConnectorResourceAssert {
+	o.ValueSet("display_name", "")
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasFromEmpty() *OpenflowConnectorResourceAssert {
+	o.ValueSet("from.#", "0")
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasFullyQualifiedNameEmpty() *OpenflowConnectorResourceAssert {
+	o.ValueSet("fully_qualified_name", "")
+	return o
+}
+
+///////////////////////////////
+// Attribute presence checks //
+///////////////////////////////
+
+func (o *OpenflowConnectorResourceAssert) HasDatabaseNotEmpty() *OpenflowConnectorResourceAssert {
+	o.ValuePresent("database")
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasSchemaNotEmpty() *OpenflowConnectorResourceAssert {
+	o.ValuePresent("schema")
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasNameNotEmpty() *OpenflowConnectorResourceAssert {
+	o.ValuePresent("name")
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasCommentNotEmpty() *OpenflowConnectorResourceAssert {
+	o.ValuePresent("comment")
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasDefinitionNotEmpty() *OpenflowConnectorResourceAssert {
+	o.ValuePresent("definition")
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasDisplayNameNotEmpty() *OpenflowConnectorResourceAssert {
+	o.ValuePresent("display_name")
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasFullyQualifiedNameNotEmpty() *OpenflowConnectorResourceAssert {
+	o.ValuePresent("fully_qualified_name")
+	return o
+}
+
+func (o *OpenflowConnectorResourceAssert) HasRuntimeNotEmpty() *OpenflowConnectorResourceAssert {
+	o.ValuePresent("runtime")
+	return o
+}
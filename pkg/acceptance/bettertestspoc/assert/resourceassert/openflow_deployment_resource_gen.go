@@ -0,0 +1,289 @@
+// Code generated by resource assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type OpenflowDeploymentResourceAssert struct {
+	*assert.ResourceAssert
+}
+
+func OpenflowDeploymentResource(t *testing.T, name string) *OpenflowDeploymentResourceAssert {
+	t.Helper()
+
+	return &OpenflowDeploymentResourceAssert{
+		ResourceAssert: assert.NewResourceAssert(name),
+	}
+}
+
+func ImportedOpenflowDeploymentResource(t *testing.T, id string) *OpenflowDeploymentResourceAssert {
+	t.Helper()
+
+	return &OpenflowDeploymentResourceAssert{
+		ResourceAssert: assert.NewImportedResourceAssert(id),
+	}
+}
+
+//////////////////////////////////
+// Attribute typed value checks //
+//////////////////////////////////
+
+func (o *OpenflowDeploymentResourceAssert) HasName(expected string) *OpenflowDeploymentResourceAssert {
+	o.StringValueSet("name", expected)
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasComment(expected string) *OpenflowDeploymentResourceAssert {
+	o.StringValueSet("comment", expected)
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasCustomIngressHostname(expected string) *OpenflowDeploymentResourceAssert {
+	o.StringValueSet("custom_ingress_hostname", expected)
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasDeploymentType(expected string) *OpenflowDeploymentResourceAssert {
+	o.StringValueSet("deployment_type", expected)
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasDisplayName(expected string) *OpenflowDeploymentResourceAssert {
+	o.StringValueSet("display_name", expected)
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasEventTable(expected string) *OpenflowDeploymentResourceAssert {
+	o.StringValueSet("event_table", expected)
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasFullyQualifiedName(expected string) *OpenflowDeploymentResourceAssert {
+	o.StringValueSet("fully_qualified_name", expected)
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasUsePrivateLink(expected string) *OpenflowDeploymentResourceAssert {
+	o.StringValueSet("use_private_link", expected)
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasUseUserAuthOverPrivatelink(expected string) *OpenflowDeploymentResourceAssert {
+	o.StringValueSet("use_user_auth_over_privatelink", expected)
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasVpcType(expected string) *OpenflowDeploymentResourceAssert {
+	o.StringValueSet("vpc_type", expected)
+	return o
+}
+
+///////////////////////////////////
+// Attribute value string checks //
+///////////////////////////////////
+
+func (o *OpenflowDeploymentResourceAssert) HasNameString(expected string) *OpenflowDeploymentResourceAssert {
+	o.ValueSet("name", expected)
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasCommentString(expected string) *OpenflowDeploymentResourceAssert {
+	o.ValueSet("comment", expected)
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasCustomIngressHostnameString(expected string) *OpenflowDeploymentResourceAssert {
+	o.ValueSet("custom_ingress_hostname", expected)
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasDeploymentTypeString(expected string) *OpenflowDeploymentResourceAssert {
+	o.ValueSet("deployment_type", expected)
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasDisplayNameString(expected string) *OpenflowDeploymentResourceAssert {
+	o.ValueSet("display_name", expected)
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasEventTableString(expected string) *OpenflowDeploymentResourceAssert {
+	o.ValueSet("event_table", expected)
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasFullyQualifiedNameString(expected string) *OpenflowDeploymentResourceAssert {
+	o.ValueSet("fully_qualified_name", expected)
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasUsePrivateLinkString(expected string) *OpenflowDeploymentResourceAssert {
+	o.ValueSet("use_private_link", expected)
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasUseUserAuthOverPrivatelinkString(expected string) *OpenflowDeploymentResourceAssert {
+	o.ValueSet("use_user_auth_over_privatelink", expected)
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasVpcTypeString(expected string) *OpenflowDeploymentResourceAssert {
+	o.ValueSet("vpc_type", expected)
+	return o
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (o *OpenflowDeploymentResourceAssert) HasNoName() *OpenflowDeploymentResourceAssert {
+	o.ValueNotSet("name")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasNoComment() *OpenflowDeploymentResourceAssert {
+	o.ValueNotSet("comment")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasNoCustomIngressHostname() *OpenflowDeploymentResourceAssert {
+	o.ValueNotSet("custom_ingress_hostname")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasNoDeploymentType() *OpenflowDeploymentResourceAssert {
+	o.ValueNotSet("deployment_type")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasNoDisplayName() *OpenflowDeploymentResourceAssert {
+	o.ValueNotSet("display_name")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasNoEventTable() *OpenflowDeploymentResourceAssert {
+	o.ValueNotSet("event_table")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasNoFullyQualifiedName() *OpenflowDeploymentResourceAssert {
+	o.ValueNotSet("fully_qualified_name")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasNoUsePrivateLink() *OpenflowDeploymentResourceAssert {
+	o.ValueNotSet("use_private_link")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasNoUseUserAuthOverPrivatelink() *OpenflowDeploymentResourceAssert {
+	o.ValueNotSet("use_user_auth_over_privatelink")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasNoVpcType() *OpenflowDeploymentResourceAssert {
+	o.ValueNotSet("vpc_type")
+	return o
+}
+
+////////////////////////////
+// Attribute empty checks //
+////////////////////////////
+
+func (o *OpenflowDeploymentResourceAssert) HasCommentEmpty() *OpenflowDeploymentResourceAssert {
+	o.ValueSet("comment", "")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasCustomIngressHostnameEmpty() *OpenflowDeploymentResourceAssert {
+	o.ValueSet("custom_ingress_hostname", "")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasDisplayNameEmpty() *OpenflowDeploymentResourceAssert {
+	o.ValueSet("display_name", "")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasEventTableEmpty() *OpenflowDeploymentResourceAssert {
+	o.ValueSet("event_table", "")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasFullyQualifiedNameEmpty() *OpenflowDeploymentResourceAssert {
+	o.ValueSet("fully_qualified_name", "")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasUsePrivateLinkEmpty() *OpenflowDeploymentResourceAssert {
+	o.ValueSet("use_private_link", "")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasUseUserAuthOverPrivatelinkEmpty() *OpenflowDeploymentResourceAssert {
+	o.ValueSet("use_user_auth_over_privatelink", "")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasVpcTypeEmpty() *OpenflowDeploymentResourceAssert {
+	o.ValueSet("vpc_type", "")
+	return o
+}
+
+///////////////////////////////
+// Attribute presence checks //
+///////////////////////////////
+
+func (o *OpenflowDeploymentResourceAssert) HasNameNotEmpty() *OpenflowDeploymentResourceAssert {
+	o.ValuePresent("name")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasCommentNotEmpty() *OpenflowDeploymentResourceAssert {
+	o.ValuePresent("comment")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasCustomIngressHostnameNotEmpty() *OpenflowDeploymentResourceAssert {
+	o.ValuePresent("custom_ingress_hostname")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasDeploymentTypeNotEmpty() *OpenflowDeploymentResourceAssert {
+	o.ValuePresent("deployment_type")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasDisplayNameNotEmpty() *OpenflowDeploymentResourceAssert {
+	o.ValuePresent("display_name")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasEventTableNotEmpty() *OpenflowDeploymentResourceAssert {
+	o.ValuePresent("event_table")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasFullyQualifiedNameNotEmpty() *OpenflowDeploymentResourceAssert {
+	o.ValuePresent("fully_qualified_name")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasUsePrivateLinkNotEmpty() *OpenflowDeploymentResourceAssert {
+	o.ValuePresent("use_private_link")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasUseUserAuthOverPrivatelinkNotEmpty() *OpenflowDeploymentResourceAssert {
+	o.ValuePresent("use_user_auth_over_privatelink")
+	return o
+}
+
+func (o *OpenflowDeploymentResourceAssert) HasVpcTypeNotEmpty() *OpenflowDeploymentResourceAssert {
+	o.ValuePresent("vpc_type")
+	return o
+}
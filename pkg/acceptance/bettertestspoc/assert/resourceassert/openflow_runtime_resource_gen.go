@@ -0,0 +1,294 @@
+// Code generated by resource assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type OpenflowRuntimeResourceAssert struct {
+	*assert.ResourceAssert
+}
+
+func OpenflowRuntimeResource(t *testing.T, name string) *OpenflowRuntimeResourceAssert {
+	t.Helper()
+
+	return &OpenflowRuntimeResourceAssert{
+		ResourceAssert: assert.NewResourceAssert(name),
+	}
+}
+
+func ImportedOpenflowRuntimeResource(t *testing.T, id string) *OpenflowRuntimeResourceAssert {
+	t.Helper()
+
+	return &OpenflowRuntimeResourceAssert{
+		ResourceAssert: assert.NewImportedResourceAssert(id),
+	}
+}
+
+//////////////////////////////////
+// Attribute typed value checks //
+//////////////////////////////////
+
+func (o *OpenflowRuntimeResourceAssert) HasDatabase(expected string) *OpenflowRuntimeResourceAssert {
+	o.StringValueSet("database", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasSchema(expected string) *OpenflowRuntimeResourceAssert {
+	o.StringValueSet("schema", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasName(expected string) *OpenflowRuntimeResourceAssert {
+	o.StringValueSet("name", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasComment(expected string) *OpenflowRuntimeResourceAssert {
+	o.StringValueSet("comment", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasDeployment(expected string) *OpenflowRuntimeResourceAssert {
+	o.StringValueSet("deployment", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasDisplayName(expected string) *OpenflowRuntimeResourceAssert {
+	o.StringValueSet("display_name", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasExecuteAsRole(expected string) *OpenflowRuntimeResourceAssert {
+	o.StringValueSet("execute_as_role", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasExternalAccessIntegrations(expected ...string) *OpenflowRuntimeResourceAssert {
+	o.SetContainsExactlyStringValues("external_access_integrations", expected...)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasFullyQualifiedName(expected string) *OpenflowRuntimeResourceAssert {
+	o.StringValueSet("fully_qualified_name", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasMaxNodes(expected int) *OpenflowRuntimeResourceAssert {
+	o.IntValueSet("max_nodes", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasMinNodes(expected int) *OpenflowRuntimeResourceAssert {
+	o.IntValueSet("min_nodes", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasNodeType(expected string) *OpenflowRuntimeResourceAssert {
+	o.StringValueSet("node_type", expected)
+	return o
+}
+
+///////////////////////////////////
+// Attribute value string checks //
+///////////////////////////////////
+
+func (o *OpenflowRuntimeResourceAssert) HasDatabaseString(expected string) *OpenflowRuntimeResourceAssert {
+	o.ValueSet("database", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasSchemaString(expected string) *OpenflowRuntimeResourceAssert {
+	o.ValueSet("schema", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasNameString(expected string) *OpenflowRuntimeResourceAssert {
+	o.ValueSet("name", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasCommentString(expected string) *OpenflowRuntimeResourceAssert {
+	o.ValueSet("comment", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasDeploymentString(expected string) *OpenflowRuntimeResourceAssert {
+	o.ValueSet("deployment", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasDisplayNameString(expected string) *OpenflowRuntimeResourceAssert {
+	o.ValueSet("display_name", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasExecuteAsRoleString(expected string) *OpenflowRuntimeResourceAssert {
+	o.ValueSet("execute_as_role", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasFullyQualifiedNameString(expected string) *OpenflowRuntimeResourceAssert {
+	o.ValueSet("fully_qualified_name", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasMaxNodesString(expected string) *OpenflowRuntimeResourceAssert {
+	o.ValueSet("max_nodes", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasMinNodesString(expected string) *OpenflowRuntimeResourceAssert {
+	o.ValueSet("min_nodes", expected)
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasNodeTypeString(expected string) *OpenflowRuntimeResourceAssert {
+	o.ValueSet("node_type", expected)
+	return o
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (o *OpenflowRuntimeResourceAssert) HasNoDatabase() *OpenflowRuntimeResourceAssert {
+	o.ValueNotSet("database")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasNoSchema() *OpenflowRuntimeResourceAssert {
+	o.ValueNotSet("schema")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasNoName() *OpenflowRuntimeResourceAssert {
+	o.ValueNotSet("name")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasNoComment() *OpenflowRuntimeResourceAssert {
+	o.ValueNotSet("comment")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasNoDeployment() *OpenflowRuntimeResourceAssert {
+	o.ValueNotSet("deployment")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasNoDisplayName() *OpenflowRuntimeResourceAssert {
+	o.ValueNotSet("display_name")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasNoExecuteAsRole() *OpenflowRuntimeResourceAssert {
+	o.ValueNotSet("execute_as_role")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasNoFullyQualifiedName() *OpenflowRuntimeResourceAssert {
+	o.ValueNotSet("fully_qualified_name")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasNoMaxNodes() *OpenflowRuntimeResourceAssert {
+	o.ValueNotSet("max_nodes")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasNoMinNodes() *OpenflowRuntimeResourceAssert {
+	o.ValueNotSet("min_nodes")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasNoNodeType() *OpenflowRuntimeResourceAssert {
+	o.ValueNotSet("node_type")
+	return o
+}
+
+////////////////////////////
+// Attribute empty checks //
+////////////////////////////
+
+func (o *OpenflowRuntimeResourceAssert) HasCommentEmpty() *OpenflowRuntimeResourceAssert {
+	o.ValueSet("comment", "")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasDisplayNameEmpty() *OpenflowRuntimeResourceAssert {
+	o.ValueSet("display_name", "")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasExternalAccessIntegrationsEmpty() *OpenflowRuntimeResourceAssert {
+	o.ValueSet("external_access_integrations.#", "0")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasFullyQualifiedNameEmpty() *OpenflowRuntimeResourceAssert {
+	o.ValueSet("fully_qualified_name", "")
+	return o
+}
+
+///////////////////////////////
+// Attribute presence checks //
+///////////////////////////////
+
+func (o *OpenflowRuntimeResourceAssert) HasDatabaseNotEmpty() *OpenflowRuntimeResourceAssert {
+	o.ValuePresent("database")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasSchemaNotEmpty() *OpenflowRuntimeResourceAssert {
+	o.ValuePresent("schema")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasNameNotEmpty() *OpenflowRuntimeResourceAssert {
+	o.ValuePresent("name")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasCommentNotEmpty() *OpenflowRuntimeResourceAssert {
+	o.ValuePresent("comment")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasDeploymentNotEmpty() *OpenflowRuntimeResourceAssert {
+	o.ValuePresent("deployment")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasDisplayNameNotEmpty() *OpenflowRuntimeResourceAssert {
+	o.ValuePresent("display_name")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasExecuteAsRoleNotEmpty() *OpenflowRuntimeResourceAssert {
+	o.ValuePresent("execute_as_role")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasFullyQualifiedNameNotEmpty() *OpenflowRuntimeResourceAssert {
+	o.ValuePresent("fully_qualified_name")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasMaxNodesNotEmpty() *OpenflowRuntimeResourceAssert {
+	o.ValuePresent("max_nodes")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasMinNodesNotEmpty() *OpenflowRuntimeResourceAssert {
+	o.ValuePresent("min_nodes")
+	return o
+}
+
+func (o *OpenflowRuntimeResourceAssert) HasNodeTypeNotEmpty() *OpenflowRuntimeResourceAssert {
+	o.ValuePresent("node_type")
+	return o
+}
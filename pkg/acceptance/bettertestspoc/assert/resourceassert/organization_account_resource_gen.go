@@ -0,0 +1,3479 @@
+// Code generated by resource assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type OrganizationAccountResourceAssert struct {
+	*assert.ResourceAssert
+}
+
+func OrganizationAccountResource(t *testing.T, name string) *OrganizationAccountResourceAssert {
+	t.Helper()
+
+	return &OrganizationAccountResourceAssert{
+		ResourceAssert: assert.NewResourceAssert(name),
+	}
+}
+
+func ImportedOrganizationAccountResource(t *testing.T, id string) *OrganizationAccountResourceAssert {
+	t.Helper()
+
+	return &OrganizationAccountResourceAssert{
+		ResourceAssert: assert.NewImportedResourceAssert(id),
+	}
+}
+
+//////////////////////////////////
+// Attribute typed value checks //
+//////////////////////////////////
+
+func (o *OrganizationAccountResourceAssert) HasName(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("name", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAbortDetachedQuery(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("abort_detached_query", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasActivePythonProfiler(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("active_python_profiler", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAdminName(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("admin_name", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAdminPassword(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("admin_password", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAdminRsaPublicKey(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("admin_rsa_public_key", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAllowClientMfaCaching(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("allow_client_mfa_caching", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAllowIdToken(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("allow_id_token", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAutocommit(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("autocommit", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasBaseLocationPrefix(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("base_location_prefix", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasBinaryInputFormat(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("binary_input_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasBinaryOutputFormat(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("binary_output_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCatalog(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("catalog", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCatalogSync(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("catalog_sync", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientEnableLogInfoStatementParameters(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("client_enable_log_info_statement_parameters", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientEncryptionKeySize(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("client_encryption_key_size", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientMemoryLimit(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("client_memory_limit", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientMetadataRequestUseConnectionCtx(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("client_metadata_request_use_connection_ctx", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientMetadataUseSessionDatabase(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("client_metadata_use_session_database", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientPrefetchThreads(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("client_prefetch_threads", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientResultChunkSize(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("client_result_chunk_size", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientResultColumnCaseInsensitive(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("client_result_column_case_insensitive", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientSessionKeepAlive(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("client_session_keep_alive", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientSessionKeepAliveHeartbeatFrequency(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("client_session_keep_alive_heartbeat_frequency", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientTimestampTypeMapping(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("client_timestamp_type_mapping", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasComment(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("comment", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexCodeCliDailyEstCreditLimitPerUser(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("cortex_code_cli_daily_est_credit_limit_per_user", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexCodeDesktopDailyEstCreditLimitPerUser(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("cortex_code_desktop_daily_est_credit_limit_per_user", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexCodeSnowsightDailyEstCreditLimitPerUser(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("cortex_code_snowsight_daily_est_credit_limit_per_user", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexEnabledCrossRegion(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("cortex_enabled_cross_region", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexModelsAllowlist(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("cortex_models_allowlist", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCsvTimestampFormat(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("csv_timestamp_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDataRetentionTimeInDays(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("data_retention_time_in_days", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDateInputFormat(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("date_input_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDateOutputFormat(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("date_output_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultDdlCollation(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("default_ddl_collation", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultNotebookComputePoolCpu(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("default_notebook_compute_pool_cpu", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultNotebookComputePoolGpu(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("default_notebook_compute_pool_gpu", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultNullOrdering(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("default_null_ordering", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultStreamlitComputePool(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("default_streamlit_compute_pool", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultStreamlitNotebookWarehouse(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("default_streamlit_notebook_warehouse", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDisableUiDownloadButton(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("disable_ui_download_button", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDisableUserPrivilegeGrants(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("disable_user_privilege_grants", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEdition(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("edition", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEmail(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("email", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableAutomaticSensitiveDataClassificationLog(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("enable_automatic_sensitive_data_classification_log", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableEgressCostOptimizer(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("enable_egress_cost_optimizer", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableIdentifierFirstLogin(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("enable_identifier_first_login", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableInternalStagesPrivatelink(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("enable_internal_stages_privatelink", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnablePerAccountAppServicePrivatelinkUrl(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("enable_per_account_app_service_privatelink_url", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableTriSecretAndRekeyOptOutForImageRepository(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("enable_tri_secret_and_rekey_opt_out_for_image_repository", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableTriSecretAndRekeyOptOutForSpcsBlockStorage(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("enable_tri_secret_and_rekey_opt_out_for_spcs_block_storage", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableUnhandledExceptionsReporting(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("enable_unhandled_exceptions_reporting", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableUnloadPhysicalTypeOptimization(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("enable_unload_physical_type_optimization", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableUnredactedQuerySyntaxError(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("enable_unredacted_query_syntax_error", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableUnredactedSecureObjectError(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("enable_unredacted_secure_object_error", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnforceNetworkRulesForInternalStages(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("enforce_network_rules_for_internal_stages", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasErrorOnNondeterministicMerge(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("error_on_nondeterministic_merge", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasErrorOnNondeterministicUpdate(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("error_on_nondeterministic_update", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEventTable(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("event_table", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasExternalOauthAddPrivilegedRolesToBlockedList(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("external_oauth_add_privileged_roles_to_blocked_list", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasExternalVolume(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("external_volume", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasFirstName(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("first_name", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasFullyQualifiedName(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("fully_qualified_name", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasGeographyOutputFormat(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("geography_output_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasGeometryOutputFormat(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("geometry_output_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasHybridTableLockTimeout(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("hybrid_table_lock_timeout", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasInitialReplicationSizeLimitInTb(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("initial_replication_size_limit_in_tb", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJdbcTreatDecimalAsInt(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("jdbc_treat_decimal_as_int", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJdbcTreatTimestampNtzAsUtc(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("jdbc_treat_timestamp_ntz_as_utc", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJdbcUseSessionTimezone(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("jdbc_use_session_timezone", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJsTreatIntegerAsBigint(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("js_treat_integer_as_bigint", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJsonIndent(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("json_indent", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasLastName(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("last_name", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasListingAutoFulfillmentReplicationRefreshSchedule(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("listing_auto_fulfillment_replication_refresh_schedule", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasLockTimeout(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("lock_timeout", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasLogEventLevel(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("log_event_level", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasLogLevel(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("log_level", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMaxConcurrencyLevel(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("max_concurrency_level", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMaxDataExtensionTimeInDays(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("max_data_extension_time_in_days", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMetricLevel(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("metric_level", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMinDataRetentionTimeInDays(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("min_data_retention_time_in_days", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMultiStatementCount(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("multi_statement_count", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMustChangePassword(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("must_change_password", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNetworkPolicy(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("network_policy", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoorderSequenceAsDefault(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("noorder_sequence_as_default", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasOauthAddPrivilegedRolesToBlockedList(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("oauth_add_privileged_roles_to_blocked_list", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasOdbcTreatDecimalAsInt(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("odbc_treat_decimal_as_int", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPasswordPolicy(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("password_policy", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPeriodicDataRekeying(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("periodic_data_rekeying", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPipeExecutionPaused(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("pipe_execution_paused", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPreventUnloadToInlineUrl(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("prevent_unload_to_inline_url", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPreventUnloadToInternalStages(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("prevent_unload_to_internal_stages", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPythonProfilerModules(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("python_profiler_modules", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPythonProfilerTargetStage(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("python_profiler_target_stage", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasQueryTag(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("query_tag", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasQuotedIdentifiersIgnoreCase(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("quoted_identifiers_ignore_case", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRegion(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("region", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRegionGroup(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("region_group", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasReplaceInvalidCharacters(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("replace_invalid_characters", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRequireStorageIntegrationForStageCreation(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("require_storage_integration_for_stage_creation", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRequireStorageIntegrationForStageOperation(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("require_storage_integration_for_stage_operation", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasResourceMonitor(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("resource_monitor", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRowsPerResultset(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("rows_per_resultset", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasS3StageVpceDnsName(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("s3_stage_vpce_dns_name", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSearchPath(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("search_path", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasServerlessTaskMaxStatementSize(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("serverless_task_max_statement_size", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasServerlessTaskMinStatementSize(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("serverless_task_min_statement_size", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSessionPolicy(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("session_policy", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSimulatedDataSharingConsumer(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("simulated_data_sharing_consumer", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSsoLoginPage(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("sso_login_page", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasStatementQueuedTimeoutInSeconds(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("statement_queued_timeout_in_seconds", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasStatementTimeoutInSeconds(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("statement_timeout_in_seconds", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasStorageSerializationPolicy(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("storage_serialization_policy", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasStrictJsonOutput(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("strict_json_output", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSuspendTaskAfterNumFailures(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("suspend_task_after_num_failures", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTaskAutoRetryAttempts(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("task_auto_retry_attempts", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimeInputFormat(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("time_input_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimeOutputFormat(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("time_output_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampDayIsAlways24h(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("timestamp_day_is_always_24h", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampInputFormat(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("timestamp_input_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampLtzOutputFormat(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("timestamp_ltz_output_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampNtzOutputFormat(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("timestamp_ntz_output_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampOutputFormat(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("timestamp_output_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampTypeMapping(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("timestamp_type_mapping", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampTzOutputFormat(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("timestamp_tz_output_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimezone(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("timezone", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTraceLevel(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("trace_level", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTransactionAbortOnError(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("transaction_abort_on_error", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTransactionDefaultIsolationLevel(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("transaction_default_isolation_level", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTwoDigitCenturyStart(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("two_digit_century_start", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUnsupportedDdlAction(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("unsupported_ddl_action", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUseCachedResult(expected bool) *OrganizationAccountResourceAssert {
+	o.BoolValueSet("use_cached_result", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUserTaskManagedInitialWarehouseSize(expected string) *OrganizationAccountResourceAssert {
+	o.StringValueSet("user_task_managed_initial_warehouse_size", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUserTaskMinimumTriggerIntervalInSeconds(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("user_task_minimum_trigger_interval_in_seconds", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUserTaskTimeoutMs(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("user_task_timeout_ms", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasWeekOfYearPolicy(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("week_of_year_policy", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasWeekStart(expected int) *OrganizationAccountResourceAssert {
+	o.IntValueSet("week_start", expected)
+	return o
+}
+
+///////////////////////////////////
+// Attribute value string checks //
+///////////////////////////////////
+
+func (o *OrganizationAccountResourceAssert) HasNameString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("name", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAbortDetachedQueryString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("abort_detached_query", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasActivePythonProfilerString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("active_python_profiler", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAdminNameString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("admin_name", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAdminPasswordString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("admin_password", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAdminRsaPublicKeyString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("admin_rsa_public_key", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAllowClientMfaCachingString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("allow_client_mfa_caching", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAllowIdTokenString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("allow_id_token", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAutocommitString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("autocommit", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasBaseLocationPrefixString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("base_location_prefix", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasBinaryInputFormatString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("binary_input_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasBinaryOutputFormatString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("binary_output_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCatalogString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("catalog", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCatalogSyncString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("catalog_sync", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientEnableLogInfoStatementParametersString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("client_enable_log_info_statement_parameters", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientEncryptionKeySizeString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("client_encryption_key_size", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientMemoryLimitString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("client_memory_limit", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientMetadataRequestUseConnectionCtxString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("client_metadata_request_use_connection_ctx", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientMetadataUseSessionDatabaseString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("client_metadata_use_session_database", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientPrefetchThreadsString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("client_prefetch_threads", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientResultChunkSizeString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("client_result_chunk_size", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientResultColumnCaseInsensitiveString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("client_result_column_case_insensitive", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientSessionKeepAliveString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("client_session_keep_alive", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientSessionKeepAliveHeartbeatFrequencyString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("client_session_keep_alive_heartbeat_frequency", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientTimestampTypeMappingString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("client_timestamp_type_mapping", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCommentString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("comment", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexCodeCliDailyEstCreditLimitPerUserString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("cortex_code_cli_daily_est_credit_limit_per_user", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexCodeDesktopDailyEstCreditLimitPerUserString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("cortex_code_desktop_daily_est_credit_limit_per_user", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexCodeSnowsightDailyEstCreditLimitPerUserString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("cortex_code_snowsight_daily_est_credit_limit_per_user", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexEnabledCrossRegionString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("cortex_enabled_cross_region", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexModelsAllowlistString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("cortex_models_allowlist", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCsvTimestampFormatString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("csv_timestamp_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDataRetentionTimeInDaysString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("data_retention_time_in_days", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDateInputFormatString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("date_input_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDateOutputFormatString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("date_output_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultDdlCollationString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("default_ddl_collation", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultNotebookComputePoolCpuString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("default_notebook_compute_pool_cpu", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultNotebookComputePoolGpuString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("default_notebook_compute_pool_gpu", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultNullOrderingString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("default_null_ordering", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultStreamlitComputePoolString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("default_streamlit_compute_pool", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultStreamlitNotebookWarehouseString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("default_streamlit_notebook_warehouse", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDisableUiDownloadButtonString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("disable_ui_download_button", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDisableUserPrivilegeGrantsString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("disable_user_privilege_grants", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEditionString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("edition", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEmailString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("email", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableAutomaticSensitiveDataClassificationLogString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_automatic_sensitive_data_classification_log", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableEgressCostOptimizerString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_egress_cost_optimizer", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableIdentifierFirstLoginString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_identifier_first_login", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableInternalStagesPrivatelinkString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_internal_stages_privatelink", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnablePerAccountAppServicePrivatelinkUrlString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_per_account_app_service_privatelink_url", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableTriSecretAndRekeyOptOutForImageRepositoryString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_tri_secret_and_rekey_opt_out_for_image_repository", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableTriSecretAndRekeyOptOutForSpcsBlockStorageString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_tri_secret_and_rekey_opt_out_for_spcs_block_storage", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableUnhandledExceptionsReportingString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_unhandled_exceptions_reporting", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableUnloadPhysicalTypeOptimizationString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_unload_physical_type_optimization", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableUnredactedQuerySyntaxErrorString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_unredacted_query_syntax_error", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableUnredactedSecureObjectErrorString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_unredacted_secure_object_error", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnforceNetworkRulesForInternalStagesString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("enforce_network_rules_for_internal_stages", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasErrorOnNondeterministicMergeString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("error_on_nondeterministic_merge", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasErrorOnNondeterministicUpdateString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("error_on_nondeterministic_update", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEventTableString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("event_table", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasExternalOauthAddPrivilegedRolesToBlockedListString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("external_oauth_add_privileged_roles_to_blocked_list", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasExternalVolumeString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("external_volume", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasFirstNameString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("first_name", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasFullyQualifiedNameString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("fully_qualified_name", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasGeographyOutputFormatString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("geography_output_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasGeometryOutputFormatString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("geometry_output_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasHybridTableLockTimeoutString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("hybrid_table_lock_timeout", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasInitialReplicationSizeLimitInTbString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("initial_replication_size_limit_in_tb", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJdbcTreatDecimalAsIntString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("jdbc_treat_decimal_as_int", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJdbcTreatTimestampNtzAsUtcString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("jdbc_treat_timestamp_ntz_as_utc", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJdbcUseSessionTimezoneString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("jdbc_use_session_timezone", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJsTreatIntegerAsBigintString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("js_treat_integer_as_bigint", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJsonIndentString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("json_indent", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasLastNameString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("last_name", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasListingAutoFulfillmentReplicationRefreshScheduleString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("listing_auto_fulfillment_replication_refresh_schedule", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasLockTimeoutString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("lock_timeout", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasLogEventLevelString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("log_event_level", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasLogLevelString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("log_level", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMaxConcurrencyLevelString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("max_concurrency_level", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMaxDataExtensionTimeInDaysString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("max_data_extension_time_in_days", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMetricLevelString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("metric_level", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMinDataRetentionTimeInDaysString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("min_data_retention_time_in_days", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMultiStatementCountString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("multi_statement_count", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMustChangePasswordString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("must_change_password", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNetworkPolicyString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("network_policy", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoorderSequenceAsDefaultString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("noorder_sequence_as_default", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasOauthAddPrivilegedRolesToBlockedListString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("oauth_add_privileged_roles_to_blocked_list", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasOdbcTreatDecimalAsIntString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("odbc_treat_decimal_as_int", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPasswordPolicyString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("password_policy", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPeriodicDataRekeyingString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("periodic_data_rekeying", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPipeExecutionPausedString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("pipe_execution_paused", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPreventUnloadToInlineUrlString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("prevent_unload_to_inline_url", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPreventUnloadToInternalStagesString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("prevent_unload_to_internal_stages", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPythonProfilerModulesString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("python_profiler_modules", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPythonProfilerTargetStageString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("python_profiler_target_stage", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasQueryTagString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("query_tag", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasQuotedIdentifiersIgnoreCaseString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("quoted_identifiers_ignore_case", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRegionString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("region", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRegionGroupString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("region_group", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasReplaceInvalidCharactersString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("replace_invalid_characters", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRequireStorageIntegrationForStageCreationString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("require_storage_integration_for_stage_creation", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRequireStorageIntegrationForStageOperationString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("require_storage_integration_for_stage_operation", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasResourceMonitorString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("resource_monitor", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRowsPerResultsetString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("rows_per_resultset", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasS3StageVpceDnsNameString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("s3_stage_vpce_dns_name", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSearchPathString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("search_path", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasServerlessTaskMaxStatementSizeString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("serverless_task_max_statement_size", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasServerlessTaskMinStatementSizeString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("serverless_task_min_statement_size", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSessionPolicyString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("session_policy", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSimulatedDataSharingConsumerString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("simulated_data_sharing_consumer", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSsoLoginPageString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("sso_login_page", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasStatementQueuedTimeoutInSecondsString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("statement_queued_timeout_in_seconds", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasStatementTimeoutInSecondsString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("statement_timeout_in_seconds", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasStorageSerializationPolicyString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("storage_serialization_policy", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasStrictJsonOutputString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("strict_json_output", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSuspendTaskAfterNumFailuresString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("suspend_task_after_num_failures", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTaskAutoRetryAttemptsString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("task_auto_retry_attempts", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimeInputFormatString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("time_input_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimeOutputFormatString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("time_output_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampDayIsAlways24hString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("timestamp_day_is_always_24h", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampInputFormatString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("timestamp_input_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampLtzOutputFormatString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("timestamp_ltz_output_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampNtzOutputFormatString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("timestamp_ntz_output_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampOutputFormatString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("timestamp_output_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampTypeMappingString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("timestamp_type_mapping", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampTzOutputFormatString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("timestamp_tz_output_format", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimezoneString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("timezone", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTraceLevelString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("trace_level", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTransactionAbortOnErrorString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("transaction_abort_on_error", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTransactionDefaultIsolationLevelString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("transaction_default_isolation_level", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTwoDigitCenturyStartString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("two_digit_century_start", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUnsupportedDdlActionString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("unsupported_ddl_action", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUseCachedResultString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("use_cached_result", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUserTaskManagedInitialWarehouseSizeString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("user_task_managed_initial_warehouse_size", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUserTaskMinimumTriggerIntervalInSecondsString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("user_task_minimum_trigger_interval_in_seconds", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUserTaskTimeoutMsString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("user_task_timeout_ms", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasWeekOfYearPolicyString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("week_of_year_policy", expected)
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasWeekStartString(expected string) *OrganizationAccountResourceAssert {
+	o.ValueSet("week_start", expected)
+	return o
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (o *OrganizationAccountResourceAssert) HasNoName() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("name")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoAbortDetachedQuery() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("abort_detached_query")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoActivePythonProfiler() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("active_python_profiler")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoAdminName() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("admin_name")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoAdminPassword() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("admin_password")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoAdminRsaPublicKey() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("admin_rsa_public_key")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoAllowClientMfaCaching() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("allow_client_mfa_caching")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoAllowIdToken() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("allow_id_token")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoAutocommit() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("autocommit")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoBaseLocationPrefix() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("base_location_prefix")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoBinaryInputFormat() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("binary_input_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoBinaryOutputFormat() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("binary_output_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoCatalog() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("catalog")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoCatalogSync() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("catalog_sync")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoClientEnableLogInfoStatementParameters() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("client_enable_log_info_statement_parameters")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoClientEncryptionKeySize() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("client_encryption_key_size")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoClientMemoryLimit() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("client_memory_limit")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoClientMetadataRequestUseConnectionCtx() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("client_metadata_request_use_connection_ctx")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoClientMetadataUseSessionDatabase() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("client_metadata_use_session_database")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoClientPrefetchThreads() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("client_prefetch_threads")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoClientResultChunkSize() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("client_result_chunk_size")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoClientResultColumnCaseInsensitive() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("client_result_column_case_insensitive")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoClientSessionKeepAlive() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("client_session_keep_alive")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoClientSessionKeepAliveHeartbeatFrequency() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("client_session_keep_alive_heartbeat_frequency")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoClientTimestampTypeMapping() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("client_timestamp_type_mapping")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoComment() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("comment")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoCortexCodeCliDailyEstCreditLimitPerUser() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("cortex_code_cli_daily_est_credit_limit_per_user")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoCortexCodeDesktopDailyEstCreditLimitPerUser() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("cortex_code_desktop_daily_est_credit_limit_per_user")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoCortexCodeSnowsightDailyEstCreditLimitPerUser() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("cortex_code_snowsight_daily_est_credit_limit_per_user")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoCortexEnabledCrossRegion() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("cortex_enabled_cross_region")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoCortexModelsAllowlist() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("cortex_models_allowlist")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoCsvTimestampFormat() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("csv_timestamp_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoDataRetentionTimeInDays() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("data_retention_time_in_days")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoDateInputFormat() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("date_input_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoDateOutputFormat() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("date_output_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoDefaultDdlCollation() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("default_ddl_collation")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoDefaultNotebookComputePoolCpu() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("default_notebook_compute_pool_cpu")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoDefaultNotebookComputePoolGpu() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("default_notebook_compute_pool_gpu")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoDefaultNullOrdering() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("default_null_ordering")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoDefaultStreamlitComputePool() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("default_streamlit_compute_pool")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoDefaultStreamlitNotebookWarehouse() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("default_streamlit_notebook_warehouse")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoDisableUiDownloadButton() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("disable_ui_download_button")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoDisableUserPrivilegeGrants() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("disable_user_privilege_grants")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoEdition() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("edition")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoEmail() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("email")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoEnableAutomaticSensitiveDataClassificationLog() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("enable_automatic_sensitive_data_classification_log")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoEnableEgressCostOptimizer() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("enable_egress_cost_optimizer")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoEnableIdentifierFirstLogin() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("enable_identifier_first_login")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoEnableInternalStagesPrivatelink() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("enable_internal_stages_privatelink")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoEnablePerAccountAppServicePrivatelinkUrl() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("enable_per_account_app_service_privatelink_url")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoEnableTriSecretAndRekeyOptOutForImageRepository() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("enable_tri_secret_and_rekey_opt_out_for_image_repository")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoEnableTriSecretAndRekeyOptOutForSpcsBlockStorage() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("enable_tri_secret_and_rekey_opt_out_for_spcs_block_storage")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoEnableUnhandledExceptionsReporting() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("enable_unhandled_exceptions_reporting")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoEnableUnloadPhysicalTypeOptimization() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("enable_unload_physical_type_optimization")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoEnableUnredactedQuerySyntaxError() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("enable_unredacted_query_syntax_error")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoEnableUnredactedSecureObjectError() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("enable_unredacted_secure_object_error")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoEnforceNetworkRulesForInternalStages() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("enforce_network_rules_for_internal_stages")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoErrorOnNondeterministicMerge() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("error_on_nondeterministic_merge")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoErrorOnNondeterministicUpdate() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("error_on_nondeterministic_update")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoEventTable() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("event_table")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoExternalOauthAddPrivilegedRolesToBlockedList() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("external_oauth_add_privileged_roles_to_blocked_list")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoExternalVolume() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("external_volume")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoFirstName() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("first_name")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoFullyQualifiedName() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("fully_qualified_name")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoGeographyOutputFormat() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("geography_output_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoGeometryOutputFormat() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("geometry_output_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoHybridTableLockTimeout() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("hybrid_table_lock_timeout")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoInitialReplicationSizeLimitInTb() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("initial_replication_size_limit_in_tb")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoJdbcTreatDecimalAsInt() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("jdbc_treat_decimal_as_int")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoJdbcTreatTimestampNtzAsUtc() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("jdbc_treat_timestamp_ntz_as_utc")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoJdbcUseSessionTimezone() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("jdbc_use_session_timezone")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoJsTreatIntegerAsBigint() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("js_treat_integer_as_bigint")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoJsonIndent() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("json_indent")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoLastName() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("last_name")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoListingAutoFulfillmentReplicationRefreshSchedule() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("listing_auto_fulfillment_replication_refresh_schedule")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoLockTimeout() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("lock_timeout")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoLogEventLevel() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("log_event_level")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoLogLevel() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("log_level")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoMaxConcurrencyLevel() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("max_concurrency_level")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoMaxDataExtensionTimeInDays() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("max_data_extension_time_in_days")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoMetricLevel() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("metric_level")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoMinDataRetentionTimeInDays() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("min_data_retention_time_in_days")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoMultiStatementCount() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("multi_statement_count")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoMustChangePassword() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("must_change_password")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoNetworkPolicy() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("network_policy")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoNoorderSequenceAsDefault() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("noorder_sequence_as_default")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoOauthAddPrivilegedRolesToBlockedList() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("oauth_add_privileged_roles_to_blocked_list")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoOdbcTreatDecimalAsInt() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("odbc_treat_decimal_as_int")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoPasswordPolicy() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("password_policy")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoPeriodicDataRekeying() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("periodic_data_rekeying")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoPipeExecutionPaused() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("pipe_execution_paused")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoPreventUnloadToInlineUrl() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("prevent_unload_to_inline_url")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoPreventUnloadToInternalStages() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("prevent_unload_to_internal_stages")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoPythonProfilerModules() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("python_profiler_modules")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoPythonProfilerTargetStage() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("python_profiler_target_stage")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoQueryTag() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("query_tag")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoQuotedIdentifiersIgnoreCase() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("quoted_identifiers_ignore_case")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoRegion() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("region")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoRegionGroup() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("region_group")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoReplaceInvalidCharacters() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("replace_invalid_characters")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoRequireStorageIntegrationForStageCreation() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("require_storage_integration_for_stage_creation")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoRequireStorageIntegrationForStageOperation() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("require_storage_integration_for_stage_operation")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoResourceMonitor() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("resource_monitor")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoRowsPerResultset() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("rows_per_resultset")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoS3StageVpceDnsName() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("s3_stage_vpce_dns_name")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoSearchPath() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("search_path")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoServerlessTaskMaxStatementSize() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("serverless_task_max_statement_size")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoServerlessTaskMinStatementSize() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("serverless_task_min_statement_size")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoSessionPolicy() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("session_policy")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoSimulatedDataSharingConsumer() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("simulated_data_sharing_consumer")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoSsoLoginPage() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("sso_login_page")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoStatementQueuedTimeoutInSeconds() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("statement_queued_timeout_in_seconds")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoStatementTimeoutInSeconds() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("statement_timeout_in_seconds")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoStorageSerializationPolicy() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("storage_serialization_policy")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoStrictJsonOutput() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("strict_json_output")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoSuspendTaskAfterNumFailures() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("suspend_task_after_num_failures")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoTaskAutoRetryAttempts() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("task_auto_retry_attempts")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoTimeInputFormat() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("time_input_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoTimeOutputFormat() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("time_output_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoTimestampDayIsAlways24h() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("timestamp_day_is_always_24h")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoTimestampInputFormat() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("timestamp_input_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoTimestampLtzOutputFormat() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("timestamp_ltz_output_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoTimestampNtzOutputFormat() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("timestamp_ntz_output_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoTimestampOutputFormat() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("timestamp_output_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoTimestampTypeMapping() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("timestamp_type_mapping")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoTimestampTzOutputFormat() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("timestamp_tz_output_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoTimezone() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("timezone")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoTraceLevel() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("trace_level")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoTransactionAbortOnError() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("transaction_abort_on_error")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoTransactionDefaultIsolationLevel() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("transaction_default_isolation_level")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoTwoDigitCenturyStart() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("two_digit_century_start")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoUnsupportedDdlAction() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("unsupported_ddl_action")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoUseCachedResult() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("use_cached_result")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoUserTaskManagedInitialWarehouseSize() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("user_task_managed_initial_warehouse_size")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoUserTaskMinimumTriggerIntervalInSeconds() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("user_task_minimum_trigger_interval_in_seconds")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoUserTaskTimeoutMs() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("user_task_timeout_ms")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoWeekOfYearPolicy() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("week_of_year_policy")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoWeekStart() *OrganizationAccountResourceAssert {
+	o.ValueNotSet("week_start")
+	return o
+}
+
+////////////////////////////
+// Attribute empty checks //
+////////////////////////////
+
+func (o *OrganizationAccountResourceAssert) HasAbortDetachedQueryEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("abort_detached_query", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasActivePythonProfilerEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("active_python_profiler", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAdminPasswordEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("admin_password", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAdminRsaPublicKeyEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("admin_rsa_public_key", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAllowClientMfaCachingEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("allow_client_mfa_caching", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAllowIdTokenEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("allow_id_token", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAutocommitEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("autocommit", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasBaseLocationPrefixEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("base_location_prefix", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasBinaryInputFormatEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("binary_input_format", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasBinaryOutputFormatEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("binary_output_format", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCatalogEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("catalog", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCatalogSyncEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("catalog_sync", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientEnableLogInfoStatementParametersEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("client_enable_log_info_statement_parameters", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientEncryptionKeySizeEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("client_encryption_key_size", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientMemoryLimitEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("client_memory_limit", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientMetadataRequestUseConnectionCtxEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("client_metadata_request_use_connection_ctx", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientMetadataUseSessionDatabaseEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("client_metadata_use_session_database", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientPrefetchThreadsEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("client_prefetch_threads", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientResultChunkSizeEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("client_result_chunk_size", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientResultColumnCaseInsensitiveEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("client_result_column_case_insensitive", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientSessionKeepAliveEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("client_session_keep_alive", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientSessionKeepAliveHeartbeatFrequencyEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("client_session_keep_alive_heartbeat_frequency", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientTimestampTypeMappingEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("client_timestamp_type_mapping", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCommentEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("comment", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexCodeCliDailyEstCreditLimitPerUserEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("cortex_code_cli_daily_est_credit_limit_per_user", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexCodeDesktopDailyEstCreditLimitPerUserEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("cortex_code_desktop_daily_est_credit_limit_per_user", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexCodeSnowsightDailyEstCreditLimitPerUserEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("cortex_code_snowsight_daily_est_credit_limit_per_user", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexEnabledCrossRegionEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("cortex_enabled_cross_region", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexModelsAllowlistEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("cortex_models_allowlist", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCsvTimestampFormatEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("csv_timestamp_format", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDataRetentionTimeInDaysEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("data_retention_time_in_days", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDateInputFormatEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("date_input_format", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDateOutputFormatEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("date_output_format", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultDdlCollationEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("default_ddl_collation", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultNotebookComputePoolCpuEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("default_notebook_compute_pool_cpu", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultNotebookComputePoolGpuEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("default_notebook_compute_pool_gpu", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultNullOrderingEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("default_null_ordering", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultStreamlitComputePoolEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("default_streamlit_compute_pool", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultStreamlitNotebookWarehouseEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("default_streamlit_notebook_warehouse", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDisableUiDownloadButtonEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("disable_ui_download_button", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDisableUserPrivilegeGrantsEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("disable_user_privilege_grants", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableAutomaticSensitiveDataClassificationLogEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_automatic_sensitive_data_classification_log", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableEgressCostOptimizerEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_egress_cost_optimizer", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableIdentifierFirstLoginEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_identifier_first_login", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableInternalStagesPrivatelinkEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_internal_stages_privatelink", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnablePerAccountAppServicePrivatelinkUrlEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_per_account_app_service_privatelink_url", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableTriSecretAndRekeyOptOutForImageRepositoryEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_tri_secret_and_rekey_opt_out_for_image_repository", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableTriSecretAndRekeyOptOutForSpcsBlockStorageEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_tri_secret_and_rekey_opt_out_for_spcs_block_storage", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableUnhandledExceptionsReportingEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_unhandled_exceptions_reporting", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableUnloadPhysicalTypeOptimizationEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_unload_physical_type_optimization", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableUnredactedQuerySyntaxErrorEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_unredacted_query_syntax_error", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableUnredactedSecureObjectErrorEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("enable_unredacted_secure_object_error", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnforceNetworkRulesForInternalStagesEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("enforce_network_rules_for_internal_stages", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasErrorOnNondeterministicMergeEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("error_on_nondeterministic_merge", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasErrorOnNondeterministicUpdateEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("error_on_nondeterministic_update", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEventTableEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("event_table", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasExternalOauthAddPrivilegedRolesToBlockedListEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("external_oauth_add_privileged_roles_to_blocked_list", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasExternalVolumeEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("external_volume", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasFirstNameEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("first_name", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasFullyQualifiedNameEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("fully_qualified_name", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasGeographyOutputFormatEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("geography_output_format", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasGeometryOutputFormatEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("geometry_output_format", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasHybridTableLockTimeoutEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("hybrid_table_lock_timeout", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasInitialReplicationSizeLimitInTbEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("initial_replication_size_limit_in_tb", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJdbcTreatDecimalAsIntEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("jdbc_treat_decimal_as_int", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJdbcTreatTimestampNtzAsUtcEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("jdbc_treat_timestamp_ntz_as_utc", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJdbcUseSessionTimezoneEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("jdbc_use_session_timezone", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJsTreatIntegerAsBigintEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("js_treat_integer_as_bigint", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJsonIndentEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("json_indent", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasLastNameEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("last_name", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasListingAutoFulfillmentReplicationRefreshScheduleEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("listing_auto_fulfillment_replication_refresh_schedule", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasLockTimeoutEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("lock_timeout", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasLogEventLevelEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("log_event_level", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasLogLevelEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("log_level", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMaxConcurrencyLevelEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("max_concurrency_level", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMaxDataExtensionTimeInDaysEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("max_data_extension_time_in_days", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMetricLevelEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("metric_level", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMinDataRetentionTimeInDaysEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("min_data_retention_time_in_days", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMultiStatementCountEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("multi_statement_count", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMustChangePasswordEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("must_change_password", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNetworkPolicyEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("network_policy", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoorderSequenceAsDefaultEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("noorder_sequence_as_default", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasOauthAddPrivilegedRolesToBlockedListEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("oauth_add_privileged_roles_to_blocked_list", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasOdbcTreatDecimalAsIntEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("odbc_treat_decimal_as_int", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPasswordPolicyEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("password_policy", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPeriodicDataRekeyingEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("periodic_data_rekeying", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPipeExecutionPausedEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("pipe_execution_paused", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPreventUnloadToInlineUrlEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("prevent_unload_to_inline_url", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPreventUnloadToInternalStagesEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("prevent_unload_to_internal_stages", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPythonProfilerModulesEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("python_profiler_modules", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPythonProfilerTargetStageEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("python_profiler_target_stage", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasQueryTagEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("query_tag", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasQuotedIdentifiersIgnoreCaseEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("quoted_identifiers_ignore_case", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRegionEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("region", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRegionGroupEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("region_group", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasReplaceInvalidCharactersEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("replace_invalid_characters", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRequireStorageIntegrationForStageCreationEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("require_storage_integration_for_stage_creation", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRequireStorageIntegrationForStageOperationEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("require_storage_integration_for_stage_operation", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasResourceMonitorEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("resource_monitor", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRowsPerResultsetEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("rows_per_resultset", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasS3StageVpceDnsNameEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("s3_stage_vpce_dns_name", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSearchPathEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("search_path", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasServerlessTaskMaxStatementSizeEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("serverless_task_max_statement_size", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasServerlessTaskMinStatementSizeEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("serverless_task_min_statement_size", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSessionPolicyEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("session_policy", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSimulatedDataSharingConsumerEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("simulated_data_sharing_consumer", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSsoLoginPageEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("sso_login_page", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasStatementQueuedTimeoutInSecondsEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("statement_queued_timeout_in_seconds", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasStatementTimeoutInSecondsEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("statement_timeout_in_seconds", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasStorageSerializationPolicyEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("storage_serialization_policy", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasStrictJsonOutputEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("strict_json_output", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSuspendTaskAfterNumFailuresEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("suspend_task_after_num_failures", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTaskAutoRetryAttemptsEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("task_auto_retry_attempts", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimeInputFormatEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("time_input_format", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimeOutputFormatEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("time_output_format", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampDayIsAlways24hEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("timestamp_day_is_always_24h", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampInputFormatEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("timestamp_input_format", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampLtzOutputFormatEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("timestamp_ltz_output_format", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampNtzOutputFormatEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("timestamp_ntz_output_format", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampOutputFormatEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("timestamp_output_format", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampTypeMappingEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("timestamp_type_mapping", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampTzOutputFormatEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("timestamp_tz_output_format", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimezoneEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("timezone", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTraceLevelEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("trace_level", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTransactionAbortOnErrorEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("transaction_abort_on_error", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTransactionDefaultIsolationLevelEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("transaction_default_isolation_level", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTwoDigitCenturyStartEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("two_digit_century_start", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUnsupportedDdlActionEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("unsupported_ddl_action", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUseCachedResultEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("use_cached_result", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUserTaskManagedInitialWarehouseSizeEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("user_task_managed_initial_warehouse_size", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUserTaskMinimumTriggerIntervalInSecondsEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("user_task_minimum_trigger_interval_in_seconds", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUserTaskTimeoutMsEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("user_task_timeout_ms", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasWeekOfYearPolicyEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("week_of_year_policy", "")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasWeekStartEmpty() *OrganizationAccountResourceAssert {
+	o.ValueSet("week_start", "")
+	return o
+}
+
+///////////////////////////////
+// Attribute presence checks //
+///////////////////////////////
+
+func (o *OrganizationAccountResourceAssert) HasNameNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("name")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAbortDetachedQueryNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("abort_detached_query")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasActivePythonProfilerNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("active_python_profiler")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAdminNameNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("admin_name")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAdminPasswordNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("admin_password")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAdminRsaPublicKeyNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("admin_rsa_public_key")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAllowClientMfaCachingNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("allow_client_mfa_caching")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAllowIdTokenNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("allow_id_token")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasAutocommitNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("autocommit")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasBaseLocationPrefixNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("base_location_prefix")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasBinaryInputFormatNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("binary_input_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasBinaryOutputFormatNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("binary_output_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCatalogNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("catalog")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCatalogSyncNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("catalog_sync")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientEnableLogInfoStatementParametersNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("client_enable_log_info_statement_parameters")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientEncryptionKeySizeNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("client_encryption_key_size")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientMemoryLimitNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("client_memory_limit")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientMetadataRequestUseConnectionCtxNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("client_metadata_request_use_connection_ctx")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientMetadataUseSessionDatabaseNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("client_metadata_use_session_database")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientPrefetchThreadsNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("client_prefetch_threads")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientResultChunkSizeNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("client_result_chunk_size")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientResultColumnCaseInsensitiveNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("client_result_column_case_insensitive")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientSessionKeepAliveNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("client_session_keep_alive")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientSessionKeepAliveHeartbeatFrequencyNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("client_session_keep_alive_heartbeat_frequency")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasClientTimestampTypeMappingNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("client_timestamp_type_mapping")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCommentNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("comment")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexCodeCliDailyEstCreditLimitPerUserNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("cortex_code_cli_daily_est_credit_limit_per_user")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexCodeDesktopDailyEstCreditLimitPerUserNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("cortex_code_desktop_daily_est_credit_limit_per_user")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexCodeSnowsightDailyEstCreditLimitPerUserNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("cortex_code_snowsight_daily_est_credit_limit_per_user")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexEnabledCrossRegionNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("cortex_enabled_cross_region")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCortexModelsAllowlistNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("cortex_models_allowlist")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasCsvTimestampFormatNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("csv_timestamp_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDataRetentionTimeInDaysNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("data_retention_time_in_days")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDateInputFormatNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("date_input_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDateOutputFormatNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("date_output_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultDdlCollationNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("default_ddl_collation")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultNotebookComputePoolCpuNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("default_notebook_compute_pool_cpu")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultNotebookComputePoolGpuNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("default_notebook_compute_pool_gpu")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultNullOrderingNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("default_null_ordering")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultStreamlitComputePoolNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("default_streamlit_compute_pool")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDefaultStreamlitNotebookWarehouseNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("default_streamlit_notebook_warehouse")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDisableUiDownloadButtonNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("disable_ui_download_button")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasDisableUserPrivilegeGrantsNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("disable_user_privilege_grants")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEditionNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("edition")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEmailNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("email")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableAutomaticSensitiveDataClassificationLogNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("enable_automatic_sensitive_data_classification_log")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableEgressCostOptimizerNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("enable_egress_cost_optimizer")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableIdentifierFirstLoginNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("enable_identifier_first_login")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableInternalStagesPrivatelinkNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("enable_internal_stages_privatelink")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnablePerAccountAppServicePrivatelinkUrlNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("enable_per_account_app_service_privatelink_url")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableTriSecretAndRekeyOptOutForImageRepositoryNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("enable_tri_secret_and_rekey_opt_out_for_image_repository")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableTriSecretAndRekeyOptOutForSpcsBlockStorageNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("enable_tri_secret_and_rekey_opt_out_for_spcs_block_storage")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableUnhandledExceptionsReportingNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("enable_unhandled_exceptions_reporting")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableUnloadPhysicalTypeOptimizationNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("enable_unload_physical_type_optimization")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableUnredactedQuerySyntaxErrorNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("enable_unredacted_query_syntax_error")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnableUnredactedSecureObjectErrorNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("enable_unredacted_secure_object_error")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEnforceNetworkRulesForInternalStagesNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("enforce_network_rules_for_internal_stages")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasErrorOnNondeterministicMergeNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("error_on_nondeterministic_merge")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasErrorOnNondeterministicUpdateNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("error_on_nondeterministic_update")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasEventTableNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("event_table")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasExternalOauthAddPrivilegedRolesToBlockedListNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("external_oauth_add_privileged_roles_to_blocked_list")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasExternalVolumeNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("external_volume")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasFirstNameNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("first_name")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasFullyQualifiedNameNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("fully_qualified_name")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasGeographyOutputFormatNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("geography_output_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasGeometryOutputFormatNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("geometry_output_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasHybridTableLockTimeoutNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("hybrid_table_lock_timeout")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasInitialReplicationSizeLimitInTbNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("initial_replication_size_limit_in_tb")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJdbcTreatDecimalAsIntNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("jdbc_treat_decimal_as_int")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJdbcTreatTimestampNtzAsUtcNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("jdbc_treat_timestamp_ntz_as_utc")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJdbcUseSessionTimezoneNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("jdbc_use_session_timezone")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJsTreatIntegerAsBigintNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("js_treat_integer_as_bigint")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasJsonIndentNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("json_indent")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasLastNameNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("last_name")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasListingAutoFulfillmentReplicationRefreshScheduleNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("listing_auto_fulfillment_replication_refresh_schedule")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasLockTimeoutNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("lock_timeout")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasLogEventLevelNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("log_event_level")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasLogLevelNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("log_level")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMaxConcurrencyLevelNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("max_concurrency_level")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMaxDataExtensionTimeInDaysNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("max_data_extension_time_in_days")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMetricLevelNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("metric_level")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMinDataRetentionTimeInDaysNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("min_data_retention_time_in_days")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMultiStatementCountNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("multi_statement_count")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasMustChangePasswordNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("must_change_password")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNetworkPolicyNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("network_policy")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasNoorderSequenceAsDefaultNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("noorder_sequence_as_default")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasOauthAddPrivilegedRolesToBlockedListNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("oauth_add_privileged_roles_to_blocked_list")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasOdbcTreatDecimalAsIntNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("odbc_treat_decimal_as_int")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPasswordPolicyNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("password_policy")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPeriodicDataRekeyingNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("periodic_data_rekeying")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPipeExecutionPausedNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("pipe_execution_paused")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPreventUnloadToInlineUrlNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("prevent_unload_to_inline_url")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPreventUnloadToInternalStagesNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("prevent_unload_to_internal_stages")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPythonProfilerModulesNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("python_profiler_modules")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasPythonProfilerTargetStageNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("python_profiler_target_stage")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasQueryTagNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("query_tag")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasQuotedIdentifiersIgnoreCaseNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("quoted_identifiers_ignore_case")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRegionNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("region")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRegionGroupNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("region_group")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasReplaceInvalidCharactersNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("replace_invalid_characters")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRequireStorageIntegrationForStageCreationNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("require_storage_integration_for_stage_creation")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRequireStorageIntegrationForStageOperationNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("require_storage_integration_for_stage_operation")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasResourceMonitorNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("resource_monitor")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasRowsPerResultsetNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("rows_per_resultset")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasS3StageVpceDnsNameNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("s3_stage_vpce_dns_name")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSearchPathNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("search_path")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasServerlessTaskMaxStatementSizeNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("serverless_task_max_statement_size")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasServerlessTaskMinStatementSizeNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("serverless_task_min_statement_size")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSessionPolicyNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("session_policy")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSimulatedDataSharingConsumerNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("simulated_data_sharing_consumer")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSsoLoginPageNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("sso_login_page")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasStatementQueuedTimeoutInSecondsNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("statement_queued_timeout_in_seconds")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasStatementTimeoutInSecondsNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("statement_timeout_in_seconds")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasStorageSerializationPolicyNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("storage_serialization_policy")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasStrictJsonOutputNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("strict_json_output")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasSuspendTaskAfterNumFailuresNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("suspend_task_after_num_failures")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTaskAutoRetryAttemptsNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("task_auto_retry_attempts")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimeInputFormatNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("time_input_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimeOutputFormatNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("time_output_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampDayIsAlways24hNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("timestamp_day_is_always_24h")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampInputFormatNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("timestamp_input_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampLtzOutputFormatNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("timestamp_ltz_output_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampNtzOutputFormatNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("timestamp_ntz_output_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampOutputFormatNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("timestamp_output_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampTypeMappingNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("timestamp_type_mapping")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimestampTzOutputFormatNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("timestamp_tz_output_format")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTimezoneNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("timezone")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTraceLevelNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("trace_level")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTransactionAbortOnErrorNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("transaction_abort_on_error")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTransactionDefaultIsolationLevelNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("transaction_default_isolation_level")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasTwoDigitCenturyStartNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("two_digit_century_start")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUnsupportedDdlActionNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("unsupported_ddl_action")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUseCachedResultNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("use_cached_result")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUserTaskManagedInitialWarehouseSizeNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("user_task_managed_initial_warehouse_size")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUserTaskMinimumTriggerIntervalInSecondsNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("user_task_minimum_trigger_interval_in_seconds")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasUserTaskTimeoutMsNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("user_task_timeout_ms")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasWeekOfYearPolicyNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("week_of_year_policy")
+	return o
+}
+
+func (o *OrganizationAccountResourceAssert) HasWeekStartNotEmpty() *OrganizationAccountResourceAssert {
+	o.ValuePresent("week_start")
+	return o
+}
@@ -92,6 +92,10 @@ func (p *PipeResourceAssert) HasOwner(expected string) *PipeResourceAssert {
 	return p
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -156,6 +160,16 @@ func (p *PipeResourceAssert) HasOwnerString(expected string) *PipeResourceAssert
 	return p
 }
 
+func (p *PipeResourceAssert) HasTagsString(expected string) *PipeResourceAssert {
+	p.ValueSet("tags", expected)
+	return p
+}
+
+func (p *PipeResourceAssert) HasTagsAllString(expected string) *PipeResourceAssert {
+	p.ValueSet("tags_all", expected)
+	return p
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -220,6 +234,16 @@ func (p *PipeResourceAssert) HasNoOwner() *PipeResourceAssert {
 	return p
 }
 
+func (p *PipeResourceAssert) HasNoTags() *PipeResourceAssert {
+	p.ValueNotSet("tags")
+	return p
+}
+
+func (p *PipeResourceAssert) HasNoTagsAll() *PipeResourceAssert {
+	p.ValueNotSet("tags_all")
+	return p
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -264,6 +288,16 @@ func (p *PipeResourceAssert) HasOwnerEmpty() *PipeResourceAssert {
 	return p
 }
 
+func (p *PipeResourceAssert) HasTagsEmpty() *PipeResourceAssert {
+	p.ValueSet("tags", "")
+	return p
+}
+
+func (p *PipeResourceAssert) HasTagsAllEmpty() *PipeResourceAssert {
+	p.ValueSet("tags_all", "")
+	return p
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -327,3 +361,13 @@ func (p *PipeResourceAssert) HasOwnerNotEmpty() *PipeResourceAssert {
 	p.ValuePresent("owner")
 	return p
 }
+
+func (p *PipeResourceAssert) HasTagsNotEmpty() *PipeResourceAssert {
+	p.ValuePresent("tags")
+	return p
+}
+
+func (p *PipeResourceAssert) HasTagsAllNotEmpty() *PipeResourceAssert {
+	p.ValuePresent("tags_all")
+	return p
+}
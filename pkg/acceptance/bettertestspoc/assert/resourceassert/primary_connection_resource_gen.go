@@ -57,6 +57,11 @@ func (p *PrimaryConnectionResourceAssert) HasIsPrimary(expected bool) *PrimaryCo
 	return p
 }
 
+func (p *PrimaryConnectionResourceAssert) HasPrimaryAccount(expected string) *PrimaryConnectionResourceAssert {
+	p.StringValueSet("primary_account", expected)
+	return p
+}
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -81,6 +86,11 @@ func (p *PrimaryConnectionResourceAssert) HasIsPrimaryString(expected string) *P
 	return p
 }
 
+func (p *PrimaryConnectionResourceAssert) HasPrimaryAccountString(expected string) *PrimaryConnectionResourceAssert {
+	p.ValueSet("primary_account", expected)
+	return p
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -105,6 +115,11 @@ func (p *PrimaryConnectionResourceAssert) HasNoIsPrimary() *PrimaryConnectionRes
 	return p
 }
 
+func (p *PrimaryConnectionResourceAssert) HasNoPrimaryAccount() *PrimaryConnectionResourceAssert {
+	p.ValueNotSet("primary_account")
+	return p
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -129,6 +144,11 @@ func (p *PrimaryConnectionResourceAssert) HasIsPrimaryEmpty() *PrimaryConnection
 	return p
 }
 
+func (p *PrimaryConnectionResourceAssert) HasPrimaryAccountEmpty() *PrimaryConnectionResourceAssert {
+	p.ValueSet("primary_account", "")
+	return p
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -152,3 +172,8 @@ func (p *PrimaryConnectionResourceAssert) HasIsPrimaryNotEmpty() *PrimaryConnect
 	p.ValuePresent("is_primary")
 	return p
 }
+
+func (p *PrimaryConnectionResourceAssert) HasPrimaryAccountNotEmpty() *PrimaryConnectionResourceAssert {
+	p.ValuePresent("primary_account")
+	return p
+}
@@ -138,6 +138,10 @@ func (p *ProcedureJavaResourceAssert) HasSnowparkPackage(expected string) *Proce
 	return p
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 // typed assert for "target_path" (type: Set, subtype: Map) is not currently supported
 
 func (p *ProcedureJavaResourceAssert) HasTraceLevel(expected string) *ProcedureJavaResourceAssert {
@@ -239,6 +243,16 @@ func (p *ProcedureJavaResourceAssert) HasSnowparkPackageString(expected string)
 	return p
 }
 
+func (p *ProcedureJavaResourceAssert) HasTagsString(expected string) *ProcedureJavaResourceAssert {
+	p.ValueSet("tags", expected)
+	return p
+}
+
+func (p *ProcedureJavaResourceAssert) HasTagsAllString(expected string) *ProcedureJavaResourceAssert {
+	p.ValueSet("tags_all", expected)
+	return p
+}
+
 func (p *ProcedureJavaResourceAssert) HasTraceLevelString(expected string) *ProcedureJavaResourceAssert {
 	p.ValueSet("trace_level", expected)
 	return p
@@ -338,6 +352,16 @@ func (p *ProcedureJavaResourceAssert) HasNoSnowparkPackage() *ProcedureJavaResou
 	return p
 }
 
+func (p *ProcedureJavaResourceAssert) HasNoTags() *ProcedureJavaResourceAssert {
+	p.ValueNotSet("tags")
+	return p
+}
+
+func (p *ProcedureJavaResourceAssert) HasNoTagsAll() *ProcedureJavaResourceAssert {
+	p.ValueNotSet("tags_all")
+	return p
+}
+
 func (p *ProcedureJavaResourceAssert) HasNoTraceLevel() *ProcedureJavaResourceAssert {
 	p.ValueNotSet("trace_level")
 	return p
@@ -427,6 +451,16 @@ func (p *ProcedureJavaResourceAssert) HasSecretsEmpty() *ProcedureJavaResourceAs
 	return p
 }
 
+func (p *ProcedureJavaResourceAssert) HasTagsEmpty() *ProcedureJavaResourceAssert {
+	p.ValueSet("tags", "")
+	return p
+}
+
+func (p *ProcedureJavaResourceAssert) HasTagsAllEmpty() *ProcedureJavaResourceAssert {
+	p.ValueSet("tags_all", "")
+	return p
+}
+
 func (p *ProcedureJavaResourceAssert) HasTargetPathEmpty() *ProcedureJavaResourceAssert {
 	p.ValueSet("target_path.#", "0")
 	return p
@@ -531,6 +565,16 @@ func (p *ProcedureJavaResourceAssert) HasSnowparkPackageNotEmpty() *ProcedureJav
 	return p
 }
 
+func (p *ProcedureJavaResourceAssert) HasTagsNotEmpty() *ProcedureJavaResourceAssert {
+	p.ValuePresent("tags")
+	return p
+}
+
+func (p *ProcedureJavaResourceAssert) HasTagsAllNotEmpty() *ProcedureJavaResourceAssert {
+	p.ValuePresent("tags_all")
+	return p
+}
+
 func (p *ProcedureJavaResourceAssert) HasTraceLevelNotEmpty() *ProcedureJavaResourceAssert {
 	p.ValuePresent("trace_level")
 	return p
@@ -109,6 +109,10 @@ func (p *ProcedureJavascriptResourceAssert) HasReturnType(expected string) *Proc
 	return p
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (p *ProcedureJavascriptResourceAssert) HasTraceLevel(expected string) *ProcedureJavascriptResourceAssert {
 	p.StringValueSet("trace_level", expected)
 	return p
@@ -193,6 +197,16 @@ func (p *ProcedureJavascriptResourceAssert) HasReturnTypeString(expected string)
 	return p
 }
 
+func (p *ProcedureJavascriptResourceAssert) HasTagsString(expected string) *ProcedureJavascriptResourceAssert {
+	p.ValueSet("tags", expected)
+	return p
+}
+
+func (p *ProcedureJavascriptResourceAssert) HasTagsAllString(expected string) *ProcedureJavascriptResourceAssert {
+	p.ValueSet("tags_all", expected)
+	return p
+}
+
 func (p *ProcedureJavascriptResourceAssert) HasTraceLevelString(expected string) *ProcedureJavascriptResourceAssert {
 	p.ValueSet("trace_level", expected)
 	return p
@@ -277,6 +291,16 @@ func (p *ProcedureJavascriptResourceAssert) HasNoReturnType() *ProcedureJavascri
 	return p
 }
 
+func (p *ProcedureJavascriptResourceAssert) HasNoTags() *ProcedureJavascriptResourceAssert {
+	p.ValueNotSet("tags")
+	return p
+}
+
+func (p *ProcedureJavascriptResourceAssert) HasNoTagsAll() *ProcedureJavascriptResourceAssert {
+	p.ValueNotSet("tags_all")
+	return p
+}
+
 func (p *ProcedureJavascriptResourceAssert) HasNoTraceLevel() *ProcedureJavascriptResourceAssert {
 	p.ValueNotSet("trace_level")
 	return p
@@ -341,6 +365,16 @@ func (p *ProcedureJavascriptResourceAssert) HasProcedureLanguageEmpty() *Procedu
 	return p
 }
 
+func (p *ProcedureJavascriptResourceAssert) HasTagsEmpty() *ProcedureJavascriptResourceAssert {
+	p.ValueSet("tags", "")
+	return p
+}
+
+func (p *ProcedureJavascriptResourceAssert) HasTagsAllEmpty() *ProcedureJavascriptResourceAssert {
+	p.ValueSet("tags_all", "")
+	return p
+}
+
 func (p *ProcedureJavascriptResourceAssert) HasTraceLevelEmpty() *ProcedureJavascriptResourceAssert {
 	p.ValueSet("trace_level", "")
 	return p
@@ -425,6 +459,16 @@ func (p *ProcedureJavascriptResourceAssert) HasReturnTypeNotEmpty() *ProcedureJa
 	return p
 }
 
+func (p *ProcedureJavascriptResourceAssert) HasTagsNotEmpty() *ProcedureJavascriptResourceAssert {
+	p.ValuePresent("tags")
+	return p
+}
+
+func (p *ProcedureJavascriptResourceAssert) HasTagsAllNotEmpty() *ProcedureJavascriptResourceAssert {
+	p.ValuePresent("tags_all")
+	return p
+}
+
 func (p *ProcedureJavascriptResourceAssert) HasTraceLevelNotEmpty() *ProcedureJavascriptResourceAssert {
 	p.ValuePresent("trace_level")
 	return p
@@ -138,6 +138,10 @@ func (p *ProcedurePythonResourceAssert) HasSnowparkPackage(expected string) *Pro
 	return p
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (p *ProcedurePythonResourceAssert) HasTraceLevel(expected string) *ProcedurePythonResourceAssert {
 	p.StringValueSet("trace_level", expected)
 	return p
@@ -237,6 +241,16 @@ func (p *ProcedurePythonResourceAssert) HasSnowparkPackageString(expected string
 	return p
 }
 
+func (p *ProcedurePythonResourceAssert) HasTagsString(expected string) *ProcedurePythonResourceAssert {
+	p.ValueSet("tags", expected)
+	return p
+}
+
+func (p *ProcedurePythonResourceAssert) HasTagsAllString(expected string) *ProcedurePythonResourceAssert {
+	p.ValueSet("tags_all", expected)
+	return p
+}
+
 func (p *ProcedurePythonResourceAssert) HasTraceLevelString(expected string) *ProcedurePythonResourceAssert {
 	p.ValueSet("trace_level", expected)
 	return p
@@ -336,6 +350,16 @@ func (p *ProcedurePythonResourceAssert) HasNoSnowparkPackage() *ProcedurePythonR
 	return p
 }
 
+func (p *ProcedurePythonResourceAssert) HasNoTags() *ProcedurePythonResourceAssert {
+	p.ValueNotSet("tags")
+	return p
+}
+
+func (p *ProcedurePythonResourceAssert) HasNoTagsAll() *ProcedurePythonResourceAssert {
+	p.ValueNotSet("tags_all")
+	return p
+}
+
 func (p *ProcedurePythonResourceAssert) HasNoTraceLevel() *ProcedurePythonResourceAssert {
 	p.ValueNotSet("trace_level")
 	return p
@@ -425,6 +449,16 @@ func (p *ProcedurePythonResourceAssert) HasSecretsEmpty() *ProcedurePythonResour
 	return p
 }
 
+func (p *ProcedurePythonResourceAssert) HasTagsEmpty() *ProcedurePythonResourceAssert {
+	p.ValueSet("tags", "")
+	return p
+}
+
+func (p *ProcedurePythonResourceAssert) HasTagsAllEmpty() *ProcedurePythonResourceAssert {
+	p.ValueSet("tags_all", "")
+	return p
+}
+
 func (p *ProcedurePythonResourceAssert) HasTraceLevelEmpty() *ProcedurePythonResourceAssert {
 	p.ValueSet("trace_level", "")
 	return p
@@ -524,6 +558,16 @@ func (p *ProcedurePythonResourceAssert) HasSnowparkPackageNotEmpty() *ProcedureP
 	return p
 }
 
+func (p *ProcedurePythonResourceAssert) HasTagsNotEmpty() *ProcedurePythonResourceAssert {
+	p.ValuePresent("tags")
+	return p
+}
+
+func (p *ProcedurePythonResourceAssert) HasTagsAllNotEmpty() *ProcedurePythonResourceAssert {
+	p.ValuePresent("tags_all")
+	return p
+}
+
 func (p *ProcedurePythonResourceAssert) HasTraceLevelNotEmpty() *ProcedurePythonResourceAssert {
 	p.ValuePresent("trace_level")
 	return p
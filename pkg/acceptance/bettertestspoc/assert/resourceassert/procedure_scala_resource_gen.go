@@ -138,6 +138,10 @@ func (p *ProcedureScalaResourceAssert) HasSnowparkPackage(expected string) *Proc
 	return p
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 // typed assert for "target_path" (type: Set, subtype: Map) is not currently supported
 
 func (p *ProcedureScalaResourceAssert) HasTraceLevel(expected string) *ProcedureScalaResourceAssert {
@@ -239,6 +243,16 @@ func (p *ProcedureScalaResourceAssert) HasSnowparkPackageString(expected string)
 	return p
 }
 
+func (p *ProcedureScalaResourceAssert) HasTagsString(expected string) *ProcedureScalaResourceAssert {
+	p.ValueSet("tags", expected)
+	return p
+}
+
+func (p *ProcedureScalaResourceAssert) HasTagsAllString(expected string) *ProcedureScalaResourceAssert {
+	p.ValueSet("tags_all", expected)
+	return p
+}
+
 func (p *ProcedureScalaResourceAssert) HasTraceLevelString(expected string) *ProcedureScalaResourceAssert {
 	p.ValueSet("trace_level", expected)
 	return p
@@ -338,6 +352,16 @@ func (p *ProcedureScalaResourceAssert) HasNoSnowparkPackage() *ProcedureScalaRes
 	return p
 }
 
+func (p *ProcedureScalaResourceAssert) HasNoTags() *ProcedureScalaResourceAssert {
+	p.ValueNotSet("tags")
+	return p
+}
+
+func (p *ProcedureScalaResourceAssert) HasNoTagsAll() *ProcedureScalaResourceAssert {
+	p.ValueNotSet("tags_all")
+	return p
+}
+
 func (p *ProcedureScalaResourceAssert) HasNoTraceLevel() *ProcedureScalaResourceAssert {
 	p.ValueNotSet("trace_level")
 	return p
@@ -427,6 +451,16 @@ func (p *ProcedureScalaResourceAssert) HasSecretsEmpty() *ProcedureScalaResource
 	return p
 }
 
+func (p *ProcedureScalaResourceAssert) HasTagsEmpty() *ProcedureScalaResourceAssert {
+	p.ValueSet("tags", "")
+	return p
+}
+
+func (p *ProcedureScalaResourceAssert) HasTagsAllEmpty() *ProcedureScalaResourceAssert {
+	p.ValueSet("tags_all", "")
+	return p
+}
+
 func (p *ProcedureScalaResourceAssert) HasTargetPathEmpty() *ProcedureScalaResourceAssert {
 	p.ValueSet("target_path.#", "0")
 	return p
@@ -531,6 +565,16 @@ func (p *ProcedureScalaResourceAssert) HasSnowparkPackageNotEmpty() *ProcedureSc
 	return p
 }
 
+func (p *ProcedureScalaResourceAssert) HasTagsNotEmpty() *ProcedureScalaResourceAssert {
+	p.ValuePresent("tags")
+	return p
+}
+
+func (p *ProcedureScalaResourceAssert) HasTagsAllNotEmpty() *ProcedureScalaResourceAssert {
+	p.ValuePresent("tags_all")
+	return p
+}
+
 func (p *ProcedureScalaResourceAssert) HasTraceLevelNotEmpty() *ProcedureScalaResourceAssert {
 	p.ValuePresent("trace_level")
 	return p
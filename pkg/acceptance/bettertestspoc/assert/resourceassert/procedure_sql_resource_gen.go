@@ -109,6 +109,10 @@ func (p *ProcedureSqlResourceAssert) HasReturnType(expected string) *ProcedureSq
 	return p
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (p *ProcedureSqlResourceAssert) HasTraceLevel(expected string) *ProcedureSqlResourceAssert {
 	p.StringValueSet("trace_level", expected)
 	return p
@@ -193,6 +197,16 @@ func (p *ProcedureSqlResourceAssert) HasReturnTypeString(expected string) *Proce
 	return p
 }
 
+func (p *ProcedureSqlResourceAssert) HasTagsString(expected string) *ProcedureSqlResourceAssert {
+	p.ValueSet("tags", expected)
+	return p
+}
+
+func (p *ProcedureSqlResourceAssert) HasTagsAllString(expected string) *ProcedureSqlResourceAssert {
+	p.ValueSet("tags_all", expected)
+	return p
+}
+
 func (p *ProcedureSqlResourceAssert) HasTraceLevelString(expected string) *ProcedureSqlResourceAssert {
 	p.ValueSet("trace_level", expected)
 	return p
@@ -277,6 +291,16 @@ func (p *ProcedureSqlResourceAssert) HasNoReturnType() *ProcedureSqlResourceAsse
 	return p
 }
 
+func (p *ProcedureSqlResourceAssert) HasNoTags() *ProcedureSqlResourceAssert {
+	p.ValueNotSet("tags")
+	return p
+}
+
+func (p *ProcedureSqlResourceAssert) HasNoTagsAll() *ProcedureSqlResourceAssert {
+	p.ValueNotSet("tags_all")
+	return p
+}
+
 func (p *ProcedureSqlResourceAssert) HasNoTraceLevel() *ProcedureSqlResourceAssert {
 	p.ValueNotSet("trace_level")
 	return p
@@ -341,6 +365,16 @@ func (p *ProcedureSqlResourceAssert) HasProcedureLanguageEmpty() *ProcedureSqlRe
 	return p
 }
 
+func (p *ProcedureSqlResourceAssert) HasTagsEmpty() *ProcedureSqlResourceAssert {
+	p.ValueSet("tags", "")
+	return p
+}
+
+func (p *ProcedureSqlResourceAssert) HasTagsAllEmpty() *ProcedureSqlResourceAssert {
+	p.ValueSet("tags_all", "")
+	return p
+}
+
 func (p *ProcedureSqlResourceAssert) HasTraceLevelEmpty() *ProcedureSqlResourceAssert {
 	p.ValueSet("trace_level", "")
 	return p
@@ -425,6 +459,16 @@ func (p *ProcedureSqlResourceAssert) HasReturnTypeNotEmpty() *ProcedureSqlResour
 	return p
 }
 
+func (p *ProcedureSqlResourceAssert) HasTagsNotEmpty() *ProcedureSqlResourceAssert {
+	p.ValuePresent("tags")
+	return p
+}
+
+func (p *ProcedureSqlResourceAssert) HasTagsAllNotEmpty() *ProcedureSqlResourceAssert {
+	p.ValuePresent("tags_all")
+	return p
+}
+
 func (p *ProcedureSqlResourceAssert) HasTraceLevelNotEmpty() *ProcedureSqlResourceAssert {
 	p.ValuePresent("trace_level")
 	return p
@@ -0,0 +1,179 @@
+// Code generated by resource assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceassert
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type ProjectionPolicyResourceAssert struct {
+	*assert.ResourceAssert
+}
+
+func ProjectionPolicyResource(t *testing.T, name string) *ProjectionPolicyResourceAssert {
+	t.Helper()
+
+	return &ProjectionPolicyResourceAssert{
+		ResourceAssert: assert.NewResourceAssert(name),
+	}
+}
+
+func ImportedProjectionPolicyResource(t *testing.T, id string) *ProjectionPolicyResourceAssert {
+	t.Helper()
+
+	return &ProjectionPolicyResourceAssert{
+		ResourceAssert: assert.NewImportedResourceAssert(id),
+	}
+}
+
+//////////////////////////////////
+// Attribute typed value checks //
+//////////////////////////////////
+
+func (p *ProjectionPolicyResourceAssert) HasDatabase(expected string) *ProjectionPolicyResourceAssert {
+	p.StringValueSet("database", expected)
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasSchema(expected string) *ProjectionPolicyResourceAssert {
+	p.StringValueSet("schema", expected)
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasName(expected string) *ProjectionPolicyResourceAssert {
+	p.StringValueSet("name", expected)
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasBody(expected string) *ProjectionPolicyResourceAssert {
+	p.StringValueSet("body", expected)
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasComment(expected string) *ProjectionPolicyResourceAssert {
+	p.StringValueSet("comment", expected)
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasFullyQualifiedName(expected string) *ProjectionPolicyResourceAssert {
+	p.StringValueSet("fully_qualified_name", expected)
+	return p
+}
+
+///////////////////////////////////
+// Attribute value string checks //
+///////////////////////////////////
+
+func (p *ProjectionPolicyResourceAssert) HasDatabaseString(expected string) *ProjectionPolicyResourceAssert {
+	p.ValueSet("database", expected)
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasSchemaString(expected string) *ProjectionPolicyResourceAssert {
+	p.ValueSet("schema", expected)
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasNameString(expected string) *ProjectionPolicyResourceAssert {
+	p.ValueSet("name", expected)
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasBodyString(expected string) *ProjectionPolicyResourceAssert {
+	p.ValueSet("body", expected)
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasCommentString(expected string) *ProjectionPolicyResourceAssert {
+	p.ValueSet("comment", expected)
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasFullyQualifiedNameString(expected string) *ProjectionPolicyResourceAssert {
+	p.ValueSet("fully_qualified_name", expected)
+	return p
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (p *ProjectionPolicyResourceAssert) HasNoDatabase() *ProjectionPolicyResourceAssert {
+	p.ValueNotSet("database")
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasNoSchema() *ProjectionPolicyResourceAssert {
+	p.ValueNotSet("schema")
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasNoName() *ProjectionPolicyResourceAssert {
+	p.ValueNotSet("name")
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasNoBody() *ProjectionPolicyResourceAssert {
+	p.ValueNotSet("body")
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasNoComment() *ProjectionPolicyResourceAssert {
+	p.ValueNotSet("comment")
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasNoFullyQualifiedName() *ProjectionPolicyResourceAssert {
+	p.ValueNotSet("fully_qualified_name")
+	return p
+}
+
+////////////////////////////
+// Attribute empty checks //
+////////////////////////////
+
+func (p *ProjectionPolicyResourceAssert) HasCommentEmpty() *ProjectionPolicyResourceAssert {
+	p.ValueSet("comment", "")
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasFullyQualifiedNameEmpty() *ProjectionPolicyResourceAssert {
+	p.ValueSet("fully_qualified_name", "")
+	return p
+}
+
+///////////////////////////////
+// Attribute presence checks //
+///////////////////////////////
+
+func (p *ProjectionPolicyResourceAssert) HasDatabaseNotEmpty() *ProjectionPolicyResourceAssert {
+	p.ValuePresent("database")
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasSchemaNotEmpty() *ProjectionPolicyResourceAssert {
+	p.ValuePresent("schema")
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasNameNotEmpty() *ProjectionPolicyResourceAssert {
+	p.ValuePresent("name")
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasBodyNotEmpty() *ProjectionPolicyResourceAssert {
+	p.ValuePresent("body")
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasCommentNotEmpty() *ProjectionPolicyResourceAssert {
+	p.ValuePresent("comment")
+	return p
+}
+
+func (p *ProjectionPolicyResourceAssert) HasFullyQualifiedNameNotEmpty() *ProjectionPolicyResourceAssert {
+	p.ValuePresent("fully_qualified_name")
+	return p
+}
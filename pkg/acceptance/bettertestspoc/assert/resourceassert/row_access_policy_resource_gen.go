@@ -64,6 +64,10 @@ func (r *RowAccessPolicyResourceAssert) HasFullyQualifiedName(expected string) *
 	return r
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -98,6 +102,16 @@ func (r *RowAccessPolicyResourceAssert) HasFullyQualifiedNameString(expected str
 	return r
 }
 
+func (r *RowAccessPolicyResourceAssert) HasTagsString(expected string) *RowAccessPolicyResourceAssert {
+	r.ValueSet("tags", expected)
+	return r
+}
+
+func (r *RowAccessPolicyResourceAssert) HasTagsAllString(expected string) *RowAccessPolicyResourceAssert {
+	r.ValueSet("tags_all", expected)
+	return r
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -132,6 +146,16 @@ func (r *RowAccessPolicyResourceAssert) HasNoFullyQualifiedName() *RowAccessPoli
 	return r
 }
 
+func (r *RowAccessPolicyResourceAssert) HasNoTags() *RowAccessPolicyResourceAssert {
+	r.ValueNotSet("tags")
+	return r
+}
+
+func (r *RowAccessPolicyResourceAssert) HasNoTagsAll() *RowAccessPolicyResourceAssert {
+	r.ValueNotSet("tags_all")
+	return r
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -146,6 +170,16 @@ func (r *RowAccessPolicyResourceAssert) HasFullyQualifiedNameEmpty() *RowAccessP
 	return r
 }
 
+func (r *RowAccessPolicyResourceAssert) HasTagsEmpty() *RowAccessPolicyResourceAssert {
+	r.ValueSet("tags", "")
+	return r
+}
+
+func (r *RowAccessPolicyResourceAssert) HasTagsAllEmpty() *RowAccessPolicyResourceAssert {
+	r.ValueSet("tags_all", "")
+	return r
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -179,3 +213,13 @@ func (r *RowAccessPolicyResourceAssert) HasFullyQualifiedNameNotEmpty() *RowAcce
 	r.ValuePresent("fully_qualified_name")
 	return r
 }
+
+func (r *RowAccessPolicyResourceAssert) HasTagsNotEmpty() *RowAccessPolicyResourceAssert {
+	r.ValuePresent("tags")
+	return r
+}
+
+func (r *RowAccessPolicyResourceAssert) HasTagsAllNotEmpty() *RowAccessPolicyResourceAssert {
+	r.ValuePresent("tags_all")
+	return r
+}
@@ -122,6 +122,10 @@ func (s *Saml2SecurityIntegrationResourceAssert) HasSaml2X509Cert(expected strin
 	return s
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -206,6 +210,16 @@ func (s *Saml2SecurityIntegrationResourceAssert) HasSaml2X509CertString(expected
 	return s
 }
 
+func (s *Saml2SecurityIntegrationResourceAssert) HasTagsString(expected string) *Saml2SecurityIntegrationResourceAssert {
+	s.ValueSet("tags", expected)
+	return s
+}
+
+func (s *Saml2SecurityIntegrationResourceAssert) HasTagsAllString(expected string) *Saml2SecurityIntegrationResourceAssert {
+	s.ValueSet("tags_all", expected)
+	return s
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -290,6 +304,16 @@ func (s *Saml2SecurityIntegrationResourceAssert) HasNoSaml2X509Cert() *Saml2Secu
 	return s
 }
 
+func (s *Saml2SecurityIntegrationResourceAssert) HasNoTags() *Saml2SecurityIntegrationResourceAssert {
+	s.ValueNotSet("tags")
+	return s
+}
+
+func (s *Saml2SecurityIntegrationResourceAssert) HasNoTagsAll() *Saml2SecurityIntegrationResourceAssert {
+	s.ValueNotSet("tags_all")
+	return s
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -359,6 +383,16 @@ func (s *Saml2SecurityIntegrationResourceAssert) HasSaml2SpInitiatedLoginPageLab
 	return s
 }
 
+func (s *Saml2SecurityIntegrationResourceAssert) HasTagsEmpty() *Saml2SecurityIntegrationResourceAssert {
+	s.ValueSet("tags", "")
+	return s
+}
+
+func (s *Saml2SecurityIntegrationResourceAssert) HasTagsAllEmpty() *Saml2SecurityIntegrationResourceAssert {
+	s.ValueSet("tags_all", "")
+	return s
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -442,3 +476,13 @@ func (s *Saml2SecurityIntegrationResourceAssert) HasSaml2X509CertNotEmpty() *Sam
 	s.ValuePresent("saml2_x509_cert")
 	return s
 }
+
+func (s *Saml2SecurityIntegrationResourceAssert) HasTagsNotEmpty() *Saml2SecurityIntegrationResourceAssert {
+	s.ValuePresent("tags")
+	return s
+}
+
+func (s *Saml2SecurityIntegrationResourceAssert) HasTagsAllNotEmpty() *Saml2SecurityIntegrationResourceAssert {
+	s.ValuePresent("tags_all")
+	return s
+}
@@ -132,6 +132,10 @@ func (s *SchemaResourceAssert) HasSuspendTaskAfterNumFailures(expected int) *Sch
 	return s
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (s *SchemaResourceAssert) HasTaskAutoRetryAttempts(expected int) *SchemaResourceAssert {
 	s.IntValueSet("task_auto_retry_attempts", expected)
 	return s
@@ -266,6 +270,16 @@ func (s *SchemaResourceAssert) HasSuspendTaskAfterNumFailuresString(expected str
 	return s
 }
 
+func (s *SchemaResourceAssert) HasTagsString(expected string) *SchemaResourceAssert {
+	s.ValueSet("tags", expected)
+	return s
+}
+
+func (s *SchemaResourceAssert) HasTagsAllString(expected string) *SchemaResourceAssert {
+	s.ValueSet("tags_all", expected)
+	return s
+}
+
 func (s *SchemaResourceAssert) HasTaskAutoRetryAttemptsString(expected string) *SchemaResourceAssert {
 	s.ValueSet("task_auto_retry_attempts", expected)
 	return s
@@ -400,6 +414,16 @@ func (s *SchemaResourceAssert) HasNoSuspendTaskAfterNumFailures() *SchemaResourc
 	return s
 }
 
+func (s *SchemaResourceAssert) HasNoTags() *SchemaResourceAssert {
+	s.ValueNotSet("tags")
+	return s
+}
+
+func (s *SchemaResourceAssert) HasNoTagsAll() *SchemaResourceAssert {
+	s.ValueNotSet("tags_all")
+	return s
+}
+
 func (s *SchemaResourceAssert) HasNoTaskAutoRetryAttempts() *SchemaResourceAssert {
 	s.ValueNotSet("task_auto_retry_attempts")
 	return s
@@ -524,6 +548,16 @@ func (s *SchemaResourceAssert) HasSuspendTaskAfterNumFailuresEmpty() *SchemaReso
 	return s
 }
 
+func (s *SchemaResourceAssert) HasTagsEmpty() *SchemaResourceAssert {
+	s.ValueSet("tags", "")
+	return s
+}
+
+func (s *SchemaResourceAssert) HasTagsAllEmpty() *SchemaResourceAssert {
+	s.ValueSet("tags_all", "")
+	return s
+}
+
 func (s *SchemaResourceAssert) HasTaskAutoRetryAttemptsEmpty() *SchemaResourceAssert {
 	s.ValueSet("task_auto_retry_attempts", "")
 	return s
@@ -658,6 +692,16 @@ func (s *SchemaResourceAssert) HasSuspendTaskAfterNumFailuresNotEmpty() *SchemaR
 	return s
 }
 
+func (s *SchemaResourceAssert) HasTagsNotEmpty() *SchemaResourceAssert {
+	s.ValuePresent("tags")
+	return s
+}
+
+func (s *SchemaResourceAssert) HasTagsAllNotEmpty() *SchemaResourceAssert {
+	s.ValuePresent("tags_all")
+	return s
+}
+
 func (s *SchemaResourceAssert) HasTaskAutoRetryAttemptsNotEmpty() *SchemaResourceAssert {
 	s.ValuePresent("task_auto_retry_attempts")
 	return s
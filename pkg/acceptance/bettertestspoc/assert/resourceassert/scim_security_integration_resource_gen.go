@@ -72,6 +72,10 @@ func (s *ScimSecurityIntegrationResourceAssert) HasSyncPassword(expected string)
 	return s
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -116,6 +120,16 @@ func (s *ScimSecurityIntegrationResourceAssert) HasSyncPasswordString(expected s
 	return s
 }
 
+func (s *ScimSecurityIntegrationResourceAssert) HasTagsString(expected string) *ScimSecurityIntegrationResourceAssert {
+	s.ValueSet("tags", expected)
+	return s
+}
+
+func (s *ScimSecurityIntegrationResourceAssert) HasTagsAllString(expected string) *ScimSecurityIntegrationResourceAssert {
+	s.ValueSet("tags_all", expected)
+	return s
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -160,6 +174,16 @@ func (s *ScimSecurityIntegrationResourceAssert) HasNoSyncPassword() *ScimSecurit
 	return s
 }
 
+func (s *ScimSecurityIntegrationResourceAssert) HasNoTags() *ScimSecurityIntegrationResourceAssert {
+	s.ValueNotSet("tags")
+	return s
+}
+
+func (s *ScimSecurityIntegrationResourceAssert) HasNoTagsAll() *ScimSecurityIntegrationResourceAssert {
+	s.ValueNotSet("tags_all")
+	return s
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -184,6 +208,16 @@ func (s *ScimSecurityIntegrationResourceAssert) HasSyncPasswordEmpty() *ScimSecu
 	return s
 }
 
+func (s *ScimSecurityIntegrationResourceAssert) HasTagsEmpty() *ScimSecurityIntegrationResourceAssert {
+	s.ValueSet("tags", "")
+	return s
+}
+
+func (s *ScimSecurityIntegrationResourceAssert) HasTagsAllEmpty() *ScimSecurityIntegrationResourceAssert {
+	s.ValueSet("tags_all", "")
+	return s
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -227,3 +261,13 @@ func (s *ScimSecurityIntegrationResourceAssert) HasSyncPasswordNotEmpty() *ScimS
 	s.ValuePresent("sync_password")
 	return s
 }
+
+func (s *ScimSecurityIntegrationResourceAssert) HasTagsNotEmpty() *ScimSecurityIntegrationResourceAssert {
+	s.ValuePresent("tags")
+	return s
+}
+
+func (s *ScimSecurityIntegrationResourceAssert) HasTagsAllNotEmpty() *ScimSecurityIntegrationResourceAssert {
+	s.ValuePresent("tags_all")
+	return s
+}
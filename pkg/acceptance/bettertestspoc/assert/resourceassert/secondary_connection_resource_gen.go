@@ -57,6 +57,11 @@ func (s *SecondaryConnectionResourceAssert) HasIsPrimary(expected bool) *Seconda
 	return s
 }
 
+func (s *SecondaryConnectionResourceAssert) HasPrimaryAccount(expected string) *SecondaryConnectionResourceAssert {
+	s.StringValueSet("primary_account", expected)
+	return s
+}
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -86,6 +91,11 @@ func (s *SecondaryConnectionResourceAssert) HasIsPrimaryString(expected string)
 	return s
 }
 
+func (s *SecondaryConnectionResourceAssert) HasPrimaryAccountString(expected string) *SecondaryConnectionResourceAssert {
+	s.ValueSet("primary_account", expected)
+	return s
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -115,6 +125,11 @@ func (s *SecondaryConnectionResourceAssert) HasNoIsPrimary() *SecondaryConnectio
 	return s
 }
 
+func (s *SecondaryConnectionResourceAssert) HasNoPrimaryAccount() *SecondaryConnectionResourceAssert {
+	s.ValueNotSet("primary_account")
+	return s
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -134,6 +149,11 @@ func (s *SecondaryConnectionResourceAssert) HasIsPrimaryEmpty() *SecondaryConnec
 	return s
 }
 
+func (s *SecondaryConnectionResourceAssert) HasPrimaryAccountEmpty() *SecondaryConnectionResourceAssert {
+	s.ValueSet("primary_account", "")
+	return s
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -162,3 +182,8 @@ func (s *SecondaryConnectionResourceAssert) HasIsPrimaryNotEmpty() *SecondaryCon
 	s.ValuePresent("is_primary")
 	return s
 }
+
+func (s *SecondaryConnectionResourceAssert) HasPrimaryAccountNotEmpty() *SecondaryConnectionResourceAssert {
+	s.ValuePresent("primary_account")
+	return s
+}
@@ -314,6 +314,10 @@ func (s *ServiceUserResourceAssert) HasStrictJsonOutput(expected bool) *ServiceU
 	return s
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (s *ServiceUserResourceAssert) HasTimeInputFormat(expected string) *ServiceUserResourceAssert {
 	s.StringValueSet("time_input_format", expected)
 	return s
@@ -693,6 +697,16 @@ func (s *ServiceUserResourceAssert) HasStrictJsonOutputString(expected string) *
 	return s
 }
 
+func (s *ServiceUserResourceAssert) HasTagsString(expected string) *ServiceUserResourceAssert {
+	s.ValueSet("tags", expected)
+	return s
+}
+
+func (s *ServiceUserResourceAssert) HasTagsAllString(expected string) *ServiceUserResourceAssert {
+	s.ValueSet("tags_all", expected)
+	return s
+}
+
 func (s *ServiceUserResourceAssert) HasTimeInputFormatString(expected string) *ServiceUserResourceAssert {
 	s.ValueSet("time_input_format", expected)
 	return s
@@ -1072,6 +1086,16 @@ func (s *ServiceUserResourceAssert) HasNoStrictJsonOutput() *ServiceUserResource
 	return s
 }
 
+func (s *ServiceUserResourceAssert) HasNoTags() *ServiceUserResourceAssert {
+	s.ValueNotSet("tags")
+	return s
+}
+
+func (s *ServiceUserResourceAssert) HasNoTagsAll() *ServiceUserResourceAssert {
+	s.ValueNotSet("tags_all")
+	return s
+}
+
 func (s *ServiceUserResourceAssert) HasNoTimeInputFormat() *ServiceUserResourceAssert {
 	s.ValueNotSet("time_input_format")
 	return s
@@ -1451,6 +1475,16 @@ func (s *ServiceUserResourceAssert) HasStrictJsonOutputEmpty() *ServiceUserResou
 	return s
 }
 
+func (s *ServiceUserResourceAssert) HasTagsEmpty() *ServiceUserResourceAssert {
+	s.ValueSet("tags", "")
+	return s
+}
+
+func (s *ServiceUserResourceAssert) HasTagsAllEmpty() *ServiceUserResourceAssert {
+	s.ValueSet("tags_all", "")
+	return s
+}
+
 func (s *ServiceUserResourceAssert) HasTimeInputFormatEmpty() *ServiceUserResourceAssert {
 	s.ValueSet("time_input_format", "")
 	return s
@@ -1830,6 +1864,16 @@ func (s *ServiceUserResourceAssert) HasStrictJsonOutputNotEmpty() *ServiceUserRe
 	return s
 }
 
+func (s *ServiceUserResourceAssert) HasTagsNotEmpty() *ServiceUserResourceAssert {
+	s.ValuePresent("tags")
+	return s
+}
+
+func (s *ServiceUserResourceAssert) HasTagsAllNotEmpty() *ServiceUserResourceAssert {
+	s.ValuePresent("tags_all")
+	return s
+}
+
 func (s *ServiceUserResourceAssert) HasTimeInputFormatNotEmpty() *ServiceUserResourceAssert {
 	s.ValuePresent("time_input_format")
 	return s
@@ -99,6 +99,10 @@ func (s *StageResourceAssert) HasStorageIntegration(expected string) *StageResou
 
 // typed assert for "tag" (type: List, subtype: Map) is not currently supported
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (s *StageResourceAssert) HasUrl(expected string) *StageResourceAssert {
 	s.StringValueSet("url", expected)
 	return s
@@ -173,6 +177,16 @@ func (s *StageResourceAssert) HasStorageIntegrationString(expected string) *Stag
 	return s
 }
 
+func (s *StageResourceAssert) HasTagsString(expected string) *StageResourceAssert {
+	s.ValueSet("tags", expected)
+	return s
+}
+
+func (s *StageResourceAssert) HasTagsAllString(expected string) *StageResourceAssert {
+	s.ValueSet("tags_all", expected)
+	return s
+}
+
 func (s *StageResourceAssert) HasUrlString(expected string) *StageResourceAssert {
 	s.ValueSet("url", expected)
 	return s
@@ -247,6 +261,16 @@ func (s *StageResourceAssert) HasNoStorageIntegration() *StageResourceAssert {
 	return s
 }
 
+func (s *StageResourceAssert) HasNoTags() *StageResourceAssert {
+	s.ValueNotSet("tags")
+	return s
+}
+
+func (s *StageResourceAssert) HasNoTagsAll() *StageResourceAssert {
+	s.ValueNotSet("tags_all")
+	return s
+}
+
 func (s *StageResourceAssert) HasNoUrl() *StageResourceAssert {
 	s.ValueNotSet("url")
 	return s
@@ -311,6 +335,16 @@ func (s *StageResourceAssert) HasTagEmpty() *StageResourceAssert {
 	return s
 }
 
+func (s *StageResourceAssert) HasTagsEmpty() *StageResourceAssert {
+	s.ValueSet("tags", "")
+	return s
+}
+
+func (s *StageResourceAssert) HasTagsAllEmpty() *StageResourceAssert {
+	s.ValueSet("tags_all", "")
+	return s
+}
+
 func (s *StageResourceAssert) HasUrlEmpty() *StageResourceAssert {
 	s.ValueSet("url", "")
 	return s
@@ -385,6 +419,16 @@ func (s *StageResourceAssert) HasStorageIntegrationNotEmpty() *StageResourceAsse
 	return s
 }
 
+func (s *StageResourceAssert) HasTagsNotEmpty() *StageResourceAssert {
+	s.ValuePresent("tags")
+	return s
+}
+
+func (s *StageResourceAssert) HasTagsAllNotEmpty() *StageResourceAssert {
+	s.ValuePresent("tags_all")
+	return s
+}
+
 func (s *StageResourceAssert) HasUrlNotEmpty() *StageResourceAssert {
 	s.ValuePresent("url")
 	return s
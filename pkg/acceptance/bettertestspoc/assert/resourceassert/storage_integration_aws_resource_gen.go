@@ -82,6 +82,10 @@ func (s *StorageIntegrationAwsResourceAssert) HasStorageProvider(expected string
 	return s
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (s *StorageIntegrationAwsResourceAssert) HasUsePrivatelinkEndpoint(expected string) *StorageIntegrationAwsResourceAssert {
 	s.StringValueSet("use_privatelink_endpoint", expected)
 	return s
@@ -131,6 +135,16 @@ func (s *StorageIntegrationAwsResourceAssert) HasStorageProviderString(expected
 	return s
 }
 
+func (s *StorageIntegrationAwsResourceAssert) HasTagsString(expected string) *StorageIntegrationAwsResourceAssert {
+	s.ValueSet("tags", expected)
+	return s
+}
+
+func (s *StorageIntegrationAwsResourceAssert) HasTagsAllString(expected string) *StorageIntegrationAwsResourceAssert {
+	s.ValueSet("tags_all", expected)
+	return s
+}
+
 func (s *StorageIntegrationAwsResourceAssert) HasUsePrivatelinkEndpointString(expected string) *StorageIntegrationAwsResourceAssert {
 	s.ValueSet("use_privatelink_endpoint", expected)
 	return s
@@ -180,6 +194,16 @@ func (s *StorageIntegrationAwsResourceAssert) HasNoStorageProvider() *StorageInt
 	return s
 }
 
+func (s *StorageIntegrationAwsResourceAssert) HasNoTags() *StorageIntegrationAwsResourceAssert {
+	s.ValueNotSet("tags")
+	return s
+}
+
+func (s *StorageIntegrationAwsResourceAssert) HasNoTagsAll() *StorageIntegrationAwsResourceAssert {
+	s.ValueNotSet("tags_all")
+	return s
+}
+
 func (s *StorageIntegrationAwsResourceAssert) HasNoUsePrivatelinkEndpoint() *StorageIntegrationAwsResourceAssert {
 	s.ValueNotSet("use_privatelink_endpoint")
 	return s
@@ -214,6 +238,16 @@ func (s *StorageIntegrationAwsResourceAssert) HasStorageBlockedLocationsEmpty()
 	return s
 }
 
+func (s *StorageIntegrationAwsResourceAssert) HasTagsEmpty() *StorageIntegrationAwsResourceAssert {
+	s.ValueSet("tags", "")
+	return s
+}
+
+func (s *StorageIntegrationAwsResourceAssert) HasTagsAllEmpty() *StorageIntegrationAwsResourceAssert {
+	s.ValueSet("tags_all", "")
+	return s
+}
+
 func (s *StorageIntegrationAwsResourceAssert) HasUsePrivatelinkEndpointEmpty() *StorageIntegrationAwsResourceAssert {
 	s.ValueSet("use_privatelink_endpoint", "")
 	return s
@@ -263,6 +297,16 @@ func (s *StorageIntegrationAwsResourceAssert) HasStorageProviderNotEmpty() *Stor
 	return s
 }
 
+func (s *StorageIntegrationAwsResourceAssert) HasTagsNotEmpty() *StorageIntegrationAwsResourceAssert {
+	s.ValuePresent("tags")
+	return s
+}
+
+func (s *StorageIntegrationAwsResourceAssert) HasTagsAllNotEmpty() *StorageIntegrationAwsResourceAssert {
+	s.ValuePresent("tags_all")
+	return s
+}
+
 func (s *StorageIntegrationAwsResourceAssert) HasUsePrivatelinkEndpointNotEmpty() *StorageIntegrationAwsResourceAssert {
 	s.ValuePresent("use_privatelink_endpoint")
 	return s
@@ -67,6 +67,10 @@ func (s *StorageIntegrationAzureResourceAssert) HasStorageBlockedLocations(expec
 	return s
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (s *StorageIntegrationAzureResourceAssert) HasUsePrivatelinkEndpoint(expected string) *StorageIntegrationAzureResourceAssert {
 	s.StringValueSet("use_privatelink_endpoint", expected)
 	return s
@@ -101,6 +105,16 @@ func (s *StorageIntegrationAzureResourceAssert) HasFullyQualifiedNameString(expe
 	return s
 }
 
+func (s *StorageIntegrationAzureResourceAssert) HasTagsString(expected string) *StorageIntegrationAzureResourceAssert {
+	s.ValueSet("tags", expected)
+	return s
+}
+
+func (s *StorageIntegrationAzureResourceAssert) HasTagsAllString(expected string) *StorageIntegrationAzureResourceAssert {
+	s.ValueSet("tags_all", expected)
+	return s
+}
+
 func (s *StorageIntegrationAzureResourceAssert) HasUsePrivatelinkEndpointString(expected string) *StorageIntegrationAzureResourceAssert {
 	s.ValueSet("use_privatelink_endpoint", expected)
 	return s
@@ -135,6 +149,16 @@ func (s *StorageIntegrationAzureResourceAssert) HasNoFullyQualifiedName() *Stora
 	return s
 }
 
+func (s *StorageIntegrationAzureResourceAssert) HasNoTags() *StorageIntegrationAzureResourceAssert {
+	s.ValueNotSet("tags")
+	return s
+}
+
+func (s *StorageIntegrationAzureResourceAssert) HasNoTagsAll() *StorageIntegrationAzureResourceAssert {
+	s.ValueNotSet("tags_all")
+	return s
+}
+
 func (s *StorageIntegrationAzureResourceAssert) HasNoUsePrivatelinkEndpoint() *StorageIntegrationAzureResourceAssert {
 	s.ValueNotSet("use_privatelink_endpoint")
 	return s
@@ -159,6 +183,16 @@ func (s *StorageIntegrationAzureResourceAssert) HasStorageBlockedLocationsEmpty(
 	return s
 }
 
+func (s *StorageIntegrationAzureResourceAssert) HasTagsEmpty() *StorageIntegrationAzureResourceAssert {
+	s.ValueSet("tags", "")
+	return s
+}
+
+func (s *StorageIntegrationAzureResourceAssert) HasTagsAllEmpty() *StorageIntegrationAzureResourceAssert {
+	s.ValueSet("tags_all", "")
+	return s
+}
+
 func (s *StorageIntegrationAzureResourceAssert) HasUsePrivatelinkEndpointEmpty() *StorageIntegrationAzureResourceAssert {
 	s.ValueSet("use_privatelink_endpoint", "")
 	return s
@@ -193,6 +227,16 @@ func (s *StorageIntegrationAzureResourceAssert) HasFullyQualifiedNameNotEmpty()
 	return s
 }
 
+func (s *StorageIntegrationAzureResourceAssert) HasTagsNotEmpty() *StorageIntegrationAzureResourceAssert {
+	s.ValuePresent("tags")
+	return s
+}
+
+func (s *StorageIntegrationAzureResourceAssert) HasTagsAllNotEmpty() *StorageIntegrationAzureResourceAssert {
+	s.ValuePresent("tags_all")
+	return s
+}
+
 func (s *StorageIntegrationAzureResourceAssert) HasUsePrivatelinkEndpointNotEmpty() *StorageIntegrationAzureResourceAssert {
 	s.ValuePresent("use_privatelink_endpoint")
 	return s
@@ -62,6 +62,10 @@ func (s *StorageIntegrationGcsResourceAssert) HasStorageBlockedLocations(expecte
 	return s
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -86,6 +90,16 @@ func (s *StorageIntegrationGcsResourceAssert) HasFullyQualifiedNameString(expect
 	return s
 }
 
+func (s *StorageIntegrationGcsResourceAssert) HasTagsString(expected string) *StorageIntegrationGcsResourceAssert {
+	s.ValueSet("tags", expected)
+	return s
+}
+
+func (s *StorageIntegrationGcsResourceAssert) HasTagsAllString(expected string) *StorageIntegrationGcsResourceAssert {
+	s.ValueSet("tags_all", expected)
+	return s
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -110,6 +124,16 @@ func (s *StorageIntegrationGcsResourceAssert) HasNoFullyQualifiedName() *Storage
 	return s
 }
 
+func (s *StorageIntegrationGcsResourceAssert) HasNoTags() *StorageIntegrationGcsResourceAssert {
+	s.ValueNotSet("tags")
+	return s
+}
+
+func (s *StorageIntegrationGcsResourceAssert) HasNoTagsAll() *StorageIntegrationGcsResourceAssert {
+	s.ValueNotSet("tags_all")
+	return s
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -129,6 +153,16 @@ func (s *StorageIntegrationGcsResourceAssert) HasStorageBlockedLocationsEmpty()
 	return s
 }
 
+func (s *StorageIntegrationGcsResourceAssert) HasTagsEmpty() *StorageIntegrationGcsResourceAssert {
+	s.ValueSet("tags", "")
+	return s
+}
+
+func (s *StorageIntegrationGcsResourceAssert) HasTagsAllEmpty() *StorageIntegrationGcsResourceAssert {
+	s.ValueSet("tags_all", "")
+	return s
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -152,3 +186,13 @@ func (s *StorageIntegrationGcsResourceAssert) HasFullyQualifiedNameNotEmpty() *S
 	s.ValuePresent("fully_qualified_name")
 	return s
 }
+
+func (s *StorageIntegrationGcsResourceAssert) HasTagsNotEmpty() *StorageIntegrationGcsResourceAssert {
+	s.ValuePresent("tags")
+	return s
+}
+
+func (s *StorageIntegrationGcsResourceAssert) HasTagsAllNotEmpty() *StorageIntegrationGcsResourceAssert {
+	s.ValuePresent("tags_all")
+	return s
+}
@@ -112,6 +112,10 @@ func (s *StorageIntegrationResourceAssert) HasStorageProvider(expected string) *
 	return s
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (s *StorageIntegrationResourceAssert) HasType(expected string) *StorageIntegrationResourceAssert {
 	s.StringValueSet("type", expected)
 	return s
@@ -196,6 +200,16 @@ func (s *StorageIntegrationResourceAssert) HasStorageProviderString(expected str
 	return s
 }
 
+func (s *StorageIntegrationResourceAssert) HasTagsString(expected string) *StorageIntegrationResourceAssert {
+	s.ValueSet("tags", expected)
+	return s
+}
+
+func (s *StorageIntegrationResourceAssert) HasTagsAllString(expected string) *StorageIntegrationResourceAssert {
+	s.ValueSet("tags_all", expected)
+	return s
+}
+
 func (s *StorageIntegrationResourceAssert) HasTypeString(expected string) *StorageIntegrationResourceAssert {
 	s.ValueSet("type", expected)
 	return s
@@ -280,6 +294,16 @@ func (s *StorageIntegrationResourceAssert) HasNoStorageProvider() *StorageIntegr
 	return s
 }
 
+func (s *StorageIntegrationResourceAssert) HasNoTags() *StorageIntegrationResourceAssert {
+	s.ValueNotSet("tags")
+	return s
+}
+
+func (s *StorageIntegrationResourceAssert) HasNoTagsAll() *StorageIntegrationResourceAssert {
+	s.ValueNotSet("tags_all")
+	return s
+}
+
 func (s *StorageIntegrationResourceAssert) HasNoType() *StorageIntegrationResourceAssert {
 	s.ValueNotSet("type")
 	return s
@@ -359,6 +383,16 @@ func (s *StorageIntegrationResourceAssert) HasStorageGcpServiceAccountEmpty() *S
 	return s
 }
 
+func (s *StorageIntegrationResourceAssert) HasTagsEmpty() *StorageIntegrationResourceAssert {
+	s.ValueSet("tags", "")
+	return s
+}
+
+func (s *StorageIntegrationResourceAssert) HasTagsAllEmpty() *StorageIntegrationResourceAssert {
+	s.ValueSet("tags_all", "")
+	return s
+}
+
 func (s *StorageIntegrationResourceAssert) HasTypeEmpty() *StorageIntegrationResourceAssert {
 	s.ValueSet("type", "")
 	return s
@@ -443,6 +477,16 @@ func (s *StorageIntegrationResourceAssert) HasStorageProviderNotEmpty() *Storage
 	return s
 }
 
+func (s *StorageIntegrationResourceAssert) HasTagsNotEmpty() *StorageIntegrationResourceAssert {
+	s.ValuePresent("tags")
+	return s
+}
+
+func (s *StorageIntegrationResourceAssert) HasTagsAllNotEmpty() *StorageIntegrationResourceAssert {
+	s.ValuePresent("tags_all")
+	return s
+}
+
 func (s *StorageIntegrationResourceAssert) HasTypeNotEmpty() *StorageIntegrationResourceAssert {
 	s.ValuePresent("type")
 	return s
@@ -77,6 +77,10 @@ func (s *StreamOnDirectoryTableResourceAssert) HasStreamType(expected string) *S
 	return s
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -126,6 +130,16 @@ func (s *StreamOnDirectoryTableResourceAssert) HasStreamTypeString(expected stri
 	return s
 }
 
+func (s *StreamOnDirectoryTableResourceAssert) HasTagsString(expected string) *StreamOnDirectoryTableResourceAssert {
+	s.ValueSet("tags", expected)
+	return s
+}
+
+func (s *StreamOnDirectoryTableResourceAssert) HasTagsAllString(expected string) *StreamOnDirectoryTableResourceAssert {
+	s.ValueSet("tags_all", expected)
+	return s
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -175,6 +189,16 @@ func (s *StreamOnDirectoryTableResourceAssert) HasNoStreamType() *StreamOnDirect
 	return s
 }
 
+func (s *StreamOnDirectoryTableResourceAssert) HasNoTags() *StreamOnDirectoryTableResourceAssert {
+	s.ValueNotSet("tags")
+	return s
+}
+
+func (s *StreamOnDirectoryTableResourceAssert) HasNoTagsAll() *StreamOnDirectoryTableResourceAssert {
+	s.ValueNotSet("tags_all")
+	return s
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -204,6 +228,16 @@ func (s *StreamOnDirectoryTableResourceAssert) HasStreamTypeEmpty() *StreamOnDir
 	return s
 }
 
+func (s *StreamOnDirectoryTableResourceAssert) HasTagsEmpty() *StreamOnDirectoryTableResourceAssert {
+	s.ValueSet("tags", "")
+	return s
+}
+
+func (s *StreamOnDirectoryTableResourceAssert) HasTagsAllEmpty() *StreamOnDirectoryTableResourceAssert {
+	s.ValueSet("tags_all", "")
+	return s
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -252,3 +286,13 @@ func (s *StreamOnDirectoryTableResourceAssert) HasStreamTypeNotEmpty() *StreamOn
 	s.ValuePresent("stream_type")
 	return s
 }
+
+func (s *StreamOnDirectoryTableResourceAssert) HasTagsNotEmpty() *StreamOnDirectoryTableResourceAssert {
+	s.ValuePresent("tags")
+	return s
+}
+
+func (s *StreamOnDirectoryTableResourceAssert) HasTagsAllNotEmpty() *StreamOnDirectoryTableResourceAssert {
+	s.ValuePresent("tags_all")
+	return s
+}
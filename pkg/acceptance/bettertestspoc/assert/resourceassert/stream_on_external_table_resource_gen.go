@@ -86,6 +86,10 @@ func (s *StreamOnExternalTableResourceAssert) HasStreamType(expected string) *St
 	return s
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -140,6 +144,16 @@ func (s *StreamOnExternalTableResourceAssert) HasStreamTypeString(expected strin
 	return s
 }
 
+func (s *StreamOnExternalTableResourceAssert) HasTagsString(expected string) *StreamOnExternalTableResourceAssert {
+	s.ValueSet("tags", expected)
+	return s
+}
+
+func (s *StreamOnExternalTableResourceAssert) HasTagsAllString(expected string) *StreamOnExternalTableResourceAssert {
+	s.ValueSet("tags_all", expected)
+	return s
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -194,6 +208,16 @@ func (s *StreamOnExternalTableResourceAssert) HasNoStreamType() *StreamOnExterna
 	return s
 }
 
+func (s *StreamOnExternalTableResourceAssert) HasNoTags() *StreamOnExternalTableResourceAssert {
+	s.ValueNotSet("tags")
+	return s
+}
+
+func (s *StreamOnExternalTableResourceAssert) HasNoTagsAll() *StreamOnExternalTableResourceAssert {
+	s.ValueNotSet("tags_all")
+	return s
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -238,6 +262,16 @@ func (s *StreamOnExternalTableResourceAssert) HasStreamTypeEmpty() *StreamOnExte
 	return s
 }
 
+func (s *StreamOnExternalTableResourceAssert) HasTagsEmpty() *StreamOnExternalTableResourceAssert {
+	s.ValueSet("tags", "")
+	return s
+}
+
+func (s *StreamOnExternalTableResourceAssert) HasTagsAllEmpty() *StreamOnExternalTableResourceAssert {
+	s.ValueSet("tags_all", "")
+	return s
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -291,3 +325,13 @@ func (s *StreamOnExternalTableResourceAssert) HasStreamTypeNotEmpty() *StreamOnE
 	s.ValuePresent("stream_type")
 	return s
 }
+
+func (s *StreamOnExternalTableResourceAssert) HasTagsNotEmpty() *StreamOnExternalTableResourceAssert {
+	s.ValuePresent("tags")
+	return s
+}
+
+func (s *StreamOnExternalTableResourceAssert) HasTagsAllNotEmpty() *StreamOnExternalTableResourceAssert {
+	s.ValuePresent("tags_all")
+	return s
+}
@@ -91,6 +91,10 @@ func (s *StreamOnTableResourceAssert) HasTable(expected string) *StreamOnTableRe
 	return s
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -150,6 +154,16 @@ func (s *StreamOnTableResourceAssert) HasTableString(expected string) *StreamOnT
 	return s
 }
 
+func (s *StreamOnTableResourceAssert) HasTagsString(expected string) *StreamOnTableResourceAssert {
+	s.ValueSet("tags", expected)
+	return s
+}
+
+func (s *StreamOnTableResourceAssert) HasTagsAllString(expected string) *StreamOnTableResourceAssert {
+	s.ValueSet("tags_all", expected)
+	return s
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -209,6 +223,16 @@ func (s *StreamOnTableResourceAssert) HasNoTable() *StreamOnTableResourceAssert
 	return s
 }
 
+func (s *StreamOnTableResourceAssert) HasNoTags() *StreamOnTableResourceAssert {
+	s.ValueNotSet("tags")
+	return s
+}
+
+func (s *StreamOnTableResourceAssert) HasNoTagsAll() *StreamOnTableResourceAssert {
+	s.ValueNotSet("tags_all")
+	return s
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -258,6 +282,16 @@ func (s *StreamOnTableResourceAssert) HasStreamTypeEmpty() *StreamOnTableResourc
 	return s
 }
 
+func (s *StreamOnTableResourceAssert) HasTagsEmpty() *StreamOnTableResourceAssert {
+	s.ValueSet("tags", "")
+	return s
+}
+
+func (s *StreamOnTableResourceAssert) HasTagsAllEmpty() *StreamOnTableResourceAssert {
+	s.ValueSet("tags_all", "")
+	return s
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -316,3 +350,13 @@ func (s *StreamOnTableResourceAssert) HasTableNotEmpty() *StreamOnTableResourceA
 	s.ValuePresent("table")
 	return s
 }
+
+func (s *StreamOnTableResourceAssert) HasTagsNotEmpty() *StreamOnTableResourceAssert {
+	s.ValuePresent("tags")
+	return s
+}
+
+func (s *StreamOnTableResourceAssert) HasTagsAllNotEmpty() *StreamOnTableResourceAssert {
+	s.ValuePresent("tags_all")
+	return s
+}
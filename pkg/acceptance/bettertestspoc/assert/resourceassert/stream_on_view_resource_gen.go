@@ -86,6 +86,10 @@ func (s *StreamOnViewResourceAssert) HasStreamType(expected string) *StreamOnVie
 	return s
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (s *StreamOnViewResourceAssert) HasView(expected string) *StreamOnViewResourceAssert {
 	s.StringValueSet("view", expected)
 	return s
@@ -145,6 +149,16 @@ func (s *StreamOnViewResourceAssert) HasStreamTypeString(expected string) *Strea
 	return s
 }
 
+func (s *StreamOnViewResourceAssert) HasTagsString(expected string) *StreamOnViewResourceAssert {
+	s.ValueSet("tags", expected)
+	return s
+}
+
+func (s *StreamOnViewResourceAssert) HasTagsAllString(expected string) *StreamOnViewResourceAssert {
+	s.ValueSet("tags_all", expected)
+	return s
+}
+
 func (s *StreamOnViewResourceAssert) HasViewString(expected string) *StreamOnViewResourceAssert {
 	s.ValueSet("view", expected)
 	return s
@@ -204,6 +218,16 @@ func (s *StreamOnViewResourceAssert) HasNoStreamType() *StreamOnViewResourceAsse
 	return s
 }
 
+func (s *StreamOnViewResourceAssert) HasNoTags() *StreamOnViewResourceAssert {
+	s.ValueNotSet("tags")
+	return s
+}
+
+func (s *StreamOnViewResourceAssert) HasNoTagsAll() *StreamOnViewResourceAssert {
+	s.ValueNotSet("tags_all")
+	return s
+}
+
 func (s *StreamOnViewResourceAssert) HasNoView() *StreamOnViewResourceAssert {
 	s.ValueNotSet("view")
 	return s
@@ -258,6 +282,16 @@ func (s *StreamOnViewResourceAssert) HasStreamTypeEmpty() *StreamOnViewResourceA
 	return s
 }
 
+func (s *StreamOnViewResourceAssert) HasTagsEmpty() *StreamOnViewResourceAssert {
+	s.ValueSet("tags", "")
+	return s
+}
+
+func (s *StreamOnViewResourceAssert) HasTagsAllEmpty() *StreamOnViewResourceAssert {
+	s.ValueSet("tags_all", "")
+	return s
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -312,6 +346,16 @@ func (s *StreamOnViewResourceAssert) HasStreamTypeNotEmpty() *StreamOnViewResour
 	return s
 }
 
+func (s *StreamOnViewResourceAssert) HasTagsNotEmpty() *StreamOnViewResourceAssert {
+	s.ValuePresent("tags")
+	return s
+}
+
+func (s *StreamOnViewResourceAssert) HasTagsAllNotEmpty() *StreamOnViewResourceAssert {
+	s.ValuePresent("tags_all")
+	return s
+}
+
 func (s *StreamOnViewResourceAssert) HasViewNotEmpty() *StreamOnViewResourceAssert {
 	s.ValuePresent("view")
 	return s
@@ -47,6 +47,8 @@ func (t *TableResourceAssert) HasName(expected string) *TableResourceAssert {
 	return t
 }
 
+// typed assert for "aggregation_policy" (type: List, subtype: Map) is not currently supported
+
 func (t *TableResourceAssert) HasChangeTracking(expected bool) *TableResourceAssert {
 	t.BoolValueSet("change_tracking", expected)
 	return t
@@ -74,6 +76,8 @@ func (t *TableResourceAssert) HasFullyQualifiedName(expected string) *TableResou
 	return t
 }
 
+// typed assert for "join_policy" (type: List, subtype: Map) is not currently supported
+
 func (t *TableResourceAssert) HasOwner(expected string) *TableResourceAssert {
 	t.StringValueSet("owner", expected)
 	return t
@@ -81,8 +85,14 @@ func (t *TableResourceAssert) HasOwner(expected string) *TableResourceAssert {
 
 // typed assert for "primary_key" (type: List, subtype: Map) is not currently supported
 
+// typed assert for "row_access_policy" (type: List, subtype: Map) is not currently supported
+
 // typed assert for "tag" (type: List, subtype: Map) is not currently supported
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -127,6 +137,16 @@ func (t *TableResourceAssert) HasOwnerString(expected string) *TableResourceAsse
 	return t
 }
 
+func (t *TableResourceAssert) HasTagsString(expected string) *TableResourceAssert {
+	t.ValueSet("tags", expected)
+	return t
+}
+
+func (t *TableResourceAssert) HasTagsAllString(expected string) *TableResourceAssert {
+	t.ValueSet("tags_all", expected)
+	return t
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -171,10 +191,25 @@ func (t *TableResourceAssert) HasNoOwner() *TableResourceAssert {
 	return t
 }
 
+func (t *TableResourceAssert) HasNoTags() *TableResourceAssert {
+	t.ValueNotSet("tags")
+	return t
+}
+
+func (t *TableResourceAssert) HasNoTagsAll() *TableResourceAssert {
+	t.ValueNotSet("tags_all")
+	return t
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
 
+func (t *TableResourceAssert) HasAggregationPolicyEmpty() *TableResourceAssert {
+	t.ValueSet("aggregation_policy.#", "0")
+	return t
+}
+
 func (t *TableResourceAssert) HasChangeTrackingEmpty() *TableResourceAssert {
 	t.ValueSet("change_tracking", "")
 	return t
@@ -200,6 +235,11 @@ func (t *TableResourceAssert) HasFullyQualifiedNameEmpty() *TableResourceAssert
 	return t
 }
 
+func (t *TableResourceAssert) HasJoinPolicyEmpty() *TableResourceAssert {
+	t.ValueSet("join_policy.#", "0")
+	return t
+}
+
 func (t *TableResourceAssert) HasOwnerEmpty() *TableResourceAssert {
 	t.ValueSet("owner", "")
 	return t
@@ -210,11 +250,26 @@ func (t *TableResourceAssert) HasPrimaryKeyEmpty() *TableResourceAssert {
 	return t
 }
 
+func (t *TableResourceAssert) HasRowAccessPolicyEmpty() *TableResourceAssert {
+	t.ValueSet("row_access_policy.#", "0")
+	return t
+}
+
 func (t *TableResourceAssert) HasTagEmpty() *TableResourceAssert {
 	t.ValueSet("tag.#", "0")
 	return t
 }
 
+func (t *TableResourceAssert) HasTagsEmpty() *TableResourceAssert {
+	t.ValueSet("tags", "")
+	return t
+}
+
+func (t *TableResourceAssert) HasTagsAllEmpty() *TableResourceAssert {
+	t.ValueSet("tags_all", "")
+	return t
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -258,3 +313,13 @@ func (t *TableResourceAssert) HasOwnerNotEmpty() *TableResourceAssert {
 	t.ValuePresent("owner")
 	return t
 }
+
+func (t *TableResourceAssert) HasTagsNotEmpty() *TableResourceAssert {
+	t.ValuePresent("tags")
+	return t
+}
+
+func (t *TableResourceAssert) HasTagsAllNotEmpty() *TableResourceAssert {
+	t.ValuePresent("tags_all")
+	return t
+}
@@ -289,6 +289,10 @@ func (t *TaskResourceAssert) HasSuspendTaskAfterNumFailures(expected int) *TaskR
 	return t
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 // typed assert for "target_completion_interval" (type: List, subtype: Map) is not currently supported
 
 func (t *TaskResourceAssert) HasTaskAutoRetryAttempts(expected int) *TaskResourceAssert {
@@ -665,6 +669,16 @@ func (t *TaskResourceAssert) HasSuspendTaskAfterNumFailuresString(expected strin
 	return t
 }
 
+func (t *TaskResourceAssert) HasTagsString(expected string) *TaskResourceAssert {
+	t.ValueSet("tags", expected)
+	return t
+}
+
+func (t *TaskResourceAssert) HasTagsAllString(expected string) *TaskResourceAssert {
+	t.ValueSet("tags_all", expected)
+	return t
+}
+
 func (t *TaskResourceAssert) HasTaskAutoRetryAttemptsString(expected string) *TaskResourceAssert {
 	t.ValueSet("task_auto_retry_attempts", expected)
 	return t
@@ -1039,6 +1053,16 @@ func (t *TaskResourceAssert) HasNoSuspendTaskAfterNumFailures() *TaskResourceAss
 	return t
 }
 
+func (t *TaskResourceAssert) HasNoTags() *TaskResourceAssert {
+	t.ValueNotSet("tags")
+	return t
+}
+
+func (t *TaskResourceAssert) HasNoTagsAll() *TaskResourceAssert {
+	t.ValueNotSet("tags_all")
+	return t
+}
+
 func (t *TaskResourceAssert) HasNoTaskAutoRetryAttempts() *TaskResourceAssert {
 	t.ValueNotSet("task_auto_retry_attempts")
 	return t
@@ -1398,6 +1422,16 @@ func (t *TaskResourceAssert) HasSuspendTaskAfterNumFailuresEmpty() *TaskResource
 	return t
 }
 
+func (t *TaskResourceAssert) HasTagsEmpty() *TaskResourceAssert {
+	t.ValueSet("tags", "")
+	return t
+}
+
+func (t *TaskResourceAssert) HasTagsAllEmpty() *TaskResourceAssert {
+	t.ValueSet("tags_all", "")
+	return t
+}
+
 func (t *TaskResourceAssert) HasTargetCompletionIntervalEmpty() *TaskResourceAssert {
 	t.ValueSet("target_completion_interval.#", "0")
 	return t
@@ -1777,6 +1811,16 @@ func (t *TaskResourceAssert) HasSuspendTaskAfterNumFailuresNotEmpty() *TaskResou
 	return t
 }
 
+func (t *TaskResourceAssert) HasTagsNotEmpty() *TaskResourceAssert {
+	t.ValuePresent("tags")
+	return t
+}
+
+func (t *TaskResourceAssert) HasTagsAllNotEmpty() *TaskResourceAssert {
+	t.ValuePresent("tags_all")
+	return t
+}
+
 func (t *TaskResourceAssert) HasTaskAutoRetryAttemptsNotEmpty() *TaskResourceAssert {
 	t.ValuePresent("task_auto_retry_attempts")
 	return t
@@ -347,6 +347,10 @@ func (u *UserResourceAssert) HasStrictJsonOutput(expected bool) *UserResourceAss
 	return u
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (u *UserResourceAssert) HasTimeInputFormat(expected string) *UserResourceAssert {
 	u.StringValueSet("time_input_format", expected)
 	return u
@@ -761,6 +765,16 @@ func (u *UserResourceAssert) HasStrictJsonOutputString(expected string) *UserRes
 	return u
 }
 
+func (u *UserResourceAssert) HasTagsString(expected string) *UserResourceAssert {
+	u.ValueSet("tags", expected)
+	return u
+}
+
+func (u *UserResourceAssert) HasTagsAllString(expected string) *UserResourceAssert {
+	u.ValueSet("tags_all", expected)
+	return u
+}
+
 func (u *UserResourceAssert) HasTimeInputFormatString(expected string) *UserResourceAssert {
 	u.ValueSet("time_input_format", expected)
 	return u
@@ -1175,6 +1189,16 @@ func (u *UserResourceAssert) HasNoStrictJsonOutput() *UserResourceAssert {
 	return u
 }
 
+func (u *UserResourceAssert) HasNoTags() *UserResourceAssert {
+	u.ValueNotSet("tags")
+	return u
+}
+
+func (u *UserResourceAssert) HasNoTagsAll() *UserResourceAssert {
+	u.ValueNotSet("tags_all")
+	return u
+}
+
 func (u *UserResourceAssert) HasNoTimeInputFormat() *UserResourceAssert {
 	u.ValueNotSet("time_input_format")
 	return u
@@ -1584,6 +1608,16 @@ func (u *UserResourceAssert) HasStrictJsonOutputEmpty() *UserResourceAssert {
 	return u
 }
 
+func (u *UserResourceAssert) HasTagsEmpty() *UserResourceAssert {
+	u.ValueSet("tags", "")
+	return u
+}
+
+func (u *UserResourceAssert) HasTagsAllEmpty() *UserResourceAssert {
+	u.ValueSet("tags_all", "")
+	return u
+}
+
 func (u *UserResourceAssert) HasTimeInputFormatEmpty() *UserResourceAssert {
 	u.ValueSet("time_input_format", "")
 	return u
@@ -1998,6 +2032,16 @@ func (u *UserResourceAssert) HasStrictJsonOutputNotEmpty() *UserResourceAssert {
 	return u
 }
 
+func (u *UserResourceAssert) HasTagsNotEmpty() *UserResourceAssert {
+	u.ValuePresent("tags")
+	return u
+}
+
+func (u *UserResourceAssert) HasTagsAllNotEmpty() *UserResourceAssert {
+	u.ValuePresent("tags_all")
+	return u
+}
+
 func (u *UserResourceAssert) HasTimeInputFormatNotEmpty() *UserResourceAssert {
 	u.ValuePresent("time_input_format")
 	return u
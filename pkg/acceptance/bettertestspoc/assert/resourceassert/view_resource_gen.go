@@ -97,6 +97,10 @@ func (v *ViewResourceAssert) HasStatement(expected string) *ViewResourceAssert {
 	return v
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 ///////////////////////////////////
 // Attribute value string checks //
 ///////////////////////////////////
@@ -156,6 +160,16 @@ func (v *ViewResourceAssert) HasStatementString(expected string) *ViewResourceAs
 	return v
 }
 
+func (v *ViewResourceAssert) HasTagsString(expected string) *ViewResourceAssert {
+	v.ValueSet("tags", expected)
+	return v
+}
+
+func (v *ViewResourceAssert) HasTagsAllString(expected string) *ViewResourceAssert {
+	v.ValueSet("tags_all", expected)
+	return v
+}
+
 ///////////////////////////////
 // Attribute no value checks //
 ///////////////////////////////
@@ -215,6 +229,16 @@ func (v *ViewResourceAssert) HasNoStatement() *ViewResourceAssert {
 	return v
 }
 
+func (v *ViewResourceAssert) HasNoTags() *ViewResourceAssert {
+	v.ValueNotSet("tags")
+	return v
+}
+
+func (v *ViewResourceAssert) HasNoTagsAll() *ViewResourceAssert {
+	v.ValueNotSet("tags_all")
+	return v
+}
+
 ////////////////////////////
 // Attribute empty checks //
 ////////////////////////////
@@ -279,6 +303,16 @@ func (v *ViewResourceAssert) HasRowAccessPolicyEmpty() *ViewResourceAssert {
 	return v
 }
 
+func (v *ViewResourceAssert) HasTagsEmpty() *ViewResourceAssert {
+	v.ValueSet("tags", "")
+	return v
+}
+
+func (v *ViewResourceAssert) HasTagsAllEmpty() *ViewResourceAssert {
+	v.ValueSet("tags_all", "")
+	return v
+}
+
 ///////////////////////////////
 // Attribute presence checks //
 ///////////////////////////////
@@ -337,3 +371,13 @@ func (v *ViewResourceAssert) HasStatementNotEmpty() *ViewResourceAssert {
 	v.ValuePresent("statement")
 	return v
 }
+
+func (v *ViewResourceAssert) HasTagsNotEmpty() *ViewResourceAssert {
+	v.ValuePresent("tags")
+	return v
+}
+
+func (v *ViewResourceAssert) HasTagsAllNotEmpty() *ViewResourceAssert {
+	v.ValuePresent("tags_all")
+	return v
+}
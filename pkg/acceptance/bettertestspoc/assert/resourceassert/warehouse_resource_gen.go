@@ -117,6 +117,10 @@ func (w *WarehouseResourceAssert) HasStatementTimeoutInSeconds(expected int) *Wa
 	return w
 }
 
+// typed assert for "tags" (type: Map, subtype: Map) is not currently supported
+
+// typed assert for "tags_all" (type: Map, subtype: Map) is not currently supported
+
 func (w *WarehouseResourceAssert) HasWarehouseSize(expected string) *WarehouseResourceAssert {
 	w.StringValueSet("warehouse_size", expected)
 	return w
@@ -216,6 +220,16 @@ func (w *WarehouseResourceAssert) HasStatementTimeoutInSecondsString(expected st
 	return w
 }
 
+func (w *WarehouseResourceAssert) HasTagsString(expected string) *WarehouseResourceAssert {
+	w.ValueSet("tags", expected)
+	return w
+}
+
+func (w *WarehouseResourceAssert) HasTagsAllString(expected string) *WarehouseResourceAssert {
+	w.ValueSet("tags_all", expected)
+	return w
+}
+
 func (w *WarehouseResourceAssert) HasWarehouseSizeString(expected string) *WarehouseResourceAssert {
 	w.ValueSet("warehouse_size", expected)
 	return w
@@ -315,6 +329,16 @@ func (w *WarehouseResourceAssert) HasNoStatementTimeoutInSeconds() *WarehouseRes
 	return w
 }
 
+func (w *WarehouseResourceAssert) HasNoTags() *WarehouseResourceAssert {
+	w.ValueNotSet("tags")
+	return w
+}
+
+func (w *WarehouseResourceAssert) HasNoTagsAll() *WarehouseResourceAssert {
+	w.ValueNotSet("tags_all")
+	return w
+}
+
 func (w *WarehouseResourceAssert) HasNoWarehouseSize() *WarehouseResourceAssert {
 	w.ValueNotSet("warehouse_size")
 	return w
@@ -409,6 +433,16 @@ func (w *WarehouseResourceAssert) HasStatementTimeoutInSecondsEmpty() *Warehouse
 	return w
 }
 
+func (w *WarehouseResourceAssert) HasTagsEmpty() *WarehouseResourceAssert {
+	w.ValueSet("tags", "")
+	return w
+}
+
+func (w *WarehouseResourceAssert) HasTagsAllEmpty() *WarehouseResourceAssert {
+	w.ValueSet("tags_all", "")
+	return w
+}
+
 func (w *WarehouseResourceAssert) HasWarehouseSizeEmpty() *WarehouseResourceAssert {
 	w.ValueSet("warehouse_size", "")
 	return w
@@ -508,6 +542,16 @@ func (w *WarehouseResourceAssert) HasStatementTimeoutInSecondsNotEmpty() *Wareho
 	return w
 }
 
+func (w *WarehouseResourceAssert) HasTagsNotEmpty() *WarehouseResourceAssert {
+	w.ValuePresent("tags")
+	return w
+}
+
+func (w *WarehouseResourceAssert) HasTagsAllNotEmpty() *WarehouseResourceAssert {
+	w.ValuePresent("tags_all")
+	return w
+}
+
 func (w *WarehouseResourceAssert) HasWarehouseSizeNotEmpty() *WarehouseResourceAssert {
 	w.ValuePresent("warehouse_size")
 	return w
@@ -0,0 +1,6 @@
+package resourceshowoutputassert
+
+func (p *AggregationPolicyShowOutputAssert) HasCreatedOnNotEmpty() *AggregationPolicyShowOutputAssert {
+	p.ValuePresent("created_on")
+	return p
+}
@@ -0,0 +1,145 @@
+// Code generated by resource show output assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceshowoutputassert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type AggregationPolicyShowOutputAssert struct {
+	*assert.ResourceAssert
+}
+
+func AggregationPolicyShowOutput(t *testing.T, name string) *AggregationPolicyShowOutputAssert {
+	t.Helper()
+
+	aggregationPolicyAssert := AggregationPolicyShowOutputAssert{
+		ResourceAssert: assert.NewResourceShowOutputAssert(name),
+	}
+	return &aggregationPolicyAssert
+}
+
+func ImportedAggregationPolicyShowOutput(t *testing.T, id string) *AggregationPolicyShowOutputAssert {
+	t.Helper()
+
+	aggregationPolicyAssert := AggregationPolicyShowOutputAssert{
+		ResourceAssert: assert.NewImportedResourceShowOutputAssert(id),
+	}
+	return &aggregationPolicyAssert
+}
+
+func AggregationPoliciesDatasourceShowOutput(t *testing.T, name string) *AggregationPolicyShowOutputAssert {
+	t.Helper()
+
+	return AggregationPoliciesDatasourceShowOutputOnIdx(t, name, 0)
+}
+
+func AggregationPoliciesDatasourceShowOutputOnIdx(t *testing.T, name string, idx int) *AggregationPolicyShowOutputAssert {
+	t.Helper()
+
+	aggregationPolicyAssert := AggregationPolicyShowOutputAssert{
+		ResourceAssert: assert.NewDatasourceShowOutputAssert(name, "aggregation_policies", idx),
+	}
+	return &aggregationPolicyAssert
+}
+
+////////////////////////////
+// Attribute value checks //
+////////////////////////////
+
+func (a *AggregationPolicyShowOutputAssert) HasCreatedOn(expected time.Time) *AggregationPolicyShowOutputAssert {
+	a.StringValueSet("created_on", expected.String())
+	return a
+}
+
+func (a *AggregationPolicyShowOutputAssert) HasName(expected string) *AggregationPolicyShowOutputAssert {
+	a.StringValueSet("name", expected)
+	return a
+}
+
+func (a *AggregationPolicyShowOutputAssert) HasDatabaseName(expected string) *AggregationPolicyShowOutputAssert {
+	a.StringValueSet("database_name", expected)
+	return a
+}
+
+func (a *AggregationPolicyShowOutputAssert) HasSchemaName(expected string) *AggregationPolicyShowOutputAssert {
+	a.StringValueSet("schema_name", expected)
+	return a
+}
+
+func (a *AggregationPolicyShowOutputAssert) HasKind(expected string) *AggregationPolicyShowOutputAssert {
+	a.StringValueSet("kind", expected)
+	return a
+}
+
+func (a *AggregationPolicyShowOutputAssert) HasOwner(expected string) *AggregationPolicyShowOutputAssert {
+	a.StringValueSet("owner", expected)
+	return a
+}
+
+func (a *AggregationPolicyShowOutputAssert) HasComment(expected string) *AggregationPolicyShowOutputAssert {
+	a.StringValueSet("comment", expected)
+	return a
+}
+
+func (a *AggregationPolicyShowOutputAssert) HasOptions(expected string) *AggregationPolicyShowOutputAssert {
+	a.StringValueSet("options", expected)
+	return a
+}
+
+func (a *AggregationPolicyShowOutputAssert) HasOwnerRoleType(expected string) *AggregationPolicyShowOutputAssert {
+	a.StringValueSet("owner_role_type", expected)
+	return a
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (a *AggregationPolicyShowOutputAssert) HasNoCreatedOn() *AggregationPolicyShowOutputAssert {
+	a.ValueNotSet("created_on")
+	return a
+}
+
+func (a *AggregationPolicyShowOutputAssert) HasNoName() *AggregationPolicyShowOutputAssert {
+	a.ValueNotSet("name")
+	return a
+}
+
+func (a *AggregationPolicyShowOutputAssert) HasNoDatabaseName() *AggregationPolicyShowOutputAssert {
+	a.ValueNotSet("database_name")
+	return a
+}
+
+func (a *AggregationPolicyShowOutputAssert) HasNoSchemaName() *AggregationPolicyShowOutputAssert {
+	a.ValueNotSet("schema_name")
+	return a
+}
+
+func (a *AggregationPolicyShowOutputAssert) HasNoKind() *AggregationPolicyShowOutputAssert {
+	a.ValueNotSet("kind")
+	return a
+}
+
+func (a *AggregationPolicyShowOutputAssert) HasNoOwner() *AggregationPolicyShowOutputAssert {
+	a.ValueNotSet("owner")
+	return a
+}
+
+func (a *AggregationPolicyShowOutputAssert) HasNoComment() *AggregationPolicyShowOutputAssert {
+	a.ValueNotSet("comment")
+	return a
+}
+
+func (a *AggregationPolicyShowOutputAssert) HasNoOptions() *AggregationPolicyShowOutputAssert {
+	a.ValueNotSet("options")
+	return a
+}
+
+func (a *AggregationPolicyShowOutputAssert) HasNoOwnerRoleType() *AggregationPolicyShowOutputAssert {
+	a.ValueNotSet("owner_role_type")
+	return a
+}
@@ -0,0 +1,6 @@
+package resourceshowoutputassert
+
+func (a *ApplicationPackageShowOutputAssert) HasCreatedOnNotEmpty() *ApplicationPackageShowOutputAssert {
+	a.ValuePresent("created_on")
+	return a
+}
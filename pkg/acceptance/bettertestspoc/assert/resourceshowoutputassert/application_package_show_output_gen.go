@@ -0,0 +1,165 @@
+// Code generated by resource show output assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceshowoutputassert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type ApplicationPackageShowOutputAssert struct {
+	*assert.ResourceAssert
+}
+
+func ApplicationPackageShowOutput(t *testing.T, name string) *ApplicationPackageShowOutputAssert {
+	t.Helper()
+
+	applicationPackageAssert := ApplicationPackageShowOutputAssert{
+		ResourceAssert: assert.NewResourceShowOutputAssert(name),
+	}
+	return &applicationPackageAssert
+}
+
+func ImportedApplicationPackageShowOutput(t *testing.T, id string) *ApplicationPackageShowOutputAssert {
+	t.Helper()
+
+	applicationPackageAssert := ApplicationPackageShowOutputAssert{
+		ResourceAssert: assert.NewImportedResourceShowOutputAssert(id),
+	}
+	return &applicationPackageAssert
+}
+
+func ApplicationPackagesDatasourceShowOutput(t *testing.T, name string) *ApplicationPackageShowOutputAssert {
+	t.Helper()
+
+	return ApplicationPackagesDatasourceShowOutputOnIdx(t, name, 0)
+}
+
+func ApplicationPackagesDatasourceShowOutputOnIdx(t *testing.T, name string, idx int) *ApplicationPackageShowOutputAssert {
+	t.Helper()
+
+	applicationPackageAssert := ApplicationPackageShowOutputAssert{
+		ResourceAssert: assert.NewDatasourceShowOutputAssert(name, "application_packages", idx),
+	}
+	return &applicationPackageAssert
+}
+
+////////////////////////////
+// Attribute value checks //
+////////////////////////////
+
+func (a *ApplicationPackageShowOutputAssert) HasCreatedOn(expected time.Time) *ApplicationPackageShowOutputAssert {
+	a.StringValueSet("created_on", expected.String())
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasName(expected string) *ApplicationPackageShowOutputAssert {
+	a.StringValueSet("name", expected)
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasIsDefault(expected bool) *ApplicationPackageShowOutputAssert {
+	a.BoolValueSet("is_default", expected)
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasIsCurrent(expected bool) *ApplicationPackageShowOutputAssert {
+	a.BoolValueSet("is_current", expected)
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasDistribution(expected string) *ApplicationPackageShowOutputAssert {
+	a.StringValueSet("distribution", expected)
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasOwner(expected string) *ApplicationPackageShowOutputAssert {
+	a.StringValueSet("owner", expected)
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasComment(expected string) *ApplicationPackageShowOutputAssert {
+	a.StringValueSet("comment", expected)
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasRetentionTime(expected int) *ApplicationPackageShowOutputAssert {
+	a.IntValueSet("retention_time", expected)
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasOptions(expected string) *ApplicationPackageShowOutputAssert {
+	a.StringValueSet("options", expected)
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasDroppedOn(expected string) *ApplicationPackageShowOutputAssert {
+	a.StringValueSet("dropped_on", expected)
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasApplicationClass(expected string) *ApplicationPackageShowOutputAssert {
+	a.StringValueSet("application_class", expected)
+	return a
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (a *ApplicationPackageShowOutputAssert) HasNoCreatedOn() *ApplicationPackageShowOutputAssert {
+	a.ValueNotSet("created_on")
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasNoName() *ApplicationPackageShowOutputAssert {
+	a.ValueNotSet("name")
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasNoIsDefault() *ApplicationPackageShowOutputAssert {
+	a.ValueNotSet("is_default")
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasNoIsCurrent() *ApplicationPackageShowOutputAssert {
+	a.ValueNotSet("is_current")
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasNoDistribution() *ApplicationPackageShowOutputAssert {
+	a.ValueNotSet("distribution")
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasNoOwner() *ApplicationPackageShowOutputAssert {
+	a.ValueNotSet("owner")
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasNoComment() *ApplicationPackageShowOutputAssert {
+	a.ValueNotSet("comment")
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasNoRetentionTime() *ApplicationPackageShowOutputAssert {
+	a.ValueNotSet("retention_time")
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasNoOptions() *ApplicationPackageShowOutputAssert {
+	a.ValueNotSet("options")
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasNoDroppedOn() *ApplicationPackageShowOutputAssert {
+	a.ValueNotSet("dropped_on")
+	return a
+}
+
+func (a *ApplicationPackageShowOutputAssert) HasNoApplicationClass() *ApplicationPackageShowOutputAssert {
+	a.ValueNotSet("application_class")
+	return a
+}
@@ -0,0 +1,6 @@
+package resourceshowoutputassert
+
+func (a *ApplicationShowOutputAssert) HasCreatedOnNotEmpty() *ApplicationShowOutputAssert {
+	a.ValuePresent("created_on")
+	return a
+}
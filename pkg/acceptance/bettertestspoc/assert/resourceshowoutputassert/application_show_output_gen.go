@@ -0,0 +1,185 @@
+// Code generated by resource show output assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceshowoutputassert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type ApplicationShowOutputAssert struct {
+	*assert.ResourceAssert
+}
+
+func ApplicationShowOutput(t *testing.T, name string) *ApplicationShowOutputAssert {
+	t.Helper()
+
+	applicationAssert := ApplicationShowOutputAssert{
+		ResourceAssert: assert.NewResourceShowOutputAssert(name),
+	}
+	return &applicationAssert
+}
+
+func ImportedApplicationShowOutput(t *testing.T, id string) *ApplicationShowOutputAssert {
+	t.Helper()
+
+	applicationAssert := ApplicationShowOutputAssert{
+		ResourceAssert: assert.NewImportedResourceShowOutputAssert(id),
+	}
+	return &applicationAssert
+}
+
+func ApplicationsDatasourceShowOutput(t *testing.T, name string) *ApplicationShowOutputAssert {
+	t.Helper()
+
+	return ApplicationsDatasourceShowOutputOnIdx(t, name, 0)
+}
+
+func ApplicationsDatasourceShowOutputOnIdx(t *testing.T, name string, idx int) *ApplicationShowOutputAssert {
+	t.Helper()
+
+	applicationAssert := ApplicationShowOutputAssert{
+		ResourceAssert: assert.NewDatasourceShowOutputAssert(name, "applications", idx),
+	}
+	return &applicationAssert
+}
+
+////////////////////////////
+// Attribute value checks //
+////////////////////////////
+
+func (a *ApplicationShowOutputAssert) HasCreatedOn(expected time.Time) *ApplicationShowOutputAssert {
+	a.StringValueSet("created_on", expected.String())
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasName(expected string) *ApplicationShowOutputAssert {
+	a.StringValueSet("name", expected)
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasIsDefault(expected bool) *ApplicationShowOutputAssert {
+	a.BoolValueSet("is_default", expected)
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasIsCurrent(expected bool) *ApplicationShowOutputAssert {
+	a.BoolValueSet("is_current", expected)
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasSourceType(expected string) *ApplicationShowOutputAssert {
+	a.StringValueSet("source_type", expected)
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasSource(expected string) *ApplicationShowOutputAssert {
+	a.StringValueSet("source", expected)
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasOwner(expected string) *ApplicationShowOutputAssert {
+	a.StringValueSet("owner", expected)
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasComment(expected string) *ApplicationShowOutputAssert {
+	a.StringValueSet("comment", expected)
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasVersion(expected string) *ApplicationShowOutputAssert {
+	a.StringValueSet("version", expected)
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasLabel(expected string) *ApplicationShowOutputAssert {
+	a.StringValueSet("label", expected)
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasPatch(expected int) *ApplicationShowOutputAssert {
+	a.IntValueSet("patch", expected)
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasOptions(expected string) *ApplicationShowOutputAssert {
+	a.StringValueSet("options", expected)
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasRetentionTime(expected int) *ApplicationShowOutputAssert {
+	a.IntValueSet("retention_time", expected)
+	return a
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (a *ApplicationShowOutputAssert) HasNoCreatedOn() *ApplicationShowOutputAssert {
+	a.ValueNotSet("created_on")
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasNoName() *ApplicationShowOutputAssert {
+	a.ValueNotSet("name")
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasNoIsDefault() *ApplicationShowOutputAssert {
+	a.ValueNotSet("is_default")
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasNoIsCurrent() *ApplicationShowOutputAssert {
+	a.ValueNotSet("is_current")
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasNoSourceType() *ApplicationShowOutputAssert {
+	a.ValueNotSet("source_type")
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasNoSource() *ApplicationShowOutputAssert {
+	a.ValueNotSet("source")
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasNoOwner() *ApplicationShowOutputAssert {
+	a.ValueNotSet("owner")
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasNoComment() *ApplicationShowOutputAssert {
+	a.ValueNotSet("comment")
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasNoVersion() *ApplicationShowOutputAssert {
+	a.ValueNotSet("version")
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasNoLabel() *ApplicationShowOutputAssert {
+	a.ValueNotSet("label")
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasNoPatch() *ApplicationShowOutputAssert {
+	a.ValueNotSet("patch")
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasNoOptions() *ApplicationShowOutputAssert {
+	a.ValueNotSet("options")
+	return a
+}
+
+func (a *ApplicationShowOutputAssert) HasNoRetentionTime() *ApplicationShowOutputAssert {
+	a.ValueNotSet("retention_time")
+	return a
+}
@@ -0,0 +1,155 @@
+// Code generated by resource show output assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceshowoutputassert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type BackupPolicyDescribeOutputAssert struct {
+	*assert.ResourceAssert
+}
+
+func BackupPolicyDescribeOutput(t *testing.T, name string) *BackupPolicyDescribeOutputAssert {
+	t.Helper()
+
+	backupPolicyAssert := BackupPolicyDescribeOutputAssert{
+		ResourceAssert: assert.NewResourceDescribeOutputAssert(name),
+	}
+	return &backupPolicyAssert
+}
+
+func ImportedBackupPolicyDescribeOutput(t *testing.T, id string) *BackupPolicyDescribeOutputAssert {
+	t.Helper()
+
+	backupPolicyAssert := BackupPolicyDescribeOutputAssert{
+		ResourceAssert: assert.NewImportedResourceDescribeOutputAssert(id),
+	}
+	return &backupPolicyAssert
+}
+
+func BackupPoliciesDatasourceDescribeOutput(t *testing.T, name string) *BackupPolicyDescribeOutputAssert {
+	t.Helper()
+
+	return BackupPoliciesDatasourceDescribeOutputOnIdx(t, name, 0)
+}
+
+func BackupPoliciesDatasourceDescribeOutputOnIdx(t *testing.T, name string, idx int) *BackupPolicyDescribeOutputAssert {
+	t.Helper()
+
+	backupPolicyAssert := BackupPolicyDescribeOutputAssert{
+		ResourceAssert: assert.NewDatasourceDescribeOutputAssert(name, "backup_policies", idx),
+	}
+	return &backupPolicyAssert
+}
+
+////////////////////////////
+// Attribute value checks //
+////////////////////////////
+
+func (b *BackupPolicyDescribeOutputAssert) HasCreatedOn(expected time.Time) *BackupPolicyDescribeOutputAssert {
+	b.StringValueSet("created_on", expected.String())
+	return b
+}
+
+func (b *BackupPolicyDescribeOutputAssert) HasName(expected string) *BackupPolicyDescribeOutputAssert {
+	b.StringValueSet("name", expected)
+	return b
+}
+
+func (b *BackupPolicyDescribeOutputAssert) HasDatabaseName(expected string) *BackupPolicyDescribeOutputAssert {
+	b.StringValueSet("database_name", expected)
+	return b
+}
+
+func (b *BackupPolicyDescribeOutputAssert) HasSchemaName(expected string) *BackupPolicyDescribeOutputAssert {
+	b.StringValueSet("schema_name", expected)
+	return b
+}
+
+func (b *BackupPolicyDescribeOutputAssert) HasOwner(expected string) *BackupPolicyDescribeOutputAssert {
+	b.StringValueSet("owner", expected)
+	return b
+}
+
+func (b *BackupPolicyDescribeOutputAssert) HasOwnerRoleType(expected string) *BackupPolicyDescribeOutputAssert {
+	b.StringValueSet("owner_role_type", expected)
+	return b
+}
+
+func (b *BackupPolicyDescribeOutputAssert) HasComment(expected string) *BackupPolicyDescribeOutputAssert {
+	b.StringValueSet("comment", expected)
+	return b
+}
+
+func (b *BackupPolicyDescribeOutputAssert) HasSchedule(expected string) *BackupPolicyDescribeOutputAssert {
+	b.StringValueSet("schedule", expected)
+	return b
+}
+
+func (b *BackupPolicyDescribeOutputAssert) HasExpireAfterDays(expected int) *BackupPolicyDescribeOutputAssert {
+	b.IntValueSet("expire_after_days", expected)
+	return b
+}
+
+func (b *BackupPolicyDescribeOutputAssert) HasHasRetentionLock(expected bool) *BackupPolicyDescribeOutputAssert {
+	b.BoolValueSet("has_retention_lock", expected)
+	return b
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (b *BackupPolicyDescribeOutputAssert) HasNoCreatedOn() *BackupPolicyDescribeOutputAssert {
+	b.ValueNotSet("created_on")
+	return b
+}
+
+func (b *BackupPolicyDescribeOutputAssert) HasNoName() *BackupPolicyDescribeOutputAssert {
+	b.ValueNotSet("name")
+	return b
+}
+
+func (b *BackupPolicyDescribeOutputAssert) HasNoDatabaseName() *BackupPolicyDescribeOutputAssert {
+	b.ValueNotSet("database_name")
+	return b
+}
+
+func (b *BackupPolicyDescribeOutputAssert) HasNoSchemaName() *BackupPolicyDescribeOutputAssert {
+	b.ValueNotSet("schema_name")
+	return b
+}
+
+func (b *BackupPolicyDescribeOutputAssert) HasNoOwner() *BackupPolicyDescribeOutputAssert {
+	b.ValueNotSet("owner")
+	return b
+}
+
+func (b *BackupPolicyDescribeOutputAssert) HasNoOwnerRoleType() *BackupPolicyDescribeOutputAssert {
+	b.ValueNotSet("owner_role_type")
+	return b
+}
+
+func (b *BackupPolicyDescribeOutputAssert) HasNoComment() *BackupPolicyDescribeOutputAssert {
+	b.ValueNotSet("comment")
+	return b
+}
+
+func (b *BackupPolicyDescribeOutputAssert) HasNoSchedule() *BackupPolicyDescribeOutputAssert {
+	b.ValueNotSet("schedule")
+	return b
+}
+
+func (b *BackupPolicyDescribeOutputAssert) HasNoExpireAfterDays() *BackupPolicyDescribeOutputAssert {
+	b.ValueNotSet("expire_after_days")
+	return b
+}
+
+func (b *BackupPolicyDescribeOutputAssert) HasNoHasRetentionLock() *BackupPolicyDescribeOutputAssert {
+	b.ValueNotSet("has_retention_lock")
+	return b
+}
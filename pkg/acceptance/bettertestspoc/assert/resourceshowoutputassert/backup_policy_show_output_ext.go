@@ -0,0 +1,6 @@
+package resourceshowoutputassert
+
+func (b *BackupPolicyShowOutputAssert) HasCreatedOnNotEmpty() *BackupPolicyShowOutputAssert {
+	b.ValuePresent("created_on")
+	return b
+}
@@ -0,0 +1,155 @@
+// Code generated by resource show output assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceshowoutputassert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type BackupPolicyShowOutputAssert struct {
+	*assert.ResourceAssert
+}
+
+func BackupPolicyShowOutput(t *testing.T, name string) *BackupPolicyShowOutputAssert {
+	t.Helper()
+
+	backupPolicyAssert := BackupPolicyShowOutputAssert{
+		ResourceAssert: assert.NewResourceShowOutputAssert(name),
+	}
+	return &backupPolicyAssert
+}
+
+func ImportedBackupPolicyShowOutput(t *testing.T, id string) *BackupPolicyShowOutputAssert {
+	t.Helper()
+
+	backupPolicyAssert := BackupPolicyShowOutputAssert{
+		ResourceAssert: assert.NewImportedResourceShowOutputAssert(id),
+	}
+	return &backupPolicyAssert
+}
+
+func BackupPoliciesDatasourceShowOutput(t *testing.T, name string) *BackupPolicyShowOutputAssert {
+	t.Helper()
+
+	return BackupPoliciesDatasourceShowOutputOnIdx(t, name, 0)
+}
+
+func BackupPoliciesDatasourceShowOutputOnIdx(t *testing.T, name string, idx int) *BackupPolicyShowOutputAssert {
+	t.Helper()
+
+	backupPolicyAssert := BackupPolicyShowOutputAssert{
+		ResourceAssert: assert.NewDatasourceShowOutputAssert(name, "backup_policies", idx),
+	}
+	return &backupPolicyAssert
+}
+
+////////////////////////////
+// Attribute value checks //
+////////////////////////////
+
+func (b *BackupPolicyShowOutputAssert) HasCreatedOn(expected time.Time) *BackupPolicyShowOutputAssert {
+	b.StringValueSet("created_on", expected.String())
+	return b
+}
+
+func (b *BackupPolicyShowOutputAssert) HasName(expected string) *BackupPolicyShowOutputAssert {
+	b.StringValueSet("name", expected)
+	return b
+}
+
+func (b *BackupPolicyShowOutputAssert) HasDatabaseName(expected string) *BackupPolicyShowOutputAssert {
+	b.StringValueSet("database_name", expected)
+	return b
+}
+
+func (b *BackupPolicyShowOutputAssert) HasSchemaName(expected string) *BackupPolicyShowOutputAssert {
+	b.StringValueSet("schema_name", expected)
+	return b
+}
+
+func (b *BackupPolicyShowOutputAssert) HasOwner(expected string) *BackupPolicyShowOutputAssert {
+	b.StringValueSet("owner", expected)
+	return b
+}
+
+func (b *BackupPolicyShowOutputAssert) HasOwnerRoleType(expected string) *BackupPolicyShowOutputAssert {
+	b.StringValueSet("owner_role_type", expected)
+	return b
+}
+
+func (b *BackupPolicyShowOutputAssert) HasComment(expected string) *BackupPolicyShowOutputAssert {
+	b.StringValueSet("comment", expected)
+	return b
+}
+
+func (b *BackupPolicyShowOutputAssert) HasSchedule(expected string) *BackupPolicyShowOutputAssert {
+	b.StringValueSet("schedule", expected)
+	return b
+}
+
+func (b *BackupPolicyShowOutputAssert) HasExpireAfterDays(expected int) *BackupPolicyShowOutputAssert {
+	b.IntValueSet("expire_after_days", expected)
+	return b
+}
+
+func (b *BackupPolicyShowOutputAssert) HasHasRetentionLock(expected bool) *BackupPolicyShowOutputAssert {
+	b.BoolValueSet("has_retention_lock", expected)
+	return b
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (b *BackupPolicyShowOutputAssert) HasNoCreatedOn() *BackupPolicyShowOutputAssert {
+	b.ValueNotSet("created_on")
+	return b
+}
+
+func (b *BackupPolicyShowOutputAssert) HasNoName() *BackupPolicyShowOutputAssert {
+	b.ValueNotSet("name")
+	return b
+}
+
+func (b *BackupPolicyShowOutputAssert) HasNoDatabaseName() *BackupPolicyShowOutputAssert {
+	b.ValueNotSet("database_name")
+	return b
+}
+
+func (b *BackupPolicyShowOutputAssert) HasNoSchemaName() *BackupPolicyShowOutputAssert {
+	b.ValueNotSet("schema_name")
+	return b
+}
+
+func (b *BackupPolicyShowOutputAssert) HasNoOwner() *BackupPolicyShowOutputAssert {
+	b.ValueNotSet("owner")
+	return b
+}
+
+func (b *BackupPolicyShowOutputAssert) HasNoOwnerRoleType() *BackupPolicyShowOutputAssert {
+	b.ValueNotSet("owner_role_type")
+	return b
+}
+
+func (b *BackupPolicyShowOutputAssert) HasNoComment() *BackupPolicyShowOutputAssert {
+	b.ValueNotSet("comment")
+	return b
+}
+
+func (b *BackupPolicyShowOutputAssert) HasNoSchedule() *BackupPolicyShowOutputAssert {
+	b.ValueNotSet("schedule")
+	return b
+}
+
+func (b *BackupPolicyShowOutputAssert) HasNoExpireAfterDays() *BackupPolicyShowOutputAssert {
+	b.ValueNotSet("expire_after_days")
+	return b
+}
+
+func (b *BackupPolicyShowOutputAssert) HasNoHasRetentionLock() *BackupPolicyShowOutputAssert {
+	b.ValueNotSet("has_retention_lock")
+	return b
+}
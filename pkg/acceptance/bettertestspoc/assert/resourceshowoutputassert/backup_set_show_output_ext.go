@@ -0,0 +1,6 @@
+package resourceshowoutputassert
+
+func (b *BackupSetShowOutputAssert) HasCreatedOnNotEmpty() *BackupSetShowOutputAssert {
+	b.ValuePresent("created_on")
+	return b
+}
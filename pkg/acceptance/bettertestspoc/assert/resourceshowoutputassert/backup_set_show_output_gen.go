@@ -0,0 +1,160 @@
+// Code generated by resource show output assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceshowoutputassert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type BackupSetShowOutputAssert struct {
+	*assert.ResourceAssert
+}
+
+func BackupSetShowOutput(t *testing.T, name string) *BackupSetShowOutputAssert {
+	t.Helper()
+
+	backupSetAssert := BackupSetShowOutputAssert{
+		ResourceAssert: assert.NewResourceShowOutputAssert(name),
+	}
+	return &backupSetAssert
+}
+
+func ImportedBackupSetShowOutput(t *testing.T, id string) *BackupSetShowOutputAssert {
+	t.Helper()
+
+	backupSetAssert := BackupSetShowOutputAssert{
+		ResourceAssert: assert.NewImportedResourceShowOutputAssert(id),
+	}
+	return &backupSetAssert
+}
+
+////////////////////////////
+// Attribute value checks //
+////////////////////////////
+
+func (b *BackupSetShowOutputAssert) HasCreatedOn(expected time.Time) *BackupSetShowOutputAssert {
+	b.StringValueSet("created_on", expected.String())
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasName(expected string) *BackupSetShowOutputAssert {
+	b.StringValueSet("name", expected)
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasDatabaseName(expected string) *BackupSetShowOutputAssert {
+	b.StringValueSet("database_name", expected)
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasSchemaName(expected string) *BackupSetShowOutputAssert {
+	b.StringValueSet("schema_name", expected)
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasComment(expected string) *BackupSetShowOutputAssert {
+	b.StringValueSet("comment", expected)
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasObjectKind(expected string) *BackupSetShowOutputAssert {
+	b.StringValueSet("object_kind", expected)
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasObjectName(expected string) *BackupSetShowOutputAssert {
+	b.StringValueSet("object_name", expected)
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasObjectDatabaseName(expected string) *BackupSetShowOutputAssert {
+	b.StringValueSet("object_database_name", expected)
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasObjectSchemaName(expected string) *BackupSetShowOutputAssert {
+	b.StringValueSet("object_schema_name", expected)
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasBackupPolicyName(expected string) *BackupSetShowOutputAssert {
+	b.StringValueSet("backup_policy_name", expected)
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasOwner(expected string) *BackupSetShowOutputAssert {
+	b.StringValueSet("owner", expected)
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasOwnerRoleType(expected string) *BackupSetShowOutputAssert {
+	b.StringValueSet("owner_role_type", expected)
+	return b
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (b *BackupSetShowOutputAssert) HasNoCreatedOn() *BackupSetShowOutputAssert {
+	b.ValueNotSet("created_on")
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasNoName() *BackupSetShowOutputAssert {
+	b.ValueNotSet("name")
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasNoDatabaseName() *BackupSetShowOutputAssert {
+	b.ValueNotSet("database_name")
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasNoSchemaName() *BackupSetShowOutputAssert {
+	b.ValueNotSet("schema_name")
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasNoComment() *BackupSetShowOutputAssert {
+	b.ValueNotSet("comment")
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasNoObjectKind() *BackupSetShowOutputAssert {
+	b.ValueNotSet("object_kind")
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasNoObjectName() *BackupSetShowOutputAssert {
+	b.ValueNotSet("object_name")
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasNoObjectDatabaseName() *BackupSetShowOutputAssert {
+	b.ValueNotSet("object_database_name")
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasNoObjectSchemaName() *BackupSetShowOutputAssert {
+	b.ValueNotSet("object_schema_name")
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasNoBackupPolicyName() *BackupSetShowOutputAssert {
+	b.ValueNotSet("backup_policy_name")
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasNoOwner() *BackupSetShowOutputAssert {
+	b.ValueNotSet("owner")
+	return b
+}
+
+func (b *BackupSetShowOutputAssert) HasNoOwnerRoleType() *BackupSetShowOutputAssert {
+	b.ValueNotSet("owner_role_type")
+	return b
+}
@@ -0,0 +1,6 @@
+package resourceshowoutputassert
+
+func (b *BudgetShowOutputAssert) HasCreatedOnNotEmpty() *BudgetShowOutputAssert {
+	b.ValuePresent("created_on")
+	return b
+}
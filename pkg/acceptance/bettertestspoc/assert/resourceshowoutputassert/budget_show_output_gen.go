@@ -0,0 +1,135 @@
+// Code generated by resource show output assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceshowoutputassert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type BudgetShowOutputAssert struct {
+	*assert.ResourceAssert
+}
+
+func BudgetShowOutput(t *testing.T, name string) *BudgetShowOutputAssert {
+	t.Helper()
+
+	budgetAssert := BudgetShowOutputAssert{
+		ResourceAssert: assert.NewResourceShowOutputAssert(name),
+	}
+	return &budgetAssert
+}
+
+func ImportedBudgetShowOutput(t *testing.T, id string) *BudgetShowOutputAssert {
+	t.Helper()
+
+	budgetAssert := BudgetShowOutputAssert{
+		ResourceAssert: assert.NewImportedResourceShowOutputAssert(id),
+	}
+	return &budgetAssert
+}
+
+func BudgetsDatasourceShowOutput(t *testing.T, name string) *BudgetShowOutputAssert {
+	t.Helper()
+
+	return BudgetsDatasourceShowOutputOnIdx(t, name, 0)
+}
+
+func BudgetsDatasourceShowOutputOnIdx(t *testing.T, name string, idx int) *BudgetShowOutputAssert {
+	t.Helper()
+
+	budgetAssert := BudgetShowOutputAssert{
+		ResourceAssert: assert.NewDatasourceShowOutputAssert(name, "budgets", idx),
+	}
+	return &budgetAssert
+}
+
+////////////////////////////
+// Attribute value checks //
+////////////////////////////
+
+func (b *BudgetShowOutputAssert) HasCreatedOn(expected time.Time) *BudgetShowOutputAssert {
+	b.StringValueSet("created_on", expected.String())
+	return b
+}
+
+func (b *BudgetShowOutputAssert) HasName(expected string) *BudgetShowOutputAssert {
+	b.StringValueSet("name", expected)
+	return b
+}
+
+func (b *BudgetShowOutputAssert) HasDatabaseName(expected string) *BudgetShowOutputAssert {
+	b.StringValueSet("database_name", expected)
+	return b
+}
+
+func (b *BudgetShowOutputAssert) HasSchemaName(expected string) *BudgetShowOutputAssert {
+	b.StringValueSet("schema_name", expected)
+	return b
+}
+
+func (b *BudgetShowOutputAssert) HasCurrentVersion(expected string) *BudgetShowOutputAssert {
+	b.StringValueSet("current_version", expected)
+	return b
+}
+
+func (b *BudgetShowOutputAssert) HasComment(expected string) *BudgetShowOutputAssert {
+	b.StringValueSet("comment", expected)
+	return b
+}
+
+func (b *BudgetShowOutputAssert) HasOwner(expected string) *BudgetShowOutputAssert {
+	b.StringValueSet("owner", expected)
+	return b
+}
+
+func (b *BudgetShowOutputAssert) HasOwnerRoleType(expected string) *BudgetShowOutputAssert {
+	b.StringValueSet("owner_role_type", expected)
+	return b
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (b *BudgetShowOutputAssert) HasNoCreatedOn() *BudgetShowOutputAssert {
+	b.ValueNotSet("created_on")
+	return b
+}
+
+func (b *BudgetShowOutputAssert) HasNoName() *BudgetShowOutputAssert {
+	b.ValueNotSet("name")
+	return b
+}
+
+func (b *BudgetShowOutputAssert) HasNoDatabaseName() *BudgetShowOutputAssert {
+	b.ValueNotSet("database_name")
+	return b
+}
+
+func (b *BudgetShowOutputAssert) HasNoSchemaName() *BudgetShowOutputAssert {
+	b.ValueNotSet("schema_name")
+	return b
+}
+
+func (b *BudgetShowOutputAssert) HasNoCurrentVersion() *BudgetShowOutputAssert {
+	b.ValueNotSet("current_version")
+	return b
+}
+
+func (b *BudgetShowOutputAssert) HasNoComment() *BudgetShowOutputAssert {
+	b.ValueNotSet("comment")
+	return b
+}
+
+func (b *BudgetShowOutputAssert) HasNoOwner() *BudgetShowOutputAssert {
+	b.ValueNotSet("owner")
+	return b
+}
+
+func (b *BudgetShowOutputAssert) HasNoOwnerRoleType() *BudgetShowOutputAssert {
+	b.ValueNotSet("owner_role_type")
+	return b
+}
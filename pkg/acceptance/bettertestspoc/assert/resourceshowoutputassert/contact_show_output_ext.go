@@ -0,0 +1,6 @@
+package resourceshowoutputassert
+
+func (c *ContactShowOutputAssert) HasCreatedOnNotEmpty() *ContactShowOutputAssert {
+	c.ValuePresent("created_on")
+	return c
+}
@@ -0,0 +1,135 @@
+// Code generated by resource show output assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceshowoutputassert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type ContactShowOutputAssert struct {
+	*assert.ResourceAssert
+}
+
+func ContactShowOutput(t *testing.T, name string) *ContactShowOutputAssert {
+	t.Helper()
+
+	contactAssert := ContactShowOutputAssert{
+		ResourceAssert: assert.NewResourceShowOutputAssert(name),
+	}
+	return &contactAssert
+}
+
+func ImportedContactShowOutput(t *testing.T, id string) *ContactShowOutputAssert {
+	t.Helper()
+
+	contactAssert := ContactShowOutputAssert{
+		ResourceAssert: assert.NewImportedResourceShowOutputAssert(id),
+	}
+	return &contactAssert
+}
+
+////////////////////////////
+// Attribute value checks //
+////////////////////////////
+
+func (c *ContactShowOutputAssert) HasCreatedOn(expected time.Time) *ContactShowOutputAssert {
+	c.StringValueSet("created_on", expected.String())
+	return c
+}
+
+func (c *ContactShowOutputAssert) HasName(expected string) *ContactShowOutputAssert {
+	c.StringValueSet("name", expected)
+	return c
+}
+
+func (c *ContactShowOutputAssert) HasDatabaseName(expected string) *ContactShowOutputAssert {
+	c.StringValueSet("database_name", expected)
+	return c
+}
+
+func (c *ContactShowOutputAssert) HasSchemaName(expected string) *ContactShowOutputAssert {
+	c.StringValueSet("schema_name", expected)
+	return c
+}
+
+func (c *ContactShowOutputAssert) HasOwner(expected string) *ContactShowOutputAssert {
+	c.StringValueSet("owner", expected)
+	return c
+}
+
+func (c *ContactShowOutputAssert) HasComment(expected string) *ContactShowOutputAssert {
+	c.StringValueSet("comment", expected)
+	return c
+}
+
+func (c *ContactShowOutputAssert) HasEmailDistributionList(expected string) *ContactShowOutputAssert {
+	c.StringValueSet("email_distribution_list", expected)
+	return c
+}
+
+func (c *ContactShowOutputAssert) HasUrl(expected string) *ContactShowOutputAssert {
+	c.StringValueSet("url", expected)
+	return c
+}
+
+func (c *ContactShowOutputAssert) HasOwnerRoleType(expected string) *ContactShowOutputAssert {
+	c.StringValueSet("owner_role_type", expected)
+	return c
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (c *ContactShowOutputAssert) HasNoCreatedOn() *ContactShowOutputAssert {
+	c.ValueNotSet("created_on")
+	return c
+}
+
+func (c *ContactShowOutputAssert) HasNoName() *ContactShowOutputAssert {
+	c.ValueNotSet("name")
+	return c
+}
+
+func (c *ContactShowOutputAssert) HasNoDatabaseName() *ContactShowOutputAssert {
+	c.ValueNotSet("database_name")
+	return c
+}
+
+func (c *ContactShowOutputAssert) HasNoSchemaName() *ContactShowOutputAssert {
+	c.ValueNotSet("schema_name")
+	return c
+}
+
+func (c *ContactShowOutputAssert) HasNoOwner() *ContactShowOutputAssert {
+	c.ValueNotSet("owner")
+	return c
+}
+
+func (c *ContactShowOutputAssert) HasNoComment() *ContactShowOutputAssert {
+	c.ValueNotSet("comment")
+	return c
+}
+
+func (c *ContactShowOutputAssert) HasNoUsers() *ContactShowOutputAssert {
+	c.ValueSet("users.#", "0")
+	return c
+}
+
+func (c *ContactShowOutputAssert) HasNoEmailDistributionList() *ContactShowOutputAssert {
+	c.ValueNotSet("email_distribution_list")
+	return c
+}
+
+func (c *ContactShowOutputAssert) HasNoUrl() *ContactShowOutputAssert {
+	c.ValueNotSet("url")
+	return c
+}
+
+func (c *ContactShowOutputAssert) HasNoOwnerRoleType() *ContactShowOutputAssert {
+	c.ValueNotSet("owner_role_type")
+	return c
+}
@@ -0,0 +1,6 @@
+package resourceshowoutputassert
+
+func (e *EventTableShowOutputAssert) HasCreatedOnNotEmpty() *EventTableShowOutputAssert {
+	e.ValuePresent("created_on")
+	return e
+}
@@ -0,0 +1,125 @@
+// Code generated by resource show output assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceshowoutputassert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type EventTableShowOutputAssert struct {
+	*assert.ResourceAssert
+}
+
+func EventTableShowOutput(t *testing.T, name string) *EventTableShowOutputAssert {
+	t.Helper()
+
+	eventTableAssert := EventTableShowOutputAssert{
+		ResourceAssert: assert.NewResourceShowOutputAssert(name),
+	}
+	return &eventTableAssert
+}
+
+func ImportedEventTableShowOutput(t *testing.T, id string) *EventTableShowOutputAssert {
+	t.Helper()
+
+	eventTableAssert := EventTableShowOutputAssert{
+		ResourceAssert: assert.NewImportedResourceShowOutputAssert(id),
+	}
+	return &eventTableAssert
+}
+
+func EventTablesDatasourceShowOutput(t *testing.T, name string) *EventTableShowOutputAssert {
+	t.Helper()
+
+	return EventTablesDatasourceShowOutputOnIdx(t, name, 0)
+}
+
+func EventTablesDatasourceShowOutputOnIdx(t *testing.T, name string, idx int) *EventTableShowOutputAssert {
+	t.Helper()
+
+	eventTableAssert := EventTableShowOutputAssert{
+		ResourceAssert: assert.NewDatasourceShowOutputAssert(name, "event_tables", idx),
+	}
+	return &eventTableAssert
+}
+
+////////////////////////////
+// Attribute value checks //
+////////////////////////////
+
+func (e *EventTableShowOutputAssert) HasCreatedOn(expected time.Time) *EventTableShowOutputAssert {
+	e.StringValueSet("created_on", expected.String())
+	return e
+}
+
+func (e *EventTableShowOutputAssert) HasName(expected string) *EventTableShowOutputAssert {
+	e.StringValueSet("name", expected)
+	return e
+}
+
+func (e *EventTableShowOutputAssert) HasDatabaseName(expected string) *EventTableShowOutputAssert {
+	e.StringValueSet("database_name", expected)
+	return e
+}
+
+func (e *EventTableShowOutputAssert) HasSchemaName(expected string) *EventTableShowOutputAssert {
+	e.StringValueSet("schema_name", expected)
+	return e
+}
+
+func (e *EventTableShowOutputAssert) HasOwner(expected string) *EventTableShowOutputAssert {
+	e.StringValueSet("owner", expected)
+	return e
+}
+
+func (e *EventTableShowOutputAssert) HasComment(expected string) *EventTableShowOutputAssert {
+	e.StringValueSet("comment", expected)
+	return e
+}
+
+func (e *EventTableShowOutputAssert) HasOwnerRoleType(expected string) *EventTableShowOutputAssert {
+	e.StringValueSet("owner_role_type", expected)
+	return e
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (e *EventTableShowOutputAssert) HasNoCreatedOn() *EventTableShowOutputAssert {
+	e.ValueNotSet("created_on")
+	return e
+}
+
+func (e *EventTableShowOutputAssert) HasNoName() *EventTableShowOutputAssert {
+	e.ValueNotSet("name")
+	return e
+}
+
+func (e *EventTableShowOutputAssert) HasNoDatabaseName() *EventTableShowOutputAssert {
+	e.ValueNotSet("database_name")
+	return e
+}
+
+func (e *EventTableShowOutputAssert) HasNoSchemaName() *EventTableShowOutputAssert {
+	e.ValueNotSet("schema_name")
+	return e
+}
+
+func (e *EventTableShowOutputAssert) HasNoOwner() *EventTableShowOutputAssert {
+	e.ValueNotSet("owner")
+	return e
+}
+
+func (e *EventTableShowOutputAssert) HasNoComment() *EventTableShowOutputAssert {
+	e.ValueNotSet("comment")
+	return e
+}
+
+func (e *EventTableShowOutputAssert) HasNoOwnerRoleType() *EventTableShowOutputAssert {
+	e.ValueNotSet("owner_role_type")
+	return e
+}
@@ -19,19 +19,26 @@ type SdkObjectShowOutputDetails struct {
 var dataSourceMappingNormalized = map[string]dataSourceDef{
 	// Show output - standard:
 	normalized(sdk.Account{}):                   {"Accounts"},
+	normalized(sdk.AggregationPolicy{}):         {"AggregationPolicies"},
 	normalized(sdk.ApiIntegration{}):            {"ApiIntegrations"},
+	normalized(sdk.Application{}):               {"Applications"},
+	normalized(sdk.ApplicationPackage{}):        {"ApplicationPackages"},
 	normalized(sdk.AuthenticationPolicy{}):      {"AuthenticationPolicies"},
+	normalized(sdk.BackupPolicy{}):              {"BackupPolicies"},
+	normalized(sdk.Budget{}):                    {"Budgets"},
 	normalized(sdk.CatalogIntegration{}):        {"CatalogIntegrations"},
 	normalized(sdk.ComputePool{}):               {"ComputePools"},
 	normalized(sdk.CortexAgent{}):               {"CortexAgents"},
 	normalized(sdk.Database{}):                  {"Databases"},
 	normalized(sdk.DatabaseRole{}):              {"DatabaseRoles"},
+	normalized(sdk.EventTable{}):                {"EventTables"},
 	normalized(sdk.ExternalAccessIntegration{}): {"ExternalAccessIntegrations"},
 	normalized(sdk.ExternalVolume{}):            {"ExternalVolumes"},
 	normalized(sdk.FileFormat{}):                {"FileFormats"},
 	normalized(sdk.GitRepository{}):             {"GitRepositories"},
 	normalized(sdk.IcebergTable{}):              {"IcebergTables"},
 	normalized(sdk.ImageRepository{}):           {"ImageRepositories"},
+	normalized(sdk.JoinPolicy{}):                {"JoinPolicies"},
 	normalized(sdk.Listing{}):                   {"Listings"},
 	normalized(sdk.MaskingPolicy{}):             {"MaskingPolicies"},
 	normalized(sdk.McpServer{}):                 {"McpServers"},
@@ -42,6 +49,7 @@ var dataSourceMappingNormalized = map[string]dataSourceDef{
 	normalized(sdk.Notebook{}):                  {"Notebooks"},
 	normalized(sdk.PasswordPolicy{}):            {"PasswordPolicies"},
 	normalized(sdk.ProgrammaticAccessToken{}):   {"UserProgrammaticAccessTokens"},
+	normalized(sdk.ProjectionPolicy{}):          {"ProjectionPolicies"},
 	normalized(sdk.ResourceMonitor{}):           {"ResourceMonitors"},
 	normalized(sdk.Role{}):                      {"AccountRoles"},
 	normalized(sdk.RowAccessPolicy{}):           {"RowAccessPolicies"},
@@ -63,6 +71,7 @@ var dataSourceMappingNormalized = map[string]dataSourceDef{
 
 	// Describe output:
 	normalized(sdk.ApiIntegrationAllDetails{}):      {"ApiIntegrations"},
+	normalized(sdk.BackupPolicyDetails{}):           {"BackupPolicies"},
 	normalized(sdk.CatalogIntegrationAllDetails{}):  {"CatalogIntegrations"},
 	normalized(sdk.CortexAgentDetails{}):            {"CortexAgents"},
 	normalized(sdk.ExternalVolumeDetails{}):         {"ExternalVolumes"},
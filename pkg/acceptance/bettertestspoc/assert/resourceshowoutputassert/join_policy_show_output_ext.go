@@ -0,0 +1,6 @@
+package resourceshowoutputassert
+
+func (p *JoinPolicyShowOutputAssert) HasCreatedOnNotEmpty() *JoinPolicyShowOutputAssert {
+	p.ValuePresent("created_on")
+	return p
+}
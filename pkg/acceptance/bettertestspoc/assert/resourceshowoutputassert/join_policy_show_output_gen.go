@@ -0,0 +1,145 @@
+// Code generated by resource show output assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceshowoutputassert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type JoinPolicyShowOutputAssert struct {
+	*assert.ResourceAssert
+}
+
+func JoinPolicyShowOutput(t *testing.T, name string) *JoinPolicyShowOutputAssert {
+	t.Helper()
+
+	joinPolicyAssert := JoinPolicyShowOutputAssert{
+		ResourceAssert: assert.NewResourceShowOutputAssert(name),
+	}
+	return &joinPolicyAssert
+}
+
+func ImportedJoinPolicyShowOutput(t *testing.T, id string) *JoinPolicyShowOutputAssert {
+	t.Helper()
+
+	joinPolicyAssert := JoinPolicyShowOutputAssert{
+		ResourceAssert: assert.NewImportedResourceShowOutputAssert(id),
+	}
+	return &joinPolicyAssert
+}
+
+func JoinPoliciesDatasourceShowOutput(t *testing.T, name string) *JoinPolicyShowOutputAssert {
+	t.Helper()
+
+	return JoinPoliciesDatasourceShowOutputOnIdx(t, name, 0)
+}
+
+func JoinPoliciesDatasourceShowOutputOnIdx(t *testing.T, name string, idx int) *JoinPolicyShowOutputAssert {
+	t.Helper()
+
+	joinPolicyAssert := JoinPolicyShowOutputAssert{
+		ResourceAssert: assert.NewDatasourceShowOutputAssert(name, "join_policies", idx),
+	}
+	return &joinPolicyAssert
+}
+
+////////////////////////////
+// Attribute value checks //
+////////////////////////////
+
+func (j *JoinPolicyShowOutputAssert) HasCreatedOn(expected time.Time) *JoinPolicyShowOutputAssert {
+	j.StringValueSet("created_on", expected.String())
+	return j
+}
+
+func (j *JoinPolicyShowOutputAssert) HasName(expected string) *JoinPolicyShowOutputAssert {
+	j.StringValueSet("name", expected)
+	return j
+}
+
+func (j *JoinPolicyShowOutputAssert) HasDatabaseName(expected string) *JoinPolicyShowOutputAssert {
+	j.StringValueSet("database_name", expected)
+	return j
+}
+
+func (j *JoinPolicyShowOutputAssert) HasSchemaName(expected string) *JoinPolicyShowOutputAssert {
+	j.StringValueSet("schema_name", expected)
+	return j
+}
+
+func (j *JoinPolicyShowOutputAssert) HasKind(expected string) *JoinPolicyShowOutputAssert {
+	j.StringValueSet("kind", expected)
+	return j
+}
+
+func (j *JoinPolicyShowOutputAssert) HasOwner(expected string) *JoinPolicyShowOutputAssert {
+	j.StringValueSet("owner", expected)
+	return j
+}
+
+func (j *JoinPolicyShowOutputAssert) HasComment(expected string) *JoinPolicyShowOutputAssert {
+	j.StringValueSet("comment", expected)
+	return j
+}
+
+func (j *JoinPolicyShowOutputAssert) HasOptions(expected string) *JoinPolicyShowOutputAssert {
+	j.StringValueSet("options", expected)
+	return j
+}
+
+func (j *JoinPolicyShowOutputAssert) HasOwnerRoleType(expected string) *JoinPolicyShowOutputAssert {
+	j.StringValueSet("owner_role_type", expected)
+	return j
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (j *JoinPolicyShowOutputAssert) HasNoCreatedOn() *JoinPolicyShowOutputAssert {
+	j.ValueNotSet("created_on")
+	return j
+}
+
+func (j *JoinPolicyShowOutputAssert) HasNoName() *JoinPolicyShowOutputAssert {
+	j.ValueNotSet("name")
+	return j
+}
+
+func (j *JoinPolicyShowOutputAssert) HasNoDatabaseName() *JoinPolicyShowOutputAssert {
+	j.ValueNotSet("database_name")
+	return j
+}
+
+func (j *JoinPolicyShowOutputAssert) HasNoSchemaName() *JoinPolicyShowOutputAssert {
+	j.ValueNotSet("schema_name")
+	return j
+}
+
+func (j *JoinPolicyShowOutputAssert) HasNoKind() *JoinPolicyShowOutputAssert {
+	j.ValueNotSet("kind")
+	return j
+}
+
+func (j *JoinPolicyShowOutputAssert) HasNoOwner() *JoinPolicyShowOutputAssert {
+	j.ValueNotSet("owner")
+	return j
+}
+
+func (j *JoinPolicyShowOutputAssert) HasNoComment() *JoinPolicyShowOutputAssert {
+	j.ValueNotSet("comment")
+	return j
+}
+
+func (j *JoinPolicyShowOutputAssert) HasNoOptions() *JoinPolicyShowOutputAssert {
+	j.ValueNotSet("options")
+	return j
+}
+
+func (j *JoinPolicyShowOutputAssert) HasNoOwnerRoleType() *JoinPolicyShowOutputAssert {
+	j.ValueNotSet("owner_role_type")
+	return j
+}
@@ -0,0 +1,6 @@
+package resourceshowoutputassert
+
+func (p *ProjectionPolicyShowOutputAssert) HasCreatedOnNotEmpty() *ProjectionPolicyShowOutputAssert {
+	p.ValuePresent("created_on")
+	return p
+}
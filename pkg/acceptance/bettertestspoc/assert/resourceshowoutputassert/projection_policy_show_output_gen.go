@@ -0,0 +1,145 @@
+// Code generated by resource show output assertions generator (v0.1.0); DO NOT EDIT.
+
+package resourceshowoutputassert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+)
+
+type ProjectionPolicyShowOutputAssert struct {
+	*assert.ResourceAssert
+}
+
+func ProjectionPolicyShowOutput(t *testing.T, name string) *ProjectionPolicyShowOutputAssert {
+	t.Helper()
+
+	projectionPolicyAssert := ProjectionPolicyShowOutputAssert{
+		ResourceAssert: assert.NewResourceShowOutputAssert(name),
+	}
+	return &projectionPolicyAssert
+}
+
+func ImportedProjectionPolicyShowOutput(t *testing.T, id string) *ProjectionPolicyShowOutputAssert {
+	t.Helper()
+
+	projectionPolicyAssert := ProjectionPolicyShowOutputAssert{
+		ResourceAssert: assert.NewImportedResourceShowOutputAssert(id),
+	}
+	return &projectionPolicyAssert
+}
+
+func ProjectionPoliciesDatasourceShowOutput(t *testing.T, name string) *ProjectionPolicyShowOutputAssert {
+	t.Helper()
+
+	return ProjectionPoliciesDatasourceShowOutputOnIdx(t, name, 0)
+}
+
+func ProjectionPoliciesDatasourceShowOutputOnIdx(t *testing.T, name string, idx int) *ProjectionPolicyShowOutputAssert {
+	t.Helper()
+
+	projectionPolicyAssert := ProjectionPolicyShowOutputAssert{
+		ResourceAssert: assert.NewDatasourceShowOutputAssert(name, "projection_policies", idx),
+	}
+	return &projectionPolicyAssert
+}
+
+////////////////////////////
+// Attribute value checks //
+////////////////////////////
+
+func (p *ProjectionPolicyShowOutputAssert) HasCreatedOn(expected time.Time) *ProjectionPolicyShowOutputAssert {
+	p.StringValueSet("created_on", expected.String())
+	return p
+}
+
+func (p *ProjectionPolicyShowOutputAssert) HasName(expected string) *ProjectionPolicyShowOutputAssert {
+	p.StringValueSet("name", expected)
+	return p
+}
+
+func (p *ProjectionPolicyShowOutputAssert) HasDatabaseName(expected string) *ProjectionPolicyShowOutputAssert {
+	p.StringValueSet("database_name", expected)
+	return p
+}
+
+func (p *ProjectionPolicyShowOutputAssert) HasSchemaName(expected string) *ProjectionPolicyShowOutputAssert {
+	p.StringValueSet("schema_name", expected)
+	return p
+}
+
+func (p *ProjectionPolicyShowOutputAssert) HasKind(expected string) *ProjectionPolicyShowOutputAssert {
+	p.StringValueSet("kind", expected)
+	return p
+}
+
+func (p *ProjectionPolicyShowOutputAssert) HasOwner(expected string) *ProjectionPolicyShowOutputAssert {
+	p.StringValueSet("owner", expected)
+	return p
+}
+
+func (p *ProjectionPolicyShowOutputAssert) HasComment(expected string) *ProjectionPolicyShowOutputAssert {
+	p.StringValueSet("comment", expected)
+	return p
+}
+
+func (p *ProjectionPolicyShowOutputAssert) HasOptions(expected string) *ProjectionPolicyShowOutputAssert {
+	p.StringValueSet("options", expected)
+	return p
+}
+
+func (p *ProjectionPolicyShowOutputAssert) HasOwnerRoleType(expected string) *ProjectionPolicyShowOutputAssert {
+	p.StringValueSet("owner_role_type", expected)
+	return p
+}
+
+///////////////////////////////
+// Attribute no value checks //
+///////////////////////////////
+
+func (p *ProjectionPolicyShowOutputAssert) HasNoCreatedOn() *ProjectionPolicyShowOutputAssert {
+	p.ValueNotSet("created_on")
+	return p
+}
+
+func (p *ProjectionPolicyShowOutputAssert) HasNoName() *ProjectionPolicyShowOutputAssert {
+	p.ValueNotSet("name")
+	return p
+}
+
+func (p *ProjectionPolicyShowOutputAssert) HasNoDatabaseName() *ProjectionPolicyShowOutputAssert {
+	p.ValueNotSet("database_name")
+	return p
+}
+
+func (p *ProjectionPolicyShowOutputAssert) HasNoSchemaName() *ProjectionPolicyShowOutputAssert {
+	p.ValueNotSet("schema_name")
+	return p
+}
+
+func (p *ProjectionPolicyShowOutputAssert) HasNoKind() *ProjectionPolicyShowOutputAssert {
+	p.ValueNotSet("kind")
+	return p
+}
+
+func (p *ProjectionPolicyShowOutputAssert) HasNoOwner() *ProjectionPolicyShowOutputAssert {
+	p.ValueNotSet("owner")
+	return p
+}
+
+func (p *ProjectionPolicyShowOutputAssert) HasNoComment() *ProjectionPolicyShowOutputAssert {
+	p.ValueNotSet("comment")
+	return p
+}
+
+func (p *ProjectionPolicyShowOutputAssert) HasNoOptions() *ProjectionPolicyShowOutputAssert {
+	p.ValueNotSet("options")
+	return p
+}
+
+func (p *ProjectionPolicyShowOutputAssert) HasNoOwnerRoleType() *ProjectionPolicyShowOutputAssert {
+	p.ValueNotSet("owner_role_type")
+	return p
+}
@@ -0,0 +1,31 @@
+package datasourcemodel
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func (b *AggregationPoliciesModel) WithRowsAndFrom(rows int, from string) *AggregationPoliciesModel {
+	return b.WithLimitValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"rows": tfconfig.IntegerVariable(rows),
+			"from": tfconfig.StringVariable(from),
+		}),
+	)
+}
+
+func (b *AggregationPoliciesModel) WithInDatabase(databaseId sdk.AccountObjectIdentifier) *AggregationPoliciesModel {
+	return b.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"database": tfconfig.StringVariable(databaseId.Name()),
+		}),
+	)
+}
+
+func (b *AggregationPoliciesModel) WithInSchema(schemaId sdk.DatabaseObjectIdentifier) *AggregationPoliciesModel {
+	return b.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"schema": tfconfig.StringVariable(schemaId.FullyQualifiedName()),
+		}),
+	)
+}
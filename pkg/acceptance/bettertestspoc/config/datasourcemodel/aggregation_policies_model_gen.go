@@ -0,0 +1,108 @@
+// Code generated by data source model builder generator (v0.1.0); DO NOT EDIT.
+
+package datasourcemodel
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type AggregationPoliciesModel struct {
+	AggregationPolicies tfconfig.Variable `json:"aggregation_policies,omitempty"`
+	In                  tfconfig.Variable `json:"in,omitempty"`
+	Like                tfconfig.Variable `json:"like,omitempty"`
+	Limit               tfconfig.Variable `json:"limit,omitempty"`
+	WithDescribe        tfconfig.Variable `json:"with_describe,omitempty"`
+
+	*config.DatasourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func AggregationPolicies(
+	datasourceName string,
+) *AggregationPoliciesModel {
+	a := &AggregationPoliciesModel{DatasourceModelMeta: config.DatasourceMeta(datasourceName, datasources.AggregationPolicies)}
+	return a
+}
+
+func AggregationPoliciesWithDefaultMeta() *AggregationPoliciesModel {
+	a := &AggregationPoliciesModel{DatasourceModelMeta: config.DatasourceDefaultMeta(datasources.AggregationPolicies)}
+	return a
+}
+
+///////////////////////////////////////////////////////
+// set proper json marshalling and handle depends on //
+///////////////////////////////////////////////////////
+
+func (a *AggregationPoliciesModel) MarshalJSON() ([]byte, error) {
+	type Alias AggregationPoliciesModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn                 []string                      `json:"depends_on,omitempty"`
+		SingleAttributeWorkaround config.ReplacementPlaceholder `json:"single_attribute_workaround,omitempty"`
+	}{
+		Alias:                     (*Alias)(a),
+		DependsOn:                 a.DependsOn(),
+		SingleAttributeWorkaround: config.SnowflakeProviderConfigSingleAttributeWorkaround,
+	})
+}
+
+func (a *AggregationPoliciesModel) WithDependsOn(values ...string) *AggregationPoliciesModel {
+	a.SetDependsOn(values...)
+	return a
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+// aggregation_policies attribute type is not yet supported, so WithAggregationPolicies can't be generated
+
+// in attribute type is not yet supported, so WithIn can't be generated
+
+func (a *AggregationPoliciesModel) WithLike(like string) *AggregationPoliciesModel {
+	a.Like = tfconfig.StringVariable(like)
+	return a
+}
+
+// limit attribute type is not yet supported, so WithLimit can't be generated
+
+func (a *AggregationPoliciesModel) WithWithDescribe(withDescribe bool) *AggregationPoliciesModel {
+	a.WithDescribe = tfconfig.BoolVariable(withDescribe)
+	return a
+}
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (a *AggregationPoliciesModel) WithAggregationPoliciesValue(value tfconfig.Variable) *AggregationPoliciesModel {
+	a.AggregationPolicies = value
+	return a
+}
+
+func (a *AggregationPoliciesModel) WithInValue(value tfconfig.Variable) *AggregationPoliciesModel {
+	a.In = value
+	return a
+}
+
+func (a *AggregationPoliciesModel) WithLikeValue(value tfconfig.Variable) *AggregationPoliciesModel {
+	a.Like = value
+	return a
+}
+
+func (a *AggregationPoliciesModel) WithLimitValue(value tfconfig.Variable) *AggregationPoliciesModel {
+	a.Limit = value
+	return a
+}
+
+func (a *AggregationPoliciesModel) WithWithDescribeValue(value tfconfig.Variable) *AggregationPoliciesModel {
+	a.WithDescribe = value
+	return a
+}
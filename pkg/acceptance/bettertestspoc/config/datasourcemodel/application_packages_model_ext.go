@@ -0,0 +1,14 @@
+package datasourcemodel
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func (b *ApplicationPackagesModel) WithRowsAndFrom(rows int, from string) *ApplicationPackagesModel {
+	return b.WithLimitValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"rows": tfconfig.IntegerVariable(rows),
+			"from": tfconfig.StringVariable(from),
+		}),
+	)
+}
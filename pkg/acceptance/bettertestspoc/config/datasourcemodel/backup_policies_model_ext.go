@@ -0,0 +1,31 @@
+package datasourcemodel
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func (b *BackupPoliciesModel) WithRowsAndFrom(rows int, from string) *BackupPoliciesModel {
+	return b.WithLimitValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"rows": tfconfig.IntegerVariable(rows),
+			"from": tfconfig.StringVariable(from),
+		}),
+	)
+}
+
+func (b *BackupPoliciesModel) WithInDatabase(databaseId sdk.AccountObjectIdentifier) *BackupPoliciesModel {
+	return b.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"database": tfconfig.StringVariable(databaseId.Name()),
+		}),
+	)
+}
+
+func (b *BackupPoliciesModel) WithInSchema(schemaId sdk.DatabaseObjectIdentifier) *BackupPoliciesModel {
+	return b.WithInValue(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"schema": tfconfig.StringVariable(schemaId.FullyQualifiedName()),
+		}),
+	)
+}
@@ -0,0 +1,89 @@
+// Code generated by data source model builder generator (v0.1.0); DO NOT EDIT.
+
+package datasourcemodel
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type BudgetsModel struct {
+	Budgets tfconfig.Variable `json:"budgets,omitempty"`
+	In      tfconfig.Variable `json:"in,omitempty"`
+	Like    tfconfig.Variable `json:"like,omitempty"`
+
+	*config.DatasourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func Budgets(
+	datasourceName string,
+) *BudgetsModel {
+	b := &BudgetsModel{DatasourceModelMeta: config.DatasourceMeta(datasourceName, datasources.Budgets)}
+	return b
+}
+
+func BudgetsWithDefaultMeta() *BudgetsModel {
+	b := &BudgetsModel{DatasourceModelMeta: config.DatasourceDefaultMeta(datasources.Budgets)}
+	return b
+}
+
+///////////////////////////////////////////////////////
+// set proper json marshalling and handle depends on //
+///////////////////////////////////////////////////////
+
+func (b *BudgetsModel) MarshalJSON() ([]byte, error) {
+	type Alias BudgetsModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn                 []string                      `json:"depends_on,omitempty"`
+		SingleAttributeWorkaround config.ReplacementPlaceholder `json:"single_attribute_workaround,omitempty"`
+	}{
+		Alias:                     (*Alias)(b),
+		DependsOn:                 b.DependsOn(),
+		SingleAttributeWorkaround: config.SnowflakeProviderConfigSingleAttributeWorkaround,
+	})
+}
+
+func (b *BudgetsModel) WithDependsOn(values ...string) *BudgetsModel {
+	b.SetDependsOn(values...)
+	return b
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+// budgets attribute type is not yet supported, so WithBudgets can't be generated
+
+// in attribute type is not yet supported, so WithIn can't be generated
+
+func (b *BudgetsModel) WithLike(like string) *BudgetsModel {
+	b.Like = tfconfig.StringVariable(like)
+	return b
+}
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (b *BudgetsModel) WithBudgetsValue(value tfconfig.Variable) *BudgetsModel {
+	b.Budgets = value
+	return b
+}
+
+func (b *BudgetsModel) WithInValue(value tfconfig.Variable) *BudgetsModel {
+	b.In = value
+	return b
+}
+
+func (b *BudgetsModel) WithLikeValue(value tfconfig.Variable) *BudgetsModel {
+	b.Like = value
+	return b
+}
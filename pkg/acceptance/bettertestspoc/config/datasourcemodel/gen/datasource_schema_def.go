@@ -30,14 +30,34 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "AccountRoles",
 		schema: datasources.AccountRoles().Schema,
 	},
+	{
+		name:   "AggregationPolicies",
+		schema: datasources.AggregationPolicies().Schema,
+	},
 	{
 		name:   "ApiIntegrations",
 		schema: datasources.ApiIntegrations().Schema,
 	},
+	{
+		name:   "ApplicationPackages",
+		schema: datasources.ApplicationPackages().Schema,
+	},
+	{
+		name:   "Applications",
+		schema: datasources.Applications().Schema,
+	},
 	{
 		name:   "AuthenticationPolicies",
 		schema: datasources.AuthenticationPolicies().Schema,
 	},
+	{
+		name:   "BackupPolicies",
+		schema: datasources.BackupPolicies().Schema,
+	},
+	{
+		name:   "Budgets",
+		schema: datasources.Budgets().Schema,
+	},
 	{
 		name:   "CatalogIntegrations",
 		schema: datasources.CatalogIntegrations().Schema,
@@ -50,6 +70,10 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "ComputePools",
 		schema: datasources.ComputePools().Schema,
 	},
+	{
+		name:   "DataMetricFunctionReferences",
+		schema: datasources.DataMetricFunctionReferences().Schema,
+	},
 	{
 		name:   "Database",
 		schema: datasources.Database().Schema,
@@ -66,6 +90,10 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "Databases",
 		schema: datasources.Databases().Schema,
 	},
+	{
+		name:   "EventTables",
+		schema: datasources.EventTables().Schema,
+	},
 	{
 		name:   "ExternalAccessIntegrations",
 		schema: datasources.ExternalAccessIntegrations().Schema,
@@ -74,6 +102,10 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "ExternalVolumes",
 		schema: datasources.ExternalVolumes().Schema,
 	},
+	{
+		name:   "FailoverGroupMembers",
+		schema: datasources.FailoverGroupMembers().Schema,
+	},
 	{
 		name:   "FileFormats",
 		schema: datasources.FileFormats().Schema,
@@ -86,6 +118,10 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "GitRepositories",
 		schema: datasources.GitRepositories().Schema,
 	},
+	{
+		name:   "GitRepositoryRefs",
+		schema: datasources.GitRepositoryRefs().Schema,
+	},
 	{
 		name:   "Grants",
 		schema: datasources.Grants().Schema,
@@ -98,6 +134,10 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "ImageRepositories",
 		schema: datasources.ImageRepositories().Schema,
 	},
+	{
+		name:   "JoinPolicies",
+		schema: datasources.JoinPolicies().Schema,
+	},
 	{
 		name:   "Listings",
 		schema: datasources.Listings().Schema,
@@ -126,10 +166,34 @@ var allDatasourcesSchemaDefs = []DatasourceSchemaDef{
 		name:   "Notebooks",
 		schema: datasources.Notebooks().Schema,
 	},
+	{
+		name:   "OrganizationAccounts",
+		schema: datasources.OrganizationAccounts().Schema,
+	},
+	{
+		name:   "PolicyReferences",
+		schema: datasources.PolicyReferences().Schema,
+	},
 	{
 		name:   "Procedures",
 		schema: datasources.Procedures().Schema,
 	},
+	{
+		name:   "ProjectionPolicies",
+		schema: datasources.ProjectionPolicies().Schema,
+	},
+	{
+		name:   "Regions",
+		schema: datasources.Regions().Schema,
+	},
+	{
+		name:   "ReplicationAccounts",
+		schema: datasources.ReplicationAccounts().Schema,
+	},
+	{
+		name:   "ReplicationDatabases",
+		schema: datasources.ReplicationDatabases().Schema,
+	},
 	{
 		name:   "ResourceMonitors",
 		schema: datasources.ResourceMonitors().Schema,
@@ -0,0 +1,108 @@
+// Code generated by data source model builder generator (v0.1.0); DO NOT EDIT.
+
+package datasourcemodel
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type JoinPoliciesModel struct {
+	In           tfconfig.Variable `json:"in,omitempty"`
+	JoinPolicies tfconfig.Variable `json:"join_policies,omitempty"`
+	Like         tfconfig.Variable `json:"like,omitempty"`
+	Limit        tfconfig.Variable `json:"limit,omitempty"`
+	WithDescribe tfconfig.Variable `json:"with_describe,omitempty"`
+
+	*config.DatasourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func JoinPolicies(
+	datasourceName string,
+) *JoinPoliciesModel {
+	j := &JoinPoliciesModel{DatasourceModelMeta: config.DatasourceMeta(datasourceName, datasources.JoinPolicies)}
+	return j
+}
+
+func JoinPoliciesWithDefaultMeta() *JoinPoliciesModel {
+	j := &JoinPoliciesModel{DatasourceModelMeta: config.DatasourceDefaultMeta(datasources.JoinPolicies)}
+	return j
+}
+
+///////////////////////////////////////////////////////
+// set proper json marshalling and handle depends on //
+///////////////////////////////////////////////////////
+
+func (j *JoinPoliciesModel) MarshalJSON() ([]byte, error) {
+	type Alias JoinPoliciesModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn                 []string                      `json:"depends_on,omitempty"`
+		SingleAttributeWorkaround config.ReplacementPlaceholder `json:"single_attribute_workaround,omitempty"`
+	}{
+		Alias:                     (*Alias)(j),
+		DependsOn:                 j.DependsOn(),
+		SingleAttributeWorkaround: config.SnowflakeProviderConfigSingleAttributeWorkaround,
+	})
+}
+
+func (j *JoinPoliciesModel) WithDependsOn(values ...string) *JoinPoliciesModel {
+	j.SetDependsOn(values...)
+	return j
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+// in attribute type is not yet supported, so WithIn can't be generated
+
+// join_policies attribute type is not yet supported, so WithJoinPolicies can't be generated
+
+func (j *JoinPoliciesModel) WithLike(like string) *JoinPoliciesModel {
+	j.Like = tfconfig.StringVariable(like)
+	return j
+}
+
+// limit attribute type is not yet supported, so WithLimit can't be generated
+
+func (j *JoinPoliciesModel) WithWithDescribe(withDescribe bool) *JoinPoliciesModel {
+	j.WithDescribe = tfconfig.BoolVariable(withDescribe)
+	return j
+}
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (j *JoinPoliciesModel) WithInValue(value tfconfig.Variable) *JoinPoliciesModel {
+	j.In = value
+	return j
+}
+
+func (j *JoinPoliciesModel) WithJoinPoliciesValue(value tfconfig.Variable) *JoinPoliciesModel {
+	j.JoinPolicies = value
+	return j
+}
+
+func (j *JoinPoliciesModel) WithLikeValue(value tfconfig.Variable) *JoinPoliciesModel {
+	j.Like = value
+	return j
+}
+
+func (j *JoinPoliciesModel) WithLimitValue(value tfconfig.Variable) *JoinPoliciesModel {
+	j.Limit = value
+	return j
+}
+
+func (j *JoinPoliciesModel) WithWithDescribeValue(value tfconfig.Variable) *JoinPoliciesModel {
+	j.WithDescribe = value
+	return j
+}
@@ -0,0 +1,81 @@
+// Code generated by data source model builder generator (v0.1.0); DO NOT EDIT.
+
+package datasourcemodel
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type OrganizationAccountsModel struct {
+	Like                 tfconfig.Variable `json:"like,omitempty"`
+	OrganizationAccounts tfconfig.Variable `json:"organization_accounts,omitempty"`
+
+	*config.DatasourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func OrganizationAccounts(
+	datasourceName string,
+) *OrganizationAccountsModel {
+	o := &OrganizationAccountsModel{DatasourceModelMeta: config.DatasourceMeta(datasourceName, datasources.OrganizationAccounts)}
+	return o
+}
+
+func OrganizationAccountsWithDefaultMeta() *OrganizationAccountsModel {
+	o := &OrganizationAccountsModel{DatasourceModelMeta: config.DatasourceDefaultMeta(datasources.OrganizationAccounts)}
+	return o
+}
+
+///////////////////////////////////////////////////////
+// set proper json marshalling and handle depends on //
+///////////////////////////////////////////////////////
+
+func (o *OrganizationAccountsModel) MarshalJSON() ([]byte, error) {
+	type Alias OrganizationAccountsModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn                 []string                      `json:"depends_on,omitempty"`
+		SingleAttributeWorkaround config.ReplacementPlaceholder `json:"single_attribute_workaround,omitempty"`
+	}{
+		Alias:                     (*Alias)(o),
+		DependsOn:                 o.DependsOn(),
+		SingleAttributeWorkaround: config.SnowflakeProviderConfigSingleAttributeWorkaround,
+	})
+}
+
+func (o *OrganizationAccountsModel) WithDependsOn(values ...string) *OrganizationAccountsModel {
+	o.SetDependsOn(values...)
+	return o
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+func (o *OrganizationAccountsModel) WithLike(like string) *OrganizationAccountsModel {
+	o.Like = tfconfig.StringVariable(like)
+	return o
+}
+
+// organization_accounts attribute type is not yet supported, so WithOrganizationAccounts can't be generated
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (o *OrganizationAccountsModel) WithLikeValue(value tfconfig.Variable) *OrganizationAccountsModel {
+	o.Like = value
+	return o
+}
+
+func (o *OrganizationAccountsModel) WithOrganizationAccountsValue(value tfconfig.Variable) *OrganizationAccountsModel {
+	o.OrganizationAccounts = value
+	return o
+}
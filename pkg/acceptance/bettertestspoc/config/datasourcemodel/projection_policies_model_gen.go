@@ -0,0 +1,108 @@
+// Code generated by data source model builder generator (v0.1.0); DO NOT EDIT.
+
+package datasourcemodel
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type ProjectionPoliciesModel struct {
+	In                 tfconfig.Variable `json:"in,omitempty"`
+	Like               tfconfig.Variable `json:"like,omitempty"`
+	Limit              tfconfig.Variable `json:"limit,omitempty"`
+	ProjectionPolicies tfconfig.Variable `json:"projection_policies,omitempty"`
+	WithDescribe       tfconfig.Variable `json:"with_describe,omitempty"`
+
+	*config.DatasourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func ProjectionPolicies(
+	datasourceName string,
+) *ProjectionPoliciesModel {
+	p := &ProjectionPoliciesModel{DatasourceModelMeta: config.DatasourceMeta(datasourceName, datasources.ProjectionPolicies)}
+	return p
+}
+
+func ProjectionPoliciesWithDefaultMeta() *ProjectionPoliciesModel {
+	p := &ProjectionPoliciesModel{DatasourceModelMeta: config.DatasourceDefaultMeta(datasources.ProjectionPolicies)}
+	return p
+}
+
+///////////////////////////////////////////////////////
+// set proper json marshalling and handle depends on //
+///////////////////////////////////////////////////////
+
+func (p *ProjectionPoliciesModel) MarshalJSON() ([]byte, error) {
+	type Alias ProjectionPoliciesModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn                 []string                      `json:"depends_on,omitempty"`
+		SingleAttributeWorkaround config.ReplacementPlaceholder `json:"single_attribute_workaround,omitempty"`
+	}{
+		Alias:                     (*Alias)(p),
+		DependsOn:                 p.DependsOn(),
+		SingleAttributeWorkaround: config.SnowflakeProviderConfigSingleAttributeWorkaround,
+	})
+}
+
+func (p *ProjectionPoliciesModel) WithDependsOn(values ...string) *ProjectionPoliciesModel {
+	p.SetDependsOn(values...)
+	return p
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+// in attribute type is not yet supported, so WithIn can't be generated
+
+func (p *ProjectionPoliciesModel) WithLike(like string) *ProjectionPoliciesModel {
+	p.Like = tfconfig.StringVariable(like)
+	return p
+}
+
+// limit attribute type is not yet supported, so WithLimit can't be generated
+
+// projection_policies attribute type is not yet supported, so WithProjectionPolicies can't be generated
+
+func (p *ProjectionPoliciesModel) WithWithDescribe(withDescribe bool) *ProjectionPoliciesModel {
+	p.WithDescribe = tfconfig.BoolVariable(withDescribe)
+	return p
+}
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (p *ProjectionPoliciesModel) WithInValue(value tfconfig.Variable) *ProjectionPoliciesModel {
+	p.In = value
+	return p
+}
+
+func (p *ProjectionPoliciesModel) WithLikeValue(value tfconfig.Variable) *ProjectionPoliciesModel {
+	p.Like = value
+	return p
+}
+
+func (p *ProjectionPoliciesModel) WithLimitValue(value tfconfig.Variable) *ProjectionPoliciesModel {
+	p.Limit = value
+	return p
+}
+
+func (p *ProjectionPoliciesModel) WithProjectionPoliciesValue(value tfconfig.Variable) *ProjectionPoliciesModel {
+	p.ProjectionPolicies = value
+	return p
+}
+
+func (p *ProjectionPoliciesModel) WithWithDescribeValue(value tfconfig.Variable) *ProjectionPoliciesModel {
+	p.WithDescribe = value
+	return p
+}
@@ -0,0 +1,81 @@
+// Code generated by data source model builder generator (v0.1.0); DO NOT EDIT.
+
+package datasourcemodel
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type RegionsModel struct {
+	Like    tfconfig.Variable `json:"like,omitempty"`
+	Regions tfconfig.Variable `json:"regions,omitempty"`
+
+	*config.DatasourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func Regions(
+	datasourceName string,
+) *RegionsModel {
+	r := &RegionsModel{DatasourceModelMeta: config.DatasourceMeta(datasourceName, datasources.Regions)}
+	return r
+}
+
+func RegionsWithDefaultMeta() *RegionsModel {
+	r := &RegionsModel{DatasourceModelMeta: config.DatasourceDefaultMeta(datasources.Regions)}
+	return r
+}
+
+///////////////////////////////////////////////////////
+// set proper json marshalling and handle depends on //
+///////////////////////////////////////////////////////
+
+func (r *RegionsModel) MarshalJSON() ([]byte, error) {
+	type Alias RegionsModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn                 []string                      `json:"depends_on,omitempty"`
+		SingleAttributeWorkaround config.ReplacementPlaceholder `json:"single_attribute_workaround,omitempty"`
+	}{
+		Alias:                     (*Alias)(r),
+		DependsOn:                 r.DependsOn(),
+		SingleAttributeWorkaround: config.SnowflakeProviderConfigSingleAttributeWorkaround,
+	})
+}
+
+func (r *RegionsModel) WithDependsOn(values ...string) *RegionsModel {
+	r.SetDependsOn(values...)
+	return r
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+func (r *RegionsModel) WithLike(like string) *RegionsModel {
+	r.Like = tfconfig.StringVariable(like)
+	return r
+}
+
+// regions attribute type is not yet supported, so WithRegions can't be generated
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (r *RegionsModel) WithLikeValue(value tfconfig.Variable) *RegionsModel {
+	r.Like = value
+	return r
+}
+
+func (r *RegionsModel) WithRegionsValue(value tfconfig.Variable) *RegionsModel {
+	r.Regions = value
+	return r
+}
@@ -0,0 +1,70 @@
+// Code generated by data source model builder generator (v0.1.0); DO NOT EDIT.
+
+package datasourcemodel
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type ReplicationAccountsModel struct {
+	ReplicationAccounts tfconfig.Variable `json:"replication_accounts,omitempty"`
+
+	*config.DatasourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func ReplicationAccounts(
+	datasourceName string,
+) *ReplicationAccountsModel {
+	r := &ReplicationAccountsModel{DatasourceModelMeta: config.DatasourceMeta(datasourceName, datasources.ReplicationAccounts)}
+	return r
+}
+
+func ReplicationAccountsWithDefaultMeta() *ReplicationAccountsModel {
+	r := &ReplicationAccountsModel{DatasourceModelMeta: config.DatasourceDefaultMeta(datasources.ReplicationAccounts)}
+	return r
+}
+
+///////////////////////////////////////////////////////
+// set proper json marshalling and handle depends on //
+///////////////////////////////////////////////////////
+
+func (r *ReplicationAccountsModel) MarshalJSON() ([]byte, error) {
+	type Alias ReplicationAccountsModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn                 []string                      `json:"depends_on,omitempty"`
+		SingleAttributeWorkaround config.ReplacementPlaceholder `json:"single_attribute_workaround,omitempty"`
+	}{
+		Alias:                     (*Alias)(r),
+		DependsOn:                 r.DependsOn(),
+		SingleAttributeWorkaround: config.SnowflakeProviderConfigSingleAttributeWorkaround,
+	})
+}
+
+func (r *ReplicationAccountsModel) WithDependsOn(values ...string) *ReplicationAccountsModel {
+	r.SetDependsOn(values...)
+	return r
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+// replication_accounts attribute type is not yet supported, so WithReplicationAccounts can't be generated
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (r *ReplicationAccountsModel) WithReplicationAccountsValue(value tfconfig.Variable) *ReplicationAccountsModel {
+	r.ReplicationAccounts = value
+	return r
+}
@@ -0,0 +1,19 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func (a *AccountRoleMembershipModel) WithParentRoleNames(parentRoleIds ...sdk.AccountObjectIdentifier) *AccountRoleMembershipModel {
+	parentRoleNameVariables := collections.Map(parentRoleIds, func(id sdk.AccountObjectIdentifier) tfconfig.Variable { return tfconfig.StringVariable(id.Name()) })
+	a.WithParentRoleNamesValue(tfconfig.SetVariable(parentRoleNameVariables...))
+	return a
+}
+
+func (a *AccountRoleMembershipModel) WithUserNames(userIds ...sdk.AccountObjectIdentifier) *AccountRoleMembershipModel {
+	userNameVariables := collections.Map(userIds, func(id sdk.AccountObjectIdentifier) tfconfig.Variable { return tfconfig.StringVariable(id.Name()) })
+	a.WithUserNamesValue(tfconfig.SetVariable(userNameVariables...))
+	return a
+}
@@ -0,0 +1,106 @@
+// Code generated by resource model builder generator (v0.1.0); DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type AccountRoleMembershipModel struct {
+	ParentRoleNames tfconfig.Variable `json:"parent_role_names,omitempty"`
+	RoleName        tfconfig.Variable `json:"role_name,omitempty"`
+	UserNames       tfconfig.Variable `json:"user_names,omitempty"`
+
+	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
+
+	*config.ResourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func AccountRoleMembership(
+	resourceName string,
+	roleName string,
+) *AccountRoleMembershipModel {
+	a := &AccountRoleMembershipModel{ResourceModelMeta: config.Meta(resourceName, resources.AccountRoleMembership)}
+	a.WithRoleName(roleName)
+	return a
+}
+
+func AccountRoleMembershipWithDefaultMeta(
+	roleName string,
+) *AccountRoleMembershipModel {
+	a := &AccountRoleMembershipModel{ResourceModelMeta: config.DefaultMeta(resources.AccountRoleMembership)}
+	a.WithRoleName(roleName)
+	return a
+}
+
+///////////////////////////////////////////////////////////////////////
+// set proper json marshalling, handle depends on and dynamic blocks //
+///////////////////////////////////////////////////////////////////////
+
+func (a *AccountRoleMembershipModel) MarshalJSON() ([]byte, error) {
+	type Alias AccountRoleMembershipModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn []string         `json:"depends_on,omitempty"`
+		Timeouts  *config.Timeouts `json:"timeouts,omitempty"`
+	}{
+		Alias:     (*Alias)(a),
+		DependsOn: a.DependsOn(),
+		Timeouts:  a.Timeouts(),
+	})
+}
+
+func (a *AccountRoleMembershipModel) WithDependsOn(values ...string) *AccountRoleMembershipModel {
+	a.SetDependsOn(values...)
+	return a
+}
+
+func (a *AccountRoleMembershipModel) WithDynamicBlock(dynamicBlock *config.DynamicBlock) *AccountRoleMembershipModel {
+	a.DynamicBlock = dynamicBlock
+	return a
+}
+
+func (a *AccountRoleMembershipModel) WithTimeout(timeout config.Timeouts) *AccountRoleMembershipModel {
+	a.SetTimeout(timeout)
+	return a
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+// parent_role_names attribute type is not yet supported, so WithParentRoleNames can't be generated
+
+func (a *AccountRoleMembershipModel) WithRoleName(roleName string) *AccountRoleMembershipModel {
+	a.RoleName = tfconfig.StringVariable(roleName)
+	return a
+}
+
+// user_names attribute type is not yet supported, so WithUserNames can't be generated
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (a *AccountRoleMembershipModel) WithParentRoleNamesValue(value tfconfig.Variable) *AccountRoleMembershipModel {
+	a.ParentRoleNames = value
+	return a
+}
+
+func (a *AccountRoleMembershipModel) WithRoleNameValue(value tfconfig.Variable) *AccountRoleMembershipModel {
+	a.RoleName = value
+	return a
+}
+
+func (a *AccountRoleMembershipModel) WithUserNamesValue(value tfconfig.Variable) *AccountRoleMembershipModel {
+	a.UserNames = value
+	return a
+}
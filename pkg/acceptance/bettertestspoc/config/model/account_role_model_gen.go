@@ -14,6 +14,8 @@ type AccountRoleModel struct {
 	Name               tfconfig.Variable `json:"name,omitempty"`
 	Comment            tfconfig.Variable `json:"comment,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll            tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -92,6 +94,10 @@ func (a *AccountRoleModel) WithFullyQualifiedName(fullyQualifiedName string) *Ac
 	return a
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -110,3 +116,13 @@ func (a *AccountRoleModel) WithFullyQualifiedNameValue(value tfconfig.Variable)
 	a.FullyQualifiedName = value
 	return a
 }
+
+func (a *AccountRoleModel) WithTagsValue(value tfconfig.Variable) *AccountRoleModel {
+	a.Tags = value
+	return a
+}
+
+func (a *AccountRoleModel) WithTagsAllValue(value tfconfig.Variable) *AccountRoleModel {
+	a.TagsAll = value
+	return a
+}
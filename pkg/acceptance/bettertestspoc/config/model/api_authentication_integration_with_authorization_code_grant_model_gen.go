@@ -23,6 +23,8 @@ type ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel struct {
 	OauthClientSecret          tfconfig.Variable `json:"oauth_client_secret,omitempty"`
 	OauthRefreshTokenValidity  tfconfig.Variable `json:"oauth_refresh_token_validity,omitempty"`
 	OauthTokenEndpoint         tfconfig.Variable `json:"oauth_token_endpoint,omitempty"`
+	Tags                       tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                    tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -155,6 +157,10 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel) WithOauthT
 	return a
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -218,3 +224,13 @@ func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel) WithOauthT
 	a.OauthTokenEndpoint = value
 	return a
 }
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel) WithTagsValue(value tfconfig.Variable) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel {
+	a.Tags = value
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel) WithTagsAllValue(value tfconfig.Variable) *ApiAuthenticationIntegrationWithAuthorizationCodeGrantModel {
+	a.TagsAll = value
+	return a
+}
@@ -22,6 +22,8 @@ type ApiAuthenticationIntegrationWithClientCredentialsModel struct {
 	OauthClientSecret         tfconfig.Variable `json:"oauth_client_secret,omitempty"`
 	OauthRefreshTokenValidity tfconfig.Variable `json:"oauth_refresh_token_validity,omitempty"`
 	OauthTokenEndpoint        tfconfig.Variable `json:"oauth_token_endpoint,omitempty"`
+	Tags                      tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                   tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -149,6 +151,10 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsModel) WithOauthTokenE
 	return a
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -207,3 +213,13 @@ func (a *ApiAuthenticationIntegrationWithClientCredentialsModel) WithOauthTokenE
 	a.OauthTokenEndpoint = value
 	return a
 }
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsModel) WithTagsValue(value tfconfig.Variable) *ApiAuthenticationIntegrationWithClientCredentialsModel {
+	a.Tags = value
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithClientCredentialsModel) WithTagsAllValue(value tfconfig.Variable) *ApiAuthenticationIntegrationWithClientCredentialsModel {
+	a.TagsAll = value
+	return a
+}
@@ -23,6 +23,8 @@ type ApiAuthenticationIntegrationWithJwtBearerModel struct {
 	OauthClientSecret          tfconfig.Variable `json:"oauth_client_secret,omitempty"`
 	OauthRefreshTokenValidity  tfconfig.Variable `json:"oauth_refresh_token_validity,omitempty"`
 	OauthTokenEndpoint         tfconfig.Variable `json:"oauth_token_endpoint,omitempty"`
+	Tags                       tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                    tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -162,6 +164,10 @@ func (a *ApiAuthenticationIntegrationWithJwtBearerModel) WithOauthTokenEndpoint(
 	return a
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -225,3 +231,13 @@ func (a *ApiAuthenticationIntegrationWithJwtBearerModel) WithOauthTokenEndpointV
 	a.OauthTokenEndpoint = value
 	return a
 }
+
+func (a *ApiAuthenticationIntegrationWithJwtBearerModel) WithTagsValue(value tfconfig.Variable) *ApiAuthenticationIntegrationWithJwtBearerModel {
+	a.Tags = value
+	return a
+}
+
+func (a *ApiAuthenticationIntegrationWithJwtBearerModel) WithTagsAllValue(value tfconfig.Variable) *ApiAuthenticationIntegrationWithJwtBearerModel {
+	a.TagsAll = value
+	return a
+}
@@ -20,6 +20,8 @@ type ApiIntegrationAmazonApiGatewayModel struct {
 	Comment            tfconfig.Variable `json:"comment,omitempty"`
 	Enabled            tfconfig.Variable `json:"enabled,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll            tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -138,6 +140,10 @@ func (a *ApiIntegrationAmazonApiGatewayModel) WithFullyQualifiedName(fullyQualif
 	return a
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -186,3 +192,13 @@ func (a *ApiIntegrationAmazonApiGatewayModel) WithFullyQualifiedNameValue(value
 	a.FullyQualifiedName = value
 	return a
 }
+
+func (a *ApiIntegrationAmazonApiGatewayModel) WithTagsValue(value tfconfig.Variable) *ApiIntegrationAmazonApiGatewayModel {
+	a.Tags = value
+	return a
+}
+
+func (a *ApiIntegrationAmazonApiGatewayModel) WithTagsAllValue(value tfconfig.Variable) *ApiIntegrationAmazonApiGatewayModel {
+	a.TagsAll = value
+	return a
+}
@@ -20,6 +20,8 @@ type ApiIntegrationAzureApiManagementModel struct {
 	Comment              tfconfig.Variable `json:"comment,omitempty"`
 	Enabled              tfconfig.Variable `json:"enabled,omitempty"`
 	FullyQualifiedName   tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	Tags                 tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll              tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -138,6 +140,10 @@ func (a *ApiIntegrationAzureApiManagementModel) WithFullyQualifiedName(fullyQual
 	return a
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -186,3 +192,13 @@ func (a *ApiIntegrationAzureApiManagementModel) WithFullyQualifiedNameValue(valu
 	a.FullyQualifiedName = value
 	return a
 }
+
+func (a *ApiIntegrationAzureApiManagementModel) WithTagsValue(value tfconfig.Variable) *ApiIntegrationAzureApiManagementModel {
+	a.Tags = value
+	return a
+}
+
+func (a *ApiIntegrationAzureApiManagementModel) WithTagsAllValue(value tfconfig.Variable) *ApiIntegrationAzureApiManagementModel {
+	a.TagsAll = value
+	return a
+}
@@ -18,6 +18,8 @@ type ApiIntegrationExternalMcpDynamicClientModel struct {
 	Enabled            tfconfig.Variable `json:"enabled,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	OauthResourceUrl   tfconfig.Variable `json:"oauth_resource_url,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll            tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -122,6 +124,10 @@ func (a *ApiIntegrationExternalMcpDynamicClientModel) WithOauthResourceUrl(oauth
 	return a
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -160,3 +166,13 @@ func (a *ApiIntegrationExternalMcpDynamicClientModel) WithOauthResourceUrlValue(
 	a.OauthResourceUrl = value
 	return a
 }
+
+func (a *ApiIntegrationExternalMcpDynamicClientModel) WithTagsValue(value tfconfig.Variable) *ApiIntegrationExternalMcpDynamicClientModel {
+	a.Tags = value
+	return a
+}
+
+func (a *ApiIntegrationExternalMcpDynamicClientModel) WithTagsAllValue(value tfconfig.Variable) *ApiIntegrationExternalMcpDynamicClientModel {
+	a.TagsAll = value
+	return a
+}
@@ -23,6 +23,8 @@ type ApiIntegrationExternalMcpOAuth2Model struct {
 	OauthClientSecret          tfconfig.Variable `json:"oauth_client_secret,omitempty"`
 	OauthRefreshTokenValidity  tfconfig.Variable `json:"oauth_refresh_token_validity,omitempty"`
 	OauthTokenEndpoint         tfconfig.Variable `json:"oauth_token_endpoint,omitempty"`
+	Tags                       tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                    tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -164,6 +166,10 @@ func (a *ApiIntegrationExternalMcpOAuth2Model) WithOauthTokenEndpoint(oauthToken
 	return a
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -227,3 +233,13 @@ func (a *ApiIntegrationExternalMcpOAuth2Model) WithOauthTokenEndpointValue(value
 	a.OauthTokenEndpoint = value
 	return a
 }
+
+func (a *ApiIntegrationExternalMcpOAuth2Model) WithTagsValue(value tfconfig.Variable) *ApiIntegrationExternalMcpOAuth2Model {
+	a.Tags = value
+	return a
+}
+
+func (a *ApiIntegrationExternalMcpOAuth2Model) WithTagsAllValue(value tfconfig.Variable) *ApiIntegrationExternalMcpOAuth2Model {
+	a.TagsAll = value
+	return a
+}
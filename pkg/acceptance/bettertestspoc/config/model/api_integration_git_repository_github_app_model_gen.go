@@ -17,6 +17,8 @@ type ApiIntegrationGitRepositoryGithubAppModel struct {
 	Comment            tfconfig.Variable `json:"comment,omitempty"`
 	Enabled            tfconfig.Variable `json:"enabled,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll            tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -112,6 +114,10 @@ func (a *ApiIntegrationGitRepositoryGithubAppModel) WithFullyQualifiedName(fully
 	return a
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -145,3 +151,13 @@ func (a *ApiIntegrationGitRepositoryGithubAppModel) WithFullyQualifiedNameValue(
 	a.FullyQualifiedName = value
 	return a
 }
+
+func (a *ApiIntegrationGitRepositoryGithubAppModel) WithTagsValue(value tfconfig.Variable) *ApiIntegrationGitRepositoryGithubAppModel {
+	a.Tags = value
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryGithubAppModel) WithTagsAllValue(value tfconfig.Variable) *ApiIntegrationGitRepositoryGithubAppModel {
+	a.TagsAll = value
+	return a
+}
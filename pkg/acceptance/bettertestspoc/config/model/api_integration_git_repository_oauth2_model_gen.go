@@ -25,6 +25,8 @@ type ApiIntegrationGitRepositoryOauth2Model struct {
 	OauthRefreshTokenValidity  tfconfig.Variable `json:"oauth_refresh_token_validity,omitempty"`
 	OauthTokenEndpoint         tfconfig.Variable `json:"oauth_token_endpoint,omitempty"`
 	OauthUsername              tfconfig.Variable `json:"oauth_username,omitempty"`
+	Tags                       tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                    tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -173,6 +175,10 @@ func (a *ApiIntegrationGitRepositoryOauth2Model) WithOauthUsername(oauthUsername
 	return a
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -246,3 +252,13 @@ func (a *ApiIntegrationGitRepositoryOauth2Model) WithOauthUsernameValue(value tf
 	a.OauthUsername = value
 	return a
 }
+
+func (a *ApiIntegrationGitRepositoryOauth2Model) WithTagsValue(value tfconfig.Variable) *ApiIntegrationGitRepositoryOauth2Model {
+	a.Tags = value
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryOauth2Model) WithTagsAllValue(value tfconfig.Variable) *ApiIntegrationGitRepositoryOauth2Model {
+	a.TagsAll = value
+	return a
+}
@@ -20,6 +20,8 @@ type ApiIntegrationGitRepositoryPrivateLinkModel struct {
 	Enabled                         tfconfig.Variable `json:"enabled,omitempty"`
 	FullyQualifiedName              tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	NoAllowedAuthenticationSecrets  tfconfig.Variable `json:"no_allowed_authentication_secrets,omitempty"`
+	Tags                            tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                         tfconfig.Variable `json:"tags_all,omitempty"`
 	TlsTrustedCertificates          tfconfig.Variable `json:"tls_trusted_certificates,omitempty"`
 	UsePrivatelinkEndpoint          tfconfig.Variable `json:"use_privatelink_endpoint,omitempty"`
 
@@ -133,6 +135,10 @@ func (a *ApiIntegrationGitRepositoryPrivateLinkModel) WithNoAllowedAuthenticatio
 	return a
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 // tls_trusted_certificates attribute type is not yet supported, so WithTlsTrustedCertificates can't be generated
 
 func (a *ApiIntegrationGitRepositoryPrivateLinkModel) WithUsePrivatelinkEndpoint(usePrivatelinkEndpoint bool) *ApiIntegrationGitRepositoryPrivateLinkModel {
@@ -189,6 +195,16 @@ func (a *ApiIntegrationGitRepositoryPrivateLinkModel) WithNoAllowedAuthenticatio
 	return a
 }
 
+func (a *ApiIntegrationGitRepositoryPrivateLinkModel) WithTagsValue(value tfconfig.Variable) *ApiIntegrationGitRepositoryPrivateLinkModel {
+	a.Tags = value
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryPrivateLinkModel) WithTagsAllValue(value tfconfig.Variable) *ApiIntegrationGitRepositoryPrivateLinkModel {
+	a.TagsAll = value
+	return a
+}
+
 func (a *ApiIntegrationGitRepositoryPrivateLinkModel) WithTlsTrustedCertificatesValue(value tfconfig.Variable) *ApiIntegrationGitRepositoryPrivateLinkModel {
 	a.TlsTrustedCertificates = value
 	return a
@@ -20,6 +20,8 @@ type ApiIntegrationGitRepositoryTokenModel struct {
 	Enabled                         tfconfig.Variable `json:"enabled,omitempty"`
 	FullyQualifiedName              tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	NoAllowedAuthenticationSecrets  tfconfig.Variable `json:"no_allowed_authentication_secrets,omitempty"`
+	Tags                            tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                         tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -127,6 +129,10 @@ func (a *ApiIntegrationGitRepositoryTokenModel) WithNoAllowedAuthenticationSecre
 	return a
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -175,3 +181,13 @@ func (a *ApiIntegrationGitRepositoryTokenModel) WithNoAllowedAuthenticationSecre
 	a.NoAllowedAuthenticationSecrets = value
 	return a
 }
+
+func (a *ApiIntegrationGitRepositoryTokenModel) WithTagsValue(value tfconfig.Variable) *ApiIntegrationGitRepositoryTokenModel {
+	a.Tags = value
+	return a
+}
+
+func (a *ApiIntegrationGitRepositoryTokenModel) WithTagsAllValue(value tfconfig.Variable) *ApiIntegrationGitRepositoryTokenModel {
+	a.TagsAll = value
+	return a
+}
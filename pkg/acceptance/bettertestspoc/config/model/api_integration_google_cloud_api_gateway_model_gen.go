@@ -18,6 +18,8 @@ type ApiIntegrationGoogleCloudApiGatewayModel struct {
 	Enabled            tfconfig.Variable `json:"enabled,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	GoogleAudience     tfconfig.Variable `json:"google_audience,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll            tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -122,6 +124,10 @@ func (a *ApiIntegrationGoogleCloudApiGatewayModel) WithGoogleAudience(googleAudi
 	return a
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -160,3 +166,13 @@ func (a *ApiIntegrationGoogleCloudApiGatewayModel) WithGoogleAudienceValue(value
 	a.GoogleAudience = value
 	return a
 }
+
+func (a *ApiIntegrationGoogleCloudApiGatewayModel) WithTagsValue(value tfconfig.Variable) *ApiIntegrationGoogleCloudApiGatewayModel {
+	a.Tags = value
+	return a
+}
+
+func (a *ApiIntegrationGoogleCloudApiGatewayModel) WithTagsAllValue(value tfconfig.Variable) *ApiIntegrationGoogleCloudApiGatewayModel {
+	a.TagsAll = value
+	return a
+}
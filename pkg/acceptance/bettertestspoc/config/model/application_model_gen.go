@@ -0,0 +1,178 @@
+// Code generated by resource model builder generator (v0.1.0); DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type ApplicationModel struct {
+	Name                   tfconfig.Variable `json:"name,omitempty"`
+	Comment                tfconfig.Variable `json:"comment,omitempty"`
+	DebugMode              tfconfig.Variable `json:"debug_mode,omitempty"`
+	FromApplicationPackage tfconfig.Variable `json:"from_application_package,omitempty"`
+	FromListing            tfconfig.Variable `json:"from_listing,omitempty"`
+	FullyQualifiedName     tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	Patch                  tfconfig.Variable `json:"patch,omitempty"`
+	Version                tfconfig.Variable `json:"version,omitempty"`
+	VersionDirectory       tfconfig.Variable `json:"version_directory,omitempty"`
+
+	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
+
+	*config.ResourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func Application(
+	resourceName string,
+	name string,
+) *ApplicationModel {
+	a := &ApplicationModel{ResourceModelMeta: config.Meta(resourceName, resources.Application)}
+	a.WithName(name)
+	return a
+}
+
+func ApplicationWithDefaultMeta(
+	name string,
+) *ApplicationModel {
+	a := &ApplicationModel{ResourceModelMeta: config.DefaultMeta(resources.Application)}
+	a.WithName(name)
+	return a
+}
+
+///////////////////////////////////////////////////////////////////////
+// set proper json marshalling, handle depends on and dynamic blocks //
+///////////////////////////////////////////////////////////////////////
+
+func (a *ApplicationModel) MarshalJSON() ([]byte, error) {
+	type Alias ApplicationModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn []string         `json:"depends_on,omitempty"`
+		Timeouts  *config.Timeouts `json:"timeouts,omitempty"`
+	}{
+		Alias:     (*Alias)(a),
+		DependsOn: a.DependsOn(),
+		Timeouts:  a.Timeouts(),
+	})
+}
+
+func (a *ApplicationModel) WithDependsOn(values ...string) *ApplicationModel {
+	a.SetDependsOn(values...)
+	return a
+}
+
+func (a *ApplicationModel) WithDynamicBlock(dynamicBlock *config.DynamicBlock) *ApplicationModel {
+	a.DynamicBlock = dynamicBlock
+	return a
+}
+
+func (a *ApplicationModel) WithTimeout(timeout config.Timeouts) *ApplicationModel {
+	a.SetTimeout(timeout)
+	return a
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+func (a *ApplicationModel) WithName(name string) *ApplicationModel {
+	a.Name = tfconfig.StringVariable(name)
+	return a
+}
+
+func (a *ApplicationModel) WithComment(comment string) *ApplicationModel {
+	a.Comment = tfconfig.StringVariable(comment)
+	return a
+}
+
+func (a *ApplicationModel) WithDebugMode(debugMode string) *ApplicationModel {
+	a.DebugMode = tfconfig.StringVariable(debugMode)
+	return a
+}
+
+func (a *ApplicationModel) WithFromApplicationPackage(fromApplicationPackage string) *ApplicationModel {
+	a.FromApplicationPackage = tfconfig.StringVariable(fromApplicationPackage)
+	return a
+}
+
+func (a *ApplicationModel) WithFromListing(fromListing string) *ApplicationModel {
+	a.FromListing = tfconfig.StringVariable(fromListing)
+	return a
+}
+
+func (a *ApplicationModel) WithFullyQualifiedName(fullyQualifiedName string) *ApplicationModel {
+	a.FullyQualifiedName = tfconfig.StringVariable(fullyQualifiedName)
+	return a
+}
+
+func (a *ApplicationModel) WithPatch(patch int) *ApplicationModel {
+	a.Patch = tfconfig.IntegerVariable(patch)
+	return a
+}
+
+func (a *ApplicationModel) WithVersion(version string) *ApplicationModel {
+	a.Version = tfconfig.StringVariable(version)
+	return a
+}
+
+func (a *ApplicationModel) WithVersionDirectory(versionDirectory string) *ApplicationModel {
+	a.VersionDirectory = tfconfig.StringVariable(versionDirectory)
+	return a
+}
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (a *ApplicationModel) WithNameValue(value tfconfig.Variable) *ApplicationModel {
+	a.Name = value
+	return a
+}
+
+func (a *ApplicationModel) WithCommentValue(value tfconfig.Variable) *ApplicationModel {
+	a.Comment = value
+	return a
+}
+
+func (a *ApplicationModel) WithDebugModeValue(value tfconfig.Variable) *ApplicationModel {
+	a.DebugMode = value
+	return a
+}
+
+func (a *ApplicationModel) WithFromApplicationPackageValue(value tfconfig.Variable) *ApplicationModel {
+	a.FromApplicationPackage = value
+	return a
+}
+
+func (a *ApplicationModel) WithFromListingValue(value tfconfig.Variable) *ApplicationModel {
+	a.FromListing = value
+	return a
+}
+
+func (a *ApplicationModel) WithFullyQualifiedNameValue(value tfconfig.Variable) *ApplicationModel {
+	a.FullyQualifiedName = value
+	return a
+}
+
+func (a *ApplicationModel) WithPatchValue(value tfconfig.Variable) *ApplicationModel {
+	a.Patch = value
+	return a
+}
+
+func (a *ApplicationModel) WithVersionValue(value tfconfig.Variable) *ApplicationModel {
+	a.Version = value
+	return a
+}
+
+func (a *ApplicationModel) WithVersionDirectoryValue(value tfconfig.Variable) *ApplicationModel {
+	a.VersionDirectory = value
+	return a
+}
@@ -0,0 +1,23 @@
+package model
+
+import (
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func (a *ApplicationPackageModel) WithVersion(name string, using string) *ApplicationPackageModel {
+	return a.WithVersionValue(tfconfig.SetVariable(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"name":  tfconfig.StringVariable(name),
+			"using": tfconfig.StringVariable(using),
+		}),
+	))
+}
+
+func (a *ApplicationPackageModel) WithDefaultReleaseDirective(version string, patch int) *ApplicationPackageModel {
+	return a.WithDefaultReleaseDirectiveValue(tfconfig.ListVariable(
+		tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"version": tfconfig.StringVariable(version),
+			"patch":   tfconfig.IntegerVariable(patch),
+		}),
+	))
+}
@@ -0,0 +1,169 @@
+// Code generated by resource model builder generator (v0.1.0); DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type ApplicationPackageModel struct {
+	Name                    tfconfig.Variable `json:"name,omitempty"`
+	Comment                 tfconfig.Variable `json:"comment,omitempty"`
+	DataRetentionTimeInDays tfconfig.Variable `json:"data_retention_time_in_days,omitempty"`
+	DefaultReleaseDirective tfconfig.Variable `json:"default_release_directive,omitempty"`
+	Distribution            tfconfig.Variable `json:"distribution,omitempty"`
+	EnableReleaseChannels   tfconfig.Variable `json:"enable_release_channels,omitempty"`
+	FullyQualifiedName      tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	ReleaseDirective        tfconfig.Variable `json:"release_directive,omitempty"`
+	Version                 tfconfig.Variable `json:"version,omitempty"`
+
+	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
+
+	*config.ResourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func ApplicationPackage(
+	resourceName string,
+	name string,
+) *ApplicationPackageModel {
+	a := &ApplicationPackageModel{ResourceModelMeta: config.Meta(resourceName, resources.ApplicationPackage)}
+	a.WithName(name)
+	return a
+}
+
+func ApplicationPackageWithDefaultMeta(
+	name string,
+) *ApplicationPackageModel {
+	a := &ApplicationPackageModel{ResourceModelMeta: config.DefaultMeta(resources.ApplicationPackage)}
+	a.WithName(name)
+	return a
+}
+
+///////////////////////////////////////////////////////////////////////
+// set proper json marshalling, handle depends on and dynamic blocks //
+///////////////////////////////////////////////////////////////////////
+
+func (a *ApplicationPackageModel) MarshalJSON() ([]byte, error) {
+	type Alias ApplicationPackageModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn []string         `json:"depends_on,omitempty"`
+		Timeouts  *config.Timeouts `json:"timeouts,omitempty"`
+	}{
+		Alias:     (*Alias)(a),
+		DependsOn: a.DependsOn(),
+		Timeouts:  a.Timeouts(),
+	})
+}
+
+func (a *ApplicationPackageModel) WithDependsOn(values ...string) *ApplicationPackageModel {
+	a.SetDependsOn(values...)
+	return a
+}
+
+func (a *ApplicationPackageModel) WithDynamicBlock(dynamicBlock *config.DynamicBlock) *ApplicationPackageModel {
+	a.DynamicBlock = dynamicBlock
+	return a
+}
+
+func (a *ApplicationPackageModel) WithTimeout(timeout config.Timeouts) *ApplicationPackageModel {
+	a.SetTimeout(timeout)
+	return a
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+func (a *ApplicationPackageModel) WithName(name string) *ApplicationPackageModel {
+	a.Name = tfconfig.StringVariable(name)
+	return a
+}
+
+func (a *ApplicationPackageModel) WithComment(comment string) *ApplicationPackageModel {
+	a.Comment = tfconfig.StringVariable(comment)
+	return a
+}
+
+func (a *ApplicationPackageModel) WithDataRetentionTimeInDays(dataRetentionTimeInDays int) *ApplicationPackageModel {
+	a.DataRetentionTimeInDays = tfconfig.IntegerVariable(dataRetentionTimeInDays)
+	return a
+}
+
+// default_release_directive attribute type is not yet supported, so WithDefaultReleaseDirective can't be generated
+
+func (a *ApplicationPackageModel) WithDistribution(distribution string) *ApplicationPackageModel {
+	a.Distribution = tfconfig.StringVariable(distribution)
+	return a
+}
+
+func (a *ApplicationPackageModel) WithEnableReleaseChannels(enableReleaseChannels string) *ApplicationPackageModel {
+	a.EnableReleaseChannels = tfconfig.StringVariable(enableReleaseChannels)
+	return a
+}
+
+func (a *ApplicationPackageModel) WithFullyQualifiedName(fullyQualifiedName string) *ApplicationPackageModel {
+	a.FullyQualifiedName = tfconfig.StringVariable(fullyQualifiedName)
+	return a
+}
+
+// release_directive attribute type is not yet supported, so WithReleaseDirective can't be generated
+
+// version attribute type is not yet supported, so WithVersion can't be generated
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (a *ApplicationPackageModel) WithNameValue(value tfconfig.Variable) *ApplicationPackageModel {
+	a.Name = value
+	return a
+}
+
+func (a *ApplicationPackageModel) WithCommentValue(value tfconfig.Variable) *ApplicationPackageModel {
+	a.Comment = value
+	return a
+}
+
+func (a *ApplicationPackageModel) WithDataRetentionTimeInDaysValue(value tfconfig.Variable) *ApplicationPackageModel {
+	a.DataRetentionTimeInDays = value
+	return a
+}
+
+func (a *ApplicationPackageModel) WithDefaultReleaseDirectiveValue(value tfconfig.Variable) *ApplicationPackageModel {
+	a.DefaultReleaseDirective = value
+	return a
+}
+
+func (a *ApplicationPackageModel) WithDistributionValue(value tfconfig.Variable) *ApplicationPackageModel {
+	a.Distribution = value
+	return a
+}
+
+func (a *ApplicationPackageModel) WithEnableReleaseChannelsValue(value tfconfig.Variable) *ApplicationPackageModel {
+	a.EnableReleaseChannels = value
+	return a
+}
+
+func (a *ApplicationPackageModel) WithFullyQualifiedNameValue(value tfconfig.Variable) *ApplicationPackageModel {
+	a.FullyQualifiedName = value
+	return a
+}
+
+func (a *ApplicationPackageModel) WithReleaseDirectiveValue(value tfconfig.Variable) *ApplicationPackageModel {
+	a.ReleaseDirective = value
+	return a
+}
+
+func (a *ApplicationPackageModel) WithVersionValue(value tfconfig.Variable) *ApplicationPackageModel {
+	a.Version = value
+	return a
+}
@@ -0,0 +1,175 @@
+// Code generated by resource model builder generator (v0.1.0); DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type BackupPolicyModel struct {
+	Database           tfconfig.Variable `json:"database,omitempty"`
+	Schema             tfconfig.Variable `json:"schema,omitempty"`
+	Name               tfconfig.Variable `json:"name,omitempty"`
+	Comment            tfconfig.Variable `json:"comment,omitempty"`
+	ExpireAfterDays    tfconfig.Variable `json:"expire_after_days,omitempty"`
+	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	Schedule           tfconfig.Variable `json:"schedule,omitempty"`
+	WithRetentionLock  tfconfig.Variable `json:"with_retention_lock,omitempty"`
+
+	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
+
+	*config.ResourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func BackupPolicy(
+	resourceName string,
+	database string,
+	schema string,
+	name string,
+) *BackupPolicyModel {
+	b := &BackupPolicyModel{ResourceModelMeta: config.Meta(resourceName, resources.BackupPolicy)}
+	b.WithDatabase(database)
+	b.WithSchema(schema)
+	b.WithName(name)
+	return b
+}
+
+func BackupPolicyWithDefaultMeta(
+	database string,
+	schema string,
+	name string,
+) *BackupPolicyModel {
+	b := &BackupPolicyModel{ResourceModelMeta: config.DefaultMeta(resources.BackupPolicy)}
+	b.WithDatabase(database)
+	b.WithSchema(schema)
+	b.WithName(name)
+	return b
+}
+
+///////////////////////////////////////////////////////////////////////
+// set proper json marshalling, handle depends on and dynamic blocks //
+///////////////////////////////////////////////////////////////////////
+
+func (b *BackupPolicyModel) MarshalJSON() ([]byte, error) {
+	type Alias BackupPolicyModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn []string         `json:"depends_on,omitempty"`
+		Timeouts  *config.Timeouts `json:"timeouts,omitempty"`
+	}{
+		Alias:     (*Alias)(b),
+		DependsOn: b.DependsOn(),
+		Timeouts:  b.Timeouts(),
+	})
+}
+
+func (b *BackupPolicyModel) WithDependsOn(values ...string) *BackupPolicyModel {
+	b.SetDependsOn(values...)
+	return b
+}
+
+func (b *BackupPolicyModel) WithDynamicBlock(dynamicBlock *config.DynamicBlock) *BackupPolicyModel {
+	b.DynamicBlock = dynamicBlock
+	return b
+}
+
+func (b *BackupPolicyModel) WithTimeout(timeout config.Timeouts) *BackupPolicyModel {
+	b.SetTimeout(timeout)
+	return b
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+func (b *BackupPolicyModel) WithDatabase(database string) *BackupPolicyModel {
+	b.Database = tfconfig.StringVariable(database)
+	return b
+}
+
+func (b *BackupPolicyModel) WithSchema(schema string) *BackupPolicyModel {
+	b.Schema = tfconfig.StringVariable(schema)
+	return b
+}
+
+func (b *BackupPolicyModel) WithName(name string) *BackupPolicyModel {
+	b.Name = tfconfig.StringVariable(name)
+	return b
+}
+
+func (b *BackupPolicyModel) WithComment(comment string) *BackupPolicyModel {
+	b.Comment = tfconfig.StringVariable(comment)
+	return b
+}
+
+func (b *BackupPolicyModel) WithExpireAfterDays(expireAfterDays int) *BackupPolicyModel {
+	b.ExpireAfterDays = tfconfig.IntegerVariable(expireAfterDays)
+	return b
+}
+
+func (b *BackupPolicyModel) WithFullyQualifiedName(fullyQualifiedName string) *BackupPolicyModel {
+	b.FullyQualifiedName = tfconfig.StringVariable(fullyQualifiedName)
+	return b
+}
+
+func (b *BackupPolicyModel) WithSchedule(schedule string) *BackupPolicyModel {
+	b.Schedule = tfconfig.StringVariable(schedule)
+	return b
+}
+
+func (b *BackupPolicyModel) WithWithRetentionLock(withRetentionLock bool) *BackupPolicyModel {
+	b.WithRetentionLock = tfconfig.BoolVariable(withRetentionLock)
+	return b
+}
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (b *BackupPolicyModel) WithDatabaseValue(value tfconfig.Variable) *BackupPolicyModel {
+	b.Database = value
+	return b
+}
+
+func (b *BackupPolicyModel) WithSchemaValue(value tfconfig.Variable) *BackupPolicyModel {
+	b.Schema = value
+	return b
+}
+
+func (b *BackupPolicyModel) WithNameValue(value tfconfig.Variable) *BackupPolicyModel {
+	b.Name = value
+	return b
+}
+
+func (b *BackupPolicyModel) WithCommentValue(value tfconfig.Variable) *BackupPolicyModel {
+	b.Comment = value
+	return b
+}
+
+func (b *BackupPolicyModel) WithExpireAfterDaysValue(value tfconfig.Variable) *BackupPolicyModel {
+	b.ExpireAfterDays = value
+	return b
+}
+
+func (b *BackupPolicyModel) WithFullyQualifiedNameValue(value tfconfig.Variable) *BackupPolicyModel {
+	b.FullyQualifiedName = value
+	return b
+}
+
+func (b *BackupPolicyModel) WithScheduleValue(value tfconfig.Variable) *BackupPolicyModel {
+	b.Schedule = value
+	return b
+}
+
+func (b *BackupPolicyModel) WithWithRetentionLockValue(value tfconfig.Variable) *BackupPolicyModel {
+	b.WithRetentionLock = value
+	return b
+}
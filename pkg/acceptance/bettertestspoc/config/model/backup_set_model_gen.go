@@ -0,0 +1,183 @@
+// Code generated by resource model builder generator (v0.1.0); DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type BackupSetModel struct {
+	Database           tfconfig.Variable `json:"database,omitempty"`
+	Schema             tfconfig.Variable `json:"schema,omitempty"`
+	Name               tfconfig.Variable `json:"name,omitempty"`
+	BackupPolicy       tfconfig.Variable `json:"backup_policy,omitempty"`
+	Comment            tfconfig.Variable `json:"comment,omitempty"`
+	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	ObjectName         tfconfig.Variable `json:"object_name,omitempty"`
+	ObjectType         tfconfig.Variable `json:"object_type,omitempty"`
+
+	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
+
+	*config.ResourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func BackupSet(
+	resourceName string,
+	database string,
+	schema string,
+	name string,
+	objectName string,
+	objectType string,
+) *BackupSetModel {
+	b := &BackupSetModel{ResourceModelMeta: config.Meta(resourceName, resources.BackupSet)}
+	b.WithDatabase(database)
+	b.WithSchema(schema)
+	b.WithName(name)
+	b.WithObjectName(objectName)
+	b.WithObjectType(objectType)
+	return b
+}
+
+func BackupSetWithDefaultMeta(
+	database string,
+	schema string,
+	name string,
+	objectName string,
+	objectType string,
+) *BackupSetModel {
+	b := &BackupSetModel{ResourceModelMeta: config.DefaultMeta(resources.BackupSet)}
+	b.WithDatabase(database)
+	b.WithSchema(schema)
+	b.WithName(name)
+	b.WithObjectName(objectName)
+	b.WithObjectType(objectType)
+	return b
+}
+
+///////////////////////////////////////////////////////////////////////
+// set proper json marshalling, handle depends on and dynamic blocks //
+///////////////////////////////////////////////////////////////////////
+
+func (b *BackupSetModel) MarshalJSON() ([]byte, error) {
+	type Alias BackupSetModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn []string         `json:"depends_on,omitempty"`
+		Timeouts  *config.Timeouts `json:"timeouts,omitempty"`
+	}{
+		Alias:     (*Alias)(b),
+		DependsOn: b.DependsOn(),
+		Timeouts:  b.Timeouts(),
+	})
+}
+
+func (b *BackupSetModel) WithDependsOn(values ...string) *BackupSetModel {
+	b.SetDependsOn(values...)
+	return b
+}
+
+func (b *BackupSetModel) WithDynamicBlock(dynamicBlock *config.DynamicBlock) *BackupSetModel {
+	b.DynamicBlock = dynamicBlock
+	return b
+}
+
+func (b *BackupSetModel) WithTimeout(timeout config.Timeouts) *BackupSetModel {
+	b.SetTimeout(timeout)
+	return b
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+func (b *BackupSetModel) WithDatabase(database string) *BackupSetModel {
+	b.Database = tfconfig.StringVariable(database)
+	return b
+}
+
+func (b *BackupSetModel) WithSchema(schema string) *BackupSetModel {
+	b.Schema = tfconfig.StringVariable(schema)
+	return b
+}
+
+func (b *BackupSetModel) WithName(name string) *BackupSetModel {
+	b.Name = tfconfig.StringVariable(name)
+	return b
+}
+
+func (b *BackupSetModel) WithBackupPolicy(backupPolicy string) *BackupSetModel {
+	b.BackupPolicy = tfconfig.StringVariable(backupPolicy)
+	return b
+}
+
+func (b *BackupSetModel) WithComment(comment string) *BackupSetModel {
+	b.Comment = tfconfig.StringVariable(comment)
+	return b
+}
+
+func (b *BackupSetModel) WithFullyQualifiedName(fullyQualifiedName string) *BackupSetModel {
+	b.FullyQualifiedName = tfconfig.StringVariable(fullyQualifiedName)
+	return b
+}
+
+func (b *BackupSetModel) WithObjectName(objectName string) *BackupSetModel {
+	b.ObjectName = tfconfig.StringVariable(objectName)
+	return b
+}
+
+func (b *BackupSetModel) WithObjectType(objectType string) *BackupSetModel {
+	b.ObjectType = tfconfig.StringVariable(objectType)
+	return b
+}
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (b *BackupSetModel) WithDatabaseValue(value tfconfig.Variable) *BackupSetModel {
+	b.Database = value
+	return b
+}
+
+func (b *BackupSetModel) WithSchemaValue(value tfconfig.Variable) *BackupSetModel {
+	b.Schema = value
+	return b
+}
+
+func (b *BackupSetModel) WithNameValue(value tfconfig.Variable) *BackupSetModel {
+	b.Name = value
+	return b
+}
+
+func (b *BackupSetModel) WithBackupPolicyValue(value tfconfig.Variable) *BackupSetModel {
+	b.BackupPolicy = value
+	return b
+}
+
+func (b *BackupSetModel) WithCommentValue(value tfconfig.Variable) *BackupSetModel {
+	b.Comment = value
+	return b
+}
+
+func (b *BackupSetModel) WithFullyQualifiedNameValue(value tfconfig.Variable) *BackupSetModel {
+	b.FullyQualifiedName = value
+	return b
+}
+
+func (b *BackupSetModel) WithObjectNameValue(value tfconfig.Variable) *BackupSetModel {
+	b.ObjectName = value
+	return b
+}
+
+func (b *BackupSetModel) WithObjectTypeValue(value tfconfig.Variable) *BackupSetModel {
+	b.ObjectType = value
+	return b
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func (b *BudgetModel) WithEmailRecipients(emails ...string) *BudgetModel {
+	emailVars := collections.Map(emails, func(e string) tfconfig.Variable { return tfconfig.StringVariable(e) })
+	b.WithEmailRecipientsValue(tfconfig.SetVariable(emailVars...))
+	return b
+}
+
+func (b *BudgetModel) WithCycleStartAction(procedure string, arguments ...string) *BudgetModel {
+	argumentVars := collections.Map(arguments, func(a string) tfconfig.Variable { return tfconfig.StringVariable(a) })
+	b.WithCycleStartActionValue(tfconfig.ListVariable(tfconfig.MapVariable(map[string]tfconfig.Variable{
+		"procedure": tfconfig.StringVariable(procedure),
+		"arguments": tfconfig.ListVariable(argumentVars...),
+	})))
+	return b
+}
@@ -0,0 +1,184 @@
+// Code generated by resource model builder generator (v0.1.0); DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type BudgetModel struct {
+	Database                tfconfig.Variable `json:"database,omitempty"`
+	Schema                  tfconfig.Variable `json:"schema,omitempty"`
+	Name                    tfconfig.Variable `json:"name,omitempty"`
+	Comment                 tfconfig.Variable `json:"comment,omitempty"`
+	CycleStartAction        tfconfig.Variable `json:"cycle_start_action,omitempty"`
+	EmailRecipients         tfconfig.Variable `json:"email_recipients,omitempty"`
+	FullyQualifiedName      tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	NotificationIntegration tfconfig.Variable `json:"notification_integration,omitempty"`
+	SpendingLimit           tfconfig.Variable `json:"spending_limit,omitempty"`
+
+	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
+
+	*config.ResourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func Budget(
+	resourceName string,
+	database string,
+	schema string,
+	name string,
+	spendingLimit int,
+) *BudgetModel {
+	b := &BudgetModel{ResourceModelMeta: config.Meta(resourceName, resources.Budget)}
+	b.WithDatabase(database)
+	b.WithSchema(schema)
+	b.WithName(name)
+	b.WithSpendingLimit(spendingLimit)
+	return b
+}
+
+func BudgetWithDefaultMeta(
+	database string,
+	schema string,
+	name string,
+	spendingLimit int,
+) *BudgetModel {
+	b := &BudgetModel{ResourceModelMeta: config.DefaultMeta(resources.Budget)}
+	b.WithDatabase(database)
+	b.WithSchema(schema)
+	b.WithName(name)
+	b.WithSpendingLimit(spendingLimit)
+	return b
+}
+
+///////////////////////////////////////////////////////////////////////
+// set proper json marshalling, handle depends on and dynamic blocks //
+///////////////////////////////////////////////////////////////////////
+
+func (b *BudgetModel) MarshalJSON() ([]byte, error) {
+	type Alias BudgetModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn []string         `json:"depends_on,omitempty"`
+		Timeouts  *config.Timeouts `json:"timeouts,omitempty"`
+	}{
+		Alias:     (*Alias)(b),
+		DependsOn: b.DependsOn(),
+		Timeouts:  b.Timeouts(),
+	})
+}
+
+func (b *BudgetModel) WithDependsOn(values ...string) *BudgetModel {
+	b.SetDependsOn(values...)
+	return b
+}
+
+func (b *BudgetModel) WithDynamicBlock(dynamicBlock *config.DynamicBlock) *BudgetModel {
+	b.DynamicBlock = dynamicBlock
+	return b
+}
+
+func (b *BudgetModel) WithTimeout(timeout config.Timeouts) *BudgetModel {
+	b.SetTimeout(timeout)
+	return b
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+func (b *BudgetModel) WithDatabase(database string) *BudgetModel {
+	b.Database = tfconfig.StringVariable(database)
+	return b
+}
+
+func (b *BudgetModel) WithSchema(schema string) *BudgetModel {
+	b.Schema = tfconfig.StringVariable(schema)
+	return b
+}
+
+func (b *BudgetModel) WithName(name string) *BudgetModel {
+	b.Name = tfconfig.StringVariable(name)
+	return b
+}
+
+func (b *BudgetModel) WithComment(comment string) *BudgetModel {
+	b.Comment = tfconfig.StringVariable(comment)
+	return b
+}
+
+// cycle_start_action attribute type is not yet supported, so WithCycleStartAction can't be generated
+
+// email_recipients attribute type is not yet supported, so WithEmailRecipients can't be generated
+
+func (b *BudgetModel) WithFullyQualifiedName(fullyQualifiedName string) *BudgetModel {
+	b.FullyQualifiedName = tfconfig.StringVariable(fullyQualifiedName)
+	return b
+}
+
+func (b *BudgetModel) WithNotificationIntegration(notificationIntegration string) *BudgetModel {
+	b.NotificationIntegration = tfconfig.StringVariable(notificationIntegration)
+	return b
+}
+
+func (b *BudgetModel) WithSpendingLimit(spendingLimit int) *BudgetModel {
+	b.SpendingLimit = tfconfig.IntegerVariable(spendingLimit)
+	return b
+}
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (b *BudgetModel) WithDatabaseValue(value tfconfig.Variable) *BudgetModel {
+	b.Database = value
+	return b
+}
+
+func (b *BudgetModel) WithSchemaValue(value tfconfig.Variable) *BudgetModel {
+	b.Schema = value
+	return b
+}
+
+func (b *BudgetModel) WithNameValue(value tfconfig.Variable) *BudgetModel {
+	b.Name = value
+	return b
+}
+
+func (b *BudgetModel) WithCommentValue(value tfconfig.Variable) *BudgetModel {
+	b.Comment = value
+	return b
+}
+
+func (b *BudgetModel) WithCycleStartActionValue(value tfconfig.Variable) *BudgetModel {
+	b.CycleStartAction = value
+	return b
+}
+
+func (b *BudgetModel) WithEmailRecipientsValue(value tfconfig.Variable) *BudgetModel {
+	b.EmailRecipients = value
+	return b
+}
+
+func (b *BudgetModel) WithFullyQualifiedNameValue(value tfconfig.Variable) *BudgetModel {
+	b.FullyQualifiedName = value
+	return b
+}
+
+func (b *BudgetModel) WithNotificationIntegrationValue(value tfconfig.Variable) *BudgetModel {
+	b.NotificationIntegration = value
+	return b
+}
+
+func (b *BudgetModel) WithSpendingLimitValue(value tfconfig.Variable) *BudgetModel {
+	b.SpendingLimit = value
+	return b
+}
@@ -0,0 +1,120 @@
+// Code generated by resource model builder generator (v0.1.0); DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type BudgetTrackedObjectModel struct {
+	BudgetName tfconfig.Variable `json:"budget_name,omitempty"`
+	ObjectName tfconfig.Variable `json:"object_name,omitempty"`
+	ObjectType tfconfig.Variable `json:"object_type,omitempty"`
+
+	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
+
+	*config.ResourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func BudgetTrackedObject(
+	resourceName string,
+	budgetName string,
+	objectName string,
+	objectType string,
+) *BudgetTrackedObjectModel {
+	b := &BudgetTrackedObjectModel{ResourceModelMeta: config.Meta(resourceName, resources.BudgetTrackedObject)}
+	b.WithBudgetName(budgetName)
+	b.WithObjectName(objectName)
+	b.WithObjectType(objectType)
+	return b
+}
+
+func BudgetTrackedObjectWithDefaultMeta(
+	budgetName string,
+	objectName string,
+	objectType string,
+) *BudgetTrackedObjectModel {
+	b := &BudgetTrackedObjectModel{ResourceModelMeta: config.DefaultMeta(resources.BudgetTrackedObject)}
+	b.WithBudgetName(budgetName)
+	b.WithObjectName(objectName)
+	b.WithObjectType(objectType)
+	return b
+}
+
+///////////////////////////////////////////////////////////////////////
+// set proper json marshalling, handle depends on and dynamic blocks //
+///////////////////////////////////////////////////////////////////////
+
+func (b *BudgetTrackedObjectModel) MarshalJSON() ([]byte, error) {
+	type Alias BudgetTrackedObjectModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn []string         `json:"depends_on,omitempty"`
+		Timeouts  *config.Timeouts `json:"timeouts,omitempty"`
+	}{
+		Alias:     (*Alias)(b),
+		DependsOn: b.DependsOn(),
+		Timeouts:  b.Timeouts(),
+	})
+}
+
+func (b *BudgetTrackedObjectModel) WithDependsOn(values ...string) *BudgetTrackedObjectModel {
+	b.SetDependsOn(values...)
+	return b
+}
+
+func (b *BudgetTrackedObjectModel) WithDynamicBlock(dynamicBlock *config.DynamicBlock) *BudgetTrackedObjectModel {
+	b.DynamicBlock = dynamicBlock
+	return b
+}
+
+func (b *BudgetTrackedObjectModel) WithTimeout(timeout config.Timeouts) *BudgetTrackedObjectModel {
+	b.SetTimeout(timeout)
+	return b
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+func (b *BudgetTrackedObjectModel) WithBudgetName(budgetName string) *BudgetTrackedObjectModel {
+	b.BudgetName = tfconfig.StringVariable(budgetName)
+	return b
+}
+
+func (b *BudgetTrackedObjectModel) WithObjectName(objectName string) *BudgetTrackedObjectModel {
+	b.ObjectName = tfconfig.StringVariable(objectName)
+	return b
+}
+
+func (b *BudgetTrackedObjectModel) WithObjectType(objectType string) *BudgetTrackedObjectModel {
+	b.ObjectType = tfconfig.StringVariable(objectType)
+	return b
+}
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (b *BudgetTrackedObjectModel) WithBudgetNameValue(value tfconfig.Variable) *BudgetTrackedObjectModel {
+	b.BudgetName = value
+	return b
+}
+
+func (b *BudgetTrackedObjectModel) WithObjectNameValue(value tfconfig.Variable) *BudgetTrackedObjectModel {
+	b.ObjectName = value
+	return b
+}
+
+func (b *BudgetTrackedObjectModel) WithObjectTypeValue(value tfconfig.Variable) *BudgetTrackedObjectModel {
+	b.ObjectType = value
+	return b
+}
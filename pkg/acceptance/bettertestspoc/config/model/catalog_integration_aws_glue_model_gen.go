@@ -21,6 +21,8 @@ type CatalogIntegrationAwsGlueModel struct {
 	GlueCatalogId          tfconfig.Variable `json:"glue_catalog_id,omitempty"`
 	GlueRegion             tfconfig.Variable `json:"glue_region,omitempty"`
 	RefreshIntervalSeconds tfconfig.Variable `json:"refresh_interval_seconds,omitempty"`
+	Tags                   tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -146,6 +148,10 @@ func (c *CatalogIntegrationAwsGlueModel) WithRefreshIntervalSeconds(refreshInter
 	return c
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -199,3 +205,13 @@ func (c *CatalogIntegrationAwsGlueModel) WithRefreshIntervalSecondsValue(value t
 	c.RefreshIntervalSeconds = value
 	return c
 }
+
+func (c *CatalogIntegrationAwsGlueModel) WithTagsValue(value tfconfig.Variable) *CatalogIntegrationAwsGlueModel {
+	c.Tags = value
+	return c
+}
+
+func (c *CatalogIntegrationAwsGlueModel) WithTagsAllValue(value tfconfig.Variable) *CatalogIntegrationAwsGlueModel {
+	c.TagsAll = value
+	return c
+}
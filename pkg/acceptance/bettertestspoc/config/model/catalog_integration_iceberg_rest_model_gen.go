@@ -23,6 +23,8 @@ type CatalogIntegrationIcebergRestModel struct {
 	RefreshIntervalSeconds   tfconfig.Variable `json:"refresh_interval_seconds,omitempty"`
 	RestConfig               tfconfig.Variable `json:"rest_config,omitempty"`
 	Sigv4RestAuthentication  tfconfig.Variable `json:"sigv4_rest_authentication,omitempty"`
+	Tags                     tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                  tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -137,6 +139,10 @@ func (c *CatalogIntegrationIcebergRestModel) WithRefreshIntervalSeconds(refreshI
 
 // sigv4_rest_authentication attribute type is not yet supported, so WithSigv4RestAuthentication can't be generated
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -195,3 +201,13 @@ func (c *CatalogIntegrationIcebergRestModel) WithSigv4RestAuthenticationValue(va
 	c.Sigv4RestAuthentication = value
 	return c
 }
+
+func (c *CatalogIntegrationIcebergRestModel) WithTagsValue(value tfconfig.Variable) *CatalogIntegrationIcebergRestModel {
+	c.Tags = value
+	return c
+}
+
+func (c *CatalogIntegrationIcebergRestModel) WithTagsAllValue(value tfconfig.Variable) *CatalogIntegrationIcebergRestModel {
+	c.TagsAll = value
+	return c
+}
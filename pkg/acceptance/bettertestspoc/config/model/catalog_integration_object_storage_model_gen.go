@@ -18,6 +18,8 @@ type CatalogIntegrationObjectStorageModel struct {
 	FullyQualifiedName     tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	RefreshIntervalSeconds tfconfig.Variable `json:"refresh_interval_seconds,omitempty"`
 	TableFormat            tfconfig.Variable `json:"table_format,omitempty"`
+	Tags                   tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -124,6 +126,10 @@ func (c *CatalogIntegrationObjectStorageModel) WithTableFormat(tableFormat strin
 	return c
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -162,3 +168,13 @@ func (c *CatalogIntegrationObjectStorageModel) WithTableFormatValue(value tfconf
 	c.TableFormat = value
 	return c
 }
+
+func (c *CatalogIntegrationObjectStorageModel) WithTagsValue(value tfconfig.Variable) *CatalogIntegrationObjectStorageModel {
+	c.Tags = value
+	return c
+}
+
+func (c *CatalogIntegrationObjectStorageModel) WithTagsAllValue(value tfconfig.Variable) *CatalogIntegrationObjectStorageModel {
+	c.TagsAll = value
+	return c
+}
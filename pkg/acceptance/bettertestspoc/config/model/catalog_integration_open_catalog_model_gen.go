@@ -21,6 +21,8 @@ type CatalogIntegrationOpenCatalogModel struct {
 	RefreshIntervalSeconds tfconfig.Variable `json:"refresh_interval_seconds,omitempty"`
 	RestAuthentication     tfconfig.Variable `json:"rest_authentication,omitempty"`
 	RestConfig             tfconfig.Variable `json:"rest_config,omitempty"`
+	Tags                   tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -135,6 +137,10 @@ func (c *CatalogIntegrationOpenCatalogModel) WithRefreshIntervalSeconds(refreshI
 
 // rest_config attribute type is not yet supported, so WithRestConfig can't be generated
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -183,3 +189,13 @@ func (c *CatalogIntegrationOpenCatalogModel) WithRestConfigValue(value tfconfig.
 	c.RestConfig = value
 	return c
 }
+
+func (c *CatalogIntegrationOpenCatalogModel) WithTagsValue(value tfconfig.Variable) *CatalogIntegrationOpenCatalogModel {
+	c.Tags = value
+	return c
+}
+
+func (c *CatalogIntegrationOpenCatalogModel) WithTagsAllValue(value tfconfig.Variable) *CatalogIntegrationOpenCatalogModel {
+	c.TagsAll = value
+	return c
+}
@@ -22,6 +22,8 @@ type ComputePoolModel struct {
 	InstanceFamily         tfconfig.Variable `json:"instance_family,omitempty"`
 	MaxNodes               tfconfig.Variable `json:"max_nodes,omitempty"`
 	MinNodes               tfconfig.Variable `json:"min_nodes,omitempty"`
+	Tags                   tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -149,6 +151,10 @@ func (c *ComputePoolModel) WithMinNodes(minNodes int) *ComputePoolModel {
 	return c
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -207,3 +213,13 @@ func (c *ComputePoolModel) WithMinNodesValue(value tfconfig.Variable) *ComputePo
 	c.MinNodes = value
 	return c
 }
+
+func (c *ComputePoolModel) WithTagsValue(value tfconfig.Variable) *ComputePoolModel {
+	c.Tags = value
+	return c
+}
+
+func (c *ComputePoolModel) WithTagsAllValue(value tfconfig.Variable) *ComputePoolModel {
+	c.TagsAll = value
+	return c
+}
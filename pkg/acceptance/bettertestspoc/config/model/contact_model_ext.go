@@ -0,0 +1,11 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func (c *ContactModel) WithUsers(users []string) *ContactModel {
+	userVars := collections.Map(users, func(u string) tfconfig.Variable { return tfconfig.StringVariable(u) })
+	return c.WithUsersValue(tfconfig.SetVariable(userVars...))
+}
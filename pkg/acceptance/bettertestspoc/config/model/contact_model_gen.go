@@ -0,0 +1,172 @@
+// Code generated by resource model builder generator (v0.1.0); DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type ContactModel struct {
+	Database              tfconfig.Variable `json:"database,omitempty"`
+	Schema                tfconfig.Variable `json:"schema,omitempty"`
+	Name                  tfconfig.Variable `json:"name,omitempty"`
+	Comment               tfconfig.Variable `json:"comment,omitempty"`
+	EmailDistributionList tfconfig.Variable `json:"email_distribution_list,omitempty"`
+	FullyQualifiedName    tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	Url                   tfconfig.Variable `json:"url,omitempty"`
+	Users                 tfconfig.Variable `json:"users,omitempty"`
+
+	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
+
+	*config.ResourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func Contact(
+	resourceName string,
+	database string,
+	schema string,
+	name string,
+) *ContactModel {
+	c := &ContactModel{ResourceModelMeta: config.Meta(resourceName, resources.Contact)}
+	c.WithDatabase(database)
+	c.WithSchema(schema)
+	c.WithName(name)
+	return c
+}
+
+func ContactWithDefaultMeta(
+	database string,
+	schema string,
+	name string,
+) *ContactModel {
+	c := &ContactModel{ResourceModelMeta: config.DefaultMeta(resources.Contact)}
+	c.WithDatabase(database)
+	c.WithSchema(schema)
+	c.WithName(name)
+	return c
+}
+
+///////////////////////////////////////////////////////////////////////
+// set proper json marshalling, handle depends on and dynamic blocks //
+///////////////////////////////////////////////////////////////////////
+
+func (c *ContactModel) MarshalJSON() ([]byte, error) {
+	type Alias ContactModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn []string         `json:"depends_on,omitempty"`
+		Timeouts  *config.Timeouts `json:"timeouts,omitempty"`
+	}{
+		Alias:     (*Alias)(c),
+		DependsOn: c.DependsOn(),
+		Timeouts:  c.Timeouts(),
+	})
+}
+
+func (c *ContactModel) WithDependsOn(values ...string) *ContactModel {
+	c.SetDependsOn(values...)
+	return c
+}
+
+func (c *ContactModel) WithDynamicBlock(dynamicBlock *config.DynamicBlock) *ContactModel {
+	c.DynamicBlock = dynamicBlock
+	return c
+}
+
+func (c *ContactModel) WithTimeout(timeout config.Timeouts) *ContactModel {
+	c.SetTimeout(timeout)
+	return c
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+func (c *ContactModel) WithDatabase(database string) *ContactModel {
+	c.Database = tfconfig.StringVariable(database)
+	return c
+}
+
+func (c *ContactModel) WithSchema(schema string) *ContactModel {
+	c.Schema = tfconfig.StringVariable(schema)
+	return c
+}
+
+func (c *ContactModel) WithName(name string) *ContactModel {
+	c.Name = tfconfig.StringVariable(name)
+	return c
+}
+
+func (c *ContactModel) WithComment(comment string) *ContactModel {
+	c.Comment = tfconfig.StringVariable(comment)
+	return c
+}
+
+func (c *ContactModel) WithEmailDistributionList(emailDistributionList string) *ContactModel {
+	c.EmailDistributionList = tfconfig.StringVariable(emailDistributionList)
+	return c
+}
+
+func (c *ContactModel) WithFullyQualifiedName(fullyQualifiedName string) *ContactModel {
+	c.FullyQualifiedName = tfconfig.StringVariable(fullyQualifiedName)
+	return c
+}
+
+func (c *ContactModel) WithUrl(url string) *ContactModel {
+	c.Url = tfconfig.StringVariable(url)
+	return c
+}
+
+// users attribute type is not yet supported, so WithUsers can't be generated
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (c *ContactModel) WithDatabaseValue(value tfconfig.Variable) *ContactModel {
+	c.Database = value
+	return c
+}
+
+func (c *ContactModel) WithSchemaValue(value tfconfig.Variable) *ContactModel {
+	c.Schema = value
+	return c
+}
+
+func (c *ContactModel) WithNameValue(value tfconfig.Variable) *ContactModel {
+	c.Name = value
+	return c
+}
+
+func (c *ContactModel) WithCommentValue(value tfconfig.Variable) *ContactModel {
+	c.Comment = value
+	return c
+}
+
+func (c *ContactModel) WithEmailDistributionListValue(value tfconfig.Variable) *ContactModel {
+	c.EmailDistributionList = value
+	return c
+}
+
+func (c *ContactModel) WithFullyQualifiedNameValue(value tfconfig.Variable) *ContactModel {
+	c.FullyQualifiedName = value
+	return c
+}
+
+func (c *ContactModel) WithUrlValue(value tfconfig.Variable) *ContactModel {
+	c.Url = value
+	return c
+}
+
+func (c *ContactModel) WithUsersValue(value tfconfig.Variable) *ContactModel {
+	c.Users = value
+	return c
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func (d *DataMetricFunctionAttachmentModel) WithOn(on []string) *DataMetricFunctionAttachmentModel {
+	columnVars := collections.Map(on, func(c string) tfconfig.Variable { return tfconfig.StringVariable(c) })
+	return d.WithOnValue(tfconfig.ListVariable(columnVars...))
+}
+
+// WithDataMetricSchedule accepts the schedule in the same format as DATA_METRIC_SCHEDULE (e.g. "5 MINUTE", "USING CRON 0 8 * * * UTC", "TRIGGER_ON_CHANGES").
+func (d *DataMetricFunctionAttachmentModel) WithDataMetricSchedule(dataMetricSchedule []sdk.DataMetricFunctionOnObjectSetScheduleRequest) *DataMetricFunctionAttachmentModel {
+	if len(dataMetricSchedule) != 1 {
+		log.Panicf("expected exactly one data metric schedule, got %d", len(dataMetricSchedule))
+	}
+	schedule := dataMetricSchedule[0].DataMetricSchedule
+
+	switch {
+	case schedule == "TRIGGER_ON_CHANGES":
+		return d.WithDataMetricScheduleValue(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"trigger_on_changes": tfconfig.BoolVariable(true),
+		}))
+	case strings.HasPrefix(schedule, "USING CRON "):
+		return d.WithDataMetricScheduleValue(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"using_cron": tfconfig.StringVariable(strings.TrimPrefix(schedule, "USING CRON ")),
+		}))
+	case strings.HasSuffix(schedule, " MINUTE"):
+		minutes, err := strconv.Atoi(strings.TrimSuffix(schedule, " MINUTE"))
+		if err != nil {
+			log.Panicf("invalid number of minutes in data metric schedule %s: %v", schedule, err)
+		}
+		return d.WithDataMetricScheduleValue(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"minutes": tfconfig.IntegerVariable(minutes),
+		}))
+	}
+
+	log.Panicf("unsupported data metric schedule: %s", schedule)
+	return nil
+}
@@ -0,0 +1,156 @@
+// Code generated by resource model builder generator (v0.1.0); DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type DataMetricFunctionAttachmentModel struct {
+	DataMetricFunction tfconfig.Variable `json:"data_metric_function,omitempty"`
+	DataMetricSchedule tfconfig.Variable `json:"data_metric_schedule,omitempty"`
+	ObjectName         tfconfig.Variable `json:"object_name,omitempty"`
+	ObjectType         tfconfig.Variable `json:"object_type,omitempty"`
+	On                 tfconfig.Variable `json:"on,omitempty"`
+	ScheduleStatus     tfconfig.Variable `json:"schedule_status,omitempty"`
+
+	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
+
+	*config.ResourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func DataMetricFunctionAttachment(
+	resourceName string,
+	dataMetricFunction string,
+	dataMetricSchedule []sdk.DataMetricFunctionOnObjectSetScheduleRequest,
+	objectName string,
+	objectType string,
+	on []string,
+) *DataMetricFunctionAttachmentModel {
+	d := &DataMetricFunctionAttachmentModel{ResourceModelMeta: config.Meta(resourceName, resources.DataMetricFunctionAttachment)}
+	d.WithDataMetricFunction(dataMetricFunction)
+	d.WithDataMetricSchedule(dataMetricSchedule)
+	d.WithObjectName(objectName)
+	d.WithObjectType(objectType)
+	d.WithOn(on)
+	return d
+}
+
+func DataMetricFunctionAttachmentWithDefaultMeta(
+	dataMetricFunction string,
+	dataMetricSchedule []sdk.DataMetricFunctionOnObjectSetScheduleRequest,
+	objectName string,
+	objectType string,
+	on []string,
+) *DataMetricFunctionAttachmentModel {
+	d := &DataMetricFunctionAttachmentModel{ResourceModelMeta: config.DefaultMeta(resources.DataMetricFunctionAttachment)}
+	d.WithDataMetricFunction(dataMetricFunction)
+	d.WithDataMetricSchedule(dataMetricSchedule)
+	d.WithObjectName(objectName)
+	d.WithObjectType(objectType)
+	d.WithOn(on)
+	return d
+}
+
+///////////////////////////////////////////////////////////////////////
+// set proper json marshalling, handle depends on and dynamic blocks //
+///////////////////////////////////////////////////////////////////////
+
+func (d *DataMetricFunctionAttachmentModel) MarshalJSON() ([]byte, error) {
+	type Alias DataMetricFunctionAttachmentModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn []string         `json:"depends_on,omitempty"`
+		Timeouts  *config.Timeouts `json:"timeouts,omitempty"`
+	}{
+		Alias:     (*Alias)(d),
+		DependsOn: d.DependsOn(),
+		Timeouts:  d.Timeouts(),
+	})
+}
+
+func (d *DataMetricFunctionAttachmentModel) WithDependsOn(values ...string) *DataMetricFunctionAttachmentModel {
+	d.SetDependsOn(values...)
+	return d
+}
+
+func (d *DataMetricFunctionAttachmentModel) WithDynamicBlock(dynamicBlock *config.DynamicBlock) *DataMetricFunctionAttachmentModel {
+	d.DynamicBlock = dynamicBlock
+	return d
+}
+
+func (d *DataMetricFunctionAttachmentModel) WithTimeout(timeout config.Timeouts) *DataMetricFunctionAttachmentModel {
+	d.SetTimeout(timeout)
+	return d
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+func (d *DataMetricFunctionAttachmentModel) WithDataMetricFunction(dataMetricFunction string) *DataMetricFunctionAttachmentModel {
+	d.DataMetricFunction = tfconfig.StringVariable(dataMetricFunction)
+	return d
+}
+
+// data_metric_schedule attribute type is not yet supported, so WithDataMetricSchedule can't be generated
+
+func (d *DataMetricFunctionAttachmentModel) WithObjectName(objectName string) *DataMetricFunctionAttachmentModel {
+	d.ObjectName = tfconfig.StringVariable(objectName)
+	return d
+}
+
+func (d *DataMetricFunctionAttachmentModel) WithObjectType(objectType string) *DataMetricFunctionAttachmentModel {
+	d.ObjectType = tfconfig.StringVariable(objectType)
+	return d
+}
+
+// on attribute type is not yet supported, so WithOn can't be generated
+
+func (d *DataMetricFunctionAttachmentModel) WithScheduleStatus(scheduleStatus string) *DataMetricFunctionAttachmentModel {
+	d.ScheduleStatus = tfconfig.StringVariable(scheduleStatus)
+	return d
+}
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (d *DataMetricFunctionAttachmentModel) WithDataMetricFunctionValue(value tfconfig.Variable) *DataMetricFunctionAttachmentModel {
+	d.DataMetricFunction = value
+	return d
+}
+
+func (d *DataMetricFunctionAttachmentModel) WithDataMetricScheduleValue(value tfconfig.Variable) *DataMetricFunctionAttachmentModel {
+	d.DataMetricSchedule = value
+	return d
+}
+
+func (d *DataMetricFunctionAttachmentModel) WithObjectNameValue(value tfconfig.Variable) *DataMetricFunctionAttachmentModel {
+	d.ObjectName = value
+	return d
+}
+
+func (d *DataMetricFunctionAttachmentModel) WithObjectTypeValue(value tfconfig.Variable) *DataMetricFunctionAttachmentModel {
+	d.ObjectType = value
+	return d
+}
+
+func (d *DataMetricFunctionAttachmentModel) WithOnValue(value tfconfig.Variable) *DataMetricFunctionAttachmentModel {
+	d.On = value
+	return d
+}
+
+func (d *DataMetricFunctionAttachmentModel) WithScheduleStatusValue(value tfconfig.Variable) *DataMetricFunctionAttachmentModel {
+	d.ScheduleStatus = value
+	return d
+}
@@ -34,3 +34,11 @@ func (d *DatabaseModel) WithReplication(accountIdentifier sdk.AccountIdentifier,
 		),
 	)
 }
+
+func (d *DatabaseModel) WithTags(tags map[string]string) *DatabaseModel {
+	tagVariables := make(map[string]tfconfig.Variable, len(tags))
+	for tagName, value := range tags {
+		tagVariables[tagName] = tfconfig.StringVariable(value)
+	}
+	return d.WithTagsValue(tfconfig.MapVariable(tagVariables))
+}
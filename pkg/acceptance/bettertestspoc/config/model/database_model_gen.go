@@ -20,6 +20,7 @@ type DatabaseModel struct {
 	DefaultNotebookComputePoolGpu           tfconfig.Variable `json:"default_notebook_compute_pool_gpu,omitempty"`
 	DropPublicSchemaOnCreation              tfconfig.Variable `json:"drop_public_schema_on_creation,omitempty"`
 	EnableConsoleOutput                     tfconfig.Variable `json:"enable_console_output,omitempty"`
+	EventTable                              tfconfig.Variable `json:"event_table,omitempty"`
 	ExternalVolume                          tfconfig.Variable `json:"external_volume,omitempty"`
 	FullyQualifiedName                      tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	IsTransient                             tfconfig.Variable `json:"is_transient,omitempty"`
@@ -31,6 +32,8 @@ type DatabaseModel struct {
 	Replication                             tfconfig.Variable `json:"replication,omitempty"`
 	StorageSerializationPolicy              tfconfig.Variable `json:"storage_serialization_policy,omitempty"`
 	SuspendTaskAfterNumFailures             tfconfig.Variable `json:"suspend_task_after_num_failures,omitempty"`
+	Tags                                    tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                                 tfconfig.Variable `json:"tags_all,omitempty"`
 	TaskAutoRetryAttempts                   tfconfig.Variable `json:"task_auto_retry_attempts,omitempty"`
 	TraceLevel                              tfconfig.Variable `json:"trace_level,omitempty"`
 	UserTaskManagedInitialWarehouseSize     tfconfig.Variable `json:"user_task_managed_initial_warehouse_size,omitempty"`
@@ -144,6 +147,11 @@ func (d *DatabaseModel) WithEnableConsoleOutput(enableConsoleOutput bool) *Datab
 	return d
 }
 
+func (d *DatabaseModel) WithEventTable(eventTable string) *DatabaseModel {
+	d.EventTable = tfconfig.StringVariable(eventTable)
+	return d
+}
+
 func (d *DatabaseModel) WithExternalVolume(externalVolume string) *DatabaseModel {
 	d.ExternalVolume = tfconfig.StringVariable(externalVolume)
 	return d
@@ -196,6 +204,10 @@ func (d *DatabaseModel) WithSuspendTaskAfterNumFailures(suspendTaskAfterNumFailu
 	return d
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (d *DatabaseModel) WithTaskAutoRetryAttempts(taskAutoRetryAttempts int) *DatabaseModel {
 	d.TaskAutoRetryAttempts = tfconfig.IntegerVariable(taskAutoRetryAttempts)
 	return d
@@ -270,6 +282,11 @@ func (d *DatabaseModel) WithEnableConsoleOutputValue(value tfconfig.Variable) *D
 	return d
 }
 
+func (d *DatabaseModel) WithEventTableValue(value tfconfig.Variable) *DatabaseModel {
+	d.EventTable = value
+	return d
+}
+
 func (d *DatabaseModel) WithExternalVolumeValue(value tfconfig.Variable) *DatabaseModel {
 	d.ExternalVolume = value
 	return d
@@ -325,6 +342,16 @@ func (d *DatabaseModel) WithSuspendTaskAfterNumFailuresValue(value tfconfig.Vari
 	return d
 }
 
+func (d *DatabaseModel) WithTagsValue(value tfconfig.Variable) *DatabaseModel {
+	d.Tags = value
+	return d
+}
+
+func (d *DatabaseModel) WithTagsAllValue(value tfconfig.Variable) *DatabaseModel {
+	d.TagsAll = value
+	return d
+}
+
 func (d *DatabaseModel) WithTaskAutoRetryAttemptsValue(value tfconfig.Variable) *DatabaseModel {
 	d.TaskAutoRetryAttempts = value
 	return d
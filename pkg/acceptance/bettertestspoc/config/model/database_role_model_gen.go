@@ -15,6 +15,8 @@ type DatabaseRoleModel struct {
 	Name               tfconfig.Variable `json:"name,omitempty"`
 	Comment            tfconfig.Variable `json:"comment,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll            tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -102,6 +104,10 @@ func (d *DatabaseRoleModel) WithFullyQualifiedName(fullyQualifiedName string) *D
 	return d
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -125,3 +131,13 @@ func (d *DatabaseRoleModel) WithFullyQualifiedNameValue(value tfconfig.Variable)
 	d.FullyQualifiedName = value
 	return d
 }
+
+func (d *DatabaseRoleModel) WithTagsValue(value tfconfig.Variable) *DatabaseRoleModel {
+	d.Tags = value
+	return d
+}
+
+func (d *DatabaseRoleModel) WithTagsAllValue(value tfconfig.Variable) *DatabaseRoleModel {
+	d.TagsAll = value
+	return d
+}
@@ -33,6 +33,8 @@ type DynamicTableModel struct {
 	RefreshModeReason   tfconfig.Variable `json:"refresh_mode_reason,omitempty"`
 	Rows                tfconfig.Variable `json:"rows,omitempty"`
 	SchedulingState     tfconfig.Variable `json:"scheduling_state,omitempty"`
+	Tags                tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll             tfconfig.Variable `json:"tags_all,omitempty"`
 	TargetLag           tfconfig.Variable `json:"target_lag,omitempty"`
 	Warehouse           tfconfig.Variable `json:"warehouse,omitempty"`
 
@@ -223,6 +225,10 @@ func (d *DynamicTableModel) WithSchedulingState(schedulingState string) *Dynamic
 	return d
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 // target_lag attribute type is not yet supported, so WithTargetLag can't be generated
 
 func (d *DynamicTableModel) WithWarehouse(warehouse string) *DynamicTableModel {
@@ -339,6 +345,16 @@ func (d *DynamicTableModel) WithSchedulingStateValue(value tfconfig.Variable) *D
 	return d
 }
 
+func (d *DynamicTableModel) WithTagsValue(value tfconfig.Variable) *DynamicTableModel {
+	d.Tags = value
+	return d
+}
+
+func (d *DynamicTableModel) WithTagsAllValue(value tfconfig.Variable) *DynamicTableModel {
+	d.TagsAll = value
+	return d
+}
+
 func (d *DynamicTableModel) WithTargetLagValue(value tfconfig.Variable) *DynamicTableModel {
 	d.TargetLag = value
 	return d
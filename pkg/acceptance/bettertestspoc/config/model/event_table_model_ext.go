@@ -0,0 +1,12 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func (e *EventTableModel) WithClusterBy(clusterBy ...string) *EventTableModel {
+	return e.WithClusterByValue(tfconfig.ListVariable(collections.Map(clusterBy, func(s string) tfconfig.Variable {
+		return tfconfig.StringVariable(s)
+	})...))
+}
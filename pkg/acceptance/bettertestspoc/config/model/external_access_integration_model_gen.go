@@ -18,6 +18,8 @@ type ExternalAccessIntegrationModel struct {
 	Comment                              tfconfig.Variable `json:"comment,omitempty"`
 	Enabled                              tfconfig.Variable `json:"enabled,omitempty"`
 	FullyQualifiedName                   tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	Tags                                 tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                              tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -115,6 +117,10 @@ func (e *ExternalAccessIntegrationModel) WithFullyQualifiedName(fullyQualifiedNa
 	return e
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -153,3 +159,13 @@ func (e *ExternalAccessIntegrationModel) WithFullyQualifiedNameValue(value tfcon
 	e.FullyQualifiedName = value
 	return e
 }
+
+func (e *ExternalAccessIntegrationModel) WithTagsValue(value tfconfig.Variable) *ExternalAccessIntegrationModel {
+	e.Tags = value
+	return e
+}
+
+func (e *ExternalAccessIntegrationModel) WithTagsAllValue(value tfconfig.Variable) *ExternalAccessIntegrationModel {
+	e.TagsAll = value
+	return e
+}
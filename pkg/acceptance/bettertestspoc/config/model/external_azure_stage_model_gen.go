@@ -23,6 +23,8 @@ type ExternalAzureStageModel struct {
 	FullyQualifiedName     tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	StageType              tfconfig.Variable `json:"stage_type,omitempty"`
 	StorageIntegration     tfconfig.Variable `json:"storage_integration,omitempty"`
+	Tags                   tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                tfconfig.Variable `json:"tags_all,omitempty"`
 	Url                    tfconfig.Variable `json:"url,omitempty"`
 	UsePrivatelinkEndpoint tfconfig.Variable `json:"use_privatelink_endpoint,omitempty"`
 
@@ -148,6 +150,10 @@ func (e *ExternalAzureStageModel) WithStorageIntegration(storageIntegration stri
 	return e
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (e *ExternalAzureStageModel) WithUrl(url string) *ExternalAzureStageModel {
 	e.Url = tfconfig.StringVariable(url)
 	return e
@@ -222,6 +228,16 @@ func (e *ExternalAzureStageModel) WithStorageIntegrationValue(value tfconfig.Var
 	return e
 }
 
+func (e *ExternalAzureStageModel) WithTagsValue(value tfconfig.Variable) *ExternalAzureStageModel {
+	e.Tags = value
+	return e
+}
+
+func (e *ExternalAzureStageModel) WithTagsAllValue(value tfconfig.Variable) *ExternalAzureStageModel {
+	e.TagsAll = value
+	return e
+}
+
 func (e *ExternalAzureStageModel) WithUrlValue(value tfconfig.Variable) *ExternalAzureStageModel {
 	e.Url = value
 	return e
@@ -22,6 +22,8 @@ type ExternalGcsStageModel struct {
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	StageType          tfconfig.Variable `json:"stage_type,omitempty"`
 	StorageIntegration tfconfig.Variable `json:"storage_integration,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll            tfconfig.Variable `json:"tags_all,omitempty"`
 	Url                tfconfig.Variable `json:"url,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -148,6 +150,10 @@ func (e *ExternalGcsStageModel) WithStorageIntegration(storageIntegration string
 	return e
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (e *ExternalGcsStageModel) WithUrl(url string) *ExternalGcsStageModel {
 	e.Url = tfconfig.StringVariable(url)
 	return e
@@ -212,6 +218,16 @@ func (e *ExternalGcsStageModel) WithStorageIntegrationValue(value tfconfig.Varia
 	return e
 }
 
+func (e *ExternalGcsStageModel) WithTagsValue(value tfconfig.Variable) *ExternalGcsStageModel {
+	e.Tags = value
+	return e
+}
+
+func (e *ExternalGcsStageModel) WithTagsAllValue(value tfconfig.Variable) *ExternalGcsStageModel {
+	e.TagsAll = value
+	return e
+}
+
 func (e *ExternalGcsStageModel) WithUrlValue(value tfconfig.Variable) *ExternalGcsStageModel {
 	e.Url = value
 	return e
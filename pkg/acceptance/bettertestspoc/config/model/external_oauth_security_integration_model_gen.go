@@ -29,6 +29,8 @@ type ExternalOauthSecurityIntegrationModel struct {
 	ExternalOauthType                          tfconfig.Variable `json:"external_oauth_type,omitempty"`
 	FullyQualifiedName                         tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	RelatedParameters                          tfconfig.Variable `json:"related_parameters,omitempty"`
+	Tags                                       tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                                    tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -184,6 +186,10 @@ func (e *ExternalOauthSecurityIntegrationModel) WithFullyQualifiedName(fullyQual
 
 // related_parameters attribute type is not yet supported, so WithRelatedParameters can't be generated
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -277,3 +283,13 @@ func (e *ExternalOauthSecurityIntegrationModel) WithRelatedParametersValue(value
 	e.RelatedParameters = value
 	return e
 }
+
+func (e *ExternalOauthSecurityIntegrationModel) WithTagsValue(value tfconfig.Variable) *ExternalOauthSecurityIntegrationModel {
+	e.Tags = value
+	return e
+}
+
+func (e *ExternalOauthSecurityIntegrationModel) WithTagsAllValue(value tfconfig.Variable) *ExternalOauthSecurityIntegrationModel {
+	e.TagsAll = value
+	return e
+}
@@ -22,6 +22,8 @@ type ExternalS3CompatibleStageModel struct {
 	FileFormat         tfconfig.Variable `json:"file_format,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	StageType          tfconfig.Variable `json:"stage_type,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll            tfconfig.Variable `json:"tags_all,omitempty"`
 	Url                tfconfig.Variable `json:"url,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -148,6 +150,10 @@ func (e *ExternalS3CompatibleStageModel) WithStageType(stageType string) *Extern
 	return e
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (e *ExternalS3CompatibleStageModel) WithUrl(url string) *ExternalS3CompatibleStageModel {
 	e.Url = tfconfig.StringVariable(url)
 	return e
@@ -212,6 +218,16 @@ func (e *ExternalS3CompatibleStageModel) WithStageTypeValue(value tfconfig.Varia
 	return e
 }
 
+func (e *ExternalS3CompatibleStageModel) WithTagsValue(value tfconfig.Variable) *ExternalS3CompatibleStageModel {
+	e.Tags = value
+	return e
+}
+
+func (e *ExternalS3CompatibleStageModel) WithTagsAllValue(value tfconfig.Variable) *ExternalS3CompatibleStageModel {
+	e.TagsAll = value
+	return e
+}
+
 func (e *ExternalS3CompatibleStageModel) WithUrlValue(value tfconfig.Variable) *ExternalS3CompatibleStageModel {
 	e.Url = value
 	return e
@@ -24,6 +24,8 @@ type ExternalS3StageModel struct {
 	FullyQualifiedName     tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	StageType              tfconfig.Variable `json:"stage_type,omitempty"`
 	StorageIntegration     tfconfig.Variable `json:"storage_integration,omitempty"`
+	Tags                   tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                tfconfig.Variable `json:"tags_all,omitempty"`
 	Url                    tfconfig.Variable `json:"url,omitempty"`
 	UsePrivatelinkEndpoint tfconfig.Variable `json:"use_privatelink_endpoint,omitempty"`
 
@@ -154,6 +156,10 @@ func (e *ExternalS3StageModel) WithStorageIntegration(storageIntegration string)
 	return e
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (e *ExternalS3StageModel) WithUrl(url string) *ExternalS3StageModel {
 	e.Url = tfconfig.StringVariable(url)
 	return e
@@ -233,6 +239,16 @@ func (e *ExternalS3StageModel) WithStorageIntegrationValue(value tfconfig.Variab
 	return e
 }
 
+func (e *ExternalS3StageModel) WithTagsValue(value tfconfig.Variable) *ExternalS3StageModel {
+	e.Tags = value
+	return e
+}
+
+func (e *ExternalS3StageModel) WithTagsAllValue(value tfconfig.Variable) *ExternalS3StageModel {
+	e.TagsAll = value
+	return e
+}
+
 func (e *ExternalS3StageModel) WithUrlValue(value tfconfig.Variable) *ExternalS3StageModel {
 	e.Url = value
 	return e
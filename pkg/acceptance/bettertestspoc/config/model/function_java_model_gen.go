@@ -33,6 +33,8 @@ type FunctionJavaModel struct {
 	ReturnType                 tfconfig.Variable `json:"return_type,omitempty"`
 	RuntimeVersion             tfconfig.Variable `json:"runtime_version,omitempty"`
 	Secrets                    tfconfig.Variable `json:"secrets,omitempty"`
+	Tags                       tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                    tfconfig.Variable `json:"tags_all,omitempty"`
 	TargetPath                 tfconfig.Variable `json:"target_path,omitempty"`
 	TraceLevel                 tfconfig.Variable `json:"trace_level,omitempty"`
 
@@ -209,6 +211,10 @@ func (f *FunctionJavaModel) WithRuntimeVersion(runtimeVersion string) *FunctionJ
 
 // secrets attribute type is not yet supported, so WithSecrets can't be generated
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 // target_path attribute type is not yet supported, so WithTargetPath can't be generated
 
 func (f *FunctionJavaModel) WithTraceLevel(traceLevel string) *FunctionJavaModel {
@@ -330,6 +336,16 @@ func (f *FunctionJavaModel) WithSecretsValue(value tfconfig.Variable) *FunctionJ
 	return f
 }
 
+func (f *FunctionJavaModel) WithTagsValue(value tfconfig.Variable) *FunctionJavaModel {
+	f.Tags = value
+	return f
+}
+
+func (f *FunctionJavaModel) WithTagsAllValue(value tfconfig.Variable) *FunctionJavaModel {
+	f.TagsAll = value
+	return f
+}
+
 func (f *FunctionJavaModel) WithTargetPathValue(value tfconfig.Variable) *FunctionJavaModel {
 	f.TargetPath = value
 	return f
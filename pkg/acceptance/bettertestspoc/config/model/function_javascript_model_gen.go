@@ -27,6 +27,8 @@ type FunctionJavascriptModel struct {
 	NullInputBehavior     tfconfig.Variable `json:"null_input_behavior,omitempty"`
 	ReturnResultsBehavior tfconfig.Variable `json:"return_results_behavior,omitempty"`
 	ReturnType            tfconfig.Variable `json:"return_type,omitempty"`
+	Tags                  tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll               tfconfig.Variable `json:"tags_all,omitempty"`
 	TraceLevel            tfconfig.Variable `json:"trace_level,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -184,6 +186,10 @@ func (f *FunctionJavascriptModel) WithReturnType(returnType string) *FunctionJav
 	return f
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (f *FunctionJavascriptModel) WithTraceLevel(traceLevel string) *FunctionJavascriptModel {
 	f.TraceLevel = tfconfig.StringVariable(traceLevel)
 	return f
@@ -273,6 +279,16 @@ func (f *FunctionJavascriptModel) WithReturnTypeValue(value tfconfig.Variable) *
 	return f
 }
 
+func (f *FunctionJavascriptModel) WithTagsValue(value tfconfig.Variable) *FunctionJavascriptModel {
+	f.Tags = value
+	return f
+}
+
+func (f *FunctionJavascriptModel) WithTagsAllValue(value tfconfig.Variable) *FunctionJavascriptModel {
+	f.TagsAll = value
+	return f
+}
+
 func (f *FunctionJavascriptModel) WithTraceLevelValue(value tfconfig.Variable) *FunctionJavascriptModel {
 	f.TraceLevel = value
 	return f
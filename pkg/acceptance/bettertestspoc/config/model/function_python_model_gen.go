@@ -34,6 +34,8 @@ type FunctionPythonModel struct {
 	ReturnType                 tfconfig.Variable `json:"return_type,omitempty"`
 	RuntimeVersion             tfconfig.Variable `json:"runtime_version,omitempty"`
 	Secrets                    tfconfig.Variable `json:"secrets,omitempty"`
+	Tags                       tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                    tfconfig.Variable `json:"tags_all,omitempty"`
 	TraceLevel                 tfconfig.Variable `json:"trace_level,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -218,6 +220,10 @@ func (f *FunctionPythonModel) WithRuntimeVersion(runtimeVersion string) *Functio
 
 // secrets attribute type is not yet supported, so WithSecrets can't be generated
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (f *FunctionPythonModel) WithTraceLevel(traceLevel string) *FunctionPythonModel {
 	f.TraceLevel = tfconfig.StringVariable(traceLevel)
 	return f
@@ -342,6 +348,16 @@ func (f *FunctionPythonModel) WithSecretsValue(value tfconfig.Variable) *Functio
 	return f
 }
 
+func (f *FunctionPythonModel) WithTagsValue(value tfconfig.Variable) *FunctionPythonModel {
+	f.Tags = value
+	return f
+}
+
+func (f *FunctionPythonModel) WithTagsAllValue(value tfconfig.Variable) *FunctionPythonModel {
+	f.TagsAll = value
+	return f
+}
+
 func (f *FunctionPythonModel) WithTraceLevelValue(value tfconfig.Variable) *FunctionPythonModel {
 	f.TraceLevel = value
 	return f
@@ -33,6 +33,8 @@ type FunctionScalaModel struct {
 	ReturnType                 tfconfig.Variable `json:"return_type,omitempty"`
 	RuntimeVersion             tfconfig.Variable `json:"runtime_version,omitempty"`
 	Secrets                    tfconfig.Variable `json:"secrets,omitempty"`
+	Tags                       tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                    tfconfig.Variable `json:"tags_all,omitempty"`
 	TargetPath                 tfconfig.Variable `json:"target_path,omitempty"`
 	TraceLevel                 tfconfig.Variable `json:"trace_level,omitempty"`
 
@@ -213,6 +215,10 @@ func (f *FunctionScalaModel) WithRuntimeVersion(runtimeVersion string) *Function
 
 // secrets attribute type is not yet supported, so WithSecrets can't be generated
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 // target_path attribute type is not yet supported, so WithTargetPath can't be generated
 
 func (f *FunctionScalaModel) WithTraceLevel(traceLevel string) *FunctionScalaModel {
@@ -334,6 +340,16 @@ func (f *FunctionScalaModel) WithSecretsValue(value tfconfig.Variable) *Function
 	return f
 }
 
+func (f *FunctionScalaModel) WithTagsValue(value tfconfig.Variable) *FunctionScalaModel {
+	f.Tags = value
+	return f
+}
+
+func (f *FunctionScalaModel) WithTagsAllValue(value tfconfig.Variable) *FunctionScalaModel {
+	f.TagsAll = value
+	return f
+}
+
 func (f *FunctionScalaModel) WithTargetPathValue(value tfconfig.Variable) *FunctionScalaModel {
 	f.TargetPath = value
 	return f
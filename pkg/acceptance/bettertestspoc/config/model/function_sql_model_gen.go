@@ -26,6 +26,8 @@ type FunctionSqlModel struct {
 	MetricLevel           tfconfig.Variable `json:"metric_level,omitempty"`
 	ReturnResultsBehavior tfconfig.Variable `json:"return_results_behavior,omitempty"`
 	ReturnType            tfconfig.Variable `json:"return_type,omitempty"`
+	Tags                  tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll               tfconfig.Variable `json:"tags_all,omitempty"`
 	TraceLevel            tfconfig.Variable `json:"trace_level,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -178,6 +180,10 @@ func (f *FunctionSqlModel) WithReturnType(returnType string) *FunctionSqlModel {
 	return f
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (f *FunctionSqlModel) WithTraceLevel(traceLevel string) *FunctionSqlModel {
 	f.TraceLevel = tfconfig.StringVariable(traceLevel)
 	return f
@@ -262,6 +268,16 @@ func (f *FunctionSqlModel) WithReturnTypeValue(value tfconfig.Variable) *Functio
 	return f
 }
 
+func (f *FunctionSqlModel) WithTagsValue(value tfconfig.Variable) *FunctionSqlModel {
+	f.Tags = value
+	return f
+}
+
+func (f *FunctionSqlModel) WithTagsAllValue(value tfconfig.Variable) *FunctionSqlModel {
+	f.TagsAll = value
+	return f
+}
+
 func (f *FunctionSqlModel) WithTraceLevelValue(value tfconfig.Variable) *FunctionSqlModel {
 	f.TraceLevel = value
 	return f
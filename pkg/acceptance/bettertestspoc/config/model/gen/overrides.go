@@ -27,6 +27,7 @@ var complexListAttributesOverrides = map[string]map[string]string{
 	"GrantOwnership":                {"on": "sdk.OwnershipGrantOn"},
 	"CatalogIntegrationOpenCatalog": {"rest_config": "sdk.OpenCatalogRestConfigRequest", "rest_authentication": "sdk.OAuthRestAuthenticationRequest"},
 	"CatalogIntegrationIcebergRest": {"rest_config": "sdk.IcebergRestRestConfigRequest", "oauth_rest_authentication": "sdk.OAuthRestAuthenticationRequest", "bearer_rest_authentication": "sdk.BearerRestAuthenticationRequest", "sigv4_rest_authentication": "sdk.SigV4RestAuthenticationRequest"}, //nolint:gosec // field-name mapping, not a credential
+	"DataMetricFunctionAttachment":  {"data_metric_schedule": "sdk.DataMetricFunctionOnObjectSetScheduleRequest"},
 	"ExternalVolume":                {"storage_location": "sdk.ExternalVolumeStorageLocationRequest"},
 	"Listing":                       {"manifest": "sdk.StageLocation"},
 	"MaskingPolicy":                 {"argument": "sdk.TableColumnSignature"},
@@ -42,4 +43,5 @@ var complexListAttributesOverrides = map[string]map[string]string{
 	"StorageIntegrationAzure": {"storage_allowed_locations": "sdk.StorageLocation"},
 	"StorageIntegrationGcs":   {"storage_allowed_locations": "sdk.StorageLocation"},
 	"StorageLifecyclePolicy":  {"argument": "sdk.TableColumnSignature"},
+	"GrantPrivilegesMatrix":   {"entry": "GrantPrivilegesMatrixEntry"},
 }
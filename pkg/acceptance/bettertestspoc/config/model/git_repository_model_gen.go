@@ -16,6 +16,7 @@ type GitRepositoryModel struct {
 	Name               tfconfig.Variable `json:"name,omitempty"`
 	ApiIntegration     tfconfig.Variable `json:"api_integration,omitempty"`
 	Comment            tfconfig.Variable `json:"comment,omitempty"`
+	FetchTrigger       tfconfig.Variable `json:"fetch_trigger,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	GitCredentials     tfconfig.Variable `json:"git_credentials,omitempty"`
 	Origin             tfconfig.Variable `json:"origin,omitempty"`
@@ -123,6 +124,11 @@ func (g *GitRepositoryModel) WithComment(comment string) *GitRepositoryModel {
 	return g
 }
 
+func (g *GitRepositoryModel) WithFetchTrigger(fetchTrigger string) *GitRepositoryModel {
+	g.FetchTrigger = tfconfig.StringVariable(fetchTrigger)
+	return g
+}
+
 func (g *GitRepositoryModel) WithFullyQualifiedName(fullyQualifiedName string) *GitRepositoryModel {
 	g.FullyQualifiedName = tfconfig.StringVariable(fullyQualifiedName)
 	return g
@@ -167,6 +173,11 @@ func (g *GitRepositoryModel) WithCommentValue(value tfconfig.Variable) *GitRepos
 	return g
 }
 
+func (g *GitRepositoryModel) WithFetchTriggerValue(value tfconfig.Variable) *GitRepositoryModel {
+	g.FetchTrigger = value
+	return g
+}
+
 func (g *GitRepositoryModel) WithFullyQualifiedNameValue(value tfconfig.Variable) *GitRepositoryModel {
 	g.FullyQualifiedName = value
 	return g
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func (g *GrantCallerPrivilegesModel) WithPrivileges(privileges ...string) *GrantCallerPrivilegesModel {
+	privilegeStringVariables := collections.Map(privileges, func(privilege string) tfconfig.Variable { return tfconfig.StringVariable(privilege) })
+	g.WithPrivilegesValue(tfconfig.SetVariable(privilegeStringVariables...))
+	return g
+}
+
+func (g *GrantCallerPrivilegesModel) WithOnObject(objectType sdk.ObjectType, objectName string) *GrantCallerPrivilegesModel {
+	return g.WithOnObjectValue(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+		"object_type": tfconfig.StringVariable(string(objectType)),
+		"object_name": tfconfig.StringVariable(objectName),
+	}))
+}
+
+func (g *GrantCallerPrivilegesModel) WithInheritedInSchema(objectTypePlural sdk.PluralObjectType, schemaFQN string) *GrantCallerPrivilegesModel {
+	return g.WithInheritedValue(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+		"object_type_plural": tfconfig.StringVariable(string(objectTypePlural)),
+		"in_schema":          tfconfig.StringVariable(schemaFQN),
+	}))
+}
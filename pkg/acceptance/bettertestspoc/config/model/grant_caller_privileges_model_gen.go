@@ -0,0 +1,131 @@
+// Code generated by resource model builder generator (v0.1.0); DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type GrantCallerPrivilegesModel struct {
+	AccountRoleName  tfconfig.Variable `json:"account_role_name,omitempty"`
+	AllPrivileges    tfconfig.Variable `json:"all_privileges,omitempty"`
+	DatabaseRoleName tfconfig.Variable `json:"database_role_name,omitempty"`
+	Inherited        tfconfig.Variable `json:"inherited,omitempty"`
+	OnObject         tfconfig.Variable `json:"on_object,omitempty"`
+	Privileges       tfconfig.Variable `json:"privileges,omitempty"`
+
+	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
+
+	*config.ResourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func GrantCallerPrivileges(
+	resourceName string,
+) *GrantCallerPrivilegesModel {
+	g := &GrantCallerPrivilegesModel{ResourceModelMeta: config.Meta(resourceName, resources.GrantCallerPrivileges)}
+	return g
+}
+
+func GrantCallerPrivilegesWithDefaultMeta() *GrantCallerPrivilegesModel {
+	g := &GrantCallerPrivilegesModel{ResourceModelMeta: config.DefaultMeta(resources.GrantCallerPrivileges)}
+	return g
+}
+
+///////////////////////////////////////////////////////////////////////
+// set proper json marshalling, handle depends on and dynamic blocks //
+///////////////////////////////////////////////////////////////////////
+
+func (g *GrantCallerPrivilegesModel) MarshalJSON() ([]byte, error) {
+	type Alias GrantCallerPrivilegesModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn []string         `json:"depends_on,omitempty"`
+		Timeouts  *config.Timeouts `json:"timeouts,omitempty"`
+	}{
+		Alias:     (*Alias)(g),
+		DependsOn: g.DependsOn(),
+		Timeouts:  g.Timeouts(),
+	})
+}
+
+func (g *GrantCallerPrivilegesModel) WithDependsOn(values ...string) *GrantCallerPrivilegesModel {
+	g.SetDependsOn(values...)
+	return g
+}
+
+func (g *GrantCallerPrivilegesModel) WithDynamicBlock(dynamicBlock *config.DynamicBlock) *GrantCallerPrivilegesModel {
+	g.DynamicBlock = dynamicBlock
+	return g
+}
+
+func (g *GrantCallerPrivilegesModel) WithTimeout(timeout config.Timeouts) *GrantCallerPrivilegesModel {
+	g.SetTimeout(timeout)
+	return g
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+func (g *GrantCallerPrivilegesModel) WithAccountRoleName(accountRoleName string) *GrantCallerPrivilegesModel {
+	g.AccountRoleName = tfconfig.StringVariable(accountRoleName)
+	return g
+}
+
+func (g *GrantCallerPrivilegesModel) WithAllPrivileges(allPrivileges bool) *GrantCallerPrivilegesModel {
+	g.AllPrivileges = tfconfig.BoolVariable(allPrivileges)
+	return g
+}
+
+func (g *GrantCallerPrivilegesModel) WithDatabaseRoleName(databaseRoleName string) *GrantCallerPrivilegesModel {
+	g.DatabaseRoleName = tfconfig.StringVariable(databaseRoleName)
+	return g
+}
+
+// inherited attribute type is not yet supported, so WithInherited can't be generated
+
+// on_object attribute type is not yet supported, so WithOnObject can't be generated
+
+// privileges attribute type is not yet supported, so WithPrivileges can't be generated
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (g *GrantCallerPrivilegesModel) WithAccountRoleNameValue(value tfconfig.Variable) *GrantCallerPrivilegesModel {
+	g.AccountRoleName = value
+	return g
+}
+
+func (g *GrantCallerPrivilegesModel) WithAllPrivilegesValue(value tfconfig.Variable) *GrantCallerPrivilegesModel {
+	g.AllPrivileges = value
+	return g
+}
+
+func (g *GrantCallerPrivilegesModel) WithDatabaseRoleNameValue(value tfconfig.Variable) *GrantCallerPrivilegesModel {
+	g.DatabaseRoleName = value
+	return g
+}
+
+func (g *GrantCallerPrivilegesModel) WithInheritedValue(value tfconfig.Variable) *GrantCallerPrivilegesModel {
+	g.Inherited = value
+	return g
+}
+
+func (g *GrantCallerPrivilegesModel) WithOnObjectValue(value tfconfig.Variable) *GrantCallerPrivilegesModel {
+	g.OnObject = value
+	return g
+}
+
+func (g *GrantCallerPrivilegesModel) WithPrivilegesValue(value tfconfig.Variable) *GrantCallerPrivilegesModel {
+	g.Privileges = value
+	return g
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type GrantPrivilegesMatrixEntry struct {
+	AccountRoleName string
+	Privileges      []string
+	ObjectType      sdk.ObjectType
+	ObjectName      string
+}
+
+func (g *GrantPrivilegesMatrixModel) WithEntry(entries []GrantPrivilegesMatrixEntry) *GrantPrivilegesMatrixModel {
+	entryVariables := make([]tfconfig.Variable, len(entries))
+	for i, entry := range entries {
+		entryVariables[i] = tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"account_role_name": tfconfig.StringVariable(entry.AccountRoleName),
+			"privileges":        tfconfig.SetVariable(collections.Map(entry.Privileges, func(privilege string) tfconfig.Variable { return tfconfig.StringVariable(privilege) })...),
+			"object_type":       tfconfig.StringVariable(string(entry.ObjectType)),
+			"object_name":       tfconfig.StringVariable(entry.ObjectName),
+		})
+	}
+	g.Entry = tfconfig.ListVariable(entryVariables...)
+	return g
+}
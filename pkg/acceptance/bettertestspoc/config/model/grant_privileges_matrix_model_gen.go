@@ -0,0 +1,87 @@
+// Code generated by resource model builder generator (v0.1.0); DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type GrantPrivilegesMatrixModel struct {
+	Entry tfconfig.Variable `json:"entry,omitempty"`
+
+	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
+
+	*config.ResourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func GrantPrivilegesMatrix(
+	resourceName string,
+	entry []GrantPrivilegesMatrixEntry,
+) *GrantPrivilegesMatrixModel {
+	g := &GrantPrivilegesMatrixModel{ResourceModelMeta: config.Meta(resourceName, resources.GrantPrivilegesMatrix)}
+	g.WithEntry(entry)
+	return g
+}
+
+func GrantPrivilegesMatrixWithDefaultMeta(
+	entry []GrantPrivilegesMatrixEntry,
+) *GrantPrivilegesMatrixModel {
+	g := &GrantPrivilegesMatrixModel{ResourceModelMeta: config.DefaultMeta(resources.GrantPrivilegesMatrix)}
+	g.WithEntry(entry)
+	return g
+}
+
+///////////////////////////////////////////////////////////////////////
+// set proper json marshalling, handle depends on and dynamic blocks //
+///////////////////////////////////////////////////////////////////////
+
+func (g *GrantPrivilegesMatrixModel) MarshalJSON() ([]byte, error) {
+	type Alias GrantPrivilegesMatrixModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn []string         `json:"depends_on,omitempty"`
+		Timeouts  *config.Timeouts `json:"timeouts,omitempty"`
+	}{
+		Alias:     (*Alias)(g),
+		DependsOn: g.DependsOn(),
+		Timeouts:  g.Timeouts(),
+	})
+}
+
+func (g *GrantPrivilegesMatrixModel) WithDependsOn(values ...string) *GrantPrivilegesMatrixModel {
+	g.SetDependsOn(values...)
+	return g
+}
+
+func (g *GrantPrivilegesMatrixModel) WithDynamicBlock(dynamicBlock *config.DynamicBlock) *GrantPrivilegesMatrixModel {
+	g.DynamicBlock = dynamicBlock
+	return g
+}
+
+func (g *GrantPrivilegesMatrixModel) WithTimeout(timeout config.Timeouts) *GrantPrivilegesMatrixModel {
+	g.SetTimeout(timeout)
+	return g
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+// entry attribute type is not yet supported, so WithEntry can't be generated
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (g *GrantPrivilegesMatrixModel) WithEntryValue(value tfconfig.Variable) *GrantPrivilegesMatrixModel {
+	g.Entry = value
+	return g
+}
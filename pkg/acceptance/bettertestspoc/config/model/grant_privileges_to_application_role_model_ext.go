@@ -0,0 +1,42 @@
+package model
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithPrivileges(privileges ...string) *GrantPrivilegesToApplicationRoleModel {
+	privilegeStringVariables := collections.Map(privileges, func(privilege string) tfconfig.Variable { return tfconfig.StringVariable(privilege) })
+	g.WithPrivilegesValue(tfconfig.SetVariable(privilegeStringVariables...))
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithOnAccountObject(objectType sdk.ObjectType, id sdk.AccountObjectIdentifier) *GrantPrivilegesToApplicationRoleModel {
+	return g.WithOnAccountObjectValue(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+		"object_type": tfconfig.StringVariable(string(objectType)),
+		"object_name": tfconfig.StringVariable(id.FullyQualifiedName()),
+	}))
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithOnSchemaName(schemaFQN string) *GrantPrivilegesToApplicationRoleModel {
+	return g.WithOnSchemaValue(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+		"schema_name": tfconfig.StringVariable(schemaFQN),
+	}))
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithOnSchemaObjectObject(objectType sdk.ObjectType, objectName string) *GrantPrivilegesToApplicationRoleModel {
+	return g.WithOnSchemaObjectValue(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+		"object_type": tfconfig.StringVariable(string(objectType)),
+		"object_name": tfconfig.StringVariable(objectName),
+	}))
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithOnSchemaObjectFutureInSchema(objectTypePlural sdk.PluralObjectType, schemaFQN string) *GrantPrivilegesToApplicationRoleModel {
+	return g.WithOnSchemaObjectValue(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+		"future": tfconfig.ListVariable(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+			"object_type_plural": tfconfig.StringVariable(string(objectTypePlural)),
+			"in_schema":          tfconfig.StringVariable(schemaFQN),
+		})),
+	}))
+}
@@ -0,0 +1,177 @@
+// Code generated by resource model builder generator (v0.1.0); DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type GrantPrivilegesToApplicationRoleModel struct {
+	AllPrivileges       tfconfig.Variable `json:"all_privileges,omitempty"`
+	AlwaysApply         tfconfig.Variable `json:"always_apply,omitempty"`
+	AlwaysApplyTrigger  tfconfig.Variable `json:"always_apply_trigger,omitempty"`
+	ApplicationRoleName tfconfig.Variable `json:"application_role_name,omitempty"`
+	OnAccount           tfconfig.Variable `json:"on_account,omitempty"`
+	OnAccountObject     tfconfig.Variable `json:"on_account_object,omitempty"`
+	OnSchema            tfconfig.Variable `json:"on_schema,omitempty"`
+	OnSchemaObject      tfconfig.Variable `json:"on_schema_object,omitempty"`
+	Privileges          tfconfig.Variable `json:"privileges,omitempty"`
+	WithGrantOption     tfconfig.Variable `json:"with_grant_option,omitempty"`
+
+	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
+
+	*config.ResourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func GrantPrivilegesToApplicationRole(
+	resourceName string,
+	applicationRoleName string,
+) *GrantPrivilegesToApplicationRoleModel {
+	g := &GrantPrivilegesToApplicationRoleModel{ResourceModelMeta: config.Meta(resourceName, resources.GrantPrivilegesToApplicationRole)}
+	g.WithApplicationRoleName(applicationRoleName)
+	return g
+}
+
+func GrantPrivilegesToApplicationRoleWithDefaultMeta(
+	applicationRoleName string,
+) *GrantPrivilegesToApplicationRoleModel {
+	g := &GrantPrivilegesToApplicationRoleModel{ResourceModelMeta: config.DefaultMeta(resources.GrantPrivilegesToApplicationRole)}
+	g.WithApplicationRoleName(applicationRoleName)
+	return g
+}
+
+///////////////////////////////////////////////////////////////////////
+// set proper json marshalling, handle depends on and dynamic blocks //
+///////////////////////////////////////////////////////////////////////
+
+func (g *GrantPrivilegesToApplicationRoleModel) MarshalJSON() ([]byte, error) {
+	type Alias GrantPrivilegesToApplicationRoleModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn []string         `json:"depends_on,omitempty"`
+		Timeouts  *config.Timeouts `json:"timeouts,omitempty"`
+	}{
+		Alias:     (*Alias)(g),
+		DependsOn: g.DependsOn(),
+		Timeouts:  g.Timeouts(),
+	})
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithDependsOn(values ...string) *GrantPrivilegesToApplicationRoleModel {
+	g.SetDependsOn(values...)
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithDynamicBlock(dynamicBlock *config.DynamicBlock) *GrantPrivilegesToApplicationRoleModel {
+	g.DynamicBlock = dynamicBlock
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithTimeout(timeout config.Timeouts) *GrantPrivilegesToApplicationRoleModel {
+	g.SetTimeout(timeout)
+	return g
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithAllPrivileges(allPrivileges bool) *GrantPrivilegesToApplicationRoleModel {
+	g.AllPrivileges = tfconfig.BoolVariable(allPrivileges)
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithAlwaysApply(alwaysApply bool) *GrantPrivilegesToApplicationRoleModel {
+	g.AlwaysApply = tfconfig.BoolVariable(alwaysApply)
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithAlwaysApplyTrigger(alwaysApplyTrigger string) *GrantPrivilegesToApplicationRoleModel {
+	g.AlwaysApplyTrigger = tfconfig.StringVariable(alwaysApplyTrigger)
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithApplicationRoleName(applicationRoleName string) *GrantPrivilegesToApplicationRoleModel {
+	g.ApplicationRoleName = tfconfig.StringVariable(applicationRoleName)
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithOnAccount(onAccount bool) *GrantPrivilegesToApplicationRoleModel {
+	g.OnAccount = tfconfig.BoolVariable(onAccount)
+	return g
+}
+
+// on_account_object attribute type is not yet supported, so WithOnAccountObject can't be generated
+
+// on_schema attribute type is not yet supported, so WithOnSchema can't be generated
+
+// on_schema_object attribute type is not yet supported, so WithOnSchemaObject can't be generated
+
+// privileges attribute type is not yet supported, so WithPrivileges can't be generated
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithWithGrantOption(withGrantOption bool) *GrantPrivilegesToApplicationRoleModel {
+	g.WithGrantOption = tfconfig.BoolVariable(withGrantOption)
+	return g
+}
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithAllPrivilegesValue(value tfconfig.Variable) *GrantPrivilegesToApplicationRoleModel {
+	g.AllPrivileges = value
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithAlwaysApplyValue(value tfconfig.Variable) *GrantPrivilegesToApplicationRoleModel {
+	g.AlwaysApply = value
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithAlwaysApplyTriggerValue(value tfconfig.Variable) *GrantPrivilegesToApplicationRoleModel {
+	g.AlwaysApplyTrigger = value
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithApplicationRoleNameValue(value tfconfig.Variable) *GrantPrivilegesToApplicationRoleModel {
+	g.ApplicationRoleName = value
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithOnAccountValue(value tfconfig.Variable) *GrantPrivilegesToApplicationRoleModel {
+	g.OnAccount = value
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithOnAccountObjectValue(value tfconfig.Variable) *GrantPrivilegesToApplicationRoleModel {
+	g.OnAccountObject = value
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithOnSchemaValue(value tfconfig.Variable) *GrantPrivilegesToApplicationRoleModel {
+	g.OnSchema = value
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithOnSchemaObjectValue(value tfconfig.Variable) *GrantPrivilegesToApplicationRoleModel {
+	g.OnSchemaObject = value
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithPrivilegesValue(value tfconfig.Variable) *GrantPrivilegesToApplicationRoleModel {
+	g.Privileges = value
+	return g
+}
+
+func (g *GrantPrivilegesToApplicationRoleModel) WithWithGrantOptionValue(value tfconfig.Variable) *GrantPrivilegesToApplicationRoleModel {
+	g.WithGrantOption = value
+	return g
+}
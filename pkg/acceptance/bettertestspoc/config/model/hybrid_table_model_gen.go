@@ -23,6 +23,8 @@ type HybridTableModel struct {
 	Index                      tfconfig.Variable `json:"index,omitempty"`
 	MaxDataExtensionTimeInDays tfconfig.Variable `json:"max_data_extension_time_in_days,omitempty"`
 	PrimaryKeyConstraint       tfconfig.Variable `json:"primary_key_constraint,omitempty"`
+	Tags                       tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                    tfconfig.Variable `json:"tags_all,omitempty"`
 	UniqueConstraint           tfconfig.Variable `json:"unique_constraint,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -146,6 +148,10 @@ func (h *HybridTableModel) WithMaxDataExtensionTimeInDays(maxDataExtensionTimeIn
 
 // primary_key_constraint attribute type is not yet supported, so WithPrimaryKeyConstraint can't be generated
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 // unique_constraint attribute type is not yet supported, so WithUniqueConstraint can't be generated
 
 //////////////////////////////////////////
@@ -207,6 +213,16 @@ func (h *HybridTableModel) WithPrimaryKeyConstraintValue(value tfconfig.Variable
 	return h
 }
 
+func (h *HybridTableModel) WithTagsValue(value tfconfig.Variable) *HybridTableModel {
+	h.Tags = value
+	return h
+}
+
+func (h *HybridTableModel) WithTagsAllValue(value tfconfig.Variable) *HybridTableModel {
+	h.TagsAll = value
+	return h
+}
+
 func (h *HybridTableModel) WithUniqueConstraintValue(value tfconfig.Variable) *HybridTableModel {
 	h.UniqueConstraint = value
 	return h
@@ -22,6 +22,8 @@ type IcebergTableFromAwsGlueModel struct {
 	ExternalVolume           tfconfig.Variable `json:"external_volume,omitempty"`
 	FullyQualifiedName       tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	ReplaceInvalidCharacters tfconfig.Variable `json:"replace_invalid_characters,omitempty"`
+	Tags                     tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                  tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -152,6 +154,10 @@ func (i *IcebergTableFromAwsGlueModel) WithReplaceInvalidCharacters(replaceInval
 	return i
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -210,3 +216,13 @@ func (i *IcebergTableFromAwsGlueModel) WithReplaceInvalidCharactersValue(value t
 	i.ReplaceInvalidCharacters = value
 	return i
 }
+
+func (i *IcebergTableFromAwsGlueModel) WithTagsValue(value tfconfig.Variable) *IcebergTableFromAwsGlueModel {
+	i.Tags = value
+	return i
+}
+
+func (i *IcebergTableFromAwsGlueModel) WithTagsAllValue(value tfconfig.Variable) *IcebergTableFromAwsGlueModel {
+	i.TagsAll = value
+	return i
+}
@@ -21,6 +21,8 @@ type IcebergTableFromDeltaFilesModel struct {
 	ExternalVolume           tfconfig.Variable `json:"external_volume,omitempty"`
 	FullyQualifiedName       tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	ReplaceInvalidCharacters tfconfig.Variable `json:"replace_invalid_characters,omitempty"`
+	Tags                     tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                  tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -146,6 +148,10 @@ func (i *IcebergTableFromDeltaFilesModel) WithReplaceInvalidCharacters(replaceIn
 	return i
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -199,3 +205,13 @@ func (i *IcebergTableFromDeltaFilesModel) WithReplaceInvalidCharactersValue(valu
 	i.ReplaceInvalidCharacters = value
 	return i
 }
+
+func (i *IcebergTableFromDeltaFilesModel) WithTagsValue(value tfconfig.Variable) *IcebergTableFromDeltaFilesModel {
+	i.Tags = value
+	return i
+}
+
+func (i *IcebergTableFromDeltaFilesModel) WithTagsAllValue(value tfconfig.Variable) *IcebergTableFromDeltaFilesModel {
+	i.TagsAll = value
+	return i
+}
@@ -20,6 +20,8 @@ type IcebergTableFromFilesModel struct {
 	FullyQualifiedName       tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	MetadataFilePath         tfconfig.Variable `json:"metadata_file_path,omitempty"`
 	ReplaceInvalidCharacters tfconfig.Variable `json:"replace_invalid_characters,omitempty"`
+	Tags                     tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                  tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -140,6 +142,10 @@ func (i *IcebergTableFromFilesModel) WithReplaceInvalidCharacters(replaceInvalid
 	return i
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -188,3 +194,13 @@ func (i *IcebergTableFromFilesModel) WithReplaceInvalidCharactersValue(value tfc
 	i.ReplaceInvalidCharacters = value
 	return i
 }
+
+func (i *IcebergTableFromFilesModel) WithTagsValue(value tfconfig.Variable) *IcebergTableFromFilesModel {
+	i.Tags = value
+	return i
+}
+
+func (i *IcebergTableFromFilesModel) WithTagsAllValue(value tfconfig.Variable) *IcebergTableFromFilesModel {
+	i.TagsAll = value
+	return i
+}
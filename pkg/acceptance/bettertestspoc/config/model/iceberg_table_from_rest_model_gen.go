@@ -26,6 +26,8 @@ type IcebergTableFromRestModel struct {
 	PathLayout                 tfconfig.Variable `json:"path_layout,omitempty"`
 	ReplaceInvalidCharacters   tfconfig.Variable `json:"replace_invalid_characters,omitempty"`
 	StorageSerializationPolicy tfconfig.Variable `json:"storage_serialization_policy,omitempty"`
+	Tags                       tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                    tfconfig.Variable `json:"tags_all,omitempty"`
 	TargetFileSize             tfconfig.Variable `json:"target_file_size,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -177,6 +179,10 @@ func (i *IcebergTableFromRestModel) WithStorageSerializationPolicy(storageSerial
 	return i
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (i *IcebergTableFromRestModel) WithTargetFileSize(targetFileSize string) *IcebergTableFromRestModel {
 	i.TargetFileSize = tfconfig.StringVariable(targetFileSize)
 	return i
@@ -261,6 +267,16 @@ func (i *IcebergTableFromRestModel) WithStorageSerializationPolicyValue(value tf
 	return i
 }
 
+func (i *IcebergTableFromRestModel) WithTagsValue(value tfconfig.Variable) *IcebergTableFromRestModel {
+	i.Tags = value
+	return i
+}
+
+func (i *IcebergTableFromRestModel) WithTagsAllValue(value tfconfig.Variable) *IcebergTableFromRestModel {
+	i.TagsAll = value
+	return i
+}
+
 func (i *IcebergTableFromRestModel) WithTargetFileSizeValue(value tfconfig.Variable) *IcebergTableFromRestModel {
 	i.TargetFileSize = value
 	return i
@@ -185,6 +185,16 @@ func (i *IcebergTableModel) WithAggregationPolicy(ap sdk.SchemaObjectIdentifier,
 	)
 }
 
+func (i *IcebergTableModel) WithJoinPolicy(jp sdk.SchemaObjectIdentifier) *IcebergTableModel {
+	return i.WithJoinPolicyValue(
+		tfconfig.ObjectVariable(
+			map[string]tfconfig.Variable{
+				"policy_name": tfconfig.StringVariable(jp.FullyQualifiedName()),
+			},
+		),
+	)
+}
+
 // WithClusterBy satisfies the generated constructor's call for the complex list `cluster_by` attribute.
 func (i *IcebergTableModel) WithClusterBy(clusterBy ...string) *IcebergTableModel {
 	return i.WithClusterByValue(tfconfig.ListVariable(collections.Map(clusterBy, func(s string) tfconfig.Variable {
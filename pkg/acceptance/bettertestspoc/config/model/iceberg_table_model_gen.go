@@ -32,12 +32,15 @@ type IcebergTableModel struct {
 	ForeignKeyConstraint       tfconfig.Variable `json:"foreign_key_constraint,omitempty"`
 	FullyQualifiedName         tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	IcebergVersion             tfconfig.Variable `json:"iceberg_version,omitempty"`
+	JoinPolicy                 tfconfig.Variable `json:"join_policy,omitempty"`
 	MaxDataExtensionTimeInDays tfconfig.Variable `json:"max_data_extension_time_in_days,omitempty"`
 	PartitionBy                tfconfig.Variable `json:"partition_by,omitempty"`
 	PathLayout                 tfconfig.Variable `json:"path_layout,omitempty"`
 	PrimaryKeyConstraint       tfconfig.Variable `json:"primary_key_constraint,omitempty"`
 	RowAccessPolicy            tfconfig.Variable `json:"row_access_policy,omitempty"`
 	StorageSerializationPolicy tfconfig.Variable `json:"storage_serialization_policy,omitempty"`
+	Tags                       tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                    tfconfig.Variable `json:"tags_all,omitempty"`
 	TargetFileSize             tfconfig.Variable `json:"target_file_size,omitempty"`
 	UniqueConstraint           tfconfig.Variable `json:"unique_constraint,omitempty"`
 
@@ -200,6 +203,8 @@ func (i *IcebergTableModel) WithIcebergVersion(icebergVersion int) *IcebergTable
 	return i
 }
 
+// join_policy attribute type is not yet supported, so WithJoinPolicy can't be generated
+
 func (i *IcebergTableModel) WithMaxDataExtensionTimeInDays(maxDataExtensionTimeInDays int) *IcebergTableModel {
 	i.MaxDataExtensionTimeInDays = tfconfig.IntegerVariable(maxDataExtensionTimeInDays)
 	return i
@@ -221,6 +226,10 @@ func (i *IcebergTableModel) WithStorageSerializationPolicy(storageSerializationP
 	return i
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (i *IcebergTableModel) WithTargetFileSize(targetFileSize string) *IcebergTableModel {
 	i.TargetFileSize = tfconfig.StringVariable(targetFileSize)
 	return i
@@ -332,6 +341,11 @@ func (i *IcebergTableModel) WithIcebergVersionValue(value tfconfig.Variable) *Ic
 	return i
 }
 
+func (i *IcebergTableModel) WithJoinPolicyValue(value tfconfig.Variable) *IcebergTableModel {
+	i.JoinPolicy = value
+	return i
+}
+
 func (i *IcebergTableModel) WithMaxDataExtensionTimeInDaysValue(value tfconfig.Variable) *IcebergTableModel {
 	i.MaxDataExtensionTimeInDays = value
 	return i
@@ -362,6 +376,16 @@ func (i *IcebergTableModel) WithStorageSerializationPolicyValue(value tfconfig.V
 	return i
 }
 
+func (i *IcebergTableModel) WithTagsValue(value tfconfig.Variable) *IcebergTableModel {
+	i.Tags = value
+	return i
+}
+
+func (i *IcebergTableModel) WithTagsAllValue(value tfconfig.Variable) *IcebergTableModel {
+	i.TagsAll = value
+	return i
+}
+
 func (i *IcebergTableModel) WithTargetFileSizeValue(value tfconfig.Variable) *IcebergTableModel {
 	i.TargetFileSize = value
 	return i
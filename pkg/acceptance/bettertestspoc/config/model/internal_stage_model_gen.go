@@ -20,6 +20,8 @@ type InternalStageModel struct {
 	FileFormat         tfconfig.Variable `json:"file_format,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	StageType          tfconfig.Variable `json:"stage_type,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll            tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -127,6 +129,10 @@ func (i *InternalStageModel) WithStageType(stageType string) *InternalStageModel
 	return i
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -175,3 +181,13 @@ func (i *InternalStageModel) WithStageTypeValue(value tfconfig.Variable) *Intern
 	i.StageType = value
 	return i
 }
+
+func (i *InternalStageModel) WithTagsValue(value tfconfig.Variable) *InternalStageModel {
+	i.Tags = value
+	return i
+}
+
+func (i *InternalStageModel) WithTagsAllValue(value tfconfig.Variable) *InternalStageModel {
+	i.TagsAll = value
+	return i
+}
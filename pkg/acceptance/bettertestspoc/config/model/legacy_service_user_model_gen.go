@@ -70,6 +70,8 @@ type LegacyServiceUserModel struct {
 	StatementQueuedTimeoutInSeconds          tfconfig.Variable `json:"statement_queued_timeout_in_seconds,omitempty"`
 	StatementTimeoutInSeconds                tfconfig.Variable `json:"statement_timeout_in_seconds,omitempty"`
 	StrictJsonOutput                         tfconfig.Variable `json:"strict_json_output,omitempty"`
+	Tags                                     tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                                  tfconfig.Variable `json:"tags_all,omitempty"`
 	TimeInputFormat                          tfconfig.Variable `json:"time_input_format,omitempty"`
 	TimeOutputFormat                         tfconfig.Variable `json:"time_output_format,omitempty"`
 	TimestampDayIsAlways24h                  tfconfig.Variable `json:"timestamp_day_is_always_24h,omitempty"`
@@ -444,6 +446,10 @@ func (l *LegacyServiceUserModel) WithStrictJsonOutput(strictJsonOutput bool) *Le
 	return l
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (l *LegacyServiceUserModel) WithTimeInputFormat(timeInputFormat string) *LegacyServiceUserModel {
 	l.TimeInputFormat = tfconfig.StringVariable(timeInputFormat)
 	return l
@@ -838,6 +844,16 @@ func (l *LegacyServiceUserModel) WithStrictJsonOutputValue(value tfconfig.Variab
 	return l
 }
 
+func (l *LegacyServiceUserModel) WithTagsValue(value tfconfig.Variable) *LegacyServiceUserModel {
+	l.Tags = value
+	return l
+}
+
+func (l *LegacyServiceUserModel) WithTagsAllValue(value tfconfig.Variable) *LegacyServiceUserModel {
+	l.TagsAll = value
+	return l
+}
+
 func (l *LegacyServiceUserModel) WithTimeInputFormatValue(value tfconfig.Variable) *LegacyServiceUserModel {
 	l.TimeInputFormat = value
 	return l
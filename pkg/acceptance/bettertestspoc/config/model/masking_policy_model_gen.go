@@ -21,6 +21,8 @@ type MaskingPolicyModel struct {
 	ExemptOtherPolicies tfconfig.Variable `json:"exempt_other_policies,omitempty"`
 	FullyQualifiedName  tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	ReturnDataType      tfconfig.Variable `json:"return_data_type,omitempty"`
+	Tags                tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll             tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -146,6 +148,10 @@ func (m *MaskingPolicyModel) WithReturnDataType(returnDataType string) *MaskingP
 	return m
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -194,3 +200,13 @@ func (m *MaskingPolicyModel) WithReturnDataTypeValue(value tfconfig.Variable) *M
 	m.ReturnDataType = value
 	return m
 }
+
+func (m *MaskingPolicyModel) WithTagsValue(value tfconfig.Variable) *MaskingPolicyModel {
+	m.Tags = value
+	return m
+}
+
+func (m *MaskingPolicyModel) WithTagsAllValue(value tfconfig.Variable) *MaskingPolicyModel {
+	m.TagsAll = value
+	return m
+}
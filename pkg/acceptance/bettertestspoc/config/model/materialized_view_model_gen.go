@@ -20,6 +20,8 @@ type MaterializedViewModel struct {
 	OrReplace          tfconfig.Variable `json:"or_replace,omitempty"`
 	Statement          tfconfig.Variable `json:"statement,omitempty"`
 	Tag                tfconfig.Variable `json:"tag,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll            tfconfig.Variable `json:"tags_all,omitempty"`
 	Warehouse          tfconfig.Variable `json:"warehouse,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -142,6 +144,10 @@ func (m *MaterializedViewModel) WithStatement(statement string) *MaterializedVie
 
 // tag attribute type is not yet supported, so WithTag can't be generated
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (m *MaterializedViewModel) WithWarehouse(warehouse string) *MaterializedViewModel {
 	m.Warehouse = tfconfig.StringVariable(warehouse)
 	return m
@@ -196,6 +202,16 @@ func (m *MaterializedViewModel) WithTagValue(value tfconfig.Variable) *Materiali
 	return m
 }
 
+func (m *MaterializedViewModel) WithTagsValue(value tfconfig.Variable) *MaterializedViewModel {
+	m.Tags = value
+	return m
+}
+
+func (m *MaterializedViewModel) WithTagsAllValue(value tfconfig.Variable) *MaterializedViewModel {
+	m.TagsAll = value
+	return m
+}
+
 func (m *MaterializedViewModel) WithWarehouseValue(value tfconfig.Variable) *MaterializedViewModel {
 	m.Warehouse = value
 	return m
@@ -29,6 +29,8 @@ type OauthIntegrationForCustomClientsModel struct {
 	OauthUseSecondaryRoles      tfconfig.Variable `json:"oauth_use_secondary_roles,omitempty"`
 	PreAuthorizedRolesList      tfconfig.Variable `json:"pre_authorized_roles_list,omitempty"`
 	RelatedParameters           tfconfig.Variable `json:"related_parameters,omitempty"`
+	Tags                        tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                     tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -178,6 +180,10 @@ func (o *OauthIntegrationForCustomClientsModel) WithOauthUseSecondaryRoles(oauth
 
 // related_parameters attribute type is not yet supported, so WithRelatedParameters can't be generated
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -271,3 +277,13 @@ func (o *OauthIntegrationForCustomClientsModel) WithRelatedParametersValue(value
 	o.RelatedParameters = value
 	return o
 }
+
+func (o *OauthIntegrationForCustomClientsModel) WithTagsValue(value tfconfig.Variable) *OauthIntegrationForCustomClientsModel {
+	o.Tags = value
+	return o
+}
+
+func (o *OauthIntegrationForCustomClientsModel) WithTagsAllValue(value tfconfig.Variable) *OauthIntegrationForCustomClientsModel {
+	o.TagsAll = value
+	return o
+}
@@ -23,6 +23,8 @@ type OauthIntegrationForPartnerApplicationsModel struct {
 	OauthRefreshTokenValidity tfconfig.Variable `json:"oauth_refresh_token_validity,omitempty"`
 	OauthUseSecondaryRoles    tfconfig.Variable `json:"oauth_use_secondary_roles,omitempty"`
 	RelatedParameters         tfconfig.Variable `json:"related_parameters,omitempty"`
+	Tags                      tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                   tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -141,6 +143,10 @@ func (o *OauthIntegrationForPartnerApplicationsModel) WithOauthUseSecondaryRoles
 
 // related_parameters attribute type is not yet supported, so WithRelatedParameters can't be generated
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -204,3 +210,13 @@ func (o *OauthIntegrationForPartnerApplicationsModel) WithRelatedParametersValue
 	o.RelatedParameters = value
 	return o
 }
+
+func (o *OauthIntegrationForPartnerApplicationsModel) WithTagsValue(value tfconfig.Variable) *OauthIntegrationForPartnerApplicationsModel {
+	o.Tags = value
+	return o
+}
+
+func (o *OauthIntegrationForPartnerApplicationsModel) WithTagsAllValue(value tfconfig.Variable) *OauthIntegrationForPartnerApplicationsModel {
+	o.TagsAll = value
+	return o
+}
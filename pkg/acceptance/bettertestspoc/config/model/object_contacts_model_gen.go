@@ -0,0 +1,138 @@
+// Code generated by resource model builder generator (v0.1.0); DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type ObjectContactsModel struct {
+	AccessApproval tfconfig.Variable `json:"access_approval,omitempty"`
+	ObjectName     tfconfig.Variable `json:"object_name,omitempty"`
+	ObjectType     tfconfig.Variable `json:"object_type,omitempty"`
+	Steward        tfconfig.Variable `json:"steward,omitempty"`
+	Support        tfconfig.Variable `json:"support,omitempty"`
+
+	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
+
+	*config.ResourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func ObjectContacts(
+	resourceName string,
+	objectName string,
+	objectType string,
+) *ObjectContactsModel {
+	o := &ObjectContactsModel{ResourceModelMeta: config.Meta(resourceName, resources.ObjectContacts)}
+	o.WithObjectName(objectName)
+	o.WithObjectType(objectType)
+	return o
+}
+
+func ObjectContactsWithDefaultMeta(
+	objectName string,
+	objectType string,
+) *ObjectContactsModel {
+	o := &ObjectContactsModel{ResourceModelMeta: config.DefaultMeta(resources.ObjectContacts)}
+	o.WithObjectName(objectName)
+	o.WithObjectType(objectType)
+	return o
+}
+
+///////////////////////////////////////////////////////////////////////
+// set proper json marshalling, handle depends on and dynamic blocks //
+///////////////////////////////////////////////////////////////////////
+
+func (o *ObjectContactsModel) MarshalJSON() ([]byte, error) {
+	type Alias ObjectContactsModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn []string         `json:"depends_on,omitempty"`
+		Timeouts  *config.Timeouts `json:"timeouts,omitempty"`
+	}{
+		Alias:     (*Alias)(o),
+		DependsOn: o.DependsOn(),
+		Timeouts:  o.Timeouts(),
+	})
+}
+
+func (o *ObjectContactsModel) WithDependsOn(values ...string) *ObjectContactsModel {
+	o.SetDependsOn(values...)
+	return o
+}
+
+func (o *ObjectContactsModel) WithDynamicBlock(dynamicBlock *config.DynamicBlock) *ObjectContactsModel {
+	o.DynamicBlock = dynamicBlock
+	return o
+}
+
+func (o *ObjectContactsModel) WithTimeout(timeout config.Timeouts) *ObjectContactsModel {
+	o.SetTimeout(timeout)
+	return o
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+func (o *ObjectContactsModel) WithAccessApproval(accessApproval string) *ObjectContactsModel {
+	o.AccessApproval = tfconfig.StringVariable(accessApproval)
+	return o
+}
+
+func (o *ObjectContactsModel) WithObjectName(objectName string) *ObjectContactsModel {
+	o.ObjectName = tfconfig.StringVariable(objectName)
+	return o
+}
+
+func (o *ObjectContactsModel) WithObjectType(objectType string) *ObjectContactsModel {
+	o.ObjectType = tfconfig.StringVariable(objectType)
+	return o
+}
+
+func (o *ObjectContactsModel) WithSteward(steward string) *ObjectContactsModel {
+	o.Steward = tfconfig.StringVariable(steward)
+	return o
+}
+
+func (o *ObjectContactsModel) WithSupport(support string) *ObjectContactsModel {
+	o.Support = tfconfig.StringVariable(support)
+	return o
+}
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (o *ObjectContactsModel) WithAccessApprovalValue(value tfconfig.Variable) *ObjectContactsModel {
+	o.AccessApproval = value
+	return o
+}
+
+func (o *ObjectContactsModel) WithObjectNameValue(value tfconfig.Variable) *ObjectContactsModel {
+	o.ObjectName = value
+	return o
+}
+
+func (o *ObjectContactsModel) WithObjectTypeValue(value tfconfig.Variable) *ObjectContactsModel {
+	o.ObjectType = value
+	return o
+}
+
+func (o *ObjectContactsModel) WithStewardValue(value tfconfig.Variable) *ObjectContactsModel {
+	o.Steward = value
+	return o
+}
+
+func (o *ObjectContactsModel) WithSupportValue(value tfconfig.Variable) *ObjectContactsModel {
+	o.Support = value
+	return o
+}
@@ -0,0 +1,187 @@
+// Code generated by resource model builder generator (v0.1.0); DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type OpenflowConnectorModel struct {
+	Database           tfconfig.Variable `json:"database,omitempty"`
+	Schema             tfconfig.Variable `json:"schema,omitempty"`
+	Name               tfconfig.Variable `json:"name,omitempty"`
+	Comment            tfconfig.Variable `json:"comment,omitempty"`
+	Definition         tfconfig.Variable `json:"definition,omitempty"`
+	DisplayName        tfconfig.Variable `json:"display_name,omitempty"`
+	From               tfconfig.Variable `json:"from,omitempty"`
+	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	Runtime            tfconfig.Variable `json:"runtime,omitempty"`
+
+	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
+
+	*config.ResourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func OpenflowConnector(
+	resourceName string,
+	database string,
+	schema string,
+	name string,
+	runtime string,
+) *OpenflowConnectorModel {
+	o := &OpenflowConnectorModel{ResourceModelMeta: config.Meta(resourceName, resources.OpenflowConnector)}
+	o.WithDatabase(database)
+	o.WithSchema(schema)
+	o.WithName(name)
+	o.WithRuntime(runtime)
+	return o
+}
+
+func OpenflowConnectorWithDefaultMeta(
+	database string,
+	schema string,
+	name string,
+	runtime string,
+) *OpenflowConnectorModel {
+	o := &OpenflowConnectorModel{ResourceModelMeta: config.DefaultMeta(resources.OpenflowConnector)}
+	o.WithDatabase(database)
+	o.WithSchema(schema)
+	o.WithName(name)
+	o.WithRuntime(runtime)
+	return o
+}
+
+///////////////////////////////////////////////////////////////////////
+// set proper json marshalling, handle depends on and dynamic blocks //
+///////////////////////////////////////////////////////////////////////
+
+func (o *OpenflowConnectorModel) MarshalJSON() ([]byte, error) {
+	type Alias OpenflowConnectorModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn []string         `json:"depends_on,omitempty"`
+		Timeouts  *config.Timeouts `json:"timeouts,omitempty"`
+	}{
+		Alias:     (*Alias)(o),
+		DependsOn: o.DependsOn(),
+		Timeouts:  o.Timeouts(),
+	})
+}
+
+func (o *OpenflowConnectorModel) WithDependsOn(values ...string) *OpenflowConnectorModel {
+	o.SetDependsOn(values...)
+	return o
+}
+
+func (o *OpenflowConnectorModel) WithDynamicBlock(dynamicBlock *config.DynamicBlock) *OpenflowConnectorModel {
+	o.DynamicBlock = dynamicBlock
+	return o
+}
+
+func (o *OpenflowConnectorModel) WithTimeout(timeout config.Timeouts) *OpenflowConnectorModel {
+	o.SetTimeout(timeout)
+	return o
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+func (o *OpenflowConnectorModel) WithDatabase(database string) *OpenflowConnectorModel {
+	o.Database = tfconfig.StringVariable(database)
+	return o
+}
+
+func (o *OpenflowConnectorModel) WithSchema(schema string) *OpenflowConnectorModel {
+	o.Schema = tfconfig.StringVariable(schema)
+	return o
+}
+
+func (o *OpenflowConnectorModel) WithName(name string) *OpenflowConnectorModel {
+	o.Name = tfconfig.StringVariable(name)
+	return o
+}
+
+func (o *OpenflowConnectorModel) WithComment(comment string) *OpenflowConnectorModel {
+	o.Comment = tfconfig.StringVariable(comment)
+	return o
+}
+
+func (o *OpenflowConnectorModel) WithDefinition(definition string) *OpenflowConnectorModel {
+	o.Definition = tfconfig.StringVariable(definition)
+	return o
+}
+
+func (o *OpenflowConnectorModel) WithDisplayName(displayName string) *OpenflowConnectorModel {
+	o.DisplayName = tfconfig.StringVariable(displayName)
+	return o
+}
+
+// from attribute type is not yet supported, so WithFrom can't be generated
+
+func (o *OpenflowConnectorModel) WithFullyQualifiedName(fullyQualifiedName string) *OpenflowConnectorModel {
+	o.FullyQualifiedName = tfconfig.StringVariable(fullyQualifiedName)
+	return o
+}
+
+func (o *OpenflowConnectorModel) WithRuntime(runtime string) *OpenflowConnectorModel {
+	o.Runtime = tfconfig.StringVariable(runtime)
+	return o
+}
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (o *OpenflowConnectorModel) WithDatabaseValue(value tfconfig.Variable) *OpenflowConnectorModel {
+	o.Database = value
+	return o
+}
+
+func (o *OpenflowConnectorModel) WithSchemaValue(value tfconfig.Variable) *OpenflowConnectorModel {
+	o.Schema = value
+	return o
+}
+
+func (o *OpenflowConnectorModel) WithNameValue(value tfconfig.Variable) *OpenflowConnectorModel {
+	o.Name = value
+	return o
+}
+
+func (o *OpenflowConnectorModel) WithCommentValue(value tfconfig.Variable) *OpenflowConnectorModel {
+	o.Comment = value
+	return o
+}
+
+func (o *OpenflowConnectorModel) WithDefinitionValue(value tfconfig.Variable) *OpenflowConnectorModel {
+	o.Definition = value
+	return o
+}
+
+func (o *OpenflowConnectorModel) WithDisplayNameValue(value tfconfig.Variable) *OpenflowConnectorModel {
+	o.DisplayName = value
+	return o
+}
+
+func (o *OpenflowConnectorModel) WithFromValue(value tfconfig.Variable) *OpenflowConnectorModel {
+	o.From = value
+	return o
+}
+
+func (o *OpenflowConnectorModel) WithFullyQualifiedNameValue(value tfconfig.Variable) *OpenflowConnectorModel {
+	o.FullyQualifiedName = value
+	return o
+}
+
+func (o *OpenflowConnectorModel) WithRuntimeValue(value tfconfig.Variable) *OpenflowConnectorModel {
+	o.Runtime = value
+	return o
+}
@@ -0,0 +1,193 @@
+// Code generated by resource model builder generator (v0.1.0); DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type OpenflowDeploymentModel struct {
+	Name                       tfconfig.Variable `json:"name,omitempty"`
+	Comment                    tfconfig.Variable `json:"comment,omitempty"`
+	CustomIngressHostname      tfconfig.Variable `json:"custom_ingress_hostname,omitempty"`
+	DeploymentType             tfconfig.Variable `json:"deployment_type,omitempty"`
+	DisplayName                tfconfig.Variable `json:"display_name,omitempty"`
+	EventTable                 tfconfig.Variable `json:"event_table,omitempty"`
+	FullyQualifiedName         tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	UsePrivateLink             tfconfig.Variable `json:"use_private_link,omitempty"`
+	UseUserAuthOverPrivatelink tfconfig.Variable `json:"use_user_auth_over_privatelink,omitempty"`
+	VpcType                    tfconfig.Variable `json:"vpc_type,omitempty"`
+
+	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
+
+	*config.ResourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func OpenflowDeployment(
+	resourceName string,
+	name string,
+	deploymentType string,
+) *OpenflowDeploymentModel {
+	o := &OpenflowDeploymentModel{ResourceModelMeta: config.Meta(resourceName, resources.OpenflowDeployment)}
+	o.WithName(name)
+	o.WithDeploymentType(deploymentType)
+	return o
+}
+
+func OpenflowDeploymentWithDefaultMeta(
+	name string,
+	deploymentType string,
+) *OpenflowDeploymentModel {
+	o := &OpenflowDeploymentModel{ResourceModelMeta: config.DefaultMeta(resources.OpenflowDeployment)}
+	o.WithName(name)
+	o.WithDeploymentType(deploymentType)
+	return o
+}
+
+///////////////////////////////////////////////////////////////////////
+// set proper json marshalling, handle depends on and dynamic blocks //
+///////////////////////////////////////////////////////////////////////
+
+func (o *OpenflowDeploymentModel) MarshalJSON() ([]byte, error) {
+	type Alias OpenflowDeploymentModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn []string         `json:"depends_on,omitempty"`
+		Timeouts  *config.Timeouts `json:"timeouts,omitempty"`
+	}{
+		Alias:     (*Alias)(o),
+		DependsOn: o.DependsOn(),
+		Timeouts:  o.Timeouts(),
+	})
+}
+
+func (o *OpenflowDeploymentModel) WithDependsOn(values ...string) *OpenflowDeploymentModel {
+	o.SetDependsOn(values...)
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithDynamicBlock(dynamicBlock *config.DynamicBlock) *OpenflowDeploymentModel {
+	o.DynamicBlock = dynamicBlock
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithTimeout(timeout config.Timeouts) *OpenflowDeploymentModel {
+	o.SetTimeout(timeout)
+	return o
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+func (o *OpenflowDeploymentModel) WithName(name string) *OpenflowDeploymentModel {
+	o.Name = tfconfig.StringVariable(name)
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithComment(comment string) *OpenflowDeploymentModel {
+	o.Comment = tfconfig.StringVariable(comment)
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithCustomIngressHostname(customIngressHostname string) *OpenflowDeploymentModel {
+	o.CustomIngressHostname = tfconfig.StringVariable(customIngressHostname)
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithDeploymentType(deploymentType string) *OpenflowDeploymentModel {
+	o.DeploymentType = tfconfig.StringVariable(deploymentType)
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithDisplayName(displayName string) *OpenflowDeploymentModel {
+	o.DisplayName = tfconfig.StringVariable(displayName)
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithEventTable(eventTable string) *OpenflowDeploymentModel {
+	o.EventTable = tfconfig.StringVariable(eventTable)
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithFullyQualifiedName(fullyQualifiedName string) *OpenflowDeploymentModel {
+	o.FullyQualifiedName = tfconfig.StringVariable(fullyQualifiedName)
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithUsePrivateLink(usePrivateLink string) *OpenflowDeploymentModel {
+	o.UsePrivateLink = tfconfig.StringVariable(usePrivateLink)
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithUseUserAuthOverPrivatelink(useUserAuthOverPrivatelink string) *OpenflowDeploymentModel {
+	o.UseUserAuthOverPrivatelink = tfconfig.StringVariable(useUserAuthOverPrivatelink)
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithVpcType(vpcType string) *OpenflowDeploymentModel {
+	o.VpcType = tfconfig.StringVariable(vpcType)
+	return o
+}
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (o *OpenflowDeploymentModel) WithNameValue(value tfconfig.Variable) *OpenflowDeploymentModel {
+	o.Name = value
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithCommentValue(value tfconfig.Variable) *OpenflowDeploymentModel {
+	o.Comment = value
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithCustomIngressHostnameValue(value tfconfig.Variable) *OpenflowDeploymentModel {
+	o.CustomIngressHostname = value
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithDeploymentTypeValue(value tfconfig.Variable) *OpenflowDeploymentModel {
+	o.DeploymentType = value
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithDisplayNameValue(value tfconfig.Variable) *OpenflowDeploymentModel {
+	o.DisplayName = value
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithEventTableValue(value tfconfig.Variable) *OpenflowDeploymentModel {
+	o.EventTable = value
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithFullyQualifiedNameValue(value tfconfig.Variable) *OpenflowDeploymentModel {
+	o.FullyQualifiedName = value
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithUsePrivateLinkValue(value tfconfig.Variable) *OpenflowDeploymentModel {
+	o.UsePrivateLink = value
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithUseUserAuthOverPrivatelinkValue(value tfconfig.Variable) *OpenflowDeploymentModel {
+	o.UseUserAuthOverPrivatelink = value
+	return o
+}
+
+func (o *OpenflowDeploymentModel) WithVpcTypeValue(value tfconfig.Variable) *OpenflowDeploymentModel {
+	o.VpcType = value
+	return o
+}
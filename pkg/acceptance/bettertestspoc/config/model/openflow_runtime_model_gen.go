@@ -0,0 +1,236 @@
+// Code generated by resource model builder generator (v0.1.0); DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type OpenflowRuntimeModel struct {
+	Database                   tfconfig.Variable `json:"database,omitempty"`
+	Schema                     tfconfig.Variable `json:"schema,omitempty"`
+	Name                       tfconfig.Variable `json:"name,omitempty"`
+	Comment                    tfconfig.Variable `json:"comment,omitempty"`
+	Deployment                 tfconfig.Variable `json:"deployment,omitempty"`
+	DisplayName                tfconfig.Variable `json:"display_name,omitempty"`
+	ExecuteAsRole              tfconfig.Variable `json:"execute_as_role,omitempty"`
+	ExternalAccessIntegrations tfconfig.Variable `json:"external_access_integrations,omitempty"`
+	FullyQualifiedName         tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	MaxNodes                   tfconfig.Variable `json:"max_nodes,omitempty"`
+	MinNodes                   tfconfig.Variable `json:"min_nodes,omitempty"`
+	NodeType                   tfconfig.Variable `json:"node_type,omitempty"`
+
+	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
+
+	*config.ResourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func OpenflowRuntime(
+	resourceName string,
+	database string,
+	schema string,
+	name string,
+	deployment string,
+	executeAsRole string,
+	maxNodes int,
+	minNodes int,
+	nodeType string,
+) *OpenflowRuntimeModel {
+	o := &OpenflowRuntimeModel{ResourceModelMeta: config.Meta(resourceName, resources.OpenflowRuntime)}
+	o.WithDatabase(database)
+	o.WithSchema(schema)
+	o.WithName(name)
+	o.WithDeployment(deployment)
+	o.WithExecuteAsRole(executeAsRole)
+	o.WithMaxNodes(maxNodes)
+	o.WithMinNodes(minNodes)
+	o.WithNodeType(nodeType)
+	return o
+}
+
+func OpenflowRuntimeWithDefaultMeta(
+	database string,
+	schema string,
+	name string,
+	deployment string,
+	executeAsRole string,
+	maxNodes int,
+	minNodes int,
+	nodeType string,
+) *OpenflowRuntimeModel {
+	o := &OpenflowRuntimeModel{ResourceModelMeta: config.DefaultMeta(resources.OpenflowRuntime)}
+	o.WithDatabase(database)
+	o.WithSchema(schema)
+	o.WithName(name)
+	o.WithDeployment(deployment)
+	o.WithExecuteAsRole(executeAsRole)
+	o.WithMaxNodes(maxNodes)
+	o.WithMinNodes(minNodes)
+	o.WithNodeType(nodeType)
+	return o
+}
+
+///////////////////////////////////////////////////////////////////////
+// set proper json marshalling, handle depends on and dynamic blocks //
+///////////////////////////////////////////////////////////////////////
+
+func (o *OpenflowRuntimeModel) MarshalJSON() ([]byte, error) {
+	type Alias OpenflowRuntimeModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn []string         `json:"depends_on,omitempty"`
+		Timeouts  *config.Timeouts `json:"timeouts,omitempty"`
+	}{
+		Alias:     (*Alias)(o),
+		DependsOn: o.DependsOn(),
+		Timeouts:  o.Timeouts(),
+	})
+}
+
+func (o *OpenflowRuntimeModel) WithDependsOn(values ...string) *OpenflowRuntimeModel {
+	o.SetDependsOn(values...)
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithDynamicBlock(dynamicBlock *config.DynamicBlock) *OpenflowRuntimeModel {
+	o.DynamicBlock = dynamicBlock
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithTimeout(timeout config.Timeouts) *OpenflowRuntimeModel {
+	o.SetTimeout(timeout)
+	return o
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+func (o *OpenflowRuntimeModel) WithDatabase(database string) *OpenflowRuntimeModel {
+	o.Database = tfconfig.StringVariable(database)
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithSchema(schema string) *OpenflowRuntimeModel {
+	o.Schema = tfconfig.StringVariable(schema)
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithName(name string) *OpenflowRuntimeModel {
+	o.Name = tfconfig.StringVariable(name)
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithComment(comment string) *OpenflowRuntimeModel {
+	o.Comment = tfconfig.StringVariable(comment)
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithDeployment(deployment string) *OpenflowRuntimeModel {
+	o.Deployment = tfconfig.StringVariable(deployment)
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithDisplayName(displayName string) *OpenflowRuntimeModel {
+	o.DisplayName = tfconfig.StringVariable(displayName)
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithExecuteAsRole(executeAsRole string) *OpenflowRuntimeModel {
+	o.ExecuteAsRole = tfconfig.StringVariable(executeAsRole)
+	return o
+}
+
+// external_access_integrations attribute type is not yet supported, so WithExternalAccessIntegrations can't be generated
+
+func (o *OpenflowRuntimeModel) WithFullyQualifiedName(fullyQualifiedName string) *OpenflowRuntimeModel {
+	o.FullyQualifiedName = tfconfig.StringVariable(fullyQualifiedName)
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithMaxNodes(maxNodes int) *OpenflowRuntimeModel {
+	o.MaxNodes = tfconfig.IntegerVariable(maxNodes)
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithMinNodes(minNodes int) *OpenflowRuntimeModel {
+	o.MinNodes = tfconfig.IntegerVariable(minNodes)
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithNodeType(nodeType string) *OpenflowRuntimeModel {
+	o.NodeType = tfconfig.StringVariable(nodeType)
+	return o
+}
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (o *OpenflowRuntimeModel) WithDatabaseValue(value tfconfig.Variable) *OpenflowRuntimeModel {
+	o.Database = value
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithSchemaValue(value tfconfig.Variable) *OpenflowRuntimeModel {
+	o.Schema = value
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithNameValue(value tfconfig.Variable) *OpenflowRuntimeModel {
+	o.Name = value
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithCommentValue(value tfconfig.Variable) *OpenflowRuntimeModel {
+	o.Comment = value
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithDeploymentValue(value tfconfig.Variable) *OpenflowRuntimeModel {
+	o.Deployment = value
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithDisplayNameValue(value tfconfig.Variable) *OpenflowRuntimeModel {
+	o.DisplayName = value
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithExecuteAsRoleValue(value tfconfig.Variable) *OpenflowRuntimeModel {
+	o.ExecuteAsRole = value
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithExternalAccessIntegrationsValue(value tfconfig.Variable) *OpenflowRuntimeModel {
+	o.ExternalAccessIntegrations = value
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithFullyQualifiedNameValue(value tfconfig.Variable) *OpenflowRuntimeModel {
+	o.FullyQualifiedName = value
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithMaxNodesValue(value tfconfig.Variable) *OpenflowRuntimeModel {
+	o.MaxNodes = value
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithMinNodesValue(value tfconfig.Variable) *OpenflowRuntimeModel {
+	o.MinNodes = value
+	return o
+}
+
+func (o *OpenflowRuntimeModel) WithNodeTypeValue(value tfconfig.Variable) *OpenflowRuntimeModel {
+	o.NodeType = value
+	return o
+}
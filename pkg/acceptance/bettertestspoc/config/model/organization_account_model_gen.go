@@ -0,0 +1,1609 @@
+// Code generated by resource model builder generator (v0.1.0); DO NOT EDIT.
+
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	tfconfig "github.com/hashicorp/terraform-plugin-testing/config"
+)
+
+type OrganizationAccountModel struct {
+	Name                                             tfconfig.Variable `json:"name,omitempty"`
+	AbortDetachedQuery                               tfconfig.Variable `json:"abort_detached_query,omitempty"`
+	ActivePythonProfiler                             tfconfig.Variable `json:"active_python_profiler,omitempty"`
+	AdminName                                        tfconfig.Variable `json:"admin_name,omitempty"`
+	AdminPassword                                    tfconfig.Variable `json:"admin_password,omitempty"`
+	AdminRsaPublicKey                                tfconfig.Variable `json:"admin_rsa_public_key,omitempty"`
+	AllowClientMfaCaching                            tfconfig.Variable `json:"allow_client_mfa_caching,omitempty"`
+	AllowIdToken                                     tfconfig.Variable `json:"allow_id_token,omitempty"`
+	Autocommit                                       tfconfig.Variable `json:"autocommit,omitempty"`
+	BaseLocationPrefix                               tfconfig.Variable `json:"base_location_prefix,omitempty"`
+	BinaryInputFormat                                tfconfig.Variable `json:"binary_input_format,omitempty"`
+	BinaryOutputFormat                               tfconfig.Variable `json:"binary_output_format,omitempty"`
+	Catalog                                          tfconfig.Variable `json:"catalog,omitempty"`
+	CatalogSync                                      tfconfig.Variable `json:"catalog_sync,omitempty"`
+	ClientEnableLogInfoStatementParameters           tfconfig.Variable `json:"client_enable_log_info_statement_parameters,omitempty"`
+	ClientEncryptionKeySize                          tfconfig.Variable `json:"client_encryption_key_size,omitempty"`
+	ClientMemoryLimit                                tfconfig.Variable `json:"client_memory_limit,omitempty"`
+	ClientMetadataRequestUseConnectionCtx            tfconfig.Variable `json:"client_metadata_request_use_connection_ctx,omitempty"`
+	ClientMetadataUseSessionDatabase                 tfconfig.Variable `json:"client_metadata_use_session_database,omitempty"`
+	ClientPrefetchThreads                            tfconfig.Variable `json:"client_prefetch_threads,omitempty"`
+	ClientResultChunkSize                            tfconfig.Variable `json:"client_result_chunk_size,omitempty"`
+	ClientResultColumnCaseInsensitive                tfconfig.Variable `json:"client_result_column_case_insensitive,omitempty"`
+	ClientSessionKeepAlive                           tfconfig.Variable `json:"client_session_keep_alive,omitempty"`
+	ClientSessionKeepAliveHeartbeatFrequency         tfconfig.Variable `json:"client_session_keep_alive_heartbeat_frequency,omitempty"`
+	ClientTimestampTypeMapping                       tfconfig.Variable `json:"client_timestamp_type_mapping,omitempty"`
+	Comment                                          tfconfig.Variable `json:"comment,omitempty"`
+	CortexCodeCliDailyEstCreditLimitPerUser          tfconfig.Variable `json:"cortex_code_cli_daily_est_credit_limit_per_user,omitempty"`
+	CortexCodeDesktopDailyEstCreditLimitPerUser      tfconfig.Variable `json:"cortex_code_desktop_daily_est_credit_limit_per_user,omitempty"`
+	CortexCodeSnowsightDailyEstCreditLimitPerUser    tfconfig.Variable `json:"cortex_code_snowsight_daily_est_credit_limit_per_user,omitempty"`
+	CortexEnabledCrossRegion                         tfconfig.Variable `json:"cortex_enabled_cross_region,omitempty"`
+	CortexModelsAllowlist                            tfconfig.Variable `json:"cortex_models_allowlist,omitempty"`
+	CsvTimestampFormat                               tfconfig.Variable `json:"csv_timestamp_format,omitempty"`
+	DataRetentionTimeInDays                          tfconfig.Variable `json:"data_retention_time_in_days,omitempty"`
+	DateInputFormat                                  tfconfig.Variable `json:"date_input_format,omitempty"`
+	DateOutputFormat                                 tfconfig.Variable `json:"date_output_format,omitempty"`
+	DefaultDdlCollation                              tfconfig.Variable `json:"default_ddl_collation,omitempty"`
+	DefaultNotebookComputePoolCpu                    tfconfig.Variable `json:"default_notebook_compute_pool_cpu,omitempty"`
+	DefaultNotebookComputePoolGpu                    tfconfig.Variable `json:"default_notebook_compute_pool_gpu,omitempty"`
+	DefaultNullOrdering                              tfconfig.Variable `json:"default_null_ordering,omitempty"`
+	DefaultStreamlitComputePool                      tfconfig.Variable `json:"default_streamlit_compute_pool,omitempty"`
+	DefaultStreamlitNotebookWarehouse                tfconfig.Variable `json:"default_streamlit_notebook_warehouse,omitempty"`
+	DisableUiDownloadButton                          tfconfig.Variable `json:"disable_ui_download_button,omitempty"`
+	DisableUserPrivilegeGrants                       tfconfig.Variable `json:"disable_user_privilege_grants,omitempty"`
+	Edition                                          tfconfig.Variable `json:"edition,omitempty"`
+	Email                                            tfconfig.Variable `json:"email,omitempty"`
+	EnableAutomaticSensitiveDataClassificationLog    tfconfig.Variable `json:"enable_automatic_sensitive_data_classification_log,omitempty"`
+	EnableEgressCostOptimizer                        tfconfig.Variable `json:"enable_egress_cost_optimizer,omitempty"`
+	EnableIdentifierFirstLogin                       tfconfig.Variable `json:"enable_identifier_first_login,omitempty"`
+	EnableInternalStagesPrivatelink                  tfconfig.Variable `json:"enable_internal_stages_privatelink,omitempty"`
+	EnablePerAccountAppServicePrivatelinkUrl         tfconfig.Variable `json:"enable_per_account_app_service_privatelink_url,omitempty"`
+	EnableTriSecretAndRekeyOptOutForImageRepository  tfconfig.Variable `json:"enable_tri_secret_and_rekey_opt_out_for_image_repository,omitempty"`
+	EnableTriSecretAndRekeyOptOutForSpcsBlockStorage tfconfig.Variable `json:"enable_tri_secret_and_rekey_opt_out_for_spcs_block_storage,omitempty"`
+	EnableUnhandledExceptionsReporting               tfconfig.Variable `json:"enable_unhandled_exceptions_reporting,omitempty"`
+	EnableUnloadPhysicalTypeOptimization             tfconfig.Variable `json:"enable_unload_physical_type_optimization,omitempty"`
+	EnableUnredactedQuerySyntaxError                 tfconfig.Variable `json:"enable_unredacted_query_syntax_error,omitempty"`
+	EnableUnredactedSecureObjectError                tfconfig.Variable `json:"enable_unredacted_secure_object_error,omitempty"`
+	EnforceNetworkRulesForInternalStages             tfconfig.Variable `json:"enforce_network_rules_for_internal_stages,omitempty"`
+	ErrorOnNondeterministicMerge                     tfconfig.Variable `json:"error_on_nondeterministic_merge,omitempty"`
+	ErrorOnNondeterministicUpdate                    tfconfig.Variable `json:"error_on_nondeterministic_update,omitempty"`
+	EventTable                                       tfconfig.Variable `json:"event_table,omitempty"`
+	ExternalOauthAddPrivilegedRolesToBlockedList     tfconfig.Variable `json:"external_oauth_add_privileged_roles_to_blocked_list,omitempty"`
+	ExternalVolume                                   tfconfig.Variable `json:"external_volume,omitempty"`
+	FirstName                                        tfconfig.Variable `json:"first_name,omitempty"`
+	FullyQualifiedName                               tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	GeographyOutputFormat                            tfconfig.Variable `json:"geography_output_format,omitempty"`
+	GeometryOutputFormat                             tfconfig.Variable `json:"geometry_output_format,omitempty"`
+	HybridTableLockTimeout                           tfconfig.Variable `json:"hybrid_table_lock_timeout,omitempty"`
+	InitialReplicationSizeLimitInTb                  tfconfig.Variable `json:"initial_replication_size_limit_in_tb,omitempty"`
+	JdbcTreatDecimalAsInt                            tfconfig.Variable `json:"jdbc_treat_decimal_as_int,omitempty"`
+	JdbcTreatTimestampNtzAsUtc                       tfconfig.Variable `json:"jdbc_treat_timestamp_ntz_as_utc,omitempty"`
+	JdbcUseSessionTimezone                           tfconfig.Variable `json:"jdbc_use_session_timezone,omitempty"`
+	JsTreatIntegerAsBigint                           tfconfig.Variable `json:"js_treat_integer_as_bigint,omitempty"`
+	JsonIndent                                       tfconfig.Variable `json:"json_indent,omitempty"`
+	LastName                                         tfconfig.Variable `json:"last_name,omitempty"`
+	ListingAutoFulfillmentReplicationRefreshSchedule tfconfig.Variable `json:"listing_auto_fulfillment_replication_refresh_schedule,omitempty"`
+	LockTimeout                                      tfconfig.Variable `json:"lock_timeout,omitempty"`
+	LogEventLevel                                    tfconfig.Variable `json:"log_event_level,omitempty"`
+	LogLevel                                         tfconfig.Variable `json:"log_level,omitempty"`
+	MaxConcurrencyLevel                              tfconfig.Variable `json:"max_concurrency_level,omitempty"`
+	MaxDataExtensionTimeInDays                       tfconfig.Variable `json:"max_data_extension_time_in_days,omitempty"`
+	MetricLevel                                      tfconfig.Variable `json:"metric_level,omitempty"`
+	MinDataRetentionTimeInDays                       tfconfig.Variable `json:"min_data_retention_time_in_days,omitempty"`
+	MultiStatementCount                              tfconfig.Variable `json:"multi_statement_count,omitempty"`
+	MustChangePassword                               tfconfig.Variable `json:"must_change_password,omitempty"`
+	NetworkPolicy                                    tfconfig.Variable `json:"network_policy,omitempty"`
+	NoorderSequenceAsDefault                         tfconfig.Variable `json:"noorder_sequence_as_default,omitempty"`
+	OauthAddPrivilegedRolesToBlockedList             tfconfig.Variable `json:"oauth_add_privileged_roles_to_blocked_list,omitempty"`
+	OdbcTreatDecimalAsInt                            tfconfig.Variable `json:"odbc_treat_decimal_as_int,omitempty"`
+	PasswordPolicy                                   tfconfig.Variable `json:"password_policy,omitempty"`
+	PeriodicDataRekeying                             tfconfig.Variable `json:"periodic_data_rekeying,omitempty"`
+	PipeExecutionPaused                              tfconfig.Variable `json:"pipe_execution_paused,omitempty"`
+	PreventUnloadToInlineUrl                         tfconfig.Variable `json:"prevent_unload_to_inline_url,omitempty"`
+	PreventUnloadToInternalStages                    tfconfig.Variable `json:"prevent_unload_to_internal_stages,omitempty"`
+	PythonProfilerModules                            tfconfig.Variable `json:"python_profiler_modules,omitempty"`
+	PythonProfilerTargetStage                        tfconfig.Variable `json:"python_profiler_target_stage,omitempty"`
+	QueryTag                                         tfconfig.Variable `json:"query_tag,omitempty"`
+	QuotedIdentifiersIgnoreCase                      tfconfig.Variable `json:"quoted_identifiers_ignore_case,omitempty"`
+	Region                                           tfconfig.Variable `json:"region,omitempty"`
+	RegionGroup                                      tfconfig.Variable `json:"region_group,omitempty"`
+	ReplaceInvalidCharacters                         tfconfig.Variable `json:"replace_invalid_characters,omitempty"`
+	RequireStorageIntegrationForStageCreation        tfconfig.Variable `json:"require_storage_integration_for_stage_creation,omitempty"`
+	RequireStorageIntegrationForStageOperation       tfconfig.Variable `json:"require_storage_integration_for_stage_operation,omitempty"`
+	ResourceMonitor                                  tfconfig.Variable `json:"resource_monitor,omitempty"`
+	RowsPerResultset                                 tfconfig.Variable `json:"rows_per_resultset,omitempty"`
+	S3StageVpceDnsName                               tfconfig.Variable `json:"s3_stage_vpce_dns_name,omitempty"`
+	SearchPath                                       tfconfig.Variable `json:"search_path,omitempty"`
+	ServerlessTaskMaxStatementSize                   tfconfig.Variable `json:"serverless_task_max_statement_size,omitempty"`
+	ServerlessTaskMinStatementSize                   tfconfig.Variable `json:"serverless_task_min_statement_size,omitempty"`
+	SessionPolicy                                    tfconfig.Variable `json:"session_policy,omitempty"`
+	SimulatedDataSharingConsumer                     tfconfig.Variable `json:"simulated_data_sharing_consumer,omitempty"`
+	SsoLoginPage                                     tfconfig.Variable `json:"sso_login_page,omitempty"`
+	StatementQueuedTimeoutInSeconds                  tfconfig.Variable `json:"statement_queued_timeout_in_seconds,omitempty"`
+	StatementTimeoutInSeconds                        tfconfig.Variable `json:"statement_timeout_in_seconds,omitempty"`
+	StorageSerializationPolicy                       tfconfig.Variable `json:"storage_serialization_policy,omitempty"`
+	StrictJsonOutput                                 tfconfig.Variable `json:"strict_json_output,omitempty"`
+	SuspendTaskAfterNumFailures                      tfconfig.Variable `json:"suspend_task_after_num_failures,omitempty"`
+	TaskAutoRetryAttempts                            tfconfig.Variable `json:"task_auto_retry_attempts,omitempty"`
+	TimeInputFormat                                  tfconfig.Variable `json:"time_input_format,omitempty"`
+	TimeOutputFormat                                 tfconfig.Variable `json:"time_output_format,omitempty"`
+	TimestampDayIsAlways24h                          tfconfig.Variable `json:"timestamp_day_is_always_24h,omitempty"`
+	TimestampInputFormat                             tfconfig.Variable `json:"timestamp_input_format,omitempty"`
+	TimestampLtzOutputFormat                         tfconfig.Variable `json:"timestamp_ltz_output_format,omitempty"`
+	TimestampNtzOutputFormat                         tfconfig.Variable `json:"timestamp_ntz_output_format,omitempty"`
+	TimestampOutputFormat                            tfconfig.Variable `json:"timestamp_output_format,omitempty"`
+	TimestampTypeMapping                             tfconfig.Variable `json:"timestamp_type_mapping,omitempty"`
+	TimestampTzOutputFormat                          tfconfig.Variable `json:"timestamp_tz_output_format,omitempty"`
+	Timezone                                         tfconfig.Variable `json:"timezone,omitempty"`
+	TraceLevel                                       tfconfig.Variable `json:"trace_level,omitempty"`
+	TransactionAbortOnError                          tfconfig.Variable `json:"transaction_abort_on_error,omitempty"`
+	TransactionDefaultIsolationLevel                 tfconfig.Variable `json:"transaction_default_isolation_level,omitempty"`
+	TwoDigitCenturyStart                             tfconfig.Variable `json:"two_digit_century_start,omitempty"`
+	UnsupportedDdlAction                             tfconfig.Variable `json:"unsupported_ddl_action,omitempty"`
+	UseCachedResult                                  tfconfig.Variable `json:"use_cached_result,omitempty"`
+	UserTaskManagedInitialWarehouseSize              tfconfig.Variable `json:"user_task_managed_initial_warehouse_size,omitempty"`
+	UserTaskMinimumTriggerIntervalInSeconds          tfconfig.Variable `json:"user_task_minimum_trigger_interval_in_seconds,omitempty"`
+	UserTaskTimeoutMs                                tfconfig.Variable `json:"user_task_timeout_ms,omitempty"`
+	WeekOfYearPolicy                                 tfconfig.Variable `json:"week_of_year_policy,omitempty"`
+	WeekStart                                        tfconfig.Variable `json:"week_start,omitempty"`
+
+	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
+
+	*config.ResourceModelMeta
+}
+
+/////////////////////////////////////////////////
+// Basic builders (resource name and required) //
+/////////////////////////////////////////////////
+
+func OrganizationAccount(
+	resourceName string,
+	name string,
+	adminName string,
+	edition string,
+	email string,
+) *OrganizationAccountModel {
+	o := &OrganizationAccountModel{ResourceModelMeta: config.Meta(resourceName, resources.OrganizationAccount)}
+	o.WithName(name)
+	o.WithAdminName(adminName)
+	o.WithEdition(edition)
+	o.WithEmail(email)
+	return o
+}
+
+func OrganizationAccountWithDefaultMeta(
+	name string,
+	adminName string,
+	edition string,
+	email string,
+) *OrganizationAccountModel {
+	o := &OrganizationAccountModel{ResourceModelMeta: config.DefaultMeta(resources.OrganizationAccount)}
+	o.WithName(name)
+	o.WithAdminName(adminName)
+	o.WithEdition(edition)
+	o.WithEmail(email)
+	return o
+}
+
+///////////////////////////////////////////////////////////////////////
+// set proper json marshalling, handle depends on and dynamic blocks //
+///////////////////////////////////////////////////////////////////////
+
+func (o *OrganizationAccountModel) MarshalJSON() ([]byte, error) {
+	type Alias OrganizationAccountModel
+	return json.Marshal(&struct {
+		*Alias
+		DependsOn []string         `json:"depends_on,omitempty"`
+		Timeouts  *config.Timeouts `json:"timeouts,omitempty"`
+	}{
+		Alias:     (*Alias)(o),
+		DependsOn: o.DependsOn(),
+		Timeouts:  o.Timeouts(),
+	})
+}
+
+func (o *OrganizationAccountModel) WithDependsOn(values ...string) *OrganizationAccountModel {
+	o.SetDependsOn(values...)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDynamicBlock(dynamicBlock *config.DynamicBlock) *OrganizationAccountModel {
+	o.DynamicBlock = dynamicBlock
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimeout(timeout config.Timeouts) *OrganizationAccountModel {
+	o.SetTimeout(timeout)
+	return o
+}
+
+/////////////////////////////////
+// below all the proper values //
+/////////////////////////////////
+
+func (o *OrganizationAccountModel) WithName(name string) *OrganizationAccountModel {
+	o.Name = tfconfig.StringVariable(name)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithAbortDetachedQuery(abortDetachedQuery bool) *OrganizationAccountModel {
+	o.AbortDetachedQuery = tfconfig.BoolVariable(abortDetachedQuery)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithActivePythonProfiler(activePythonProfiler string) *OrganizationAccountModel {
+	o.ActivePythonProfiler = tfconfig.StringVariable(activePythonProfiler)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithAdminName(adminName string) *OrganizationAccountModel {
+	o.AdminName = tfconfig.StringVariable(adminName)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithAdminPassword(adminPassword string) *OrganizationAccountModel {
+	o.AdminPassword = tfconfig.StringVariable(adminPassword)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithAdminRsaPublicKey(adminRsaPublicKey string) *OrganizationAccountModel {
+	o.AdminRsaPublicKey = tfconfig.StringVariable(adminRsaPublicKey)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithAllowClientMfaCaching(allowClientMfaCaching bool) *OrganizationAccountModel {
+	o.AllowClientMfaCaching = tfconfig.BoolVariable(allowClientMfaCaching)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithAllowIdToken(allowIdToken bool) *OrganizationAccountModel {
+	o.AllowIdToken = tfconfig.BoolVariable(allowIdToken)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithAutocommit(autocommit bool) *OrganizationAccountModel {
+	o.Autocommit = tfconfig.BoolVariable(autocommit)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithBaseLocationPrefix(baseLocationPrefix string) *OrganizationAccountModel {
+	o.BaseLocationPrefix = tfconfig.StringVariable(baseLocationPrefix)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithBinaryInputFormat(binaryInputFormat string) *OrganizationAccountModel {
+	o.BinaryInputFormat = tfconfig.StringVariable(binaryInputFormat)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithBinaryOutputFormat(binaryOutputFormat string) *OrganizationAccountModel {
+	o.BinaryOutputFormat = tfconfig.StringVariable(binaryOutputFormat)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithCatalog(catalog string) *OrganizationAccountModel {
+	o.Catalog = tfconfig.StringVariable(catalog)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithCatalogSync(catalogSync string) *OrganizationAccountModel {
+	o.CatalogSync = tfconfig.StringVariable(catalogSync)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientEnableLogInfoStatementParameters(clientEnableLogInfoStatementParameters bool) *OrganizationAccountModel {
+	o.ClientEnableLogInfoStatementParameters = tfconfig.BoolVariable(clientEnableLogInfoStatementParameters)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientEncryptionKeySize(clientEncryptionKeySize int) *OrganizationAccountModel {
+	o.ClientEncryptionKeySize = tfconfig.IntegerVariable(clientEncryptionKeySize)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientMemoryLimit(clientMemoryLimit int) *OrganizationAccountModel {
+	o.ClientMemoryLimit = tfconfig.IntegerVariable(clientMemoryLimit)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientMetadataRequestUseConnectionCtx(clientMetadataRequestUseConnectionCtx bool) *OrganizationAccountModel {
+	o.ClientMetadataRequestUseConnectionCtx = tfconfig.BoolVariable(clientMetadataRequestUseConnectionCtx)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientMetadataUseSessionDatabase(clientMetadataUseSessionDatabase bool) *OrganizationAccountModel {
+	o.ClientMetadataUseSessionDatabase = tfconfig.BoolVariable(clientMetadataUseSessionDatabase)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientPrefetchThreads(clientPrefetchThreads int) *OrganizationAccountModel {
+	o.ClientPrefetchThreads = tfconfig.IntegerVariable(clientPrefetchThreads)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientResultChunkSize(clientResultChunkSize int) *OrganizationAccountModel {
+	o.ClientResultChunkSize = tfconfig.IntegerVariable(clientResultChunkSize)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientResultColumnCaseInsensitive(clientResultColumnCaseInsensitive bool) *OrganizationAccountModel {
+	o.ClientResultColumnCaseInsensitive = tfconfig.BoolVariable(clientResultColumnCaseInsensitive)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientSessionKeepAlive(clientSessionKeepAlive bool) *OrganizationAccountModel {
+	o.ClientSessionKeepAlive = tfconfig.BoolVariable(clientSessionKeepAlive)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientSessionKeepAliveHeartbeatFrequency(clientSessionKeepAliveHeartbeatFrequency int) *OrganizationAccountModel {
+	o.ClientSessionKeepAliveHeartbeatFrequency = tfconfig.IntegerVariable(clientSessionKeepAliveHeartbeatFrequency)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientTimestampTypeMapping(clientTimestampTypeMapping string) *OrganizationAccountModel {
+	o.ClientTimestampTypeMapping = tfconfig.StringVariable(clientTimestampTypeMapping)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithComment(comment string) *OrganizationAccountModel {
+	o.Comment = tfconfig.StringVariable(comment)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithCortexCodeCliDailyEstCreditLimitPerUser(cortexCodeCliDailyEstCreditLimitPerUser int) *OrganizationAccountModel {
+	o.CortexCodeCliDailyEstCreditLimitPerUser = tfconfig.IntegerVariable(cortexCodeCliDailyEstCreditLimitPerUser)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithCortexCodeDesktopDailyEstCreditLimitPerUser(cortexCodeDesktopDailyEstCreditLimitPerUser int) *OrganizationAccountModel {
+	o.CortexCodeDesktopDailyEstCreditLimitPerUser = tfconfig.IntegerVariable(cortexCodeDesktopDailyEstCreditLimitPerUser)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithCortexCodeSnowsightDailyEstCreditLimitPerUser(cortexCodeSnowsightDailyEstCreditLimitPerUser int) *OrganizationAccountModel {
+	o.CortexCodeSnowsightDailyEstCreditLimitPerUser = tfconfig.IntegerVariable(cortexCodeSnowsightDailyEstCreditLimitPerUser)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithCortexEnabledCrossRegion(cortexEnabledCrossRegion string) *OrganizationAccountModel {
+	o.CortexEnabledCrossRegion = tfconfig.StringVariable(cortexEnabledCrossRegion)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithCortexModelsAllowlist(cortexModelsAllowlist string) *OrganizationAccountModel {
+	o.CortexModelsAllowlist = tfconfig.StringVariable(cortexModelsAllowlist)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithCsvTimestampFormat(csvTimestampFormat string) *OrganizationAccountModel {
+	o.CsvTimestampFormat = tfconfig.StringVariable(csvTimestampFormat)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDataRetentionTimeInDays(dataRetentionTimeInDays int) *OrganizationAccountModel {
+	o.DataRetentionTimeInDays = tfconfig.IntegerVariable(dataRetentionTimeInDays)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDateInputFormat(dateInputFormat string) *OrganizationAccountModel {
+	o.DateInputFormat = tfconfig.StringVariable(dateInputFormat)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDateOutputFormat(dateOutputFormat string) *OrganizationAccountModel {
+	o.DateOutputFormat = tfconfig.StringVariable(dateOutputFormat)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDefaultDdlCollation(defaultDdlCollation string) *OrganizationAccountModel {
+	o.DefaultDdlCollation = tfconfig.StringVariable(defaultDdlCollation)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDefaultNotebookComputePoolCpu(defaultNotebookComputePoolCpu string) *OrganizationAccountModel {
+	o.DefaultNotebookComputePoolCpu = tfconfig.StringVariable(defaultNotebookComputePoolCpu)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDefaultNotebookComputePoolGpu(defaultNotebookComputePoolGpu string) *OrganizationAccountModel {
+	o.DefaultNotebookComputePoolGpu = tfconfig.StringVariable(defaultNotebookComputePoolGpu)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDefaultNullOrdering(defaultNullOrdering string) *OrganizationAccountModel {
+	o.DefaultNullOrdering = tfconfig.StringVariable(defaultNullOrdering)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDefaultStreamlitComputePool(defaultStreamlitComputePool string) *OrganizationAccountModel {
+	o.DefaultStreamlitComputePool = tfconfig.StringVariable(defaultStreamlitComputePool)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDefaultStreamlitNotebookWarehouse(defaultStreamlitNotebookWarehouse string) *OrganizationAccountModel {
+	o.DefaultStreamlitNotebookWarehouse = tfconfig.StringVariable(defaultStreamlitNotebookWarehouse)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDisableUiDownloadButton(disableUiDownloadButton bool) *OrganizationAccountModel {
+	o.DisableUiDownloadButton = tfconfig.BoolVariable(disableUiDownloadButton)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDisableUserPrivilegeGrants(disableUserPrivilegeGrants bool) *OrganizationAccountModel {
+	o.DisableUserPrivilegeGrants = tfconfig.BoolVariable(disableUserPrivilegeGrants)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEdition(edition string) *OrganizationAccountModel {
+	o.Edition = tfconfig.StringVariable(edition)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEmail(email string) *OrganizationAccountModel {
+	o.Email = tfconfig.StringVariable(email)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableAutomaticSensitiveDataClassificationLog(enableAutomaticSensitiveDataClassificationLog bool) *OrganizationAccountModel {
+	o.EnableAutomaticSensitiveDataClassificationLog = tfconfig.BoolVariable(enableAutomaticSensitiveDataClassificationLog)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableEgressCostOptimizer(enableEgressCostOptimizer bool) *OrganizationAccountModel {
+	o.EnableEgressCostOptimizer = tfconfig.BoolVariable(enableEgressCostOptimizer)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableIdentifierFirstLogin(enableIdentifierFirstLogin bool) *OrganizationAccountModel {
+	o.EnableIdentifierFirstLogin = tfconfig.BoolVariable(enableIdentifierFirstLogin)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableInternalStagesPrivatelink(enableInternalStagesPrivatelink bool) *OrganizationAccountModel {
+	o.EnableInternalStagesPrivatelink = tfconfig.BoolVariable(enableInternalStagesPrivatelink)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnablePerAccountAppServicePrivatelinkUrl(enablePerAccountAppServicePrivatelinkUrl bool) *OrganizationAccountModel {
+	o.EnablePerAccountAppServicePrivatelinkUrl = tfconfig.BoolVariable(enablePerAccountAppServicePrivatelinkUrl)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableTriSecretAndRekeyOptOutForImageRepository(enableTriSecretAndRekeyOptOutForImageRepository bool) *OrganizationAccountModel {
+	o.EnableTriSecretAndRekeyOptOutForImageRepository = tfconfig.BoolVariable(enableTriSecretAndRekeyOptOutForImageRepository)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableTriSecretAndRekeyOptOutForSpcsBlockStorage(enableTriSecretAndRekeyOptOutForSpcsBlockStorage bool) *OrganizationAccountModel {
+	o.EnableTriSecretAndRekeyOptOutForSpcsBlockStorage = tfconfig.BoolVariable(enableTriSecretAndRekeyOptOutForSpcsBlockStorage)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableUnhandledExceptionsReporting(enableUnhandledExceptionsReporting bool) *OrganizationAccountModel {
+	o.EnableUnhandledExceptionsReporting = tfconfig.BoolVariable(enableUnhandledExceptionsReporting)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableUnloadPhysicalTypeOptimization(enableUnloadPhysicalTypeOptimization bool) *OrganizationAccountModel {
+	o.EnableUnloadPhysicalTypeOptimization = tfconfig.BoolVariable(enableUnloadPhysicalTypeOptimization)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableUnredactedQuerySyntaxError(enableUnredactedQuerySyntaxError bool) *OrganizationAccountModel {
+	o.EnableUnredactedQuerySyntaxError = tfconfig.BoolVariable(enableUnredactedQuerySyntaxError)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableUnredactedSecureObjectError(enableUnredactedSecureObjectError bool) *OrganizationAccountModel {
+	o.EnableUnredactedSecureObjectError = tfconfig.BoolVariable(enableUnredactedSecureObjectError)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnforceNetworkRulesForInternalStages(enforceNetworkRulesForInternalStages bool) *OrganizationAccountModel {
+	o.EnforceNetworkRulesForInternalStages = tfconfig.BoolVariable(enforceNetworkRulesForInternalStages)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithErrorOnNondeterministicMerge(errorOnNondeterministicMerge bool) *OrganizationAccountModel {
+	o.ErrorOnNondeterministicMerge = tfconfig.BoolVariable(errorOnNondeterministicMerge)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithErrorOnNondeterministicUpdate(errorOnNondeterministicUpdate bool) *OrganizationAccountModel {
+	o.ErrorOnNondeterministicUpdate = tfconfig.BoolVariable(errorOnNondeterministicUpdate)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEventTable(eventTable string) *OrganizationAccountModel {
+	o.EventTable = tfconfig.StringVariable(eventTable)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithExternalOauthAddPrivilegedRolesToBlockedList(externalOauthAddPrivilegedRolesToBlockedList bool) *OrganizationAccountModel {
+	o.ExternalOauthAddPrivilegedRolesToBlockedList = tfconfig.BoolVariable(externalOauthAddPrivilegedRolesToBlockedList)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithExternalVolume(externalVolume string) *OrganizationAccountModel {
+	o.ExternalVolume = tfconfig.StringVariable(externalVolume)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithFirstName(firstName string) *OrganizationAccountModel {
+	o.FirstName = tfconfig.StringVariable(firstName)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithFullyQualifiedName(fullyQualifiedName string) *OrganizationAccountModel {
+	o.FullyQualifiedName = tfconfig.StringVariable(fullyQualifiedName)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithGeographyOutputFormat(geographyOutputFormat string) *OrganizationAccountModel {
+	o.GeographyOutputFormat = tfconfig.StringVariable(geographyOutputFormat)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithGeometryOutputFormat(geometryOutputFormat string) *OrganizationAccountModel {
+	o.GeometryOutputFormat = tfconfig.StringVariable(geometryOutputFormat)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithHybridTableLockTimeout(hybridTableLockTimeout int) *OrganizationAccountModel {
+	o.HybridTableLockTimeout = tfconfig.IntegerVariable(hybridTableLockTimeout)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithInitialReplicationSizeLimitInTb(initialReplicationSizeLimitInTb string) *OrganizationAccountModel {
+	o.InitialReplicationSizeLimitInTb = tfconfig.StringVariable(initialReplicationSizeLimitInTb)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithJdbcTreatDecimalAsInt(jdbcTreatDecimalAsInt bool) *OrganizationAccountModel {
+	o.JdbcTreatDecimalAsInt = tfconfig.BoolVariable(jdbcTreatDecimalAsInt)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithJdbcTreatTimestampNtzAsUtc(jdbcTreatTimestampNtzAsUtc bool) *OrganizationAccountModel {
+	o.JdbcTreatTimestampNtzAsUtc = tfconfig.BoolVariable(jdbcTreatTimestampNtzAsUtc)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithJdbcUseSessionTimezone(jdbcUseSessionTimezone bool) *OrganizationAccountModel {
+	o.JdbcUseSessionTimezone = tfconfig.BoolVariable(jdbcUseSessionTimezone)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithJsTreatIntegerAsBigint(jsTreatIntegerAsBigint bool) *OrganizationAccountModel {
+	o.JsTreatIntegerAsBigint = tfconfig.BoolVariable(jsTreatIntegerAsBigint)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithJsonIndent(jsonIndent int) *OrganizationAccountModel {
+	o.JsonIndent = tfconfig.IntegerVariable(jsonIndent)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithLastName(lastName string) *OrganizationAccountModel {
+	o.LastName = tfconfig.StringVariable(lastName)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithListingAutoFulfillmentReplicationRefreshSchedule(listingAutoFulfillmentReplicationRefreshSchedule string) *OrganizationAccountModel {
+	o.ListingAutoFulfillmentReplicationRefreshSchedule = tfconfig.StringVariable(listingAutoFulfillmentReplicationRefreshSchedule)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithLockTimeout(lockTimeout int) *OrganizationAccountModel {
+	o.LockTimeout = tfconfig.IntegerVariable(lockTimeout)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithLogEventLevel(logEventLevel string) *OrganizationAccountModel {
+	o.LogEventLevel = tfconfig.StringVariable(logEventLevel)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithLogLevel(logLevel string) *OrganizationAccountModel {
+	o.LogLevel = tfconfig.StringVariable(logLevel)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithMaxConcurrencyLevel(maxConcurrencyLevel int) *OrganizationAccountModel {
+	o.MaxConcurrencyLevel = tfconfig.IntegerVariable(maxConcurrencyLevel)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithMaxDataExtensionTimeInDays(maxDataExtensionTimeInDays int) *OrganizationAccountModel {
+	o.MaxDataExtensionTimeInDays = tfconfig.IntegerVariable(maxDataExtensionTimeInDays)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithMetricLevel(metricLevel string) *OrganizationAccountModel {
+	o.MetricLevel = tfconfig.StringVariable(metricLevel)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithMinDataRetentionTimeInDays(minDataRetentionTimeInDays int) *OrganizationAccountModel {
+	o.MinDataRetentionTimeInDays = tfconfig.IntegerVariable(minDataRetentionTimeInDays)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithMultiStatementCount(multiStatementCount int) *OrganizationAccountModel {
+	o.MultiStatementCount = tfconfig.IntegerVariable(multiStatementCount)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithMustChangePassword(mustChangePassword string) *OrganizationAccountModel {
+	o.MustChangePassword = tfconfig.StringVariable(mustChangePassword)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithNetworkPolicy(networkPolicy string) *OrganizationAccountModel {
+	o.NetworkPolicy = tfconfig.StringVariable(networkPolicy)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithNoorderSequenceAsDefault(noorderSequenceAsDefault bool) *OrganizationAccountModel {
+	o.NoorderSequenceAsDefault = tfconfig.BoolVariable(noorderSequenceAsDefault)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithOauthAddPrivilegedRolesToBlockedList(oauthAddPrivilegedRolesToBlockedList bool) *OrganizationAccountModel {
+	o.OauthAddPrivilegedRolesToBlockedList = tfconfig.BoolVariable(oauthAddPrivilegedRolesToBlockedList)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithOdbcTreatDecimalAsInt(odbcTreatDecimalAsInt bool) *OrganizationAccountModel {
+	o.OdbcTreatDecimalAsInt = tfconfig.BoolVariable(odbcTreatDecimalAsInt)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithPasswordPolicy(passwordPolicy string) *OrganizationAccountModel {
+	o.PasswordPolicy = tfconfig.StringVariable(passwordPolicy)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithPeriodicDataRekeying(periodicDataRekeying bool) *OrganizationAccountModel {
+	o.PeriodicDataRekeying = tfconfig.BoolVariable(periodicDataRekeying)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithPipeExecutionPaused(pipeExecutionPaused bool) *OrganizationAccountModel {
+	o.PipeExecutionPaused = tfconfig.BoolVariable(pipeExecutionPaused)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithPreventUnloadToInlineUrl(preventUnloadToInlineUrl bool) *OrganizationAccountModel {
+	o.PreventUnloadToInlineUrl = tfconfig.BoolVariable(preventUnloadToInlineUrl)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithPreventUnloadToInternalStages(preventUnloadToInternalStages bool) *OrganizationAccountModel {
+	o.PreventUnloadToInternalStages = tfconfig.BoolVariable(preventUnloadToInternalStages)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithPythonProfilerModules(pythonProfilerModules string) *OrganizationAccountModel {
+	o.PythonProfilerModules = tfconfig.StringVariable(pythonProfilerModules)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithPythonProfilerTargetStage(pythonProfilerTargetStage string) *OrganizationAccountModel {
+	o.PythonProfilerTargetStage = tfconfig.StringVariable(pythonProfilerTargetStage)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithQueryTag(queryTag string) *OrganizationAccountModel {
+	o.QueryTag = tfconfig.StringVariable(queryTag)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithQuotedIdentifiersIgnoreCase(quotedIdentifiersIgnoreCase bool) *OrganizationAccountModel {
+	o.QuotedIdentifiersIgnoreCase = tfconfig.BoolVariable(quotedIdentifiersIgnoreCase)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithRegion(region string) *OrganizationAccountModel {
+	o.Region = tfconfig.StringVariable(region)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithRegionGroup(regionGroup string) *OrganizationAccountModel {
+	o.RegionGroup = tfconfig.StringVariable(regionGroup)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithReplaceInvalidCharacters(replaceInvalidCharacters bool) *OrganizationAccountModel {
+	o.ReplaceInvalidCharacters = tfconfig.BoolVariable(replaceInvalidCharacters)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithRequireStorageIntegrationForStageCreation(requireStorageIntegrationForStageCreation bool) *OrganizationAccountModel {
+	o.RequireStorageIntegrationForStageCreation = tfconfig.BoolVariable(requireStorageIntegrationForStageCreation)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithRequireStorageIntegrationForStageOperation(requireStorageIntegrationForStageOperation bool) *OrganizationAccountModel {
+	o.RequireStorageIntegrationForStageOperation = tfconfig.BoolVariable(requireStorageIntegrationForStageOperation)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithResourceMonitor(resourceMonitor string) *OrganizationAccountModel {
+	o.ResourceMonitor = tfconfig.StringVariable(resourceMonitor)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithRowsPerResultset(rowsPerResultset int) *OrganizationAccountModel {
+	o.RowsPerResultset = tfconfig.IntegerVariable(rowsPerResultset)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithS3StageVpceDnsName(s3StageVpceDnsName string) *OrganizationAccountModel {
+	o.S3StageVpceDnsName = tfconfig.StringVariable(s3StageVpceDnsName)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithSearchPath(searchPath string) *OrganizationAccountModel {
+	o.SearchPath = tfconfig.StringVariable(searchPath)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithServerlessTaskMaxStatementSize(serverlessTaskMaxStatementSize string) *OrganizationAccountModel {
+	o.ServerlessTaskMaxStatementSize = tfconfig.StringVariable(serverlessTaskMaxStatementSize)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithServerlessTaskMinStatementSize(serverlessTaskMinStatementSize string) *OrganizationAccountModel {
+	o.ServerlessTaskMinStatementSize = tfconfig.StringVariable(serverlessTaskMinStatementSize)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithSessionPolicy(sessionPolicy string) *OrganizationAccountModel {
+	o.SessionPolicy = tfconfig.StringVariable(sessionPolicy)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithSimulatedDataSharingConsumer(simulatedDataSharingConsumer string) *OrganizationAccountModel {
+	o.SimulatedDataSharingConsumer = tfconfig.StringVariable(simulatedDataSharingConsumer)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithSsoLoginPage(ssoLoginPage bool) *OrganizationAccountModel {
+	o.SsoLoginPage = tfconfig.BoolVariable(ssoLoginPage)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithStatementQueuedTimeoutInSeconds(statementQueuedTimeoutInSeconds int) *OrganizationAccountModel {
+	o.StatementQueuedTimeoutInSeconds = tfconfig.IntegerVariable(statementQueuedTimeoutInSeconds)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithStatementTimeoutInSeconds(statementTimeoutInSeconds int) *OrganizationAccountModel {
+	o.StatementTimeoutInSeconds = tfconfig.IntegerVariable(statementTimeoutInSeconds)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithStorageSerializationPolicy(storageSerializationPolicy string) *OrganizationAccountModel {
+	o.StorageSerializationPolicy = tfconfig.StringVariable(storageSerializationPolicy)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithStrictJsonOutput(strictJsonOutput bool) *OrganizationAccountModel {
+	o.StrictJsonOutput = tfconfig.BoolVariable(strictJsonOutput)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithSuspendTaskAfterNumFailures(suspendTaskAfterNumFailures int) *OrganizationAccountModel {
+	o.SuspendTaskAfterNumFailures = tfconfig.IntegerVariable(suspendTaskAfterNumFailures)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTaskAutoRetryAttempts(taskAutoRetryAttempts int) *OrganizationAccountModel {
+	o.TaskAutoRetryAttempts = tfconfig.IntegerVariable(taskAutoRetryAttempts)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimeInputFormat(timeInputFormat string) *OrganizationAccountModel {
+	o.TimeInputFormat = tfconfig.StringVariable(timeInputFormat)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimeOutputFormat(timeOutputFormat string) *OrganizationAccountModel {
+	o.TimeOutputFormat = tfconfig.StringVariable(timeOutputFormat)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimestampDayIsAlways24h(timestampDayIsAlways24h bool) *OrganizationAccountModel {
+	o.TimestampDayIsAlways24h = tfconfig.BoolVariable(timestampDayIsAlways24h)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimestampInputFormat(timestampInputFormat string) *OrganizationAccountModel {
+	o.TimestampInputFormat = tfconfig.StringVariable(timestampInputFormat)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimestampLtzOutputFormat(timestampLtzOutputFormat string) *OrganizationAccountModel {
+	o.TimestampLtzOutputFormat = tfconfig.StringVariable(timestampLtzOutputFormat)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimestampNtzOutputFormat(timestampNtzOutputFormat string) *OrganizationAccountModel {
+	o.TimestampNtzOutputFormat = tfconfig.StringVariable(timestampNtzOutputFormat)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimestampOutputFormat(timestampOutputFormat string) *OrganizationAccountModel {
+	o.TimestampOutputFormat = tfconfig.StringVariable(timestampOutputFormat)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimestampTypeMapping(timestampTypeMapping string) *OrganizationAccountModel {
+	o.TimestampTypeMapping = tfconfig.StringVariable(timestampTypeMapping)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimestampTzOutputFormat(timestampTzOutputFormat string) *OrganizationAccountModel {
+	o.TimestampTzOutputFormat = tfconfig.StringVariable(timestampTzOutputFormat)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimezone(timezone string) *OrganizationAccountModel {
+	o.Timezone = tfconfig.StringVariable(timezone)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTraceLevel(traceLevel string) *OrganizationAccountModel {
+	o.TraceLevel = tfconfig.StringVariable(traceLevel)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTransactionAbortOnError(transactionAbortOnError bool) *OrganizationAccountModel {
+	o.TransactionAbortOnError = tfconfig.BoolVariable(transactionAbortOnError)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTransactionDefaultIsolationLevel(transactionDefaultIsolationLevel string) *OrganizationAccountModel {
+	o.TransactionDefaultIsolationLevel = tfconfig.StringVariable(transactionDefaultIsolationLevel)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTwoDigitCenturyStart(twoDigitCenturyStart int) *OrganizationAccountModel {
+	o.TwoDigitCenturyStart = tfconfig.IntegerVariable(twoDigitCenturyStart)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithUnsupportedDdlAction(unsupportedDdlAction string) *OrganizationAccountModel {
+	o.UnsupportedDdlAction = tfconfig.StringVariable(unsupportedDdlAction)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithUseCachedResult(useCachedResult bool) *OrganizationAccountModel {
+	o.UseCachedResult = tfconfig.BoolVariable(useCachedResult)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithUserTaskManagedInitialWarehouseSize(userTaskManagedInitialWarehouseSize string) *OrganizationAccountModel {
+	o.UserTaskManagedInitialWarehouseSize = tfconfig.StringVariable(userTaskManagedInitialWarehouseSize)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithUserTaskMinimumTriggerIntervalInSeconds(userTaskMinimumTriggerIntervalInSeconds int) *OrganizationAccountModel {
+	o.UserTaskMinimumTriggerIntervalInSeconds = tfconfig.IntegerVariable(userTaskMinimumTriggerIntervalInSeconds)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithUserTaskTimeoutMs(userTaskTimeoutMs int) *OrganizationAccountModel {
+	o.UserTaskTimeoutMs = tfconfig.IntegerVariable(userTaskTimeoutMs)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithWeekOfYearPolicy(weekOfYearPolicy int) *OrganizationAccountModel {
+	o.WeekOfYearPolicy = tfconfig.IntegerVariable(weekOfYearPolicy)
+	return o
+}
+
+func (o *OrganizationAccountModel) WithWeekStart(weekStart int) *OrganizationAccountModel {
+	o.WeekStart = tfconfig.IntegerVariable(weekStart)
+	return o
+}
+
+//////////////////////////////////////////
+// below it's possible to set any value //
+//////////////////////////////////////////
+
+func (o *OrganizationAccountModel) WithNameValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.Name = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithAbortDetachedQueryValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.AbortDetachedQuery = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithActivePythonProfilerValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ActivePythonProfiler = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithAdminNameValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.AdminName = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithAdminPasswordValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.AdminPassword = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithAdminRsaPublicKeyValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.AdminRsaPublicKey = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithAllowClientMfaCachingValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.AllowClientMfaCaching = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithAllowIdTokenValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.AllowIdToken = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithAutocommitValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.Autocommit = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithBaseLocationPrefixValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.BaseLocationPrefix = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithBinaryInputFormatValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.BinaryInputFormat = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithBinaryOutputFormatValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.BinaryOutputFormat = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithCatalogValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.Catalog = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithCatalogSyncValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.CatalogSync = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientEnableLogInfoStatementParametersValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ClientEnableLogInfoStatementParameters = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientEncryptionKeySizeValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ClientEncryptionKeySize = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientMemoryLimitValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ClientMemoryLimit = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientMetadataRequestUseConnectionCtxValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ClientMetadataRequestUseConnectionCtx = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientMetadataUseSessionDatabaseValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ClientMetadataUseSessionDatabase = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientPrefetchThreadsValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ClientPrefetchThreads = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientResultChunkSizeValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ClientResultChunkSize = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientResultColumnCaseInsensitiveValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ClientResultColumnCaseInsensitive = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientSessionKeepAliveValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ClientSessionKeepAlive = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientSessionKeepAliveHeartbeatFrequencyValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ClientSessionKeepAliveHeartbeatFrequency = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithClientTimestampTypeMappingValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ClientTimestampTypeMapping = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithCommentValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.Comment = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithCortexCodeCliDailyEstCreditLimitPerUserValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.CortexCodeCliDailyEstCreditLimitPerUser = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithCortexCodeDesktopDailyEstCreditLimitPerUserValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.CortexCodeDesktopDailyEstCreditLimitPerUser = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithCortexCodeSnowsightDailyEstCreditLimitPerUserValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.CortexCodeSnowsightDailyEstCreditLimitPerUser = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithCortexEnabledCrossRegionValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.CortexEnabledCrossRegion = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithCortexModelsAllowlistValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.CortexModelsAllowlist = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithCsvTimestampFormatValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.CsvTimestampFormat = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDataRetentionTimeInDaysValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.DataRetentionTimeInDays = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDateInputFormatValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.DateInputFormat = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDateOutputFormatValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.DateOutputFormat = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDefaultDdlCollationValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.DefaultDdlCollation = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDefaultNotebookComputePoolCpuValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.DefaultNotebookComputePoolCpu = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDefaultNotebookComputePoolGpuValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.DefaultNotebookComputePoolGpu = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDefaultNullOrderingValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.DefaultNullOrdering = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDefaultStreamlitComputePoolValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.DefaultStreamlitComputePool = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDefaultStreamlitNotebookWarehouseValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.DefaultStreamlitNotebookWarehouse = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDisableUiDownloadButtonValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.DisableUiDownloadButton = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithDisableUserPrivilegeGrantsValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.DisableUserPrivilegeGrants = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEditionValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.Edition = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEmailValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.Email = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableAutomaticSensitiveDataClassificationLogValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.EnableAutomaticSensitiveDataClassificationLog = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableEgressCostOptimizerValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.EnableEgressCostOptimizer = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableIdentifierFirstLoginValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.EnableIdentifierFirstLogin = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableInternalStagesPrivatelinkValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.EnableInternalStagesPrivatelink = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnablePerAccountAppServicePrivatelinkUrlValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.EnablePerAccountAppServicePrivatelinkUrl = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableTriSecretAndRekeyOptOutForImageRepositoryValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.EnableTriSecretAndRekeyOptOutForImageRepository = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableTriSecretAndRekeyOptOutForSpcsBlockStorageValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.EnableTriSecretAndRekeyOptOutForSpcsBlockStorage = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableUnhandledExceptionsReportingValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.EnableUnhandledExceptionsReporting = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableUnloadPhysicalTypeOptimizationValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.EnableUnloadPhysicalTypeOptimization = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableUnredactedQuerySyntaxErrorValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.EnableUnredactedQuerySyntaxError = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnableUnredactedSecureObjectErrorValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.EnableUnredactedSecureObjectError = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEnforceNetworkRulesForInternalStagesValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.EnforceNetworkRulesForInternalStages = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithErrorOnNondeterministicMergeValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ErrorOnNondeterministicMerge = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithErrorOnNondeterministicUpdateValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ErrorOnNondeterministicUpdate = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithEventTableValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.EventTable = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithExternalOauthAddPrivilegedRolesToBlockedListValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ExternalOauthAddPrivilegedRolesToBlockedList = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithExternalVolumeValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ExternalVolume = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithFirstNameValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.FirstName = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithFullyQualifiedNameValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.FullyQualifiedName = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithGeographyOutputFormatValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.GeographyOutputFormat = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithGeometryOutputFormatValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.GeometryOutputFormat = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithHybridTableLockTimeoutValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.HybridTableLockTimeout = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithInitialReplicationSizeLimitInTbValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.InitialReplicationSizeLimitInTb = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithJdbcTreatDecimalAsIntValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.JdbcTreatDecimalAsInt = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithJdbcTreatTimestampNtzAsUtcValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.JdbcTreatTimestampNtzAsUtc = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithJdbcUseSessionTimezoneValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.JdbcUseSessionTimezone = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithJsTreatIntegerAsBigintValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.JsTreatIntegerAsBigint = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithJsonIndentValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.JsonIndent = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithLastNameValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.LastName = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithListingAutoFulfillmentReplicationRefreshScheduleValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ListingAutoFulfillmentReplicationRefreshSchedule = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithLockTimeoutValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.LockTimeout = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithLogEventLevelValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.LogEventLevel = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithLogLevelValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.LogLevel = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithMaxConcurrencyLevelValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.MaxConcurrencyLevel = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithMaxDataExtensionTimeInDaysValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.MaxDataExtensionTimeInDays = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithMetricLevelValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.MetricLevel = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithMinDataRetentionTimeInDaysValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.MinDataRetentionTimeInDays = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithMultiStatementCountValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.MultiStatementCount = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithMustChangePasswordValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.MustChangePassword = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithNetworkPolicyValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.NetworkPolicy = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithNoorderSequenceAsDefaultValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.NoorderSequenceAsDefault = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithOauthAddPrivilegedRolesToBlockedListValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.OauthAddPrivilegedRolesToBlockedList = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithOdbcTreatDecimalAsIntValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.OdbcTreatDecimalAsInt = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithPasswordPolicyValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.PasswordPolicy = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithPeriodicDataRekeyingValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.PeriodicDataRekeying = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithPipeExecutionPausedValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.PipeExecutionPaused = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithPreventUnloadToInlineUrlValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.PreventUnloadToInlineUrl = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithPreventUnloadToInternalStagesValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.PreventUnloadToInternalStages = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithPythonProfilerModulesValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.PythonProfilerModules = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithPythonProfilerTargetStageValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.PythonProfilerTargetStage = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithQueryTagValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.QueryTag = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithQuotedIdentifiersIgnoreCaseValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.QuotedIdentifiersIgnoreCase = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithRegionValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.Region = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithRegionGroupValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.RegionGroup = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithReplaceInvalidCharactersValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ReplaceInvalidCharacters = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithRequireStorageIntegrationForStageCreationValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.RequireStorageIntegrationForStageCreation = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithRequireStorageIntegrationForStageOperationValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.RequireStorageIntegrationForStageOperation = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithResourceMonitorValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ResourceMonitor = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithRowsPerResultsetValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.RowsPerResultset = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithS3StageVpceDnsNameValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.S3StageVpceDnsName = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithSearchPathValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.SearchPath = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithServerlessTaskMaxStatementSizeValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ServerlessTaskMaxStatementSize = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithServerlessTaskMinStatementSizeValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.ServerlessTaskMinStatementSize = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithSessionPolicyValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.SessionPolicy = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithSimulatedDataSharingConsumerValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.SimulatedDataSharingConsumer = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithSsoLoginPageValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.SsoLoginPage = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithStatementQueuedTimeoutInSecondsValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.StatementQueuedTimeoutInSeconds = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithStatementTimeoutInSecondsValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.StatementTimeoutInSeconds = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithStorageSerializationPolicyValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.StorageSerializationPolicy = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithStrictJsonOutputValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.StrictJsonOutput = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithSuspendTaskAfterNumFailuresValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.SuspendTaskAfterNumFailures = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTaskAutoRetryAttemptsValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.TaskAutoRetryAttempts = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimeInputFormatValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.TimeInputFormat = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimeOutputFormatValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.TimeOutputFormat = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimestampDayIsAlways24hValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.TimestampDayIsAlways24h = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimestampInputFormatValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.TimestampInputFormat = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimestampLtzOutputFormatValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.TimestampLtzOutputFormat = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimestampNtzOutputFormatValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.TimestampNtzOutputFormat = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimestampOutputFormatValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.TimestampOutputFormat = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimestampTypeMappingValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.TimestampTypeMapping = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimestampTzOutputFormatValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.TimestampTzOutputFormat = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTimezoneValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.Timezone = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTraceLevelValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.TraceLevel = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTransactionAbortOnErrorValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.TransactionAbortOnError = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTransactionDefaultIsolationLevelValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.TransactionDefaultIsolationLevel = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithTwoDigitCenturyStartValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.TwoDigitCenturyStart = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithUnsupportedDdlActionValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.UnsupportedDdlAction = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithUseCachedResultValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.UseCachedResult = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithUserTaskManagedInitialWarehouseSizeValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.UserTaskManagedInitialWarehouseSize = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithUserTaskMinimumTriggerIntervalInSecondsValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.UserTaskMinimumTriggerIntervalInSeconds = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithUserTaskTimeoutMsValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.UserTaskTimeoutMs = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithWeekOfYearPolicyValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.WeekOfYearPolicy = value
+	return o
+}
+
+func (o *OrganizationAccountModel) WithWeekStartValue(value tfconfig.Variable) *OrganizationAccountModel {
+	o.WeekStart = value
+	return o
+}
@@ -23,6 +23,8 @@ type PipeModel struct {
 	Integration         tfconfig.Variable `json:"integration,omitempty"`
 	NotificationChannel tfconfig.Variable `json:"notification_channel,omitempty"`
 	Owner               tfconfig.Variable `json:"owner,omitempty"`
+	Tags                tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll             tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -158,6 +160,10 @@ func (p *PipeModel) WithOwner(owner string) *PipeModel {
 	return p
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -221,3 +227,13 @@ func (p *PipeModel) WithOwnerValue(value tfconfig.Variable) *PipeModel {
 	p.Owner = value
 	return p
 }
+
+func (p *PipeModel) WithTagsValue(value tfconfig.Variable) *PipeModel {
+	p.Tags = value
+	return p
+}
+
+func (p *PipeModel) WithTagsAllValue(value tfconfig.Variable) *PipeModel {
+	p.TagsAll = value
+	return p
+}
@@ -16,6 +16,7 @@ type PrimaryConnectionModel struct {
 	EnableFailoverToAccounts tfconfig.Variable `json:"enable_failover_to_accounts,omitempty"`
 	FullyQualifiedName       tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	IsPrimary                tfconfig.Variable `json:"is_primary,omitempty"`
+	PrimaryAccount           tfconfig.Variable `json:"primary_account,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -101,6 +102,11 @@ func (p *PrimaryConnectionModel) WithIsPrimary(isPrimary bool) *PrimaryConnectio
 	return p
 }
 
+func (p *PrimaryConnectionModel) WithPrimaryAccount(primaryAccount string) *PrimaryConnectionModel {
+	p.PrimaryAccount = tfconfig.StringVariable(primaryAccount)
+	return p
+}
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -129,3 +135,8 @@ func (p *PrimaryConnectionModel) WithIsPrimaryValue(value tfconfig.Variable) *Pr
 	p.IsPrimary = value
 	return p
 }
+
+func (p *PrimaryConnectionModel) WithPrimaryAccountValue(value tfconfig.Variable) *PrimaryConnectionModel {
+	p.PrimaryAccount = value
+	return p
+}
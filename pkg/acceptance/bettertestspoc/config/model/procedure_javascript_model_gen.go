@@ -27,6 +27,8 @@ type ProcedureJavascriptModel struct {
 	ProcedureDefinition tfconfig.Variable `json:"procedure_definition,omitempty"`
 	ProcedureLanguage   tfconfig.Variable `json:"procedure_language,omitempty"`
 	ReturnType          tfconfig.Variable `json:"return_type,omitempty"`
+	Tags                tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll             tfconfig.Variable `json:"tags_all,omitempty"`
 	TraceLevel          tfconfig.Variable `json:"trace_level,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -184,6 +186,10 @@ func (p *ProcedureJavascriptModel) WithReturnType(returnType string) *ProcedureJ
 	return p
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (p *ProcedureJavascriptModel) WithTraceLevel(traceLevel string) *ProcedureJavascriptModel {
 	p.TraceLevel = tfconfig.StringVariable(traceLevel)
 	return p
@@ -273,6 +279,16 @@ func (p *ProcedureJavascriptModel) WithReturnTypeValue(value tfconfig.Variable)
 	return p
 }
 
+func (p *ProcedureJavascriptModel) WithTagsValue(value tfconfig.Variable) *ProcedureJavascriptModel {
+	p.Tags = value
+	return p
+}
+
+func (p *ProcedureJavascriptModel) WithTagsAllValue(value tfconfig.Variable) *ProcedureJavascriptModel {
+	p.TagsAll = value
+	return p
+}
+
 func (p *ProcedureJavascriptModel) WithTraceLevelValue(value tfconfig.Variable) *ProcedureJavascriptModel {
 	p.TraceLevel = value
 	return p
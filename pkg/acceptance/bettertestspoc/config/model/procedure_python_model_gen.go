@@ -34,6 +34,8 @@ type ProcedurePythonModel struct {
 	RuntimeVersion             tfconfig.Variable `json:"runtime_version,omitempty"`
 	Secrets                    tfconfig.Variable `json:"secrets,omitempty"`
 	SnowparkPackage            tfconfig.Variable `json:"snowpark_package,omitempty"`
+	Tags                       tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                    tfconfig.Variable `json:"tags_all,omitempty"`
 	TraceLevel                 tfconfig.Variable `json:"trace_level,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -222,6 +224,10 @@ func (p *ProcedurePythonModel) WithSnowparkPackage(snowparkPackage string) *Proc
 	return p
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (p *ProcedurePythonModel) WithTraceLevel(traceLevel string) *ProcedurePythonModel {
 	p.TraceLevel = tfconfig.StringVariable(traceLevel)
 	return p
@@ -346,6 +352,16 @@ func (p *ProcedurePythonModel) WithSnowparkPackageValue(value tfconfig.Variable)
 	return p
 }
 
+func (p *ProcedurePythonModel) WithTagsValue(value tfconfig.Variable) *ProcedurePythonModel {
+	p.Tags = value
+	return p
+}
+
+func (p *ProcedurePythonModel) WithTagsAllValue(value tfconfig.Variable) *ProcedurePythonModel {
+	p.TagsAll = value
+	return p
+}
+
 func (p *ProcedurePythonModel) WithTraceLevelValue(value tfconfig.Variable) *ProcedurePythonModel {
 	p.TraceLevel = value
 	return p
@@ -34,6 +34,8 @@ type ProcedureScalaModel struct {
 	RuntimeVersion             tfconfig.Variable `json:"runtime_version,omitempty"`
 	Secrets                    tfconfig.Variable `json:"secrets,omitempty"`
 	SnowparkPackage            tfconfig.Variable `json:"snowpark_package,omitempty"`
+	Tags                       tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                    tfconfig.Variable `json:"tags_all,omitempty"`
 	TargetPath                 tfconfig.Variable `json:"target_path,omitempty"`
 	TraceLevel                 tfconfig.Variable `json:"trace_level,omitempty"`
 
@@ -223,6 +225,10 @@ func (p *ProcedureScalaModel) WithSnowparkPackage(snowparkPackage string) *Proce
 	return p
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 // target_path attribute type is not yet supported, so WithTargetPath can't be generated
 
 func (p *ProcedureScalaModel) WithTraceLevel(traceLevel string) *ProcedureScalaModel {
@@ -349,6 +355,16 @@ func (p *ProcedureScalaModel) WithSnowparkPackageValue(value tfconfig.Variable)
 	return p
 }
 
+func (p *ProcedureScalaModel) WithTagsValue(value tfconfig.Variable) *ProcedureScalaModel {
+	p.Tags = value
+	return p
+}
+
+func (p *ProcedureScalaModel) WithTagsAllValue(value tfconfig.Variable) *ProcedureScalaModel {
+	p.TagsAll = value
+	return p
+}
+
 func (p *ProcedureScalaModel) WithTargetPathValue(value tfconfig.Variable) *ProcedureScalaModel {
 	p.TargetPath = value
 	return p
@@ -27,6 +27,8 @@ type ProcedureSqlModel struct {
 	ProcedureDefinition tfconfig.Variable `json:"procedure_definition,omitempty"`
 	ProcedureLanguage   tfconfig.Variable `json:"procedure_language,omitempty"`
 	ReturnType          tfconfig.Variable `json:"return_type,omitempty"`
+	Tags                tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll             tfconfig.Variable `json:"tags_all,omitempty"`
 	TraceLevel          tfconfig.Variable `json:"trace_level,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -184,6 +186,10 @@ func (p *ProcedureSqlModel) WithReturnType(returnType string) *ProcedureSqlModel
 	return p
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (p *ProcedureSqlModel) WithTraceLevel(traceLevel string) *ProcedureSqlModel {
 	p.TraceLevel = tfconfig.StringVariable(traceLevel)
 	return p
@@ -273,6 +279,16 @@ func (p *ProcedureSqlModel) WithReturnTypeValue(value tfconfig.Variable) *Proced
 	return p
 }
 
+func (p *ProcedureSqlModel) WithTagsValue(value tfconfig.Variable) *ProcedureSqlModel {
+	p.Tags = value
+	return p
+}
+
+func (p *ProcedureSqlModel) WithTagsAllValue(value tfconfig.Variable) *ProcedureSqlModel {
+	p.TagsAll = value
+	return p
+}
+
 func (p *ProcedureSqlModel) WithTraceLevelValue(value tfconfig.Variable) *ProcedureSqlModel {
 	p.TraceLevel = value
 	return p
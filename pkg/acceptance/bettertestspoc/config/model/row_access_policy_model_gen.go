@@ -19,6 +19,8 @@ type RowAccessPolicyModel struct {
 	Body               tfconfig.Variable `json:"body,omitempty"`
 	Comment            tfconfig.Variable `json:"comment,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll            tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -130,6 +132,10 @@ func (r *RowAccessPolicyModel) WithFullyQualifiedName(fullyQualifiedName string)
 	return r
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -168,3 +174,13 @@ func (r *RowAccessPolicyModel) WithFullyQualifiedNameValue(value tfconfig.Variab
 	r.FullyQualifiedName = value
 	return r
 }
+
+func (r *RowAccessPolicyModel) WithTagsValue(value tfconfig.Variable) *RowAccessPolicyModel {
+	r.Tags = value
+	return r
+}
+
+func (r *RowAccessPolicyModel) WithTagsAllValue(value tfconfig.Variable) *RowAccessPolicyModel {
+	r.TagsAll = value
+	return r
+}
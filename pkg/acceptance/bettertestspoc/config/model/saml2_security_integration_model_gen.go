@@ -29,6 +29,8 @@ type Saml2SecurityIntegrationModel struct {
 	Saml2SpInitiatedLoginPageLabel tfconfig.Variable `json:"saml2_sp_initiated_login_page_label,omitempty"`
 	Saml2SsoUrl                    tfconfig.Variable `json:"saml2_sso_url,omitempty"`
 	Saml2X509Cert                  tfconfig.Variable `json:"saml2_x509_cert,omitempty"`
+	Tags                           tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                        tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -192,6 +194,10 @@ func (s *Saml2SecurityIntegrationModel) WithSaml2X509Cert(saml2X509Cert string)
 	return s
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -285,3 +291,13 @@ func (s *Saml2SecurityIntegrationModel) WithSaml2X509CertValue(value tfconfig.Va
 	s.Saml2X509Cert = value
 	return s
 }
+
+func (s *Saml2SecurityIntegrationModel) WithTagsValue(value tfconfig.Variable) *Saml2SecurityIntegrationModel {
+	s.Tags = value
+	return s
+}
+
+func (s *Saml2SecurityIntegrationModel) WithTagsAllValue(value tfconfig.Variable) *Saml2SecurityIntegrationModel {
+	s.TagsAll = value
+	return s
+}
@@ -19,6 +19,8 @@ type ScimSecurityIntegrationModel struct {
 	RunAsRole          tfconfig.Variable `json:"run_as_role,omitempty"`
 	ScimClient         tfconfig.Variable `json:"scim_client,omitempty"`
 	SyncPassword       tfconfig.Variable `json:"sync_password,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll            tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -134,6 +136,10 @@ func (s *ScimSecurityIntegrationModel) WithSyncPassword(syncPassword string) *Sc
 	return s
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -177,3 +183,13 @@ func (s *ScimSecurityIntegrationModel) WithSyncPasswordValue(value tfconfig.Vari
 	s.SyncPassword = value
 	return s
 }
+
+func (s *ScimSecurityIntegrationModel) WithTagsValue(value tfconfig.Variable) *ScimSecurityIntegrationModel {
+	s.Tags = value
+	return s
+}
+
+func (s *ScimSecurityIntegrationModel) WithTagsAllValue(value tfconfig.Variable) *ScimSecurityIntegrationModel {
+	s.TagsAll = value
+	return s
+}
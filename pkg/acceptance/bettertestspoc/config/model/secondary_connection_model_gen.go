@@ -16,6 +16,7 @@ type SecondaryConnectionModel struct {
 	Comment            tfconfig.Variable `json:"comment,omitempty"`
 	FullyQualifiedName tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	IsPrimary          tfconfig.Variable `json:"is_primary,omitempty"`
+	PrimaryAccount     tfconfig.Variable `json:"primary_account,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -108,6 +109,11 @@ func (s *SecondaryConnectionModel) WithIsPrimary(isPrimary bool) *SecondaryConne
 	return s
 }
 
+func (s *SecondaryConnectionModel) WithPrimaryAccount(primaryAccount string) *SecondaryConnectionModel {
+	s.PrimaryAccount = tfconfig.StringVariable(primaryAccount)
+	return s
+}
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -136,3 +142,8 @@ func (s *SecondaryConnectionModel) WithIsPrimaryValue(value tfconfig.Variable) *
 	s.IsPrimary = value
 	return s
 }
+
+func (s *SecondaryConnectionModel) WithPrimaryAccountValue(value tfconfig.Variable) *SecondaryConnectionModel {
+	s.PrimaryAccount = value
+	return s
+}
@@ -68,6 +68,8 @@ type ServiceUserModel struct {
 	StatementQueuedTimeoutInSeconds          tfconfig.Variable `json:"statement_queued_timeout_in_seconds,omitempty"`
 	StatementTimeoutInSeconds                tfconfig.Variable `json:"statement_timeout_in_seconds,omitempty"`
 	StrictJsonOutput                         tfconfig.Variable `json:"strict_json_output,omitempty"`
+	Tags                                     tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                                  tfconfig.Variable `json:"tags_all,omitempty"`
 	TimeInputFormat                          tfconfig.Variable `json:"time_input_format,omitempty"`
 	TimeOutputFormat                         tfconfig.Variable `json:"time_output_format,omitempty"`
 	TimestampDayIsAlways24h                  tfconfig.Variable `json:"timestamp_day_is_always_24h,omitempty"`
@@ -432,6 +434,10 @@ func (s *ServiceUserModel) WithStrictJsonOutput(strictJsonOutput bool) *ServiceU
 	return s
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (s *ServiceUserModel) WithTimeInputFormat(timeInputFormat string) *ServiceUserModel {
 	s.TimeInputFormat = tfconfig.StringVariable(timeInputFormat)
 	return s
@@ -816,6 +822,16 @@ func (s *ServiceUserModel) WithStrictJsonOutputValue(value tfconfig.Variable) *S
 	return s
 }
 
+func (s *ServiceUserModel) WithTagsValue(value tfconfig.Variable) *ServiceUserModel {
+	s.Tags = value
+	return s
+}
+
+func (s *ServiceUserModel) WithTagsAllValue(value tfconfig.Variable) *ServiceUserModel {
+	s.TagsAll = value
+	return s
+}
+
 func (s *ServiceUserModel) WithTimeInputFormatValue(value tfconfig.Variable) *ServiceUserModel {
 	s.TimeInputFormat = value
 	return s
@@ -25,6 +25,8 @@ type StageModel struct {
 	SnowflakeIamUser   tfconfig.Variable `json:"snowflake_iam_user,omitempty"`
 	StorageIntegration tfconfig.Variable `json:"storage_integration,omitempty"`
 	Tag                tfconfig.Variable `json:"tag,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll            tfconfig.Variable `json:"tags_all,omitempty"`
 	Url                tfconfig.Variable `json:"url,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -164,6 +166,10 @@ func (s *StageModel) WithStorageIntegration(storageIntegration string) *StageMod
 
 // tag attribute type is not yet supported, so WithTag can't be generated
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (s *StageModel) WithUrl(url string) *StageModel {
 	s.Url = tfconfig.StringVariable(url)
 	return s
@@ -243,6 +249,16 @@ func (s *StageModel) WithTagValue(value tfconfig.Variable) *StageModel {
 	return s
 }
 
+func (s *StageModel) WithTagsValue(value tfconfig.Variable) *StageModel {
+	s.Tags = value
+	return s
+}
+
+func (s *StageModel) WithTagsAllValue(value tfconfig.Variable) *StageModel {
+	s.TagsAll = value
+	return s
+}
+
 func (s *StageModel) WithUrlValue(value tfconfig.Variable) *StageModel {
 	s.Url = value
 	return s
@@ -22,6 +22,8 @@ type StorageIntegrationAwsModel struct {
 	StorageAwsRoleArn       tfconfig.Variable `json:"storage_aws_role_arn,omitempty"`
 	StorageBlockedLocations tfconfig.Variable `json:"storage_blocked_locations,omitempty"`
 	StorageProvider         tfconfig.Variable `json:"storage_provider,omitempty"`
+	Tags                    tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                 tfconfig.Variable `json:"tags_all,omitempty"`
 	UsePrivatelinkEndpoint  tfconfig.Variable `json:"use_privatelink_endpoint,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -146,6 +148,10 @@ func (s *StorageIntegrationAwsModel) WithStorageProvider(storageProvider string)
 	return s
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (s *StorageIntegrationAwsModel) WithUsePrivatelinkEndpoint(usePrivatelinkEndpoint string) *StorageIntegrationAwsModel {
 	s.UsePrivatelinkEndpoint = tfconfig.StringVariable(usePrivatelinkEndpoint)
 	return s
@@ -205,6 +211,16 @@ func (s *StorageIntegrationAwsModel) WithStorageProviderValue(value tfconfig.Var
 	return s
 }
 
+func (s *StorageIntegrationAwsModel) WithTagsValue(value tfconfig.Variable) *StorageIntegrationAwsModel {
+	s.Tags = value
+	return s
+}
+
+func (s *StorageIntegrationAwsModel) WithTagsAllValue(value tfconfig.Variable) *StorageIntegrationAwsModel {
+	s.TagsAll = value
+	return s
+}
+
 func (s *StorageIntegrationAwsModel) WithUsePrivatelinkEndpointValue(value tfconfig.Variable) *StorageIntegrationAwsModel {
 	s.UsePrivatelinkEndpoint = value
 	return s
@@ -19,6 +19,8 @@ type StorageIntegrationAzureModel struct {
 	FullyQualifiedName      tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	StorageAllowedLocations tfconfig.Variable `json:"storage_allowed_locations,omitempty"`
 	StorageBlockedLocations tfconfig.Variable `json:"storage_blocked_locations,omitempty"`
+	Tags                    tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                 tfconfig.Variable `json:"tags_all,omitempty"`
 	UsePrivatelinkEndpoint  tfconfig.Variable `json:"use_privatelink_endpoint,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -124,6 +126,10 @@ func (s *StorageIntegrationAzureModel) WithFullyQualifiedName(fullyQualifiedName
 
 // storage_blocked_locations attribute type is not yet supported, so WithStorageBlockedLocations can't be generated
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (s *StorageIntegrationAzureModel) WithUsePrivatelinkEndpoint(usePrivatelinkEndpoint string) *StorageIntegrationAzureModel {
 	s.UsePrivatelinkEndpoint = tfconfig.StringVariable(usePrivatelinkEndpoint)
 	return s
@@ -168,6 +174,16 @@ func (s *StorageIntegrationAzureModel) WithStorageBlockedLocationsValue(value tf
 	return s
 }
 
+func (s *StorageIntegrationAzureModel) WithTagsValue(value tfconfig.Variable) *StorageIntegrationAzureModel {
+	s.Tags = value
+	return s
+}
+
+func (s *StorageIntegrationAzureModel) WithTagsAllValue(value tfconfig.Variable) *StorageIntegrationAzureModel {
+	s.TagsAll = value
+	return s
+}
+
 func (s *StorageIntegrationAzureModel) WithUsePrivatelinkEndpointValue(value tfconfig.Variable) *StorageIntegrationAzureModel {
 	s.UsePrivatelinkEndpoint = value
 	return s
@@ -18,6 +18,8 @@ type StorageIntegrationGcsModel struct {
 	FullyQualifiedName      tfconfig.Variable `json:"fully_qualified_name,omitempty"`
 	StorageAllowedLocations tfconfig.Variable `json:"storage_allowed_locations,omitempty"`
 	StorageBlockedLocations tfconfig.Variable `json:"storage_blocked_locations,omitempty"`
+	Tags                    tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                 tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -113,6 +115,10 @@ func (s *StorageIntegrationGcsModel) WithFullyQualifiedName(fullyQualifiedName s
 
 // storage_blocked_locations attribute type is not yet supported, so WithStorageBlockedLocations can't be generated
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -146,3 +152,13 @@ func (s *StorageIntegrationGcsModel) WithStorageBlockedLocationsValue(value tfco
 	s.StorageBlockedLocations = value
 	return s
 }
+
+func (s *StorageIntegrationGcsModel) WithTagsValue(value tfconfig.Variable) *StorageIntegrationGcsModel {
+	s.Tags = value
+	return s
+}
+
+func (s *StorageIntegrationGcsModel) WithTagsAllValue(value tfconfig.Variable) *StorageIntegrationGcsModel {
+	s.TagsAll = value
+	return s
+}
@@ -27,6 +27,8 @@ type StorageIntegrationModel struct {
 	StorageBlockedLocations  tfconfig.Variable `json:"storage_blocked_locations,omitempty"`
 	StorageGcpServiceAccount tfconfig.Variable `json:"storage_gcp_service_account,omitempty"`
 	StorageProvider          tfconfig.Variable `json:"storage_provider,omitempty"`
+	Tags                     tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                  tfconfig.Variable `json:"tags_all,omitempty"`
 	Type_                    tfconfig.Variable `json:"type,omitempty"`
 	UsePrivatelinkEndpoint   tfconfig.Variable `json:"use_privatelink_endpoint,omitempty"`
 
@@ -174,6 +176,10 @@ func (s *StorageIntegrationModel) WithStorageProvider(storageProvider string) *S
 	return s
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (s *StorageIntegrationModel) WithType(type_ string) *StorageIntegrationModel {
 	s.Type_ = tfconfig.StringVariable(type_)
 	return s
@@ -268,6 +274,16 @@ func (s *StorageIntegrationModel) WithStorageProviderValue(value tfconfig.Variab
 	return s
 }
 
+func (s *StorageIntegrationModel) WithTagsValue(value tfconfig.Variable) *StorageIntegrationModel {
+	s.Tags = value
+	return s
+}
+
+func (s *StorageIntegrationModel) WithTagsAllValue(value tfconfig.Variable) *StorageIntegrationModel {
+	s.TagsAll = value
+	return s
+}
+
 func (s *StorageIntegrationModel) WithTypeValue(value tfconfig.Variable) *StorageIntegrationModel {
 	s.Type_ = value
 	return s
@@ -20,6 +20,8 @@ type StreamOnDirectoryTableModel struct {
 	Stage              tfconfig.Variable `json:"stage,omitempty"`
 	Stale              tfconfig.Variable `json:"stale,omitempty"`
 	StreamType         tfconfig.Variable `json:"stream_type,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll            tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -140,6 +142,10 @@ func (s *StreamOnDirectoryTableModel) WithStreamType(streamType string) *StreamO
 	return s
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -188,3 +194,13 @@ func (s *StreamOnDirectoryTableModel) WithStreamTypeValue(value tfconfig.Variabl
 	s.StreamType = value
 	return s
 }
+
+func (s *StreamOnDirectoryTableModel) WithTagsValue(value tfconfig.Variable) *StreamOnDirectoryTableModel {
+	s.Tags = value
+	return s
+}
+
+func (s *StreamOnDirectoryTableModel) WithTagsAllValue(value tfconfig.Variable) *StreamOnDirectoryTableModel {
+	s.TagsAll = value
+	return s
+}
@@ -23,6 +23,8 @@ type StreamOnExternalTableModel struct {
 	InsertOnly         tfconfig.Variable `json:"insert_only,omitempty"`
 	Stale              tfconfig.Variable `json:"stale,omitempty"`
 	StreamType         tfconfig.Variable `json:"stream_type,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll            tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -152,6 +154,10 @@ func (s *StreamOnExternalTableModel) WithStreamType(streamType string) *StreamOn
 	return s
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -215,3 +221,13 @@ func (s *StreamOnExternalTableModel) WithStreamTypeValue(value tfconfig.Variable
 	s.StreamType = value
 	return s
 }
+
+func (s *StreamOnExternalTableModel) WithTagsValue(value tfconfig.Variable) *StreamOnExternalTableModel {
+	s.Tags = value
+	return s
+}
+
+func (s *StreamOnExternalTableModel) WithTagsAllValue(value tfconfig.Variable) *StreamOnExternalTableModel {
+	s.TagsAll = value
+	return s
+}
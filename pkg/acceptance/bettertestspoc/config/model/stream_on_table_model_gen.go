@@ -24,6 +24,8 @@ type StreamOnTableModel struct {
 	Stale              tfconfig.Variable `json:"stale,omitempty"`
 	StreamType         tfconfig.Variable `json:"stream_type,omitempty"`
 	Table              tfconfig.Variable `json:"table,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll            tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -158,6 +160,10 @@ func (s *StreamOnTableModel) WithTable(table string) *StreamOnTableModel {
 	return s
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -226,3 +232,13 @@ func (s *StreamOnTableModel) WithTableValue(value tfconfig.Variable) *StreamOnTa
 	s.Table = value
 	return s
 }
+
+func (s *StreamOnTableModel) WithTagsValue(value tfconfig.Variable) *StreamOnTableModel {
+	s.Tags = value
+	return s
+}
+
+func (s *StreamOnTableModel) WithTagsAllValue(value tfconfig.Variable) *StreamOnTableModel {
+	s.TagsAll = value
+	return s
+}
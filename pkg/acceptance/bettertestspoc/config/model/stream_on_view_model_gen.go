@@ -23,6 +23,8 @@ type StreamOnViewModel struct {
 	ShowInitialRows    tfconfig.Variable `json:"show_initial_rows,omitempty"`
 	Stale              tfconfig.Variable `json:"stale,omitempty"`
 	StreamType         tfconfig.Variable `json:"stream_type,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll            tfconfig.Variable `json:"tags_all,omitempty"`
 	View               tfconfig.Variable `json:"view,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
@@ -153,6 +155,10 @@ func (s *StreamOnViewModel) WithStreamType(streamType string) *StreamOnViewModel
 	return s
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (s *StreamOnViewModel) WithView(view string) *StreamOnViewModel {
 	s.View = tfconfig.StringVariable(view)
 	return s
@@ -222,6 +228,16 @@ func (s *StreamOnViewModel) WithStreamTypeValue(value tfconfig.Variable) *Stream
 	return s
 }
 
+func (s *StreamOnViewModel) WithTagsValue(value tfconfig.Variable) *StreamOnViewModel {
+	s.Tags = value
+	return s
+}
+
+func (s *StreamOnViewModel) WithTagsAllValue(value tfconfig.Variable) *StreamOnViewModel {
+	s.TagsAll = value
+	return s
+}
+
 func (s *StreamOnViewModel) WithViewValue(value tfconfig.Variable) *StreamOnViewModel {
 	s.View = value
 	return s
@@ -40,3 +40,20 @@ func (t *TableModel) WithColumn(column []sdk.TableColumnSignature) *TableModel {
 	t.Column = tfconfig.SetVariable(maps...)
 	return t
 }
+
+func (t *TableModel) WithTags(tags map[string]string) *TableModel {
+	tagVariables := make(map[string]tfconfig.Variable, len(tags))
+	for tagName, value := range tags {
+		tagVariables[tagName] = tfconfig.StringVariable(value)
+	}
+	return t.WithTagsValue(tfconfig.MapVariable(tagVariables))
+}
+
+func (t *TableModel) WithLegacyTag(tagId sdk.SchemaObjectIdentifier, value string) *TableModel {
+	return t.WithTagValue(tfconfig.ListVariable(tfconfig.MapVariable(map[string]tfconfig.Variable{
+		"database": tfconfig.StringVariable(tagId.DatabaseName()),
+		"schema":   tfconfig.StringVariable(tagId.SchemaName()),
+		"name":     tfconfig.StringVariable(tagId.Name()),
+		"value":    tfconfig.StringVariable(value),
+	})))
+}
@@ -15,15 +15,20 @@ type TableModel struct {
 	Database                tfconfig.Variable `json:"database,omitempty"`
 	Schema                  tfconfig.Variable `json:"schema,omitempty"`
 	Name                    tfconfig.Variable `json:"name,omitempty"`
+	AggregationPolicy       tfconfig.Variable `json:"aggregation_policy,omitempty"`
 	ChangeTracking          tfconfig.Variable `json:"change_tracking,omitempty"`
 	ClusterBy               tfconfig.Variable `json:"cluster_by,omitempty"`
 	Column                  tfconfig.Variable `json:"column,omitempty"`
 	Comment                 tfconfig.Variable `json:"comment,omitempty"`
 	DataRetentionTimeInDays tfconfig.Variable `json:"data_retention_time_in_days,omitempty"`
 	FullyQualifiedName      tfconfig.Variable `json:"fully_qualified_name,omitempty"`
+	JoinPolicy              tfconfig.Variable `json:"join_policy,omitempty"`
 	Owner                   tfconfig.Variable `json:"owner,omitempty"`
 	PrimaryKey              tfconfig.Variable `json:"primary_key,omitempty"`
+	RowAccessPolicy         tfconfig.Variable `json:"row_access_policy,omitempty"`
 	Tag                     tfconfig.Variable `json:"tag,omitempty"`
+	Tags                    tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                 tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -114,6 +119,8 @@ func (t *TableModel) WithName(name string) *TableModel {
 	return t
 }
 
+// aggregation_policy attribute type is not yet supported, so WithAggregationPolicy can't be generated
+
 func (t *TableModel) WithChangeTracking(changeTracking bool) *TableModel {
 	t.ChangeTracking = tfconfig.BoolVariable(changeTracking)
 	return t
@@ -138,6 +145,8 @@ func (t *TableModel) WithFullyQualifiedName(fullyQualifiedName string) *TableMod
 	return t
 }
 
+// join_policy attribute type is not yet supported, so WithJoinPolicy can't be generated
+
 func (t *TableModel) WithOwner(owner string) *TableModel {
 	t.Owner = tfconfig.StringVariable(owner)
 	return t
@@ -145,8 +154,14 @@ func (t *TableModel) WithOwner(owner string) *TableModel {
 
 // primary_key attribute type is not yet supported, so WithPrimaryKey can't be generated
 
+// row_access_policy attribute type is not yet supported, so WithRowAccessPolicy can't be generated
+
 // tag attribute type is not yet supported, so WithTag can't be generated
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -166,6 +181,11 @@ func (t *TableModel) WithNameValue(value tfconfig.Variable) *TableModel {
 	return t
 }
 
+func (t *TableModel) WithAggregationPolicyValue(value tfconfig.Variable) *TableModel {
+	t.AggregationPolicy = value
+	return t
+}
+
 func (t *TableModel) WithChangeTrackingValue(value tfconfig.Variable) *TableModel {
 	t.ChangeTracking = value
 	return t
@@ -196,6 +216,11 @@ func (t *TableModel) WithFullyQualifiedNameValue(value tfconfig.Variable) *Table
 	return t
 }
 
+func (t *TableModel) WithJoinPolicyValue(value tfconfig.Variable) *TableModel {
+	t.JoinPolicy = value
+	return t
+}
+
 func (t *TableModel) WithOwnerValue(value tfconfig.Variable) *TableModel {
 	t.Owner = value
 	return t
@@ -206,7 +231,22 @@ func (t *TableModel) WithPrimaryKeyValue(value tfconfig.Variable) *TableModel {
 	return t
 }
 
+func (t *TableModel) WithRowAccessPolicyValue(value tfconfig.Variable) *TableModel {
+	t.RowAccessPolicy = value
+	return t
+}
+
 func (t *TableModel) WithTagValue(value tfconfig.Variable) *TableModel {
 	t.Tag = value
 	return t
 }
+
+func (t *TableModel) WithTagsValue(value tfconfig.Variable) *TableModel {
+	t.Tags = value
+	return t
+}
+
+func (t *TableModel) WithTagsAllValue(value tfconfig.Variable) *TableModel {
+	t.TagsAll = value
+	return t
+}
@@ -63,6 +63,8 @@ type TaskModel struct {
 	StatementTimeoutInSeconds                tfconfig.Variable `json:"statement_timeout_in_seconds,omitempty"`
 	StrictJsonOutput                         tfconfig.Variable `json:"strict_json_output,omitempty"`
 	SuspendTaskAfterNumFailures              tfconfig.Variable `json:"suspend_task_after_num_failures,omitempty"`
+	Tags                                     tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                                  tfconfig.Variable `json:"tags_all,omitempty"`
 	TargetCompletionInterval                 tfconfig.Variable `json:"target_completion_interval,omitempty"`
 	TaskAutoRetryAttempts                    tfconfig.Variable `json:"task_auto_retry_attempts,omitempty"`
 	TimeInputFormat                          tfconfig.Variable `json:"time_input_format,omitempty"`
@@ -421,6 +423,10 @@ func (t *TaskModel) WithSuspendTaskAfterNumFailures(suspendTaskAfterNumFailures
 	return t
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 // target_completion_interval attribute type is not yet supported, so WithTargetCompletionInterval can't be generated
 
 func (t *TaskModel) WithTaskAutoRetryAttempts(taskAutoRetryAttempts int) *TaskModel {
@@ -807,6 +813,16 @@ func (t *TaskModel) WithSuspendTaskAfterNumFailuresValue(value tfconfig.Variable
 	return t
 }
 
+func (t *TaskModel) WithTagsValue(value tfconfig.Variable) *TaskModel {
+	t.Tags = value
+	return t
+}
+
+func (t *TaskModel) WithTagsAllValue(value tfconfig.Variable) *TaskModel {
+	t.TagsAll = value
+	return t
+}
+
 func (t *TaskModel) WithTargetCompletionIntervalValue(value tfconfig.Variable) *TaskModel {
 	t.TargetCompletionInterval = value
 	return t
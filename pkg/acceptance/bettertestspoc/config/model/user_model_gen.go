@@ -74,6 +74,8 @@ type UserModel struct {
 	StatementQueuedTimeoutInSeconds          tfconfig.Variable `json:"statement_queued_timeout_in_seconds,omitempty"`
 	StatementTimeoutInSeconds                tfconfig.Variable `json:"statement_timeout_in_seconds,omitempty"`
 	StrictJsonOutput                         tfconfig.Variable `json:"strict_json_output,omitempty"`
+	Tags                                     tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                                  tfconfig.Variable `json:"tags_all,omitempty"`
 	TimeInputFormat                          tfconfig.Variable `json:"time_input_format,omitempty"`
 	TimeOutputFormat                         tfconfig.Variable `json:"time_output_format,omitempty"`
 	TimestampDayIsAlways24h                  tfconfig.Variable `json:"timestamp_day_is_always_24h,omitempty"`
@@ -471,6 +473,10 @@ func (u *UserModel) WithStrictJsonOutput(strictJsonOutput bool) *UserModel {
 	return u
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (u *UserModel) WithTimeInputFormat(timeInputFormat string) *UserModel {
 	u.TimeInputFormat = tfconfig.StringVariable(timeInputFormat)
 	return u
@@ -885,6 +891,16 @@ func (u *UserModel) WithStrictJsonOutputValue(value tfconfig.Variable) *UserMode
 	return u
 }
 
+func (u *UserModel) WithTagsValue(value tfconfig.Variable) *UserModel {
+	u.Tags = value
+	return u
+}
+
+func (u *UserModel) WithTagsAllValue(value tfconfig.Variable) *UserModel {
+	u.TagsAll = value
+	return u
+}
+
 func (u *UserModel) WithTimeInputFormatValue(value tfconfig.Variable) *UserModel {
 	u.TimeInputFormat = value
 	return u
@@ -27,6 +27,8 @@ type ViewModel struct {
 	IsTemporary        tfconfig.Variable `json:"is_temporary,omitempty"`
 	RowAccessPolicy    tfconfig.Variable `json:"row_access_policy,omitempty"`
 	Statement          tfconfig.Variable `json:"statement,omitempty"`
+	Tags               tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll            tfconfig.Variable `json:"tags_all,omitempty"`
 
 	DynamicBlock *config.DynamicBlock `json:"dynamic,omitempty"`
 
@@ -167,6 +169,10 @@ func (v *ViewModel) WithStatement(statement string) *ViewModel {
 	return v
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 //////////////////////////////////////////
 // below it's possible to set any value //
 //////////////////////////////////////////
@@ -250,3 +256,13 @@ func (v *ViewModel) WithStatementValue(value tfconfig.Variable) *ViewModel {
 	v.Statement = value
 	return v
 }
+
+func (v *ViewModel) WithTagsValue(value tfconfig.Variable) *ViewModel {
+	v.Tags = value
+	return v
+}
+
+func (v *ViewModel) WithTagsAllValue(value tfconfig.Variable) *ViewModel {
+	v.TagsAll = value
+	return v
+}
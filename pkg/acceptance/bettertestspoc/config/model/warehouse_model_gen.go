@@ -28,6 +28,8 @@ type WarehouseModel struct {
 	ScalingPolicy                   tfconfig.Variable `json:"scaling_policy,omitempty"`
 	StatementQueuedTimeoutInSeconds tfconfig.Variable `json:"statement_queued_timeout_in_seconds,omitempty"`
 	StatementTimeoutInSeconds       tfconfig.Variable `json:"statement_timeout_in_seconds,omitempty"`
+	Tags                            tfconfig.Variable `json:"tags,omitempty"`
+	TagsAll                         tfconfig.Variable `json:"tags_all,omitempty"`
 	WarehouseSize                   tfconfig.Variable `json:"warehouse_size,omitempty"`
 	WarehouseType                   tfconfig.Variable `json:"warehouse_type,omitempty"`
 
@@ -178,6 +180,10 @@ func (w *WarehouseModel) WithStatementTimeoutInSeconds(statementTimeoutInSeconds
 	return w
 }
 
+// tags attribute type is not yet supported, so WithTags can't be generated
+
+// tags_all attribute type is not yet supported, so WithTagsAll can't be generated
+
 func (w *WarehouseModel) WithWarehouseSize(warehouseSize string) *WarehouseModel {
 	w.WarehouseSize = tfconfig.StringVariable(warehouseSize)
 	return w
@@ -277,6 +283,16 @@ func (w *WarehouseModel) WithStatementTimeoutInSecondsValue(value tfconfig.Varia
 	return w
 }
 
+func (w *WarehouseModel) WithTagsValue(value tfconfig.Variable) *WarehouseModel {
+	w.Tags = value
+	return w
+}
+
+func (w *WarehouseModel) WithTagsAllValue(value tfconfig.Variable) *WarehouseModel {
+	w.TagsAll = value
+	return w
+}
+
 func (w *WarehouseModel) WithWarehouseSizeValue(value tfconfig.Variable) *WarehouseModel {
 	w.WarehouseSize = value
 	return w
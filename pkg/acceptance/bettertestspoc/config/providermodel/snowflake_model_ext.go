@@ -76,6 +76,16 @@ func (m *SnowflakeModel) WithDisableConsoleLoginBool(disableConsoleLogin bool) *
 	return m
 }
 
+func (m *SnowflakeModel) WithDefaultTags(tags map[string]string) *SnowflakeModel {
+	tagVariables := make(map[string]tfconfig.Variable, len(tags))
+	for tagName, value := range tags {
+		tagVariables[tagName] = tfconfig.StringVariable(value)
+	}
+	return m.WithDefaultTagsValue(tfconfig.ObjectVariable(map[string]tfconfig.Variable{
+		"tags": tfconfig.MapVariable(tagVariables),
+	}))
+}
+
 func (m *SnowflakeModel) WithPreviewFeaturesEnabled(previewFeaturesEnabled ...string) *SnowflakeModel {
 	previewFeaturesEnabledStringVariables := make([]tfconfig.Variable, len(previewFeaturesEnabled))
 	for i, v := range previewFeaturesEnabled {
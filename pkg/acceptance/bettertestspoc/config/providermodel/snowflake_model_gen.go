@@ -20,6 +20,7 @@ type SnowflakeModel struct {
 	CrlHttpClientTimeout               tfconfig.Variable `json:"crl_http_client_timeout,omitempty"`
 	CrlInMemoryCacheDisabled           tfconfig.Variable `json:"crl_in_memory_cache_disabled,omitempty"`
 	CrlOnDiskCacheDisabled             tfconfig.Variable `json:"crl_on_disk_cache_disabled,omitempty"`
+	DefaultTags                        tfconfig.Variable `json:"default_tags,omitempty"`
 	DisableConsoleLogin                tfconfig.Variable `json:"disable_console_login,omitempty"`
 	DisableOcspChecks                  tfconfig.Variable `json:"disable_ocsp_checks,omitempty"`
 	DisableQueryContextCache           tfconfig.Variable `json:"disable_query_context_cache,omitempty"`
@@ -64,9 +65,17 @@ type SnowflakeModel struct {
 	ProxyPort                          tfconfig.Variable `json:"proxy_port,omitempty"`
 	ProxyProtocol                      tfconfig.Variable `json:"proxy_protocol,omitempty"`
 	ProxyUser                          tfconfig.Variable `json:"proxy_user,omitempty"`
+	QueryTag                           tfconfig.Variable `json:"query_tag,omitempty"`
+	QueryTagIncludeMetadata            tfconfig.Variable `json:"query_tag_include_metadata,omitempty"`
+	QueryTagWorkspace                  tfconfig.Variable `json:"query_tag_workspace,omitempty"`
 	RequestTimeout                     tfconfig.Variable `json:"request_timeout,omitempty"`
 	Role                               tfconfig.Variable `json:"role,omitempty"`
 	SkipTomlFilePermissionVerification tfconfig.Variable `json:"skip_toml_file_permission_verification,omitempty"`
+	SqlAuditLogPath                    tfconfig.Variable `json:"sql_audit_log_path,omitempty"`
+	SqlDryRun                          tfconfig.Variable `json:"sql_dry_run,omitempty"`
+	StatementRetryInitialBackoff       tfconfig.Variable `json:"statement_retry_initial_backoff,omitempty"`
+	StatementRetryMaxAttempts          tfconfig.Variable `json:"statement_retry_max_attempts,omitempty"`
+	StatementRetryMaxBackoff           tfconfig.Variable `json:"statement_retry_max_backoff,omitempty"`
 	TfcWorkloadIdentityTokenTag        tfconfig.Variable `json:"tfc_workload_identity_token_tag,omitempty"`
 	TmpDirectoryPath                   tfconfig.Variable `json:"tmp_directory_path,omitempty"`
 	Token                              tfconfig.Variable `json:"token,omitempty"`
@@ -161,6 +170,8 @@ func (s *SnowflakeModel) WithCrlOnDiskCacheDisabled(crlOnDiskCacheDisabled bool)
 	return s
 }
 
+// default_tags attribute type is not yet supported, so WithDefaultTags can't be generated
+
 func (s *SnowflakeModel) WithDisableConsoleLogin(disableConsoleLogin string) *SnowflakeModel {
 	s.DisableConsoleLogin = tfconfig.StringVariable(disableConsoleLogin)
 	return s
@@ -372,6 +383,21 @@ func (s *SnowflakeModel) WithProxyUser(proxyUser string) *SnowflakeModel {
 	return s
 }
 
+func (s *SnowflakeModel) WithQueryTag(queryTag string) *SnowflakeModel {
+	s.QueryTag = tfconfig.StringVariable(queryTag)
+	return s
+}
+
+func (s *SnowflakeModel) WithQueryTagIncludeMetadata(queryTagIncludeMetadata bool) *SnowflakeModel {
+	s.QueryTagIncludeMetadata = tfconfig.BoolVariable(queryTagIncludeMetadata)
+	return s
+}
+
+func (s *SnowflakeModel) WithQueryTagWorkspace(queryTagWorkspace string) *SnowflakeModel {
+	s.QueryTagWorkspace = tfconfig.StringVariable(queryTagWorkspace)
+	return s
+}
+
 func (s *SnowflakeModel) WithRequestTimeout(requestTimeout int) *SnowflakeModel {
 	s.RequestTimeout = tfconfig.IntegerVariable(requestTimeout)
 	return s
@@ -387,6 +413,31 @@ func (s *SnowflakeModel) WithSkipTomlFilePermissionVerification(skipTomlFilePerm
 	return s
 }
 
+func (s *SnowflakeModel) WithSqlAuditLogPath(sqlAuditLogPath string) *SnowflakeModel {
+	s.SqlAuditLogPath = tfconfig.StringVariable(sqlAuditLogPath)
+	return s
+}
+
+func (s *SnowflakeModel) WithSqlDryRun(sqlDryRun bool) *SnowflakeModel {
+	s.SqlDryRun = tfconfig.BoolVariable(sqlDryRun)
+	return s
+}
+
+func (s *SnowflakeModel) WithStatementRetryInitialBackoff(statementRetryInitialBackoff int) *SnowflakeModel {
+	s.StatementRetryInitialBackoff = tfconfig.IntegerVariable(statementRetryInitialBackoff)
+	return s
+}
+
+func (s *SnowflakeModel) WithStatementRetryMaxAttempts(statementRetryMaxAttempts int) *SnowflakeModel {
+	s.StatementRetryMaxAttempts = tfconfig.IntegerVariable(statementRetryMaxAttempts)
+	return s
+}
+
+func (s *SnowflakeModel) WithStatementRetryMaxBackoff(statementRetryMaxBackoff int) *SnowflakeModel {
+	s.StatementRetryMaxBackoff = tfconfig.IntegerVariable(statementRetryMaxBackoff)
+	return s
+}
+
 func (s *SnowflakeModel) WithTfcWorkloadIdentityTokenTag(tfcWorkloadIdentityTokenTag string) *SnowflakeModel {
 	s.TfcWorkloadIdentityTokenTag = tfconfig.StringVariable(tfcWorkloadIdentityTokenTag)
 	return s
@@ -498,6 +549,11 @@ func (s *SnowflakeModel) WithCrlOnDiskCacheDisabledValue(value tfconfig.Variable
 	return s
 }
 
+func (s *SnowflakeModel) WithDefaultTagsValue(value tfconfig.Variable) *SnowflakeModel {
+	s.DefaultTags = value
+	return s
+}
+
 func (s *SnowflakeModel) WithDisableConsoleLoginValue(value tfconfig.Variable) *SnowflakeModel {
 	s.DisableConsoleLogin = value
 	return s
@@ -718,6 +774,21 @@ func (s *SnowflakeModel) WithProxyUserValue(value tfconfig.Variable) *SnowflakeM
 	return s
 }
 
+func (s *SnowflakeModel) WithQueryTagValue(value tfconfig.Variable) *SnowflakeModel {
+	s.QueryTag = value
+	return s
+}
+
+func (s *SnowflakeModel) WithQueryTagIncludeMetadataValue(value tfconfig.Variable) *SnowflakeModel {
+	s.QueryTagIncludeMetadata = value
+	return s
+}
+
+func (s *SnowflakeModel) WithQueryTagWorkspaceValue(value tfconfig.Variable) *SnowflakeModel {
+	s.QueryTagWorkspace = value
+	return s
+}
+
 func (s *SnowflakeModel) WithRequestTimeoutValue(value tfconfig.Variable) *SnowflakeModel {
 	s.RequestTimeout = value
 	return s
@@ -733,6 +804,31 @@ func (s *SnowflakeModel) WithSkipTomlFilePermissionVerificationValue(value tfcon
 	return s
 }
 
+func (s *SnowflakeModel) WithSqlAuditLogPathValue(value tfconfig.Variable) *SnowflakeModel {
+	s.SqlAuditLogPath = value
+	return s
+}
+
+func (s *SnowflakeModel) WithSqlDryRunValue(value tfconfig.Variable) *SnowflakeModel {
+	s.SqlDryRun = value
+	return s
+}
+
+func (s *SnowflakeModel) WithStatementRetryInitialBackoffValue(value tfconfig.Variable) *SnowflakeModel {
+	s.StatementRetryInitialBackoff = value
+	return s
+}
+
+func (s *SnowflakeModel) WithStatementRetryMaxAttemptsValue(value tfconfig.Variable) *SnowflakeModel {
+	s.StatementRetryMaxAttempts = value
+	return s
+}
+
+func (s *SnowflakeModel) WithStatementRetryMaxBackoffValue(value tfconfig.Variable) *SnowflakeModel {
+	s.StatementRetryMaxBackoff = value
+	return s
+}
+
 func (s *SnowflakeModel) WithTfcWorkloadIdentityTokenTagValue(value tfconfig.Variable) *SnowflakeModel {
 	s.TfcWorkloadIdentityTokenTag = value
 	return s
@@ -2,14 +2,13 @@ package helpers
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-// TODO(SNOW-1564954): change raw sqls to proper client
 type AggregationPolicyClient struct {
 	context *TestClientContext
 	ids     *IdsGenerator
@@ -22,18 +21,33 @@ func NewAggregationPolicyClient(context *TestClientContext, idsGenerator *IdsGen
 	}
 }
 
-func (c *AggregationPolicyClient) client() *sdk.Client {
-	return c.context.client
+func (c *AggregationPolicyClient) client() sdk.AggregationPolicies {
+	return c.context.client.AggregationPolicies
 }
 
 func (c *AggregationPolicyClient) CreateAggregationPolicy(t *testing.T) (sdk.SchemaObjectIdentifier, func()) {
 	t.Helper()
-	ctx := context.Background()
 
 	id := c.ids.RandomSchemaObjectIdentifier()
-	_, err := c.client().ExecForTests(ctx, fmt.Sprintf(`CREATE AGGREGATION POLICY %s AS () RETURNS AGGREGATION_CONSTRAINT -> AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)`, id.FullyQualifiedName()))
+	return id, c.CreateWithRequest(t, id, sdk.NewCreateAggregationPolicyRequest(id, "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)"))
+}
+
+func (c *AggregationPolicyClient) CreateWithRequest(t *testing.T, id sdk.SchemaObjectIdentifier, req *sdk.CreateAggregationPolicyRequest) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, req)
+	require.NoError(t, err)
+
+	return c.DropAggregationPolicyFunc(t, id)
+}
+
+func (c *AggregationPolicyClient) Alter(t *testing.T, req *sdk.AlterAggregationPolicyRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, req)
 	require.NoError(t, err)
-	return id, c.DropAggregationPolicyFunc(t, id)
 }
 
 func (c *AggregationPolicyClient) DropAggregationPolicyFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
@@ -41,7 +55,21 @@ func (c *AggregationPolicyClient) DropAggregationPolicyFunc(t *testing.T, id sdk
 	ctx := context.Background()
 
 	return func() {
-		_, err := c.client().ExecForTests(ctx, fmt.Sprintf(`DROP AGGREGATION POLICY IF EXISTS %s`, id.FullyQualifiedName()))
-		require.NoError(t, err)
+		err := c.client().DropSafely(ctx, id)
+		assert.NoError(t, err)
 	}
 }
+
+func (c *AggregationPolicyClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.AggregationPolicy, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *AggregationPolicyClient) Describe(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.AggregationPolicyDetails, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().Describe(ctx, id)
+}
@@ -58,3 +58,25 @@ func (c *ApplicationClient) DropApplicationFunc(t *testing.T, id sdk.AccountObje
 		require.NoError(t, err)
 	}
 }
+
+func (c *ApplicationClient) Show(t *testing.T, id sdk.AccountObjectIdentifier) (*sdk.Application, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *ApplicationClient) Describe(t *testing.T, id sdk.AccountObjectIdentifier) ([]sdk.ApplicationProperty, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().Describe(ctx, id)
+}
+
+func (c *ApplicationClient) Alter(t *testing.T, req *sdk.AlterApplicationRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, req)
+	require.NoError(t, err)
+}
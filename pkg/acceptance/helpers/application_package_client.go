@@ -44,7 +44,7 @@ func (c *ApplicationPackageClient) CreateApplicationPackageWithReleaseChannelsDi
 	ctx := context.Background()
 
 	id := c.ids.RandomAccountObjectIdentifier()
-	_, err := c.context.client.ExecForTests(ctx, fmt.Sprintf("CREATE APPLICATION PACKAGE %s ENABLE_RELEASE_CHANNELS = FALSE", id.FullyQualifiedName()))
+	err := c.client().Create(ctx, sdk.NewCreateApplicationPackageRequest(id).WithEnableReleaseChannels(false))
 	require.NoError(t, err)
 
 	applicationPackage, err := c.client().ShowByID(ctx, id)
@@ -83,20 +83,14 @@ func (c *ApplicationPackageClient) SetDefaultReleaseDirective(t *testing.T, id s
 	require.NoError(t, err)
 }
 
-func (c *ApplicationPackageClient) ShowVersions(t *testing.T, id sdk.AccountObjectIdentifier) []ApplicationPackageVersion {
+func (c *ApplicationPackageClient) ShowVersions(t *testing.T, id sdk.AccountObjectIdentifier) []sdk.ApplicationPackageVersion {
 	t.Helper()
 
-	var versions []ApplicationPackageVersion
-	err := c.context.client.QueryForTests(context.Background(), &versions, fmt.Sprintf(`SHOW VERSIONS IN APPLICATION PACKAGE %s`, id.FullyQualifiedName()))
+	versions, err := c.client().ShowVersions(context.Background(), sdk.NewShowVersionsApplicationPackageRequest(id))
 	require.NoError(t, err)
 	return versions
 }
 
-type ApplicationPackageVersion struct {
-	Version string `json:"version"`
-	Patch   int    `json:"patch"`
-}
-
 func (c *ApplicationPackageClient) RegisterVersion(t *testing.T, id sdk.AccountObjectIdentifier, stageId sdk.SchemaObjectIdentifier, versionName string) {
 	t.Helper()
 	ctx := context.Background()
@@ -104,3 +98,18 @@ func (c *ApplicationPackageClient) RegisterVersion(t *testing.T, id sdk.AccountO
 	_, err := c.context.client.ExecForTests(ctx, fmt.Sprintf(`ALTER APPLICATION PACKAGE %s REGISTER VERSION %s USING '@%s'`, id.FullyQualifiedName(), versionName, stageId.FullyQualifiedName()))
 	require.NoError(t, err)
 }
+
+func (c *ApplicationPackageClient) Show(t *testing.T, id sdk.AccountObjectIdentifier) (*sdk.ApplicationPackage, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *ApplicationPackageClient) Alter(t *testing.T, req *sdk.AlterApplicationPackageRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, req)
+	require.NoError(t, err)
+}
@@ -0,0 +1,81 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type BackupPolicyClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewBackupPolicyClient(context *TestClientContext, idsGenerator *IdsGenerator) *BackupPolicyClient {
+	return &BackupPolicyClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *BackupPolicyClient) client() sdk.BackupPolicies {
+	return c.context.client.BackupPolicies
+}
+
+func (c *BackupPolicyClient) Create(t *testing.T) (*sdk.BackupPolicy, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomSchemaObjectIdentifier()
+	cleanup := c.CreateWithRequest(t, id, sdk.NewCreateBackupPolicyRequest(id).WithExpireAfterDays(1))
+
+	backupPolicy, err := c.client().ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	return backupPolicy, cleanup
+}
+
+func (c *BackupPolicyClient) CreateWithRequest(t *testing.T, id sdk.SchemaObjectIdentifier, req *sdk.CreateBackupPolicyRequest) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, req)
+	require.NoError(t, err)
+
+	return c.DropFunc(t, id)
+}
+
+func (c *BackupPolicyClient) Alter(t *testing.T, req *sdk.AlterBackupPolicyRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, req)
+	require.NoError(t, err)
+}
+
+func (c *BackupPolicyClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().DropSafely(ctx, id)
+		assert.NoError(t, err)
+	}
+}
+
+func (c *BackupPolicyClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.BackupPolicy, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *BackupPolicyClient) Describe(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.BackupPolicyDetails, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().Describe(ctx, id)
+}
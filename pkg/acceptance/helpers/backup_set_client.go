@@ -0,0 +1,74 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type BackupSetClient struct {
+	context *TestClientContext
+	ids     *IdsGenerator
+}
+
+func NewBackupSetClient(context *TestClientContext, idsGenerator *IdsGenerator) *BackupSetClient {
+	return &BackupSetClient{
+		context: context,
+		ids:     idsGenerator,
+	}
+}
+
+func (c *BackupSetClient) client() sdk.BackupSets {
+	return c.context.client.BackupSets
+}
+
+func (c *BackupSetClient) CreateForTable(t *testing.T, tableId sdk.SchemaObjectIdentifier) (*sdk.BackupSet, func()) {
+	t.Helper()
+	ctx := context.Background()
+
+	id := c.ids.RandomSchemaObjectIdentifier()
+	cleanup := c.CreateWithRequest(t, id, sdk.NewCreateBackupSetRequest(id, *sdk.NewBackupSetForRequest().WithTable(tableId)))
+
+	backupSet, err := c.client().ShowByID(ctx, id)
+	require.NoError(t, err)
+
+	return backupSet, cleanup
+}
+
+func (c *BackupSetClient) CreateWithRequest(t *testing.T, id sdk.SchemaObjectIdentifier, req *sdk.CreateBackupSetRequest) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, req)
+	require.NoError(t, err)
+
+	return c.DropFunc(t, id)
+}
+
+func (c *BackupSetClient) Alter(t *testing.T, req *sdk.AlterBackupSetRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, req)
+	require.NoError(t, err)
+}
+
+func (c *BackupSetClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		err := c.client().DropSafely(ctx, id)
+		assert.NoError(t, err)
+	}
+}
+
+func (c *BackupSetClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.BackupSet, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
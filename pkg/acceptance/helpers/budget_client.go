@@ -2,6 +2,8 @@ package helpers
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
@@ -38,3 +40,76 @@ func (c *BudgetClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) fun
 		require.NoError(t, err)
 	}
 }
+
+func (c *BudgetClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.Budget, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *BudgetClient) SetSpendingLimit(t *testing.T, id sdk.SchemaObjectIdentifier, spendingLimit int) {
+	t.Helper()
+	ctx := context.Background()
+
+	_, err := c.client().SetSpendingLimit(ctx, sdk.NewSetSpendingLimitBudgetRequest(id, *sdk.NewBudgetSetSpendingLimitArgsRequest(spendingLimit)))
+	require.NoError(t, err)
+}
+
+func (c *BudgetClient) SetEmailNotifications(t *testing.T, id sdk.SchemaObjectIdentifier, integrationId sdk.AccountObjectIdentifier, emails ...string) {
+	t.Helper()
+	ctx := context.Background()
+
+	args := sdk.NewBudgetSetEmailNotificationsArgsRequestFromEmails(strings.Join(emails, ", ")).WithNotificationIntegration(integrationId)
+	_, err := c.client().SetEmailNotifications(ctx, sdk.NewSetEmailNotificationsBudgetRequest(id, *args))
+	require.NoError(t, err)
+}
+
+func (c *BudgetClient) SetCycleStartAction(t *testing.T, id sdk.SchemaObjectIdentifier, procedureId sdk.SchemaObjectIdentifierWithArguments, arguments ...string) {
+	t.Helper()
+	ctx := context.Background()
+
+	args := sdk.NewBudgetSetCycleStartActionArgsRequestFromValues(procedureId, arguments...)
+	_, err := c.client().SetCycleStartAction(ctx, sdk.NewSetCycleStartActionBudgetRequest(id, *args))
+	require.NoError(t, err)
+}
+
+func (c *BudgetClient) GetSpendingLimit(t *testing.T, id sdk.SchemaObjectIdentifier) int {
+	t.Helper()
+	ctx := context.Background()
+
+	spendingLimit, err := c.client().GetSpendingLimit(ctx, sdk.NewGetSpendingLimitBudgetRequest(id))
+	require.NoError(t, err)
+	return *spendingLimit
+}
+
+func (c *BudgetClient) AddResource(t *testing.T, id sdk.SchemaObjectIdentifier, objectType sdk.ObjectType, objectId sdk.ObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	reference := sdk.NewBudgetResourceReferenceRequestFromIdentifier(objectType, objectId)
+	_, err := c.client().AddResource(ctx, sdk.NewAddResourceBudgetRequest(id, *sdk.NewBudgetAddResourceArgsRequest(*reference)))
+	require.NoError(t, err)
+	return c.RemoveResourceFunc(t, id, objectType, objectId)
+}
+
+func (c *BudgetClient) RemoveResourceFunc(t *testing.T, id sdk.SchemaObjectIdentifier, objectType sdk.ObjectType, objectId sdk.ObjectIdentifier) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	return func() {
+		reference := sdk.NewBudgetResourceReferenceRequestFromIdentifier(objectType, objectId)
+		_, err := c.client().RemoveResource(ctx, sdk.NewRemoveResourceBudgetRequest(id, *sdk.NewBudgetRemoveResourceArgsRequest(*reference)))
+		// the budget could have been already dropped
+		if err != nil && !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			require.NoError(t, err)
+		}
+	}
+}
+
+func (c *BudgetClient) GetLinkedResources(t *testing.T, id sdk.SchemaObjectIdentifier) ([]sdk.BudgetLinkedResource, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().GetLinkedResources(ctx, sdk.NewGetLinkedResourcesBudgetRequest(id))
+}
@@ -2,10 +2,10 @@ package helpers
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -21,20 +21,33 @@ func NewContactClient(context *TestClientContext, idsGenerator *IdsGenerator) *C
 	}
 }
 
-func (c *ContactClient) client() *sdk.Client {
-	return c.context.client
+func (c *ContactClient) client() sdk.Contacts {
+	return c.context.client.Contacts
 }
 
-// TODO(SNOW-2175834): Replace raw SQL with SDK client once Contacts SDK is implemented.
 func (c *ContactClient) Create(t *testing.T) (sdk.SchemaObjectIdentifier, func()) {
 	t.Helper()
-	ctx := context.Background()
 
 	id := c.ids.RandomSchemaObjectIdentifier()
-	stmt := fmt.Sprintf(`CREATE CONTACT %s`, id.FullyQualifiedName())
-	_, err := c.client().ExecForTests(ctx, stmt)
+	return id, c.CreateWithRequest(t, sdk.NewCreateContactRequest(id))
+}
+
+func (c *ContactClient) CreateWithRequest(t *testing.T, req *sdk.CreateContactRequest) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, req)
+	require.NoError(t, err)
+
+	return c.DropFunc(t, req.GetName())
+}
+
+func (c *ContactClient) Alter(t *testing.T, req *sdk.AlterContactRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, req)
 	require.NoError(t, err)
-	return id, c.DropFunc(t, id)
 }
 
 func (c *ContactClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
@@ -42,7 +55,40 @@ func (c *ContactClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) fu
 	ctx := context.Background()
 
 	return func() {
-		_, err := c.client().ExecForTests(ctx, fmt.Sprintf(`DROP CONTACT IF EXISTS %s`, id.FullyQualifiedName()))
-		require.NoError(t, err)
+		err := c.client().DropSafely(ctx, id)
+		assert.NoError(t, err)
 	}
 }
+
+func (c *ContactClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.Contact, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *ContactClient) SetOnObject(t *testing.T, objectType sdk.ObjectType, objectId sdk.ObjectIdentifier, set sdk.ContactsOnObjectSetRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().SetOnObject(ctx, sdk.NewSetOnObjectContactRequest(objectType, objectId, set))
+	require.NoError(t, err)
+}
+
+func (c *ContactClient) UnsetOnObject(t *testing.T, objectType sdk.ObjectType, objectId sdk.ObjectIdentifier, unset sdk.ContactsOnObjectUnsetRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().UnsetOnObject(ctx, sdk.NewUnsetOnObjectContactRequest(objectType, objectId, unset))
+	require.NoError(t, err)
+}
+
+func (c *ContactClient) GetForObject(t *testing.T, objectType sdk.ObjectType, objectId sdk.ObjectIdentifier) []sdk.ContactReference {
+	t.Helper()
+	ctx := context.Background()
+
+	references, err := c.client().GetForObject(ctx, objectType, objectId)
+	require.NoError(t, err)
+
+	return references
+}
@@ -28,3 +28,11 @@ func (c *DataMetricFunctionReferencesClient) GetDataMetricFunctionReferences(t *
 
 	return refs
 }
+
+func (c *DataMetricFunctionReferencesClient) AlterOnObject(t *testing.T, request *sdk.AlterOnObjectDataMetricFunctionReferenceRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.context.client.DataMetricFunctionReferences.AlterOnObject(ctx, request)
+	require.NoError(t, err)
+}
@@ -47,3 +47,25 @@ func (c *EventTableClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier)
 		require.NoError(t, err)
 	}
 }
+
+func (c *EventTableClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.EventTable, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *EventTableClient) Describe(t *testing.T, id sdk.SchemaObjectIdentifier) ([]sdk.EventTableDetails, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().Describe(ctx, id)
+}
+
+func (c *EventTableClient) Alter(t *testing.T, req *sdk.AlterEventTableRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, req)
+	require.NoError(t, err)
+}
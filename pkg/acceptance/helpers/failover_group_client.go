@@ -54,6 +54,14 @@ func (c *FailoverGroupClient) RemoveAllowedAccounts(t *testing.T, id sdk.Account
 	require.NoError(t, err)
 }
 
+func (c *FailoverGroupClient) Promote(t *testing.T, id sdk.AccountObjectIdentifier) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().AlterTarget(ctx, sdk.NewAlterTargetFailoverGroupRequest(id).WithPrimary(true))
+	require.NoError(t, err)
+}
+
 func (c *FailoverGroupClient) DropFunc(t *testing.T, id sdk.AccountObjectIdentifier) func() {
 	t.Helper()
 	ctx := context.Background()
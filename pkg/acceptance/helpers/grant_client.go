@@ -760,6 +760,36 @@ func (c *GrantClient) ShowGrantsToDatabaseRole(t *testing.T, databaseRoleId sdk.
 	})
 }
 
+func (c *GrantClient) ShowGrantsToApplicationRole(t *testing.T, applicationRoleId sdk.DatabaseObjectIdentifier) ([]sdk.Grant, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().Show(ctx, &sdk.ShowGrantOptions{
+		To: &sdk.ShowGrantsTo{
+			ApplicationRole: applicationRoleId,
+		},
+	})
+}
+
+func (c *GrantClient) ShowCallerGrantsToAccountRole(t *testing.T, roleId sdk.AccountObjectIdentifier) ([]sdk.Grant, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowCallerGrants(ctx, &sdk.ShowCallerGrantOptions{
+		To: &sdk.CallerGrantTo{
+			AccountRole: &roleId,
+		},
+	})
+}
+
+func (c *GrantClient) RevokeCallerPrivilegesFromAccountRole(t *testing.T, roleId sdk.AccountObjectIdentifier, on sdk.Object, privileges ...sdk.ObjectPrivilege) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().RevokeCaller(ctx, sdk.CallerGrantPrivileges{Privileges: privileges}, on, sdk.CallerGrantTo{AccountRole: &roleId})
+	require.NoError(t, err)
+}
+
 func (c *GrantClient) GrantDatabaseRoleToUser(t *testing.T, databaseRoleId sdk.DatabaseObjectIdentifier, userId sdk.AccountObjectIdentifier) {
 	t.Helper()
 	ctx := context.Background()
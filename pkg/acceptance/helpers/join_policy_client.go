@@ -2,14 +2,13 @@ package helpers
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-// TODO(SNOW-3648593): change raw sqls to proper client
 type JoinPolicyClient struct {
 	context *TestClientContext
 	ids     *IdsGenerator
@@ -22,18 +21,33 @@ func NewJoinPolicyClient(context *TestClientContext, idsGenerator *IdsGenerator)
 	}
 }
 
-func (c *JoinPolicyClient) client() *sdk.Client {
-	return c.context.client
+func (c *JoinPolicyClient) client() sdk.JoinPolicies {
+	return c.context.client.JoinPolicies
 }
 
 func (c *JoinPolicyClient) Create(t *testing.T) (sdk.SchemaObjectIdentifier, func()) {
 	t.Helper()
-	ctx := context.Background()
 
 	id := c.ids.RandomSchemaObjectIdentifier()
-	_, err := c.client().ExecForTests(ctx, fmt.Sprintf(`CREATE JOIN POLICY %s AS () RETURNS JOIN_CONSTRAINT -> JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)`, id.FullyQualifiedName()))
+	return id, c.CreateWithRequest(t, id, sdk.NewCreateJoinPolicyRequest(id, "JOIN_CONSTRAINT(JOIN_REQUIRED => TRUE)"))
+}
+
+func (c *JoinPolicyClient) CreateWithRequest(t *testing.T, id sdk.SchemaObjectIdentifier, req *sdk.CreateJoinPolicyRequest) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, req)
+	require.NoError(t, err)
+
+	return c.DropFunc(t, id)
+}
+
+func (c *JoinPolicyClient) Alter(t *testing.T, req *sdk.AlterJoinPolicyRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, req)
 	require.NoError(t, err)
-	return id, c.DropFunc(t, id)
 }
 
 func (c *JoinPolicyClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
@@ -41,7 +55,21 @@ func (c *JoinPolicyClient) DropFunc(t *testing.T, id sdk.SchemaObjectIdentifier)
 	ctx := context.Background()
 
 	return func() {
-		_, err := c.client().ExecForTests(ctx, fmt.Sprintf(`DROP JOIN POLICY IF EXISTS %s`, id.FullyQualifiedName()))
-		require.NoError(t, err)
+		err := c.client().DropSafely(ctx, id)
+		assert.NoError(t, err)
 	}
 }
+
+func (c *JoinPolicyClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.JoinPolicy, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *JoinPolicyClient) Describe(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.JoinPolicyDetails, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().Describe(ctx, id)
+}
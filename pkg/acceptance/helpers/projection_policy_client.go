@@ -2,14 +2,13 @@ package helpers
 
 import (
 	"context"
-	"fmt"
 	"testing"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-// TODO(SNOW-1564959): change raw sqls to proper client
 type ProjectionPolicyClient struct {
 	context *TestClientContext
 	ids     *IdsGenerator
@@ -22,18 +21,33 @@ func NewProjectionPolicyClient(context *TestClientContext, idsGenerator *IdsGene
 	}
 }
 
-func (c *ProjectionPolicyClient) client() *sdk.Client {
-	return c.context.client
+func (c *ProjectionPolicyClient) client() sdk.ProjectionPolicies {
+	return c.context.client.ProjectionPolicies
 }
 
 func (c *ProjectionPolicyClient) CreateProjectionPolicy(t *testing.T) (sdk.SchemaObjectIdentifier, func()) {
 	t.Helper()
-	ctx := context.Background()
 
 	id := c.ids.RandomSchemaObjectIdentifier()
-	_, err := c.client().ExecForTests(ctx, fmt.Sprintf(`CREATE PROJECTION POLICY %s AS () RETURNS PROJECTION_CONSTRAINT -> PROJECTION_CONSTRAINT(ALLOW => false)`, id.FullyQualifiedName()))
+	return id, c.CreateWithRequest(t, id, sdk.NewCreateProjectionPolicyRequest(id, "PROJECTION_CONSTRAINT(ALLOW => false)"))
+}
+
+func (c *ProjectionPolicyClient) CreateWithRequest(t *testing.T, id sdk.SchemaObjectIdentifier, req *sdk.CreateProjectionPolicyRequest) func() {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Create(ctx, req)
+	require.NoError(t, err)
+
+	return c.DropProjectionPolicyFunc(t, id)
+}
+
+func (c *ProjectionPolicyClient) Alter(t *testing.T, req *sdk.AlterProjectionPolicyRequest) {
+	t.Helper()
+	ctx := context.Background()
+
+	err := c.client().Alter(ctx, req)
 	require.NoError(t, err)
-	return id, c.DropProjectionPolicyFunc(t, id)
 }
 
 func (c *ProjectionPolicyClient) DropProjectionPolicyFunc(t *testing.T, id sdk.SchemaObjectIdentifier) func() {
@@ -41,7 +55,21 @@ func (c *ProjectionPolicyClient) DropProjectionPolicyFunc(t *testing.T, id sdk.S
 	ctx := context.Background()
 
 	return func() {
-		_, err := c.client().ExecForTests(ctx, fmt.Sprintf(`DROP PROJECTION POLICY IF EXISTS %s`, id.FullyQualifiedName()))
-		require.NoError(t, err)
+		err := c.client().DropSafely(ctx, id)
+		assert.NoError(t, err)
 	}
 }
+
+func (c *ProjectionPolicyClient) Show(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.ProjectionPolicy, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().ShowByID(ctx, id)
+}
+
+func (c *ProjectionPolicyClient) Describe(t *testing.T, id sdk.SchemaObjectIdentifier) (*sdk.ProjectionPolicyDetails, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	return c.client().Describe(ctx, id)
+}
@@ -22,6 +22,8 @@ type TestClient struct {
 	Application                  *ApplicationClient
 	ApplicationPackage           *ApplicationPackageClient
 	AuthenticationPolicy         *AuthenticationPolicyClient
+	BackupPolicy                 *BackupPolicyClient
+	BackupSet                    *BackupSetClient
 	BcrBundles                   *BcrBundlesClient
 	Budget                       *BudgetClient
 	ComputePool                  *ComputePoolClient
@@ -130,6 +132,8 @@ func NewTestClient(
 		Application:                  NewApplicationClient(context, idsGenerator),
 		ApplicationPackage:           NewApplicationPackageClient(context, idsGenerator),
 		AuthenticationPolicy:         NewAuthenticationPolicyClient(context, idsGenerator),
+		BackupPolicy:                 NewBackupPolicyClient(context, idsGenerator),
+		BackupSet:                    NewBackupSetClient(context, idsGenerator),
 		BcrBundles:                   NewBcrBundlesClient(context),
 		Budget:                       NewBudgetClient(context, idsGenerator),
 		ComputePool:                  NewComputePoolClient(context, idsGenerator),
@@ -208,6 +208,15 @@ func (c *UserClient) ShowProgrammaticAccessToken(t *testing.T, userId sdk.Accoun
 	return token
 }
 
+func (c *UserClient) ShowProgrammaticAccessTokens(t *testing.T, userId sdk.AccountObjectIdentifier) []sdk.ProgrammaticAccessToken {
+	t.Helper()
+	ctx := context.Background()
+
+	tokens, err := c.context.client.Users.ShowProgrammaticAccessTokens(ctx, sdk.NewShowUserProgrammaticAccessTokenRequest().WithUserName(userId))
+	require.NoError(t, err)
+	return tokens
+}
+
 func (c *UserClient) ShowUserWorkloadIdentityAuthenticationMethodOptions(t *testing.T, id UserWorkloadIdentityAuthenticationMethodsObjectIdentifier) (*sdk.UserWorkloadIdentityAuthenticationMethod, error) {
 	t.Helper()
 	ctx := context.Background()
@@ -18,7 +18,11 @@ const (
 	// TestNonProdModifiableAccountLocator represents an account locator that can be used for account modification tests.
 	TestNonProdModifiableAccountLocator env = "TEST_SF_TF_NON_PROD_MODIFIABLE_ACCOUNT_LOCATOR"
 	TestAccountCreate                   env = "TEST_SF_TF_TEST_ACCOUNT_CREATE"
+	TestOrganizationAccountCreate       env = "TEST_SF_TF_TEST_ORGANIZATION_ACCOUNT_CREATE"
 	TestFailoverGroups                  env = "TEST_SF_TF_TEST_FAILOVER_GROUPS"
+	TestOpenflow                        env = "TEST_SF_TF_TEST_OPENFLOW"
+	// TestOpenflowConnectorDefinition represents the name of a connector definition available in the Openflow runtime used in tests.
+	TestOpenflowConnectorDefinition env = "TEST_SF_TF_TEST_OPENFLOW_CONNECTOR_DEFINITION"
 
 	AwsExternalBucketUrl   env = "TEST_SF_TF_AWS_EXTERNAL_BUCKET_URL"
 	AwsExternalKeyId       env = "TEST_SF_TF_AWS_EXTERNAL_KEY_ID"
@@ -0,0 +1,98 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var aggregationPoliciesSchema = map[string]*schema.Schema{
+	"with_describe": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Runs DESC AGGREGATION POLICY for each aggregation policy returned by SHOW AGGREGATION POLICIES. The output of describe is saved to the describe_output field. By default this value is set to true.",
+	},
+	"like":  likeSchema,
+	"in":    inSchema,
+	"limit": limitFromSchema,
+	"aggregation_policies": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all aggregation policy details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW AGGREGATION POLICIES.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowAggregationPolicySchema,
+					},
+				},
+				resources.DescribeOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of DESCRIBE AGGREGATION POLICY.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowAggregationPolicyDetailsSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func AggregationPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.AggregationPoliciesDatasource), TrackingReadWrapper(datasources.AggregationPolicies, ReadAggregationPolicies)),
+		Schema:      aggregationPoliciesSchema,
+		Description: "Data source used to get details of filtered aggregation policies. Filtering is aligned with the current possibilities for [SHOW AGGREGATION POLICIES](https://docs.snowflake.com/en/sql-reference/sql/show-aggregation-policies) query." +
+			" The results of SHOW and DESCRIBE are encapsulated in one output collection `aggregation_policies`.",
+	}
+}
+
+func ReadAggregationPolicies(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	req := sdk.NewShowAggregationPolicyRequest()
+
+	handleLike(d, &req.Like)
+	if err := handleIn(d, &req.In); err != nil {
+		return diag.FromErr(err)
+	}
+	handleLimitFrom(d, &req.Limit)
+
+	aggregationPolicies, err := client.AggregationPolicies.Show(ctx, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("aggregation_policies_read")
+
+	flattened := make([]map[string]any, len(aggregationPolicies))
+	for i := range aggregationPolicies {
+		aggregationPolicy := aggregationPolicies[i]
+		var describeOutput []map[string]any
+		if d.Get("with_describe").(bool) {
+			details, err := client.AggregationPolicies.Describe(ctx, aggregationPolicy.ID())
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			describeOutput = []map[string]any{schemas.AggregationPolicyDetailsToSchema(details)}
+		}
+		flattened[i] = map[string]any{
+			resources.ShowOutputAttributeName:     []map[string]any{schemas.AggregationPolicyToSchema(&aggregationPolicy)},
+			resources.DescribeOutputAttributeName: describeOutput,
+		}
+	}
+	if err := d.Set("aggregation_policies", flattened); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
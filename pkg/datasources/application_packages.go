@@ -0,0 +1,73 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var applicationPackagesSchema = map[string]*schema.Schema{
+	"like":        likeSchema,
+	"starts_with": startsWithSchema,
+	"limit":       limitFromSchema,
+	"application_packages": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all application package details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW APPLICATION PACKAGES.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowApplicationPackageSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func ApplicationPackages() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.ApplicationPackagesDatasource), TrackingReadWrapper(datasources.ApplicationPackages, ReadApplicationPackages)),
+		Schema:      applicationPackagesSchema,
+		Description: "Data source used to get details of filtered application packages. Filtering is aligned with the current possibilities for [SHOW APPLICATION PACKAGES](https://docs.snowflake.com/en/sql-reference/sql/show-application-packages) query." +
+			" The results of SHOW are encapsulated in one output collection `application_packages`.",
+	}
+}
+
+func ReadApplicationPackages(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	req := sdk.NewShowApplicationPackageRequest()
+
+	handleLike(d, &req.Like)
+	handleStartsWith(d, &req.StartsWith)
+	handleLimitFrom(d, &req.Limit)
+
+	applicationPackages, err := client.ApplicationPackages.Show(ctx, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("application_packages_read")
+
+	flattened := make([]map[string]any, len(applicationPackages))
+	for i := range applicationPackages {
+		applicationPackage := applicationPackages[i]
+		flattened[i] = map[string]any{
+			resources.ShowOutputAttributeName: []map[string]any{schemas.ApplicationPackageToSchema(&applicationPackage)},
+		}
+	}
+	if err := d.Set("application_packages", flattened); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
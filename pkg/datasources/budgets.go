@@ -0,0 +1,72 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var budgetsSchema = map[string]*schema.Schema{
+	"like": likeSchema,
+	"in":   inSchema,
+	"budgets": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all budget details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW SNOWFLAKE.CORE.BUDGET INSTANCES.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowBudgetSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func Budgets() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.BudgetsDatasource), TrackingReadWrapper(datasources.Budgets, ReadBudgets)),
+		Schema:      budgetsSchema,
+		Description: "Data source used to get details of filtered custom budgets. Filtering is aligned with the current possibilities for [SHOW SNOWFLAKE.CORE.BUDGET INSTANCES](https://docs.snowflake.com/en/sql-reference/classes/budget/commands/show-budget) query." +
+			" The results of SHOW are encapsulated in one output collection `budgets`.",
+	}
+}
+
+func ReadBudgets(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	req := sdk.ShowBudgetRequest{}
+
+	handleLike(d, &req.Like)
+	if err := handleIn(d, &req.In); err != nil {
+		return diag.FromErr(err)
+	}
+
+	budgets, err := client.Budgets.Show(ctx, &req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("budgets_read")
+
+	flattened := make([]map[string]any, len(budgets))
+	for i := range budgets {
+		flattened[i] = map[string]any{
+			resources.ShowOutputAttributeName: []map[string]any{schemas.BudgetToSchema(&budgets[i])},
+		}
+	}
+	if err := d.Set("budgets", flattened); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/docs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var dataMetricFunctionReferencesSchema = map[string]*schema.Schema{
+	"object_type": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: fmt.Sprintf("Type of the object to get data metric function references for. Valid values are (case-insensitive): %s.", docs.PossibleValuesListed(sdk.AllDataMetricFunctionAttachableObjectTypes)),
+		ValidateFunc: validation.StringInSlice(collections.Map(sdk.AllDataMetricFunctionAttachableObjectTypes, func(v sdk.ObjectType) string {
+			return string(v)
+		}), true),
+	},
+	"object_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Fully qualified name of the table, view, dynamic table, or Iceberg table to get data metric function references for.",
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+	"data_metric_function_references": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of DATA_METRIC_FUNCTION_REFERENCES for the given object.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowDataMetricFunctionReferenceSchema,
+		},
+	},
+}
+
+func DataMetricFunctionReferences() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.DataMetricFunctionReferencesDatasource), TrackingReadWrapper(datasources.DataMetricFunctionReferences, ReadDataMetricFunctionReferences)),
+		Schema:      dataMetricFunctionReferencesSchema,
+		Description: "Data source used to get all data metric functions attached to the given object. The results of the [DATA_METRIC_FUNCTION_REFERENCES](https://docs.snowflake.com/en/sql-reference/functions/data_metric_function_references) function are encapsulated in one output collection `data_metric_function_references`.",
+	}
+}
+
+func ReadDataMetricFunctionReferences(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	objectType, err := sdk.ToDataMetricFunctionAttachableObjectType(d.Get("object_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	objectId, err := sdk.ParseSchemaObjectIdentifier(d.Get("object_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	domain, err := sdk.DataMetricFunctionRefEntityDomainForObjectType(objectType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	references, err := client.DataMetricFunctionReferences.GetForEntity(ctx, sdk.NewGetForEntityDataMetricFunctionReferenceRequestCustom(objectId, domain))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(helpers.EncodeResourceIdentifier(objectType.String(), objectId.FullyQualifiedName()))
+
+	flattenedReferences := make([]map[string]any, len(references))
+	for i, reference := range references {
+		flattenedReferences[i] = schemas.DataMetricFunctionReferenceToSchema(&reference)
+	}
+
+	if err := d.Set("data_metric_function_references", flattenedReferences); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var failoverGroupMembersSchema = map[string]*schema.Schema{
+	"failover_group": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Identifier of the failover group to list the members of.",
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.AccountObjectIdentifier](),
+	},
+	"databases": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of SHOW DATABASES IN FAILOVER GROUP.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowFailoverGroupDatabaseSchema,
+		},
+	},
+	"shares": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of SHOW SHARES IN FAILOVER GROUP.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowFailoverGroupShareSchema,
+		},
+	},
+}
+
+func FailoverGroupMembers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.FailoverGroupMembersDatasource), TrackingReadWrapper(datasources.FailoverGroupMembers, ReadFailoverGroupMembers)),
+		Schema:      failoverGroupMembersSchema,
+		Description: "Data source used to get the databases and shares included in the given failover group. The results of [SHOW DATABASES IN FAILOVER GROUP](https://docs.snowflake.com/en/sql-reference/sql/show-databases-in-failover-group) and [SHOW SHARES IN FAILOVER GROUP](https://docs.snowflake.com/en/sql-reference/sql/show-shares-in-failover-group) queries are encapsulated in the `databases` and `shares` output collections.",
+	}
+}
+
+func ReadFailoverGroupMembers(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("failover_group").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	databases, err := client.FailoverGroups.ShowFailoverGroupDatabases(ctx, sdk.NewShowFailoverGroupDatabasesFailoverGroupRequest(id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	shares, err := client.FailoverGroups.ShowFailoverGroupShares(ctx, sdk.NewShowFailoverGroupSharesFailoverGroupRequest(id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id.FullyQualifiedName())
+
+	flattenedDatabases := make([]map[string]any, len(databases))
+	for i, database := range databases {
+		flattenedDatabases[i] = schemas.FailoverGroupDatabaseToSchema(&database)
+	}
+	flattenedShares := make([]map[string]any, len(shares))
+	for i, share := range shares {
+		flattenedShares[i] = schemas.FailoverGroupShareToSchema(&share)
+	}
+
+	if err := d.Set("databases", flattenedDatabases); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("shares", flattenedShares); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var gitRepositoryRefsSchema = map[string]*schema.Schema{
+	"git_repository": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      "Fully qualified name of the git repository to list the branches and tags of.",
+		ValidateDiagFunc: resources.IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+	"like": likeSchema,
+	"branches": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of SHOW GIT BRANCHES.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowGitBranchSchema,
+		},
+	},
+	"tags": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of SHOW GIT TAGS.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowGitTagSchema,
+		},
+	},
+}
+
+func GitRepositoryRefs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.GitRepositoryRefsDatasource), TrackingReadWrapper(datasources.GitRepositoryRefs, ReadGitRepositoryRefs)),
+		Schema:      gitRepositoryRefsSchema,
+		Description: "Data source used to get the branches and tags (with their commit hashes) of the given git repository. The results of [SHOW GIT BRANCHES](https://docs.snowflake.com/en/sql-reference/sql/show-git-branches) and [SHOW GIT TAGS](https://docs.snowflake.com/en/sql-reference/sql/show-git-tags) queries are encapsulated in the `branches` and `tags` output collections. The refs reflect the state of the last fetch of the git repository.",
+	}
+}
+
+func ReadGitRepositoryRefs(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Get("git_repository").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	branchesRequest := sdk.NewShowGitBranchesGitRepositoryRequest(id)
+	tagsRequest := sdk.NewShowGitTagsGitRepositoryRequest(id)
+	handleLike(d, &branchesRequest.Like)
+	handleLike(d, &tagsRequest.Like)
+
+	branches, err := client.GitRepositories.ShowGitBranches(ctx, branchesRequest)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tags, err := client.GitRepositories.ShowGitTags(ctx, tagsRequest)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id.FullyQualifiedName())
+
+	flattenedBranches := make([]map[string]any, len(branches))
+	for i, branch := range branches {
+		flattenedBranches[i] = schemas.GitBranchToSchema(&branch)
+	}
+	flattenedTags := make([]map[string]any, len(tags))
+	for i, tag := range tags {
+		flattenedTags[i] = schemas.GitTagToSchema(&tag)
+	}
+
+	if err := d.Set("branches", flattenedBranches); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("tags", flattenedTags); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
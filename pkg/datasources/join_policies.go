@@ -0,0 +1,98 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var joinPoliciesSchema = map[string]*schema.Schema{
+	"with_describe": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     true,
+		Description: "Runs DESC JOIN POLICY for each join policy returned by SHOW JOIN POLICIES. The output of describe is saved to the describe_output field. By default this value is set to true.",
+	},
+	"like":  likeSchema,
+	"in":    inSchema,
+	"limit": limitFromSchema,
+	"join_policies": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all join policy details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW JOIN POLICIES.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowJoinPolicySchema,
+					},
+				},
+				resources.DescribeOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of DESCRIBE JOIN POLICY.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowJoinPolicyDetailsSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func JoinPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.JoinPoliciesDatasource), TrackingReadWrapper(datasources.JoinPolicies, ReadJoinPolicies)),
+		Schema:      joinPoliciesSchema,
+		Description: "Data source used to get details of filtered join policies. Filtering is aligned with the current possibilities for [SHOW JOIN POLICIES](https://docs.snowflake.com/en/sql-reference/sql/show-join-policies) query." +
+			" The results of SHOW and DESCRIBE are encapsulated in one output collection `join_policies`.",
+	}
+}
+
+func ReadJoinPolicies(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	req := sdk.NewShowJoinPolicyRequest()
+
+	handleLike(d, &req.Like)
+	if err := handleIn(d, &req.In); err != nil {
+		return diag.FromErr(err)
+	}
+	handleLimitFrom(d, &req.Limit)
+
+	joinPolicies, err := client.JoinPolicies.Show(ctx, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("join_policies_read")
+
+	flattened := make([]map[string]any, len(joinPolicies))
+	for i := range joinPolicies {
+		joinPolicy := joinPolicies[i]
+		var describeOutput []map[string]any
+		if d.Get("with_describe").(bool) {
+			details, err := client.JoinPolicies.Describe(ctx, joinPolicy.ID())
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			describeOutput = []map[string]any{schemas.JoinPolicyDetailsToSchema(details)}
+		}
+		flattened[i] = map[string]any{
+			resources.ShowOutputAttributeName:     []map[string]any{schemas.JoinPolicyToSchema(&joinPolicy)},
+			resources.DescribeOutputAttributeName: describeOutput,
+		}
+	}
+	if err := d.Set("join_policies", flattened); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var organizationAccountsSchema = map[string]*schema.Schema{
+	"like": likeSchema,
+	"organization_accounts": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all organization accounts details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW ORGANIZATION ACCOUNTS.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowOrganizationAccountSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func OrganizationAccounts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.OrganizationAccountsDatasource), TrackingReadWrapper(datasources.OrganizationAccounts, ReadOrganizationAccounts)),
+		Schema:      organizationAccountsSchema,
+		Description: "Data source used to get details of filtered organization accounts. Filtering is aligned with the current possibilities for [SHOW ORGANIZATION ACCOUNTS](https://docs.snowflake.com/en/sql-reference/sql/show-organization-accounts) query. The results of SHOW are encapsulated in one output collection `organization_accounts`.",
+	}
+}
+
+func ReadOrganizationAccounts(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	req := sdk.NewShowOrganizationAccountRequest()
+	if likePattern, ok := d.GetOk("like"); ok {
+		req.WithLike(sdk.Like{Pattern: sdk.String(likePattern.(string))})
+	}
+
+	organizationAccounts, err := client.OrganizationAccounts.Show(ctx, req)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("organization_accounts")
+
+	flattenedOrganizationAccounts := make([]map[string]any, len(organizationAccounts))
+	for i, organizationAccount := range organizationAccounts {
+		flattenedOrganizationAccounts[i] = map[string]any{
+			resources.ShowOutputAttributeName: []map[string]any{schemas.OrganizationAccountToSchema(&organizationAccount)},
+		}
+	}
+
+	if err := d.Set("organization_accounts", flattenedOrganizationAccounts); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
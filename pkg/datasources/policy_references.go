@@ -0,0 +1,102 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/docs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var policyReferencesSchema = map[string]*schema.Schema{
+	"policy_name": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Fully qualified name of the policy to get the references for. Returns every object the policy is attached to. For account-level policies (e.g. network policies), provide only the policy name.",
+		ExactlyOneOf: []string{"policy_name", "ref_entity_name"},
+	},
+	"ref_entity_name": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Fully qualified name of the object to get the references for. Returns every policy attached to the object. For account-level objects (e.g. users or integrations), provide only the object name.",
+		ExactlyOneOf: []string{"policy_name", "ref_entity_name"},
+		RequiredWith: []string{"ref_entity_domain"},
+	},
+	"ref_entity_domain": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  fmt.Sprintf("Domain of the object provided in `ref_entity_name`. Valid values are (case-insensitive): %s.", docs.PossibleValuesListed(sdk.AllPolicyEntityDomains)),
+		ValidateFunc: validation.StringInSlice(collections.Map(sdk.AllPolicyEntityDomains, func(v sdk.PolicyEntityDomain) string { return string(v) }), true),
+		RequiredWith: []string{"ref_entity_name"},
+	},
+	"policy_references": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the output of POLICY_REFERENCES for the given policy or object.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowPolicyReferenceSchema,
+		},
+	},
+}
+
+func PolicyReferences() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.PolicyReferencesDatasource), TrackingReadWrapper(datasources.PolicyReferences, ReadPolicyReferences)),
+		Schema:      policyReferencesSchema,
+		Description: "Data source used to get the policy attachments of the given object, or the objects the given policy is attached to. The results of the [POLICY_REFERENCES](https://docs.snowflake.com/en/sql-reference/functions/policy_references) function are encapsulated in one output collection `policy_references`.",
+	}
+}
+
+func ReadPolicyReferences(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	var references []sdk.PolicyReference
+	var id string
+	switch {
+	case d.Get("policy_name").(string) != "":
+		policyId, err := sdk.ParseObjectIdentifierString(d.Get("policy_name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		references, err = client.PolicyReferences.GetForPolicy(ctx, sdk.NewGetForPolicyPolicyReferenceRequest(policyId))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		id = policyId.FullyQualifiedName()
+	default:
+		entityId, err := sdk.ParseObjectIdentifierString(d.Get("ref_entity_name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		domain, err := sdk.ToPolicyEntityDomain(d.Get("ref_entity_domain").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		references, err = client.PolicyReferences.GetForEntity(ctx, sdk.NewGetForEntityPolicyReferenceRequest(entityId, domain))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		id = helpers.EncodeResourceIdentifier(string(domain), entityId.FullyQualifiedName())
+	}
+	d.SetId(id)
+
+	flattenedReferences := make([]map[string]any, len(references))
+	for i, reference := range references {
+		flattenedReferences[i] = schemas.PolicyReferenceToSchema(&reference)
+	}
+
+	if err := d.Set("policy_references", flattenedReferences); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var regionsSchema = map[string]*schema.Schema{
+	"like": likeSchema,
+	"regions": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all regions details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW REGIONS.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowRegionSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func Regions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.RegionsDatasource), TrackingReadWrapper(datasources.Regions, ReadRegions)),
+		Schema:      regionsSchema,
+		Description: "Data source used to get details of filtered regions available for accounts in the organization. Filtering is aligned with the current possibilities for [SHOW REGIONS](https://docs.snowflake.com/en/sql-reference/sql/show-regions) query. The results of SHOW are encapsulated in one output collection `regions`.",
+	}
+}
+
+func ReadRegions(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	opts := &sdk.ShowRegionsOptions{}
+	handleLike(d, &opts.Like)
+
+	regions, err := client.ReplicationFunctions.ShowRegions(ctx, opts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("regions")
+
+	flattenedRegions := make([]map[string]any, len(regions))
+	for i, region := range regions {
+		flattenedRegions[i] = map[string]any{
+			resources.ShowOutputAttributeName: []map[string]any{schemas.RegionToSchema(region)},
+		}
+	}
+
+	if err := d.Set("regions", flattenedRegions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var replicationAccountsSchema = map[string]*schema.Schema{
+	"replication_accounts": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all replication accounts details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW REPLICATION ACCOUNTS.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowReplicationAccountSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func ReplicationAccounts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.ReplicationAccountsDatasource), TrackingReadWrapper(datasources.ReplicationAccounts, ReadReplicationAccounts)),
+		Schema:      replicationAccountsSchema,
+		Description: "Data source used to get details of all accounts in the organization that are enabled for replication. The results of [SHOW REPLICATION ACCOUNTS](https://docs.snowflake.com/en/sql-reference/sql/show-replication-accounts) query are encapsulated in one output collection `replication_accounts`.",
+	}
+}
+
+func ReadReplicationAccounts(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	replicationAccounts, err := client.ReplicationFunctions.ShowReplicationAccounts(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("replication_accounts")
+
+	flattenedReplicationAccounts := make([]map[string]any, len(replicationAccounts))
+	for i, replicationAccount := range replicationAccounts {
+		flattenedReplicationAccounts[i] = map[string]any{
+			resources.ShowOutputAttributeName: []map[string]any{schemas.ReplicationAccountToSchema(replicationAccount)},
+		}
+	}
+
+	if err := d.Set("replication_accounts", flattenedReplicationAccounts); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package datasources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/datasources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var replicationDatabasesSchema = map[string]*schema.Schema{
+	"like": likeSchema,
+	"with_primary": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Filters the output to the primary database and all its secondary databases. Provide the fully qualified name of the primary database in the `<organization_name>.<account_name>.<database_name>` format.",
+	},
+	"replication_databases": {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Holds the aggregated output of all replication databases details queries.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				resources.ShowOutputAttributeName: {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Holds the output of SHOW REPLICATION DATABASES.",
+					Elem: &schema.Resource{
+						Schema: schemas.ShowReplicationDatabaseSchema,
+					},
+				},
+			},
+		},
+	},
+}
+
+func ReplicationDatabases() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: PreviewFeatureReadWrapper(string(previewfeatures.ReplicationDatabasesDatasource), TrackingReadWrapper(datasources.ReplicationDatabases, ReadReplicationDatabases)),
+		Schema:      replicationDatabasesSchema,
+		Description: "Data source used to get details of filtered primary and secondary databases for which replication is enabled. Filtering is aligned with the current possibilities for [SHOW REPLICATION DATABASES](https://docs.snowflake.com/en/sql-reference/sql/show-replication-databases) query. The results of SHOW are encapsulated in one output collection `replication_databases`.",
+	}
+}
+
+func ReadReplicationDatabases(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	opts := &sdk.ShowReplicationDatabasesOptions{}
+	handleLike(d, &opts.Like)
+	if v, ok := d.GetOk("with_primary"); ok {
+		primaryId, err := sdk.ParseExternalObjectIdentifier(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		opts.WithPrimary = &primaryId
+	}
+
+	replicationDatabases, err := client.ReplicationFunctions.ShowReplicationDatabases(ctx, opts)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId("replication_databases")
+
+	flattenedReplicationDatabases := make([]map[string]any, len(replicationDatabases))
+	for i, replicationDatabase := range replicationDatabases {
+		flattenedReplicationDatabases[i] = map[string]any{
+			resources.ShowOutputAttributeName: []map[string]any{schemas.ReplicationDatabaseToSchema(&replicationDatabase)},
+		}
+	}
+
+	if err := d.Set("replication_databases", flattenedReplicationDatabases); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
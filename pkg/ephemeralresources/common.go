@@ -0,0 +1,57 @@
+package ephemeralresources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/ephemeralresources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+)
+
+// ephemeralResourceBase holds the provider context shared by all the ephemeral resources.
+type ephemeralResourceBase struct {
+	providerCtx *provider.Context
+}
+
+func (r *ephemeralResourceBase) Configure(_ context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	// the provider data is not available before the provider is configured (e.g. during the validation)
+	if request.ProviderData == nil {
+		return
+	}
+	providerCtx, ok := request.ProviderData.(*provider.Context)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected provider data",
+			fmt.Sprintf("Expected *provider.Context, got: %T. This is always a problem with the provider. Please report it to the provider developers.", request.ProviderData),
+		)
+		return
+	}
+	r.providerCtx = providerCtx
+}
+
+// ensureEnabled returns the error diagnostic when the given preview feature is not enabled in the provider configuration.
+func (r *ephemeralResourceBase) ensureEnabled(feature previewfeatures.PreviewFeature) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.providerCtx == nil {
+		diags.AddError("Provider not configured", "The ephemeral resource can not be used before the provider is configured.")
+		return diags
+	}
+	feat, err := previewfeatures.StringToFeature(feature.String())
+	if err != nil {
+		diags.AddError("Invalid preview feature", err.Error())
+		return diags
+	}
+	if err := previewfeatures.EnsurePreviewFeatureEnabled(feat, r.providerCtx.EnabledFeatures); err != nil {
+		diags.AddError("Preview feature not enabled", err.Error())
+	}
+	return diags
+}
+
+// trackingContext adds the usage tracking metadata to the context, like the tracking wrappers of the SDKv2 resources.
+func trackingContext(ctx context.Context, ephemeralResource ephemeralresources.EphemeralResource, operation tracking.Operation) context.Context {
+	return tracking.NewContext(ctx, tracking.NewVersionedEphemeralResourceMetadata(ephemeralResource, operation))
+}
@@ -0,0 +1,82 @@
+package ephemeralresources
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/ephemeralresources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &scimAccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &scimAccessTokenEphemeralResource{}
+)
+
+type scimAccessTokenEphemeralResource struct {
+	ephemeralResourceBase
+}
+
+func NewScimAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &scimAccessTokenEphemeralResource{}
+}
+
+type scimAccessTokenModel struct {
+	IntegrationName types.String `tfsdk:"integration_name"`
+	AccessToken     types.String `tfsdk:"access_token"`
+}
+
+func (r *scimAccessTokenEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = ephemeralresources.ScimAccessToken.String()
+}
+
+func (r *scimAccessTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Ephemeral resource used to generate an access token for a SCIM security integration with [SYSTEM$GENERATE_SCIM_ACCESS_TOKEN](https://docs.snowflake.com/en/sql-reference/functions/system_generate_scim_access_token). Contrary to the `snowflake_system_generate_scim_access_token` data source, the token is never persisted in the state or plan. Snowflake does not allow revoking the generated tokens, so the token stays valid after the Terraform run (for six months).",
+		Attributes: map[string]schema.Attribute{
+			"integration_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the SCIM security integration.",
+			},
+			"access_token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The generated SCIM access token.",
+			},
+		},
+	}
+}
+
+func (r *scimAccessTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	ctx = trackingContext(ctx, ephemeralresources.ScimAccessToken, tracking.OpenOperation)
+	response.Diagnostics.Append(r.ensureEnabled(previewfeatures.ScimAccessTokenEphemeralResource)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var model scimAccessTokenModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	integrationId, err := sdk.ParseAccountObjectIdentifier(model.IntegrationName.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("integration_name"), "Invalid integration name", err.Error())
+		return
+	}
+
+	accessToken, err := r.providerCtx.Client.SystemFunctions.GenerateScimAccessToken(ctx, integrationId)
+	if err != nil {
+		response.Diagnostics.AddError("Could not generate the SCIM access token", err.Error())
+		return
+	}
+
+	model.AccessToken = types.StringValue(accessToken)
+	response.Diagnostics.Append(response.Result.Set(ctx, &model)...)
+}
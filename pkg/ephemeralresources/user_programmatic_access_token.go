@@ -0,0 +1,189 @@
+package ephemeralresources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/ephemeralresources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &userProgrammaticAccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &userProgrammaticAccessTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &userProgrammaticAccessTokenEphemeralResource{}
+)
+
+// userProgrammaticAccessTokenPrivateKey is the key of the private data holding the identifiers needed to remove the token on close.
+const userProgrammaticAccessTokenPrivateKey = "user_programmatic_access_token"
+
+type userProgrammaticAccessTokenEphemeralResource struct {
+	ephemeralResourceBase
+}
+
+func NewUserProgrammaticAccessTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &userProgrammaticAccessTokenEphemeralResource{}
+}
+
+type userProgrammaticAccessTokenModel struct {
+	User                                 types.String `tfsdk:"user"`
+	Name                                 types.String `tfsdk:"name"`
+	RoleRestriction                      types.String `tfsdk:"role_restriction"`
+	DaysToExpiry                         types.Int64  `tfsdk:"days_to_expiry"`
+	MinsToBypassNetworkPolicyRequirement types.Int64  `tfsdk:"mins_to_bypass_network_policy_requirement"`
+	Comment                              types.String `tfsdk:"comment"`
+	Token                                types.String `tfsdk:"token"`
+}
+
+type userProgrammaticAccessTokenPrivateData struct {
+	User string `json:"user"`
+	Name string `json:"name"`
+}
+
+func (r *userProgrammaticAccessTokenEphemeralResource) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = ephemeralresources.UserProgrammaticAccessToken.String()
+}
+
+func (r *userProgrammaticAccessTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Description: "Ephemeral resource used to create a short-lived [programmatic access token](https://docs.snowflake.com/en/user-guide/programmatic-access-tokens) for a user. The token is added when the ephemeral resource is opened and removed when it is closed (at the end of every Terraform run), so it is never persisted in the state or plan. Use the `snowflake_user_programmatic_access_token` resource for the long-lived tokens.",
+		Attributes: map[string]schema.Attribute{
+			"user": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the user that the token is associated with. A user cannot use another user's programmatic access token to authenticate.",
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Specifies the name for the programmatic access token; must be unique for the user. The token with the same name can not be opened by the concurrent Terraform runs.",
+			},
+			"role_restriction": schema.StringAttribute{
+				Optional:    true,
+				Description: "The name of the role used for privilege evaluation and object creation. This must be one of the roles that has already been granted to the user.",
+			},
+			"days_to_expiry": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of days that the programmatic access token can be used for authentication. The token is removed at the end of the Terraform run anyway; this field limits its lifetime when the removal fails.",
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"mins_to_bypass_network_policy_requirement": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The number of minutes during which a user can use this token to access Snowflake without being subject to an active network policy.",
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"comment": schema.StringAttribute{
+				Optional:    true,
+				Description: "Descriptive comment about the programmatic access token.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The token itself. Use this to authenticate to an endpoint.",
+			},
+		},
+	}
+}
+
+func (r *userProgrammaticAccessTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	ctx = trackingContext(ctx, ephemeralresources.UserProgrammaticAccessToken, tracking.OpenOperation)
+	response.Diagnostics.Append(r.ensureEnabled(previewfeatures.UserProgrammaticAccessTokenEphemeralResource)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var model userProgrammaticAccessTokenModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	userId, err := sdk.ParseAccountObjectIdentifier(model.User.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("user"), "Invalid user", err.Error())
+		return
+	}
+	id, err := sdk.ParseAccountObjectIdentifier(model.Name.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("name"), "Invalid name", err.Error())
+		return
+	}
+
+	addRequest := sdk.NewAddUserProgrammaticAccessTokenRequest(userId, id)
+	if !model.RoleRestriction.IsNull() {
+		roleId, err := sdk.ParseAccountObjectIdentifier(model.RoleRestriction.ValueString())
+		if err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("role_restriction"), "Invalid role restriction", err.Error())
+			return
+		}
+		addRequest.WithRoleRestriction(roleId)
+	}
+	if !model.DaysToExpiry.IsNull() {
+		addRequest.WithDaysToExpiry(int(model.DaysToExpiry.ValueInt64()))
+	}
+	if !model.MinsToBypassNetworkPolicyRequirement.IsNull() {
+		addRequest.WithMinsToBypassNetworkPolicyRequirement(int(model.MinsToBypassNetworkPolicyRequirement.ValueInt64()))
+	}
+	if !model.Comment.IsNull() {
+		addRequest.WithComment(model.Comment.ValueString())
+	}
+
+	token, err := r.providerCtx.Client.Users.AddProgrammaticAccessToken(ctx, addRequest)
+	if err != nil {
+		response.Diagnostics.AddError("Could not add the programmatic access token", err.Error())
+		return
+	}
+
+	privateData, err := json.Marshal(userProgrammaticAccessTokenPrivateData{
+		User: userId.FullyQualifiedName(),
+		Name: token.ID().FullyQualifiedName(),
+	})
+	if err != nil {
+		response.Diagnostics.AddError("Could not store the token identifiers", err.Error())
+		return
+	}
+	response.Diagnostics.Append(response.Private.SetKey(ctx, userProgrammaticAccessTokenPrivateKey, privateData)...)
+
+	model.Token = types.StringValue(token.TokenSecret)
+	response.Diagnostics.Append(response.Result.Set(ctx, &model)...)
+}
+
+func (r *userProgrammaticAccessTokenEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
+	ctx = trackingContext(ctx, ephemeralresources.UserProgrammaticAccessToken, tracking.CloseOperation)
+	rawPrivateData, diags := request.Private.GetKey(ctx, userProgrammaticAccessTokenPrivateKey)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() || rawPrivateData == nil {
+		return
+	}
+
+	var privateData userProgrammaticAccessTokenPrivateData
+	if err := json.Unmarshal(rawPrivateData, &privateData); err != nil {
+		response.Diagnostics.AddError("Could not read the token identifiers", err.Error())
+		return
+	}
+	userId, err := sdk.ParseAccountObjectIdentifier(privateData.User)
+	if err != nil {
+		response.Diagnostics.AddError("Invalid user", err.Error())
+		return
+	}
+	id, err := sdk.ParseAccountObjectIdentifier(privateData.Name)
+	if err != nil {
+		response.Diagnostics.AddError("Invalid name", err.Error())
+		return
+	}
+
+	if r.providerCtx == nil {
+		response.Diagnostics.AddError("Provider not configured", fmt.Sprintf("Could not remove the programmatic access token %s of user %s.", id.FullyQualifiedName(), userId.FullyQualifiedName()))
+		return
+	}
+	if err := r.providerCtx.Client.Users.RemoveProgrammaticAccessTokenSafely(ctx, sdk.NewRemoveUserProgrammaticAccessTokenRequest(userId, id)); err != nil {
+		response.Diagnostics.AddError("Could not remove the programmatic access token", err.Error())
+	}
+}
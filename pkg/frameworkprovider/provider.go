@@ -0,0 +1,115 @@
+package frameworkprovider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/ephemeralresources"
+	internalprovider "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/providerfunctions"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+	sdkv2schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	_ provider.Provider                       = &snowflakeProvider{}
+	_ provider.ProviderWithEphemeralResources = &snowflakeProvider{}
+	_ provider.ProviderWithFunctions          = &snowflakeProvider{}
+)
+
+// snowflakeProvider is the plugin framework provider muxed with the SDKv2 provider. It serves only the features
+// that are not available in SDKv2 (i.e. ephemeral resources and provider-defined functions). It does not configure
+// its own client: the SDKv2 provider is configured first (the mux server configures the servers in order),
+// and its provider context is shared.
+type snowflakeProvider struct {
+	version       string
+	sdkV2Provider *sdkv2schema.Provider
+}
+
+func New(version string, sdkV2Provider *sdkv2schema.Provider) provider.Provider {
+	return &snowflakeProvider{
+		version:       version,
+		sdkV2Provider: sdkV2Provider,
+	}
+}
+
+func (p *snowflakeProvider) Metadata(_ context.Context, _ provider.MetadataRequest, response *provider.MetadataResponse) {
+	response.TypeName = "snowflake"
+	response.Version = p.version
+}
+
+func (p *snowflakeProvider) Schema(_ context.Context, _ provider.SchemaRequest, response *provider.SchemaResponse) {
+	providerSchema, err := schemaFromSdkV2(p.sdkV2Provider.Schema)
+	if err != nil {
+		response.Diagnostics.AddError("Could not convert the provider schema", err.Error())
+		return
+	}
+	response.Schema = providerSchema
+}
+
+func (p *snowflakeProvider) Configure(_ context.Context, _ provider.ConfigureRequest, response *provider.ConfigureResponse) {
+	providerCtx, ok := p.sdkV2Provider.Meta().(*internalprovider.Context)
+	if !ok || providerCtx == nil {
+		response.Diagnostics.AddError(
+			"Provider not configured",
+			fmt.Sprintf("The SDKv2 provider has to be configured before the plugin framework provider, got: %T. This is always a problem with the provider. Please report it to the provider developers.", p.sdkV2Provider.Meta()),
+		)
+		return
+	}
+	response.EphemeralResourceData = providerCtx
+}
+
+func (p *snowflakeProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+func (p *snowflakeProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+
+func (p *snowflakeProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		ephemeralresources.NewScimAccessTokenEphemeralResource,
+		ephemeralresources.NewUserProgrammaticAccessTokenEphemeralResource,
+	}
+}
+
+// Functions returns the provider-defined functions. They run locally and do not use the provider configuration.
+func (p *snowflakeProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		providerfunctions.NewEncodeResourceIdFunction,
+		providerfunctions.NewFullyQualifiedNameFunction,
+		providerfunctions.NewParseFunctionSignatureFunction,
+		providerfunctions.NewParseIdentifierFunction,
+	}
+}
+
+// NewMuxServer combines the given SDKv2 provider with the plugin framework provider into a single protocol 6 server.
+// The SDKv2 server has to be the first one, so that it is configured before the plugin framework provider (see Configure).
+func NewMuxServer(ctx context.Context, version string, sdkV2Provider *sdkv2schema.Provider) (tfprotov6.ProviderServer, error) {
+	upgradedSdkServer, err := tf5to6server.UpgradeServer(ctx, sdkV2Provider.GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := []func() tfprotov6.ProviderServer{
+		func() tfprotov6.ProviderServer {
+			return upgradedSdkServer
+		},
+		providerserver.NewProtocol6(New(version, sdkV2Provider)),
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer(), nil
+}
@@ -0,0 +1,32 @@
+package frameworkprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMuxServer_ProviderSchema(t *testing.T) {
+	ctx := context.Background()
+	server, err := NewMuxServer(ctx, "dev", provider.Provider())
+	require.NoError(t, err)
+
+	// the mux server reports the differences between the provider schemas of the combined servers as errors
+	response, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	require.NoError(t, err)
+	require.Empty(t, response.Diagnostics)
+
+	assert.NotNil(t, response.Provider)
+	assert.Contains(t, response.ResourceSchemas, "snowflake_database")
+	assert.Contains(t, response.DataSourceSchemas, "snowflake_databases")
+	assert.Contains(t, response.EphemeralResourceSchemas, "snowflake_scim_access_token")
+	assert.Contains(t, response.EphemeralResourceSchemas, "snowflake_user_programmatic_access_token")
+	assert.Contains(t, response.Functions, "encode_resource_id")
+	assert.Contains(t, response.Functions, "fully_qualified_name")
+	assert.Contains(t, response.Functions, "parse_function_signature")
+	assert.Contains(t, response.Functions, "parse_identifier")
+}
@@ -0,0 +1,169 @@
+package frameworkprovider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkv2schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// schemaFromSdkV2 converts the SDKv2 provider schema to the plugin framework one.
+// The servers combined by the mux server must return identical provider schemas, so the plugin framework provider
+// derives its schema from the SDKv2 provider (the single source of truth) instead of duplicating it.
+// Only the parts of the schema that are visible in the protocol are converted; the validation, defaults, and
+// the configuration handling stay in the SDKv2 provider.
+func schemaFromSdkV2(sdkV2Schema map[string]*sdkv2schema.Schema) (schema.Schema, error) {
+	attributes := make(map[string]schema.Attribute)
+	blocks := make(map[string]schema.Block)
+	for name, field := range sdkV2Schema {
+		if isNestedBlock(field) {
+			block, err := nestedBlockFromSdkV2(field)
+			if err != nil {
+				return schema.Schema{}, fmt.Errorf("converting block %s: %w", name, err)
+			}
+			blocks[name] = block
+			continue
+		}
+		attribute, err := attributeFromSdkV2(field)
+		if err != nil {
+			return schema.Schema{}, fmt.Errorf("converting attribute %s: %w", name, err)
+		}
+		attributes[name] = attribute
+	}
+	return schema.Schema{
+		Attributes: attributes,
+		Blocks:     blocks,
+	}, nil
+}
+
+func isNestedBlock(field *sdkv2schema.Schema) bool {
+	_, ok := field.Elem.(*sdkv2schema.Resource)
+	return ok && (field.Type == sdkv2schema.TypeList || field.Type == sdkv2schema.TypeSet)
+}
+
+func nestedBlockFromSdkV2(field *sdkv2schema.Schema) (schema.Block, error) {
+	attributes := make(map[string]schema.Attribute)
+	for name, nestedField := range field.Elem.(*sdkv2schema.Resource).SchemaMap() {
+		if isNestedBlock(nestedField) {
+			return nil, fmt.Errorf("nested block %s is not supported", name)
+		}
+		attribute, err := attributeFromSdkV2(nestedField)
+		if err != nil {
+			return nil, fmt.Errorf("converting attribute %s: %w", name, err)
+		}
+		attributes[name] = attribute
+	}
+	nestedObject := schema.NestedBlockObject{Attributes: attributes}
+	switch field.Type {
+	case sdkv2schema.TypeList:
+		return schema.ListNestedBlock{
+			Description:        field.Description,
+			DeprecationMessage: field.Deprecated,
+			NestedObject:       nestedObject,
+		}, nil
+	default:
+		return schema.SetNestedBlock{
+			Description:        field.Description,
+			DeprecationMessage: field.Deprecated,
+			NestedObject:       nestedObject,
+		}, nil
+	}
+}
+
+func attributeFromSdkV2(field *sdkv2schema.Schema) (schema.Attribute, error) {
+	switch field.Type {
+	case sdkv2schema.TypeString:
+		return schema.StringAttribute{
+			Description:        field.Description,
+			DeprecationMessage: field.Deprecated,
+			Required:           field.Required,
+			Optional:           field.Optional,
+			Sensitive:          field.Sensitive,
+		}, nil
+	case sdkv2schema.TypeBool:
+		return schema.BoolAttribute{
+			Description:        field.Description,
+			DeprecationMessage: field.Deprecated,
+			Required:           field.Required,
+			Optional:           field.Optional,
+			Sensitive:          field.Sensitive,
+		}, nil
+	case sdkv2schema.TypeInt:
+		return schema.Int64Attribute{
+			Description:        field.Description,
+			DeprecationMessage: field.Deprecated,
+			Required:           field.Required,
+			Optional:           field.Optional,
+			Sensitive:          field.Sensitive,
+		}, nil
+	case sdkv2schema.TypeFloat:
+		return schema.Float64Attribute{
+			Description:        field.Description,
+			DeprecationMessage: field.Deprecated,
+			Required:           field.Required,
+			Optional:           field.Optional,
+			Sensitive:          field.Sensitive,
+		}, nil
+	case sdkv2schema.TypeList, sdkv2schema.TypeSet, sdkv2schema.TypeMap:
+		elementType, err := elementTypeFromSdkV2(field.Elem)
+		if err != nil {
+			return nil, err
+		}
+		switch field.Type {
+		case sdkv2schema.TypeList:
+			return schema.ListAttribute{
+				Description:        field.Description,
+				DeprecationMessage: field.Deprecated,
+				ElementType:        elementType,
+				Required:           field.Required,
+				Optional:           field.Optional,
+				Sensitive:          field.Sensitive,
+			}, nil
+		case sdkv2schema.TypeSet:
+			return schema.SetAttribute{
+				Description:        field.Description,
+				DeprecationMessage: field.Deprecated,
+				ElementType:        elementType,
+				Required:           field.Required,
+				Optional:           field.Optional,
+				Sensitive:          field.Sensitive,
+			}, nil
+		default:
+			return schema.MapAttribute{
+				Description:        field.Description,
+				DeprecationMessage: field.Deprecated,
+				ElementType:        elementType,
+				Required:           field.Required,
+				Optional:           field.Optional,
+				Sensitive:          field.Sensitive,
+			}, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported type %s", field.Type)
+	}
+}
+
+// elementTypeFromSdkV2 returns the type of the collection elements; SDKv2 defaults to strings when Elem is not set.
+func elementTypeFromSdkV2(elem any) (attr.Type, error) {
+	if elem == nil {
+		return types.StringType, nil
+	}
+	elemSchema, ok := elem.(*sdkv2schema.Schema)
+	if !ok {
+		return nil, fmt.Errorf("unsupported collection element %T", elem)
+	}
+	switch elemSchema.Type {
+	case sdkv2schema.TypeString:
+		return types.StringType, nil
+	case sdkv2schema.TypeBool:
+		return types.BoolType, nil
+	case sdkv2schema.TypeInt:
+		return types.Int64Type, nil
+	case sdkv2schema.TypeFloat:
+		return types.Float64Type, nil
+	default:
+		return nil, fmt.Errorf("unsupported collection element type %s", elemSchema.Type)
+	}
+}
@@ -10,4 +10,6 @@ type Context struct {
 	RoleShowCache        *Cache[*sdk.Role]
 	// GrantShowCache caches SHOW GRANTS results, keyed by rendered SQL (see sdk.StructToSQL).
 	GrantShowCache *Cache[[]sdk.Grant]
+	// DefaultTags holds the provider's default_tags, keyed by the fully qualified tag name.
+	DefaultTags map[string]string
 }
@@ -43,6 +43,14 @@ const (
 	IncludeRetryReason                 = "SNOWFLAKE_INCLUDE_RETRY_REASON"
 	Profile                            = "SNOWFLAKE_PROFILE"
 	MaxRetryCount                      = "SNOWFLAKE_MAX_RETRY_COUNT"
+	StatementRetryMaxAttempts          = "SNOWFLAKE_STATEMENT_RETRY_MAX_ATTEMPTS"
+	StatementRetryInitialBackoff       = "SNOWFLAKE_STATEMENT_RETRY_INITIAL_BACKOFF"
+	StatementRetryMaxBackoff           = "SNOWFLAKE_STATEMENT_RETRY_MAX_BACKOFF"
+	QueryTag                           = "SNOWFLAKE_QUERY_TAG"
+	QueryTagIncludeMetadata            = "SNOWFLAKE_QUERY_TAG_INCLUDE_METADATA"
+	QueryTagWorkspace                  = "SNOWFLAKE_QUERY_TAG_WORKSPACE"
+	SqlAuditLogPath                    = "SNOWFLAKE_SQL_AUDIT_LOG_PATH"
+	SqlDryRun                          = "SNOWFLAKE_SQL_DRY_RUN"
 	DriverTracing                      = "SNOWFLAKE_DRIVER_TRACING"
 	TmpDirectoryPath                   = "SNOWFLAKE_TMP_DIRECTORY_PATH"
 	DisableConsoleLogin                = "SNOWFLAKE_DISABLE_CONSOLE_LOGIN"
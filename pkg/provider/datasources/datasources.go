@@ -5,9 +5,14 @@ type datasource string
 const (
 	Accounts                       datasource = "snowflake_accounts"
 	AccountRoles                   datasource = "snowflake_account_roles"
+	AggregationPolicies            datasource = "snowflake_aggregation_policies"
 	Alerts                         datasource = "snowflake_alerts"
 	ApiIntegrations                datasource = "snowflake_api_integrations"
+	ApplicationPackages            datasource = "snowflake_application_packages"
+	Applications                   datasource = "snowflake_applications"
 	AuthenticationPolicies         datasource = "snowflake_authentication_policies"
+	BackupPolicies                 datasource = "snowflake_backup_policies"
+	Budgets                        datasource = "snowflake_budgets"
 	CatalogIntegrations            datasource = "snowflake_catalog_integrations"
 	ComputePools                   datasource = "snowflake_compute_pools"
 	Connections                    datasource = "snowflake_connections"
@@ -15,22 +20,27 @@ const (
 	CortexSearchServices           datasource = "snowflake_cortex_search_services"
 	CurrentAccount                 datasource = "snowflake_current_account"
 	CurrentRole                    datasource = "snowflake_current_role"
+	DataMetricFunctionReferences   datasource = "snowflake_data_metric_function_references"
 	Database                       datasource = "snowflake_database"
 	DatabaseRole                   datasource = "snowflake_database_role"
 	DatabaseRoles                  datasource = "snowflake_database_roles"
 	Databases                      datasource = "snowflake_databases"
 	DynamicTables                  datasource = "snowflake_dynamic_tables"
+	EventTables                    datasource = "snowflake_event_tables"
 	ExternalAccessIntegrations     datasource = "snowflake_external_access_integrations"
 	ExternalFunctions              datasource = "snowflake_external_functions"
 	ExternalTables                 datasource = "snowflake_external_tables"
 	ExternalVolumes                datasource = "snowflake_external_volumes"
+	FailoverGroupMembers           datasource = "snowflake_failover_group_members"
 	FailoverGroups                 datasource = "snowflake_failover_groups"
 	FileFormats                    datasource = "snowflake_file_formats"
 	Functions                      datasource = "snowflake_functions"
 	GitRepositories                datasource = "snowflake_git_repositories"
+	GitRepositoryRefs              datasource = "snowflake_git_repository_refs"
 	Grants                         datasource = "snowflake_grants"
 	IcebergTables                  datasource = "snowflake_iceberg_tables"
 	ImageRepositories              datasource = "snowflake_image_repositories"
+	JoinPolicies                   datasource = "snowflake_join_policies"
 	Listings                       datasource = "snowflake_listings"
 	MaskingPolicies                datasource = "snowflake_masking_policies"
 	MaterializedViews              datasource = "snowflake_materialized_views"
@@ -38,10 +48,16 @@ const (
 	NetworkPolicies                datasource = "snowflake_network_policies"
 	NetworkRules                   datasource = "snowflake_network_rules"
 	Notebooks                      datasource = "snowflake_notebooks"
+	OrganizationAccounts           datasource = "snowflake_organization_accounts"
 	Parameters                     datasource = "snowflake_parameters"
 	PasswordPolicies               datasource = "snowflake_password_policies"
 	Pipes                          datasource = "snowflake_pipes"
+	PolicyReferences               datasource = "snowflake_policy_references"
 	Procedures                     datasource = "snowflake_procedures"
+	ProjectionPolicies             datasource = "snowflake_projection_policies"
+	Regions                        datasource = "snowflake_regions"
+	ReplicationAccounts            datasource = "snowflake_replication_accounts"
+	ReplicationDatabases           datasource = "snowflake_replication_databases"
 	ResourceMonitors               datasource = "snowflake_resource_monitors"
 	RowAccessPolicies              datasource = "snowflake_row_access_policies"
 	Schemas                        datasource = "snowflake_schemas"
@@ -0,0 +1,19 @@
+package ephemeralresources
+
+type ephemeralResource string
+
+const (
+	ScimAccessToken             ephemeralResource = "snowflake_scim_access_token"
+	UserProgrammaticAccessToken ephemeralResource = "snowflake_user_programmatic_access_token"
+)
+
+type EphemeralResource interface {
+	xxxProtected()
+	String() string
+}
+
+func (r ephemeralResource) xxxProtected() {}
+
+func (r ephemeralResource) String() string {
+	return string(r)
+}
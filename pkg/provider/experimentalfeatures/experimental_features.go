@@ -132,7 +132,7 @@ var allExperiments = []Experiment{
 		ExperimentalFeatureStateActive,
 		joinWithDoubleNewline(
 			"When enabled, grant destroy operations silently succeed when the underlying Snowflake object (or its dependencies) no longer exists.",
-			"Currently supported by: `snowflake_grant_privileges_to_account_role`, `snowflake_grant_privileges_to_database_role`, `snowflake_grant_privileges_to_share`, `snowflake_grant_account_role`, `snowflake_grant_database_role`, `snowflake_grant_application_role`, `snowflake_grant_ownership`.",
+			"Currently supported by: `snowflake_grant_privileges_to_account_role`, `snowflake_grant_privileges_to_database_role`, `snowflake_grant_privileges_to_application_role`, `snowflake_grant_privileges_to_share`, `snowflake_grant_account_role`, `snowflake_grant_database_role`, `snowflake_grant_application_role`, `snowflake_grant_ownership`, `snowflake_grant_caller_privileges`, `snowflake_account_role_membership`, `snowflake_grant_privileges_matrix`.",
 			"This prevents errors when, for example, a warehouse or role is deleted externally and the corresponding grant resource is later removed from the Terraform configuration.",
 			"Without this experiment, destroying such resources fails with `does not exist or not authorized`.",
 		),
@@ -174,7 +174,7 @@ var allExperiments = []Experiment{
 		ExperimentalFeatureStateActive,
 		joinWithDoubleNewline(
 			"When enabled, `SHOW GRANTS` results are cached in memory for the duration of a single plan or apply cycle, so multiple resource instances resolving to the same underlying SHOW statement share one round-trip instead of each issuing their own.",
-			"Currently supported by: `snowflake_grant_privileges_to_account_role`, `snowflake_grant_ownership`.",
+			"Currently supported by: `snowflake_grant_privileges_to_account_role`, `snowflake_grant_privileges_to_application_role`, `snowflake_grant_ownership`, `snowflake_grant_privileges_matrix`.",
 			"Without caching, every resource instance issues an independent `SHOW GRANTS ON <object>` / `SHOW FUTURE GRANTS IN <container>` call during Read. In configurations with many grants resolving to the same underlying SHOW statement (e.g. many privilege grants on the same schema, or many future-grant roles on the same database), this results in N identical round-trips returning the same full result set — only 1 is needed.",
 			"The first Read for a given SHOW statement fetches and caches the result; subsequent Reads in the same plan reuse it. The cache is invalidated on Create, Update, and Delete of the resources listed above so mutations within a single apply remain visible to subsequent Reads.",
 			fmt.Sprintf("This is a separate flag from `%s`: enabling this does not enable caching for `snowflake_grant_account_role`, and vice versa. Both can be enabled together.", GrantAccountRoleShowCaching),
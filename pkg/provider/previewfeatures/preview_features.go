@@ -13,7 +13,10 @@ type feature string
 const (
 	AccountAuthenticationPolicyAttachmentResource  feature = "snowflake_account_authentication_policy_attachment_resource"
 	AccountPasswordPolicyAttachmentResource        feature = "snowflake_account_password_policy_attachment_resource"
+	AccountRoleMembershipResource                  feature = "snowflake_account_role_membership_resource"
 	AccountSessionPolicyAttachmentResource         feature = "snowflake_account_session_policy_attachment_resource"
+	AggregationPolicyResource                      feature = "snowflake_aggregation_policy_resource"
+	AggregationPoliciesDatasource                  feature = "snowflake_aggregation_policies_datasource"
 	AlertResource                                  feature = "snowflake_alert_resource"
 	AlertsDatasource                               feature = "snowflake_alerts_datasource"
 	ApiIntegrationsDatasource                      feature = "snowflake_api_integrations_datasource"
@@ -27,8 +30,15 @@ const (
 	ApiIntegrationGitRepositoryPrivateLinkResource feature = "snowflake_api_integration_git_repository_private_link_resource"
 	ApiIntegrationGitRepositoryTokenResource       feature = "snowflake_api_integration_git_repository_token_resource"
 	ApiIntegrationGoogleCloudApiGatewayResource    feature = "snowflake_api_integration_google_cloud_api_gateway_resource"
+	ApplicationResource                            feature = "snowflake_application_resource"
+	ApplicationsDatasource                         feature = "snowflake_applications_datasource"
+	ApplicationPackageResource                     feature = "snowflake_application_package_resource"
+	ApplicationPackagesDatasource                  feature = "snowflake_application_packages_datasource"
 	AuthenticationPolicyResource                   feature = "snowflake_authentication_policy_resource"
 	AuthenticationPoliciesDatasource               feature = "snowflake_authentication_policies_datasource"
+	BudgetResource                                 feature = "snowflake_budget_resource"
+	BudgetTrackedObjectResource                    feature = "snowflake_budget_tracked_object_resource"
+	BudgetsDatasource                              feature = "snowflake_budgets_datasource"
 	CatalogIntegrationAwsGlueResource              feature = "snowflake_catalog_integration_aws_glue_resource"
 	CatalogIntegrationObjectStorageResource        feature = "snowflake_catalog_integration_object_storage_resource"
 	CatalogIntegrationOpenCatalogResource          feature = "snowflake_catalog_integration_open_catalog_resource"
@@ -36,6 +46,7 @@ const (
 	CatalogIntegrationsDatasource                  feature = "snowflake_catalog_integrations_datasource"
 	ComputePoolResource                            feature = "snowflake_compute_pool_resource"
 	ComputePoolsDatasource                         feature = "snowflake_compute_pools_datasource"
+	ContactResource                                feature = "snowflake_contact_resource"
 	CortexAgentResource                            feature = "snowflake_cortex_agent_resource"
 	CortexAgentsDatasource                         feature = "snowflake_cortex_agents_datasource"
 	CortexSearchServiceResource                    feature = "snowflake_cortex_search_service_resource"
@@ -43,11 +54,15 @@ const (
 	CurrentAccountResource                         feature = "snowflake_current_account_resource"
 	CurrentAccountDatasource                       feature = "snowflake_current_account_datasource"
 	CurrentOrganizationAccountResource             feature = "snowflake_current_organization_account_resource"
+	DataMetricFunctionAttachmentResource           feature = "snowflake_data_metric_function_attachment_resource"
+	DataMetricFunctionReferencesDatasource         feature = "snowflake_data_metric_function_references_datasource"
 	DatabaseDatasource                             feature = "snowflake_database_datasource"
 	DatabaseRoleDatasource                         feature = "snowflake_database_role_datasource"
 	DynamicTableResource                           feature = "snowflake_dynamic_table_resource"
 	DynamicTablesDatasource                        feature = "snowflake_dynamic_tables_datasource"
 	EmailNotificationIntegrationResource           feature = "snowflake_email_notification_integration_resource"
+	EventTableResource                             feature = "snowflake_event_table_resource"
+	EventTablesDatasource                          feature = "snowflake_event_tables_datasource"
 	ExternalAccessIntegrationResource              feature = "snowflake_external_access_integration_resource"
 	ExternalAccessIntegrationsDatasource           feature = "snowflake_external_access_integrations_datasource"
 	ExternalAzureStageResource                     feature = "snowflake_stage_external_azure_resource"
@@ -62,6 +77,7 @@ const (
 	ExternalVolumesDatasource                      feature = "snowflake_external_volumes_datasource"
 	FailoverGroupResource                          feature = "snowflake_failover_group_resource"
 	FailoverGroupsDatasource                       feature = "snowflake_failover_groups_datasource"
+	FailoverGroupMembersDatasource                 feature = "snowflake_failover_group_members_datasource"
 	FileFormatResource                             feature = "snowflake_file_format_resource"
 	FileFormatAvroResource                         feature = "snowflake_file_format_avro_resource"
 	FileFormatCsvResource                          feature = "snowflake_file_format_csv_resource"
@@ -78,6 +94,10 @@ const (
 	FunctionsDatasource                            feature = "snowflake_functions_datasource"
 	GitRepositoryResource                          feature = "snowflake_git_repository_resource"
 	GitRepositoriesDatasource                      feature = "snowflake_git_repositories_datasource"
+	GitRepositoryRefsDatasource                    feature = "snowflake_git_repository_refs_datasource"
+	GrantCallerPrivilegesResource                  feature = "snowflake_grant_caller_privileges_resource"
+	GrantPrivilegesMatrixResource                  feature = "snowflake_grant_privileges_matrix_resource"
+	GrantPrivilegesToApplicationRoleResource       feature = "snowflake_grant_privileges_to_application_role_resource"
 	HybridTableResource                            feature = "snowflake_hybrid_table_resource"
 	IcebergTableResource                           feature = "snowflake_iceberg_table_resource"
 	IcebergTableFromAwsGlueResource                feature = "snowflake_iceberg_table_from_aws_glue_resource"
@@ -89,6 +109,8 @@ const (
 	ImageRepositoriesDatasource                    feature = "snowflake_image_repositories_datasource"
 	InternalStageResource                          feature = "snowflake_stage_internal_resource"
 	JobServiceResource                             feature = "snowflake_job_service_resource"
+	JoinPolicyResource                             feature = "snowflake_join_policy_resource"
+	JoinPoliciesDatasource                         feature = "snowflake_join_policies_datasource"
 	ListingResource                                feature = "snowflake_listing_resource"
 	ListingsDatasource                             feature = "snowflake_listings_datasource"
 	ManagedAccountResource                         feature = "snowflake_managed_account_resource"
@@ -103,10 +125,17 @@ const (
 	NotebooksDatasource                            feature = "snowflake_notebooks_datasource"
 	NotificationIntegrationResource                feature = "snowflake_notification_integration_resource"
 	ObjectParameterResource                        feature = "snowflake_object_parameter_resource"
+	ObjectContactsResource                         feature = "snowflake_object_contacts_resource"
+	OpenflowConnectorResource                      feature = "snowflake_openflow_connector_resource"
+	OpenflowDeploymentResource                     feature = "snowflake_openflow_deployment_resource"
+	OpenflowRuntimeResource                        feature = "snowflake_openflow_runtime_resource"
+	OrganizationAccountResource                    feature = "snowflake_organization_account_resource"
+	OrganizationAccountsDatasource                 feature = "snowflake_organization_accounts_datasource"
 	PasswordPoliciesDatasource                     feature = "snowflake_password_policies_datasource"
 	PasswordPolicyResource                         feature = "snowflake_password_policy_resource"
 	PipeResource                                   feature = "snowflake_pipe_resource"
 	PipesDatasource                                feature = "snowflake_pipes_datasource"
+	PolicyReferencesDatasource                     feature = "snowflake_policy_references_datasource"
 	PostgresForkResource                           feature = "snowflake_postgres_fork_resource"
 	PostgresInstanceResource                       feature = "snowflake_postgres_instance_resource"
 	ProcedureJavaResource                          feature = "snowflake_procedure_java_resource"
@@ -115,7 +144,13 @@ const (
 	ProcedureScalaResource                         feature = "snowflake_procedure_scala_resource"
 	ProcedureSqlResource                           feature = "snowflake_procedure_sql_resource"
 	ProceduresDatasource                           feature = "snowflake_procedures_datasource"
+	ProjectionPolicyResource                       feature = "snowflake_projection_policy_resource"
+	ProjectionPoliciesDatasource                   feature = "snowflake_projection_policies_datasource"
+	RegionsDatasource                              feature = "snowflake_regions_datasource"
+	ReplicationAccountsDatasource                  feature = "snowflake_replication_accounts_datasource"
+	ReplicationDatabasesDatasource                 feature = "snowflake_replication_databases_datasource"
 	CurrentRoleDatasource                          feature = "snowflake_current_role_datasource"
+	ScimAccessTokenEphemeralResource               feature = "snowflake_scim_access_token_ephemeral_resource"
 	SemanticViewResource                           feature = "snowflake_semantic_view_resource"
 	SemanticViewDatasource                         feature = "snowflake_semantic_views_datasource"
 	SessionPoliciesDatasource                      feature = "snowflake_session_policies_datasource"
@@ -149,6 +184,7 @@ const (
 	UserPublicKeysResource                         feature = "snowflake_user_public_keys_resource"
 	UserPasswordPolicyAttachmentResource           feature = "snowflake_user_password_policy_attachment_resource"
 	UserProgrammaticAccessTokenResource            feature = "snowflake_user_programmatic_access_token_resource"
+	UserProgrammaticAccessTokenEphemeralResource   feature = "snowflake_user_programmatic_access_token_ephemeral_resource"
 	UserSessionPolicyAttachmentResource            feature = "snowflake_user_session_policy_attachment_resource"
 	UserProgrammaticAccessTokensDatasource         feature = "snowflake_user_programmatic_access_tokens_datasource"
 	WarehouseAdaptiveResource                      feature = "snowflake_warehouse_adaptive_resource"
@@ -158,6 +194,9 @@ const (
 var allPreviewFeatures = []feature{
 	AccountAuthenticationPolicyAttachmentResource,
 	AccountPasswordPolicyAttachmentResource,
+	AccountRoleMembershipResource,
+	AggregationPolicyResource,
+	AggregationPoliciesDatasource,
 	AlertResource,
 	AlertsDatasource,
 	ApiIntegrationsDatasource,
@@ -171,15 +210,27 @@ var allPreviewFeatures = []feature{
 	ApiIntegrationGitRepositoryPrivateLinkResource,
 	ApiIntegrationGitRepositoryTokenResource,
 	ApiIntegrationGoogleCloudApiGatewayResource,
+	ApplicationResource,
+	ApplicationsDatasource,
+	ApplicationPackageResource,
+	ApplicationPackagesDatasource,
+	BudgetResource,
+	BudgetTrackedObjectResource,
+	BudgetsDatasource,
+	ContactResource,
 	CortexAgentResource,
 	CortexAgentsDatasource,
 	CortexSearchServiceResource,
 	CortexSearchServicesDatasource,
 	CurrentAccountDatasource,
+	DataMetricFunctionAttachmentResource,
+	DataMetricFunctionReferencesDatasource,
 	DatabaseDatasource,
 	DatabaseRoleDatasource,
 	DynamicTableResource,
 	DynamicTablesDatasource,
+	EventTableResource,
+	EventTablesDatasource,
 	ExternalAccessIntegrationResource,
 	ExternalAccessIntegrationsDatasource,
 	ExternalFunctionResource,
@@ -188,6 +239,7 @@ var allPreviewFeatures = []feature{
 	ExternalTablesDatasource,
 	FailoverGroupResource,
 	FailoverGroupsDatasource,
+	FailoverGroupMembersDatasource,
 	FileFormatResource,
 	FileFormatAvroResource,
 	FileFormatCsvResource,
@@ -202,6 +254,10 @@ var allPreviewFeatures = []feature{
 	FunctionScalaResource,
 	FunctionSqlResource,
 	FunctionsDatasource,
+	GitRepositoryRefsDatasource,
+	GrantCallerPrivilegesResource,
+	GrantPrivilegesMatrixResource,
+	GrantPrivilegesToApplicationRoleResource,
 	HybridTableResource,
 	IcebergTableResource,
 	IcebergTableFromAwsGlueResource,
@@ -210,6 +266,8 @@ var allPreviewFeatures = []feature{
 	IcebergTableFromRestResource,
 	IcebergTablesDatasource,
 	JobServiceResource,
+	JoinPolicyResource,
+	JoinPoliciesDatasource,
 	ListingsDatasource,
 	ManagedAccountResource,
 	MaterializedViewResource,
@@ -221,12 +279,20 @@ var allPreviewFeatures = []feature{
 	NotebooksDatasource,
 	EmailNotificationIntegrationResource,
 	NotificationIntegrationResource,
+	ObjectContactsResource,
 	ObjectParameterResource,
+	OpenflowConnectorResource,
+	OpenflowDeploymentResource,
+	OpenflowRuntimeResource,
+	OrganizationAccountResource,
+	OrganizationAccountsDatasource,
 	PipeResource,
 	PipesDatasource,
+	PolicyReferencesDatasource,
 	// PostgresForkResource,
 	PostgresInstanceResource,
 	CurrentRoleDatasource,
+	ScimAccessTokenEphemeralResource,
 	SemanticViewResource,
 	SemanticViewDatasource,
 	SequenceResource,
@@ -240,6 +306,11 @@ var allPreviewFeatures = []feature{
 	ProcedureScalaResource,
 	ProcedureSqlResource,
 	ProceduresDatasource,
+	ProjectionPolicyResource,
+	ProjectionPoliciesDatasource,
+	RegionsDatasource,
+	ReplicationAccountsDatasource,
+	ReplicationDatabasesDatasource,
 	StageResource,
 	StagesDatasource,
 	StorageIntegrationResource,
@@ -257,6 +328,7 @@ var allPreviewFeatures = []feature{
 	UserAuthenticationPolicyAttachmentResource,
 	UserPasswordPolicyAttachmentResource,
 	UserPublicKeysResource,
+	UserProgrammaticAccessTokenEphemeralResource,
 	WarehouseAdaptiveResource,
 	WarehouseInteractiveResource,
 }
@@ -20,7 +20,10 @@ func Test_StringToFeature(t *testing.T) {
 		// Supported Values.
 		{input: "snowflake_account_authentication_policy_attachment_resource", want: AccountAuthenticationPolicyAttachmentResource},
 		{input: "snowflake_account_password_policy_attachment_resource", want: AccountPasswordPolicyAttachmentResource},
+		{input: "snowflake_account_role_membership_resource", want: AccountRoleMembershipResource},
 		{input: "snowflake_account_session_policy_attachment_resource", want: AccountSessionPolicyAttachmentResource},
+		{input: "snowflake_aggregation_policy_resource", want: AggregationPolicyResource},
+		{input: "snowflake_aggregation_policies_datasource", want: AggregationPoliciesDatasource},
 		{input: "snowflake_alert_resource", want: AlertResource},
 		{input: "snowflake_alerts_datasource", want: AlertsDatasource},
 		{input: "snowflake_api_integrations_datasource", want: ApiIntegrationsDatasource},
@@ -34,8 +37,15 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_api_integration_git_repository_private_link_resource", want: ApiIntegrationGitRepositoryPrivateLinkResource},
 		{input: "snowflake_api_integration_git_repository_token_resource", want: ApiIntegrationGitRepositoryTokenResource},
 		{input: "snowflake_api_integration_google_cloud_api_gateway_resource", want: ApiIntegrationGoogleCloudApiGatewayResource},
+		{input: "snowflake_application_resource", want: ApplicationResource},
+		{input: "snowflake_applications_datasource", want: ApplicationsDatasource},
+		{input: "snowflake_application_package_resource", want: ApplicationPackageResource},
+		{input: "snowflake_application_packages_datasource", want: ApplicationPackagesDatasource},
 		{input: "snowflake_authentication_policy_resource", want: AuthenticationPolicyResource},
 		{input: "snowflake_authentication_policies_datasource", want: AuthenticationPoliciesDatasource},
+		{input: "snowflake_budget_resource", want: BudgetResource},
+		{input: "snowflake_budget_tracked_object_resource", want: BudgetTrackedObjectResource},
+		{input: "snowflake_budgets_datasource", want: BudgetsDatasource},
 		{input: "snowflake_catalog_integration_aws_glue_resource", want: CatalogIntegrationAwsGlueResource},
 		{input: "snowflake_catalog_integration_object_storage_resource", want: CatalogIntegrationObjectStorageResource},
 		{input: "snowflake_catalog_integration_open_catalog_resource", want: CatalogIntegrationOpenCatalogResource},
@@ -43,6 +53,7 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_catalog_integrations_datasource", want: CatalogIntegrationsDatasource},
 		{input: "snowflake_compute_pool_resource", want: ComputePoolResource},
 		{input: "snowflake_compute_pools_datasource", want: ComputePoolsDatasource},
+		{input: "snowflake_contact_resource", want: ContactResource},
 		{input: "snowflake_cortex_agent_resource", want: CortexAgentResource},
 		{input: "snowflake_cortex_agents_datasource", want: CortexAgentsDatasource},
 		{input: "snowflake_cortex_search_service_resource", want: CortexSearchServiceResource},
@@ -50,11 +61,15 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_current_account_resource", want: CurrentAccountResource},
 		{input: "snowflake_current_account_datasource", want: CurrentAccountDatasource},
 		{input: "snowflake_current_organization_account_resource", want: CurrentOrganizationAccountResource},
+		{input: "snowflake_data_metric_function_attachment_resource", want: DataMetricFunctionAttachmentResource},
+		{input: "snowflake_data_metric_function_references_datasource", want: DataMetricFunctionReferencesDatasource},
 		{input: "snowflake_database_datasource", want: DatabaseDatasource},
 		{input: "snowflake_database_role_datasource", want: DatabaseRoleDatasource},
 		{input: "snowflake_dynamic_table_resource", want: DynamicTableResource},
 		{input: "snowflake_dynamic_tables_datasource", want: DynamicTablesDatasource},
 		{input: "snowflake_email_notification_integration_resource", want: EmailNotificationIntegrationResource},
+		{input: "snowflake_event_table_resource", want: EventTableResource},
+		{input: "snowflake_event_tables_datasource", want: EventTablesDatasource},
 		{input: "snowflake_external_access_integration_resource", want: ExternalAccessIntegrationResource},
 		{input: "snowflake_external_access_integrations_datasource", want: ExternalAccessIntegrationsDatasource},
 		{input: "snowflake_stage_external_azure_resource", want: ExternalAzureStageResource},
@@ -69,6 +84,7 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_external_volumes_datasource", want: ExternalVolumesDatasource},
 		{input: "snowflake_failover_group_resource", want: FailoverGroupResource},
 		{input: "snowflake_failover_groups_datasource", want: FailoverGroupsDatasource},
+		{input: "snowflake_failover_group_members_datasource", want: FailoverGroupMembersDatasource},
 		{input: "snowflake_file_format_resource", want: FileFormatResource},
 		{input: "snowflake_file_format_avro_resource", want: FileFormatAvroResource},
 		{input: "snowflake_file_format_csv_resource", want: FileFormatCsvResource},
@@ -84,6 +100,10 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_functions_datasource", want: FunctionsDatasource},
 		{input: "snowflake_git_repository_resource", want: GitRepositoryResource},
 		{input: "snowflake_git_repositories_datasource", want: GitRepositoriesDatasource},
+		{input: "snowflake_git_repository_refs_datasource", want: GitRepositoryRefsDatasource},
+		{input: "snowflake_grant_caller_privileges_resource", want: GrantCallerPrivilegesResource},
+		{input: "snowflake_grant_privileges_matrix_resource", want: GrantPrivilegesMatrixResource},
+		{input: "snowflake_grant_privileges_to_application_role_resource", want: GrantPrivilegesToApplicationRoleResource},
 		{input: "snowflake_hybrid_table_resource", want: HybridTableResource},
 		{input: "snowflake_iceberg_table_from_delta_files_resource", want: IcebergTableFromDeltaFilesResource},
 		{input: "snowflake_iceberg_table_from_files_resource", want: IcebergTableFromFilesResource},
@@ -94,6 +114,8 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_image_repositories_datasource", want: ImageRepositoriesDatasource},
 		{input: "snowflake_stage_internal_resource", want: InternalStageResource},
 		{input: "snowflake_job_service_resource", want: JobServiceResource},
+		{input: "snowflake_join_policy_resource", want: JoinPolicyResource},
+		{input: "snowflake_join_policies_datasource", want: JoinPoliciesDatasource},
 		{input: "snowflake_listing_resource", want: ListingResource},
 		{input: "snowflake_listings_datasource", want: ListingsDatasource},
 		{input: "snowflake_managed_account_resource", want: ManagedAccountResource},
@@ -107,11 +129,18 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_notebook_resource", want: NotebookResource},
 		{input: "snowflake_notebooks_datasource", want: NotebooksDatasource},
 		{input: "snowflake_notification_integration_resource", want: NotificationIntegrationResource},
+		{input: "snowflake_object_contacts_resource", want: ObjectContactsResource},
 		{input: "snowflake_object_parameter_resource", want: ObjectParameterResource},
+		{input: "snowflake_openflow_connector_resource", want: OpenflowConnectorResource},
+		{input: "snowflake_openflow_deployment_resource", want: OpenflowDeploymentResource},
+		{input: "snowflake_openflow_runtime_resource", want: OpenflowRuntimeResource},
+		{input: "snowflake_organization_account_resource", want: OrganizationAccountResource},
+		{input: "snowflake_organization_accounts_datasource", want: OrganizationAccountsDatasource},
 		{input: "snowflake_password_policies_datasource", want: PasswordPoliciesDatasource},
 		{input: "snowflake_password_policy_resource", want: PasswordPolicyResource},
 		{input: "snowflake_pipe_resource", want: PipeResource},
 		{input: "snowflake_pipes_datasource", want: PipesDatasource},
+		{input: "snowflake_policy_references_datasource", want: PolicyReferencesDatasource},
 		// {input: "snowflake_postgres_fork_resource", want: PostgresForkResource},
 		// {input: "snowflake_postgres_instance_resource", want: PostgresInstanceResource},
 		{input: "snowflake_procedure_java_resource", want: ProcedureJavaResource},
@@ -120,7 +149,13 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_procedure_scala_resource", want: ProcedureScalaResource},
 		{input: "snowflake_procedure_sql_resource", want: ProcedureSqlResource},
 		{input: "snowflake_procedures_datasource", want: ProceduresDatasource},
+		{input: "snowflake_projection_policy_resource", want: ProjectionPolicyResource},
+		{input: "snowflake_projection_policies_datasource", want: ProjectionPoliciesDatasource},
+		{input: "snowflake_regions_datasource", want: RegionsDatasource},
+		{input: "snowflake_replication_accounts_datasource", want: ReplicationAccountsDatasource},
+		{input: "snowflake_replication_databases_datasource", want: ReplicationDatabasesDatasource},
 		{input: "snowflake_current_role_datasource", want: CurrentRoleDatasource},
+		{input: "snowflake_scim_access_token_ephemeral_resource", want: ScimAccessTokenEphemeralResource},
 		{input: "snowflake_semantic_view_resource", want: SemanticViewResource},
 		{input: "snowflake_semantic_views_datasource", want: SemanticViewDatasource},
 		{input: "snowflake_session_policies_datasource", want: SessionPoliciesDatasource},
@@ -155,6 +190,7 @@ func Test_StringToFeature(t *testing.T) {
 		{input: "snowflake_user_password_policy_attachment_resource", want: UserPasswordPolicyAttachmentResource},
 		{input: "snowflake_user_session_policy_attachment_resource", want: UserSessionPolicyAttachmentResource},
 		{input: "snowflake_user_programmatic_access_token_resource", want: UserProgrammaticAccessTokenResource},
+		{input: "snowflake_user_programmatic_access_token_ephemeral_resource", want: UserProgrammaticAccessTokenEphemeralResource},
 		{input: "snowflake_user_programmatic_access_tokens_datasource", want: UserProgrammaticAccessTokensDatasource},
 		{input: "snowflake_warehouse_adaptive_resource", want: WarehouseAdaptiveResource},
 		{input: "snowflake_warehouse_interactive_resource", want: WarehouseInteractiveResource},
@@ -8,6 +8,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/datasources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/oswrapper"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
@@ -363,6 +364,27 @@ func GetProviderSchema() map[string]*schema.Schema {
 			DefaultFunc:      schema.EnvDefaultFunc(snowflakeenvs.MaxRetryCount, nil),
 			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
 		},
+		"statement_retry_max_attempts": {
+			Type:             schema.TypeInt,
+			Description:      envNameFieldDescription(fmt.Sprintf("Specifies the maximum number of attempts to run a SQL statement that fails with a transient error (e.g., concurrent DDL on the same object, statement or warehouse queueing timeout, or HTTP 429 returned by the login endpoint). Other errors are never retried. Statements that do not return rows (e.g., `CREATE` or `ALTER`) may have already taken effect when they failed, so they are retried only when the error means they were not run (concurrent DDL, connection failure, or HTTP 429) or when they are idempotent (e.g., `CREATE OR REPLACE`, `CREATE ... IF NOT EXISTS`, or `DROP ... IF EXISTS`). The default `%d` disables the retries. The retries are independent of the HTTP request retries controlled by `max_retry_count`.", sdk.DefaultRetryMaxAttempts), snowflakeenvs.StatementRetryMaxAttempts),
+			Optional:         true,
+			DefaultFunc:      schema.EnvDefaultFunc(snowflakeenvs.StatementRetryMaxAttempts, nil),
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+		"statement_retry_initial_backoff": {
+			Type:             schema.TypeInt,
+			Description:      envNameFieldDescription(fmt.Sprintf("Delay in seconds before the first retry of a failed SQL statement (see `statement_retry_max_attempts`). The delay doubles with every next attempt and is randomized by ±%v%%. Defaults to `%d`.", sdk.DefaultRetryJitter*100, int(sdk.DefaultRetryInitialBackoff.Seconds())), snowflakeenvs.StatementRetryInitialBackoff),
+			Optional:         true,
+			DefaultFunc:      schema.EnvDefaultFunc(snowflakeenvs.StatementRetryInitialBackoff, nil),
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
+		"statement_retry_max_backoff": {
+			Type:             schema.TypeInt,
+			Description:      envNameFieldDescription(fmt.Sprintf("Maximum delay in seconds between the retries of a failed SQL statement (see `statement_retry_max_attempts`). Defaults to `%d`.", int(sdk.DefaultRetryMaxBackoff.Seconds())), snowflakeenvs.StatementRetryMaxBackoff),
+			Optional:         true,
+			DefaultFunc:      schema.EnvDefaultFunc(snowflakeenvs.StatementRetryMaxBackoff, nil),
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		},
 		"driver_tracing": {
 			Type:             schema.TypeString,
 			Description:      envNameFieldDescription(fmt.Sprintf("Specifies the logging level to be used by the driver. Valid options are (case-insensitive): %v. The following values are deprecated and will be removed in v3: `WARNING` (uses `WARN` instead), `PRINT` (uses `INFO` instead), `PANIC` (uses `FATAL` instead).", docs.PossibleValuesListed(sdk.AllDriverLogLevels)), snowflakeenvs.DriverTracing),
@@ -524,6 +546,55 @@ func GetProviderSchema() map[string]*schema.Schema {
 			Optional:    true,
 			DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.ProxyUser, nil),
 		},
+		"query_tag": {
+			Type:             schema.TypeString,
+			Description:      envNameFieldDescription(fmt.Sprintf("Sets the [QUERY_TAG](https://docs.snowflake.com/en/sql-reference/parameters#query-tag) session parameter for all the SQL statements run by the provider. The value can have at most %d characters. It can not be set together with `QUERY_TAG` in `params`.", tracking.MaxQueryTagLength), snowflakeenvs.QueryTag),
+			Optional:         true,
+			DefaultFunc:      schema.EnvDefaultFunc(snowflakeenvs.QueryTag, nil),
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(0, tracking.MaxQueryTagLength)),
+		},
+		"query_tag_include_metadata": {
+			Type:        schema.TypeBool,
+			Description: envNameFieldDescription("When set to true, the SQL statements run by the provider are tagged with a JSON query tag containing the `query_tag` value, the `query_tag_workspace` value, the resource (or data source) type, the operation (e.g. `create`), and the provider version. It allows attributing the statements in `QUERY_HISTORY` to the Terraform resources. The statements run outside of the resource and data source operations (e.g. during the provider configuration) are tagged with `query_tag` only. Default value is false.", snowflakeenvs.QueryTagIncludeMetadata),
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.QueryTagIncludeMetadata, nil),
+		},
+		"query_tag_workspace": {
+			Type:        schema.TypeString,
+			Description: envNameFieldDescription("The name of the Terraform workspace (e.g. `terraform.workspace`) included in the query tag when `query_tag_include_metadata` is set to true.", snowflakeenvs.QueryTagWorkspace),
+			Optional:    true,
+			DefaultFunc: schema.EnvDefaultFunc(snowflakeenvs.QueryTagWorkspace, nil),
+		},
+		"sql_audit_log_path": {
+			Type:             schema.TypeString,
+			Description:      envNameFieldDescription("Path to the local file to which the provider appends every SQL statement it runs (including the read-only ones run during the plan), annotated with the timestamp, the resource (or data source) type, the resource ID (once the resource has one), and the operation. The file is created if it does not exist. Be aware that the statements may include sensitive information.", snowflakeenvs.SqlAuditLogPath),
+			Optional:         true,
+			DefaultFunc:      schema.EnvDefaultFunc(snowflakeenvs.SqlAuditLogPath, nil),
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotWhiteSpace),
+		},
+		"sql_dry_run": {
+			Type:         schema.TypeBool,
+			Description:  envNameFieldDescription("False by default. If true, the statements changing the objects (e.g., `CREATE`, `ALTER`, `DROP`, or `GRANT`) are only written to the `sql_audit_log_path` file, and they are not run in Snowflake. The read-only statements (e.g., `SHOW` or `DESCRIBE`) are still run, so the plan reflects the current state of the objects. It is meant for previewing the DDL of `terraform apply` (e.g., to attach it to the change requests), not for managing the objects: the results of such an apply are not real (e.g., the created objects are not found after the apply, which fails the apply for them), so run it on a copy of the state and discard the state afterwards.", snowflakeenvs.SqlDryRun),
+			Optional:     true,
+			RequiredWith: []string{"sql_audit_log_path"},
+			DefaultFunc:  schema.EnvDefaultFunc(snowflakeenvs.SqlDryRun, nil),
+		},
+		"default_tags": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "Tags set on every object managed by a resource with the `tags` field (e.g. `snowflake_database`, `snowflake_schema`, `snowflake_warehouse`). The tags from the resource's `tags` field take precedence over these values. The effective tags of every resource are available in its `tags_all` field. This field can not be set with environmental variables.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"tags": {
+						Type:        schema.TypeMap,
+						Required:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "Tags set on the objects. The keys are the fully qualified names of the tags (e.g. `\"\\\"<db_name>\\\".\\\"<schema_name>\\\".\\\"<tag_name>\\\"\"`) and the values are the tag values.",
+					},
+				},
+			},
+		},
 		"proxy_password": {
 			Type:        schema.TypeString,
 			Description: envNameFieldDescription("The password of the proxy to use for the connection. See more in [the proxy section below](#proxy).", snowflakeenvs.ProxyPassword),
@@ -600,9 +671,11 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_account": resources.Account(),
 		"snowflake_account_authentication_policy_attachment":                     resources.AccountAuthenticationPolicyAttachment(),
 		"snowflake_account_role":                                                 resources.AccountRole(),
+		"snowflake_account_role_membership":                                      resources.AccountRoleMembership(),
 		"snowflake_account_password_policy_attachment":                           resources.AccountPasswordPolicyAttachment(),
 		"snowflake_account_parameter":                                            resources.AccountParameter(),
 		"snowflake_account_session_policy_attachment":                            resources.AccountSessionPolicyAttachment(),
+		"snowflake_aggregation_policy":                                           resources.AggregationPolicy(),
 		"snowflake_alert":                                                        resources.Alert(),
 		"snowflake_api_authentication_integration_with_authorization_code_grant": resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant(),
 		"snowflake_api_authentication_integration_with_client_credentials":       resources.ApiAuthenticationIntegrationWithClientCredentials(),
@@ -617,20 +690,29 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_api_integration_git_repository_private_link":                  resources.ApiIntegrationGitRepositoryPrivateLink(),
 		"snowflake_api_integration_git_repository_token":                         resources.ApiIntegrationGitRepositoryToken(),
 		"snowflake_api_integration_google_cloud_api_gateway":                     resources.ApiIntegrationGoogleCloudApiGateway(),
+		"snowflake_application":                                                  resources.Application(),
+		"snowflake_application_package":                                          resources.ApplicationPackage(),
 		"snowflake_authentication_policy":                                        resources.AuthenticationPolicy(),
+		"snowflake_backup_policy":                                                resources.BackupPolicy(),
+		"snowflake_backup_set":                                                   resources.BackupSet(),
+		"snowflake_budget":                                                       resources.Budget(),
+		"snowflake_budget_tracked_object":                                        resources.BudgetTrackedObject(),
 		"snowflake_catalog_integration_aws_glue":                                 resources.CatalogIntegrationAwsGlue(),
 		"snowflake_catalog_integration_object_storage":                           resources.CatalogIntegrationObjectStorage(),
 		"snowflake_catalog_integration_open_catalog":                             resources.CatalogIntegrationOpenCatalog(),
 		"snowflake_catalog_integration_iceberg_rest":                             resources.CatalogIntegrationIcebergRest(),
 		"snowflake_compute_pool":                                                 resources.ComputePool(),
+		"snowflake_contact":                                                      resources.Contact(),
 		"snowflake_cortex_agent":                                                 resources.CortexAgent(),
 		"snowflake_cortex_search_service":                                        resources.CortexSearchService(),
 		"snowflake_current_account":                                              resources.CurrentAccount(),
 		"snowflake_current_organization_account":                                 resources.CurrentOrganizationAccount(),
+		"snowflake_data_metric_function_attachment":                              resources.DataMetricFunctionAttachment(),
 		"snowflake_database":                                                     resources.Database(),
 		"snowflake_database_role":                                                resources.DatabaseRole(),
 		"snowflake_dynamic_table":                                                resources.DynamicTable(),
 		"snowflake_email_notification_integration":                               resources.EmailNotificationIntegration(),
+		"snowflake_event_table":                                                  resources.EventTable(),
 		"snowflake_execute":                                                      resources.Execute(),
 		"snowflake_external_access_integration":                                  resources.ExternalAccessIntegration(),
 		"snowflake_stage_external_azure":                                         resources.ExternalAzureStage(),
@@ -656,9 +738,12 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_function_sql":                                                 resources.FunctionSql(),
 		"snowflake_grant_account_role":                                           resources.GrantAccountRole(),
 		"snowflake_grant_application_role":                                       resources.GrantApplicationRole(),
+		"snowflake_grant_caller_privileges":                                      resources.GrantCallerPrivileges(),
 		"snowflake_grant_database_role":                                          resources.GrantDatabaseRole(),
 		"snowflake_grant_ownership":                                              resources.GrantOwnership(),
+		"snowflake_grant_privileges_matrix":                                      resources.GrantPrivilegesMatrix(),
 		"snowflake_grant_privileges_to_account_role":                             resources.GrantPrivilegesToAccountRole(),
+		"snowflake_grant_privileges_to_application_role":                         resources.GrantPrivilegesToApplicationRole(),
 		"snowflake_grant_privileges_to_database_role":                            resources.GrantPrivilegesToDatabaseRole(),
 		"snowflake_grant_privileges_to_share":                                    resources.GrantPrivilegesToShare(),
 		"snowflake_git_repository":                                               resources.GitRepository(),
@@ -671,6 +756,7 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_image_repository":                                             resources.ImageRepository(),
 		"snowflake_stage_internal":                                               resources.InternalStage(),
 		"snowflake_job_service":                                                  resources.JobService(),
+		"snowflake_join_policy":                                                  resources.JoinPolicy(),
 		"snowflake_legacy_service_user":                                          resources.LegacyServiceUser(),
 		"snowflake_listing":                                                      resources.Listing(),
 		"snowflake_managed_account":                                              resources.ManagedAccount(),
@@ -684,7 +770,12 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_notification_integration":                                     resources.NotificationIntegration(),
 		"snowflake_oauth_integration_for_partner_applications":                   resources.OauthIntegrationForPartnerApplications(),
 		"snowflake_oauth_integration_for_custom_clients":                         resources.OauthIntegrationForCustomClients(),
+		"snowflake_object_contacts":                                              resources.ObjectContacts(),
 		"snowflake_object_parameter":                                             resources.ObjectParameter(),
+		"snowflake_openflow_connector":                                           resources.OpenflowConnector(),
+		"snowflake_openflow_deployment":                                          resources.OpenflowDeployment(),
+		"snowflake_openflow_runtime":                                             resources.OpenflowRuntime(),
+		"snowflake_organization_account":                                         resources.OrganizationAccount(),
 		"snowflake_password_policy":                                              resources.PasswordPolicy(),
 		"snowflake_pipe":                                                         resources.Pipe(),
 		"snowflake_postgres_instance":                                            resources.PostgresInstance(),
@@ -694,6 +785,7 @@ func getResources() map[string]*schema.Resource {
 		"snowflake_procedure_python":                                             resources.ProcedurePython(),
 		"snowflake_procedure_scala":                                              resources.ProcedureScala(),
 		"snowflake_procedure_sql":                                                resources.ProcedureSql(),
+		"snowflake_projection_policy":                                            resources.ProjectionPolicy(),
 		"snowflake_resource_monitor":                                             resources.ResourceMonitor(),
 		"snowflake_row_access_policy":                                            resources.RowAccessPolicy(),
 		"snowflake_saml2_integration":                                            resources.SAML2Integration(),
@@ -747,9 +839,14 @@ func getDataSources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
 		"snowflake_accounts":                           datasources.Accounts(),
 		"snowflake_account_roles":                      datasources.AccountRoles(),
+		"snowflake_aggregation_policies":               datasources.AggregationPolicies(),
 		"snowflake_alerts":                             datasources.Alerts(),
 		"snowflake_api_integrations":                   datasources.ApiIntegrations(),
+		"snowflake_application_packages":               datasources.ApplicationPackages(),
+		"snowflake_applications":                       datasources.Applications(),
 		"snowflake_authentication_policies":            datasources.AuthenticationPolicies(),
+		"snowflake_backup_policies":                    datasources.BackupPolicies(),
+		"snowflake_budgets":                            datasources.Budgets(),
 		"snowflake_catalog_integrations":               datasources.CatalogIntegrations(),
 		"snowflake_compute_pools":                      datasources.ComputePools(),
 		"snowflake_connections":                        datasources.Connections(),
@@ -757,22 +854,27 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_cortex_search_services":             datasources.CortexSearchServices(),
 		"snowflake_current_account":                    datasources.CurrentAccount(),
 		"snowflake_current_role":                       datasources.CurrentRole(),
+		"snowflake_data_metric_function_references":    datasources.DataMetricFunctionReferences(),
 		"snowflake_database":                           datasources.Database(),
 		"snowflake_database_role":                      datasources.DatabaseRole(),
 		"snowflake_database_roles":                     datasources.DatabaseRoles(),
 		"snowflake_databases":                          datasources.Databases(),
 		"snowflake_dynamic_tables":                     datasources.DynamicTables(),
+		"snowflake_event_tables":                       datasources.EventTables(),
 		"snowflake_external_access_integrations":       datasources.ExternalAccessIntegrations(),
 		"snowflake_external_functions":                 datasources.ExternalFunctions(),
 		"snowflake_external_tables":                    datasources.ExternalTables(),
 		"snowflake_external_volumes":                   datasources.ExternalVolumes(),
+		"snowflake_failover_group_members":             datasources.FailoverGroupMembers(),
 		"snowflake_failover_groups":                    datasources.FailoverGroups(),
 		"snowflake_file_formats":                       datasources.FileFormats(),
 		"snowflake_functions":                          datasources.Functions(),
 		"snowflake_git_repositories":                   datasources.GitRepositories(),
+		"snowflake_git_repository_refs":                datasources.GitRepositoryRefs(),
 		"snowflake_grants":                             datasources.Grants(),
 		"snowflake_iceberg_tables":                     datasources.IcebergTables(),
 		"snowflake_image_repositories":                 datasources.ImageRepositories(),
+		"snowflake_join_policies":                      datasources.JoinPolicies(),
 		"snowflake_listings":                           datasources.Listings(),
 		"snowflake_masking_policies":                   datasources.MaskingPolicies(),
 		"snowflake_materialized_views":                 datasources.MaterializedViews(),
@@ -780,10 +882,16 @@ func getDataSources() map[string]*schema.Resource {
 		"snowflake_network_policies":                   datasources.NetworkPolicies(),
 		"snowflake_network_rules":                      datasources.NetworkRules(),
 		"snowflake_notebooks":                          datasources.Notebooks(),
+		"snowflake_organization_accounts":              datasources.OrganizationAccounts(),
 		"snowflake_parameters":                         datasources.Parameters(),
 		"snowflake_password_policies":                  datasources.PasswordPolicies(),
 		"snowflake_pipes":                              datasources.Pipes(),
+		"snowflake_policy_references":                  datasources.PolicyReferences(),
 		"snowflake_procedures":                         datasources.Procedures(),
+		"snowflake_projection_policies":                datasources.ProjectionPolicies(),
+		"snowflake_regions":                            datasources.Regions(),
+		"snowflake_replication_accounts":               datasources.ReplicationAccounts(),
+		"snowflake_replication_databases":              datasources.ReplicationDatabases(),
 		"snowflake_resource_monitors":                  datasources.ResourceMonitors(),
 		"snowflake_row_access_policies":                datasources.RowAccessPolicies(),
 		"snowflake_schemas":                            datasources.Schemas(),
@@ -868,11 +976,35 @@ func ConfigureProvider(_ context.Context, s *schema.ResourceData) (any, diag.Dia
 		RoleShowCache:        provider.NewCache[*sdk.Role](),
 		GrantShowCache:       provider.NewCache[[]sdk.Grant](),
 	}
-	if client, err := sdk.NewClient(config); err != nil {
+	retryPolicy, err := getRetryPolicyFromTerraform(s)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	if client, err := sdk.NewClientWithRetryPolicy(config, retryPolicy); err != nil {
 		return nil, append(diags, diag.FromErr(err)...)
 	} else {
 		providerCtx.Client = client
 	}
+	queryTagOptions, err := getQueryTagOptionsFromTerraform(s)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	providerCtx.Client.SetQueryTagOptions(queryTagOptions)
+	if v, ok := s.GetOk("sql_audit_log_path"); ok {
+		recorder, err := sdk.NewFileStatementRecorder(v.(string))
+		if err != nil {
+			return nil, append(diags, diag.FromErr(err)...)
+		}
+		providerCtx.Client.SetStatementRecorder(recorder)
+	}
+	if v, ok := s.GetOk("sql_dry_run"); ok && v.(bool) {
+		providerCtx.Client.SetDryRun(true)
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "SQL dry-run mode is enabled.",
+			Detail:   "The statements changing the objects are only written to the sql_audit_log_path file, and they are not run in Snowflake. The state produced in this mode does not reflect the objects in Snowflake.",
+		})
+	}
 
 	if v, ok := s.GetOk("preview_features_enabled"); ok {
 		providerCtx.EnabledFeatures = expandStringList(v.(*schema.Set).List())
@@ -888,9 +1020,66 @@ func ConfigureProvider(_ context.Context, s *schema.ResourceData) (any, diag.Dia
 
 	providerCtx.EnabledExperiments = enabledExperiments
 
+	defaultTags, err := getDefaultTagsFromTerraform(s)
+	if err != nil {
+		return nil, append(diags, diag.FromErr(err)...)
+	}
+	providerCtx.DefaultTags = defaultTags
+
 	return providerCtx, diags
 }
 
+func getRetryPolicyFromTerraform(s *schema.ResourceData) (sdk.RetryPolicy, error) {
+	maxAttempts := sdk.DefaultRetryMaxAttempts
+	initialBackoff := sdk.DefaultRetryInitialBackoff
+	maxBackoff := sdk.DefaultRetryMaxBackoff
+	err := errors.Join(
+		handleIntAttribute(s, "statement_retry_max_attempts", &maxAttempts),
+		handleDurationInSecondsAttribute(s, "statement_retry_initial_backoff", &initialBackoff),
+		handleDurationInSecondsAttribute(s, "statement_retry_max_backoff", &maxBackoff),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if maxBackoff < initialBackoff {
+		return nil, fmt.Errorf("statement_retry_max_backoff (%v) can not be lower than statement_retry_initial_backoff (%v)", maxBackoff, initialBackoff)
+	}
+	if maxAttempts <= 1 {
+		return sdk.NoRetryPolicy, nil
+	}
+	return sdk.NewExponentialBackoffRetryPolicy(maxAttempts, initialBackoff, maxBackoff), nil
+}
+
+func getQueryTagOptionsFromTerraform(s *schema.ResourceData) (sdk.QueryTagOptions, error) {
+	options := sdk.QueryTagOptions{}
+	err := errors.Join(
+		handleStringField(s, "query_tag", &options.QueryTag),
+		handleStringField(s, "query_tag_workspace", &options.Workspace),
+		handleBoolField(s, "query_tag_include_metadata", &options.IncludeMetadata),
+	)
+	if err != nil {
+		return sdk.QueryTagOptions{}, err
+	}
+	return options, nil
+}
+
+// getDefaultTagsFromTerraform returns the default tags keyed by the normalized fully qualified tag names.
+func getDefaultTagsFromTerraform(s *schema.ResourceData) (map[string]string, error) {
+	defaultTags := make(map[string]string)
+	v, ok := s.GetOk("default_tags")
+	if !ok || v.([]any)[0] == nil {
+		return defaultTags, nil
+	}
+	for tagName, value := range v.([]any)[0].(map[string]any)["tags"].(map[string]any) {
+		tagId, err := sdk.ParseSchemaObjectIdentifier(tagName)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tag name %s in default_tags, err = %w", tagName, err)
+		}
+		defaultTags[tagId.FullyQualifiedName()] = value.(string)
+	}
+	return defaultTags, nil
+}
+
 // fixBooleanConfigFields is a temporary function to fix the boolean config fields that are set in the Terraform configuration.
 // Without this function, if the users set a value to false explicitly, it will be overridden by the TOML profile value because of MergeConfig logic.
 // Instead, MergeConfig should have an abstraction that does this correctly, so this workaround can be removed.
@@ -1108,6 +1297,14 @@ func getDriverConfigFromTerraform(s *schema.ResourceData, enabledExperiments []s
 	if _, ok := s.GetOk("disable_telemetry"); ok {
 		params[sdk.ClientTelemetryEnableSessionParameter] = sdk.Pointer(provider.BooleanFalse)
 	}
+	if v, ok := s.GetOk("query_tag"); ok {
+		for key := range params {
+			if strings.EqualFold(key, string(sdk.SessionParameterQueryTag)) {
+				return nil, diag.FromErr(fmt.Errorf("query_tag can not be set together with %s in params", sdk.SessionParameterQueryTag))
+			}
+		}
+		params[string(sdk.SessionParameterQueryTag)] = sdk.String(v.(string))
+	}
 	config.Params = params
 
 	if v, ok := s.GetOk("token_accessor"); ok {
@@ -306,3 +306,113 @@ func TestGetDriverConfigFromTerraform_AccountFallback(t *testing.T) {
 		})
 	}
 }
+
+func TestGetRetryPolicyFromTerraform(t *testing.T) {
+	t.Run("retries disabled by default", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, GetProviderSchema(), map[string]any{})
+
+		policy, err := getRetryPolicyFromTerraform(d)
+
+		require.NoError(t, err)
+		assert.Equal(t, sdk.NoRetryPolicy, policy)
+	})
+
+	t.Run("retries disabled with a single attempt", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, GetProviderSchema(), map[string]any{"statement_retry_max_attempts": 1})
+
+		policy, err := getRetryPolicyFromTerraform(d)
+
+		require.NoError(t, err)
+		assert.Equal(t, sdk.NoRetryPolicy, policy)
+	})
+
+	t.Run("default backoff", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, GetProviderSchema(), map[string]any{"statement_retry_max_attempts": 3})
+
+		policy, err := getRetryPolicyFromTerraform(d)
+
+		require.NoError(t, err)
+		require.IsType(t, &sdk.ExponentialBackoffRetryPolicy{}, policy)
+		exponentialPolicy := policy.(*sdk.ExponentialBackoffRetryPolicy)
+		assert.Equal(t, 3, exponentialPolicy.MaxAttempts)
+		assert.Equal(t, sdk.DefaultRetryInitialBackoff, exponentialPolicy.InitialBackoff)
+		assert.Equal(t, sdk.DefaultRetryMaxBackoff, exponentialPolicy.MaxBackoff)
+	})
+
+	t.Run("all fields", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, GetProviderSchema(), map[string]any{
+			"statement_retry_max_attempts":    5,
+			"statement_retry_initial_backoff": 2,
+			"statement_retry_max_backoff":     60,
+		})
+
+		policy, err := getRetryPolicyFromTerraform(d)
+
+		require.NoError(t, err)
+		require.IsType(t, &sdk.ExponentialBackoffRetryPolicy{}, policy)
+		exponentialPolicy := policy.(*sdk.ExponentialBackoffRetryPolicy)
+		assert.Equal(t, 5, exponentialPolicy.MaxAttempts)
+		assert.Equal(t, 2*time.Second, exponentialPolicy.InitialBackoff)
+		assert.Equal(t, 60*time.Second, exponentialPolicy.MaxBackoff)
+	})
+
+	t.Run("max backoff lower than initial backoff", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, GetProviderSchema(), map[string]any{
+			"statement_retry_max_attempts":    5,
+			"statement_retry_initial_backoff": 10,
+			"statement_retry_max_backoff":     5,
+		})
+
+		_, err := getRetryPolicyFromTerraform(d)
+
+		require.ErrorContains(t, err, "statement_retry_max_backoff (5s) can not be lower than statement_retry_initial_backoff (10s)")
+	})
+}
+
+func TestGetQueryTagOptionsFromTerraform(t *testing.T) {
+	t.Run("empty by default", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, GetProviderSchema(), map[string]any{})
+
+		options, err := getQueryTagOptionsFromTerraform(d)
+
+		require.NoError(t, err)
+		assert.Equal(t, sdk.QueryTagOptions{}, options)
+	})
+
+	t.Run("all fields", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, GetProviderSchema(), map[string]any{
+			"query_tag":                  "tag",
+			"query_tag_include_metadata": true,
+			"query_tag_workspace":        "prod",
+		})
+
+		options, err := getQueryTagOptionsFromTerraform(d)
+
+		require.NoError(t, err)
+		assert.Equal(t, sdk.QueryTagOptions{QueryTag: "tag", Workspace: "prod", IncludeMetadata: true}, options)
+	})
+}
+
+func TestGetDriverConfigFromTerraform_QueryTag(t *testing.T) {
+	t.Run("query tag set as session parameter", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, GetProviderSchema(), map[string]any{"query_tag": "tag"})
+
+		config, diags := getDriverConfigFromTerraform(d, nil)
+
+		require.False(t, diags.HasError())
+		require.NotNil(t, config.Params["QUERY_TAG"])
+		assert.Equal(t, "tag", *config.Params["QUERY_TAG"])
+	})
+
+	t.Run("query tag set together with params", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, GetProviderSchema(), map[string]any{
+			"query_tag": "tag",
+			"params":    map[string]any{"query_tag": "other"},
+		})
+
+		_, diags := getDriverConfigFromTerraform(d, nil)
+
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Summary, "query_tag can not be set together with QUERY_TAG in params")
+	})
+}
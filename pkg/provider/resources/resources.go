@@ -8,7 +8,9 @@ const (
 	AccountParameter                                       resource = "snowflake_account_parameter"
 	AccountPasswordPolicyAttachment                        resource = "snowflake_account_password_policy_attachment"
 	AccountRole                                            resource = "snowflake_account_role"
+	AccountRoleMembership                                  resource = "snowflake_account_role_membership"
 	AccountSessionPolicyAttachment                         resource = "snowflake_account_session_policy_attachment"
+	AggregationPolicy                                      resource = "snowflake_aggregation_policy"
 	Alert                                                  resource = "snowflake_alert"
 	ApiAuthenticationIntegrationWithAuthorizationCodeGrant resource = "snowflake_api_authentication_integration_with_authorization_code_grant"
 	ApiAuthenticationIntegrationWithClientCredentials      resource = "snowflake_api_authentication_integration_with_client_credentials"
@@ -23,20 +25,29 @@ const (
 	ApiIntegrationGitRepositoryPrivateLink                 resource = "snowflake_api_integration_git_repository_private_link"
 	ApiIntegrationGitRepositoryToken                       resource = "snowflake_api_integration_git_repository_token"
 	ApiIntegrationGoogleCloudApiGateway                    resource = "snowflake_api_integration_google_cloud_api_gateway"
+	Application                                            resource = "snowflake_application"
+	ApplicationPackage                                     resource = "snowflake_application_package"
 	AuthenticationPolicy                                   resource = "snowflake_authentication_policy"
+	BackupPolicy                                           resource = "snowflake_backup_policy"
+	BackupSet                                              resource = "snowflake_backup_set"
+	Budget                                                 resource = "snowflake_budget"
+	BudgetTrackedObject                                    resource = "snowflake_budget_tracked_object"
 	CatalogIntegrationAwsGlue                              resource = "snowflake_catalog_integration_aws_glue"
 	CatalogIntegrationObjectStorage                        resource = "snowflake_catalog_integration_object_storage"
 	CatalogIntegrationOpenCatalog                          resource = "snowflake_catalog_integration_open_catalog"
 	CatalogIntegrationIcebergRest                          resource = "snowflake_catalog_integration_iceberg_rest"
 	ComputePool                                            resource = "snowflake_compute_pool"
+	Contact                                                resource = "snowflake_contact"
 	CortexAgent                                            resource = "snowflake_cortex_agent"
 	CortexSearchService                                    resource = "snowflake_cortex_search_service"
 	CurrentAccount                                         resource = "snowflake_current_account"
 	CurrentOrganizationAccount                             resource = "snowflake_current_organization_account"
+	DataMetricFunctionAttachment                           resource = "snowflake_data_metric_function_attachment"
 	Database                                               resource = "snowflake_database"
 	DatabaseRole                                           resource = "snowflake_database_role"
 	DynamicTable                                           resource = "snowflake_dynamic_table"
 	EmailNotificationIntegration                           resource = "snowflake_email_notification_integration"
+	EventTable                                             resource = "snowflake_event_table"
 	Execute                                                resource = "snowflake_execute"
 	ExternalAccessIntegration                              resource = "snowflake_external_access_integration"
 	ExternalAzureStage                                     resource = "snowflake_stage_external_azure"
@@ -63,9 +74,12 @@ const (
 	GitRepository                                          resource = "snowflake_git_repository"
 	GrantAccountRole                                       resource = "snowflake_grant_account_role"
 	GrantApplicationRole                                   resource = "snowflake_grant_application_role"
+	GrantCallerPrivileges                                  resource = "snowflake_grant_caller_privileges"
 	GrantDatabaseRole                                      resource = "snowflake_grant_database_role"
 	GrantOwnership                                         resource = "snowflake_grant_ownership"
+	GrantPrivilegesMatrix                                  resource = "snowflake_grant_privileges_matrix"
 	GrantPrivilegesToAccountRole                           resource = "snowflake_grant_privileges_to_account_role"
+	GrantPrivilegesToApplicationRole                       resource = "snowflake_grant_privileges_to_application_role"
 	GrantPrivilegesToDatabaseRole                          resource = "snowflake_grant_privileges_to_database_role"
 	GrantPrivilegesToShare                                 resource = "snowflake_grant_privileges_to_share"
 	HybridTable                                            resource = "snowflake_hybrid_table"
@@ -77,6 +91,7 @@ const (
 	ImageRepository                                        resource = "snowflake_image_repository"
 	InternalStage                                          resource = "snowflake_stage_internal"
 	JobService                                             resource = "snowflake_job_service"
+	JoinPolicy                                             resource = "snowflake_join_policy"
 	LegacyServiceUser                                      resource = "snowflake_legacy_service_user"
 	Listing                                                resource = "snowflake_listing"
 	ManagedAccount                                         resource = "snowflake_managed_account"
@@ -91,7 +106,12 @@ const (
 	OauthIntegration                                       resource = "snowflake_oauth_integration"
 	OauthIntegrationForCustomClients                       resource = "snowflake_oauth_integration_for_custom_clients"
 	OauthIntegrationForPartnerApplications                 resource = "snowflake_oauth_integration_for_partner_applications"
+	ObjectContacts                                         resource = "snowflake_object_contacts"
 	ObjectParameter                                        resource = "snowflake_object_parameter"
+	OpenflowConnector                                      resource = "snowflake_openflow_connector"
+	OpenflowDeployment                                     resource = "snowflake_openflow_deployment"
+	OpenflowRuntime                                        resource = "snowflake_openflow_runtime"
+	OrganizationAccount                                    resource = "snowflake_organization_account"
 	PasswordPolicy                                         resource = "snowflake_password_policy"
 	Pipe                                                   resource = "snowflake_pipe"
 	PostgresFork                                           resource = "snowflake_postgres_fork"
@@ -102,6 +122,7 @@ const (
 	ProcedurePython                                        resource = "snowflake_procedure_python"
 	ProcedureScala                                         resource = "snowflake_procedure_scala"
 	ProcedureSql                                           resource = "snowflake_procedure_sql"
+	ProjectionPolicy                                       resource = "snowflake_projection_policy"
 	ResourceMonitor                                        resource = "snowflake_resource_monitor"
 	RowAccessPolicy                                        resource = "snowflake_row_access_policy"
 	SamlSecurityIntegration                                resource = "snowflake_saml_integration"
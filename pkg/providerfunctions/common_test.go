@@ -0,0 +1,27 @@
+package providerfunctions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/stretchr/testify/require"
+)
+
+// runFunction runs the function the same way the plugin framework server does, without the protocol layer.
+func runFunction(t *testing.T, f function.Function, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	ctx := context.Background()
+
+	definitionResponse := &function.DefinitionResponse{}
+	f.Definition(ctx, function.DefinitionRequest{}, definitionResponse)
+	require.Empty(t, definitionResponse.Diagnostics)
+
+	result, funcErr := definitionResponse.Definition.Return.NewResultData(ctx)
+	require.Nil(t, funcErr)
+
+	response := &function.RunResponse{Result: result}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(arguments)}, response)
+	return response.Result.Value(), response.Error
+}
@@ -0,0 +1,41 @@
+package providerfunctions
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &encodeResourceIdFunction{}
+
+type encodeResourceIdFunction struct{}
+
+func NewEncodeResourceIdFunction() function.Function {
+	return &encodeResourceIdFunction{}
+}
+
+func (f *encodeResourceIdFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "encode_resource_id"
+}
+
+func (f *encodeResourceIdFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "Builds the ID of a resource consisting of many identifiers.",
+		MarkdownDescription: "Builds the ID of a resource consisting of many identifiers (e.g. the grants or the policy attachments) by joining the given parts with the `|` delimiter, the same way the provider does. It is meant for constructing the IDs used in the `import` blocks; check the import section of the given resource documentation for the expected parts.",
+		VariadicParameter: function.StringParameter{
+			Name:                "parts",
+			MarkdownDescription: "Parts of the resource ID, usually the fully qualified names of the objects.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *encodeResourceIdFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var parts []string
+	response.Error = request.Arguments.Get(ctx, &parts)
+	if response.Error != nil {
+		return
+	}
+	response.Error = response.Result.Set(ctx, helpers.EncodeResourceIdentifier(parts...))
+}
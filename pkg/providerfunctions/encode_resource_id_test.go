@@ -0,0 +1,30 @@
+package providerfunctions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeResourceIdFunction(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Parts    []string
+		Expected string
+	}{
+		{Name: "no parts", Parts: []string{}, Expected: ""},
+		{Name: "single part", Parts: []string{`"db"`}, Expected: `"db"`},
+		{Name: "many parts", Parts: []string{`"role"`, "false", "OWNERSHIP", `"db"."schema"`}, Expected: `"role"|false|OWNERSHIP|"db"."schema"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			result, funcErr := runFunction(t, NewEncodeResourceIdFunction(), variadicArgument(tc.Parts...))
+
+			require.Nil(t, funcErr)
+			assert.Equal(t, types.StringValue(tc.Expected), result)
+		})
+	}
+}
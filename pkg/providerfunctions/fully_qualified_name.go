@@ -0,0 +1,57 @@
+package providerfunctions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &fullyQualifiedNameFunction{}
+
+type fullyQualifiedNameFunction struct{}
+
+func NewFullyQualifiedNameFunction() function.Function {
+	return &fullyQualifiedNameFunction{}
+}
+
+func (f *fullyQualifiedNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "fully_qualified_name"
+}
+
+func (f *fullyQualifiedNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "Builds a quoted fully qualified name of a Snowflake object.",
+		MarkdownDescription: "Builds a fully qualified name of a Snowflake object from its unquoted parts (from one to four: the account object, the database object, the schema object, or the table column), e.g. `provider::snowflake::fully_qualified_name(\"database\", \"schema\", \"name\")` returns `\"database\".\"schema\".\"name\"`. The result is the same as the `fully_qualified_name` field of the resources.",
+		VariadicParameter: function.StringParameter{
+			Name:                "parts",
+			MarkdownDescription: "Unquoted identifier parts, from the outermost to the innermost one.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *fullyQualifiedNameFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var parts []string
+	response.Error = request.Arguments.Get(ctx, &parts)
+	if response.Error != nil {
+		return
+	}
+
+	var id sdk.ObjectIdentifier
+	switch len(parts) {
+	case 1:
+		id = sdk.NewAccountObjectIdentifier(parts[0])
+	case 2:
+		id = sdk.NewDatabaseObjectIdentifier(parts[0], parts[1])
+	case 3:
+		id = sdk.NewSchemaObjectIdentifier(parts[0], parts[1], parts[2])
+	case 4:
+		id = sdk.NewTableColumnIdentifier(parts[0], parts[1], parts[2], parts[3])
+	default:
+		response.Error = function.NewArgumentFuncError(0, fmt.Sprintf("unsupported number of identifier parts: %d, expected from 1 to 4", len(parts)))
+		return
+	}
+	response.Error = response.Result.Set(ctx, id.FullyQualifiedName())
+}
@@ -0,0 +1,58 @@
+package providerfunctions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFullyQualifiedNameFunction(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Parts    []string
+		Expected string
+	}{
+		{Name: "account object", Parts: []string{"abc"}, Expected: `"abc"`},
+		{Name: "database object", Parts: []string{"db", "name"}, Expected: `"db"."name"`},
+		{Name: "schema object", Parts: []string{"db", "schema", "name"}, Expected: `"db"."schema"."name"`},
+		{Name: "schema object with dots", Parts: []string{"d.b", "sch.ema", "na.me"}, Expected: `"d.b"."sch.ema"."na.me"`},
+		{Name: "table column", Parts: []string{"db", "schema", "table", "column"}, Expected: `"db"."schema"."table"."column"`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			result, funcErr := runFunction(t, NewFullyQualifiedNameFunction(), variadicArgument(tc.Parts...))
+
+			require.Nil(t, funcErr)
+			assert.Equal(t, types.StringValue(tc.Expected), result)
+		})
+	}
+
+	t.Run("no parts", func(t *testing.T) {
+		_, funcErr := runFunction(t, NewFullyQualifiedNameFunction(), variadicArgument())
+
+		require.NotNil(t, funcErr)
+		assert.Contains(t, funcErr.Error(), "unsupported number of identifier parts: 0")
+	})
+
+	t.Run("too many parts", func(t *testing.T) {
+		_, funcErr := runFunction(t, NewFullyQualifiedNameFunction(), variadicArgument("a", "b", "c", "d", "e"))
+
+		require.NotNil(t, funcErr)
+		assert.Contains(t, funcErr.Error(), "unsupported number of identifier parts: 5")
+	})
+}
+
+// variadicArgument returns the variadic string argument in the form sent by the plugin framework server (a tuple).
+func variadicArgument(values ...string) attr.Value {
+	elementTypes := make([]attr.Type, len(values))
+	elements := make([]attr.Value, len(values))
+	for i, value := range values {
+		elementTypes[i] = types.StringType
+		elements[i] = types.StringValue(value)
+	}
+	return types.TupleValueMust(elementTypes, elements)
+}
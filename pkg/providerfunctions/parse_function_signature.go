@@ -0,0 +1,74 @@
+package providerfunctions
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseFunctionSignatureFunction{}
+
+type parseFunctionSignatureFunction struct{}
+
+func NewParseFunctionSignatureFunction() function.Function {
+	return &parseFunctionSignatureFunction{}
+}
+
+type parsedFunctionSignature struct {
+	Database           string   `tfsdk:"database"`
+	Schema             string   `tfsdk:"schema"`
+	Name               string   `tfsdk:"name"`
+	ArgumentDataTypes  []string `tfsdk:"argument_data_types"`
+	FullyQualifiedName string   `tfsdk:"fully_qualified_name"`
+}
+
+func (f *parseFunctionSignatureFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "parse_function_signature"
+}
+
+func (f *parseFunctionSignatureFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "Splits a function or procedure signature into its identifier and argument data types.",
+		MarkdownDescription: "Splits a function or procedure signature (e.g. `\"database\".\"schema\".\"name\"(VARCHAR, NUMBER)`) into its unquoted identifier parts and the argument data types, with the same rules as the resources. The argument names are optional and are not returned. The `fully_qualified_name` is normalized to the format used by the `fully_qualified_name` field of the function and procedure resources.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "signature",
+				MarkdownDescription: "Function or procedure signature in a form of `<database_name>.<schema_name>.<name>(<argname> <argtype>...)`, where `<argname>` is optional.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"database":             types.StringType,
+				"schema":               types.StringType,
+				"name":                 types.StringType,
+				"argument_data_types":  types.ListType{ElemType: types.StringType},
+				"fully_qualified_name": types.StringType,
+			},
+		},
+	}
+}
+
+func (f *parseFunctionSignatureFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var signature string
+	response.Error = request.Arguments.Get(ctx, &signature)
+	if response.Error != nil {
+		return
+	}
+
+	id, err := sdk.ParseSchemaObjectIdentifierWithArguments(signature)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	response.Error = response.Result.Set(ctx, parsedFunctionSignature{
+		Database:           id.DatabaseName(),
+		Schema:             id.SchemaName(),
+		Name:               id.Name(),
+		ArgumentDataTypes:  collections.Map(id.ArgumentDataTypes(), func(dataType sdk.DataType) string { return string(dataType) }),
+		FullyQualifiedName: id.FullyQualifiedName(),
+	})
+}
@@ -0,0 +1,67 @@
+package providerfunctions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFunctionSignatureFunction(t *testing.T) {
+	attributeTypes := map[string]attr.Type{
+		"database":             types.StringType,
+		"schema":               types.StringType,
+		"name":                 types.StringType,
+		"argument_data_types":  types.ListType{ElemType: types.StringType},
+		"fully_qualified_name": types.StringType,
+	}
+	parsed := func(database, schema, name, fullyQualifiedName string, argumentDataTypes ...string) types.Object {
+		argumentDataTypeValues := make([]attr.Value, len(argumentDataTypes))
+		for i, argumentDataType := range argumentDataTypes {
+			argumentDataTypeValues[i] = types.StringValue(argumentDataType)
+		}
+		return types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"database":             types.StringValue(database),
+			"schema":               types.StringValue(schema),
+			"name":                 types.StringValue(name),
+			"argument_data_types":  types.ListValueMust(types.StringType, argumentDataTypeValues),
+			"fully_qualified_name": types.StringValue(fullyQualifiedName),
+		})
+	}
+
+	testCases := []struct {
+		Name      string
+		Signature string
+		Expected  types.Object
+	}{
+		{Name: "no arguments", Signature: `"db"."schema"."fun"()`, Expected: parsed("db", "schema", "fun", `"db"."schema"."fun"()`)},
+		{Name: "arguments", Signature: `"db"."schema"."fun"(VARCHAR, NUMBER)`, Expected: parsed("db", "schema", "fun", `"db"."schema"."fun"(VARCHAR, NUMBER)`, "VARCHAR", "NUMBER")},
+		{Name: "named arguments", Signature: `db.schema.fun(a VARCHAR, b NUMBER)`, Expected: parsed("db", "schema", "fun", `"db"."schema"."fun"(VARCHAR, NUMBER)`, "VARCHAR", "NUMBER")},
+		{Name: "dots in the name", Signature: `"d.b"."sch.ema"."f.un"(VARCHAR)`, Expected: parsed("d.b", "sch.ema", "f.un", `"d.b"."sch.ema"."f.un"(VARCHAR)`, "VARCHAR")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			result, funcErr := runFunction(t, NewParseFunctionSignatureFunction(), types.StringValue(tc.Signature))
+
+			require.Nil(t, funcErr)
+			assert.Equal(t, tc.Expected, result)
+		})
+	}
+
+	t.Run("missing arguments", func(t *testing.T) {
+		_, funcErr := runFunction(t, NewParseFunctionSignatureFunction(), types.StringValue(`"db"."schema"."fun"`))
+
+		require.NotNil(t, funcErr)
+		assert.Contains(t, funcErr.Error(), "'(' not present")
+	})
+
+	t.Run("invalid number of parts", func(t *testing.T) {
+		_, funcErr := runFunction(t, NewParseFunctionSignatureFunction(), types.StringValue(`"schema"."fun"(VARCHAR)`))
+
+		require.NotNil(t, funcErr)
+		assert.Contains(t, funcErr.Error(), "unexpected number of parts 2")
+	})
+}
@@ -0,0 +1,79 @@
+package providerfunctions
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseIdentifierFunction{}
+
+type parseIdentifierFunction struct{}
+
+func NewParseIdentifierFunction() function.Function {
+	return &parseIdentifierFunction{}
+}
+
+type parsedIdentifier struct {
+	Database *string  `tfsdk:"database"`
+	Schema   *string  `tfsdk:"schema"`
+	Name     string   `tfsdk:"name"`
+	Column   *string  `tfsdk:"column"`
+	Parts    []string `tfsdk:"parts"`
+}
+
+func (f *parseIdentifierFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "parse_identifier"
+}
+
+func (f *parseIdentifierFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "Splits a Snowflake identifier into its parts.",
+		MarkdownDescription: "Splits a Snowflake identifier (e.g. `\"database\".\"schema\".\"name\"`) into its unquoted parts with the same rules as the resources, so the quoted parts containing dots are not split. The identifier type is inferred from the number of parts: one part is the `name`, two parts are `database` and `name`, three parts are `database`, `schema`, and `name`, and four parts are `database`, `schema`, `name` (of the table), and `column`. The missing parts are null.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "identifier",
+				MarkdownDescription: "Identifier to parse, e.g. `\"database\".\"schema\".\"name\"` or `database.schema.name`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"database": types.StringType,
+				"schema":   types.StringType,
+				"name":     types.StringType,
+				"column":   types.StringType,
+				"parts":    types.ListType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+func (f *parseIdentifierFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var identifier string
+	response.Error = request.Arguments.Get(ctx, &identifier)
+	if response.Error != nil {
+		return
+	}
+
+	id, err := sdk.ParseObjectIdentifierString(identifier)
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	var result parsedIdentifier
+	switch typedId := id.(type) {
+	case sdk.AccountObjectIdentifier:
+		result = parsedIdentifier{Name: typedId.Name(), Parts: []string{typedId.Name()}}
+	case sdk.DatabaseObjectIdentifier:
+		result = parsedIdentifier{Database: sdk.String(typedId.DatabaseName()), Name: typedId.Name(), Parts: []string{typedId.DatabaseName(), typedId.Name()}}
+	case sdk.SchemaObjectIdentifier:
+		result = parsedIdentifier{Database: sdk.String(typedId.DatabaseName()), Schema: sdk.String(typedId.SchemaName()), Name: typedId.Name(), Parts: []string{typedId.DatabaseName(), typedId.SchemaName(), typedId.Name()}}
+	case sdk.TableColumnIdentifier:
+		result = parsedIdentifier{Database: sdk.String(typedId.DatabaseName()), Schema: sdk.String(typedId.SchemaName()), Name: typedId.TableName(), Column: sdk.String(typedId.Name()), Parts: []string{typedId.DatabaseName(), typedId.SchemaName(), typedId.TableName(), typedId.Name()}}
+	}
+	response.Error = response.Result.Set(ctx, result)
+}
@@ -0,0 +1,62 @@
+package providerfunctions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIdentifierFunction(t *testing.T) {
+	attributeTypes := map[string]attr.Type{
+		"database": types.StringType,
+		"schema":   types.StringType,
+		"name":     types.StringType,
+		"column":   types.StringType,
+		"parts":    types.ListType{ElemType: types.StringType},
+	}
+	parsed := func(database, schema attr.Value, name string, column attr.Value, parts ...string) types.Object {
+		partValues := make([]attr.Value, len(parts))
+		for i, part := range parts {
+			partValues[i] = types.StringValue(part)
+		}
+		return types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+			"database": database,
+			"schema":   schema,
+			"name":     types.StringValue(name),
+			"column":   column,
+			"parts":    types.ListValueMust(types.StringType, partValues),
+		})
+	}
+
+	testCases := []struct {
+		Name       string
+		Identifier string
+		Expected   types.Object
+	}{
+		{Name: "account object", Identifier: `"abc"`, Expected: parsed(types.StringNull(), types.StringNull(), "abc", types.StringNull(), "abc")},
+		{Name: "account object with a dot", Identifier: `"a.b"`, Expected: parsed(types.StringNull(), types.StringNull(), "a.b", types.StringNull(), "a.b")},
+		{Name: "database object", Identifier: `"db"."name"`, Expected: parsed(types.StringValue("db"), types.StringNull(), "name", types.StringNull(), "db", "name")},
+		{Name: "schema object", Identifier: `"db"."sc.hema"."name"`, Expected: parsed(types.StringValue("db"), types.StringValue("sc.hema"), "name", types.StringNull(), "db", "sc.hema", "name")},
+		{Name: "schema object unquoted", Identifier: `db.schema.name`, Expected: parsed(types.StringValue("db"), types.StringValue("schema"), "name", types.StringNull(), "db", "schema", "name")},
+		{Name: "table column", Identifier: `"db"."schema"."table"."column"`, Expected: parsed(types.StringValue("db"), types.StringValue("schema"), "table", types.StringValue("column"), "db", "schema", "table", "column")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			result, funcErr := runFunction(t, NewParseIdentifierFunction(), types.StringValue(tc.Identifier))
+
+			require.Nil(t, funcErr)
+			assert.Equal(t, tc.Expected, result)
+		})
+	}
+
+	t.Run("invalid identifier", func(t *testing.T) {
+		_, funcErr := runFunction(t, NewParseIdentifierFunction(), types.StringValue(`"a"."b"."c"."d"."e"`))
+
+		require.NotNil(t, funcErr)
+		assert.Contains(t, funcErr.Error(), "unsupported identifier")
+	})
+}
@@ -43,7 +43,7 @@ var accountRoleSchema = map[string]*schema.Schema{
 }
 
 func AccountRole() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeRole, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		Schema: accountRoleSchema,
 
 		CreateContext: TrackingCreateWrapper(resources.AccountRole, CreateAccountRole),
@@ -61,7 +61,7 @@ func AccountRole() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.AccountRole, ImportName[sdk.AccountObjectIdentifier]),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateAccountRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
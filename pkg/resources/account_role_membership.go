@@ -0,0 +1,288 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/experimentalfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var accountRoleMembershipSchema = map[string]*schema.Schema{
+	"role_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      relatedResourceDescription("The fully qualified name of the account role whose membership is managed.", resources.AccountRole),
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"parent_role_names": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: relatedResourceDescription("The names of all the account roles the role is granted to. Any other parent role found in Snowflake is revoked.", resources.AccountRole),
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		},
+	},
+	"user_names": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: relatedResourceDescription("The names of all the users the role is granted to. Any other user found in Snowflake is revoked.", resources.User),
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		},
+	},
+}
+
+func AccountRoleMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.AccountRoleMembershipResource), TrackingCreateWrapper(resources.AccountRoleMembership, CreateAccountRoleMembership)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.AccountRoleMembershipResource), TrackingUpdateWrapper(resources.AccountRoleMembership, UpdateAccountRoleMembership)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.AccountRoleMembershipResource), TrackingDeleteWrapper(resources.AccountRoleMembership, DeleteAccountRoleMembership)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.AccountRoleMembershipResource), TrackingReadWrapper(resources.AccountRoleMembership, ReadAccountRoleMembership)),
+		Description:   "Resource used to authoritatively manage the membership of an account role, i.e. all the parent roles and users the role is granted to. Grants of the role that are not declared in the configuration are revoked. It should not be used together with `snowflake_grant_account_role` resources granting the same role.",
+
+		Schema: accountRoleMembershipSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.AccountRoleMembership, ImportAccountRoleMembership),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportAccountRoleMembership(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("role_name", id.FullyQualifiedName()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateAccountRoleMembership(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	providerCtx := meta.(*provider.Context)
+	client := providerCtx.Client
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("role_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parentRoles, err := getAccountObjectIdentifiersFromSet(d, "parent_role_names")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	users, err := getAccountObjectIdentifiersFromSet(d, "user_names")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The membership is reconciled against the grants existing in Snowflake, so the grants that were made outside
+	// of Terraform before the resource was created are revoked as well.
+	grants, err := client.Grants.Show(ctx, &sdk.ShowGrantOptions{
+		Of: &sdk.ShowGrantsOf{Role: id},
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	currentParentRoles, currentUsers := accountRoleMembersFromGrants(grants)
+
+	parentRolesToAdd, parentRolesToRemove := ListDiff(currentParentRoles, parentRoles)
+	usersToAdd, usersToRemove := ListDiff(currentUsers, users)
+	if err := updateAccountRoleMembership(ctx, client, id, parentRolesToAdd, parentRolesToRemove, usersToAdd, usersToRemove, false); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when setting account role membership",
+				Detail:   fmt.Sprintf("Role name: %s\nError: %s", id.FullyQualifiedName(), err.Error()),
+			},
+		}
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	invalidateGrantsOfRoleCache(providerCtx, id)
+
+	return ReadAccountRoleMembership(ctx, d, meta)
+}
+
+func ReadAccountRoleMembership(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	providerCtx := meta.(*provider.Context)
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	grants, err := showGrantsOfRoleCached(ctx, providerCtx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve account role membership. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Role name: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+	parentRoles, users := accountRoleMembersFromGrants(grants)
+
+	errs := errors.Join(
+		d.Set("role_name", id.FullyQualifiedName()),
+		d.Set("parent_role_names", collections.Map(parentRoles, sdk.AccountObjectIdentifier.Name)),
+		d.Set("user_names", collections.Map(users, sdk.AccountObjectIdentifier.Name)),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	return nil
+}
+
+func UpdateAccountRoleMembership(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	providerCtx := meta.(*provider.Context)
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var parentRolesToAdd, parentRolesToRemove, usersToAdd, usersToRemove []sdk.AccountObjectIdentifier
+	if d.HasChange("parent_role_names") {
+		parentRolesToAdd, parentRolesToRemove, err = getAccountObjectIdentifiersSetChange(d, "parent_role_names")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("user_names") {
+		usersToAdd, usersToRemove, err = getAccountObjectIdentifiersSetChange(d, "user_names")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := updateAccountRoleMembership(ctx, providerCtx.Client, id, parentRolesToAdd, parentRolesToRemove, usersToAdd, usersToRemove, false); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when updating account role membership",
+				Detail:   fmt.Sprintf("Role name: %s\nError: %s", id.FullyQualifiedName(), err.Error()),
+			},
+		}
+	}
+	invalidateGrantsOfRoleCache(providerCtx, id)
+
+	return ReadAccountRoleMembership(ctx, d, meta)
+}
+
+func DeleteAccountRoleMembership(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	providerCtx := meta.(*provider.Context)
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parentRoles, err := getAccountObjectIdentifiersFromSet(d, "parent_role_names")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	users, err := getAccountObjectIdentifiersFromSet(d, "user_names")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	safely := experimentalfeatures.IsExperimentEnabled(experimentalfeatures.GrantsSafeDestroy, providerCtx.EnabledExperiments)
+	if err := updateAccountRoleMembership(ctx, providerCtx.Client, id, nil, parentRoles, nil, users, safely); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when revoking account role membership",
+				Detail:   fmt.Sprintf("Role name: %s\nError: %s", id.FullyQualifiedName(), err.Error()),
+			},
+		}
+	}
+	invalidateGrantsOfRoleCache(providerCtx, id)
+
+	d.SetId("")
+	return nil
+}
+
+// accountRoleMembersFromGrants splits the result of SHOW GRANTS OF ROLE into the parent roles and the users the role is granted to.
+func accountRoleMembersFromGrants(grants []sdk.Grant) (parentRoles []sdk.AccountObjectIdentifier, users []sdk.AccountObjectIdentifier) {
+	for _, grant := range grants {
+		switch grant.GrantedTo {
+		case sdk.ObjectTypeRole:
+			parentRoles = append(parentRoles, sdk.NewAccountObjectIdentifier(grant.GranteeName.Name()))
+		case sdk.ObjectTypeUser:
+			users = append(users, sdk.NewAccountObjectIdentifier(grant.GranteeName.Name()))
+		}
+	}
+	return parentRoles, users
+}
+
+func updateAccountRoleMembership(
+	ctx context.Context,
+	client *sdk.Client,
+	id sdk.AccountObjectIdentifier,
+	parentRolesToAdd []sdk.AccountObjectIdentifier,
+	parentRolesToRemove []sdk.AccountObjectIdentifier,
+	usersToAdd []sdk.AccountObjectIdentifier,
+	usersToRemove []sdk.AccountObjectIdentifier,
+	safely bool,
+) error {
+	revokeFunc := client.Roles.Revoke
+	if safely {
+		revokeFunc = client.Roles.RevokeSafely
+	}
+
+	for _, parentRole := range parentRolesToAdd {
+		if err := client.Roles.Grant(ctx, sdk.NewGrantRoleRequest(id, *sdk.NewGrantRoleToRequest().WithRole(parentRole))); err != nil {
+			return err
+		}
+	}
+	for _, user := range usersToAdd {
+		if err := client.Roles.Grant(ctx, sdk.NewGrantRoleRequest(id, *sdk.NewGrantRoleToRequest().WithUser(user))); err != nil {
+			return err
+		}
+	}
+	for _, parentRole := range parentRolesToRemove {
+		if err := revokeFunc(ctx, sdk.NewRevokeRoleRequest(id, *sdk.NewRevokeRoleFromRequest().WithRole(parentRole))); err != nil {
+			return err
+		}
+	}
+	for _, user := range usersToRemove {
+		if err := revokeFunc(ctx, sdk.NewRevokeRoleRequest(id, *sdk.NewRevokeRoleFromRequest().WithUser(user))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func getAccountObjectIdentifiersFromSet(d *schema.ResourceData, key string) ([]sdk.AccountObjectIdentifier, error) {
+	return collections.MapErr(expandStringList(d.Get(key).(*schema.Set).List()), sdk.ParseAccountObjectIdentifier)
+}
+
+func getAccountObjectIdentifiersSetChange(d *schema.ResourceData, key string) (added []sdk.AccountObjectIdentifier, removed []sdk.AccountObjectIdentifier, err error) {
+	before, after := d.GetChange(key)
+	beforeIds, err := collections.MapErr(expandStringList(before.(*schema.Set).List()), sdk.ParseAccountObjectIdentifier)
+	if err != nil {
+		return nil, nil, err
+	}
+	afterIds, err := collections.MapErr(expandStringList(after.(*schema.Set).List()), sdk.ParseAccountObjectIdentifier)
+	if err != nil {
+		return nil, nil, err
+	}
+	added, removed = ListDiff(beforeIds, afterIds)
+	return added, removed, nil
+}
@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountRoleMembersFromGrants(t *testing.T) {
+	testCases := []struct {
+		Name                string
+		Grants              []sdk.Grant
+		ExpectedParentRoles []sdk.AccountObjectIdentifier
+		ExpectedUsers       []sdk.AccountObjectIdentifier
+	}{
+		{
+			Name:                "no grants",
+			Grants:              []sdk.Grant{},
+			ExpectedParentRoles: nil,
+			ExpectedUsers:       nil,
+		},
+		{
+			Name: "parent roles and users",
+			Grants: []sdk.Grant{
+				{GrantedTo: sdk.ObjectTypeRole, GranteeName: sdk.NewAccountObjectIdentifier("PARENT_1")},
+				{GrantedTo: sdk.ObjectTypeUser, GranteeName: sdk.NewAccountObjectIdentifier("USER_1")},
+				{GrantedTo: sdk.ObjectTypeRole, GranteeName: sdk.NewAccountObjectIdentifier("PARENT_2")},
+			},
+			ExpectedParentRoles: []sdk.AccountObjectIdentifier{sdk.NewAccountObjectIdentifier("PARENT_1"), sdk.NewAccountObjectIdentifier("PARENT_2")},
+			ExpectedUsers:       []sdk.AccountObjectIdentifier{sdk.NewAccountObjectIdentifier("USER_1")},
+		},
+		{
+			Name: "other grantee types are skipped",
+			Grants: []sdk.Grant{
+				{GrantedTo: sdk.ObjectTypeShare, GranteeName: sdk.NewAccountObjectIdentifier("SHARE_1")},
+				{GrantedTo: sdk.ObjectTypeUser, GranteeName: sdk.NewAccountObjectIdentifier("USER_1")},
+			},
+			ExpectedParentRoles: nil,
+			ExpectedUsers:       []sdk.AccountObjectIdentifier{sdk.NewAccountObjectIdentifier("USER_1")},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			parentRoles, users := accountRoleMembersFromGrants(tt.Grants)
+			assert.Equal(t, tt.ExpectedParentRoles, parentRoles)
+			assert.Equal(t, tt.ExpectedUsers, users)
+		})
+	}
+}
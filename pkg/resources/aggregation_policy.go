@@ -0,0 +1,198 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var aggregationPolicyResourceSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the aggregation policy; must be unique for the database and schema in which the aggregation policy is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the aggregation policy."),
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the aggregation policy."),
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"body": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      diffSuppressStatementFieldDescription("Specifies the SQL expression that determines the aggregation constraint. The expression must return `AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => <integer>)` or `NO_AGGREGATION_CONSTRAINT()`."),
+		DiffSuppressFunc: DiffSuppressStatement,
+		ValidateFunc:     validation.StringIsNotEmpty,
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the aggregation policy.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW AGGREGATION POLICIES` for the given aggregation policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowAggregationPolicySchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE AGGREGATION POLICY` for the given aggregation policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowAggregationPolicyDetailsSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func AggregationPolicy() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.AggregationPolicies.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.AggregationPolicyResource), TrackingCreateWrapper(resources.AggregationPolicy, CreateAggregationPolicy)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.AggregationPolicyResource), TrackingReadWrapper(resources.AggregationPolicy, ReadAggregationPolicy)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.AggregationPolicyResource), TrackingUpdateWrapper(resources.AggregationPolicy, UpdateAggregationPolicy)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.AggregationPolicyResource), TrackingDeleteWrapper(resources.AggregationPolicy, deleteFunc)),
+		Description:   "Resource used to manage aggregation policy objects. For more information, check [aggregation policy documentation](https://docs.snowflake.com/en/sql-reference/sql/create-aggregation-policy).",
+
+		Schema: aggregationPolicyResourceSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.AggregationPolicy, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.AggregationPolicy, customdiff.All(
+			ComputedIfAnyAttributeChanged(aggregationPolicyResourceSchema, ShowOutputAttributeName, "name", "comment"),
+			ComputedIfAnyAttributeChanged(aggregationPolicyResourceSchema, DescribeOutputAttributeName, "name", "body"),
+			ComputedIfAnyAttributeChanged(aggregationPolicyResourceSchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateAggregationPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	request := sdk.NewCreateAggregationPolicyRequest(id, d.Get("body").(string))
+
+	if errs := errors.Join(
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.AggregationPolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating aggregation policy %v, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadAggregationPolicy(ctx, d, meta)
+}
+
+func ReadAggregationPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	aggregationPolicy, err := client.AggregationPolicies.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query aggregation policy. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Aggregation policy id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+	aggregationPolicyDetails, err := client.AggregationPolicies.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("body", aggregationPolicyDetails.Body),
+		d.Set("comment", aggregationPolicy.Comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.AggregationPolicyToSchema(aggregationPolicy)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.AggregationPolicyDetailsToSchema(aggregationPolicyDetails)}),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateAggregationPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming aggregation policy from %v to %v, err = %w", id.FullyQualifiedName(), newId.FullyQualifiedName(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	if d.HasChange("body") {
+		if err := client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithSetBody(d.Get("body").(string))); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating aggregation policy %v body, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			if err := client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithSetComment(comment)); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting comment for aggregation policy %v, err = %w", id.FullyQualifiedName(), err))
+			}
+		} else {
+			if err := client.AggregationPolicies.Alter(ctx, sdk.NewAlterAggregationPolicyRequest(id).WithUnsetComment(true)); err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting comment for aggregation policy %v, err = %w", id.FullyQualifiedName(), err))
+			}
+		}
+	}
+
+	return ReadAggregationPolicy(ctx, d, meta)
+}
@@ -118,7 +118,7 @@ func Alert() *schema.Resource {
 		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] { return client.Alerts.DropSafely },
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeAlert, helpers.DecodeSnowflakeIDErrLegacy[sdk.SchemaObjectIdentifier], &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.AlertResource), TrackingCreateWrapper(resources.Alert, CreateAlert)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.AlertResource), TrackingReadWrapper(resources.Alert, ReadAlert)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.AlertResource), TrackingUpdateWrapper(resources.Alert, UpdateAlert)),
@@ -129,7 +129,7 @@ func Alert() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 // ReadAlert implements schema.ReadContextFunc.
@@ -34,7 +34,7 @@ var apiAuthAuthorizationCodeGrantSchema = func() map[string]*schema.Schema {
 }()
 
 func ApiAuthenticationIntegrationWithAuthorizationCodeGrant() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant, CreateContextApiAuthenticationIntegrationWithAuthorizationCodeGrant),
 		ReadContext:   TrackingReadWrapper(resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant, ReadContextApiAuthenticationIntegrationWithAuthorizationCodeGrant(true)),
 		UpdateContext: TrackingUpdateWrapper(resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant, UpdateContextApiAuthenticationIntegrationWithAuthorizationCodeGrant),
@@ -55,7 +55,7 @@ func ApiAuthenticationIntegrationWithAuthorizationCodeGrant() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.ApiAuthenticationIntegrationWithAuthorizationCodeGrant, ImportApiAuthenticationWithAuthorizationCodeGrant),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportApiAuthenticationWithAuthorizationCodeGrant(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -29,7 +29,7 @@ var apiAuthClientCredentialsSchema = func() map[string]*schema.Schema {
 }()
 
 func ApiAuthenticationIntegrationWithClientCredentials() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.ApiAuthenticationIntegrationWithClientCredentials, CreateContextApiAuthenticationIntegrationWithClientCredentials),
 		ReadContext:   TrackingReadWrapper(resources.ApiAuthenticationIntegrationWithClientCredentials, ReadContextApiAuthenticationIntegrationWithClientCredentials(true)),
 		UpdateContext: TrackingUpdateWrapper(resources.ApiAuthenticationIntegrationWithClientCredentials, UpdateContextApiAuthenticationIntegrationWithClientCredentials),
@@ -49,7 +49,7 @@ func ApiAuthenticationIntegrationWithClientCredentials() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.ApiAuthenticationIntegrationWithClientCredentials, ImportApiAuthenticationWithClientCredentials),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportApiAuthenticationWithClientCredentials(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
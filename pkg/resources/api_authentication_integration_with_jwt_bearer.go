@@ -32,7 +32,7 @@ var apiAuthJwtBearerSchema = func() map[string]*schema.Schema {
 }()
 
 func ApiAuthenticationIntegrationWithJwtBearer() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.ApiAuthenticationIntegrationWithJwtBearer, CreateContextApiAuthenticationIntegrationWithJwtBearer),
 		ReadContext:   TrackingReadWrapper(resources.ApiAuthenticationIntegrationWithJwtBearer, ReadContextApiAuthenticationIntegrationWithJwtBearer(true)),
 		UpdateContext: TrackingUpdateWrapper(resources.ApiAuthenticationIntegrationWithJwtBearer, UpdateContextApiAuthenticationIntegrationWithJwtBearer),
@@ -54,7 +54,7 @@ func ApiAuthenticationIntegrationWithJwtBearer() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.ApiAuthenticationIntegrationWithJwtBearer, ImportApiAuthenticationWithJwtBearer),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportApiAuthenticationWithJwtBearer(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
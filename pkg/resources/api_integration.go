@@ -131,7 +131,7 @@ func APIIntegration() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, helpers.DecodeSnowflakeIDErrLegacy[sdk.AccountObjectIdentifier], &schema.Resource{
 		CreateContext:      PreviewFeatureCreateContextWrapper(string(previewfeatures.ApiIntegrationResource), TrackingCreateWrapper(resources.ApiIntegration, CreateAPIIntegration)),
 		ReadContext:        PreviewFeatureReadContextWrapper(string(previewfeatures.ApiIntegrationResource), TrackingReadWrapper(resources.ApiIntegration, ReadAPIIntegration)),
 		UpdateContext:      PreviewFeatureUpdateContextWrapper(string(previewfeatures.ApiIntegrationResource), TrackingUpdateWrapper(resources.ApiIntegration, UpdateAPIIntegration)),
@@ -143,7 +143,7 @@ func APIIntegration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func toApiIntegrationEndpointPrefix(paths []string) []sdk.ApiIntegrationEndpointPrefix {
@@ -61,7 +61,7 @@ func ApiIntegrationAmazonApiGateway() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ApiIntegrationAmazonApiGatewayResource), TrackingCreateWrapper(resources.ApiIntegrationAmazonApiGateway, CreateApiIntegrationAmazonApiGateway)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ApiIntegrationAmazonApiGatewayResource), TrackingReadWrapper(resources.ApiIntegrationAmazonApiGateway, ReadApiIntegrationAmazonApiGateway)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ApiIntegrationAmazonApiGatewayResource), TrackingUpdateWrapper(resources.ApiIntegrationAmazonApiGateway, UpdateApiIntegrationAmazonApiGateway)),
@@ -77,7 +77,7 @@ func ApiIntegrationAmazonApiGateway() *schema.Resource {
 			ComputedIfAnyAttributeChanged(apiIntegrationAmazonApiGatewaySchema, ShowOutputAttributeName, "enabled", "comment"),
 			ComputedIfAnyAttributeChanged(apiIntegrationAmazonApiGatewaySchema, DescribeOutputAttributeName, "enabled", "api_allowed_prefixes", "api_blocked_prefixes", "comment", "api_aws_role_arn", "api_key"),
 		),
-	}
+	})
 }
 
 func CreateApiIntegrationAmazonApiGateway(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -59,7 +59,7 @@ func ApiIntegrationAzureApiManagement() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ApiIntegrationAzureApiManagementResource), TrackingCreateWrapper(resources.ApiIntegrationAzureApiManagement, CreateApiIntegrationAzureApiManagement)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ApiIntegrationAzureApiManagementResource), TrackingReadWrapper(resources.ApiIntegrationAzureApiManagement, ReadApiIntegrationAzureApiManagement)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ApiIntegrationAzureApiManagementResource), TrackingUpdateWrapper(resources.ApiIntegrationAzureApiManagement, UpdateApiIntegrationAzureApiManagement)),
@@ -75,7 +75,7 @@ func ApiIntegrationAzureApiManagement() *schema.Resource {
 			ComputedIfAnyAttributeChanged(apiIntegrationAzureApiManagementSchema, ShowOutputAttributeName, "enabled", "comment"),
 			ComputedIfAnyAttributeChanged(apiIntegrationAzureApiManagementSchema, DescribeOutputAttributeName, "enabled", "api_allowed_prefixes", "api_blocked_prefixes", "comment", "azure_tenant_id", "azure_ad_application_id", "api_key"),
 		),
-	}
+	})
 }
 
 func ImportApiIntegrationAzureApiManagement(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -48,7 +48,7 @@ func ApiIntegrationExternalMcpDynamicClient() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ApiIntegrationExternalMcpDynamicClientResource), TrackingCreateWrapper(resources.ApiIntegrationExternalMcpDynamicClient, CreateApiIntegrationExternalMcpDynamicClient)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ApiIntegrationExternalMcpDynamicClientResource), TrackingReadWrapper(resources.ApiIntegrationExternalMcpDynamicClient, ReadApiIntegrationExternalMcpDynamicClient)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ApiIntegrationExternalMcpDynamicClientResource), TrackingUpdateWrapper(resources.ApiIntegrationExternalMcpDynamicClient, UpdateApiIntegrationExternalMcpDynamicClient)),
@@ -64,7 +64,7 @@ func ApiIntegrationExternalMcpDynamicClient() *schema.Resource {
 			ComputedIfAnyAttributeChanged(apiIntegrationExternalMcpDynamicClientSchema, ShowOutputAttributeName, "enabled", "comment"),
 			ComputedIfAnyAttributeChanged(apiIntegrationExternalMcpDynamicClientSchema, DescribeOutputAttributeName, "enabled", "api_allowed_prefixes", "api_blocked_prefixes", "comment", "oauth_resource_url"),
 		),
-	}
+	})
 }
 
 func CreateApiIntegrationExternalMcpDynamicClient(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
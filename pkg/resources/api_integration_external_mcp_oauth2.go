@@ -78,7 +78,7 @@ func ApiIntegrationExternalMcpOAuth2() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ApiIntegrationExternalMcpOAuth2Resource), TrackingCreateWrapper(resources.ApiIntegrationExternalMcpOAuth2, CreateApiIntegrationExternalMcpOAuth2)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ApiIntegrationExternalMcpOAuth2Resource), TrackingReadWrapper(resources.ApiIntegrationExternalMcpOAuth2, ReadApiIntegrationExternalMcpOAuth2)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ApiIntegrationExternalMcpOAuth2Resource), TrackingUpdateWrapper(resources.ApiIntegrationExternalMcpOAuth2, UpdateApiIntegrationExternalMcpOAuth2)),
@@ -103,7 +103,7 @@ func ApiIntegrationExternalMcpOAuth2() *schema.Resource {
 				return old.(int) != 0 && new.(int) == 0
 			}),
 		),
-	}
+	})
 }
 
 func buildExternalMcpOAuth2Auth(d *schema.ResourceData) (*sdk.OAuth2McpUserAuthenticationRequest, error) {
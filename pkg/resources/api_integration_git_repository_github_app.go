@@ -40,7 +40,7 @@ func ApiIntegrationGitRepositoryGithubApp() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ApiIntegrationGitRepositoryGithubAppResource), TrackingCreateWrapper(resources.ApiIntegrationGitRepositoryGithubApp, CreateApiIntegrationGitRepositoryGithubApp)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ApiIntegrationGitRepositoryGithubAppResource), TrackingReadWrapper(resources.ApiIntegrationGitRepositoryGithubApp, ReadApiIntegrationGitRepositoryGithubApp)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ApiIntegrationGitRepositoryGithubAppResource), TrackingUpdateWrapper(resources.ApiIntegrationGitRepositoryGithubApp, UpdateApiIntegrationGitRepositoryGithubApp)),
@@ -56,7 +56,7 @@ func ApiIntegrationGitRepositoryGithubApp() *schema.Resource {
 			ComputedIfAnyAttributeChanged(apiIntegrationGitRepositoryGithubAppSchema, ShowOutputAttributeName, "enabled", "comment"),
 			ComputedIfAnyAttributeChanged(apiIntegrationGitRepositoryGithubAppSchema, DescribeOutputAttributeName, "enabled", "api_allowed_prefixes", "api_blocked_prefixes", "comment"),
 		),
-	}
+	})
 }
 
 func ImportApiIntegrationGitRepositoryGithubApp(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
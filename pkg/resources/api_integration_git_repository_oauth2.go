@@ -100,7 +100,7 @@ func ApiIntegrationGitRepositoryOauth2() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ApiIntegrationGitRepositoryOauth2Resource), TrackingCreateWrapper(resources.ApiIntegrationGitRepositoryOauth2, CreateApiIntegrationGitRepositoryOauth2)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ApiIntegrationGitRepositoryOauth2Resource), TrackingReadWrapper(resources.ApiIntegrationGitRepositoryOauth2, ReadApiIntegrationGitRepositoryOauth2)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ApiIntegrationGitRepositoryOauth2Resource), TrackingUpdateWrapper(resources.ApiIntegrationGitRepositoryOauth2, UpdateApiIntegrationGitRepositoryOauth2)),
@@ -116,7 +116,7 @@ func ApiIntegrationGitRepositoryOauth2() *schema.Resource {
 			ComputedIfAnyAttributeChanged(apiIntegrationGitRepositoryOauth2Schema, ShowOutputAttributeName, "enabled", "comment"),
 			ComputedIfAnyAttributeChanged(apiIntegrationGitRepositoryOauth2Schema, DescribeOutputAttributeName, "enabled", "api_allowed_prefixes", "api_blocked_prefixes", "comment"),
 		),
-	}
+	})
 }
 
 func ImportApiIntegrationGitRepositoryOauth2(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
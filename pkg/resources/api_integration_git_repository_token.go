@@ -40,7 +40,7 @@ func ApiIntegrationGitRepositoryToken() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ApiIntegrationGitRepositoryTokenResource), TrackingCreateWrapper(resources.ApiIntegrationGitRepositoryToken, CreateApiIntegrationGitRepositoryToken)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ApiIntegrationGitRepositoryTokenResource), TrackingReadWrapper(resources.ApiIntegrationGitRepositoryToken, ReadApiIntegrationGitRepositoryToken)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ApiIntegrationGitRepositoryTokenResource), TrackingUpdateWrapper(resources.ApiIntegrationGitRepositoryToken, UpdateApiIntegrationGitRepositoryToken)),
@@ -56,7 +56,7 @@ func ApiIntegrationGitRepositoryToken() *schema.Resource {
 			ComputedIfAnyAttributeChanged(apiIntegrationGitRepositoryTokenSchema, ShowOutputAttributeName, "enabled", "comment"),
 			ComputedIfAnyAttributeChanged(apiIntegrationGitRepositoryTokenSchema, DescribeOutputAttributeName, "enabled", "api_allowed_prefixes", "api_blocked_prefixes", "comment", "all_allowed_authentication_secrets", "no_allowed_authentication_secrets", "allowed_authentication_secrets"),
 		),
-	}
+	})
 }
 
 func ImportApiIntegrationGitRepositoryToken(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -47,7 +47,7 @@ func ApiIntegrationGoogleCloudApiGateway() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ApiIntegrationGoogleCloudApiGatewayResource), TrackingCreateWrapper(resources.ApiIntegrationGoogleCloudApiGateway, CreateApiIntegrationGoogleCloudApiGateway)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ApiIntegrationGoogleCloudApiGatewayResource), TrackingReadWrapper(resources.ApiIntegrationGoogleCloudApiGateway, ReadApiIntegrationGoogleCloudApiGateway)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ApiIntegrationGoogleCloudApiGatewayResource), TrackingUpdateWrapper(resources.ApiIntegrationGoogleCloudApiGateway, UpdateApiIntegrationGoogleCloudApiGateway)),
@@ -63,7 +63,7 @@ func ApiIntegrationGoogleCloudApiGateway() *schema.Resource {
 			ComputedIfAnyAttributeChanged(apiIntegrationGoogleCloudApiGatewaySchema, ShowOutputAttributeName, "enabled", "comment"),
 			ComputedIfAnyAttributeChanged(apiIntegrationGoogleCloudApiGatewaySchema, DescribeOutputAttributeName, "enabled", "api_allowed_prefixes", "api_blocked_prefixes", "comment", "google_audience"),
 		),
-	}
+	})
 }
 
 func ImportApiIntegrationGoogleCloudApiGateway(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -0,0 +1,337 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	applicationSourceTypeApplicationPackage = "APPLICATION PACKAGE"
+	applicationSourceTypeListing            = "LISTING"
+)
+
+var applicationSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the application; must be unique for the account."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"from_application_package": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     []string{"from_application_package", "from_listing"},
+		Description:      relatedResourceDescription("Specifies the name of the application package used to create the application.", resources.ApplicationPackage),
+	},
+	"from_listing": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf:     []string{"from_application_package", "from_listing"},
+		Description:      "Specifies the name of the listing containing the application package used to create the application.",
+	},
+	"version": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		ConflictsWith:    []string{"from_listing", "version_directory"},
+		Description:      "Specifies the version of the application package used to create the application. Changing this field upgrades the application to the given version. Removing it upgrades the application to the version specified by the release directive.",
+	},
+	"patch": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+		RequiredWith:     []string{"version"},
+		Description:      "Specifies the patch of the `version` used to create the application. Changing this field upgrades the application to the given patch.",
+	},
+	"version_directory": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+		ConflictsWith:    []string{"from_listing", "version"},
+		Description:      "Specifies the path to the stage containing the application files (e.g. `@DATABASE.SCHEMA.STAGE/dev`). It is used to create the application in development mode. Changing this field upgrades the application using the files from the given path.",
+	},
+	"debug_mode": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          BooleanDefault,
+		ValidateDiagFunc: validateBooleanString,
+		ConflictsWith:    []string{"from_listing"},
+		Description:      booleanStringFieldDescription("Specifies whether the debug mode is enabled for the application. The value is read from Snowflake only when this field is set."),
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the application.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW APPLICATIONS` for the given application.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowApplicationSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE APPLICATION` for the given application.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowApplicationPropertySchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func Application() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseAccountObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.AccountObjectIdentifier] {
+			return client.Applications.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ApplicationResource), TrackingCreateWrapper(resources.Application, CreateApplication)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ApplicationResource), TrackingReadWrapper(resources.Application, ReadApplication)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ApplicationResource), TrackingUpdateWrapper(resources.Application, UpdateApplication)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.ApplicationResource), TrackingDeleteWrapper(resources.Application, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage application objects of the Snowflake Native App Framework, installed from an application package or a listing. For more information, check [application documentation](https://docs.snowflake.com/en/sql-reference/sql/create-application).",
+			"Changes to `version`, `patch`, and `version_directory` upgrade the application instead of recreating it.",
+		),
+
+		Schema: applicationSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.Application, ImportApplication),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.Application, customdiff.All(
+			ComputedIfAnyAttributeChanged(applicationSchema, ShowOutputAttributeName, "version", "patch", "version_directory", "comment"),
+			ComputedIfAnyAttributeChanged(applicationSchema, DescribeOutputAttributeName, "version", "patch", "version_directory", "debug_mode", "comment"),
+		)),
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportApplication(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	application, err := client.Applications.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := []error{d.Set("name", id.Name())}
+	switch application.SourceType {
+	case applicationSourceTypeApplicationPackage:
+		errs = append(errs, d.Set("from_application_package", sdk.NewAccountObjectIdentifier(application.Source).FullyQualifiedName()))
+	case applicationSourceTypeListing:
+		errs = append(errs, d.Set("from_listing", sdk.NewAccountObjectIdentifier(application.Source).FullyQualifiedName()))
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateApplication(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+	if v, ok := d.GetOk("from_listing"); ok {
+		listingId, err := sdk.ParseAccountObjectIdentifier(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		request := sdk.NewCreateFromListingApplicationRequest(id, listingId)
+		if err := stringAttributeCreateBuilder(d, "comment", request.WithComment); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := client.Applications.CreateFromListing(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error creating application %v from listing %v, err = %w", id.FullyQualifiedName(), listingId.FullyQualifiedName(), err))
+		}
+		d.SetId(helpers.EncodeResourceIdentifier(id))
+		return ReadApplication(ctx, d, meta)
+	}
+
+	packageId, err := sdk.ParseAccountObjectIdentifier(d.Get("from_application_package").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := sdk.NewCreateApplicationRequest(id, packageId)
+	version := applicationVersionRequest(d)
+	if version != nil {
+		request.WithVersion(*version)
+	}
+	if errs := errors.Join(
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+	// DEBUG_MODE can be set during creation only together with the version, otherwise it is set right after the creation.
+	if version != nil {
+		if err := booleanStringAttributeCreateBuilder(d, "debug_mode", request.WithDebugMode); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := client.Applications.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating application %v from application package %v, err = %w", id.FullyQualifiedName(), packageId.FullyQualifiedName(), err))
+	}
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	if version == nil {
+		setRequest := sdk.NewApplicationSetRequest()
+		if err := booleanStringAttributeCreate(d, "debug_mode", &setRequest.DebugMode); err != nil {
+			return diag.FromErr(err)
+		}
+		if setRequest.DebugMode != nil {
+			if err := client.Applications.Alter(ctx, sdk.NewAlterApplicationRequest(id).WithSet(*setRequest)); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting debug mode for application %v, err = %w", id.FullyQualifiedName(), err))
+			}
+		}
+	}
+
+	return ReadApplication(ctx, d, meta)
+}
+
+func ReadApplication(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	application, err := client.Applications.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query application. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Application id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+	properties, err := client.Applications.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if v := d.Get("version").(string); v != "" {
+		if err := d.Set("version", application.Version); err != nil {
+			return diag.FromErr(err)
+		}
+		if p := d.Get("patch").(int); p != IntDefault {
+			if err := d.Set("patch", application.Patch); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	if v := d.Get("debug_mode").(string); v != BooleanDefault {
+		if debugMode, err := collections.FindFirst(properties, func(p sdk.ApplicationProperty) bool { return p.Property == "debug_mode" }); err == nil {
+			if err := d.Set("debug_mode", debugMode.Value); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("comment", application.Comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.ApplicationToSchema(application)}),
+		d.Set(DescribeOutputAttributeName, collections.Map(properties, func(p sdk.ApplicationProperty) map[string]any {
+			return schemas.ApplicationPropertyToSchema(&p)
+		})),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateApplication(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("version", "patch", "version_directory") {
+		request := sdk.NewAlterApplicationRequest(id)
+		if version := applicationVersionRequest(d); version != nil {
+			request.WithUpgradeVersion(*version)
+		} else {
+			request.WithUpgrade(true)
+		}
+		if err := client.Applications.Alter(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error upgrading application %v, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	setRequest := sdk.NewApplicationSetRequest()
+	unsetRequest := sdk.NewApplicationUnsetRequest()
+
+	if errs := errors.Join(
+		booleanStringAttributeUpdate(d, "debug_mode", &setRequest.DebugMode, &unsetRequest.DebugMode),
+		stringAttributeUpdate(d, "comment", &setRequest.Comment, &unsetRequest.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if !reflect.DeepEqual(*setRequest, *sdk.NewApplicationSetRequest()) {
+		if err := client.Applications.Alter(ctx, sdk.NewAlterApplicationRequest(id).WithSet(*setRequest)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting properties for application %v, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if !reflect.DeepEqual(*unsetRequest, *sdk.NewApplicationUnsetRequest()) {
+		if err := client.Applications.Alter(ctx, sdk.NewAlterApplicationRequest(id).WithUnset(*unsetRequest)); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting properties for application %v, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	return ReadApplication(ctx, d, meta)
+}
+
+// applicationVersionRequest returns the version (or the version directory) the application should use,
+// or nil when the version specified by the release directive should be used.
+func applicationVersionRequest(d *schema.ResourceData) *sdk.ApplicationVersionRequest {
+	if v := d.Get("version_directory").(string); v != "" {
+		return sdk.NewApplicationVersionRequest().WithVersionDirectory(v)
+	}
+	if v := d.Get("version").(string); v != "" {
+		var patch *int
+		if p := d.Get("patch").(int); p != IntDefault {
+			patch = sdk.Int(p)
+		}
+		return sdk.NewApplicationVersionRequest().WithVersionAndPatch(*sdk.NewVersionAndPatchRequest(v, patch))
+	}
+	return nil
+}
@@ -0,0 +1,459 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var applicationPackageSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the application package; must be unique for the account."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"distribution": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: sdkValidation(sdk.ToDistribution),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToDistribution),
+		Description:      fmt.Sprintf("Specifies whether the application package can be shared with the consumers outside the provider's organization. Valid values are (case-insensitive): %s. The value is read from Snowflake only when this field is set.", possibleValuesListed(sdk.AllDistributions)),
+	},
+	"enable_release_channels": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Default:          BooleanDefault,
+		ValidateDiagFunc: validateBooleanString,
+		Description:      booleanStringFieldDescription("Specifies whether the release channels are enabled for the application package. The versions and the release directives managed by this resource can be used only with the release channels disabled. Snowflake does not allow changing this property after creation and does not return its value, so it is not read from Snowflake."),
+	},
+	"data_retention_time_in_days": {
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Default:          IntDefault,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(0, 90)),
+		Description:      "Specifies the number of days for which Time Travel actions can be performed on the application package. The value is read from Snowflake only when this field is set.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the application package.",
+	},
+	"version": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Specifies the versions of the application package. Adding a version runs `ALTER APPLICATION PACKAGE ... ADD VERSION`, and removing one drops the version. Changing `using` or `label` of an existing version adds a new patch for it. Only the versions removed outside of Terraform are detected, as Snowflake does not return the location of the version files.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+					Description:      "Specifies the identifier of the version.",
+				},
+				"using": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+					Description:      "Specifies the path to the stage containing the application files for the version (e.g. `@DATABASE.SCHEMA.STAGE/v1`).",
+				},
+				"label": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Specifies the label of the version displayed to the consumers.",
+				},
+			},
+		},
+	},
+	"default_release_directive": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Specifies the default release directive of the application package. Snowflake does not allow removing the default release directive, so removing this block does not change it in Snowflake.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"version": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+					Description:      "Specifies the version used by the default release directive.",
+				},
+				"patch": {
+					Type:             schema.TypeInt,
+					Required:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+					Description:      "Specifies the patch of the version used by the default release directive.",
+				},
+			},
+		},
+	},
+	"release_directive": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Specifies the custom release directives of the application package, targeting the given consumer accounts.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+					Description:      "Specifies the identifier of the release directive.",
+				},
+				"accounts": {
+					Type:        schema.TypeSet,
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Specifies the consumer accounts targeted by the release directive, in the `<organization_name>.<account_name>` format.",
+				},
+				"version": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+					Description:      "Specifies the version used by the release directive.",
+				},
+				"patch": {
+					Type:             schema.TypeInt,
+					Required:         true,
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+					Description:      "Specifies the patch of the version used by the release directive.",
+				},
+			},
+		},
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW APPLICATION PACKAGES` for the given application package.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowApplicationPackageSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func ApplicationPackage() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseAccountObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.AccountObjectIdentifier] {
+			return client.ApplicationPackages.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ApplicationPackageResource), TrackingCreateWrapper(resources.ApplicationPackage, CreateApplicationPackage)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ApplicationPackageResource), TrackingReadWrapper(resources.ApplicationPackage, ReadApplicationPackage)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ApplicationPackageResource), TrackingUpdateWrapper(resources.ApplicationPackage, UpdateApplicationPackage)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.ApplicationPackageResource), TrackingDeleteWrapper(resources.ApplicationPackage, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage application package objects of the Snowflake Native App Framework. For more information, check [application package documentation](https://docs.snowflake.com/en/sql-reference/sql/create-application-package).",
+			"The release directives are not read from Snowflake, so their changes made outside of Terraform are not detected.",
+			relatedResourceDescription("Use the companion resource to install the application from the package.", resources.Application),
+		),
+
+		Schema: applicationPackageSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.ApplicationPackage, ImportName[sdk.AccountObjectIdentifier]),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.ApplicationPackage, customdiff.All(
+			ComputedIfAnyAttributeChanged(applicationPackageSchema, ShowOutputAttributeName, "distribution", "data_retention_time_in_days", "comment"),
+		)),
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateApplicationPackage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewAccountObjectIdentifier(d.Get("name").(string))
+
+	request := sdk.NewCreateApplicationPackageRequest(id)
+	if errs := errors.Join(
+		attributeMappedValueCreateBuilder(d, "distribution", request.WithDistribution, sdk.ToDistribution),
+		booleanStringAttributeCreateBuilder(d, "enable_release_channels", request.WithEnableReleaseChannels),
+		intAttributeWithSpecialDefaultCreateBuilder(d, "data_retention_time_in_days", request.WithDataRetentionTimeInDays),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.ApplicationPackages.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating application package %v, err = %w", id.FullyQualifiedName(), err))
+	}
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	if v, ok := d.GetOk("version"); ok {
+		for _, version := range expandApplicationPackageVersions(v.(*schema.Set).List()) {
+			if err := addApplicationPackageVersion(ctx, client, id, version); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	if err := setApplicationPackageDefaultReleaseDirective(ctx, client, id, d); err != nil {
+		return diag.FromErr(err)
+	}
+	if v, ok := d.GetOk("release_directive"); ok {
+		for _, releaseDirective := range expandApplicationPackageReleaseDirectives(v.(*schema.Set).List()) {
+			if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithSetReleaseDirective(releaseDirective.toSetRequest())); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting release directive %s for application package %v, err = %w", releaseDirective.Name, id.FullyQualifiedName(), err))
+			}
+		}
+	}
+
+	return ReadApplicationPackage(ctx, d, meta)
+}
+
+func ReadApplicationPackage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	applicationPackage, err := client.ApplicationPackages.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query application package. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Application package id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	if v := d.Get("distribution").(string); v != "" {
+		if err := d.Set("distribution", applicationPackage.Distribution); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if v := d.Get("data_retention_time_in_days").(int); v != IntDefault {
+		if err := d.Set("data_retention_time_in_days", applicationPackage.RetentionTime); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if v, ok := d.GetOk("version"); ok {
+		versions, err := client.ApplicationPackages.ShowVersions(ctx, sdk.NewShowVersionsApplicationPackageRequest(id))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		existingVersions := make(map[string]bool)
+		for _, version := range versions {
+			existingVersions[version.Version] = true
+		}
+		presentVersions := make([]any, 0)
+		for _, version := range v.(*schema.Set).List() {
+			if existingVersions[version.(map[string]any)["name"].(string)] {
+				presentVersions = append(presentVersions, version)
+			}
+		}
+		if err := d.Set("version", presentVersions); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("comment", applicationPackage.Comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.ApplicationPackageToSchema(applicationPackage)}),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateApplicationPackage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setRequest := sdk.NewApplicationPackageSetRequest()
+	unsetRequest := sdk.NewApplicationPackageUnsetRequest()
+
+	if errs := errors.Join(
+		attributeMappedValueUpdate(d, "distribution", &setRequest.Distribution, &unsetRequest.Distribution, sdk.ToDistribution),
+		intAttributeWithSpecialDefaultUpdate(d, "data_retention_time_in_days", &setRequest.DataRetentionTimeInDays, &unsetRequest.DataRetentionTimeInDays),
+		stringAttributeUpdate(d, "comment", &setRequest.Comment, &unsetRequest.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if !reflect.DeepEqual(*setRequest, *sdk.NewApplicationPackageSetRequest()) {
+		if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithSet(*setRequest)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting properties for application package %v, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if !reflect.DeepEqual(*unsetRequest, *sdk.NewApplicationPackageUnsetRequest()) {
+		if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithUnset(*unsetRequest)); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting properties for application package %v, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	// The versions are dropped only after the release directives are updated, so that the directives no longer refer to them.
+	var removedVersions []applicationPackageVersion
+	if d.HasChange("version") {
+		oldRaw, newRaw := d.GetChange("version")
+		oldVersions := expandApplicationPackageVersions(oldRaw.(*schema.Set).List())
+		newVersions := expandApplicationPackageVersions(newRaw.(*schema.Set).List())
+
+		for _, newVersion := range newVersions {
+			oldVersion, err := collections.FindFirst(oldVersions, func(v applicationPackageVersion) bool { return v.Name == newVersion.Name })
+			switch {
+			case err != nil:
+				if err := addApplicationPackageVersion(ctx, client, id, newVersion); err != nil {
+					return diag.FromErr(err)
+				}
+			case *oldVersion != newVersion:
+				if err := addApplicationPackageVersionPatch(ctx, client, id, newVersion); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+		}
+		for _, oldVersion := range oldVersions {
+			if _, err := collections.FindFirst(newVersions, func(v applicationPackageVersion) bool { return v.Name == oldVersion.Name }); err != nil {
+				removedVersions = append(removedVersions, oldVersion)
+			}
+		}
+	}
+
+	if d.HasChange("default_release_directive") {
+		if err := setApplicationPackageDefaultReleaseDirective(ctx, client, id, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("release_directive") {
+		oldRaw, newRaw := d.GetChange("release_directive")
+		oldDirectives := expandApplicationPackageReleaseDirectives(oldRaw.(*schema.Set).List())
+		newDirectives := expandApplicationPackageReleaseDirectives(newRaw.(*schema.Set).List())
+
+		for _, oldDirective := range oldDirectives {
+			newDirective, err := collections.FindFirst(newDirectives, func(v applicationPackageReleaseDirective) bool { return v.Name == oldDirective.Name })
+			if err != nil || !reflect.DeepEqual(newDirective.Accounts, oldDirective.Accounts) {
+				if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithUnsetReleaseDirective(*sdk.NewUnsetReleaseDirectiveRequest(oldDirective.Name))); err != nil {
+					return diag.FromErr(fmt.Errorf("error unsetting release directive %s for application package %v, err = %w", oldDirective.Name, id.FullyQualifiedName(), err))
+				}
+			}
+		}
+		for _, newDirective := range newDirectives {
+			oldDirective, err := collections.FindFirst(oldDirectives, func(v applicationPackageReleaseDirective) bool { return v.Name == newDirective.Name })
+			switch {
+			case err != nil || !reflect.DeepEqual(newDirective.Accounts, oldDirective.Accounts):
+				if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithSetReleaseDirective(newDirective.toSetRequest())); err != nil {
+					return diag.FromErr(fmt.Errorf("error setting release directive %s for application package %v, err = %w", newDirective.Name, id.FullyQualifiedName(), err))
+				}
+			case newDirective.Version != oldDirective.Version || newDirective.Patch != oldDirective.Patch:
+				request := sdk.NewModifyReleaseDirectiveRequest(newDirective.Name, newDirective.Version, newDirective.Patch)
+				if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithModifyReleaseDirective(*request)); err != nil {
+					return diag.FromErr(fmt.Errorf("error modifying release directive %s for application package %v, err = %w", newDirective.Name, id.FullyQualifiedName(), err))
+				}
+			}
+		}
+	}
+
+	for _, version := range removedVersions {
+		if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithDropVersion(*sdk.NewDropVersionRequest(version.Name))); err != nil {
+			return diag.FromErr(fmt.Errorf("error dropping version %s from application package %v, err = %w", version.Name, id.FullyQualifiedName(), err))
+		}
+	}
+
+	return ReadApplicationPackage(ctx, d, meta)
+}
+
+type applicationPackageVersion struct {
+	Name  string
+	Using string
+	Label string
+}
+
+func expandApplicationPackageVersions(raw []any) []applicationPackageVersion {
+	return collections.Map(raw, func(v any) applicationPackageVersion {
+		version := v.(map[string]any)
+		return applicationPackageVersion{
+			Name:  version["name"].(string),
+			Using: version["using"].(string),
+			Label: version["label"].(string),
+		}
+	})
+}
+
+func addApplicationPackageVersion(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, version applicationPackageVersion) error {
+	request := sdk.NewAddVersionRequest(version.Using).WithVersionIdentifier(version.Name)
+	if version.Label != "" {
+		request.WithLabel(version.Label)
+	}
+	if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithAddVersion(*request)); err != nil {
+		return fmt.Errorf("error adding version %s to application package %v, err = %w", version.Name, id.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
+func addApplicationPackageVersionPatch(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, version applicationPackageVersion) error {
+	request := sdk.NewAddPatchForVersionRequest(sdk.String(version.Name), version.Using)
+	if version.Label != "" {
+		request.WithLabel(version.Label)
+	}
+	if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithAddPatchForVersion(*request)); err != nil {
+		return fmt.Errorf("error adding patch for version %s to application package %v, err = %w", version.Name, id.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
+func setApplicationPackageDefaultReleaseDirective(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, d *schema.ResourceData) error {
+	v, ok := d.GetOk("default_release_directive")
+	if !ok || len(v.([]any)) == 0 {
+		return nil
+	}
+	directive := v.([]any)[0].(map[string]any)
+	request := sdk.NewSetDefaultReleaseDirectiveRequest(directive["version"].(string), directive["patch"].(int))
+	if err := client.ApplicationPackages.Alter(ctx, sdk.NewAlterApplicationPackageRequest(id).WithSetDefaultReleaseDirective(*request)); err != nil {
+		return fmt.Errorf("error setting default release directive for application package %v, err = %w", id.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
+type applicationPackageReleaseDirective struct {
+	Name     string
+	Accounts []string
+	Version  string
+	Patch    int
+}
+
+func (r applicationPackageReleaseDirective) toSetRequest() sdk.SetReleaseDirectiveRequest {
+	return *sdk.NewSetReleaseDirectiveRequest(r.Name, r.Accounts, r.Version, r.Patch)
+}
+
+func expandApplicationPackageReleaseDirectives(raw []any) []applicationPackageReleaseDirective {
+	return collections.Map(raw, func(v any) applicationPackageReleaseDirective {
+		directive := v.(map[string]any)
+		accounts := expandStringList(directive["accounts"].(*schema.Set).List())
+		slices.Sort(accounts)
+		return applicationPackageReleaseDirective{
+			Name:     directive["name"].(string),
+			Accounts: accounts,
+			Version:  directive["version"].(string),
+			Patch:    directive["patch"].(int),
+		}
+	})
+}
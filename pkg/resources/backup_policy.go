@@ -0,0 +1,227 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var backupPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the backup policy; must be unique for the database and schema in which the backup policy is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the backup policy."),
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the backup policy."),
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schedule": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Specifies the schedule for creating backups of the backup set to which the policy is applied. Either a number of minutes (e.g. `60 MINUTE`) or a cron expression (e.g. `USING CRON 0 9 * * * UTC`).",
+		ValidateFunc: validation.StringIsNotEmpty,
+		AtLeastOneOf: []string{"schedule", "expire_after_days"},
+	},
+	"expire_after_days": {
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  "Specifies the number of days after which the backups created by the policy expire.",
+		ValidateFunc: validation.IntAtLeast(1),
+		AtLeastOneOf: []string{"schedule", "expire_after_days"},
+	},
+	"with_retention_lock": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "Specifies whether the backups created by the policy are protected by a retention lock, which prevents them from being deleted before they expire. The retention lock can't be removed once set, so changing this field recreates the policy.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the backup policy.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW BACKUP POLICIES` for the given backup policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowBackupPolicySchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE BACKUP POLICY` for the given backup policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowBackupPolicyDetailsSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func BackupPolicy() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.BackupPolicies.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.BackupPolicy, CreateBackupPolicy),
+		ReadContext:   TrackingReadWrapper(resources.BackupPolicy, ReadBackupPolicy),
+		UpdateContext: TrackingUpdateWrapper(resources.BackupPolicy, UpdateBackupPolicy),
+		DeleteContext: TrackingDeleteWrapper(resources.BackupPolicy, deleteFunc),
+		Description:   "Resource used to manage backup policy objects. For more information, check [backup policy documentation](https://docs.snowflake.com/en/sql-reference/sql/create-backup-policy).",
+
+		Schema: backupPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.BackupPolicy, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.BackupPolicy, customdiff.All(
+			ComputedIfAnyAttributeChanged(backupPolicySchema, ShowOutputAttributeName, "name", "schedule", "expire_after_days", "with_retention_lock", "comment"),
+			ComputedIfAnyAttributeChanged(backupPolicySchema, DescribeOutputAttributeName, "name", "schedule", "expire_after_days", "with_retention_lock", "comment"),
+			ComputedIfAnyAttributeChanged(backupPolicySchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateBackupPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	request := sdk.NewCreateBackupPolicyRequest(id)
+
+	if errs := errors.Join(
+		stringAttributeCreateBuilder(d, "schedule", request.WithSchedule),
+		intAttributeCreateBuilder(d, "expire_after_days", request.WithExpireAfterDays),
+		boolAttributeCreateBuilder(d, "with_retention_lock", request.WithWithRetentionLock),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.BackupPolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating backup policy %v, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadBackupPolicy(ctx, d, meta)
+}
+
+func ReadBackupPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupPolicy, err := client.BackupPolicies.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query backup policy. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Backup policy id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+	backupPolicyDetails, err := client.BackupPolicies.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var expireAfterDays int
+	if backupPolicy.ExpireAfterDays != nil {
+		expireAfterDays = *backupPolicy.ExpireAfterDays
+	}
+
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("schedule", backupPolicy.Schedule),
+		d.Set("expire_after_days", expireAfterDays),
+		d.Set("with_retention_lock", backupPolicy.HasRetentionLock),
+		d.Set("comment", backupPolicy.Comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.BackupPolicyToSchema(backupPolicy)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.BackupPolicyDetailsToSchema(backupPolicyDetails)}),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateBackupPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.BackupPolicies.Alter(ctx, sdk.NewAlterBackupPolicyRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming backup policy from %v to %v, err = %w", id.FullyQualifiedName(), newId.FullyQualifiedName(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	setRequest := sdk.NewBackupPolicySetRequest()
+	unsetRequest := sdk.NewBackupPolicyUnsetRequest()
+
+	if errs := errors.Join(
+		stringAttributeUpdate(d, "schedule", &setRequest.Schedule, &unsetRequest.Schedule),
+		intAttributeUpdate(d, "expire_after_days", &setRequest.ExpireAfterDays, &unsetRequest.ExpireAfterDays),
+		stringAttributeUpdate(d, "comment", &setRequest.Comment, &unsetRequest.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if !reflect.DeepEqual(*setRequest, *sdk.NewBackupPolicySetRequest()) {
+		if err := client.BackupPolicies.Alter(ctx, sdk.NewAlterBackupPolicyRequest(id).WithSet(*setRequest)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting properties for backup policy %v, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if !reflect.DeepEqual(*unsetRequest, *sdk.NewBackupPolicyUnsetRequest()) {
+		if err := client.BackupPolicies.Alter(ctx, sdk.NewAlterBackupPolicyRequest(id).WithUnset(*unsetRequest)); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting properties for backup policy %v, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	return ReadBackupPolicy(ctx, d, meta)
+}
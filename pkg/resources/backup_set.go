@@ -0,0 +1,280 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var backupSetSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the backup set; must be unique for the database and schema in which the backup set is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the backup set."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the backup set."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"object_type": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: sdkValidation(sdk.ToBackupSetObjectKind),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToBackupSetObjectKind),
+		Description:      joinWithSpace("Specifies the type of the object for which the backups are created.", enumValuesDescription(sdk.AllBackupSetObjectKinds)),
+	},
+	"object_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateFunc:     validation.StringIsNotEmpty,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      "Specifies the fully qualified name of the object for which the backups are created. For tables and dynamic tables, the identifier has the form `database.schema.name`; for schemas - `database.schema`; for databases - `database`.",
+	},
+	"backup_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Specifies the fully qualified name of the backup policy applied to the backup set. Once a policy is applied it can only be replaced by another policy; removing it from the configuration recreates the backup set.", resources.BackupPolicy),
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the backup set.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW BACKUP SETS` for the given backup set.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowBackupSetSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func BackupSet() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.BackupSets.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: TrackingCreateWrapper(resources.BackupSet, CreateBackupSet),
+		ReadContext:   TrackingReadWrapper(resources.BackupSet, ReadBackupSet),
+		UpdateContext: TrackingUpdateWrapper(resources.BackupSet, UpdateBackupSet),
+		DeleteContext: TrackingDeleteWrapper(resources.BackupSet, deleteFunc),
+		Description:   "Resource used to manage backup set objects. For more information, check [backup set documentation](https://docs.snowflake.com/en/sql-reference/sql/create-backup-set).",
+
+		Schema: backupSetSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.BackupSet, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.BackupSet, customdiff.All(
+			ForceNewIfChangeToEmptyString("backup_policy"),
+			ComputedIfAnyAttributeChanged(backupSetSchema, ShowOutputAttributeName, "backup_policy", "comment"),
+		)),
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateBackupSet(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	forObject, err := backupSetForRequest(d.Get("object_type").(string), d.Get("object_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewCreateBackupSetRequest(id, *forObject)
+
+	if errs := errors.Join(
+		schemaObjectIdentifierAttributeCreate(d, "backup_policy", &request.BackupPolicy),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.BackupSets.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating backup set %v, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadBackupSet(ctx, d, meta)
+}
+
+func backupSetForRequest(objectType string, objectName string) (*sdk.BackupSetForRequest, error) {
+	kind, err := sdk.ToBackupSetObjectKind(objectType)
+	if err != nil {
+		return nil, err
+	}
+	request := sdk.NewBackupSetForRequest()
+	switch kind {
+	case sdk.BackupSetObjectKindTable, sdk.BackupSetObjectKindDynamicTable:
+		objectId, err := sdk.ParseSchemaObjectIdentifier(objectName)
+		if err != nil {
+			return nil, err
+		}
+		if kind == sdk.BackupSetObjectKindTable {
+			return request.WithTable(objectId), nil
+		}
+		return request.WithDynamicTable(objectId), nil
+	case sdk.BackupSetObjectKindSchema:
+		objectId, err := sdk.ParseDatabaseObjectIdentifier(objectName)
+		if err != nil {
+			return nil, err
+		}
+		return request.WithSchema(objectId), nil
+	case sdk.BackupSetObjectKindDatabase:
+		objectId, err := sdk.ParseAccountObjectIdentifier(objectName)
+		if err != nil {
+			return nil, err
+		}
+		return request.WithDatabase(objectId), nil
+	default:
+		return nil, fmt.Errorf("unsupported backup set object type: %s", kind)
+	}
+}
+
+// backupSetObjectFullyQualifiedName rebuilds the identifier of the backed up object from the SHOW BACKUP SETS output.
+func backupSetObjectFullyQualifiedName(backupSet *sdk.BackupSet) (string, error) {
+	kind, err := sdk.ToBackupSetObjectKind(backupSet.ObjectKind)
+	if err != nil {
+		return "", err
+	}
+	switch kind {
+	case sdk.BackupSetObjectKindTable, sdk.BackupSetObjectKindDynamicTable:
+		if backupSet.ObjectDatabaseName == nil || backupSet.ObjectSchemaName == nil {
+			return "", fmt.Errorf("missing database or schema name of the object backed up by backup set %s", backupSet.ID().FullyQualifiedName())
+		}
+		return sdk.NewSchemaObjectIdentifier(*backupSet.ObjectDatabaseName, *backupSet.ObjectSchemaName, backupSet.ObjectName).FullyQualifiedName(), nil
+	case sdk.BackupSetObjectKindSchema:
+		if backupSet.ObjectDatabaseName == nil {
+			return "", fmt.Errorf("missing database name of the object backed up by backup set %s", backupSet.ID().FullyQualifiedName())
+		}
+		return sdk.NewDatabaseObjectIdentifier(*backupSet.ObjectDatabaseName, backupSet.ObjectName).FullyQualifiedName(), nil
+	default:
+		return sdk.NewAccountObjectIdentifier(backupSet.ObjectName).FullyQualifiedName(), nil
+	}
+}
+
+func ReadBackupSet(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backupSet, err := client.BackupSets.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query backup set. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Backup set id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	objectName, err := backupSetObjectFullyQualifiedName(backupSet)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The backup policy is returned only as a name, so the fully qualified identifier from the configuration is kept
+	// unless the policy is no longer applied.
+	if backupSet.BackupPolicyName == nil {
+		if err := d.Set("backup_policy", ""); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("object_type", backupSet.ObjectKind),
+		d.Set("object_name", objectName),
+		d.Set("comment", backupSet.Comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.BackupSetToSchema(backupSet)}),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateBackupSet(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("backup_policy") {
+		if v := d.Get("backup_policy").(string); v != "" {
+			backupPolicyId, err := sdk.ParseSchemaObjectIdentifier(v)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err := client.BackupSets.Alter(ctx, sdk.NewAlterBackupSetRequest(id).WithApplyBackupPolicy(*sdk.NewBackupSetApplyBackupPolicyRequest(backupPolicyId))); err != nil {
+				return diag.FromErr(fmt.Errorf("error applying backup policy %v to backup set %v, err = %w", backupPolicyId.FullyQualifiedName(), id.FullyQualifiedName(), err))
+			}
+		}
+	}
+
+	setRequest := sdk.NewBackupSetSetRequest()
+	unsetRequest := sdk.NewBackupSetUnsetRequest()
+
+	if errs := errors.Join(
+		stringAttributeUpdate(d, "comment", &setRequest.Comment, &unsetRequest.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if !reflect.DeepEqual(*setRequest, *sdk.NewBackupSetSetRequest()) {
+		if err := client.BackupSets.Alter(ctx, sdk.NewAlterBackupSetRequest(id).WithSet(*setRequest)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting properties for backup set %v, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if !reflect.DeepEqual(*unsetRequest, *sdk.NewBackupSetUnsetRequest()) {
+		if err := client.BackupSets.Alter(ctx, sdk.NewAlterBackupSetRequest(id).WithUnset(*unsetRequest)); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting properties for backup set %v, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	return ReadBackupSet(ctx, d, meta)
+}
@@ -0,0 +1,317 @@
+package resources
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var budgetSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the budget; must be unique for the database and schema in which the budget is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the budget."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the budget."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"spending_limit": {
+		Type:         schema.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntAtLeast(0),
+		Description:  "Specifies the spending limit (in credits) for the budget's monthly interval.",
+	},
+	"notification_integration": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		RequiredWith:     []string{"email_recipients"},
+		Description:      relatedResourceDescription("Specifies the name of the email notification integration used to send the budget notifications.", resources.EmailNotificationIntegration),
+	},
+	"email_recipients": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Specifies the email addresses that receive the budget notifications. The email addresses must be verified. Removing all the recipients recreates the budget, as the email notifications can not be unset.",
+	},
+	"cycle_start_action": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Specifies the stored procedure called at the start of every budget cycle (e.g. to resume the suspended warehouses).",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"procedure": {
+					Type:     schema.TypeString,
+					Required: true,
+					DiffSuppressFunc: NormalizeAndCompareUsingFunc(sdk.ParseSchemaObjectIdentifierWithArguments, func(a, b sdk.SchemaObjectIdentifierWithArguments) bool {
+						return a.FullyQualifiedName() == b.FullyQualifiedName()
+					}),
+					Description: "Fully qualified name of the stored procedure with its argument types, e.g. `\"<database>\".\"<schema>\".\"<procedure>\"(VARCHAR)`.",
+				},
+				"arguments": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "List of the values passed as the procedure arguments. Every value is passed to the procedure as a string (e.g. `text` is passed as `'text'`), and it is never evaluated as a SQL expression.",
+				},
+			},
+		},
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies a comment for the budget.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW SNOWFLAKE.CORE.BUDGET INSTANCES` for the given budget.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowBudgetSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func Budget() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.Budgets.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.BudgetResource), TrackingCreateWrapper(resources.Budget, CreateBudget)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.BudgetResource), TrackingReadWrapper(resources.Budget, ReadBudget)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.BudgetResource), TrackingUpdateWrapper(resources.Budget, UpdateBudget)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.BudgetResource), TrackingDeleteWrapper(resources.Budget, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage custom budget objects. For more information, check [budget documentation](https://docs.snowflake.com/en/user-guide/budgets).",
+			relatedResourceDescription("Use the companion resource to add the objects tracked by the budget.", resources.BudgetTrackedObject),
+		),
+
+		Schema: budgetSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.Budget, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.Budget, customdiff.All(
+			ForceNewIfChangeToEmptySet("email_recipients"),
+		)),
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateBudget(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	request := sdk.NewCreateBudgetRequest(id)
+	if errs := errors.Join(
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.Budgets.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating budget %v, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	if err := setBudgetSpendingLimit(ctx, client, id, d); err != nil {
+		return diag.FromErr(err)
+	}
+	if len(d.Get("email_recipients").(*schema.Set).List()) > 0 {
+		if err := setBudgetEmailNotifications(ctx, client, id, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if len(d.Get("cycle_start_action").([]any)) > 0 {
+		if err := setBudgetCycleStartAction(ctx, client, id, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadBudget(ctx, d, meta)
+}
+
+func ReadBudget(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	budget, err := client.Budgets.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query budget. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Budget id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	spendingLimit, err := client.Budgets.GetSpendingLimit(ctx, sdk.NewGetSpendingLimitBudgetRequest(id))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cycleStartAction, err := readBudgetCycleStartAction(ctx, client, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	emailRecipients, err := client.Budgets.GetEmailNotificationRecipients(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	notificationIntegration := ""
+	if integrationId, err := client.Budgets.GetEmailNotificationIntegration(ctx, id); err != nil {
+		return diag.FromErr(err)
+	} else if integrationId != nil {
+		notificationIntegration = integrationId.FullyQualifiedName()
+	}
+
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("spending_limit", *spendingLimit),
+		d.Set("email_recipients", emailRecipients),
+		d.Set("notification_integration", notificationIntegration),
+		d.Set("cycle_start_action", cycleStartAction),
+		d.Set("comment", budget.Comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.BudgetToSchema(budget)}),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateBudget(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("spending_limit") {
+		if err := setBudgetSpendingLimit(ctx, client, id, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Removing all the recipients is handled by the ForceNew in CustomizeDiff.
+	if d.HasChanges("notification_integration", "email_recipients") {
+		if err := setBudgetEmailNotifications(ctx, client, id, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("cycle_start_action") {
+		if len(d.Get("cycle_start_action").([]any)) > 0 {
+			if err := setBudgetCycleStartAction(ctx, client, id, d); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if _, err := client.Budgets.RemoveCycleStartAction(ctx, sdk.NewRemoveCycleStartActionBudgetRequest(id)); err != nil {
+				return diag.FromErr(fmt.Errorf("error removing cycle start action for budget %v, err = %w", id.FullyQualifiedName(), err))
+			}
+		}
+	}
+
+	return ReadBudget(ctx, d, meta)
+}
+
+func setBudgetSpendingLimit(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData) error {
+	request := sdk.NewSetSpendingLimitBudgetRequest(id, *sdk.NewBudgetSetSpendingLimitArgsRequest(d.Get("spending_limit").(int)))
+	if _, err := client.Budgets.SetSpendingLimit(ctx, request); err != nil {
+		return fmt.Errorf("error setting spending limit for budget %v, err = %w", id.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
+func setBudgetEmailNotifications(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData) error {
+	// SET_EMAIL_NOTIFICATIONS accepts the recipients as a single comma-separated list.
+	emails := expandStringList(d.Get("email_recipients").(*schema.Set).List())
+	args := sdk.NewBudgetSetEmailNotificationsArgsRequestFromEmails(strings.Join(emails, ", "))
+	if v := d.Get("notification_integration").(string); v != "" {
+		integrationId, err := sdk.ParseAccountObjectIdentifier(v)
+		if err != nil {
+			return err
+		}
+		args.WithNotificationIntegration(integrationId)
+	}
+	if _, err := client.Budgets.SetEmailNotifications(ctx, sdk.NewSetEmailNotificationsBudgetRequest(id, *args)); err != nil {
+		return fmt.Errorf("error setting email notifications for budget %v, err = %w", id.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
+func setBudgetCycleStartAction(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier, d *schema.ResourceData) error {
+	action := d.Get("cycle_start_action").([]any)[0].(map[string]any)
+	procedureId, err := sdk.ParseSchemaObjectIdentifierWithArguments(action["procedure"].(string))
+	if err != nil {
+		return err
+	}
+	arguments := sdk.NewBudgetSetCycleStartActionArgsRequestFromValues(procedureId, expandStringList(action["arguments"].([]any))...)
+	request := sdk.NewSetCycleStartActionBudgetRequest(id, *arguments)
+	if _, err := client.Budgets.SetCycleStartAction(ctx, request); err != nil {
+		return fmt.Errorf("error setting cycle start action for budget %v, err = %w", id.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
+func readBudgetCycleStartAction(ctx context.Context, client *sdk.Client, id sdk.SchemaObjectIdentifier) ([]map[string]any, error) {
+	action, err := client.Budgets.GetCycleStartAction(ctx, sdk.NewGetCycleStartActionBudgetRequest(id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return []map[string]any{
+		{
+			"procedure": action.ProcedureId.FullyQualifiedName(),
+			"arguments": action.ArgumentValues(),
+		},
+	}, nil
+}
@@ -0,0 +1,212 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var budgetTrackedObjectSchema = map[string]*schema.Schema{
+	"budget_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      relatedResourceDescription(blocklistedPipesFieldDescription("Fully qualified name of the budget tracking the object."), resources.Budget),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+	"object_type": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Specifies the type of the object tracked by the budget. " + enumValuesDescription(sdk.BudgetTrackedObjectTypes),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToBudgetTrackedObjectType),
+		ValidateFunc: validation.StringInSlice(collections.Map(sdk.BudgetTrackedObjectTypes, func(v sdk.ObjectType) string {
+			return string(v)
+		}), true),
+	},
+	"object_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedPipesFieldDescription("Fully qualified name of the object tracked by the budget. The number of the identifier parts has to match the `object_type` (e.g. `\"<database>\"` for a database, `\"<database>\".\"<schema>\".\"<table>\"` for a table)."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+}
+
+func BudgetTrackedObject() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Resource used to add an object to the budget (the budget tracks the credit usage of the object). For more information, check [budget documentation](https://docs.snowflake.com/en/user-guide/budgets/custom-budget).",
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.BudgetTrackedObjectResource), TrackingCreateWrapper(resources.BudgetTrackedObject, CreateBudgetTrackedObject)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.BudgetTrackedObjectResource), TrackingReadWrapper(resources.BudgetTrackedObject, ReadBudgetTrackedObject)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.BudgetTrackedObjectResource), TrackingDeleteWrapper(resources.BudgetTrackedObject, DeleteBudgetTrackedObject)),
+
+		Schema: budgetTrackedObjectSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.BudgetTrackedObject, ImportBudgetTrackedObject),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportBudgetTrackedObject(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	budgetId, objectType, objectId, err := parseBudgetTrackedObjectId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := errors.Join(
+		d.Set("budget_name", budgetId.FullyQualifiedName()),
+		d.Set("object_type", objectType.String()),
+		d.Set("object_name", objectId.FullyQualifiedName()),
+	); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateBudgetTrackedObject(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	budgetId, err := sdk.ParseSchemaObjectIdentifier(d.Get("budget_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	objectType, err := sdk.ToBudgetTrackedObjectType(d.Get("object_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	objectId, err := parseBudgetTrackedObjectIdentifier(objectType, d.Get("object_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewAddResourceBudgetRequest(budgetId, *sdk.NewBudgetAddResourceArgsRequest(*sdk.NewBudgetResourceReferenceRequestFromIdentifier(objectType, objectId)))
+	if _, err := client.Budgets.AddResource(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error adding %s %s to budget %s, err = %w", objectType, objectId.FullyQualifiedName(), budgetId.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(budgetId.FullyQualifiedName(), objectType.String(), objectId.FullyQualifiedName()))
+
+	return ReadBudgetTrackedObject(ctx, d, meta)
+}
+
+func ReadBudgetTrackedObject(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	budgetId, objectType, objectId, err := parseBudgetTrackedObjectId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	linkedResources, err := client.Budgets.GetLinkedResources(ctx, sdk.NewGetLinkedResourcesBudgetRequest(budgetId))
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to get budget linked resources. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Budget id: %s, Err: %s", budgetId.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	found := false
+	for _, linkedResource := range linkedResources {
+		linkedType, err := linkedResource.ObjectType()
+		if err != nil {
+			// the budget may track the object types not handled by this resource
+			continue
+		}
+		linkedId, err := linkedResource.ObjectId()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if linkedType == objectType && linkedId.FullyQualifiedName() == objectId.FullyQualifiedName() {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		d.SetId("")
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to find the object in the budget linked resources. Marking the resource as removed.",
+				Detail:   fmt.Sprintf("Budget id: %s, object: %s %s", budgetId.FullyQualifiedName(), objectType, objectId.FullyQualifiedName()),
+			},
+		}
+	}
+
+	errs := errors.Join(
+		d.Set("budget_name", budgetId.FullyQualifiedName()),
+		d.Set("object_type", objectType.String()),
+		d.Set("object_name", objectId.FullyQualifiedName()),
+	)
+	return diag.FromErr(errs)
+}
+
+func DeleteBudgetTrackedObject(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	budgetId, objectType, objectId, err := parseBudgetTrackedObjectId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewRemoveResourceBudgetRequest(budgetId, *sdk.NewBudgetRemoveResourceArgsRequest(*sdk.NewBudgetResourceReferenceRequestFromIdentifier(objectType, objectId)))
+	if _, err := client.Budgets.RemoveResource(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error removing %s %s from budget %s, err = %w", objectType, objectId.FullyQualifiedName(), budgetId.FullyQualifiedName(), err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func parseBudgetTrackedObjectId(id string) (sdk.SchemaObjectIdentifier, sdk.ObjectType, sdk.ObjectIdentifier, error) {
+	parts := helpers.ParseResourceIdentifier(id)
+	if len(parts) != 3 {
+		return sdk.SchemaObjectIdentifier{}, "", nil, fmt.Errorf("required id format '<budget_fqn>|<object_type>|<object_fqn>', but got: '%s'", id)
+	}
+
+	budgetId, err := sdk.ParseSchemaObjectIdentifier(parts[0])
+	if err != nil {
+		return sdk.SchemaObjectIdentifier{}, "", nil, err
+	}
+	objectType, err := sdk.ToBudgetTrackedObjectType(parts[1])
+	if err != nil {
+		return sdk.SchemaObjectIdentifier{}, "", nil, err
+	}
+	objectId, err := parseBudgetTrackedObjectIdentifier(objectType, parts[2])
+	if err != nil {
+		return sdk.SchemaObjectIdentifier{}, "", nil, err
+	}
+	return budgetId, objectType, objectId, nil
+}
+
+func parseBudgetTrackedObjectIdentifier(objectType sdk.ObjectType, fullyQualifiedName string) (sdk.ObjectIdentifier, error) {
+	switch objectType {
+	case sdk.ObjectTypeComputePool, sdk.ObjectTypeDatabase, sdk.ObjectTypeWarehouse:
+		return sdk.ParseAccountObjectIdentifier(fullyQualifiedName)
+	case sdk.ObjectTypeSchema:
+		return sdk.ParseDatabaseObjectIdentifier(fullyQualifiedName)
+	default:
+		return sdk.ParseSchemaObjectIdentifier(fullyQualifiedName)
+	}
+}
@@ -57,7 +57,7 @@ var catalogIntegrationAwsGlueSchema = func() map[string]*schema.Schema {
 }()
 
 func CatalogIntegrationAwsGlue() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.CatalogIntegrationAwsGlue, CreateCatalogIntegrationAwsGlue),
 		ReadContext:   TrackingReadWrapper(resources.CatalogIntegrationAwsGlue, ReadCatalogIntegrationAwsGlueFunc(true)),
 		UpdateContext: TrackingUpdateWrapper(resources.CatalogIntegrationAwsGlue, UpdateCatalogIntegrationAwsGlue),
@@ -74,7 +74,7 @@ func CatalogIntegrationAwsGlue() *schema.Resource {
 			ComputedIfAnyAttributeChanged(catalogIntegrationAwsGlueSchema, DescribeOutputAttributeName, "enabled", "refresh_interval_seconds", "comment"),
 			RecreateWhenCatalogSourceChangedExternally(sdk.CatalogIntegrationCatalogSourceTypeGlue),
 		),
-	}
+	})
 }
 
 func ImportCatalogIntegrationAwsGlue(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -177,7 +177,7 @@ var catalogIntegrationIcebergRestSchema = func() map[string]*schema.Schema {
 }()
 
 func CatalogIntegrationIcebergRest() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.CatalogIntegrationIcebergRest, CreateCatalogIntegrationIcebergRest),
 		ReadContext:   TrackingReadWrapper(resources.CatalogIntegrationIcebergRest, ReadCatalogIntegrationIcebergRestFunc(true)),
 		UpdateContext: TrackingUpdateWrapper(resources.CatalogIntegrationIcebergRest, UpdateCatalogIntegrationIcebergRest),
@@ -194,7 +194,7 @@ func CatalogIntegrationIcebergRest() *schema.Resource {
 			ComputedIfAnyAttributeChanged(catalogIntegrationIcebergRestSchema, DescribeOutputAttributeName, "enabled", "refresh_interval_seconds", "comment"),
 			RecreateWhenCatalogSourceChangedExternally(sdk.CatalogIntegrationCatalogSourceTypeIcebergRest),
 		),
-	}
+	})
 }
 
 func ImportCatalogIntegrationIcebergRest(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -33,7 +33,7 @@ var catalogIntegrationObjectStorageSchema = func() map[string]*schema.Schema {
 }()
 
 func CatalogIntegrationObjectStorage() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.CatalogIntegrationObjectStorage, CreateCatalogIntegrationObjectStorage),
 		ReadContext:   TrackingReadWrapper(resources.CatalogIntegrationObjectStorage, ReadCatalogIntegrationObjectStorageFunc(true)),
 		UpdateContext: TrackingUpdateWrapper(resources.CatalogIntegrationObjectStorage, UpdateCatalogIntegrationObjectStorage),
@@ -50,7 +50,7 @@ func CatalogIntegrationObjectStorage() *schema.Resource {
 			ComputedIfAnyAttributeChanged(catalogIntegrationObjectStorageSchema, DescribeOutputAttributeName, "enabled", "refresh_interval_seconds", "comment"),
 			RecreateWhenCatalogSourceChangedExternally(sdk.CatalogIntegrationCatalogSourceTypeObjectStore),
 		),
-	}
+	})
 }
 
 func ImportCatalogIntegrationObjectStorage(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
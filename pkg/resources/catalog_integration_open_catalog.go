@@ -116,7 +116,7 @@ var catalogIntegrationOpenCatalogSchema = func() map[string]*schema.Schema {
 }()
 
 func CatalogIntegrationOpenCatalog() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.CatalogIntegrationOpenCatalog, CreateCatalogIntegrationOpenCatalog),
 		ReadContext:   TrackingReadWrapper(resources.CatalogIntegrationOpenCatalog, ReadCatalogIntegrationOpenCatalogFunc(true)),
 		UpdateContext: TrackingUpdateWrapper(resources.CatalogIntegrationOpenCatalog, UpdateCatalogIntegrationOpenCatalog),
@@ -133,7 +133,7 @@ func CatalogIntegrationOpenCatalog() *schema.Resource {
 			ComputedIfAnyAttributeChanged(catalogIntegrationOpenCatalogSchema, DescribeOutputAttributeName, "enabled", "refresh_interval_seconds", "comment"),
 			RecreateWhenCatalogSourceChangedExternally(sdk.CatalogIntegrationCatalogSourceTypePolaris),
 		),
-	}
+	})
 }
 
 func ImportCatalogIntegrationOpenCatalog(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
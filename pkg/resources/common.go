@@ -149,6 +149,7 @@ func ImportName[T sdk.AccountObjectIdentifier | sdk.DatabaseObjectIdentifier | s
 func TrackingImportWrapper(resourceName resources.Resource, importImplementation schema.StateContextFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = tracking.NewContext(ctx, tracking.NewVersionedResourceMetadata(resourceName, tracking.ImportOperation))
+		ctx = tracking.NewResourceIdContext(ctx, d.Id)
 		return importImplementation(ctx, d, meta)
 	}
 }
@@ -156,6 +157,7 @@ func TrackingImportWrapper(resourceName resources.Resource, importImplementation
 func TrackingCreateWrapper(resourceName resources.Resource, createImplementation schema.CreateContextFunc) schema.CreateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		ctx = tracking.NewContext(ctx, tracking.NewVersionedResourceMetadata(resourceName, tracking.CreateOperation))
+		ctx = tracking.NewResourceIdContext(ctx, d.Id)
 		return createImplementation(ctx, d, meta)
 	}
 }
@@ -163,6 +165,7 @@ func TrackingCreateWrapper(resourceName resources.Resource, createImplementation
 func TrackingReadWrapper(resourceName resources.Resource, readImplementation schema.ReadContextFunc) schema.ReadContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		ctx = tracking.NewContext(ctx, tracking.NewVersionedResourceMetadata(resourceName, tracking.ReadOperation))
+		ctx = tracking.NewResourceIdContext(ctx, d.Id)
 		return readImplementation(ctx, d, meta)
 	}
 }
@@ -170,6 +173,7 @@ func TrackingReadWrapper(resourceName resources.Resource, readImplementation sch
 func TrackingUpdateWrapper(resourceName resources.Resource, updateImplementation schema.UpdateContextFunc) schema.UpdateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		ctx = tracking.NewContext(ctx, tracking.NewVersionedResourceMetadata(resourceName, tracking.UpdateOperation))
+		ctx = tracking.NewResourceIdContext(ctx, d.Id)
 		return updateImplementation(ctx, d, meta)
 	}
 }
@@ -177,6 +181,7 @@ func TrackingUpdateWrapper(resourceName resources.Resource, updateImplementation
 func TrackingDeleteWrapper(resourceName resources.Resource, deleteImplementation schema.DeleteContextFunc) schema.DeleteContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		ctx = tracking.NewContext(ctx, tracking.NewVersionedResourceMetadata(resourceName, tracking.DeleteOperation))
+		ctx = tracking.NewResourceIdContext(ctx, d.Id)
 		return deleteImplementation(ctx, d, meta)
 	}
 }
@@ -184,6 +189,7 @@ func TrackingDeleteWrapper(resourceName resources.Resource, deleteImplementation
 func TrackingCustomDiffWrapper(resourceName resources.Resource, customdiffImplementation schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
 	return func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
 		ctx = tracking.NewContext(ctx, tracking.NewVersionedResourceMetadata(resourceName, tracking.CustomDiffOperation))
+		ctx = tracking.NewResourceIdContext(ctx, diff.Id)
 		return customdiffImplementation(ctx, diff, meta)
 	}
 }
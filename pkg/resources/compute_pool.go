@@ -122,7 +122,7 @@ func ComputePool() *schema.Resource {
 			}
 		},
 	)
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeComputePool, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.ComputePool, CreateComputePool),
 		ReadContext:   TrackingReadWrapper(resources.ComputePool, ReadComputePoolFunc(true)),
 		UpdateContext: TrackingUpdateWrapper(resources.ComputePool, UpdateComputePool),
@@ -146,7 +146,7 @@ func ComputePool() *schema.Resource {
 		},
 
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportComputePool(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
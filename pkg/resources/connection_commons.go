@@ -44,3 +44,21 @@ func DeleteConnection(ctx context.Context, d *schema.ResourceData, meta any) dia
 
 	return nil
 }
+
+// promoteConnectionIfPrimaryInCurrentAccount promotes the connection to primary when primary_account points to the current account and the connection is not primary yet.
+func promoteConnectionIfPrimaryInCurrentAccount(ctx context.Context, d *schema.ResourceData, client *sdk.Client, id sdk.AccountObjectIdentifier) error {
+	primaryAccount, ok := d.GetOk("primary_account")
+	if !ok {
+		return nil
+	}
+
+	connection, err := client.Connections.ShowByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if connection.IsPrimary || !isCurrentAccount(primaryAccount.(string), connection.OrganizationName, connection.AccountName) {
+		return nil
+	}
+
+	return client.Connections.Alter(ctx, sdk.NewAlterConnectionRequest(id).WithPrimary(true))
+}
@@ -0,0 +1,235 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var contactCommunicationMethods = []string{"users", "email_distribution_list", "url"}
+
+var contactSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the contact; must be unique for the database and schema in which the contact is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the contact."),
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the contact."),
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"users": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		Optional:     true,
+		ExactlyOneOf: contactCommunicationMethods,
+		Description:  "Names of the Snowflake users that should be reached through the contact.",
+	},
+	"email_distribution_list": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ExactlyOneOf: contactCommunicationMethods,
+		ValidateFunc: validation.StringIsNotEmpty,
+		Description:  "Email address of the distribution list that should be reached through the contact.",
+	},
+	"url": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ExactlyOneOf: contactCommunicationMethods,
+		ValidateFunc: validation.StringIsNotEmpty,
+		Description:  "URL (e.g. of a support portal or a ticketing system) that should be used to reach the contact.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the contact.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW CONTACTS` for the given contact.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowContactSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func Contact() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.Contacts.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ContactResource), TrackingCreateWrapper(resources.Contact, CreateContact)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ContactResource), TrackingReadWrapper(resources.Contact, ReadContact)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ContactResource), TrackingUpdateWrapper(resources.Contact, UpdateContact)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.ContactResource), TrackingDeleteWrapper(resources.Contact, deleteFunc)),
+		Description:   "Resource used to manage contact objects. Contacts can be associated with objects to point at their data stewards, support or access approvers (check `snowflake_object_contacts`). For more information, check [contacts documentation](https://docs.snowflake.com/en/sql-reference/sql/create-contact).",
+
+		Schema: contactSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.Contact, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.Contact, customdiff.All(
+			ComputedIfAnyAttributeChanged(contactSchema, ShowOutputAttributeName, "name", "users", "email_distribution_list", "url", "comment"),
+			ComputedIfAnyAttributeChanged(contactSchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateContact(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	request := sdk.NewCreateContactRequest(id)
+	if v, ok := d.GetOk("users"); ok {
+		request.WithUsers(expandContactUsers(v.(*schema.Set).List()))
+	}
+
+	if errs := errors.Join(
+		stringAttributeCreateBuilder(d, "email_distribution_list", request.WithEmailDistributionList),
+		stringAttributeCreateBuilder(d, "url", request.WithUrl),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.Contacts.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating contact %v, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadContact(ctx, d, meta)
+}
+
+func ReadContact(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	contact, err := client.Contacts.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query contact. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Contact id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	var emailDistributionList, url string
+	if contact.EmailDistributionList != nil {
+		emailDistributionList = *contact.EmailDistributionList
+	}
+	if contact.Url != nil {
+		url = *contact.Url
+	}
+
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("users", contact.Users),
+		d.Set("email_distribution_list", emailDistributionList),
+		d.Set("url", url),
+		d.Set("comment", contact.Comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.ContactToSchema(contact)}),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateContact(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.Contacts.Alter(ctx, sdk.NewAlterContactRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming contact from %v to %v, err = %w", id.FullyQualifiedName(), newId.FullyQualifiedName(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	// Exactly one communication method is configured, and setting it replaces the previous one in Snowflake.
+	if d.HasChanges(contactCommunicationMethods...) {
+		set := sdk.NewContactSetRequest()
+		switch {
+		case d.Get("users").(*schema.Set).Len() > 0:
+			set.WithUsers(expandContactUsers(d.Get("users").(*schema.Set).List()))
+		case d.Get("email_distribution_list").(string) != "":
+			set.WithEmailDistributionList(d.Get("email_distribution_list").(string))
+		default:
+			set.WithUrl(d.Get("url").(string))
+		}
+		if err := client.Contacts.Alter(ctx, sdk.NewAlterContactRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating communication method of contact %v, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			if err := client.Contacts.Alter(ctx, sdk.NewAlterContactRequest(id).WithSet(*sdk.NewContactSetRequest().WithComment(comment))); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting comment for contact %v, err = %w", id.FullyQualifiedName(), err))
+			}
+		} else {
+			if err := client.Contacts.Alter(ctx, sdk.NewAlterContactRequest(id).WithUnsetComment(true)); err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting comment for contact %v, err = %w", id.FullyQualifiedName(), err))
+			}
+		}
+	}
+
+	return ReadContact(ctx, d, meta)
+}
+
+func expandContactUsers(users []any) []sdk.ContactUser {
+	return collections.Map(users, func(user any) sdk.ContactUser {
+		return sdk.ContactUser{Name: user.(string)}
+	})
+}
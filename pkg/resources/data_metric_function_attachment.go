@@ -0,0 +1,420 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var dataMetricFunctionAttachmentSchema = map[string]*schema.Schema{
+	"object_type": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Specifies the type of the object the data metric function is attached to. " + enumValuesDescription(sdk.AllDataMetricFunctionAttachableObjectTypes),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToDataMetricFunctionAttachableObjectType),
+		ValidateFunc: validation.StringInSlice(collections.Map(sdk.AllDataMetricFunctionAttachableObjectTypes, func(v sdk.ObjectType) string {
+			return string(v)
+		}), true),
+	},
+	"object_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedPipesFieldDescription("Fully qualified name of the table, view, dynamic table, or Iceberg table the data metric function is attached to."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+	"data_metric_function": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedPipesFieldDescription("Fully qualified name of the data metric function to attach. Both system (e.g. `SNOWFLAKE.CORE.NULL_COUNT`) and custom data metric functions are supported. This function identifier must be provided without arguments in parenthesis."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	},
+	"on": {
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Description: blocklistedPipesFieldDescription("The columns on which to associate the data metric function. The order and the data types of the columns must match the arguments of the data metric function definition."),
+	},
+	"schedule_status": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          string(sdk.DataMetricScheduleStatusStarted),
+		ValidateDiagFunc: sdkValidation(sdk.ToAllowedDataMetricScheduleStatusOption),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToAllowedDataMetricScheduleStatusOption),
+		Description:      fmt.Sprintf("The status of the metrics association. Valid values are: %v. Changes are applied with `MODIFY DATA METRIC FUNCTION`.", possibleValuesListed(sdk.AllAllowedDataMetricScheduleStatusOptions)),
+	},
+	"data_metric_schedule": {
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"minutes": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Description:      externalChangesNotDetectedFieldDescription(fmt.Sprintf("Specifies an interval (in minutes) of wait time inserted between runs of the data metric functions. Snowflake returns this schedule as a cron expression. Valid values are: %s.", possibleValuesListed(sdk.AllViewDataMetricScheduleMinutes))),
+					ValidateDiagFunc: IntInSlice(sdk.AllViewDataMetricScheduleMinutes),
+					ExactlyOneOf:     []string{"data_metric_schedule.0.minutes", "data_metric_schedule.0.using_cron", "data_metric_schedule.0.trigger_on_changes"},
+				},
+				"using_cron": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Specifies a cron expression and time zone for periodically running the data metric functions. Supports a subset of standard cron utility syntax.",
+					ExactlyOneOf: []string{"data_metric_schedule.0.minutes", "data_metric_schedule.0.using_cron", "data_metric_schedule.0.trigger_on_changes"},
+				},
+				"trigger_on_changes": {
+					Type:         schema.TypeBool,
+					Optional:     true,
+					Description:  "Specifies that the data metric functions run when a DML operation modifies the object.",
+					ExactlyOneOf: []string{"data_metric_schedule.0.minutes", "data_metric_schedule.0.using_cron", "data_metric_schedule.0.trigger_on_changes"},
+				},
+			},
+		},
+		Description: "Specifies the schedule to run the data metric functions periodically. The schedule (`DATA_METRIC_SCHEDULE`) is set on the object, so it is shared by all data metric functions attached to it. When attaching multiple data metric functions to the same object, make sure all of them use the same schedule.",
+	},
+}
+
+func DataMetricFunctionAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Resource used to attach a data metric function to the columns of a table, view, dynamic table, or Iceberg table, and to manage the object's data metric schedule. For more information, check [data quality documentation](https://docs.snowflake.com/en/user-guide/data-quality-working).",
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.DataMetricFunctionAttachmentResource), TrackingCreateWrapper(resources.DataMetricFunctionAttachment, CreateDataMetricFunctionAttachment)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.DataMetricFunctionAttachmentResource), TrackingReadWrapper(resources.DataMetricFunctionAttachment, ReadDataMetricFunctionAttachment)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.DataMetricFunctionAttachmentResource), TrackingUpdateWrapper(resources.DataMetricFunctionAttachment, UpdateDataMetricFunctionAttachment)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.DataMetricFunctionAttachmentResource), TrackingDeleteWrapper(resources.DataMetricFunctionAttachment, DeleteDataMetricFunctionAttachment)),
+
+		Schema: dataMetricFunctionAttachmentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.DataMetricFunctionAttachment, ImportDataMetricFunctionAttachment),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportDataMetricFunctionAttachment(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	objectType, objectId, functionId, columns, err := parseDataMetricFunctionAttachmentId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := errors.Join(
+		d.Set("object_type", objectType.String()),
+		d.Set("object_name", objectId.FullyQualifiedName()),
+		d.Set("data_metric_function", functionId.FullyQualifiedName()),
+		d.Set("on", columns),
+	); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateDataMetricFunctionAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	objectType, err := sdk.ToDataMetricFunctionAttachableObjectType(d.Get("object_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	objectId, err := sdk.ParseSchemaObjectIdentifier(d.Get("object_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	functionId, err := sdk.ParseSchemaObjectIdentifier(d.Get("data_metric_function").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	columns := expandStringList(d.Get("on").([]any))
+
+	// The schedule has to be set before a data metric function can be added to the object.
+	if err := setDataMetricFunctionAttachmentSchedule(ctx, client, d, objectType, objectId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	dataMetricFunction := sdk.ViewDataMetricFunction{
+		DataMetricFunction: functionId,
+		On:                 dataMetricFunctionAttachmentColumns(columns),
+	}
+	request := sdk.NewAlterOnObjectDataMetricFunctionReferenceRequest(objectType, objectId).
+		WithAdd(*sdk.NewDataMetricFunctionOnObjectAddRequest([]sdk.ViewDataMetricFunction{dataMetricFunction}))
+	if err := client.DataMetricFunctionReferences.AlterOnObject(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error adding data metric function %s to %s %s, err = %w", functionId.FullyQualifiedName(), objectType, objectId.FullyQualifiedName(), err))
+	}
+
+	d.SetId(encodeDataMetricFunctionAttachmentId(objectType, objectId, functionId, columns))
+
+	if status := d.Get("schedule_status").(string); status != "" {
+		expectedStatus, err := sdk.ToAllowedDataMetricScheduleStatusOption(status)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if expectedStatus == sdk.DataMetricScheduleStatusSuspended {
+			if err := modifyDataMetricFunctionAttachmentStatus(ctx, client, objectType, objectId, functionId, columns, expectedStatus); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return ReadDataMetricFunctionAttachment(ctx, d, meta)
+}
+
+func ReadDataMetricFunctionAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	objectType, objectId, functionId, columns, err := parseDataMetricFunctionAttachmentId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	domain, err := sdk.DataMetricFunctionRefEntityDomainForObjectType(objectType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	references, err := client.DataMetricFunctionReferences.GetForEntity(ctx, sdk.NewGetForEntityDataMetricFunctionReferenceRequestCustom(objectId, domain))
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to get data metric function references. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Object: %s %s, Err: %s", objectType, objectId.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	reference, found := findDataMetricFunctionReference(references, functionId, columns)
+	if !found {
+		d.SetId("")
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to find the data metric function in the object references. Marking the resource as removed.",
+				Detail:   fmt.Sprintf("Object: %s %s, data metric function: %s, columns: %v", objectType, objectId.FullyQualifiedName(), functionId.FullyQualifiedName(), columns),
+			},
+		}
+	}
+
+	scheduleStatus, err := dataMetricFunctionAttachmentScheduleStatus(reference.ScheduleStatus)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	errs := errors.Join(
+		d.Set("object_type", objectType.String()),
+		d.Set("object_name", objectId.FullyQualifiedName()),
+		d.Set("data_metric_function", functionId.FullyQualifiedName()),
+		d.Set("on", columns),
+		d.Set("schedule_status", string(scheduleStatus)),
+		handleDataMetricFunctionAttachmentSchedule(d, reference.Schedule),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateDataMetricFunctionAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	objectType, objectId, functionId, columns, err := parseDataMetricFunctionAttachmentId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("data_metric_schedule") {
+		if err := setDataMetricFunctionAttachmentSchedule(ctx, client, d, objectType, objectId); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("schedule_status") {
+		expectedStatus, err := sdk.ToAllowedDataMetricScheduleStatusOption(d.Get("schedule_status").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := modifyDataMetricFunctionAttachmentStatus(ctx, client, objectType, objectId, functionId, columns, expectedStatus); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return ReadDataMetricFunctionAttachment(ctx, d, meta)
+}
+
+func DeleteDataMetricFunctionAttachment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	objectType, objectId, functionId, columns, err := parseDataMetricFunctionAttachmentId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	domain, err := sdk.DataMetricFunctionRefEntityDomainForObjectType(objectType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	references, err := client.DataMetricFunctionReferences.GetForEntity(ctx, sdk.NewGetForEntityDataMetricFunctionReferenceRequestCustom(objectId, domain))
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			// the object was dropped together with its data metric functions
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	remainingReferences := len(references)
+	if _, found := findDataMetricFunctionReference(references, functionId, columns); found {
+		remainingReferences--
+		dataMetricFunction := sdk.ViewDataMetricFunction{
+			DataMetricFunction: functionId,
+			On:                 dataMetricFunctionAttachmentColumns(columns),
+		}
+		request := sdk.NewAlterOnObjectDataMetricFunctionReferenceRequest(objectType, objectId).
+			WithDrop(*sdk.NewDataMetricFunctionOnObjectDropRequest([]sdk.ViewDataMetricFunction{dataMetricFunction}))
+		if err := client.DataMetricFunctionReferences.AlterOnObject(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error dropping data metric function %s from %s %s, err = %w", functionId.FullyQualifiedName(), objectType, objectId.FullyQualifiedName(), err))
+		}
+	}
+
+	// The schedule is shared by all data metric functions on the object, so it is unset only after the last one is dropped.
+	if remainingReferences == 0 {
+		request := sdk.NewAlterOnObjectDataMetricFunctionReferenceRequest(objectType, objectId).WithUnsetSchedule(true)
+		if err := client.DataMetricFunctionReferences.AlterOnObject(ctx, request); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting data metric schedule on %s %s, err = %w", objectType, objectId.FullyQualifiedName(), err))
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func setDataMetricFunctionAttachmentSchedule(ctx context.Context, client *sdk.Client, d *schema.ResourceData, objectType sdk.ObjectType, objectId sdk.SchemaObjectIdentifier) error {
+	var schedule string
+	switch {
+	case d.Get("data_metric_schedule.0.minutes").(int) != 0:
+		schedule = fmt.Sprintf("%d MINUTE", d.Get("data_metric_schedule.0.minutes").(int))
+	case d.Get("data_metric_schedule.0.using_cron").(string) != "":
+		schedule = fmt.Sprintf("USING CRON %s", d.Get("data_metric_schedule.0.using_cron").(string))
+	case d.Get("data_metric_schedule.0.trigger_on_changes").(bool):
+		schedule = dataMetricScheduleTriggerOnChanges
+	default:
+		return fmt.Errorf("data metric schedule for %s %s is not specified", objectType, objectId.FullyQualifiedName())
+	}
+	request := sdk.NewAlterOnObjectDataMetricFunctionReferenceRequest(objectType, objectId).
+		WithSetSchedule(*sdk.NewDataMetricFunctionOnObjectSetScheduleRequest(schedule))
+	if err := client.DataMetricFunctionReferences.AlterOnObject(ctx, request); err != nil {
+		return fmt.Errorf("error setting data metric schedule on %s %s, err = %w", objectType, objectId.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
+func modifyDataMetricFunctionAttachmentStatus(ctx context.Context, client *sdk.Client, objectType sdk.ObjectType, objectId sdk.SchemaObjectIdentifier, functionId sdk.SchemaObjectIdentifier, columns []string, status sdk.DataMetricScheduleStatusOption) error {
+	var statusCmd sdk.ViewDataMetricScheduleStatusOperationOption
+	switch status {
+	case sdk.DataMetricScheduleStatusStarted:
+		statusCmd = sdk.ViewDataMetricScheduleStatusOperationOptionResume
+	case sdk.DataMetricScheduleStatusSuspended:
+		statusCmd = sdk.ViewDataMetricScheduleStatusOperationOptionSuspend
+	default:
+		return fmt.Errorf("unexpected data metric function schedule status: %v", status)
+	}
+	request := sdk.NewAlterOnObjectDataMetricFunctionReferenceRequest(objectType, objectId).
+		WithModify(*sdk.NewDataMetricFunctionOnObjectModifyRequest([]sdk.ViewModifyDataMetricFunction{{
+			DataMetricFunction: functionId,
+			On:                 dataMetricFunctionAttachmentColumns(columns),
+			ViewDataMetricScheduleStatusOperationOption: statusCmd,
+		}}))
+	if err := client.DataMetricFunctionReferences.AlterOnObject(ctx, request); err != nil {
+		return fmt.Errorf("error modifying data metric function %s status on %s %s, err = %w", functionId.FullyQualifiedName(), objectType, objectId.FullyQualifiedName(), err)
+	}
+	return nil
+}
+
+const dataMetricScheduleTriggerOnChanges = "TRIGGER_ON_CHANGES"
+
+// handleDataMetricFunctionAttachmentSchedule sets the schedule in the state. Schedules specified by a number of minutes
+// are returned from Snowflake as cron expressions (see SNOW-1640024), so the minutes from the config are kept as they are.
+func handleDataMetricFunctionAttachmentSchedule(d *schema.ResourceData, schedule string) error {
+	if d.Get("data_metric_schedule.0.minutes").(int) != 0 {
+		return nil
+	}
+	if strings.EqualFold(schedule, dataMetricScheduleTriggerOnChanges) {
+		return d.Set("data_metric_schedule", []map[string]any{{"trigger_on_changes": true}})
+	}
+	return d.Set("data_metric_schedule", []map[string]any{{"using_cron": strings.TrimPrefix(schedule, "USING CRON ")}})
+}
+
+func dataMetricFunctionAttachmentScheduleStatus(status string) (sdk.DataMetricScheduleStatusOption, error) {
+	parsedStatus, err := sdk.ToDataMetricScheduleStatusOption(status)
+	if err != nil {
+		return "", err
+	}
+	if slices.Contains(sdk.AllDataMetricScheduleStatusSuspendedOptions, parsedStatus) {
+		return sdk.DataMetricScheduleStatusSuspended, nil
+	}
+	return sdk.DataMetricScheduleStatusStarted, nil
+}
+
+func findDataMetricFunctionReference(references []sdk.DataMetricFunctionReference, functionId sdk.SchemaObjectIdentifier, columns []string) (sdk.DataMetricFunctionReference, bool) {
+	for _, reference := range references {
+		referenceFunctionId := sdk.NewSchemaObjectIdentifier(reference.MetricDatabaseName, reference.MetricSchemaName, reference.MetricName)
+		if referenceFunctionId.FullyQualifiedName() != functionId.FullyQualifiedName() {
+			continue
+		}
+		referenceColumns := collections.Map(reference.RefArguments, func(argument sdk.DataMetricFunctionRefArgument) string { return argument.Name })
+		if slices.Equal(referenceColumns, columns) {
+			return reference, true
+		}
+	}
+	return sdk.DataMetricFunctionReference{}, false
+}
+
+func dataMetricFunctionAttachmentColumns(columns []string) []sdk.Column {
+	return collections.Map(columns, func(column string) sdk.Column { return sdk.Column{Value: column} })
+}
+
+func encodeDataMetricFunctionAttachmentId(objectType sdk.ObjectType, objectId sdk.SchemaObjectIdentifier, functionId sdk.SchemaObjectIdentifier, columns []string) string {
+	parts := append([]string{objectType.String(), objectId.FullyQualifiedName(), functionId.FullyQualifiedName()}, columns...)
+	return helpers.EncodeResourceIdentifier(parts...)
+}
+
+func parseDataMetricFunctionAttachmentId(id string) (sdk.ObjectType, sdk.SchemaObjectIdentifier, sdk.SchemaObjectIdentifier, []string, error) {
+	parts := helpers.ParseResourceIdentifier(id)
+	if len(parts) < 4 {
+		return "", sdk.SchemaObjectIdentifier{}, sdk.SchemaObjectIdentifier{}, nil, fmt.Errorf("required id format '<object_type>|<object_fqn>|<data_metric_function_fqn>|<column>[|<column>...]', but got: '%s'", id)
+	}
+
+	objectType, err := sdk.ToDataMetricFunctionAttachableObjectType(parts[0])
+	if err != nil {
+		return "", sdk.SchemaObjectIdentifier{}, sdk.SchemaObjectIdentifier{}, nil, err
+	}
+	objectId, err := sdk.ParseSchemaObjectIdentifier(parts[1])
+	if err != nil {
+		return "", sdk.SchemaObjectIdentifier{}, sdk.SchemaObjectIdentifier{}, nil, err
+	}
+	functionId, err := sdk.ParseSchemaObjectIdentifier(parts[2])
+	if err != nil {
+		return "", sdk.SchemaObjectIdentifier{}, sdk.SchemaObjectIdentifier{}, nil, err
+	}
+	return objectType, objectId, functionId, parts[3:], nil
+}
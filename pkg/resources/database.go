@@ -88,6 +88,13 @@ var databaseSchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies a comment for the database.",
 	},
+	"event_table": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Specifies the fully qualified name of the event table that collects telemetry data (logs, traces, metrics) emitted by the objects in the database. When not set, the account-level event table is used.", providerresources.EventTable),
+	},
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -100,7 +107,7 @@ func Database() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeDatabase, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.Database, CreateDatabase),
 		UpdateContext: TrackingUpdateWrapper(resources.Database, UpdateDatabase),
 		ReadContext:   TrackingReadWrapper(resources.Database, ReadDatabase),
@@ -127,7 +134,7 @@ func Database() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -156,6 +163,17 @@ func CreateDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
 
+	// EVENT_TABLE is not accepted by CREATE DATABASE, so it is set in a separate ALTER.
+	if v, ok := d.GetOk("event_table"); ok {
+		eventTableId, err := sdk.ParseSchemaObjectIdentifier(v.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := client.Databases.Alter(ctx, sdk.NewAlterDatabaseRequest(id).WithSet(*sdk.NewDatabaseSetRequest().WithEventTable(eventTableId))); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting event table for database %v: %w", id.FullyQualifiedName(), err))
+		}
+	}
+
 	var diags diag.Diagnostics
 
 	if d.Get("drop_public_schema_on_creation").(bool) {
@@ -338,6 +356,18 @@ func UpdateDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.
 		}
 	}
 
+	if d.HasChange("event_table") {
+		if v, ok := d.GetOk("event_table"); ok {
+			eventTableId, err := sdk.ParseSchemaObjectIdentifier(v.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			databaseSetRequest.WithEventTable(eventTableId)
+		} else {
+			databaseUnsetRequest.WithEventTable(true)
+		}
+	}
+
 	if (*databaseSetRequest != sdk.DatabaseSetRequest{}) {
 		err := client.Databases.Alter(ctx, sdk.NewAlterDatabaseRequest(id).WithSet(*databaseSetRequest))
 		if err != nil {
@@ -462,5 +492,20 @@ func ReadDatabase(ctx context.Context, d *schema.ResourceData, meta any) diag.Di
 		return diags
 	}
 
+	if err := d.Set("event_table", databaseEventTableFromParameters(databaseParameters)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
+
+// databaseEventTableFromParameters returns the event table set directly on the database.
+// Values inherited from the account are ignored, as they are not managed by this resource.
+func databaseEventTableFromParameters(parameters []*sdk.Parameter) string {
+	for _, parameter := range parameters {
+		if parameter.Key == string(sdk.ObjectParameterEventTable) && parameter.Level == sdk.ParameterTypeDatabase {
+			return parameter.Value
+		}
+	}
+	return ""
+}
@@ -57,7 +57,7 @@ func DatabaseRole() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeDatabaseRole, sdk.ParseDatabaseObjectIdentifier, &schema.Resource{
 		SchemaVersion: 1,
 
 		CreateContext: TrackingCreateWrapper(resources.DatabaseRole, CreateDatabaseRole),
@@ -86,7 +86,7 @@ func DatabaseRole() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ReadDatabaseRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -30,6 +30,10 @@ func externalChangesNotDetectedFieldDescription(description string) string {
 	return fmt.Sprintf(`%s External changes for this field won't be detected. In case you want to apply external changes, you can re-create the resource manually using "terraform taint".`, description)
 }
 
+func notReadBackFieldDescription(description string) string {
+	return fmt.Sprintf(`%s The value is only set on create and update and it is not read back from Snowflake, so external changes for this field won't be detected.`, description)
+}
+
 func withPrivilegedRolesDescription(description, paramName string) string {
 	return fmt.Sprintf(`%s By default, this list includes the ACCOUNTADMIN, ORGADMIN and SECURITYADMIN roles. To remove these privileged roles from the list, use the ALTER ACCOUNT command to set the %s account parameter to FALSE.`, description, paramName)
 }
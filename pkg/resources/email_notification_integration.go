@@ -52,7 +52,7 @@ func EmailNotificationIntegration() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, helpers.DecodeSnowflakeIDErrLegacy[sdk.AccountObjectIdentifier], &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.EmailNotificationIntegrationResource), TrackingCreateWrapper(resources.EmailNotificationIntegration, CreateEmailNotificationIntegration)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.EmailNotificationIntegrationResource), TrackingReadWrapper(resources.EmailNotificationIntegration, ReadEmailNotificationIntegration)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.EmailNotificationIntegrationResource), TrackingUpdateWrapper(resources.EmailNotificationIntegration, UpdateEmailNotificationIntegration)),
@@ -63,7 +63,7 @@ func EmailNotificationIntegration() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func toAllowedRecipients(emails []string) []sdk.NotificationIntegrationAllowedRecipient {
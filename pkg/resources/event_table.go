@@ -0,0 +1,303 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/experimentalfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var eventTableSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the event table; must be unique for the database and schema in which the event table is created."),
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the event table."),
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the event table."),
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"cluster_by": {
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "A list of one or more event table columns/expressions to be used as clustering key(s) for the event table.",
+	},
+	"change_tracking": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          BooleanDefault,
+		ValidateDiagFunc: validateBooleanString,
+		Description:      booleanStringFieldDescription("Specifies whether to enable change tracking on the event table. The value is read from Snowflake only when this field is set."),
+	},
+	"default_ddl_collation": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies a default collation specification for the columns in the event table. Snowflake does not allow changing this property after creation and does not return its value, so it is not read from Snowflake.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the event table.",
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW EVENT TABLES` for the given event table.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowEventTableSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE EVENT TABLE` for the given event table.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowEventTableDetailsSchema,
+		},
+	},
+}
+
+func EventTable() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.EventTables.DropSafely
+		},
+	)
+
+	return WithObjectTags(sdk.ObjectTypeEventTable, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.EventTableResource), TrackingCreateWrapper(resources.EventTable, CreateEventTable)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.EventTableResource), TrackingReadWrapper(resources.EventTable, ReadEventTable)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.EventTableResource), TrackingUpdateWrapper(resources.EventTable, UpdateEventTable)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.EventTableResource), TrackingDeleteWrapper(resources.EventTable, deleteFunc)),
+		Description: joinWithSpace(
+			"Resource used to manage event tables. For more information, check [event tables documentation](https://docs.snowflake.com/en/developer-guide/logging-tracing/event-table-setting-up).",
+			"To collect the telemetry data of the account or a database in the event table, set the `EVENT_TABLE` parameter with the `snowflake_account_parameter` resource or the `event_table` field of the `snowflake_database` resource.",
+		),
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.EventTable, customdiff.All(
+			eventTableParametersCustomDiff,
+			TemporaryWorkaroundIdentifierForceNewIfHierarchyRenamesExperimentNotEnabled("database"),
+			TemporaryWorkaroundIdentifierForceNewIfHierarchyRenamesExperimentNotEnabled("schema"),
+			ComputedIfAnyAttributeChanged(eventTableSchema, ShowOutputAttributeName, "name", "database", "schema", "comment"),
+			ComputedIfAnyAttributeChanged(eventTableSchema, FullyQualifiedNameAttributeName, "name", "database", "schema"),
+		)),
+
+		Schema: collections.MergeMaps(eventTableSchema, eventTableParametersSchema),
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.EventTable, ImportEventTable),
+		},
+		Timeouts: defaultTimeouts,
+	})
+}
+
+func ImportEventTable(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if _, err := ImportName[sdk.SchemaObjectIdentifier](ctx, d, meta); err != nil {
+		return nil, err
+	}
+
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	table, err := client.TablesLegacy.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("change_tracking", booleanStringFromBool(table.ChangeTracking)); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateEventTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+
+	request := sdk.NewCreateEventTableRequest(id)
+	if v, ok := d.GetOk("cluster_by"); ok {
+		request.WithClusterBy(expandStringList(v.([]any)))
+	}
+	if errs := errors.Join(
+		booleanStringAttributeCreate(d, "change_tracking", &request.ChangeTracking),
+		stringAttributeCreateBuilder(d, "default_ddl_collation", request.WithDefaultDdlCollation),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+	if diags := handleEventTableParametersCreate(d, request); diags.HasError() {
+		return diags
+	}
+
+	if err := client.EventTables.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating event table %v: %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	return ReadEventTable(ctx, d, meta)
+}
+
+func ReadEventTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	eventTable, err := client.EventTables.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query event table. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Event table id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	// SHOW EVENT TABLES does not return the clustering key and change tracking, but SHOW TABLES lists event tables too.
+	table, err := client.TablesLegacy.ShowByID(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if v := d.Get("change_tracking").(string); v != BooleanDefault {
+		if err := d.Set("change_tracking", booleanStringFromBool(table.ChangeTracking)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	details, err := client.EventTables.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parameters, err := client.EventTables.ShowParameters(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if diags := handleEventTableParameterRead(d, parameters); diags.HasError() {
+		return diags
+	}
+
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("cluster_by", table.GetClusterByKeys()),
+		d.Set("comment", eventTable.Comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.EventTableToSchema(eventTable)}),
+		d.Set(DescribeOutputAttributeName, collections.Map(details, func(detail sdk.EventTableDetails) map[string]any {
+			return schemas.EventTableDetailsToSchema(&detail)
+		})),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateEventTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	providerCtx := meta.(*provider.Context)
+	client := providerCtx.Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if experimentalfeatures.IsExperimentEnabled(experimentalfeatures.HierarchyRenames, providerCtx.EnabledExperiments) && (d.HasChange("database") || d.HasChange("schema")) {
+		eventTableRenameFn := func(currentId, targetId sdk.SchemaObjectIdentifier) func() error {
+			return func() error {
+				return client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(currentId).WithRenameTo(targetId))
+			}
+		}
+
+		if diags := handleThreeLevelHierarchyRename(
+			ctx, d, client, &id,
+			eventTableRenameFn,
+			client.EventTables.ShowByID,
+			func(id sdk.SchemaObjectIdentifier) string { return helpers.EncodeResourceIdentifier(id) },
+			"event table",
+		); diags != nil {
+			return diags
+		}
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming event table from %v to %v: %w", id.FullyQualifiedName(), newId.FullyQualifiedName(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	set := sdk.NewEventTableSetRequest()
+	unset := sdk.NewEventTableUnsetRequest()
+
+	if errs := errors.Join(
+		booleanStringAttributeUpdate(d, "change_tracking", &set.ChangeTracking, &unset.ChangeTracking),
+		stringAttributeUpdate(d, "comment", &set.Comment, &unset.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+	if diags := handleEventTableParametersChanges(d, set, unset); diags.HasError() {
+		return diags
+	}
+
+	if !reflect.DeepEqual(*set, *sdk.NewEventTableSetRequest()) {
+		if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithSet(*set)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting event table properties %v: %w", id.FullyQualifiedName(), err))
+		}
+	}
+	if !reflect.DeepEqual(*unset, *sdk.NewEventTableUnsetRequest()) {
+		if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithUnset(*unset)); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting event table properties %v: %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if d.HasChange("cluster_by") {
+		clusteringAction := sdk.NewEventTableClusteringActionRequest()
+		if clusterBy := expandStringList(d.Get("cluster_by").([]any)); len(clusterBy) > 0 {
+			clusteringAction.WithClusterBy(clusterBy)
+		} else {
+			clusteringAction.WithDropClusteringKey(true)
+		}
+		if err := client.EventTables.Alter(ctx, sdk.NewAlterEventTableRequest(id).WithClusteringAction(*clusteringAction)); err != nil {
+			return diag.FromErr(fmt.Errorf("error changing clustering of event table %v: %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	return ReadEventTable(ctx, d, meta)
+}
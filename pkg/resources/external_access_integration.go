@@ -153,7 +153,7 @@ func ExternalAccessIntegration() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingCreateWrapper(resources.ExternalAccessIntegration, CreateExternalAccessIntegration)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingReadWrapper(resources.ExternalAccessIntegration, ReadExternalAccessIntegrationFunc(true))),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ExternalAccessIntegrationResource), TrackingUpdateWrapper(resources.ExternalAccessIntegration, UpdateExternalAccessIntegration)),
@@ -179,7 +179,7 @@ func ExternalAccessIntegration() *schema.Resource {
 			),
 			ComputedIfAnyAttributeChanged(externalAccessIntegrationSchema, FullyQualifiedNameAttributeName, "name"),
 		)),
-	}
+	})
 }
 
 func CreateExternalAccessIntegration(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
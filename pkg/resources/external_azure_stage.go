@@ -146,7 +146,7 @@ var externalAzureStageSchema = func() map[string]*schema.Schema {
 }()
 
 func ExternalAzureStage() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeStage, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		SchemaVersion: 1,
 
 		CreateContext: TrackingCreateWrapper(resources.ExternalAzureStage, CreateExternalAzureStage),
@@ -181,7 +181,7 @@ func ExternalAzureStage() *schema.Resource {
 				Upgrade: v2_14_0_ExternalAzureStageStateUpgrader,
 			},
 		},
-	}
+	})
 }
 
 func ImportExternalAzureStage(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -118,7 +118,7 @@ var externalGcsStageSchema = func() map[string]*schema.Schema {
 }()
 
 func ExternalGcsStage() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeStage, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		SchemaVersion: 1,
 
 		CreateContext: TrackingCreateWrapper(resources.ExternalGcsStage, CreateExternalGcsStage),
@@ -150,7 +150,7 @@ func ExternalGcsStage() *schema.Resource {
 				Upgrade: v2_14_0_ExternalGcsStageStateUpgrader,
 			},
 		},
-	}
+	})
 }
 
 func ImportExternalGcsStage(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
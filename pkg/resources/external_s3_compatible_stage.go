@@ -97,7 +97,7 @@ var externalS3CompatStageSchema = func() map[string]*schema.Schema {
 }()
 
 func ExternalS3CompatibleStage() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeStage, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		SchemaVersion: 1,
 
 		CreateContext: TrackingCreateWrapper(resources.ExternalS3CompatibleStage, CreateExternalS3CompatStage),
@@ -133,7 +133,7 @@ func ExternalS3CompatibleStage() *schema.Resource {
 				Upgrade: v2_14_0_ExternalS3CompatibleStageStateUpgrader,
 			},
 		},
-	}
+	})
 }
 
 func ImportExternalS3CompatStage(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -197,7 +197,7 @@ var externalS3StageSchema = func() map[string]*schema.Schema {
 }()
 
 func ExternalS3Stage() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeStage, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		SchemaVersion: 1,
 
 		CreateContext: TrackingCreateWrapper(resources.ExternalS3Stage, CreateExternalS3Stage),
@@ -238,7 +238,7 @@ func ExternalS3Stage() *schema.Resource {
 				Upgrade: v2_14_0_ExternalS3StageStateUpgrader,
 			},
 		},
-	}
+	})
 }
 
 func ImportExternalS3Stage(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
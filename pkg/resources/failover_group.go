@@ -8,6 +8,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
@@ -18,6 +19,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/validators"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -141,6 +143,13 @@ var failoverGroupSchema = map[string]*schema.Schema{
 			},
 		},
 	},
+	"primary_account": primaryAccountSchema("failover group"),
+	"refresh_after_apply": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Applicable only to secondary failover groups. When enabled, the secondary failover group is refreshed with `ALTER FAILOVER GROUP ... REFRESH` after it is created or updated, and the apply waits until the refresh finishes (bounded by the create and update timeouts). The refresh runs before the promotion requested with `primary_account`.",
+	},
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
 
@@ -184,6 +193,9 @@ func CreateFailoverGroup(ctx context.Context, d *schema.ResourceData, meta any)
 			return diag.FromErr(err)
 		}
 		d.SetId(name)
+		if err := refreshAndPromoteFailoverGroup(ctx, d, client, id, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
 		return ReadFailoverGroup(ctx, d, meta)
 	}
 
@@ -274,6 +286,9 @@ func CreateFailoverGroup(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 
 	d.SetId(name)
+	if err := refreshAndPromoteFailoverGroup(ctx, d, client, id, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
 	return ReadFailoverGroup(ctx, d, meta)
 }
 
@@ -303,6 +318,9 @@ func ReadFailoverGroup(ctx context.Context, d *schema.ResourceData, meta any) di
 	if err := d.Set("name", failoverGroup.Name); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("primary_account", failoverGroup.Primary.AccountIdentifier().Name()); err != nil {
+		return diag.FromErr(err)
+	}
 	if _, ok := d.GetOk("from_replica"); ok {
 		return nil
 	}
@@ -609,5 +627,51 @@ func UpdateFailoverGroup(ctx context.Context, d *schema.ResourceData, meta any)
 		}
 	}
 
+	if err := refreshAndPromoteFailoverGroup(ctx, d, client, id, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return ReadFailoverGroup(ctx, d, meta)
 }
+
+// refreshAndPromoteFailoverGroup refreshes the secondary failover group when refresh_after_apply is enabled,
+// and promotes it to primary when primary_account points to the current account.
+// Both operations are retried while another refresh of the failover group is in progress.
+func refreshAndPromoteFailoverGroup(ctx context.Context, d *schema.ResourceData, client *sdk.Client, id sdk.AccountObjectIdentifier, timeout time.Duration) error {
+	failoverGroup, err := client.FailoverGroups.ShowByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if failoverGroup.IsPrimary {
+		return nil
+	}
+
+	if d.Get("refresh_after_apply").(bool) {
+		err := alterFailoverGroupTargetWithRetry(ctx, client, sdk.NewAlterTargetFailoverGroupRequest(id).WithRefresh(true), timeout)
+		if err != nil {
+			return fmt.Errorf("error refreshing failover group %v err = %w", id.Name(), err)
+		}
+	}
+
+	if primaryAccount, ok := d.GetOk("primary_account"); ok && isCurrentAccount(primaryAccount.(string), failoverGroup.OrganizationName, failoverGroup.AccountName) {
+		err := alterFailoverGroupTargetWithRetry(ctx, client, sdk.NewAlterTargetFailoverGroupRequest(id).WithPrimary(true), timeout)
+		if err != nil {
+			return fmt.Errorf("error promoting failover group %v to primary err = %w", id.Name(), err)
+		}
+	}
+
+	return nil
+}
+
+func alterFailoverGroupTargetWithRetry(ctx context.Context, client *sdk.Client, request *sdk.AlterTargetFailoverGroupRequest, timeout time.Duration) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		if err := client.FailoverGroups.AlterTarget(ctx, request); err != nil {
+			// Snowflake rejects both the refresh and the promotion while another refresh of the failover group is running.
+			if errors.Is(err, sdk.ErrReplicationGroupRefreshInProgress) {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+}
@@ -20,7 +20,7 @@ import (
 )
 
 func FunctionJava() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeFunction, sdk.ParseSchemaObjectIdentifierWithArguments, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.FunctionJavaResource), TrackingCreateWrapper(resources.FunctionJava, CreateContextFunctionJava)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.FunctionJavaResource), TrackingReadWrapper(resources.FunctionJava, ReadContextFunctionJava)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.FunctionJavaResource), TrackingUpdateWrapper(resources.FunctionJava, UpdateFunction("JAVA", ReadContextFunctionJava))),
@@ -44,7 +44,7 @@ func FunctionJava() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.FunctionJava, ImportFunction),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateContextFunctionJava(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
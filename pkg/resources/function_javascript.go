@@ -20,7 +20,7 @@ import (
 )
 
 func FunctionJavascript() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeFunction, sdk.ParseSchemaObjectIdentifierWithArguments, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.FunctionJavascriptResource), TrackingCreateWrapper(resources.FunctionJavascript, CreateContextFunctionJavascript)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.FunctionJavascriptResource), TrackingReadWrapper(resources.FunctionJavascript, ReadContextFunctionJavascript)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.FunctionJavascriptResource), TrackingUpdateWrapper(resources.FunctionJavascript, UpdateFunction("JAVASCRIPT", ReadContextFunctionJavascript))),
@@ -44,7 +44,7 @@ func FunctionJavascript() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.FunctionJavascript, ImportFunction),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateContextFunctionJavascript(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
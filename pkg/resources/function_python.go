@@ -20,7 +20,7 @@ import (
 )
 
 func FunctionPython() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeFunction, sdk.ParseSchemaObjectIdentifierWithArguments, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.FunctionPythonResource), TrackingCreateWrapper(resources.FunctionPython, CreateContextFunctionPython)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.FunctionPythonResource), TrackingReadWrapper(resources.FunctionPython, ReadContextFunctionPython)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.FunctionPythonResource), TrackingUpdateWrapper(resources.FunctionPython, UpdateFunction("PYTHON", ReadContextFunctionPython))),
@@ -44,7 +44,7 @@ func FunctionPython() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.FunctionPython, ImportFunction),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateContextFunctionPython(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -20,7 +20,7 @@ import (
 )
 
 func FunctionScala() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeFunction, sdk.ParseSchemaObjectIdentifierWithArguments, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.FunctionScalaResource), TrackingCreateWrapper(resources.FunctionScala, CreateContextFunctionScala)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.FunctionScalaResource), TrackingReadWrapper(resources.FunctionScala, ReadContextFunctionScala)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.FunctionScalaResource), TrackingUpdateWrapper(resources.FunctionScala, UpdateFunction("SCALA", ReadContextFunctionScala))),
@@ -44,7 +44,7 @@ func FunctionScala() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.FunctionScala, ImportFunction),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateContextFunctionScala(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
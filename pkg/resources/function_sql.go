@@ -20,7 +20,7 @@ import (
 )
 
 func FunctionSql() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeFunction, sdk.ParseSchemaObjectIdentifierWithArguments, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.FunctionSqlResource), TrackingCreateWrapper(resources.FunctionSql, CreateContextFunctionSql)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.FunctionSqlResource), TrackingReadWrapper(resources.FunctionSql, ReadContextFunctionSql)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.FunctionSqlResource), TrackingUpdateWrapper(resources.FunctionSql, UpdateFunction("SQL", ReadContextFunctionSql))),
@@ -44,7 +44,7 @@ func FunctionSql() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.FunctionSql, ImportFunction),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateContextFunctionSql(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
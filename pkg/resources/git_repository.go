@@ -64,6 +64,11 @@ var gitRepositorySchema = map[string]*schema.Schema{
 		Optional:    true,
 		Description: "Specifies a comment for the git repository.",
 	},
+	"fetch_trigger": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Arbitrary value that triggers `ALTER GIT REPOSITORY ... FETCH` whenever it changes (e.g. the branch, tag, or commit hash the downstream objects should use). It lets the objects depending on the git repository see the refs that were planned against. The value is not stored in Snowflake, and the fetch is not run on creation, because Snowflake fetches the repository when it is created.",
+	},
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 	ShowOutputAttributeName: {
 		Type:        schema.TypeList,
@@ -98,7 +103,8 @@ func GitRepository() *schema.Resource {
 		Description:   "Resource used to manage git repositories. For more information, check [git repositories documentation](https://docs.snowflake.com/en/sql-reference/sql/create-git-repository).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.GitRepository, customdiff.All(
-			ComputedIfAnyAttributeChanged(gitRepositorySchema, ShowOutputAttributeName, "origin", "api_integration", "git_credentials", "comment"),
+			ComputedIfAnyAttributeChanged(gitRepositorySchema, ShowOutputAttributeName, "origin", "api_integration", "git_credentials", "comment", "fetch_trigger"),
+			ComputedIfAnyAttributeChanged(gitRepositorySchema, DescribeOutputAttributeName, "fetch_trigger"),
 		)),
 
 		Schema: gitRepositorySchema,
@@ -212,5 +218,11 @@ func UpdateGitRepository(ctx context.Context, d *schema.ResourceData, meta any)
 			return diag.FromErr(err)
 		}
 	}
+
+	if d.HasChange("fetch_trigger") {
+		if err := client.GitRepositories.Alter(ctx, sdk.NewAlterGitRepositoryRequest(id).WithFetch(true)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return ReadGitRepository(ctx, d, meta)
 }
@@ -0,0 +1,631 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/validators"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/experimentalfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var grantCallerPrivilegesSchema = map[string]*schema.Schema{
+	"account_role_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      relatedResourceDescription("The fully qualified name of the account role to which caller privileges will be granted.", resources.AccountRole),
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf: []string{
+			"account_role_name",
+			"database_role_name",
+		},
+	},
+	"database_role_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Description:      relatedResourceDescription("The fully qualified name of the database role to which caller privileges will be granted.", resources.DatabaseRole),
+		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ExactlyOneOf: []string{
+			"account_role_name",
+			"database_role_name",
+		},
+	},
+	"privileges": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "The caller privileges to grant. An owner's rights executable run with restricted caller's rights can use these privileges of the caller.",
+		MinItems:    1,
+		ExactlyOneOf: []string{
+			"privileges",
+			"all_privileges",
+		},
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateDiagFunc: validation.AllDiag(
+				isNotOwnershipGrant(),
+				validators.NormalizeValidation(sdk.ToPrivilege),
+			),
+		},
+	},
+	"all_privileges": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Grant all caller privileges.",
+		ExactlyOneOf: []string{
+			"privileges",
+			"all_privileges",
+		},
+	},
+	"on_object": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the object on which caller privileges will be granted.",
+		MaxItems:    1,
+		ExactlyOneOf: []string{
+			"on_object",
+			"inherited",
+		},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      "The object type of the object on which caller privileges will be granted (e.g. `TABLE`, `VIEW`, or `DATABASE`).",
+					ValidateDiagFunc: validators.NormalizeValidation(sdk.ToObjectType),
+				},
+				"object_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      "The fully qualified name of the object on which caller privileges will be granted.",
+					DiffSuppressFunc: suppressIdentifierQuoting,
+				},
+			},
+		},
+	},
+	"inherited": {
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Configures inherited caller privileges to be granted on all current and future objects of a given type in the account, a database, or a schema.",
+		MaxItems:    1,
+		ExactlyOneOf: []string{
+			"on_object",
+			"inherited",
+		},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_type_plural": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      joinWithSpace("The plural object type of the objects on which inherited caller privileges will be granted.", enumValuesDescription(sdk.ValidGrantToAllPluralObjectTypesString)),
+					ValidateDiagFunc: StringInSlice(sdk.ValidGrantToAllPluralObjectTypesString, true),
+				},
+				"in_account": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "If true, the inherited caller privileges will be granted on all objects of the given type in the account.",
+					ExactlyOneOf: []string{
+						"inherited.0.in_account",
+						"inherited.0.in_database",
+						"inherited.0.in_schema",
+					},
+				},
+				"in_database": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					Description:      "The fully qualified name of the database in which the inherited caller privileges will be granted on all objects of the given type.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ExactlyOneOf: []string{
+						"inherited.0.in_account",
+						"inherited.0.in_database",
+						"inherited.0.in_schema",
+					},
+				},
+				"in_schema": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					Description:      "The fully qualified name of the schema in which the inherited caller privileges will be granted on all objects of the given type.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ExactlyOneOf: []string{
+						"inherited.0.in_account",
+						"inherited.0.in_database",
+						"inherited.0.in_schema",
+					},
+				},
+			},
+		},
+	},
+}
+
+func GrantCallerPrivileges() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.GrantCallerPrivilegesResource), TrackingCreateWrapper(resources.GrantCallerPrivileges, CreateGrantCallerPrivileges)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.GrantCallerPrivilegesResource), TrackingUpdateWrapper(resources.GrantCallerPrivileges, UpdateGrantCallerPrivileges)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.GrantCallerPrivilegesResource), TrackingDeleteWrapper(resources.GrantCallerPrivileges, DeleteGrantCallerPrivileges)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.GrantCallerPrivilegesResource), TrackingReadWrapper(resources.GrantCallerPrivileges, ReadGrantCallerPrivileges)),
+		Description:   "Resource used to manage caller grants. Caller grants define which privileges of the caller an owner's rights executable (e.g. a stored procedure or a Streamlit app) can use when it runs with restricted caller's rights. For more information, check [restricted caller's rights](https://docs.snowflake.com/en/developer-guide/restricted-callers-rights) documentation.",
+
+		Schema: grantCallerPrivilegesSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.GrantCallerPrivileges, ImportGrantCallerPrivileges),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportGrantCallerPrivileges(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	id, err := ParseGrantCallerPrivilegesId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	switch id.TargetRoleKind {
+	case AccountRoleCallerGrantTargetRoleKind:
+		err = d.Set("account_role_name", id.AccountRoleName.FullyQualifiedName())
+	case DatabaseRoleCallerGrantTargetRoleKind:
+		err = d.Set("database_role_name", id.DatabaseRoleName.FullyQualifiedName())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = errors.Join(
+		d.Set("all_privileges", id.AllPrivileges),
+		d.Set("privileges", id.Privileges),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	switch id.Kind {
+	case OnObjectCallerGrantKind:
+		data := id.Data.(*OnObjectCallerGrantData)
+		onObject := map[string]any{
+			"object_type": data.ObjectType.String(),
+			"object_name": data.ObjectName.FullyQualifiedName(),
+		}
+
+		if err := d.Set("on_object", []any{onObject}); err != nil {
+			return nil, err
+		}
+	case InheritedCallerGrantKind:
+		data := id.Data.(*OnSchemaObjectInheritedGrantData)
+		inherited := map[string]any{
+			"object_type_plural": data.ObjectNamePlural.String(),
+		}
+
+		switch data.Kind {
+		case InAccountInheritedContainerKind:
+			inherited["in_account"] = true
+		case InDatabaseInheritedContainerKind:
+			inherited["in_database"] = data.DatabaseName.FullyQualifiedName()
+		case InSchemaInheritedContainerKind:
+			inherited["in_schema"] = data.SchemaName.FullyQualifiedName()
+		}
+
+		if err := d.Set("inherited", []any{inherited}); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateGrantCallerPrivileges(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := createGrantCallerPrivilegesIdFromSchema(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = grantCallerPrivileges(ctx, client, *id, getCallerGrantPrivileges(id.AllPrivileges, id.Privileges))
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when granting caller privileges",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", id.String(), err.Error()),
+			},
+		}
+	}
+
+	d.SetId(id.String())
+
+	return ReadGrantCallerPrivileges(ctx, d, meta)
+}
+
+func UpdateGrantCallerPrivileges(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := ParseGrantCallerPrivilegesId(d.Id())
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to parse internal identifier",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
+		}
+	}
+
+	// handle all_privileges -> privileges change (revoke all privileges)
+	if d.HasChange("all_privileges") {
+		_, allPrivileges := d.GetChange("all_privileges")
+
+		if !allPrivileges.(bool) {
+			if err := revokeCallerPrivileges(ctx, client, id, getCallerGrantPrivileges(true, nil), false); err != nil {
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "Failed to revoke all caller privileges",
+						Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+					},
+				}
+			}
+		}
+
+		id.AllPrivileges = allPrivileges.(bool)
+	}
+
+	if d.HasChange("privileges") {
+		shouldHandlePrivilegesChange := true
+
+		// Skip if all_privileges was set to true
+		if d.HasChange("all_privileges") {
+			if _, allPrivileges := d.GetChange("all_privileges"); allPrivileges.(bool) {
+				shouldHandlePrivilegesChange = false
+				id.Privileges = []string{}
+			}
+		}
+
+		if shouldHandlePrivilegesChange {
+			before, after := d.GetChange("privileges")
+			privilegesBeforeChange := expandStringList(before.(*schema.Set).List())
+			privilegesAfterChange := expandStringList(after.(*schema.Set).List())
+
+			var privilegesToAdd, privilegesToRemove []string
+
+			for _, privilegeBeforeChange := range privilegesBeforeChange {
+				if !slices.Contains(privilegesAfterChange, privilegeBeforeChange) {
+					privilegesToRemove = append(privilegesToRemove, privilegeBeforeChange)
+				}
+			}
+
+			for _, privilegeAfterChange := range privilegesAfterChange {
+				if !slices.Contains(privilegesBeforeChange, privilegeAfterChange) {
+					privilegesToAdd = append(privilegesToAdd, privilegeAfterChange)
+				}
+			}
+
+			if len(privilegesToAdd) > 0 {
+				if err := grantCallerPrivileges(ctx, client, id, getCallerGrantPrivileges(false, privilegesToAdd)); err != nil {
+					return diag.Diagnostics{
+						diag.Diagnostic{
+							Severity: diag.Error,
+							Summary:  "Failed to grant added caller privileges",
+							Detail:   fmt.Sprintf("Id: %s\nPrivileges to add: %v\nError: %s", d.Id(), privilegesToAdd, err.Error()),
+						},
+					}
+				}
+			}
+
+			if len(privilegesToRemove) > 0 {
+				if err := revokeCallerPrivileges(ctx, client, id, getCallerGrantPrivileges(false, privilegesToRemove), false); err != nil {
+					return diag.Diagnostics{
+						diag.Diagnostic{
+							Severity: diag.Error,
+							Summary:  "Failed to revoke removed caller privileges",
+							Detail:   fmt.Sprintf("Id: %s\nPrivileges to remove: %v\nError: %s", d.Id(), privilegesToRemove, err.Error()),
+						},
+					}
+				}
+			}
+
+			id.Privileges = privilegesAfterChange
+		}
+	}
+
+	// handle privileges -> all_privileges change (grant all privileges)
+	if d.HasChange("all_privileges") {
+		_, allPrivileges := d.GetChange("all_privileges")
+
+		if allPrivileges.(bool) {
+			if err := grantCallerPrivileges(ctx, client, id, getCallerGrantPrivileges(true, nil)); err != nil {
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "Failed to grant all caller privileges",
+						Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+					},
+				}
+			}
+		}
+
+		id.AllPrivileges = allPrivileges.(bool)
+	}
+
+	d.SetId(id.String())
+
+	return ReadGrantCallerPrivileges(ctx, d, meta)
+}
+
+func DeleteGrantCallerPrivileges(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	providerCtx := meta.(*provider.Context)
+	id, err := ParseGrantCallerPrivilegesId(d.Id())
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to parse internal identifier",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
+		}
+	}
+
+	safely := experimentalfeatures.IsExperimentEnabled(experimentalfeatures.GrantsSafeDestroy, providerCtx.EnabledExperiments)
+	if err := revokeCallerPrivileges(ctx, providerCtx.Client, id, getCallerGrantPrivileges(id.AllPrivileges, id.Privileges), safely); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when revoking caller privileges",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func ReadGrantCallerPrivileges(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	id, err := ParseGrantCallerPrivilegesId(d.Id())
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to parse internal identifier",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
+		}
+	}
+
+	if id.AllPrivileges {
+		log.Printf("[INFO] Show with all_privileges option is skipped. No changes in caller privileges in Snowflake will be detected. Consider specifying all privileges in 'privileges' block.")
+		return nil
+	}
+
+	client := meta.(*provider.Context).Client
+	grants, err := client.Grants.ShowCallerGrants(ctx, prepareShowCallerGrantsRequest(id))
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve caller grants. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to retrieve caller grants",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
+		}
+	}
+
+	privileges := computeCallerPrivileges(id, grants)
+
+	if err := d.Set("privileges", privileges); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Error setting caller privileges",
+				Detail:   fmt.Sprintf("Id: %s\nPrivileges: %v\nError: %s", d.Id(), privileges, err.Error()),
+			},
+		}
+	}
+
+	return nil
+}
+
+// prepareShowCallerGrantsRequest returns SHOW CALLER GRANTS ON <object> for caller grants on a single object,
+// and SHOW CALLER GRANTS TO [DATABASE] ROLE for inherited caller grants, which are not listed on any object.
+func prepareShowCallerGrantsRequest(id GrantCallerPrivilegesId) *sdk.ShowCallerGrantOptions {
+	if id.Kind == OnObjectCallerGrantKind {
+		data := id.Data.(*OnObjectCallerGrantData)
+		return &sdk.ShowCallerGrantOptions{
+			On: &sdk.ShowCallerGrantsOn{
+				Object: &sdk.Object{
+					ObjectType: data.ObjectType,
+					Name:       data.ObjectName,
+				},
+			},
+		}
+	}
+	return &sdk.ShowCallerGrantOptions{
+		To: new(getCallerGrantTo(id)),
+	}
+}
+
+func computeCallerPrivileges(id GrantCallerPrivilegesId, grants []sdk.Grant) (privileges []string) {
+	granteeType, granteeName := sdk.ObjectTypeRole, id.AccountRoleName.Name()
+	if id.TargetRoleKind == DatabaseRoleCallerGrantTargetRoleKind {
+		granteeType, granteeName = sdk.ObjectTypeDatabaseRole, id.DatabaseRoleName.Name()
+	}
+
+	for _, grant := range grants {
+		if grant.GrantedTo != granteeType || grant.GranteeName.Name() != granteeName {
+			continue
+		}
+		// Only consider privileges that are already present in the ID, so we
+		// don't delete privileges managed by other resources.
+		if !slices.Contains(id.Privileges, grant.Privilege) {
+			continue
+		}
+		if id.Kind == InheritedCallerGrantKind && !inheritedCallerGrantMatches(id.Data.(*OnSchemaObjectInheritedGrantData), grant) {
+			continue
+		}
+		privileges = append(privileges, grant.Privilege)
+	}
+
+	return privileges
+}
+
+// inheritedCallerGrantMatches reports whether the caller grant row describes an inherited caller grant on the
+// given object type and container. The container is matched with the inherited_from columns when they are
+// returned, and with the grant's object name otherwise.
+func inheritedCallerGrantMatches(data *OnSchemaObjectInheritedGrantData, grant sdk.Grant) bool {
+	if grant.GrantedOn != data.ObjectNamePlural.Singular() {
+		return false
+	}
+	if grant.InheritedFrom != nil {
+		return inheritedGrantMatchesContainer(grant, data.Kind, data.DatabaseName, data.SchemaName)
+	}
+	switch data.Kind {
+	case InDatabaseInheritedContainerKind:
+		return grant.Name.FullyQualifiedName() == data.DatabaseName.FullyQualifiedName()
+	case InSchemaInheritedContainerKind:
+		return grant.Name.FullyQualifiedName() == data.SchemaName.FullyQualifiedName()
+	default:
+		return true
+	}
+}
+
+func getCallerGrantTo(id GrantCallerPrivilegesId) sdk.CallerGrantTo {
+	if id.TargetRoleKind == DatabaseRoleCallerGrantTargetRoleKind {
+		return sdk.CallerGrantTo{DatabaseRole: new(id.DatabaseRoleName)}
+	}
+	return sdk.CallerGrantTo{AccountRole: new(id.AccountRoleName)}
+}
+
+func getCallerGrantPrivileges(allPrivileges bool, privileges []string) sdk.CallerGrantPrivileges {
+	if allPrivileges {
+		return sdk.CallerGrantPrivileges{AllPrivileges: sdk.Bool(true)}
+	}
+	objectPrivileges := make([]sdk.ObjectPrivilege, len(privileges))
+	for i, privilege := range privileges {
+		objectPrivileges[i] = sdk.ObjectPrivilege(privilege)
+	}
+	return sdk.CallerGrantPrivileges{Privileges: objectPrivileges}
+}
+
+func grantCallerPrivileges(ctx context.Context, client *sdk.Client, id GrantCallerPrivilegesId, privileges sdk.CallerGrantPrivileges) error {
+	switch data := id.Data.(type) {
+	case *OnObjectCallerGrantData:
+		return client.Grants.GrantCaller(ctx, privileges, sdk.Object{ObjectType: data.ObjectType, Name: data.ObjectName}, getCallerGrantTo(id))
+	case *OnSchemaObjectInheritedGrantData:
+		return client.Grants.GrantInheritedCaller(ctx, privileges, data.ObjectNamePlural, data.Kind.toInheritedCallerGrantIn(data.DatabaseName, data.SchemaName), getCallerGrantTo(id))
+	default:
+		return fmt.Errorf("unsupported caller grant kind: %s", id.Kind)
+	}
+}
+
+func revokeCallerPrivileges(ctx context.Context, client *sdk.Client, id GrantCallerPrivilegesId, privileges sdk.CallerGrantPrivileges, safely bool) error {
+	switch data := id.Data.(type) {
+	case *OnObjectCallerGrantData:
+		on := sdk.Object{ObjectType: data.ObjectType, Name: data.ObjectName}
+		if safely {
+			return client.Grants.RevokeCallerSafely(ctx, privileges, on, getCallerGrantTo(id))
+		}
+		return client.Grants.RevokeCaller(ctx, privileges, on, getCallerGrantTo(id))
+	case *OnSchemaObjectInheritedGrantData:
+		in := data.Kind.toInheritedCallerGrantIn(data.DatabaseName, data.SchemaName)
+		if safely {
+			return client.Grants.RevokeInheritedCallerSafely(ctx, privileges, data.ObjectNamePlural, in, getCallerGrantTo(id))
+		}
+		return client.Grants.RevokeInheritedCaller(ctx, privileges, data.ObjectNamePlural, in, getCallerGrantTo(id))
+	default:
+		return fmt.Errorf("unsupported caller grant kind: %s", id.Kind)
+	}
+}
+
+func createGrantCallerPrivilegesIdFromSchema(d *schema.ResourceData) (*GrantCallerPrivilegesId, error) {
+	id := new(GrantCallerPrivilegesId)
+
+	if accountRoleName, ok := d.GetOk("account_role_name"); ok {
+		roleId, err := sdk.ParseAccountObjectIdentifier(accountRoleName.(string))
+		if err != nil {
+			return nil, err
+		}
+		id.TargetRoleKind = AccountRoleCallerGrantTargetRoleKind
+		id.AccountRoleName = roleId
+	}
+	if databaseRoleName, ok := d.GetOk("database_role_name"); ok {
+		roleId, err := sdk.ParseDatabaseObjectIdentifier(databaseRoleName.(string))
+		if err != nil {
+			return nil, err
+		}
+		id.TargetRoleKind = DatabaseRoleCallerGrantTargetRoleKind
+		id.DatabaseRoleName = roleId
+	}
+
+	id.AllPrivileges = d.Get("all_privileges").(bool)
+	if p, ok := d.GetOk("privileges"); ok {
+		id.Privileges = expandStringList(p.(*schema.Set).List())
+	}
+
+	if block, ok := d.GetOk("on_object"); ok {
+		onObject := block.([]any)[0].(map[string]any)
+		objectType, err := sdk.ToObjectType(onObject["object_type"].(string))
+		if err != nil {
+			return nil, err
+		}
+		objectName, err := GetOnObjectIdentifier(objectType, onObject["object_name"].(string))
+		if err != nil {
+			return nil, err
+		}
+		id.Kind = OnObjectCallerGrantKind
+		id.Data = &OnObjectCallerGrantData{
+			ObjectType: objectType,
+			ObjectName: objectName,
+		}
+	}
+
+	if block, ok := d.GetOk("inherited"); ok {
+		inherited := block.([]any)[0].(map[string]any)
+		objectNamePlural, err := sdk.ToPluralObjectType(inherited["object_type_plural"].(string))
+		if err != nil {
+			return nil, err
+		}
+		container, database, schema, err := getInheritedGrantContainer(inherited)
+		if err != nil {
+			return nil, err
+		}
+		id.Kind = InheritedCallerGrantKind
+		id.Data = &OnSchemaObjectInheritedGrantData{
+			ObjectNamePlural: objectNamePlural,
+			Kind:             container,
+			DatabaseName:     database,
+			SchemaName:       schema,
+		}
+	}
+
+	return id, nil
+}
@@ -0,0 +1,168 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+)
+
+type CallerGrantTargetRoleKind string
+
+const (
+	AccountRoleCallerGrantTargetRoleKind  CallerGrantTargetRoleKind = "AccountRole"
+	DatabaseRoleCallerGrantTargetRoleKind CallerGrantTargetRoleKind = "DatabaseRole"
+)
+
+type CallerGrantKind string
+
+const (
+	OnObjectCallerGrantKind  CallerGrantKind = "OnObject"
+	InheritedCallerGrantKind CallerGrantKind = "Inherited"
+)
+
+type OnObjectCallerGrantData struct {
+	ObjectType sdk.ObjectType
+	ObjectName sdk.ObjectIdentifier
+}
+
+func (d *OnObjectCallerGrantData) String() string {
+	return helpers.EncodeResourceIdentifier(d.ObjectType.String(), d.ObjectName.FullyQualifiedName())
+}
+
+type GrantCallerPrivilegesId struct {
+	TargetRoleKind   CallerGrantTargetRoleKind
+	AccountRoleName  sdk.AccountObjectIdentifier
+	DatabaseRoleName sdk.DatabaseObjectIdentifier
+	AllPrivileges    bool
+	Privileges       []string
+	Kind             CallerGrantKind
+	Data             fmt.Stringer
+}
+
+func (g *GrantCallerPrivilegesId) String() string {
+	var parts []string
+	parts = append(parts, string(g.TargetRoleKind))
+	switch g.TargetRoleKind {
+	case AccountRoleCallerGrantTargetRoleKind:
+		parts = append(parts, g.AccountRoleName.FullyQualifiedName())
+	case DatabaseRoleCallerGrantTargetRoleKind:
+		parts = append(parts, g.DatabaseRoleName.FullyQualifiedName())
+	}
+	if g.AllPrivileges {
+		parts = append(parts, "ALL")
+	} else {
+		parts = append(parts, strings.Join(g.Privileges, ","))
+	}
+	parts = append(parts, string(g.Kind))
+	data := g.Data.String()
+	if len(data) > 0 {
+		parts = append(parts, data)
+	}
+	return helpers.EncodeResourceIdentifier(parts...)
+}
+
+func ParseGrantCallerPrivilegesId(id string) (GrantCallerPrivilegesId, error) {
+	var callerId GrantCallerPrivilegesId
+
+	parts := helpers.ParseResourceIdentifier(id)
+	if len(parts) < 5 {
+		return callerId, sdk.NewError(`caller grant identifier should hold at least 5 parts "<target_role_kind>|<role_name>|<privileges>|<grant_type>|<grant_data>"`)
+	}
+
+	callerId.TargetRoleKind = CallerGrantTargetRoleKind(parts[0])
+	switch callerId.TargetRoleKind {
+	case AccountRoleCallerGrantTargetRoleKind:
+		roleId, err := sdk.ParseAccountObjectIdentifier(parts[1])
+		if err != nil {
+			return callerId, err
+		}
+		callerId.AccountRoleName = roleId
+	case DatabaseRoleCallerGrantTargetRoleKind:
+		roleId, err := sdk.ParseDatabaseObjectIdentifier(parts[1])
+		if err != nil {
+			return callerId, err
+		}
+		callerId.DatabaseRoleName = roleId
+	default:
+		return callerId, sdk.NewError(fmt.Sprintf("invalid CallerGrantTargetRoleKind: %s, valid options are %v | %v", callerId.TargetRoleKind, AccountRoleCallerGrantTargetRoleKind, DatabaseRoleCallerGrantTargetRoleKind))
+	}
+
+	privileges := strings.Split(parts[2], ",")
+	if len(privileges) == 0 || (len(privileges) == 1 && privileges[0] == "") {
+		return callerId, sdk.NewError(fmt.Sprintf(`invalid Privileges value: %s, should be either a comma separated list of privileges or "ALL" / "ALL PRIVILEGES" for all privileges`, parts[2]))
+	}
+	if len(privileges) == 1 && (privileges[0] == "ALL" || privileges[0] == "ALL PRIVILEGES") {
+		callerId.AllPrivileges = true
+	} else {
+		privilegeNames, err := toPrivileges(privileges)
+		if err != nil {
+			return callerId, err
+		}
+		callerId.Privileges = privilegeNames
+	}
+
+	callerId.Kind = CallerGrantKind(parts[3])
+	switch callerId.Kind {
+	case OnObjectCallerGrantKind:
+		if len(parts) != 6 {
+			return callerId, sdk.NewError(`caller grant identifier should hold 6 parts "<target_role_kind>|<role_name>|<privileges>|OnObject|<object_type>|<object_name>"`)
+		}
+		objectType, err := sdk.ToObjectType(parts[4])
+		if err != nil {
+			return callerId, err
+		}
+		objectName, err := GetOnObjectIdentifier(objectType, parts[5])
+		if err != nil {
+			return callerId, err
+		}
+		callerId.Data = &OnObjectCallerGrantData{
+			ObjectType: objectType,
+			ObjectName: objectName,
+		}
+	case InheritedCallerGrantKind:
+		if len(parts) < 6 {
+			return callerId, sdk.NewError(`caller grant identifier should hold at least 6 parts "<target_role_kind>|<role_name>|<privileges>|Inherited|<object_type_plural>|In[Account, Database or Schema]|<identifier>..."`)
+		}
+		objectNamePlural, err := sdk.ToPluralObjectType(parts[4])
+		if err != nil {
+			return callerId, err
+		}
+		inheritedGrantData := OnSchemaObjectInheritedGrantData{
+			ObjectNamePlural: objectNamePlural,
+			Kind:             InheritedContainerKind(parts[5]),
+		}
+		switch inheritedGrantData.Kind {
+		case InAccountInheritedContainerKind:
+			if len(parts) != 6 {
+				return callerId, sdk.NewError(`caller grant identifier should hold 6 parts "<target_role_kind>|<role_name>|<privileges>|Inherited|<object_type_plural>|InAccount"`)
+			}
+		case InDatabaseInheritedContainerKind:
+			if len(parts) != 7 {
+				return callerId, sdk.NewError(`caller grant identifier should hold 7 parts "<target_role_kind>|<role_name>|<privileges>|Inherited|<object_type_plural>|InDatabase|<database_name>"`)
+			}
+			databaseId, err := sdk.ParseAccountObjectIdentifier(parts[6])
+			if err != nil {
+				return callerId, err
+			}
+			inheritedGrantData.DatabaseName = new(databaseId)
+		case InSchemaInheritedContainerKind:
+			if len(parts) != 7 {
+				return callerId, sdk.NewError(`caller grant identifier should hold 7 parts "<target_role_kind>|<role_name>|<privileges>|Inherited|<object_type_plural>|InSchema|<schema_name>"`)
+			}
+			schemaId, err := sdk.ParseDatabaseObjectIdentifier(parts[6])
+			if err != nil {
+				return callerId, err
+			}
+			inheritedGrantData.SchemaName = new(schemaId)
+		default:
+			return callerId, sdk.NewError(fmt.Sprintf("invalid InheritedContainerKind: %s", inheritedGrantData.Kind))
+		}
+		callerId.Data = &inheritedGrantData
+	default:
+		return callerId, sdk.NewError(fmt.Sprintf("invalid CallerGrantKind: %s", callerId.Kind))
+	}
+
+	return callerId, nil
+}
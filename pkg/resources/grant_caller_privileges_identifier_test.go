@@ -0,0 +1,195 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGrantCallerPrivilegesId(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Identifier string
+		Expected   GrantCallerPrivilegesId
+		Error      string
+	}{
+		{
+			Name:       "caller grant on object to account role",
+			Identifier: `AccountRole|"role-name"|SELECT,INSERT|OnObject|TABLE|"database-name"."schema-name"."table-name"`,
+			Expected: GrantCallerPrivilegesId{
+				TargetRoleKind:  AccountRoleCallerGrantTargetRoleKind,
+				AccountRoleName: sdk.NewAccountObjectIdentifier("role-name"),
+				Privileges:      []string{"SELECT", "INSERT"},
+				Kind:            OnObjectCallerGrantKind,
+				Data: &OnObjectCallerGrantData{
+					ObjectType: sdk.ObjectTypeTable,
+					ObjectName: sdk.NewSchemaObjectIdentifier("database-name", "schema-name", "table-name"),
+				},
+			},
+		},
+		{
+			Name:       "caller grant on procedure to database role - all privileges",
+			Identifier: `DatabaseRole|"database-name"."database-role"|ALL|OnObject|PROCEDURE|"database-name"."schema-name"."procedure-name"(VARCHAR)`,
+			Expected: GrantCallerPrivilegesId{
+				TargetRoleKind:   DatabaseRoleCallerGrantTargetRoleKind,
+				DatabaseRoleName: sdk.NewDatabaseObjectIdentifier("database-name", "database-role"),
+				AllPrivileges:    true,
+				Kind:             OnObjectCallerGrantKind,
+				Data: &OnObjectCallerGrantData{
+					ObjectType: sdk.ObjectTypeProcedure,
+					ObjectName: sdk.NewSchemaObjectIdentifierWithArguments("database-name", "schema-name", "procedure-name", sdk.DataTypeVARCHAR),
+				},
+			},
+		},
+		{
+			Name:       "inherited caller grant in account",
+			Identifier: `AccountRole|"role-name"|SELECT|Inherited|TABLES|InAccount`,
+			Expected: GrantCallerPrivilegesId{
+				TargetRoleKind:  AccountRoleCallerGrantTargetRoleKind,
+				AccountRoleName: sdk.NewAccountObjectIdentifier("role-name"),
+				Privileges:      []string{"SELECT"},
+				Kind:            InheritedCallerGrantKind,
+				Data: &OnSchemaObjectInheritedGrantData{
+					ObjectNamePlural: sdk.PluralObjectTypeTables,
+					Kind:             InAccountInheritedContainerKind,
+				},
+			},
+		},
+		{
+			Name:       "inherited caller grant in database",
+			Identifier: `AccountRole|"role-name"|SELECT|Inherited|VIEWS|InDatabase|"database-name"`,
+			Expected: GrantCallerPrivilegesId{
+				TargetRoleKind:  AccountRoleCallerGrantTargetRoleKind,
+				AccountRoleName: sdk.NewAccountObjectIdentifier("role-name"),
+				Privileges:      []string{"SELECT"},
+				Kind:            InheritedCallerGrantKind,
+				Data: &OnSchemaObjectInheritedGrantData{
+					ObjectNamePlural: sdk.PluralObjectTypeViews,
+					Kind:             InDatabaseInheritedContainerKind,
+					DatabaseName:     new(sdk.NewAccountObjectIdentifier("database-name")),
+				},
+			},
+		},
+		{
+			Name:       "inherited caller grant in schema",
+			Identifier: `DatabaseRole|"database-name"."database-role"|ALL|Inherited|TABLES|InSchema|"database-name"."schema-name"`,
+			Expected: GrantCallerPrivilegesId{
+				TargetRoleKind:   DatabaseRoleCallerGrantTargetRoleKind,
+				DatabaseRoleName: sdk.NewDatabaseObjectIdentifier("database-name", "database-role"),
+				AllPrivileges:    true,
+				Kind:             InheritedCallerGrantKind,
+				Data: &OnSchemaObjectInheritedGrantData{
+					ObjectNamePlural: sdk.PluralObjectTypeTables,
+					Kind:             InSchemaInheritedContainerKind,
+					SchemaName:       new(sdk.NewDatabaseObjectIdentifier("database-name", "schema-name")),
+				},
+			},
+		},
+		{
+			Name:       "validation: caller grant not enough parts",
+			Identifier: `AccountRole|"role-name"|SELECT|OnObject`,
+			Error:      "caller grant identifier should hold at least 5 parts",
+		},
+		{
+			Name:       "validation: caller grant on object not enough parts",
+			Identifier: `AccountRole|"role-name"|SELECT|OnObject|TABLE`,
+			Error:      "caller grant identifier should hold 6 parts",
+		},
+		{
+			Name:       "validation: inherited caller grant in database without database name",
+			Identifier: `AccountRole|"role-name"|SELECT|Inherited|TABLES|InDatabase`,
+			Error:      "caller grant identifier should hold 7 parts",
+		},
+		{
+			Name:       "validation: caller grant invalid target role kind",
+			Identifier: `ApplicationRole|"role-name"|SELECT|OnObject|TABLE|"database-name"."schema-name"."table-name"`,
+			Error:      "invalid CallerGrantTargetRoleKind: ApplicationRole",
+		},
+		{
+			Name:       "validation: caller grant invalid kind",
+			Identifier: `AccountRole|"role-name"|SELECT|OnFuture|TABLES|InAccount`,
+			Error:      "invalid CallerGrantKind: OnFuture",
+		},
+		{
+			Name:       "validation: inherited caller grant invalid container kind",
+			Identifier: `AccountRole|"role-name"|SELECT|Inherited|TABLES|InTable|"database-name"`,
+			Error:      "invalid InheritedContainerKind: InTable",
+		},
+		{
+			Name:       "validation: caller grant empty privileges",
+			Identifier: `AccountRole|"role-name"||OnObject|TABLE|"database-name"."schema-name"."table-name"`,
+			Error:      `invalid Privileges value: , should be either a comma separated list of privileges or "ALL" / "ALL PRIVILEGES" for all privileges`,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			id, err := ParseGrantCallerPrivilegesId(tt.Identifier)
+			if tt.Error == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Expected, id)
+			} else {
+				assert.ErrorContains(t, err, tt.Error)
+			}
+		})
+	}
+}
+
+func TestGrantCallerPrivilegesIdString(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Identifier GrantCallerPrivilegesId
+		Expected   string
+	}{
+		{
+			Name: "caller grant on object to account role",
+			Identifier: GrantCallerPrivilegesId{
+				TargetRoleKind:  AccountRoleCallerGrantTargetRoleKind,
+				AccountRoleName: sdk.NewAccountObjectIdentifier("role-name"),
+				Privileges:      []string{"SELECT", "INSERT"},
+				Kind:            OnObjectCallerGrantKind,
+				Data: &OnObjectCallerGrantData{
+					ObjectType: sdk.ObjectTypeTable,
+					ObjectName: sdk.NewSchemaObjectIdentifier("database-name", "schema-name", "table-name"),
+				},
+			},
+			Expected: `AccountRole|"role-name"|SELECT,INSERT|OnObject|TABLE|"database-name"."schema-name"."table-name"`,
+		},
+		{
+			Name: "inherited caller grant in account",
+			Identifier: GrantCallerPrivilegesId{
+				TargetRoleKind:   DatabaseRoleCallerGrantTargetRoleKind,
+				DatabaseRoleName: sdk.NewDatabaseObjectIdentifier("database-name", "database-role"),
+				AllPrivileges:    true,
+				Kind:             InheritedCallerGrantKind,
+				Data: &OnSchemaObjectInheritedGrantData{
+					ObjectNamePlural: sdk.PluralObjectTypeTables,
+					Kind:             InAccountInheritedContainerKind,
+				},
+			},
+			Expected: `DatabaseRole|"database-name"."database-role"|ALL|Inherited|TABLES|InAccount`,
+		},
+		{
+			Name: "inherited caller grant in schema",
+			Identifier: GrantCallerPrivilegesId{
+				TargetRoleKind:  AccountRoleCallerGrantTargetRoleKind,
+				AccountRoleName: sdk.NewAccountObjectIdentifier("role-name"),
+				Privileges:      []string{"SELECT"},
+				Kind:            InheritedCallerGrantKind,
+				Data: &OnSchemaObjectInheritedGrantData{
+					ObjectNamePlural: sdk.PluralObjectTypeViews,
+					Kind:             InSchemaInheritedContainerKind,
+					SchemaName:       new(sdk.NewDatabaseObjectIdentifier("database-name", "schema-name")),
+				},
+			},
+			Expected: `AccountRole|"role-name"|SELECT|Inherited|VIEWS|InSchema|"database-name"."schema-name"`,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, tt.Identifier.String())
+		})
+	}
+}
@@ -54,6 +54,32 @@ func invalidateGrantsShowCache(providerCtx *provider.Context, opts *sdk.ShowGran
 	invalidateGrantsShowCacheFor(providerCtx, experimentalfeatures.GrantsShowCaching, opts)
 }
 
+// showGrantsOfRoleCached caches SHOW GRANTS OF ROLE in providerCtx.GrantShowOfRoleCache, keyed by sdk.StructToSQL(opts).
+func showGrantsOfRoleCached(ctx context.Context, providerCtx *provider.Context, roleId sdk.AccountObjectIdentifier) ([]sdk.Grant, error) {
+	opts := &sdk.ShowGrantOptions{
+		Of: &sdk.ShowGrantsOf{Role: roleId},
+	}
+	key, err := sdk.StructToSQL(opts)
+	if err != nil {
+		log.Printf("[WARN] failed to render SHOW GRANTS OF ROLE cache key, falling back to uncached SHOW: %s", err)
+		return providerCtx.Client.Grants.Show(ctx, opts)
+	}
+	return providerCtx.GrantShowOfRoleCache.GetOrLoad(ctx, key, func(loadCtx context.Context) ([]sdk.Grant, error) {
+		return providerCtx.Client.Grants.Show(loadCtx, opts)
+	})
+}
+
+func invalidateGrantsOfRoleCache(providerCtx *provider.Context, roleId sdk.AccountObjectIdentifier) {
+	key, err := sdk.StructToSQL(&sdk.ShowGrantOptions{
+		Of: &sdk.ShowGrantsOf{Role: roleId},
+	})
+	if err != nil {
+		log.Printf("[WARN] failed to render SHOW GRANTS OF ROLE cache key for invalidation: %s", err)
+		return
+	}
+	providerCtx.GrantShowOfRoleCache.Invalidate(key)
+}
+
 func isNotOwnershipGrant() func(value any, path cty.Path) diag.Diagnostics {
 	return func(value any, path cty.Path) diag.Diagnostics {
 		var diags diag.Diagnostics
@@ -111,6 +111,11 @@ func (kind InheritedContainerKind) toInheritedDatabaseRoleGrantIn(database *sdk.
 	return sdk.InheritedDatabaseRoleGrantIn{Database: grantIn.Database, Schema: grantIn.Schema}
 }
 
+func (kind InheritedContainerKind) toInheritedCallerGrantIn(database *sdk.AccountObjectIdentifier, schema *sdk.DatabaseObjectIdentifier) sdk.InheritedCallerGrantIn {
+	grantIn := kind.toInheritedAccountRoleGrantIn(database, schema)
+	return sdk.InheritedCallerGrantIn{Account: grantIn.Account, Database: grantIn.Database, Schema: grantIn.Schema}
+}
+
 // OnAccountObjectInheritedGrantData holds identifier data for an inherited grant on all
 // account objects of a given type.
 type OnAccountObjectInheritedGrantData struct {
@@ -0,0 +1,493 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/docs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/experimentalfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type GrantPrivilegesMatrixEntryStatus string
+
+const (
+	GrantPrivilegesMatrixEntryStatusGranted           GrantPrivilegesMatrixEntryStatus = "GRANTED"
+	GrantPrivilegesMatrixEntryStatusMissingPrivileges GrantPrivilegesMatrixEntryStatus = "MISSING_PRIVILEGES"
+	GrantPrivilegesMatrixEntryStatusRoleNotFound      GrantPrivilegesMatrixEntryStatus = "ROLE_NOT_FOUND"
+)
+
+var grantPrivilegesMatrixValidObjectTypes = func() []string {
+	objectTypes := slices.Concat(sdk.ValidGrantToAccountObjectTypesString, []string{sdk.ObjectTypeSchema.String()})
+	for _, objectType := range sdk.ValidGrantToSchemaObjectTypesString {
+		if !slices.Contains(objectTypes, objectType) {
+			objectTypes = append(objectTypes, objectType)
+		}
+	}
+	return objectTypes
+}()
+
+var grantPrivilegesMatrixSchema = map[string]*schema.Schema{
+	"entry": {
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		Description: "List of privileges granted to account roles on single objects. Privileges granted outside of this resource on the same objects are not revoked.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"account_role_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      relatedResourceDescription("The fully qualified name of the account role to which privileges will be granted.", resources.AccountRole),
+					ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+				},
+				"privileges": {
+					Type:        schema.TypeSet,
+					Required:    true,
+					MinItems:    1,
+					Description: "The privileges to grant on the object.",
+					Elem: &schema.Schema{
+						Type:             schema.TypeString,
+						ValidateDiagFunc: isNotOwnershipGrant(),
+					},
+				},
+				"object_type": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      fmt.Sprintf("The object type of the object on which privileges will be granted. Valid values are: %s", docs.PossibleValuesListed(grantPrivilegesMatrixValidObjectTypes)),
+					ValidateDiagFunc: StringInSlice(grantPrivilegesMatrixValidObjectTypes, true),
+				},
+				"object_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					Description:      "The fully qualified name of the object on which privileges will be granted.",
+					DiffSuppressFunc: suppressIdentifierQuoting,
+				},
+				"status": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: fmt.Sprintf("Status of the entry computed from the grants found in Snowflake. Possible values are: %s", docs.PossibleValuesListed([]string{string(GrantPrivilegesMatrixEntryStatusGranted), string(GrantPrivilegesMatrixEntryStatusMissingPrivileges), string(GrantPrivilegesMatrixEntryStatusRoleNotFound)})),
+				},
+			},
+		},
+	},
+}
+
+func GrantPrivilegesMatrix() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.GrantPrivilegesMatrixResource), TrackingCreateWrapper(resources.GrantPrivilegesMatrix, CreateGrantPrivilegesMatrix)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.GrantPrivilegesMatrixResource), TrackingUpdateWrapper(resources.GrantPrivilegesMatrix, UpdateGrantPrivilegesMatrix)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.GrantPrivilegesMatrixResource), TrackingDeleteWrapper(resources.GrantPrivilegesMatrix, DeleteGrantPrivilegesMatrix)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.GrantPrivilegesMatrixResource), TrackingReadWrapper(resources.GrantPrivilegesMatrix, ReadGrantPrivilegesMatrix)),
+		Description:   "Resource used to manage many privilege grants to account roles on single objects in one resource. Grants in Snowflake are read with one SHOW GRANTS TO ROLE per account role, and only the missing privileges are granted or revoked.",
+
+		Schema:   grantPrivilegesMatrixSchema,
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateGrantPrivilegesMatrix(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	providerCtx := meta.(*provider.Context)
+
+	entries, err := getGrantPrivilegesMatrixEntries(d.Get("entry").([]any))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current, _, err := showGrantPrivilegesMatrixCurrentPrivileges(ctx, providerCtx, entries)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// The id is set before applying the grants, so the privileges granted before a failure are still tracked in the state.
+	d.SetId(id)
+
+	toGrant, toRevoke := computeGrantPrivilegesMatrixChanges(nil, entries, current)
+	if err := applyGrantPrivilegesMatrixChanges(ctx, providerCtx, toGrant, toRevoke, false); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when granting privileges from the matrix",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
+		}
+	}
+
+	return ReadGrantPrivilegesMatrix(ctx, d, meta)
+}
+
+func UpdateGrantPrivilegesMatrix(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	providerCtx := meta.(*provider.Context)
+
+	if d.HasChange("entry") {
+		oldEntriesRaw, newEntriesRaw := d.GetChange("entry")
+		oldEntries, err := getGrantPrivilegesMatrixEntries(oldEntriesRaw.([]any))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		newEntries, err := getGrantPrivilegesMatrixEntries(newEntriesRaw.([]any))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		current, _, err := showGrantPrivilegesMatrixCurrentPrivileges(ctx, providerCtx, slices.Concat(oldEntries, newEntries))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		toGrant, toRevoke := computeGrantPrivilegesMatrixChanges(oldEntries, newEntries, current)
+		if err := applyGrantPrivilegesMatrixChanges(ctx, providerCtx, toGrant, toRevoke, false); err != nil {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "An error occurred when updating privileges from the matrix",
+					Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+				},
+			}
+		}
+	}
+
+	return ReadGrantPrivilegesMatrix(ctx, d, meta)
+}
+
+func DeleteGrantPrivilegesMatrix(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	providerCtx := meta.(*provider.Context)
+
+	entries, err := getGrantPrivilegesMatrixEntries(d.Get("entry").([]any))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The privileges in the state are already refreshed, so there is no need to show the grants again before revoking them.
+	_, toRevoke := computeGrantPrivilegesMatrixChanges(entries, nil, grantPrivilegesMatrixPrivilegesByKey(entries))
+	safely := experimentalfeatures.IsExperimentEnabled(experimentalfeatures.GrantsSafeDestroy, providerCtx.EnabledExperiments)
+	if err := applyGrantPrivilegesMatrixChanges(ctx, providerCtx, nil, toRevoke, safely); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when revoking privileges from the matrix",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func ReadGrantPrivilegesMatrix(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	providerCtx := meta.(*provider.Context)
+
+	entriesRaw := d.Get("entry").([]any)
+	entries, err := getGrantPrivilegesMatrixEntries(entriesRaw)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	current, rolesNotFound, err := showGrantPrivilegesMatrixCurrentPrivileges(ctx, providerCtx, entries)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to retrieve grants",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err),
+			},
+		}
+	}
+
+	updatedEntries := make([]map[string]any, len(entries))
+	for i, entry := range entries {
+		entryRaw := entriesRaw[i].(map[string]any)
+		// Privileges are filtered in their original form, so the casing used in the configuration is preserved.
+		actualPrivileges := make([]string, 0)
+		for _, privilege := range expandStringList(entryRaw["privileges"].(*schema.Set).List()) {
+			if isGrantPrivilegesMatrixPrivilegeGranted(current[entry.key()], privilege) {
+				actualPrivileges = append(actualPrivileges, privilege)
+			}
+		}
+
+		var status GrantPrivilegesMatrixEntryStatus
+		switch {
+		case slices.Contains(rolesNotFound, entry.RoleName):
+			status = GrantPrivilegesMatrixEntryStatusRoleNotFound
+		case len(actualPrivileges) < len(entry.Privileges):
+			status = GrantPrivilegesMatrixEntryStatusMissingPrivileges
+		default:
+			status = GrantPrivilegesMatrixEntryStatusGranted
+		}
+
+		updatedEntries[i] = map[string]any{
+			"account_role_name": entryRaw["account_role_name"],
+			"privileges":        actualPrivileges,
+			"object_type":       entryRaw["object_type"],
+			"object_name":       entryRaw["object_name"],
+			"status":            string(status),
+		}
+	}
+
+	if err := d.Set("entry", updatedEntries); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+type grantPrivilegesMatrixEntry struct {
+	RoleName   sdk.AccountObjectIdentifier
+	Privileges []string
+	ObjectType sdk.ObjectType
+	ObjectName sdk.ObjectIdentifier
+}
+
+// grantPrivilegesMatrixKey identifies all privileges granted to one account role on one object.
+type grantPrivilegesMatrixKey struct {
+	RoleName   string
+	ObjectType sdk.ObjectType
+	ObjectName string
+}
+
+func (e grantPrivilegesMatrixEntry) key() grantPrivilegesMatrixKey {
+	return grantPrivilegesMatrixKey{
+		RoleName:   e.RoleName.FullyQualifiedName(),
+		ObjectType: e.ObjectType,
+		ObjectName: e.ObjectName.FullyQualifiedName(),
+	}
+}
+
+func getGrantPrivilegesMatrixEntries(entriesRaw []any) ([]grantPrivilegesMatrixEntry, error) {
+	entries := make([]grantPrivilegesMatrixEntry, len(entriesRaw))
+	for i, entryRaw := range entriesRaw {
+		entryMap := entryRaw.(map[string]any)
+
+		roleName, err := sdk.ParseAccountObjectIdentifier(entryMap["account_role_name"].(string))
+		if err != nil {
+			return nil, err
+		}
+		objectType, err := sdk.ToObjectType(entryMap["object_type"].(string))
+		if err != nil {
+			return nil, err
+		}
+		objectName, err := getGrantPrivilegesMatrixObjectIdentifier(objectType, entryMap["object_name"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		entries[i] = grantPrivilegesMatrixEntry{
+			RoleName:   roleName,
+			Privileges: expandStringList(entryMap["privileges"].(*schema.Set).List()),
+			ObjectType: objectType,
+			ObjectName: objectName,
+		}
+	}
+	return entries, nil
+}
+
+func getGrantPrivilegesMatrixObjectIdentifier(objectType sdk.ObjectType, objectName string) (sdk.ObjectIdentifier, error) {
+	switch {
+	case slices.Contains(sdk.ValidGrantToAccountObjectTypesString, objectType.String()):
+		return sdk.ParseAccountObjectIdentifier(objectName)
+	case objectType == sdk.ObjectTypeSchema:
+		return sdk.ParseDatabaseObjectIdentifier(objectName)
+	// TODO(SNOW-1569535): use a mapper from object type to parsing function
+	case objectType.IsWithArguments():
+		return sdk.ParseSchemaObjectIdentifierWithArguments(objectName)
+	default:
+		return sdk.ParseSchemaObjectIdentifier(objectName)
+	}
+}
+
+// showGrantPrivilegesMatrixCurrentPrivileges runs one SHOW GRANTS TO ROLE per distinct account role in the entries and
+// returns the privileges granted on the objects referenced by the entries. Account roles that do not exist are returned separately.
+func showGrantPrivilegesMatrixCurrentPrivileges(ctx context.Context, providerCtx *provider.Context, entries []grantPrivilegesMatrixEntry) (map[grantPrivilegesMatrixKey][]string, []sdk.AccountObjectIdentifier, error) {
+	managedKeys := make(map[grantPrivilegesMatrixKey]bool)
+	roleNames := make([]sdk.AccountObjectIdentifier, 0)
+	for _, entry := range entries {
+		managedKeys[entry.key()] = true
+		if !slices.Contains(roleNames, entry.RoleName) {
+			roleNames = append(roleNames, entry.RoleName)
+		}
+	}
+
+	current := make(map[grantPrivilegesMatrixKey][]string)
+	rolesNotFound := make([]sdk.AccountObjectIdentifier, 0)
+	for _, roleName := range roleNames {
+		grants, err := showGrantsCached(ctx, providerCtx, &sdk.ShowGrantOptions{
+			To: &sdk.ShowGrantsTo{Role: roleName},
+		})
+		if err != nil {
+			if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+				rolesNotFound = append(rolesNotFound, roleName)
+				continue
+			}
+			return nil, nil, err
+		}
+		for key, privileges := range grantPrivilegesMatrixPrivilegesFromGrants(roleName, grants) {
+			if managedKeys[key] {
+				current[key] = privileges
+			}
+		}
+	}
+	return current, rolesNotFound, nil
+}
+
+// grantPrivilegesMatrixPrivilegesFromGrants groups the result of SHOW GRANTS TO ROLE by the object the privileges are granted on.
+func grantPrivilegesMatrixPrivilegesFromGrants(roleName sdk.AccountObjectIdentifier, grants []sdk.Grant) map[grantPrivilegesMatrixKey][]string {
+	privileges := make(map[grantPrivilegesMatrixKey][]string)
+	for _, grant := range grants {
+		if grant.GrantedOn == "" || grant.Name == nil {
+			continue
+		}
+		// Snowflake treats applications as databases, so privileges on applications are granted with `object_type = "DATABASE"`.
+		objectType := grant.GrantedOn
+		if objectType == sdk.ObjectTypeApplication {
+			objectType = sdk.ObjectTypeDatabase
+		}
+		key := grantPrivilegesMatrixKey{
+			RoleName:   roleName.FullyQualifiedName(),
+			ObjectType: objectType,
+			ObjectName: grant.Name.FullyQualifiedName(),
+		}
+		privileges[key] = append(privileges[key], grant.Privilege)
+	}
+	return privileges
+}
+
+func grantPrivilegesMatrixPrivilegesByKey(entries []grantPrivilegesMatrixEntry) map[grantPrivilegesMatrixKey][]string {
+	privileges := make(map[grantPrivilegesMatrixKey][]string)
+	for _, entry := range entries {
+		privileges[entry.key()] = append(privileges[entry.key()], entry.Privileges...)
+	}
+	return privileges
+}
+
+// isGrantPrivilegesMatrixPrivilegeGranted checks if the privilege is in the granted privileges.
+// Snowflake returns USAGE for IMPORTED PRIVILEGES grants, so they are matched as well.
+func isGrantPrivilegesMatrixPrivilegeGranted(grantedPrivileges []string, privilege string) bool {
+	return slices.ContainsFunc(grantedPrivileges, func(grantedPrivilege string) bool {
+		return strings.EqualFold(grantedPrivilege, privilege) ||
+			(strings.EqualFold(privilege, sdk.AccountObjectPrivilegeImportedPrivileges.String()) && strings.EqualFold(grantedPrivilege, sdk.AccountObjectPrivilegeUsage.String()))
+	})
+}
+
+// computeGrantPrivilegesMatrixChanges computes the minimal set of grants and revokes, grouped by account role and object.
+// Privileges are granted when they are desired, but not granted yet. They are revoked only when they were managed before,
+// are not desired anymore, and are still granted, so privileges granted outside of the resource are left untouched.
+func computeGrantPrivilegesMatrixChanges(managed []grantPrivilegesMatrixEntry, desired []grantPrivilegesMatrixEntry, current map[grantPrivilegesMatrixKey][]string) (toGrant []grantPrivilegesMatrixEntry, toRevoke []grantPrivilegesMatrixEntry) {
+	desiredPrivileges := grantPrivilegesMatrixPrivilegesByKey(desired)
+	managedPrivileges := grantPrivilegesMatrixPrivilegesByKey(managed)
+
+	processed := make(map[grantPrivilegesMatrixKey]bool)
+	for _, entry := range desired {
+		key := entry.key()
+		if processed[key] {
+			continue
+		}
+		processed[key] = true
+
+		var missingPrivileges []string
+		for _, privilege := range desiredPrivileges[key] {
+			if !isGrantPrivilegesMatrixPrivilegeGranted(current[key], privilege) && !slices.Contains(missingPrivileges, privilege) {
+				missingPrivileges = append(missingPrivileges, privilege)
+			}
+		}
+		if len(missingPrivileges) > 0 {
+			toGrant = append(toGrant, grantPrivilegesMatrixEntry{RoleName: entry.RoleName, Privileges: missingPrivileges, ObjectType: entry.ObjectType, ObjectName: entry.ObjectName})
+		}
+	}
+
+	processed = make(map[grantPrivilegesMatrixKey]bool)
+	for _, entry := range managed {
+		key := entry.key()
+		if processed[key] {
+			continue
+		}
+		processed[key] = true
+
+		var stalePrivileges []string
+		for _, privilege := range managedPrivileges[key] {
+			if slices.ContainsFunc(desiredPrivileges[key], func(desiredPrivilege string) bool { return strings.EqualFold(desiredPrivilege, privilege) }) {
+				continue
+			}
+			if isGrantPrivilegesMatrixPrivilegeGranted(current[key], privilege) && !slices.Contains(stalePrivileges, privilege) {
+				stalePrivileges = append(stalePrivileges, privilege)
+			}
+		}
+		if len(stalePrivileges) > 0 {
+			toRevoke = append(toRevoke, grantPrivilegesMatrixEntry{RoleName: entry.RoleName, Privileges: stalePrivileges, ObjectType: entry.ObjectType, ObjectName: entry.ObjectName})
+		}
+	}
+
+	return toGrant, toRevoke
+}
+
+func applyGrantPrivilegesMatrixChanges(ctx context.Context, providerCtx *provider.Context, toGrant []grantPrivilegesMatrixEntry, toRevoke []grantPrivilegesMatrixEntry, safely bool) error {
+	client := providerCtx.Client
+	revokeFunc := client.Grants.RevokePrivilegesFromAccountRole
+	if safely {
+		revokeFunc = client.Grants.RevokePrivilegesFromAccountRoleSafely
+	}
+
+	var errs []error
+	changedRoles := make([]sdk.AccountObjectIdentifier, 0)
+	for _, entry := range toRevoke {
+		privileges, on := getGrantPrivilegesMatrixEntryGrantOn(entry)
+		if err := revokeFunc(ctx, privileges, on, entry.RoleName, nil); err != nil {
+			errs = append(errs, fmt.Errorf("revoking %v on %s %s from account role %s: %w", entry.Privileges, entry.ObjectType, entry.ObjectName.FullyQualifiedName(), entry.RoleName.FullyQualifiedName(), err))
+		}
+		if !slices.Contains(changedRoles, entry.RoleName) {
+			changedRoles = append(changedRoles, entry.RoleName)
+		}
+	}
+	for _, entry := range toGrant {
+		privileges, on := getGrantPrivilegesMatrixEntryGrantOn(entry)
+		if err := client.Grants.GrantPrivilegesToAccountRole(ctx, privileges, on, entry.RoleName, nil); err != nil {
+			errs = append(errs, fmt.Errorf("granting %v on %s %s to account role %s: %w", entry.Privileges, entry.ObjectType, entry.ObjectName.FullyQualifiedName(), entry.RoleName.FullyQualifiedName(), err))
+		}
+		if !slices.Contains(changedRoles, entry.RoleName) {
+			changedRoles = append(changedRoles, entry.RoleName)
+		}
+	}
+
+	for _, roleName := range changedRoles {
+		invalidateGrantsShowCache(providerCtx, &sdk.ShowGrantOptions{
+			To: &sdk.ShowGrantsTo{Role: roleName},
+		})
+	}
+	return errors.Join(errs...)
+}
+
+func getGrantPrivilegesMatrixEntryGrantOn(entry grantPrivilegesMatrixEntry) (*sdk.AccountRoleGrantPrivileges, *sdk.AccountRoleGrantOn) {
+	switch objectName := entry.ObjectName.(type) {
+	case sdk.AccountObjectIdentifier:
+		return getAccountRolePrivileges(false, entry.Privileges, false, true, false, false), &sdk.AccountRoleGrantOn{
+			AccountObject: getGrantOnAccountObject(entry.ObjectType, objectName),
+		}
+	case sdk.DatabaseObjectIdentifier:
+		return getAccountRolePrivileges(false, entry.Privileges, false, false, true, false), &sdk.AccountRoleGrantOn{
+			Schema: &sdk.GrantOnSchema{Schema: &objectName},
+		}
+	default:
+		return getAccountRolePrivileges(false, entry.Privileges, false, false, false, true), &sdk.AccountRoleGrantOn{
+			SchemaObject: &sdk.GrantOnSchemaObject{
+				SchemaObject: &sdk.Object{
+					ObjectType: entry.ObjectType,
+					Name:       objectName,
+				},
+			},
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeGrantPrivilegesMatrixChanges(t *testing.T) {
+	roleId := sdk.NewAccountObjectIdentifier("ROLE")
+	otherRoleId := sdk.NewAccountObjectIdentifier("OTHER_ROLE")
+	databaseId := sdk.NewAccountObjectIdentifier("DATABASE")
+	tableId := sdk.NewSchemaObjectIdentifier("DATABASE", "SCHEMA", "TABLE")
+
+	entry := func(roleId sdk.AccountObjectIdentifier, objectType sdk.ObjectType, objectName sdk.ObjectIdentifier, privileges ...string) grantPrivilegesMatrixEntry {
+		return grantPrivilegesMatrixEntry{RoleName: roleId, Privileges: privileges, ObjectType: objectType, ObjectName: objectName}
+	}
+
+	testCases := []struct {
+		Name             string
+		Managed          []grantPrivilegesMatrixEntry
+		Desired          []grantPrivilegesMatrixEntry
+		Current          map[grantPrivilegesMatrixKey][]string
+		ExpectedToGrant  []grantPrivilegesMatrixEntry
+		ExpectedToRevoke []grantPrivilegesMatrixEntry
+	}{
+		{
+			Name: "grant only missing privileges",
+			Desired: []grantPrivilegesMatrixEntry{
+				entry(roleId, sdk.ObjectTypeTable, tableId, "SELECT", "INSERT"),
+				entry(roleId, sdk.ObjectTypeDatabase, databaseId, "USAGE"),
+			},
+			Current: map[grantPrivilegesMatrixKey][]string{
+				entry(roleId, sdk.ObjectTypeTable, tableId).key(): {"SELECT"},
+			},
+			ExpectedToGrant: []grantPrivilegesMatrixEntry{
+				entry(roleId, sdk.ObjectTypeTable, tableId, "INSERT"),
+				entry(roleId, sdk.ObjectTypeDatabase, databaseId, "USAGE"),
+			},
+		},
+		{
+			Name: "nothing to do when in sync",
+			Managed: []grantPrivilegesMatrixEntry{
+				entry(roleId, sdk.ObjectTypeTable, tableId, "SELECT"),
+			},
+			Desired: []grantPrivilegesMatrixEntry{
+				entry(roleId, sdk.ObjectTypeTable, tableId, "select"),
+			},
+			Current: map[grantPrivilegesMatrixKey][]string{
+				entry(roleId, sdk.ObjectTypeTable, tableId).key(): {"SELECT", "UPDATE"},
+			},
+		},
+		{
+			Name: "duplicated entries are merged into one grant",
+			Desired: []grantPrivilegesMatrixEntry{
+				entry(roleId, sdk.ObjectTypeTable, tableId, "SELECT"),
+				entry(roleId, sdk.ObjectTypeTable, tableId, "INSERT", "SELECT"),
+				entry(otherRoleId, sdk.ObjectTypeTable, tableId, "SELECT"),
+			},
+			ExpectedToGrant: []grantPrivilegesMatrixEntry{
+				entry(roleId, sdk.ObjectTypeTable, tableId, "SELECT", "INSERT"),
+				entry(otherRoleId, sdk.ObjectTypeTable, tableId, "SELECT"),
+			},
+		},
+		{
+			Name: "revoke only managed privileges that are still granted",
+			Managed: []grantPrivilegesMatrixEntry{
+				entry(roleId, sdk.ObjectTypeTable, tableId, "SELECT", "INSERT", "DELETE"),
+				entry(otherRoleId, sdk.ObjectTypeDatabase, databaseId, "USAGE"),
+			},
+			Desired: []grantPrivilegesMatrixEntry{
+				entry(roleId, sdk.ObjectTypeTable, tableId, "SELECT"),
+			},
+			Current: map[grantPrivilegesMatrixKey][]string{
+				entry(roleId, sdk.ObjectTypeTable, tableId).key():            {"SELECT", "INSERT", "UPDATE"},
+				entry(otherRoleId, sdk.ObjectTypeDatabase, databaseId).key(): {"USAGE"},
+			},
+			ExpectedToRevoke: []grantPrivilegesMatrixEntry{
+				entry(roleId, sdk.ObjectTypeTable, tableId, "INSERT"),
+				entry(otherRoleId, sdk.ObjectTypeDatabase, databaseId, "USAGE"),
+			},
+		},
+		{
+			Name: "imported privileges are matched with usage",
+			Managed: []grantPrivilegesMatrixEntry{
+				entry(otherRoleId, sdk.ObjectTypeDatabase, databaseId, "IMPORTED PRIVILEGES"),
+			},
+			Desired: []grantPrivilegesMatrixEntry{
+				entry(roleId, sdk.ObjectTypeDatabase, databaseId, "IMPORTED PRIVILEGES"),
+			},
+			Current: map[grantPrivilegesMatrixKey][]string{
+				entry(roleId, sdk.ObjectTypeDatabase, databaseId).key():      {"USAGE"},
+				entry(otherRoleId, sdk.ObjectTypeDatabase, databaseId).key(): {"USAGE"},
+			},
+			ExpectedToRevoke: []grantPrivilegesMatrixEntry{
+				entry(otherRoleId, sdk.ObjectTypeDatabase, databaseId, "IMPORTED PRIVILEGES"),
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			toGrant, toRevoke := computeGrantPrivilegesMatrixChanges(tt.Managed, tt.Desired, tt.Current)
+			assert.Equal(t, tt.ExpectedToGrant, toGrant)
+			assert.Equal(t, tt.ExpectedToRevoke, toRevoke)
+		})
+	}
+}
+
+func TestGrantPrivilegesMatrixPrivilegesFromGrants(t *testing.T) {
+	roleId := sdk.NewAccountObjectIdentifier("ROLE")
+	tableId := sdk.NewSchemaObjectIdentifier("DATABASE", "SCHEMA", "TABLE")
+	applicationId := sdk.NewAccountObjectIdentifier("APPLICATION")
+
+	grants := []sdk.Grant{
+		{Privilege: "SELECT", GrantedOn: sdk.ObjectTypeTable, Name: tableId},
+		{Privilege: "INSERT", GrantedOn: sdk.ObjectTypeTable, Name: tableId},
+		{Privilege: "USAGE", GrantedOn: sdk.ObjectTypeApplication, Name: applicationId},
+		{Privilege: "SELECT", GrantOn: sdk.ObjectTypeTable, Name: sdk.NewDatabaseObjectIdentifier("DATABASE", "SCHEMA")},
+	}
+
+	privileges := grantPrivilegesMatrixPrivilegesFromGrants(roleId, grants)
+
+	assert.Equal(t, map[grantPrivilegesMatrixKey][]string{
+		{RoleName: roleId.FullyQualifiedName(), ObjectType: sdk.ObjectTypeTable, ObjectName: tableId.FullyQualifiedName()}:          {"SELECT", "INSERT"},
+		{RoleName: roleId.FullyQualifiedName(), ObjectType: sdk.ObjectTypeDatabase, ObjectName: applicationId.FullyQualifiedName()}: {"USAGE"},
+	}, privileges)
+}
@@ -1312,8 +1312,6 @@ func getAccountRoleGrantOn(d *schema.ResourceData) (*sdk.AccountRoleGrantOn, err
 	case onAccountObjectOk:
 		onAccountObject := onAccountObjectBlock.([]any)[0].(map[string]any)
 
-		grantOnAccountObject := new(sdk.GrantOnAccountObject)
-
 		objectType, err := sdk.ToObjectType(onAccountObject["object_type"].(string))
 		if err != nil {
 			return nil, err
@@ -1324,32 +1322,7 @@ func getAccountRoleGrantOn(d *schema.ResourceData) (*sdk.AccountRoleGrantOn, err
 			return nil, err
 		}
 
-		switch objectType {
-		case sdk.ObjectTypeDatabase:
-			grantOnAccountObject.Database = &objectIdentifier
-		case sdk.ObjectTypeConnection:
-			grantOnAccountObject.Connection = &objectIdentifier
-		case sdk.ObjectTypeFailoverGroup:
-			grantOnAccountObject.FailoverGroup = &objectIdentifier
-		case sdk.ObjectTypeIntegration:
-			grantOnAccountObject.Integration = &objectIdentifier
-		case sdk.ObjectTypeReplicationGroup:
-			grantOnAccountObject.ReplicationGroup = &objectIdentifier
-		case sdk.ObjectTypeResourceMonitor:
-			grantOnAccountObject.ResourceMonitor = &objectIdentifier
-		case sdk.ObjectTypeUser:
-			grantOnAccountObject.User = &objectIdentifier
-		case sdk.ObjectTypeWarehouse:
-			grantOnAccountObject.Warehouse = &objectIdentifier
-		case sdk.ObjectTypeComputePool:
-			grantOnAccountObject.ComputePool = &objectIdentifier
-		case sdk.ObjectTypeExternalVolume:
-			grantOnAccountObject.ExternalVolume = &objectIdentifier
-		case sdk.ObjectTypeSnowflakeIntelligence:
-			grantOnAccountObject.SnowflakeIntelligence = &objectIdentifier
-		}
-
-		on.AccountObject = grantOnAccountObject
+		on.AccountObject = getGrantOnAccountObject(objectType, objectIdentifier)
 	case onSchemaOk:
 		onSchema := onSchemaBlock.([]any)[0].(map[string]any)
 
@@ -1446,6 +1419,38 @@ func getAccountRoleGrantOn(d *schema.ResourceData) (*sdk.AccountRoleGrantOn, err
 	return on, nil
 }
 
+// getGrantOnAccountObject maps the account object type and identifier to the matching field of sdk.GrantOnAccountObject.
+func getGrantOnAccountObject(objectType sdk.ObjectType, objectIdentifier sdk.AccountObjectIdentifier) *sdk.GrantOnAccountObject {
+	grantOnAccountObject := new(sdk.GrantOnAccountObject)
+
+	switch objectType {
+	case sdk.ObjectTypeDatabase:
+		grantOnAccountObject.Database = &objectIdentifier
+	case sdk.ObjectTypeConnection:
+		grantOnAccountObject.Connection = &objectIdentifier
+	case sdk.ObjectTypeFailoverGroup:
+		grantOnAccountObject.FailoverGroup = &objectIdentifier
+	case sdk.ObjectTypeIntegration:
+		grantOnAccountObject.Integration = &objectIdentifier
+	case sdk.ObjectTypeReplicationGroup:
+		grantOnAccountObject.ReplicationGroup = &objectIdentifier
+	case sdk.ObjectTypeResourceMonitor:
+		grantOnAccountObject.ResourceMonitor = &objectIdentifier
+	case sdk.ObjectTypeUser:
+		grantOnAccountObject.User = &objectIdentifier
+	case sdk.ObjectTypeWarehouse:
+		grantOnAccountObject.Warehouse = &objectIdentifier
+	case sdk.ObjectTypeComputePool:
+		grantOnAccountObject.ComputePool = &objectIdentifier
+	case sdk.ObjectTypeExternalVolume:
+		grantOnAccountObject.ExternalVolume = &objectIdentifier
+	case sdk.ObjectTypeSnowflakeIntelligence:
+		grantOnAccountObject.SnowflakeIntelligence = &objectIdentifier
+	}
+
+	return grantOnAccountObject
+}
+
 // grantAccountRolePrivileges grants the given privileges, dispatching to the inherited-grant SQL
 // (GRANT INHERITED ...) when the grant kind is inherited, and to the regular GRANT otherwise.
 func grantAccountRolePrivileges(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id GrantPrivilegesToAccountRoleId, privileges *sdk.AccountRoleGrantPrivileges, withGrantOption bool) error {
@@ -0,0 +1,975 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/docs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider/validators"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/experimentalfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var grantPrivilegesToApplicationRoleGrantTargets = []string{
+	"on_account",
+	"on_account_object",
+	"on_schema",
+	"on_schema_object",
+}
+
+var grantPrivilegesToApplicationRoleSchema = map[string]*schema.Schema{
+	"application_role_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "The fully qualified name of the application role to which privileges will be granted (`\"<application_name>\".\"<application_role_name>\"`).",
+		ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"privileges": {
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "The privileges to grant on the application role.",
+		MinItems:    1,
+		ExactlyOneOf: []string{
+			"privileges",
+			"all_privileges",
+		},
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+			ValidateDiagFunc: validation.AllDiag(
+				isNotOwnershipGrant(),
+				validators.NormalizeValidation(sdk.ToPrivilege),
+			),
+		},
+	},
+	"all_privileges": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "Grant all privileges on the application role.",
+		ExactlyOneOf: []string{
+			"privileges",
+			"all_privileges",
+		},
+	},
+	"with_grant_option": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		ForceNew:    true,
+		Description: "If specified, allows the recipient role to grant the privileges to other roles.",
+	},
+	"always_apply": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Default:     false,
+		Description: "If true, the resource will always produce a “plan” and on “apply” it will re-grant defined privileges. It is supposed to be used only in “grant privileges on all X’s in database / schema Y” or “grant all privileges to X” scenarios to make sure that every new object in a given database / schema is granted by the application role and every new privilege is granted to the application role. Important note: this flag is not compliant with the Terraform assumptions of the config being eventually convergent (producing an empty plan).",
+	},
+	"always_apply_trigger": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "",
+		Description: "This is a helper field and should not be set. Its main purpose is to help to achieve the functionality described by the always_apply field.",
+	},
+	"on_account": {
+		Type:         schema.TypeBool,
+		Optional:     true,
+		Default:      false,
+		ForceNew:     true,
+		Description:  "If true, the privileges will be granted on the account.",
+		ExactlyOneOf: grantPrivilegesToApplicationRoleGrantTargets,
+	},
+	"on_account_object": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Specifies the account object on which privileges will be granted.",
+		MaxItems:     1,
+		ExactlyOneOf: grantPrivilegesToApplicationRoleGrantTargets,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Description:  fmt.Sprintf("The object type of the account object on which privileges will be granted. Valid values are: %s", docs.PossibleValuesListed(sdk.ValidGrantToAccountObjectTypesString)),
+					ValidateFunc: validation.StringInSlice(sdk.ValidGrantToAccountObjectTypesString, true),
+				},
+				"object_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      "The fully qualified name of the object on which privileges will be granted.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+				},
+			},
+		},
+	},
+	"on_schema": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Specifies the schema on which privileges will be granted.",
+		MaxItems:     1,
+		ExactlyOneOf: grantPrivilegesToApplicationRoleGrantTargets,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"schema_name": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					Description:      "The fully qualified name of the schema.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.DatabaseObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ExactlyOneOf: []string{
+						"on_schema.0.schema_name",
+						"on_schema.0.all_schemas_in_database",
+						"on_schema.0.future_schemas_in_database",
+					},
+				},
+				"all_schemas_in_database": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					Description:      "The fully qualified name of the database.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ExactlyOneOf: []string{
+						"on_schema.0.schema_name",
+						"on_schema.0.all_schemas_in_database",
+						"on_schema.0.future_schemas_in_database",
+					},
+				},
+				"future_schemas_in_database": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					Description:      "The fully qualified name of the database.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ExactlyOneOf: []string{
+						"on_schema.0.schema_name",
+						"on_schema.0.all_schemas_in_database",
+						"on_schema.0.future_schemas_in_database",
+					},
+				},
+			},
+		},
+	},
+	"on_schema_object": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "Specifies the schema object on which privileges will be granted.",
+		MaxItems:     1,
+		ExactlyOneOf: grantPrivilegesToApplicationRoleGrantTargets,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"object_type": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: fmt.Sprintf("The object type of the schema object on which privileges will be granted. Valid values are: %s", strings.Join(sdk.ValidGrantToSchemaObjectTypesString, " | ")),
+					RequiredWith: []string{
+						"on_schema_object.0.object_name",
+					},
+					ConflictsWith: []string{
+						"on_schema_object.0.all",
+						"on_schema_object.0.future",
+					},
+					ValidateDiagFunc: StringInSlice(sdk.ValidGrantToSchemaObjectTypesString, true),
+				},
+				"object_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "The fully qualified name of the object on which privileges will be granted.",
+					RequiredWith: []string{
+						"on_schema_object.0.object_type",
+					},
+					ExactlyOneOf: []string{
+						"on_schema_object.0.object_name",
+						"on_schema_object.0.all",
+						"on_schema_object.0.future",
+					},
+					DiffSuppressFunc: suppressIdentifierQuoting,
+				},
+				"all": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Configures the privilege to be granted on all objects in either a database or schema.",
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: getGrantPrivilegesOnDatabaseRoleBulkOperationSchema(sdk.ValidGrantToAllPluralObjectTypesString, "all"),
+					},
+					ConflictsWith: []string{
+						"on_schema_object.0.object_type",
+					},
+					ExactlyOneOf: []string{
+						"on_schema_object.0.object_name",
+						"on_schema_object.0.all",
+						"on_schema_object.0.future",
+					},
+				},
+				"future": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Configures the privilege to be granted on future objects in either a database or schema.",
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: getGrantPrivilegesOnDatabaseRoleBulkOperationSchema(sdk.ValidGrantToFuturePluralObjectTypesString, "future"),
+					},
+					ConflictsWith: []string{
+						"on_schema_object.0.object_type",
+					},
+					ExactlyOneOf: []string{
+						"on_schema_object.0.object_name",
+						"on_schema_object.0.all",
+						"on_schema_object.0.future",
+					},
+				},
+			},
+		},
+	},
+}
+
+func GrantPrivilegesToApplicationRole() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.GrantPrivilegesToApplicationRoleResource), TrackingCreateWrapper(resources.GrantPrivilegesToApplicationRole, CreateGrantPrivilegesToApplicationRole)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.GrantPrivilegesToApplicationRoleResource), TrackingUpdateWrapper(resources.GrantPrivilegesToApplicationRole, UpdateGrantPrivilegesToApplicationRole)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.GrantPrivilegesToApplicationRoleResource), TrackingDeleteWrapper(resources.GrantPrivilegesToApplicationRole, DeleteGrantPrivilegesToApplicationRole)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.GrantPrivilegesToApplicationRoleResource), TrackingReadWrapper(resources.GrantPrivilegesToApplicationRole, ReadGrantPrivilegesToApplicationRole)),
+		Description:   "Resource used to manage privileges granted to an application role. Application roles can be granted privileges only by the application itself (e.g. in the setup script of a Native App) or by the application owner. For more information, check [application roles](https://docs.snowflake.com/en/developer-guide/native-apps/creating-setup-script#create-application-roles) documentation.",
+
+		Schema: grantPrivilegesToApplicationRoleSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.GrantPrivilegesToApplicationRole, ImportGrantPrivilegesToApplicationRole),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportGrantPrivilegesToApplicationRole(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	id, err := ParseGrantPrivilegesToApplicationRoleId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	err = errors.Join(
+		d.Set("application_role_name", id.ApplicationRoleName.FullyQualifiedName()),
+		d.Set("with_grant_option", id.WithGrantOption),
+		d.Set("always_apply", id.AlwaysApply),
+		d.Set("all_privileges", id.AllPrivileges),
+		d.Set("privileges", id.Privileges),
+		d.Set("on_account", false),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	switch id.Kind {
+	case OnAccountApplicationRoleGrantKind:
+		if err := d.Set("on_account", true); err != nil {
+			return nil, err
+		}
+	case OnAccountObjectApplicationRoleGrantKind:
+		data := id.Data.(*OnAccountObjectGrantData)
+		onAccountObject := map[string]any{
+			"object_type": data.ObjectType.String(),
+			"object_name": data.ObjectName.FullyQualifiedName(),
+		}
+
+		if err := d.Set("on_account_object", []any{onAccountObject}); err != nil {
+			return nil, err
+		}
+	case OnSchemaApplicationRoleGrantKind:
+		data := id.Data.(*OnSchemaGrantData)
+		onSchema := make(map[string]any)
+
+		switch data.Kind {
+		case OnSchemaSchemaGrantKind:
+			onSchema["schema_name"] = data.SchemaName.FullyQualifiedName()
+		case OnAllSchemasInDatabaseSchemaGrantKind:
+			onSchema["all_schemas_in_database"] = data.DatabaseName.FullyQualifiedName()
+		case OnFutureSchemasInDatabaseSchemaGrantKind:
+			onSchema["future_schemas_in_database"] = data.DatabaseName.FullyQualifiedName()
+		}
+
+		if err := d.Set("on_schema", []any{onSchema}); err != nil {
+			return nil, err
+		}
+	case OnSchemaObjectApplicationRoleGrantKind:
+		data := id.Data.(*OnSchemaObjectGrantData)
+		onSchemaObject := make(map[string]any)
+
+		switch data.Kind {
+		case OnObjectSchemaObjectGrantKind:
+			onSchemaObject["object_type"] = data.Object.ObjectType.String()
+			onSchemaObject["object_name"] = data.Object.Name.FullyQualifiedName()
+		case OnAllSchemaObjectGrantKind, OnFutureSchemaObjectGrantKind:
+			bulkOperation := map[string]any{
+				"object_type_plural": data.OnAllOrFuture.ObjectNamePlural.String(),
+			}
+			switch data.OnAllOrFuture.Kind {
+			case InDatabaseBulkOperationGrantKind:
+				bulkOperation["in_database"] = data.OnAllOrFuture.Database.FullyQualifiedName()
+			case InSchemaBulkOperationGrantKind:
+				bulkOperation["in_schema"] = data.OnAllOrFuture.Schema.FullyQualifiedName()
+			}
+
+			if data.Kind == OnAllSchemaObjectGrantKind {
+				onSchemaObject["all"] = []any{bulkOperation}
+			} else {
+				onSchemaObject["future"] = []any{bulkOperation}
+			}
+		}
+
+		if err := d.Set("on_schema_object", []any{onSchemaObject}); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateGrantPrivilegesToApplicationRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	providerCtx := meta.(*provider.Context)
+	client := providerCtx.Client
+
+	id, err := createGrantPrivilegesToApplicationRoleIdFromSchema(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = grantApplicationRolePrivileges(
+		ctx,
+		client,
+		d,
+		*id,
+		getApplicationRolePrivilegesFromSchema(d),
+		d.Get("with_grant_option").(bool),
+	)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when granting privileges to application role",
+				Detail:   fmt.Sprintf("Id: %s\nApplication role name: %s\nError: %s", id.String(), id.ApplicationRoleName, err.Error()),
+			},
+		}
+	}
+
+	d.SetId(id.String())
+
+	invalidateOpts, _ := prepareShowGrantsRequestForApplicationRole(*id)
+	invalidateGrantsShowCache(providerCtx, invalidateOpts)
+
+	return ReadGrantPrivilegesToApplicationRole(ctx, d, meta)
+}
+
+func UpdateGrantPrivilegesToApplicationRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	providerCtx := meta.(*provider.Context)
+	client := providerCtx.Client
+	id, err := ParseGrantPrivilegesToApplicationRoleId(d.Id())
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to parse internal identifier",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
+		}
+	}
+
+	if d.HasChange("with_grant_option") {
+		id.WithGrantOption = d.Get("with_grant_option").(bool)
+	}
+
+	// handle all_privileges -> privileges change (revoke all privileges)
+	if d.HasChange("all_privileges") {
+		_, allPrivileges := d.GetChange("all_privileges")
+
+		if !allPrivileges.(bool) {
+			err = revokeApplicationRolePrivileges(
+				ctx,
+				client,
+				d,
+				id,
+				&sdk.ApplicationRoleGrantPrivileges{
+					AllPrivileges: sdk.Bool(true),
+				},
+				new(sdk.RevokePrivilegesFromApplicationRoleOptions),
+				false,
+			)
+			if err != nil {
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "Failed to revoke all privileges",
+						Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+					},
+				}
+			}
+		}
+
+		id.AllPrivileges = allPrivileges.(bool)
+	}
+
+	if d.HasChange("privileges") {
+		shouldHandlePrivilegesChange := true
+
+		// Skip if all_privileges was set to true
+		if d.HasChange("all_privileges") {
+			if _, allPrivileges := d.GetChange("all_privileges"); allPrivileges.(bool) {
+				shouldHandlePrivilegesChange = false
+				id.Privileges = []string{}
+			}
+		}
+
+		if shouldHandlePrivilegesChange {
+			before, after := d.GetChange("privileges")
+			privilegesBeforeChange := expandStringList(before.(*schema.Set).List())
+			privilegesAfterChange := expandStringList(after.(*schema.Set).List())
+
+			var privilegesToAdd, privilegesToRemove []string
+
+			for _, privilegeBeforeChange := range privilegesBeforeChange {
+				if !slices.Contains(privilegesAfterChange, privilegeBeforeChange) {
+					privilegesToRemove = append(privilegesToRemove, privilegeBeforeChange)
+				}
+			}
+
+			for _, privilegeAfterChange := range privilegesAfterChange {
+				if !slices.Contains(privilegesBeforeChange, privilegeAfterChange) {
+					privilegesToAdd = append(privilegesToAdd, privilegeAfterChange)
+				}
+			}
+
+			if len(privilegesToAdd) > 0 {
+				privilegesToGrant := getApplicationRolePrivileges(false, privilegesToAdd, id.Kind)
+
+				if !id.WithGrantOption {
+					if err = revokeApplicationRolePrivileges(ctx, client, d, id, privilegesToGrant, &sdk.RevokePrivilegesFromApplicationRoleOptions{
+						GrantOptionFor: sdk.Bool(true),
+					}, false); err != nil {
+						return diag.Diagnostics{
+							diag.Diagnostic{
+								Severity: diag.Error,
+								Summary:  "Failed to revoke privileges to add",
+								Detail:   fmt.Sprintf("Id: %s\nPrivileges to add: %v\nError: %s", d.Id(), privilegesToAdd, err.Error()),
+							},
+						}
+					}
+				}
+
+				err = grantApplicationRolePrivileges(ctx, client, d, id, privilegesToGrant, id.WithGrantOption)
+				if err != nil {
+					return diag.Diagnostics{
+						diag.Diagnostic{
+							Severity: diag.Error,
+							Summary:  "Failed to grant added privileges",
+							Detail:   fmt.Sprintf("Id: %s\nPrivileges to add: %v\nError: %s", d.Id(), privilegesToAdd, err.Error()),
+						},
+					}
+				}
+			}
+
+			if len(privilegesToRemove) > 0 {
+				err = revokeApplicationRolePrivileges(
+					ctx,
+					client,
+					d,
+					id,
+					getApplicationRolePrivileges(false, privilegesToRemove, id.Kind),
+					new(sdk.RevokePrivilegesFromApplicationRoleOptions),
+					false,
+				)
+				if err != nil {
+					return diag.Diagnostics{
+						diag.Diagnostic{
+							Severity: diag.Error,
+							Summary:  "Failed to revoke removed privileges",
+							Detail:   fmt.Sprintf("Id: %s\nPrivileges to remove: %v\nError: %s", d.Id(), privilegesToRemove, err.Error()),
+						},
+					}
+				}
+			}
+
+			id.Privileges = privilegesAfterChange
+		}
+	}
+
+	// handle privileges -> all_privileges change (grant all privileges)
+	if d.HasChange("all_privileges") {
+		_, allPrivileges := d.GetChange("all_privileges")
+
+		if allPrivileges.(bool) {
+			err = grantApplicationRolePrivileges(
+				ctx,
+				client,
+				d,
+				id,
+				&sdk.ApplicationRoleGrantPrivileges{
+					AllPrivileges: sdk.Bool(true),
+				},
+				false,
+			)
+			if err != nil {
+				return diag.Diagnostics{
+					diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "Failed to grant all privileges",
+						Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+					},
+				}
+			}
+		}
+
+		id.AllPrivileges = allPrivileges.(bool)
+	}
+
+	if d.HasChange("always_apply") {
+		id.AlwaysApply = d.Get("always_apply").(bool)
+	}
+
+	if id.AlwaysApply {
+		err = grantApplicationRolePrivileges(
+			ctx,
+			client,
+			d,
+			id,
+			getApplicationRolePrivilegesFromSchema(d),
+			id.WithGrantOption,
+		)
+		if err != nil {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Always apply. An error occurred when granting privileges to application role",
+					Detail:   fmt.Sprintf("Id: %s\nApplication role name: %s\nError: %s", d.Id(), id.ApplicationRoleName, err.Error()),
+				},
+			}
+		}
+	}
+
+	d.SetId(id.String())
+
+	invalidateOpts, _ := prepareShowGrantsRequestForApplicationRole(id)
+	invalidateGrantsShowCache(providerCtx, invalidateOpts)
+
+	return ReadGrantPrivilegesToApplicationRole(ctx, d, meta)
+}
+
+func DeleteGrantPrivilegesToApplicationRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	providerCtx := meta.(*provider.Context)
+	client := providerCtx.Client
+	id, err := ParseGrantPrivilegesToApplicationRoleId(d.Id())
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to parse internal identifier",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
+		}
+	}
+
+	privileges := getApplicationRolePrivilegesFromSchema(d)
+	safely := experimentalfeatures.IsExperimentEnabled(experimentalfeatures.GrantsSafeDestroy, providerCtx.EnabledExperiments)
+	err = revokeApplicationRolePrivileges(ctx, client, d, id, privileges, &sdk.RevokePrivilegesFromApplicationRoleOptions{}, safely)
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "An error occurred when revoking privileges from application role",
+				Detail:   fmt.Sprintf("Id: %s\nApplication role name: %s\nError: %s", d.Id(), id.ApplicationRoleName, err.Error()),
+			},
+		}
+	}
+	invalidateOpts, _ := prepareShowGrantsRequestForApplicationRole(id)
+	invalidateGrantsShowCache(providerCtx, invalidateOpts)
+
+	d.SetId("")
+
+	return nil
+}
+
+func ReadGrantPrivilegesToApplicationRole(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	id, err := ParseGrantPrivilegesToApplicationRoleId(d.Id())
+	if err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to parse internal identifier",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
+		}
+	}
+
+	if id.AlwaysApply {
+		// Change the value of always_apply_trigger to produce a plan (see ReadGrantPrivilegesToDatabaseRole for more details).
+		triggerId, err := uuid.GenerateUUID()
+		if err != nil {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to generate UUID",
+					Detail:   fmt.Sprintf("Original error: %s", err.Error()),
+				},
+			}
+		}
+
+		if err := d.Set("always_apply_trigger", triggerId); err != nil {
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Error setting always_apply_trigger for application role",
+					Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+				},
+			}
+		}
+	}
+
+	if id.AllPrivileges {
+		log.Printf("[INFO] Show with all_privileges option is skipped. No changes in privileges in Snowflake will be detected. Consider specifying all privileges in 'privileges' block.")
+		return nil
+	}
+
+	opts, grantedOn := prepareShowGrantsRequestForApplicationRole(id)
+	if opts == nil {
+		return nil
+	}
+
+	providerCtx := meta.(*provider.Context)
+	client := providerCtx.Client
+	if _, err := client.ApplicationRoles.ShowByID(ctx, id.ApplicationRoleName); err != nil && errors.Is(err, sdk.ErrObjectNotFound) {
+		d.SetId("")
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to retrieve application role. Marking the resource as removed.",
+				Detail:   fmt.Sprintf("Id: %s", d.Id()),
+			},
+		}
+	}
+
+	grants, err := showGrantsCached(ctx, providerCtx, opts)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to retrieve grants. Target object not found. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Id: %s", d.Id()),
+				},
+			}
+		}
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to retrieve grants",
+				Detail:   fmt.Sprintf("Id: %s\nError: %s", d.Id(), err.Error()),
+			},
+		}
+	}
+
+	privileges := computeApplicationRolePrivileges(id, grants, grantedOn, opts)
+
+	if err := d.Set("privileges", privileges); err != nil {
+		return diag.Diagnostics{
+			diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Error setting privileges for application role",
+				Detail:   fmt.Sprintf("Id: %s\nPrivileges: %v\nError: %s", d.Id(), privileges, err.Error()),
+			},
+		}
+	}
+
+	return nil
+}
+
+func computeApplicationRolePrivileges(id GrantPrivilegesToApplicationRoleId, grants []sdk.Grant, grantedOn *sdk.ObjectType, opts *sdk.ShowGrantOptions) (privileges []string) {
+	for _, grant := range grants {
+		// Accept only APPLICATION ROLEs
+		if grant.GrantTo != sdk.ObjectTypeApplicationRole && grant.GrantedTo != sdk.ObjectTypeApplicationRole {
+			continue
+		}
+		// Only consider privileges that are already present in the ID, so we
+		// don't delete privileges managed by other resources.
+		if !slices.Contains(id.Privileges, grant.Privilege) {
+			continue
+		}
+		if id.WithGrantOption != grant.GrantOption || !isApplicationRoleGrantee(id.ApplicationRoleName, grant.GranteeName) {
+			continue
+		}
+		// Future grants do not have grantedBy, only current grants do.
+		if (opts.Future == nil || !*opts.Future) && grant.GrantedBy.Name() == "" {
+			continue
+		}
+		// grant_on is for future grants, granted_on is for current grants.
+		if *grantedOn == grant.GrantedOn || *grantedOn == grant.GrantOn {
+			privileges = append(privileges, grant.Privilege)
+		}
+	}
+
+	return privileges
+}
+
+// isApplicationRoleGrantee checks the grantee name returned by SHOW GRANTS, which may hold only the application role name or
+// the name prefixed with the application name.
+func isApplicationRoleGrantee(applicationRoleName sdk.DatabaseObjectIdentifier, granteeName sdk.ObjectIdentifier) bool {
+	return granteeName.Name() == applicationRoleName.Name() ||
+		granteeName.Name() == fmt.Sprintf("%s.%s", applicationRoleName.DatabaseName(), applicationRoleName.Name())
+}
+
+func prepareShowGrantsRequestForApplicationRole(id GrantPrivilegesToApplicationRoleId) (*sdk.ShowGrantOptions, *sdk.ObjectType) {
+	opts := new(sdk.ShowGrantOptions)
+	var grantedOn sdk.ObjectType
+
+	switch id.Kind {
+	case OnAccountApplicationRoleGrantKind:
+		grantedOn = sdk.ObjectTypeAccount
+		opts.On = &sdk.ShowGrantsOn{
+			Account: sdk.Bool(true),
+		}
+	case OnAccountObjectApplicationRoleGrantKind:
+		data := id.Data.(*OnAccountObjectGrantData)
+		grantedOn = data.ObjectType
+		opts.On = &sdk.ShowGrantsOn{
+			Object: &sdk.Object{
+				ObjectType: data.ObjectType,
+				Name:       data.ObjectName,
+			},
+		}
+	case OnSchemaApplicationRoleGrantKind:
+		grantedOn = sdk.ObjectTypeSchema
+		data := id.Data.(*OnSchemaGrantData)
+
+		switch data.Kind {
+		case OnSchemaSchemaGrantKind:
+			opts.On = &sdk.ShowGrantsOn{
+				Object: &sdk.Object{
+					ObjectType: sdk.ObjectTypeSchema,
+					Name:       data.SchemaName,
+				},
+			}
+		case OnAllSchemasInDatabaseSchemaGrantKind:
+			log.Printf("[INFO] Show with on_schema.all_schemas_in_database option is skipped. No changes in privileges in Snowflake will be detected.")
+			return nil, nil
+		case OnFutureSchemasInDatabaseSchemaGrantKind:
+			opts.Future = sdk.Bool(true)
+			opts.In = &sdk.ShowGrantsIn{
+				Database: data.DatabaseName,
+			}
+		}
+	case OnSchemaObjectApplicationRoleGrantKind:
+		data := id.Data.(*OnSchemaObjectGrantData)
+
+		switch data.Kind {
+		case OnObjectSchemaObjectGrantKind:
+			grantedOn = data.Object.ObjectType
+			opts.On = &sdk.ShowGrantsOn{
+				Object: data.Object,
+			}
+		case OnAllSchemaObjectGrantKind:
+			log.Printf("[INFO] Show with on_schema_object.on_all option is skipped. No changes in privileges in Snowflake will be detected.")
+			return nil, nil
+		case OnFutureSchemaObjectGrantKind:
+			grantedOn = data.OnAllOrFuture.ObjectNamePlural.Singular()
+			opts.Future = sdk.Bool(true)
+
+			switch data.OnAllOrFuture.Kind {
+			case InDatabaseBulkOperationGrantKind:
+				opts.In = &sdk.ShowGrantsIn{
+					Database: data.OnAllOrFuture.Database,
+				}
+			case InSchemaBulkOperationGrantKind:
+				opts.In = &sdk.ShowGrantsIn{
+					Schema: data.OnAllOrFuture.Schema,
+				}
+			}
+		}
+	}
+
+	return opts, &grantedOn
+}
+
+func getApplicationRolePrivilegesFromSchema(d *schema.ResourceData) *sdk.ApplicationRoleGrantPrivileges {
+	privileges := getAccountRolePrivilegesFromSchema(d)
+	return &sdk.ApplicationRoleGrantPrivileges{
+		GlobalPrivileges:        privileges.GlobalPrivileges,
+		AccountObjectPrivileges: privileges.AccountObjectPrivileges,
+		SchemaPrivileges:        privileges.SchemaPrivileges,
+		SchemaObjectPrivileges:  privileges.SchemaObjectPrivileges,
+		AllPrivileges:           privileges.AllPrivileges,
+	}
+}
+
+func getApplicationRolePrivileges(allPrivileges bool, privileges []string, kind ApplicationRoleGrantKind) *sdk.ApplicationRoleGrantPrivileges {
+	accountRolePrivileges := getAccountRolePrivileges(
+		allPrivileges,
+		privileges,
+		kind == OnAccountApplicationRoleGrantKind,
+		kind == OnAccountObjectApplicationRoleGrantKind,
+		kind == OnSchemaApplicationRoleGrantKind,
+		kind == OnSchemaObjectApplicationRoleGrantKind,
+	)
+	return &sdk.ApplicationRoleGrantPrivileges{
+		GlobalPrivileges:        accountRolePrivileges.GlobalPrivileges,
+		AccountObjectPrivileges: accountRolePrivileges.AccountObjectPrivileges,
+		SchemaPrivileges:        accountRolePrivileges.SchemaPrivileges,
+		SchemaObjectPrivileges:  accountRolePrivileges.SchemaObjectPrivileges,
+		AllPrivileges:           accountRolePrivileges.AllPrivileges,
+	}
+}
+
+// getApplicationRoleGrantOn reuses the account role logic, as the grant target blocks of both resources have the same structure.
+func getApplicationRoleGrantOn(d *schema.ResourceData) (*sdk.ApplicationRoleGrantOn, error) {
+	on, err := getAccountRoleGrantOn(d)
+	if err != nil {
+		return nil, err
+	}
+	return &sdk.ApplicationRoleGrantOn{
+		Account:       on.Account,
+		AccountObject: on.AccountObject,
+		Schema:        on.Schema,
+		SchemaObject:  on.SchemaObject,
+	}, nil
+}
+
+func grantApplicationRolePrivileges(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id GrantPrivilegesToApplicationRoleId, privileges *sdk.ApplicationRoleGrantPrivileges, withGrantOption bool) error {
+	grantOn, err := getApplicationRoleGrantOn(d)
+	if err != nil {
+		return err
+	}
+	return client.Grants.GrantPrivilegesToApplicationRole(ctx, privileges, grantOn, id.ApplicationRoleName, &sdk.GrantPrivilegesToApplicationRoleOptions{
+		WithGrantOption: new(withGrantOption),
+	})
+}
+
+func revokeApplicationRolePrivileges(ctx context.Context, client *sdk.Client, d *schema.ResourceData, id GrantPrivilegesToApplicationRoleId, privileges *sdk.ApplicationRoleGrantPrivileges, opts *sdk.RevokePrivilegesFromApplicationRoleOptions, safely bool) error {
+	grantOn, err := getApplicationRoleGrantOn(d)
+	if err != nil {
+		return err
+	}
+	if safely {
+		return client.Grants.RevokePrivilegesFromApplicationRoleSafely(ctx, privileges, grantOn, id.ApplicationRoleName, opts)
+	}
+	return client.Grants.RevokePrivilegesFromApplicationRole(ctx, privileges, grantOn, id.ApplicationRoleName, opts)
+}
+
+func createGrantPrivilegesToApplicationRoleIdFromSchema(d *schema.ResourceData) (id *GrantPrivilegesToApplicationRoleId, err error) {
+	id = new(GrantPrivilegesToApplicationRoleId)
+	id.ApplicationRoleName, err = sdk.ParseDatabaseObjectIdentifier(d.Get("application_role_name").(string))
+	if err != nil {
+		return nil, err
+	}
+	id.AllPrivileges = d.Get("all_privileges").(bool)
+	if p, ok := d.GetOk("privileges"); ok {
+		id.Privileges = expandStringList(p.(*schema.Set).List())
+	}
+	id.WithGrantOption = d.Get("with_grant_option").(bool)
+	id.AlwaysApply = d.Get("always_apply").(bool)
+
+	on, err := getApplicationRoleGrantOn(d)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case on.Account != nil:
+		id.Kind = OnAccountApplicationRoleGrantKind
+		id.Data = new(OnAccountGrantData)
+	case on.AccountObject != nil:
+		onAccountObjectGrantData := new(OnAccountObjectGrantData)
+
+		switch {
+		case on.AccountObject.User != nil:
+			onAccountObjectGrantData.ObjectType = sdk.ObjectTypeUser
+			onAccountObjectGrantData.ObjectName = *on.AccountObject.User
+		case on.AccountObject.ResourceMonitor != nil:
+			onAccountObjectGrantData.ObjectType = sdk.ObjectTypeResourceMonitor
+			onAccountObjectGrantData.ObjectName = *on.AccountObject.ResourceMonitor
+		case on.AccountObject.Warehouse != nil:
+			onAccountObjectGrantData.ObjectType = sdk.ObjectTypeWarehouse
+			onAccountObjectGrantData.ObjectName = *on.AccountObject.Warehouse
+		case on.AccountObject.Database != nil:
+			onAccountObjectGrantData.ObjectType = sdk.ObjectTypeDatabase
+			onAccountObjectGrantData.ObjectName = *on.AccountObject.Database
+		case on.AccountObject.Integration != nil:
+			onAccountObjectGrantData.ObjectType = sdk.ObjectTypeIntegration
+			onAccountObjectGrantData.ObjectName = *on.AccountObject.Integration
+		case on.AccountObject.Connection != nil:
+			onAccountObjectGrantData.ObjectType = sdk.ObjectTypeConnection
+			onAccountObjectGrantData.ObjectName = *on.AccountObject.Connection
+		case on.AccountObject.FailoverGroup != nil:
+			onAccountObjectGrantData.ObjectType = sdk.ObjectTypeFailoverGroup
+			onAccountObjectGrantData.ObjectName = *on.AccountObject.FailoverGroup
+		case on.AccountObject.ReplicationGroup != nil:
+			onAccountObjectGrantData.ObjectType = sdk.ObjectTypeReplicationGroup
+			onAccountObjectGrantData.ObjectName = *on.AccountObject.ReplicationGroup
+		case on.AccountObject.ComputePool != nil:
+			onAccountObjectGrantData.ObjectType = sdk.ObjectTypeComputePool
+			onAccountObjectGrantData.ObjectName = *on.AccountObject.ComputePool
+		case on.AccountObject.ExternalVolume != nil:
+			onAccountObjectGrantData.ObjectType = sdk.ObjectTypeExternalVolume
+			onAccountObjectGrantData.ObjectName = *on.AccountObject.ExternalVolume
+		case on.AccountObject.SnowflakeIntelligence != nil:
+			onAccountObjectGrantData.ObjectType = sdk.ObjectTypeSnowflakeIntelligence
+			onAccountObjectGrantData.ObjectName = *on.AccountObject.SnowflakeIntelligence
+		}
+
+		id.Kind = OnAccountObjectApplicationRoleGrantKind
+		id.Data = onAccountObjectGrantData
+	case on.Schema != nil:
+		onSchemaGrantData := new(OnSchemaGrantData)
+
+		switch {
+		case on.Schema.Schema != nil:
+			onSchemaGrantData.Kind = OnSchemaSchemaGrantKind
+			onSchemaGrantData.SchemaName = on.Schema.Schema
+		case on.Schema.AllSchemasInDatabase != nil:
+			onSchemaGrantData.Kind = OnAllSchemasInDatabaseSchemaGrantKind
+			onSchemaGrantData.DatabaseName = on.Schema.AllSchemasInDatabase
+		case on.Schema.FutureSchemasInDatabase != nil:
+			onSchemaGrantData.Kind = OnFutureSchemasInDatabaseSchemaGrantKind
+			onSchemaGrantData.DatabaseName = on.Schema.FutureSchemasInDatabase
+		}
+
+		id.Kind = OnSchemaApplicationRoleGrantKind
+		id.Data = onSchemaGrantData
+	case on.SchemaObject != nil:
+		onSchemaObjectGrantData := new(OnSchemaObjectGrantData)
+
+		switch {
+		case on.SchemaObject.SchemaObject != nil:
+			onSchemaObjectGrantData.Kind = OnObjectSchemaObjectGrantKind
+			onSchemaObjectGrantData.Object = on.SchemaObject.SchemaObject
+		case on.SchemaObject.All != nil:
+			onSchemaObjectGrantData.Kind = OnAllSchemaObjectGrantKind
+			onSchemaObjectGrantData.OnAllOrFuture = getBulkOperationGrantData(on.SchemaObject.All)
+		case on.SchemaObject.Future != nil:
+			onSchemaObjectGrantData.Kind = OnFutureSchemaObjectGrantKind
+			onSchemaObjectGrantData.OnAllOrFuture = getBulkOperationGrantData(on.SchemaObject.Future)
+		}
+
+		id.Kind = OnSchemaObjectApplicationRoleGrantKind
+		id.Data = onSchemaObjectGrantData
+	}
+
+	return id, nil
+}
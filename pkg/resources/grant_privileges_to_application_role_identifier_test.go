@@ -0,0 +1,298 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGrantPrivilegesToApplicationRoleId(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Identifier string
+		Expected   GrantPrivilegesToApplicationRoleId
+		Error      string
+	}{
+		{
+			Name:       "grant application role on account",
+			Identifier: `"app-name"."app-role"|false|false|EXECUTE TASK,EXECUTE MANAGED TASK|OnAccount`,
+			Expected: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: sdk.NewDatabaseObjectIdentifier("app-name", "app-role"),
+				Privileges:          []string{"EXECUTE TASK", "EXECUTE MANAGED TASK"},
+				Kind:                OnAccountApplicationRoleGrantKind,
+				Data:                new(OnAccountGrantData),
+			},
+		},
+		{
+			Name:       "grant application role on account - always apply with grant option",
+			Identifier: `"app-name"."app-role"|true|true|EXECUTE TASK|OnAccount`,
+			Expected: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: sdk.NewDatabaseObjectIdentifier("app-name", "app-role"),
+				WithGrantOption:     true,
+				AlwaysApply:         true,
+				Privileges:          []string{"EXECUTE TASK"},
+				Kind:                OnAccountApplicationRoleGrantKind,
+				Data:                new(OnAccountGrantData),
+			},
+		},
+		{
+			Name:       "grant application role on account object - all privileges",
+			Identifier: `"app-name"."app-role"|false|false|ALL|OnAccountObject|DATABASE|"database-name"`,
+			Expected: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: sdk.NewDatabaseObjectIdentifier("app-name", "app-role"),
+				AllPrivileges:       true,
+				Kind:                OnAccountObjectApplicationRoleGrantKind,
+				Data: &OnAccountObjectGrantData{
+					ObjectType: sdk.ObjectTypeDatabase,
+					ObjectName: sdk.NewAccountObjectIdentifier("database-name"),
+				},
+			},
+		},
+		{
+			Name:       "grant application role on schema with schema name",
+			Identifier: `"app-name"."app-role"|false|false|USAGE|OnSchema|OnSchema|"database-name"."schema-name"`,
+			Expected: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: sdk.NewDatabaseObjectIdentifier("app-name", "app-role"),
+				Privileges:          []string{"USAGE"},
+				Kind:                OnSchemaApplicationRoleGrantKind,
+				Data: &OnSchemaGrantData{
+					Kind:       OnSchemaSchemaGrantKind,
+					SchemaName: sdk.Pointer(sdk.NewDatabaseObjectIdentifier("database-name", "schema-name")),
+				},
+			},
+		},
+		{
+			Name:       "grant application role on future schemas in database",
+			Identifier: `"app-name"."app-role"|false|false|USAGE|OnSchema|OnFutureSchemasInDatabase|"database-name"`,
+			Expected: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: sdk.NewDatabaseObjectIdentifier("app-name", "app-role"),
+				Privileges:          []string{"USAGE"},
+				Kind:                OnSchemaApplicationRoleGrantKind,
+				Data: &OnSchemaGrantData{
+					Kind:         OnFutureSchemasInDatabaseSchemaGrantKind,
+					DatabaseName: sdk.Pointer(sdk.NewAccountObjectIdentifier("database-name")),
+				},
+			},
+		},
+		{
+			Name:       "grant application role on schema object",
+			Identifier: `"app-name"."app-role"|false|false|SELECT,UPDATE|OnSchemaObject|OnObject|TABLE|"database-name"."schema-name"."table-name"`,
+			Expected: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: sdk.NewDatabaseObjectIdentifier("app-name", "app-role"),
+				Privileges:          []string{"SELECT", "UPDATE"},
+				Kind:                OnSchemaObjectApplicationRoleGrantKind,
+				Data: &OnSchemaObjectGrantData{
+					Kind: OnObjectSchemaObjectGrantKind,
+					Object: &sdk.Object{
+						ObjectType: sdk.ObjectTypeTable,
+						Name:       sdk.NewSchemaObjectIdentifier("database-name", "schema-name", "table-name"),
+					},
+				},
+			},
+		},
+		{
+			Name:       "grant application role on schema object with arguments",
+			Identifier: `"app-name"."app-role"|false|false|USAGE|OnSchemaObject|OnObject|FUNCTION|"database-name"."schema-name"."function-name"(NUMBER, VARCHAR)`,
+			Expected: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: sdk.NewDatabaseObjectIdentifier("app-name", "app-role"),
+				Privileges:          []string{"USAGE"},
+				Kind:                OnSchemaObjectApplicationRoleGrantKind,
+				Data: &OnSchemaObjectGrantData{
+					Kind: OnObjectSchemaObjectGrantKind,
+					Object: &sdk.Object{
+						ObjectType: sdk.ObjectTypeFunction,
+						Name:       sdk.NewSchemaObjectIdentifierWithArguments("database-name", "schema-name", "function-name", sdk.DataTypeNumber, sdk.DataTypeVARCHAR),
+					},
+				},
+			},
+		},
+		{
+			Name:       "grant application role on all tables in schema",
+			Identifier: `"app-name"."app-role"|false|false|SELECT|OnSchemaObject|OnAll|TABLES|InSchema|"database-name"."schema-name"`,
+			Expected: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: sdk.NewDatabaseObjectIdentifier("app-name", "app-role"),
+				Privileges:          []string{"SELECT"},
+				Kind:                OnSchemaObjectApplicationRoleGrantKind,
+				Data: &OnSchemaObjectGrantData{
+					Kind: OnAllSchemaObjectGrantKind,
+					OnAllOrFuture: &BulkOperationGrantData{
+						ObjectNamePlural: sdk.PluralObjectTypeTables,
+						Kind:             InSchemaBulkOperationGrantKind,
+						Schema:           sdk.Pointer(sdk.NewDatabaseObjectIdentifier("database-name", "schema-name")),
+					},
+				},
+			},
+		},
+		{
+			Name:       "grant application role on future tables in database",
+			Identifier: `"app-name"."app-role"|false|false|SELECT|OnSchemaObject|OnFuture|TABLES|InDatabase|"database-name"`,
+			Expected: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: sdk.NewDatabaseObjectIdentifier("app-name", "app-role"),
+				Privileges:          []string{"SELECT"},
+				Kind:                OnSchemaObjectApplicationRoleGrantKind,
+				Data: &OnSchemaObjectGrantData{
+					Kind: OnFutureSchemaObjectGrantKind,
+					OnAllOrFuture: &BulkOperationGrantData{
+						ObjectNamePlural: sdk.PluralObjectTypeTables,
+						Kind:             InDatabaseBulkOperationGrantKind,
+						Database:         sdk.Pointer(sdk.NewAccountObjectIdentifier("database-name")),
+					},
+				},
+			},
+		},
+		{
+			Name:       "validation: grant application role not enough parts",
+			Identifier: `"app-name"."app-role"|false|false|USAGE`,
+			Error:      "application role identifier should hold at least 5 parts",
+		},
+		{
+			Name:       "validation: grant application role not enough parts for OnAccountObject kind",
+			Identifier: `"app-name"."app-role"|false|false|USAGE|OnAccountObject|DATABASE`,
+			Error:      "application role identifier should hold 7 parts",
+		},
+		{
+			Name:       "validation: grant application role not enough parts for OnSchema kind",
+			Identifier: `"app-name"."app-role"|false|false|USAGE|OnSchema|OnAllSchemasInDatabase`,
+			Error:      "application role identifier should hold 7 parts",
+		},
+		{
+			Name:       "validation: grant application role not enough parts for OnSchemaObject.OnObject kind",
+			Identifier: `"app-name"."app-role"|false|false|SELECT|OnSchemaObject|OnObject|TABLE`,
+			Error:      "application role identifier should hold 8 parts",
+		},
+		{
+			Name:       "validation: grant application role not enough parts for OnSchemaObject.OnAll kind",
+			Identifier: `"app-name"."app-role"|false|false|SELECT|OnSchemaObject|OnAll|TABLES|InDatabase`,
+			Error:      "application role identifier should hold 9 parts",
+		},
+		{
+			Name:       "validation: grant application role invalid ApplicationRoleGrantKind kind",
+			Identifier: `"app-name"."app-role"|false|false|USAGE|OnDatabase|"database-name"`,
+			Error:      "invalid ApplicationRoleGrantKind: OnDatabase",
+		},
+		{
+			Name:       "validation: grant application role invalid OnSchemaGrantKind kind",
+			Identifier: `"app-name"."app-role"|false|false|USAGE|OnSchema|some-kind|some-data`,
+			Error:      "invalid OnSchemaGrantKind: some-kind",
+		},
+		{
+			Name:       "validation: grant application role invalid BulkOperationGrantKind kind",
+			Identifier: `"app-name"."app-role"|false|false|SELECT|OnSchemaObject|OnAll|TABLES|InAccount|"database-name"`,
+			Error:      "invalid BulkOperationGrantKind: InAccount",
+		},
+		{
+			Name:       "validation: grant application role empty privileges",
+			Identifier: `"app-name"."app-role"|false|false||OnAccount`,
+			Error:      `invalid Privileges value: , should be either a comma separated list of privileges or "ALL" / "ALL PRIVILEGES" for all privileges`,
+		},
+		{
+			Name:       "validation: grant application role invalid with grant option",
+			Identifier: `"app-name"."app-role"|yes|false|USAGE|OnAccount`,
+			Error:      `invalid WithGrantOption value: yes, should be either "true" or "false"`,
+		},
+		{
+			Name:       "validation: grant application role account level role name",
+			Identifier: `"app-role"|false|false|USAGE|OnAccount`,
+			Error:      "unexpected number of parts 1 in identifier",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			id, err := ParseGrantPrivilegesToApplicationRoleId(tt.Identifier)
+			if tt.Error == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.Expected, id)
+			} else {
+				assert.ErrorContains(t, err, tt.Error)
+			}
+		})
+	}
+}
+
+func TestGrantPrivilegesToApplicationRoleIdString(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Identifier GrantPrivilegesToApplicationRoleId
+		Expected   string
+	}{
+		{
+			Name: "grant application role on account",
+			Identifier: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: sdk.NewDatabaseObjectIdentifier("app-name", "app-role"),
+				Privileges:          []string{"EXECUTE TASK"},
+				Kind:                OnAccountApplicationRoleGrantKind,
+				Data:                new(OnAccountGrantData),
+			},
+			Expected: `"app-name"."app-role"|false|false|EXECUTE TASK|OnAccount`,
+		},
+		{
+			Name: "grant application role on account object - all privileges",
+			Identifier: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: sdk.NewDatabaseObjectIdentifier("app-name", "app-role"),
+				WithGrantOption:     true,
+				AllPrivileges:       true,
+				Kind:                OnAccountObjectApplicationRoleGrantKind,
+				Data: &OnAccountObjectGrantData{
+					ObjectType: sdk.ObjectTypeWarehouse,
+					ObjectName: sdk.NewAccountObjectIdentifier("warehouse-name"),
+				},
+			},
+			Expected: `"app-name"."app-role"|true|false|ALL|OnAccountObject|WAREHOUSE|"warehouse-name"`,
+		},
+		{
+			Name: "grant application role on all schemas in database",
+			Identifier: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: sdk.NewDatabaseObjectIdentifier("app-name", "app-role"),
+				Privileges:          []string{"USAGE"},
+				Kind:                OnSchemaApplicationRoleGrantKind,
+				Data: &OnSchemaGrantData{
+					Kind:         OnAllSchemasInDatabaseSchemaGrantKind,
+					DatabaseName: sdk.Pointer(sdk.NewAccountObjectIdentifier("database-name")),
+				},
+			},
+			Expected: `"app-name"."app-role"|false|false|USAGE|OnSchema|OnAllSchemasInDatabase|"database-name"`,
+		},
+		{
+			Name: "grant application role on schema object",
+			Identifier: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: sdk.NewDatabaseObjectIdentifier("app-name", "app-role"),
+				AlwaysApply:         true,
+				Privileges:          []string{"SELECT", "UPDATE"},
+				Kind:                OnSchemaObjectApplicationRoleGrantKind,
+				Data: &OnSchemaObjectGrantData{
+					Kind: OnObjectSchemaObjectGrantKind,
+					Object: &sdk.Object{
+						ObjectType: sdk.ObjectTypeTable,
+						Name:       sdk.NewSchemaObjectIdentifier("database-name", "schema-name", "table-name"),
+					},
+				},
+			},
+			Expected: `"app-name"."app-role"|false|true|SELECT,UPDATE|OnSchemaObject|OnObject|TABLE|"database-name"."schema-name"."table-name"`,
+		},
+		{
+			Name: "grant application role on future tables in schema",
+			Identifier: GrantPrivilegesToApplicationRoleId{
+				ApplicationRoleName: sdk.NewDatabaseObjectIdentifier("app-name", "app-role"),
+				Privileges:          []string{"SELECT"},
+				Kind:                OnSchemaObjectApplicationRoleGrantKind,
+				Data: &OnSchemaObjectGrantData{
+					Kind: OnFutureSchemaObjectGrantKind,
+					OnAllOrFuture: &BulkOperationGrantData{
+						ObjectNamePlural: sdk.PluralObjectTypeTables,
+						Kind:             InSchemaBulkOperationGrantKind,
+						Schema:           sdk.Pointer(sdk.NewDatabaseObjectIdentifier("database-name", "schema-name")),
+					},
+				},
+			},
+			Expected: `"app-name"."app-role"|false|false|SELECT|OnSchemaObject|OnFuture|TABLES|InSchema|"database-name"."schema-name"`,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.Expected, tt.Identifier.String())
+		})
+	}
+}
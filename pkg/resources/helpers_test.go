@@ -417,6 +417,36 @@ func Test_DataTypeDiffSuppressFunc(t *testing.T) {
 			new:      "TEXT(40)",
 			expected: false,
 		},
+		{
+			name:     "structured array with element attributes implicit and explicit",
+			old:      "ARRAY(NUMBER(38,0))",
+			new:      "array(int)",
+			expected: true,
+		},
+		{
+			name:     "semi-structured and structured array",
+			old:      "ARRAY",
+			new:      "ARRAY(NUMBER)",
+			expected: false,
+		},
+		{
+			name:     "structured object as returned by Snowflake",
+			old:      "OBJECT(city VARCHAR(16777216), zip NUMBER(38,0))",
+			new:      "OBJECT(city VARCHAR, zip NUMBER)",
+			expected: true,
+		},
+		{
+			name:     "structured object with different keys",
+			old:      "OBJECT(city VARCHAR(16777216))",
+			new:      "OBJECT(CITY VARCHAR)",
+			expected: false,
+		},
+		{
+			name:     "map with different value types",
+			old:      "MAP(VARCHAR(16777216), NUMBER(38,0))",
+			new:      "MAP(VARCHAR, VARCHAR)",
+			expected: false,
+		},
 	}
 
 	for _, tc := range testCases {
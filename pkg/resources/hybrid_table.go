@@ -286,7 +286,7 @@ func HybridTable() *schema.Resource {
 			return client.HybridTables.DropSafely
 		},
 	)
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeTable, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.HybridTableResource), TrackingCreateWrapper(resources.HybridTable, CreateHybridTable)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.HybridTableResource), TrackingReadWrapper(resources.HybridTable, GetReadHybridTableFunc(true))),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.HybridTableResource), TrackingUpdateWrapper(resources.HybridTable, UpdateHybridTable)),
@@ -311,7 +311,7 @@ func HybridTable() *schema.Resource {
 		},
 
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportHybridTable(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
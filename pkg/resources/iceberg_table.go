@@ -39,6 +39,7 @@ var icebergTableSchema = collections.MergeMaps(
 		"check_constraint":       checkConstraintSchema(),
 		"row_access_policy":      rowAccessPolicyFieldSchema("Iceberg table"),
 		"aggregation_policy":     aggregationPolicySchema("Iceberg table"),
+		"join_policy":            joinPolicyFieldSchema("Iceberg table"),
 		"base_location": {
 			Type:             schema.TypeString,
 			Optional:         true,
@@ -88,7 +89,7 @@ var icebergTableSchema = collections.MergeMaps(
 )
 
 func IcebergTable() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIcebergTable, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.IcebergTableResource), TrackingCreateWrapper(resources.IcebergTable, CreateIcebergTable)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.IcebergTableResource), TrackingReadWrapper(resources.IcebergTable, ReadIcebergTableFunc(true))),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.IcebergTableResource), TrackingUpdateWrapper(resources.IcebergTable, UpdateIcebergTable)),
@@ -112,7 +113,7 @@ func IcebergTable() *schema.Resource {
 			),
 			icebergTableSnowflakeManagedParametersCustomDiff,
 		),
-	}
+	})
 }
 
 func CreateIcebergTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -184,6 +185,18 @@ func CreateIcebergTable(ctx context.Context, d *schema.ResourceData, meta any) d
 	}
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	// JOIN POLICY is not a part of CREATE ICEBERG TABLE in the SDK, so it is set right after the table is created.
+	if _, ok := d.GetOk("join_policy"); ok {
+		setReq, _, err := joinPolicyUpdateRequests(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithSetJoinPolicy(*setReq)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting join policy for iceberg table %v: %w", id.FullyQualifiedName(), err))
+		}
+	}
+
 	return ReadIcebergTableFunc(false)(ctx, d, meta)
 }
 
@@ -281,6 +294,9 @@ func ReadIcebergTableFunc(withExternalChangesMarking bool) schema.ReadContextFun
 			if err != nil {
 				return err
 			}
+			if err := handleJoinPolicyReferences(policyRefs, d); err != nil {
+				return err
+			}
 
 			var partitionBy []map[string]any
 			if currentPartitionSpec, err := collections.FindFirst(table.PartitionSpecs, func(spec sdk.IcebergTablePartitionSpec) bool {
@@ -393,6 +409,22 @@ func UpdateIcebergTable(ctx context.Context, d *schema.ResourceData, meta any) d
 		}
 	}
 
+	if d.HasChange("join_policy") {
+		setReq, unsetReq, err := joinPolicyUpdateRequests(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if setReq != nil {
+			if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithSetJoinPolicy(*setReq)); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting join policy for iceberg table %v: %w", d.Id(), err))
+			}
+		} else {
+			if err := client.IcebergTables.Alter(ctx, sdk.NewAlterIcebergTableRequest(id).WithUnsetJoinPolicy(*unsetReq)); err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting join policy for iceberg table %v: %w", d.Id(), err))
+			}
+		}
+	}
+
 	return ReadIcebergTableFunc(false)(ctx, d, meta)
 }
 
@@ -57,7 +57,7 @@ var icebergTableFromAwsGlueSchema = collections.MergeMaps(
 )
 
 func IcebergTableFromAwsGlue() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIcebergTable, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.IcebergTableFromAwsGlueResource), TrackingCreateWrapper(resources.IcebergTableFromAwsGlue, CreateIcebergTableFromAwsGlue)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.IcebergTableFromAwsGlueResource), TrackingReadWrapper(resources.IcebergTableFromAwsGlue, ReadIcebergTableFromAwsGlueFunc(true))),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.IcebergTableFromAwsGlueResource), TrackingUpdateWrapper(resources.IcebergTableFromAwsGlue, UpdateIcebergTableFromAwsGlue)),
@@ -75,7 +75,7 @@ func IcebergTableFromAwsGlue() *schema.Resource {
 			ComputedIfAnyAttributeChanged(icebergTableFromAwsGlueSchema, ParametersAttributeName, "external_volume", "catalog", "replace_invalid_characters"),
 			icebergTableExternalManagedParametersCustomDiff,
 		),
-	}
+	})
 }
 
 func importIcebergTableFromAwsGlue(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
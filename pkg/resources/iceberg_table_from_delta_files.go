@@ -52,7 +52,7 @@ var icebergTableFromDeltaFilesSchema = collections.MergeMaps(
 )
 
 func IcebergTableFromDeltaFiles() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIcebergTable, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.IcebergTableFromDeltaFilesResource), TrackingCreateWrapper(resources.IcebergTableFromDeltaFiles, CreateIcebergTableFromDeltaFiles)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.IcebergTableFromDeltaFilesResource), TrackingReadWrapper(resources.IcebergTableFromDeltaFiles, ReadIcebergTableFromDeltaFilesFunc(true))),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.IcebergTableFromDeltaFilesResource), TrackingUpdateWrapper(resources.IcebergTableFromDeltaFiles, UpdateIcebergTableFromDeltaFiles)),
@@ -70,7 +70,7 @@ func IcebergTableFromDeltaFiles() *schema.Resource {
 			ComputedIfAnyAttributeChanged(icebergTableFromDeltaFilesSchema, ParametersAttributeName, "external_volume", "catalog", "replace_invalid_characters"),
 			icebergTableExternalManagedParametersCustomDiff,
 		),
-	}
+	})
 }
 
 func CreateIcebergTableFromDeltaFiles(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
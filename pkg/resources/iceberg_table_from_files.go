@@ -38,7 +38,7 @@ var icebergTableFromFilesSchema = collections.MergeMaps(
 )
 
 func IcebergTableFromFiles() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIcebergTable, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.IcebergTableFromFilesResource), TrackingCreateWrapper(resources.IcebergTableFromFiles, CreateIcebergTableFromFiles)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.IcebergTableFromFilesResource), TrackingReadWrapper(resources.IcebergTableFromFiles, ReadIcebergTableFromFiles)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.IcebergTableFromFilesResource), TrackingUpdateWrapper(resources.IcebergTableFromFiles, UpdateIcebergTableFromFiles)),
@@ -56,7 +56,7 @@ func IcebergTableFromFiles() *schema.Resource {
 			ComputedIfAnyAttributeChanged(icebergTableFromFilesSchema, ParametersAttributeName, "external_volume", "catalog", "replace_invalid_characters"),
 			icebergTableExternalManagedParametersCustomDiff,
 		),
-	}
+	})
 }
 
 func CreateIcebergTableFromFiles(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
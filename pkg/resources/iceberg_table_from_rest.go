@@ -58,7 +58,7 @@ var icebergTableFromRestSchema = collections.MergeMaps(
 )
 
 func IcebergTableFromRest() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIcebergTable, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.IcebergTableFromRestResource), TrackingCreateWrapper(resources.IcebergTableFromRest, CreateIcebergTableFromRest)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.IcebergTableFromRestResource), TrackingReadWrapper(resources.IcebergTableFromRest, ReadIcebergTableFromRestFunc(true))),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.IcebergTableFromRestResource), TrackingUpdateWrapper(resources.IcebergTableFromRest, UpdateIcebergTableFromRest)),
@@ -76,7 +76,7 @@ func IcebergTableFromRest() *schema.Resource {
 			ComputedIfAnyAttributeChanged(icebergTableFromRestSchema, ParametersAttributeName, "external_volume", "catalog", "replace_invalid_characters", "target_file_size", "storage_serialization_policy", "enable_iceberg_merge_on_read", "iceberg_merge_on_read_behavior"),
 			icebergTableFromRestParametersCustomDiff,
 		),
-	}
+	})
 }
 
 func importIcebergTableFromRest(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
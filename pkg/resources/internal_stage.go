@@ -82,7 +82,7 @@ var internalStageSchema = func() map[string]*schema.Schema {
 }()
 
 func InternalStage() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeStage, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		SchemaVersion: 1,
 
 		CreateContext: TrackingCreateWrapper(resources.InternalStage, CreateInternalStage),
@@ -112,7 +112,7 @@ func InternalStage() *schema.Resource {
 				Upgrade: v2_14_0_InternalStageStateUpgrader,
 			},
 		},
-	}
+	})
 }
 
 func ImportInternalStage(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
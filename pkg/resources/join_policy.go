@@ -0,0 +1,198 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var joinPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the join policy; must be unique for the database and schema in which the join policy is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the join policy."),
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the join policy."),
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"body": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      diffSuppressStatementFieldDescription("Specifies the SQL expression that determines whether a join is required. The expression must return `JOIN_CONSTRAINT(JOIN_REQUIRED => true)` or `JOIN_CONSTRAINT(JOIN_REQUIRED => false)`."),
+		DiffSuppressFunc: DiffSuppressStatement,
+		ValidateFunc:     validation.StringIsNotEmpty,
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the join policy.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW JOIN POLICIES` for the given join policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowJoinPolicySchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE JOIN POLICY` for the given join policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowJoinPolicyDetailsSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func JoinPolicy() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.JoinPolicies.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.JoinPolicyResource), TrackingCreateWrapper(resources.JoinPolicy, CreateJoinPolicy)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.JoinPolicyResource), TrackingReadWrapper(resources.JoinPolicy, ReadJoinPolicy)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.JoinPolicyResource), TrackingUpdateWrapper(resources.JoinPolicy, UpdateJoinPolicy)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.JoinPolicyResource), TrackingDeleteWrapper(resources.JoinPolicy, deleteFunc)),
+		Description:   "Resource used to manage join policy objects. For more information, check [join policy documentation](https://docs.snowflake.com/en/sql-reference/sql/create-join-policy).",
+
+		Schema: joinPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.JoinPolicy, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.JoinPolicy, customdiff.All(
+			ComputedIfAnyAttributeChanged(joinPolicySchema, ShowOutputAttributeName, "name", "comment"),
+			ComputedIfAnyAttributeChanged(joinPolicySchema, DescribeOutputAttributeName, "name", "body"),
+			ComputedIfAnyAttributeChanged(joinPolicySchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateJoinPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	request := sdk.NewCreateJoinPolicyRequest(id, d.Get("body").(string))
+
+	if errs := errors.Join(
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.JoinPolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating join policy %v, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadJoinPolicy(ctx, d, meta)
+}
+
+func ReadJoinPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	joinPolicy, err := client.JoinPolicies.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query join policy. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Join policy id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+	joinPolicyDetails, err := client.JoinPolicies.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("body", joinPolicyDetails.Body),
+		d.Set("comment", joinPolicy.Comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.JoinPolicyToSchema(joinPolicy)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.JoinPolicyDetailsToSchema(joinPolicyDetails)}),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateJoinPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.JoinPolicies.Alter(ctx, sdk.NewAlterJoinPolicyRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming join policy from %v to %v, err = %w", id.FullyQualifiedName(), newId.FullyQualifiedName(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	if d.HasChange("body") {
+		if err := client.JoinPolicies.Alter(ctx, sdk.NewAlterJoinPolicyRequest(id).WithSetBody(d.Get("body").(string))); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating join policy %v body, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			if err := client.JoinPolicies.Alter(ctx, sdk.NewAlterJoinPolicyRequest(id).WithSetComment(comment)); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting comment for join policy %v, err = %w", id.FullyQualifiedName(), err))
+			}
+		} else {
+			if err := client.JoinPolicies.Alter(ctx, sdk.NewAlterJoinPolicyRequest(id).WithUnsetComment(true)); err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting comment for join policy %v, err = %w", id.FullyQualifiedName(), err))
+			}
+		}
+	}
+
+	return ReadJoinPolicy(ctx, d, meta)
+}
@@ -124,7 +124,7 @@ func MaskingPolicy() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeMaskingPolicy, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		SchemaVersion: 2,
 
 		CreateContext: TrackingCreateWrapper(resources.MaskingPolicy, CreateMaskingPolicy),
@@ -159,7 +159,7 @@ func MaskingPolicy() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportMaskingPolicy(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
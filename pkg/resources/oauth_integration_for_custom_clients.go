@@ -173,7 +173,7 @@ var oauthIntegrationForCustomClientsSchema = map[string]*schema.Schema{
 }
 
 func OauthIntegrationForCustomClients() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		Schema: oauthIntegrationForCustomClientsSchema,
 
 		CreateContext: TrackingCreateWrapper(resources.OauthIntegrationForCustomClients, CreateContextOauthIntegrationForCustomClients),
@@ -216,7 +216,7 @@ func OauthIntegrationForCustomClients() *schema.Resource {
 		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
 			oauthAllowedRolesListRequiresSecondaryRolesNone,
 		},
-	}
+	})
 }
 
 func ImportOauthForCustomClientsIntegration(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
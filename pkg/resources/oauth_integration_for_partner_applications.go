@@ -130,7 +130,7 @@ var oauthIntegrationForPartnerApplicationsSchema = map[string]*schema.Schema{
 }
 
 func OauthIntegrationForPartnerApplications() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		Schema: oauthIntegrationForPartnerApplicationsSchema,
 
 		CreateContext: TrackingCreateWrapper(resources.OauthIntegrationForPartnerApplications, CreateContextOauthIntegrationForPartnerApplications),
@@ -167,7 +167,7 @@ func OauthIntegrationForPartnerApplications() *schema.Resource {
 		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
 			oauthAllowedRolesListRequiresSecondaryRolesNone,
 		},
-	}
+	})
 }
 
 func ImportOauthForPartnerApplicationIntegration(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
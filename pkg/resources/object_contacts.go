@@ -0,0 +1,245 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// objectContactPurposes maps the purpose attributes of the snowflake_object_contacts resource to the contact purposes.
+var objectContactPurposes = map[string]sdk.ContactPurpose{
+	"steward":         sdk.ContactPurposeSteward,
+	"support":         sdk.ContactPurposeSupport,
+	"access_approval": sdk.ContactPurposeAccessApproval,
+}
+
+var objectContactPurposeAttributes = []string{"steward", "support", "access_approval"}
+
+func objectContactPurposeSchema(purposeDescription string) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		AtLeastOneOf:     objectContactPurposeAttributes,
+		Description:      relatedResourceDescription(fmt.Sprintf("Fully qualified name of the contact set directly on the object for the %s purpose.", purposeDescription), resources.Contact),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+	}
+}
+
+var objectContactsSchema = map[string]*schema.Schema{
+	"object_type": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "Specifies the type of the object the contacts are set on. " + enumValuesDescription(sdk.AllContactAttachableObjectTypes),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToContactAttachableObjectType),
+		ValidateFunc: validation.StringInSlice(collections.Map(sdk.AllContactAttachableObjectTypes, func(v sdk.ObjectType) string {
+			return string(v)
+		}), true),
+	},
+	"object_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedPipesFieldDescription("Fully qualified name of the object the contacts are set on. The number of the identifier parts has to match the `object_type` (e.g. `\"<database>\"` for a database, `\"<database>\".\"<schema>\"` for a schema, `\"<database>\".\"<schema>\".\"<table>\"` for a table)."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"steward":         objectContactPurposeSchema("data steward (`STEWARD`)"),
+	"support":         objectContactPurposeSchema("support (`SUPPORT`)"),
+	"access_approval": objectContactPurposeSchema("access approval (`ACCESS_APPROVAL`)"),
+}
+
+func ObjectContacts() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Resource used to manage the contacts set directly on a database, schema, table or view. Only the contacts set on the object itself are managed (and checked for drift); the contacts inherited from the parent objects are ignored. For more information, check [contacts documentation](https://docs.snowflake.com/en/sql-reference/contacts-using).",
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ObjectContactsResource), TrackingCreateWrapper(resources.ObjectContacts, CreateObjectContacts)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ObjectContactsResource), TrackingReadWrapper(resources.ObjectContacts, ReadObjectContacts)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ObjectContactsResource), TrackingUpdateWrapper(resources.ObjectContacts, UpdateObjectContacts)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.ObjectContactsResource), TrackingDeleteWrapper(resources.ObjectContacts, DeleteObjectContacts)),
+
+		Schema: objectContactsSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.ObjectContacts, ImportObjectContacts),
+		},
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportObjectContacts(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	objectType, objectId, err := parseObjectContactsId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := errors.Join(
+		d.Set("object_type", objectType.String()),
+		d.Set("object_name", objectId.FullyQualifiedName()),
+	); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateObjectContacts(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	objectType, err := sdk.ToContactAttachableObjectType(d.Get("object_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	objectId, err := GetOnObjectIdentifier(objectType, d.Get("object_name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	set := sdk.NewContactsOnObjectSetRequest()
+	if errs := errors.Join(
+		schemaObjectIdentifierAttributeCreate(d, "steward", &set.Steward),
+		schemaObjectIdentifierAttributeCreate(d, "support", &set.Support),
+		schemaObjectIdentifierAttributeCreate(d, "access_approval", &set.AccessApproval),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.Contacts.SetOnObject(ctx, sdk.NewSetOnObjectContactRequest(objectType, objectId, *set)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting contacts on %s %s, err = %w", objectType, objectId.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(objectType.String(), objectId.FullyQualifiedName()))
+
+	return ReadObjectContacts(ctx, d, meta)
+}
+
+func ReadObjectContacts(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	objectType, objectId, err := parseObjectContactsId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	references, err := client.Contacts.GetForObject(ctx, objectType, objectId)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to get contacts of the object. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Object: %s %s, Err: %s", objectType, objectId.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	contacts := make(map[sdk.ContactPurpose]string)
+	for _, reference := range references {
+		if reference.Inherited {
+			continue
+		}
+		contacts[reference.Purpose] = reference.Contact.FullyQualifiedName()
+	}
+
+	errs := errors.Join(
+		d.Set("object_type", objectType.String()),
+		d.Set("object_name", objectId.FullyQualifiedName()),
+	)
+	for attribute, purpose := range objectContactPurposes {
+		errs = errors.Join(errs, d.Set(attribute, contacts[purpose]))
+	}
+	return diag.FromErr(errs)
+}
+
+func UpdateObjectContacts(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	objectType, objectId, err := parseObjectContactsId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	set, unset := sdk.NewContactsOnObjectSetRequest(), sdk.NewContactsOnObjectUnsetRequest()
+	if errs := errors.Join(
+		schemaObjectIdentifierAttributeUpdate(d, "steward", &set.Steward, &unset.Steward),
+		schemaObjectIdentifierAttributeUpdate(d, "support", &set.Support, &unset.Support),
+		schemaObjectIdentifierAttributeUpdate(d, "access_approval", &set.AccessApproval, &unset.AccessApproval),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if (*set != sdk.ContactsOnObjectSetRequest{}) {
+		if err := client.Contacts.SetOnObject(ctx, sdk.NewSetOnObjectContactRequest(objectType, objectId, *set)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting contacts on %s %s, err = %w", objectType, objectId.FullyQualifiedName(), err))
+		}
+	}
+
+	if (*unset != sdk.ContactsOnObjectUnsetRequest{}) {
+		if err := client.Contacts.UnsetOnObject(ctx, sdk.NewUnsetOnObjectContactRequest(objectType, objectId, *unset)); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting contacts on %s %s, err = %w", objectType, objectId.FullyQualifiedName(), err))
+		}
+	}
+
+	return ReadObjectContacts(ctx, d, meta)
+}
+
+func DeleteObjectContacts(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	objectType, objectId, err := parseObjectContactsId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	unset := sdk.NewContactsOnObjectUnsetRequest()
+	if d.Get("steward").(string) != "" {
+		unset.WithSteward(true)
+	}
+	if d.Get("support").(string) != "" {
+		unset.WithSupport(true)
+	}
+	if d.Get("access_approval").(string) != "" {
+		unset.WithAccessApproval(true)
+	}
+
+	if (*unset != sdk.ContactsOnObjectUnsetRequest{}) {
+		if err := client.Contacts.UnsetOnObject(ctx, sdk.NewUnsetOnObjectContactRequest(objectType, objectId, *unset)); err != nil {
+			// the object was dropped together with its contacts
+			if !errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+				return diag.FromErr(fmt.Errorf("error unsetting contacts on %s %s, err = %w", objectType, objectId.FullyQualifiedName(), err))
+			}
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func parseObjectContactsId(id string) (sdk.ObjectType, sdk.ObjectIdentifier, error) {
+	parts := helpers.ParseResourceIdentifier(id)
+	if len(parts) != 2 {
+		return "", nil, fmt.Errorf("required id format '<object_type>|<object_fqn>', but got: '%s'", id)
+	}
+
+	objectType, err := sdk.ToContactAttachableObjectType(parts[0])
+	if err != nil {
+		return "", nil, err
+	}
+	objectId, err := GetOnObjectIdentifier(objectType, parts[1])
+	if err != nil {
+		return "", nil, err
+	}
+	return objectType, objectId, nil
+}
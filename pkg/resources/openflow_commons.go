@@ -0,0 +1,30 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// openflowTimeouts are shared by all the Openflow resources. Openflow objects are provisioned, altered, and deleted
+// asynchronously, and the provider waits for them to settle, which may take much longer than for the other objects.
+var openflowTimeouts = &schema.ResourceTimeout{
+	Create: schema.DefaultTimeout(60 * time.Minute),
+	Read:   schema.DefaultTimeout(defaultReadTimeout),
+	Update: schema.DefaultTimeout(60 * time.Minute),
+	Delete: schema.DefaultTimeout(60 * time.Minute),
+}
+
+const openflowTimeoutsNote = "Openflow objects are provisioned asynchronously; the provider waits until they finish provisioning, which can take longer than the default timeouts. Use the `timeouts` block to adjust the limits for your environment."
+
+func ToOpenflowRuntimeExternalAccessIntegrationsRequest(value any) (sdk.OpenflowRuntimeExternalAccessIntegrationsRequest, error) {
+	raw := expandStringList(value.(*schema.Set).List())
+	integrations := make([]sdk.AccountObjectIdentifier, len(raw))
+	for i, v := range raw {
+		integrations[i] = sdk.NewAccountObjectIdentifier(v)
+	}
+	return sdk.OpenflowRuntimeExternalAccessIntegrationsRequest{
+		ExternalAccessIntegrations: integrations,
+	}, nil
+}
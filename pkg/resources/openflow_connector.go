@@ -0,0 +1,286 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var openflowConnectorSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the Openflow connector; must be unique for the database and schema in which the connector is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the Openflow connector."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the Openflow connector."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"runtime": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Specifies the fully qualified name of the Openflow runtime on which the connector runs. Snowflake returns only the name of the runtime, so on import the runtime is assumed to be in the same database and schema as the connector.", resources.OpenflowRuntime),
+	},
+	"definition": {
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+		ExactlyOneOf: []string{"definition", "from"},
+		Description:  "Specifies the name of the connector definition from which the Openflow connector is created. External changes of this field are not detected.",
+	},
+	"from": {
+		Type:         schema.TypeList,
+		Optional:     true,
+		ForceNew:     true,
+		MaxItems:     1,
+		ExactlyOneOf: []string{"definition", "from"},
+		Description:  "Specifies the location in a stage of the files from which the Openflow connector is created. External changes of this field are not detected.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"stage": {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					Description:      "Fully qualified name of the stage where the connector files are located.",
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					DiffSuppressFunc: suppressIdentifierQuoting,
+				},
+				"path": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					ForceNew:    true,
+					Description: "Location of the connector files in the stage.",
+				},
+			},
+		},
+	},
+	"display_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the name of the Openflow connector displayed in the Openflow user interface.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the Openflow connector.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW OPENFLOW CONNECTORS` for the given Openflow connector.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowOpenflowConnectorSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE OPENFLOW CONNECTOR` for the given Openflow connector.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowOpenflowConnectorDetailsSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func OpenflowConnector() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.OpenflowConnectors.DropSafelyAndWait
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.OpenflowConnectorResource), TrackingCreateWrapper(resources.OpenflowConnector, CreateOpenflowConnector)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.OpenflowConnectorResource), TrackingReadWrapper(resources.OpenflowConnector, ReadOpenflowConnector)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.OpenflowConnectorResource), TrackingUpdateWrapper(resources.OpenflowConnector, UpdateOpenflowConnector)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.OpenflowConnectorResource), TrackingDeleteWrapper(resources.OpenflowConnector, deleteFunc)),
+		Description:   "Resource used to manage Openflow connector objects. An Openflow connector runs on an Openflow runtime. " + openflowTimeoutsNote,
+
+		Schema: openflowConnectorSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.OpenflowConnector, ImportOpenflowConnector),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.OpenflowConnector, customdiff.All(
+			ComputedIfAnyAttributeChanged(openflowConnectorSchema, ShowOutputAttributeName, "display_name", "comment"),
+			ComputedIfAnyAttributeChanged(openflowConnectorSchema, DescribeOutputAttributeName, "display_name", "comment"),
+		)),
+		Timeouts: openflowTimeouts,
+	}
+}
+
+func ImportOpenflowConnector(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+	if _, err := ImportName[sdk.SchemaObjectIdentifier](ctx, d, meta); err != nil {
+		return nil, err
+	}
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	connector, err := client.OpenflowConnectors.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("runtime", sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), connector.Runtime).FullyQualifiedName()); err != nil {
+		return nil, err
+	}
+	if connector.ConnectorDefinition != nil {
+		if err := d.Set("definition", *connector.ConnectorDefinition); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateOpenflowConnector(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	runtimeId, err := sdk.ParseSchemaObjectIdentifier(d.Get("runtime").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewCreateOpenflowConnectorRequest(id, runtimeId)
+	if errs := errors.Join(
+		stringAttributeCreateBuilder(d, "definition", request.WithFromDefinition),
+		stringAttributeCreateBuilder(d, "display_name", request.WithDisplayName),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if from, ok := d.GetOk("from"); ok && len(from.([]any)) > 0 {
+		fromMap := from.([]any)[0].(map[string]any)
+
+		stage, err := sdk.ParseSchemaObjectIdentifier(fromMap["stage"].(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var path string
+		if p, ok := fromMap["path"]; ok {
+			path = p.(string)
+		}
+
+		request.WithFrom(sdk.NewStageLocation(stage, path))
+	}
+
+	if _, err := client.OpenflowConnectors.CreateSafely(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Openflow connector %s: %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadOpenflowConnector(ctx, d, meta)
+}
+
+func ReadOpenflowConnector(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	connector, err := client.OpenflowConnectors.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query Openflow connector. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Openflow connector id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	details, err := client.OpenflowConnectors.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var displayName, comment string
+	if connector.DisplayName != nil {
+		displayName = *connector.DisplayName
+	}
+	if connector.Comment != nil {
+		comment = *connector.Comment
+	}
+
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("display_name", displayName),
+		d.Set("comment", comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.OpenflowConnectorToSchema(connector)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.OpenflowConnectorDetailsToSchema(details)}),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateOpenflowConnector(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	setRequest := sdk.NewOpenflowConnectorSetRequest()
+	unsetRequest := sdk.NewOpenflowConnectorUnsetRequest()
+
+	if errs := errors.Join(
+		stringAttributeUpdate(d, "display_name", &setRequest.DisplayName, &unsetRequest.DisplayName),
+		stringAttributeUpdate(d, "comment", &setRequest.Comment, &unsetRequest.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if !reflect.DeepEqual(*setRequest, *sdk.NewOpenflowConnectorSetRequest()) {
+		if err := client.OpenflowConnectors.Alter(ctx, sdk.NewAlterOpenflowConnectorRequest(id).WithSet(*setRequest)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting properties for Openflow connector %v, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if !reflect.DeepEqual(*unsetRequest, *sdk.NewOpenflowConnectorUnsetRequest()) {
+		if err := client.OpenflowConnectors.Alter(ctx, sdk.NewAlterOpenflowConnectorRequest(id).WithUnset(*unsetRequest)); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting properties for Openflow connector %v, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	return ReadOpenflowConnector(ctx, d, meta)
+}
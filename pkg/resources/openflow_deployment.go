@@ -0,0 +1,298 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var openflowDeploymentSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the Openflow deployment; must be unique for your account."),
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"deployment_type": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: sdkValidation(sdk.ToOpenflowDeploymentType),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToOpenflowDeploymentType),
+		Description:      fmt.Sprintf("Specifies where the Openflow deployment runs. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllOpenflowDeploymentTypes)),
+	},
+	"vpc_type": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: sdkValidation(sdk.ToOpenflowVpcType),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToOpenflowVpcType),
+		Description:      fmt.Sprintf("Specifies the type of the VPC used by the Openflow deployment. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllOpenflowVpcTypes)),
+	},
+	"custom_ingress_hostname": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Specifies the custom hostname used for the ingress traffic to the Openflow deployment.",
+	},
+	"use_private_link": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Default:          BooleanDefault,
+		ValidateDiagFunc: validateBooleanString,
+		Description:      booleanStringFieldDescription("Specifies whether the Openflow deployment is accessed through a private link."),
+	},
+	"use_user_auth_over_privatelink": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		Default:          BooleanDefault,
+		ValidateDiagFunc: validateBooleanString,
+		Description:      booleanStringFieldDescription("Specifies whether the users are authenticated when accessing the Openflow deployment through a private link."),
+	},
+	"event_table": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Specifies the fully qualified name of the event table that collects the telemetry data of the Openflow deployment. External changes of this field are not detected, because Snowflake does not return it.", resources.EventTable),
+	},
+	"display_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the name of the Openflow deployment displayed in the Openflow user interface.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the Openflow deployment.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW OPENFLOW DEPLOYMENTS` for the given Openflow deployment.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowOpenflowDeploymentSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE OPENFLOW DEPLOYMENT` for the given Openflow deployment.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowOpenflowDeploymentDetailsSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func OpenflowDeployment() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseAccountObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.AccountObjectIdentifier] {
+			return client.OpenflowDeployments.DropSafelyAndWait
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.OpenflowDeploymentResource), TrackingCreateWrapper(resources.OpenflowDeployment, CreateOpenflowDeployment)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.OpenflowDeploymentResource), TrackingReadWrapper(resources.OpenflowDeployment, ReadOpenflowDeployment)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.OpenflowDeploymentResource), TrackingUpdateWrapper(resources.OpenflowDeployment, UpdateOpenflowDeployment)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.OpenflowDeploymentResource), TrackingDeleteWrapper(resources.OpenflowDeployment, deleteFunc)),
+		Description:   "Resource used to manage Openflow deployment objects. An Openflow deployment hosts the Openflow runtimes, which run the Openflow connectors. " + openflowTimeoutsNote,
+
+		Schema: openflowDeploymentSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.OpenflowDeployment, ImportOpenflowDeployment),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.OpenflowDeployment, customdiff.All(
+			ComputedIfAnyAttributeChanged(openflowDeploymentSchema, ShowOutputAttributeName, "name", "display_name", "comment"),
+			ComputedIfAnyAttributeChanged(openflowDeploymentSchema, DescribeOutputAttributeName, "name", "display_name", "comment"),
+			ComputedIfAnyAttributeChanged(openflowDeploymentSchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+		Timeouts: openflowTimeouts,
+	}
+}
+
+func ImportOpenflowDeployment(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := client.OpenflowDeployments.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var vpcType string
+	if deployment.VpcType != nil {
+		vpcType = string(*deployment.VpcType)
+	}
+	var customIngressHostname string
+	if deployment.CustomIngressHostname != nil {
+		customIngressHostname = *deployment.CustomIngressHostname
+	}
+
+	if err := errors.Join(
+		d.Set("name", id.Name()),
+		d.Set("deployment_type", string(deployment.Type)),
+		d.Set("vpc_type", vpcType),
+		d.Set("custom_ingress_hostname", customIngressHostname),
+		d.Set("use_private_link", booleanStringFromBool(deployment.UsePrivateLink)),
+		d.Set("use_user_auth_over_privatelink", booleanStringFromBool(deployment.UseUserAuthOverPrivateLink)),
+	); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateOpenflowDeployment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	deploymentType, err := sdk.ToOpenflowDeploymentType(d.Get("deployment_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewCreateOpenflowDeploymentRequest(id, deploymentType)
+	if errs := errors.Join(
+		attributeMappedValueCreateBuilder(d, "vpc_type", request.WithVpcType, sdk.ToOpenflowVpcType),
+		stringAttributeCreateBuilder(d, "custom_ingress_hostname", request.WithCustomIngressHostname),
+		booleanStringAttributeCreateBuilder(d, "use_private_link", request.WithUsePrivateLink),
+		booleanStringAttributeCreateBuilder(d, "use_user_auth_over_privatelink", request.WithUseUserAuthOverPrivatelink),
+		attributeMappedValueCreateBuilder(d, "event_table", request.WithEventTable, openflowEventTableName),
+		stringAttributeCreateBuilder(d, "display_name", request.WithDisplayName),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if _, err := client.OpenflowDeployments.CreateSafely(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Openflow deployment %s: %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadOpenflowDeployment(ctx, d, meta)
+}
+
+func ReadOpenflowDeployment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deployment, err := client.OpenflowDeployments.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query Openflow deployment. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Openflow deployment id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	details, err := client.OpenflowDeployments.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var displayName, comment string
+	if deployment.DisplayName != nil {
+		displayName = *deployment.DisplayName
+	}
+	if deployment.Comment != nil {
+		comment = *deployment.Comment
+	}
+
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("display_name", displayName),
+		d.Set("comment", comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.OpenflowDeploymentToSchema(deployment)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.OpenflowDeploymentDetailsToSchema(details)}),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateOpenflowDeployment(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := client.OpenflowDeployments.Alter(ctx, sdk.NewAlterOpenflowDeploymentRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming Openflow deployment from %v to %v, err = %w", id.FullyQualifiedName(), newId.FullyQualifiedName(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	setRequest := sdk.NewOpenflowDeploymentSetRequest()
+	unsetRequest := sdk.NewOpenflowDeploymentUnsetRequest()
+
+	if errs := errors.Join(
+		attributeMappedValueUpdate(d, "event_table", &setRequest.EventTable, &unsetRequest.EventTable, openflowEventTableName),
+		stringAttributeUpdate(d, "display_name", &setRequest.DisplayName, &unsetRequest.DisplayName),
+		stringAttributeUpdate(d, "comment", &setRequest.Comment, &unsetRequest.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if !reflect.DeepEqual(*setRequest, *sdk.NewOpenflowDeploymentSetRequest()) {
+		if err := client.OpenflowDeployments.Alter(ctx, sdk.NewAlterOpenflowDeploymentRequest(id).WithSet(*setRequest)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting properties for Openflow deployment %v, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if !reflect.DeepEqual(*unsetRequest, *sdk.NewOpenflowDeploymentUnsetRequest()) {
+		if err := client.OpenflowDeployments.Alter(ctx, sdk.NewAlterOpenflowDeploymentRequest(id).WithUnset(*unsetRequest)); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting properties for Openflow deployment %v, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	return ReadOpenflowDeployment(ctx, d, meta)
+}
+
+// openflowEventTableName normalizes the event table identifier, because Snowflake expects it as a string literal.
+func openflowEventTableName(value string) (string, error) {
+	id, err := sdk.ParseSchemaObjectIdentifier(value)
+	if err != nil {
+		return "", err
+	}
+	return id.FullyQualifiedName(), nil
+}
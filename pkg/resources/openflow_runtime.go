@@ -0,0 +1,276 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var openflowRuntimeSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the Openflow runtime; must be unique for the database and schema in which the runtime is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the Openflow runtime."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the Openflow runtime."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"deployment": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      relatedResourceDescription("Specifies the Openflow deployment in which the runtime is created.", resources.OpenflowDeployment),
+	},
+	"execute_as_role": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      "Specifies the role used by the Openflow runtime to access Snowflake objects.",
+	},
+	"node_type": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		ValidateDiagFunc: sdkValidation(sdk.ToOpenflowRuntimeNodeType),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToOpenflowRuntimeNodeType),
+		Description:      fmt.Sprintf("Specifies the size of the nodes of the Openflow runtime. Valid values are (case-insensitive): %s.", possibleValuesListed(sdk.AllOpenflowRuntimeNodeTypes)),
+	},
+	"min_nodes": {
+		Type:             schema.TypeInt,
+		Required:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		Description:      "Specifies the minimum number of nodes of the Openflow runtime.",
+	},
+	"max_nodes": {
+		Type:             schema.TypeInt,
+		Required:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		Description:      "Specifies the maximum number of nodes of the Openflow runtime.",
+	},
+	"external_access_integrations": {
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:             schema.TypeString,
+			ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		},
+		DiffSuppressFunc: NormalizeAndCompareIdentifiersInSet("external_access_integrations"),
+		Description:      "Specifies the names of the external access integrations that allow the Openflow runtime to access external sites.",
+	},
+	"display_name": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies the name of the Openflow runtime displayed in the Openflow user interface.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the Openflow runtime.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW OPENFLOW RUNTIMES` for the given Openflow runtime.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowOpenflowRuntimeSchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE OPENFLOW RUNTIME` for the given Openflow runtime.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowOpenflowRuntimeDetailsSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func OpenflowRuntime() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.OpenflowRuntimes.DropSafelyAndWait
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.OpenflowRuntimeResource), TrackingCreateWrapper(resources.OpenflowRuntime, CreateOpenflowRuntime)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.OpenflowRuntimeResource), TrackingReadWrapper(resources.OpenflowRuntime, ReadOpenflowRuntime)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.OpenflowRuntimeResource), TrackingUpdateWrapper(resources.OpenflowRuntime, UpdateOpenflowRuntime)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.OpenflowRuntimeResource), TrackingDeleteWrapper(resources.OpenflowRuntime, deleteFunc)),
+		Description:   "Resource used to manage Openflow runtime objects. An Openflow runtime is created in an Openflow deployment and runs the Openflow connectors. " + openflowTimeoutsNote,
+
+		Schema: openflowRuntimeSchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.OpenflowRuntime, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.OpenflowRuntime, customdiff.All(
+			ComputedIfAnyAttributeChanged(openflowRuntimeSchema, ShowOutputAttributeName, "name", "execute_as_role", "min_nodes", "max_nodes", "external_access_integrations", "display_name", "comment"),
+			ComputedIfAnyAttributeChanged(openflowRuntimeSchema, DescribeOutputAttributeName, "name", "execute_as_role", "min_nodes", "max_nodes", "external_access_integrations", "display_name", "comment"),
+			ComputedIfAnyAttributeChanged(openflowRuntimeSchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+		Timeouts: openflowTimeouts,
+	}
+}
+
+func CreateOpenflowRuntime(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id := sdk.NewSchemaObjectIdentifier(d.Get("database").(string), d.Get("schema").(string), d.Get("name").(string))
+	deploymentId, err := sdk.ParseAccountObjectIdentifier(d.Get("deployment").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	executeAsRoleId, err := sdk.ParseAccountObjectIdentifier(d.Get("execute_as_role").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	nodeType, err := sdk.ToOpenflowRuntimeNodeType(d.Get("node_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := sdk.NewCreateOpenflowRuntimeRequest(id, deploymentId, executeAsRoleId, nodeType, d.Get("min_nodes").(int), d.Get("max_nodes").(int))
+	if errs := errors.Join(
+		attributeMappedValueCreateBuilder(d, "external_access_integrations", request.WithExternalAccessIntegrations, ToOpenflowRuntimeExternalAccessIntegrationsRequest),
+		stringAttributeCreateBuilder(d, "display_name", request.WithDisplayName),
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if _, err := client.OpenflowRuntimes.CreateSafely(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Openflow runtime %s: %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadOpenflowRuntime(ctx, d, meta)
+}
+
+func ReadOpenflowRuntime(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	runtime, err := client.OpenflowRuntimes.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query Openflow runtime. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Openflow runtime id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	details, err := client.OpenflowRuntimes.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var displayName, comment string
+	if runtime.DisplayName != nil {
+		displayName = *runtime.DisplayName
+	}
+	if runtime.Comment != nil {
+		comment = *runtime.Comment
+	}
+
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("deployment", runtime.Deployment),
+		d.Set("execute_as_role", runtime.ExecuteAsRole),
+		d.Set("node_type", string(runtime.NodeType)),
+		d.Set("min_nodes", runtime.MinNodes),
+		d.Set("max_nodes", runtime.MaxNodes),
+		d.Set("external_access_integrations", collections.Map(runtime.ExternalAccessIntegrations, sdk.AccountObjectIdentifier.Name)),
+		d.Set("display_name", displayName),
+		d.Set("comment", comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.OpenflowRuntimeToSchema(runtime)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.OpenflowRuntimeDetailsToSchema(details)}),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateOpenflowRuntime(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.OpenflowRuntimes.AlterSafely(ctx, sdk.NewAlterOpenflowRuntimeRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming Openflow runtime from %v to %v, err = %w", id.FullyQualifiedName(), newId.FullyQualifiedName(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	setRequest := sdk.NewOpenflowRuntimeSetRequest()
+	unsetRequest := sdk.NewOpenflowRuntimeUnsetRequest()
+
+	if errs := errors.Join(
+		intAttributeUpdateSetOnly(d, "min_nodes", &setRequest.MinNodes),
+		intAttributeUpdateSetOnly(d, "max_nodes", &setRequest.MaxNodes),
+		accountObjectIdentifierAttributeSetOnly(d, "execute_as_role", &setRequest.ExecuteAsRole),
+		attributeMappedValueUpdate(d, "external_access_integrations", &setRequest.ExternalAccessIntegrations, &unsetRequest.ExternalAccessIntegrations, ToOpenflowRuntimeExternalAccessIntegrationsRequest),
+		stringAttributeUpdate(d, "display_name", &setRequest.DisplayName, &unsetRequest.DisplayName),
+		stringAttributeUpdate(d, "comment", &setRequest.Comment, &unsetRequest.Comment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if !reflect.DeepEqual(*setRequest, *sdk.NewOpenflowRuntimeSetRequest()) {
+		if err := client.OpenflowRuntimes.AlterSafely(ctx, sdk.NewAlterOpenflowRuntimeRequest(id).WithSet(*setRequest)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting properties for Openflow runtime %v, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if !reflect.DeepEqual(*unsetRequest, *sdk.NewOpenflowRuntimeUnsetRequest()) {
+		if err := client.OpenflowRuntimes.AlterSafely(ctx, sdk.NewAlterOpenflowRuntimeRequest(id).WithUnset(*unsetRequest)); err != nil {
+			return diag.FromErr(fmt.Errorf("error unsetting properties for Openflow runtime %v, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	return ReadOpenflowRuntime(ctx, d, meta)
+}
@@ -0,0 +1,452 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/util"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var organizationAccountSchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+		Description:      "Specifies the identifier (i.e. name) for the organization account. It must be unique within an organization.",
+	},
+	"admin_name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Sensitive:        true,
+		Description:      externalChangesNotDetectedFieldDescription("Login name of the initial administrative user of the organization account. A new user is created in the new account with this name and password and granted the GLOBALORGADMIN role in the account."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+	},
+	"admin_password": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		Description:      externalChangesNotDetectedFieldDescription("Password for the initial administrative user of the organization account. Either admin_password or admin_rsa_public_key has to be specified."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+		AtLeastOneOf:     []string{"admin_password", "admin_rsa_public_key"},
+	},
+	"admin_rsa_public_key": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      externalChangesNotDetectedFieldDescription("Assigns a public key to the initial administrative user of the organization account. Either admin_password or admin_rsa_public_key has to be specified."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+		AtLeastOneOf:     []string{"admin_password", "admin_rsa_public_key"},
+	},
+	"first_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		Description:      externalChangesNotDetectedFieldDescription("First name of the initial administrative user of the organization account."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+	},
+	"last_name": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		Description:      externalChangesNotDetectedFieldDescription("Last name of the initial administrative user of the organization account."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+	},
+	"email": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Sensitive:        true,
+		Description:      externalChangesNotDetectedFieldDescription("Email address of the initial administrative user of the organization account. This email address is used to send any notifications about the account."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+	},
+	"must_change_password": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Default:          BooleanDefault,
+		Description:      externalChangesNotDetectedFieldDescription("Specifies whether the new user created to administer the organization account is forced to change their password upon first login into the account."),
+		DiffSuppressFunc: IgnoreAfterCreation,
+		ValidateDiagFunc: validateBooleanString,
+	},
+	"edition": {
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      fmt.Sprintf("Snowflake Edition of the organization account. Valid options are: %s", possibleValuesListed(sdk.AllOrganizationAccountEditions)),
+		DiffSuppressFunc: NormalizeAndCompare(sdk.ToOrganizationAccountEdition),
+		ValidateDiagFunc: sdkValidation(sdk.ToOrganizationAccountEdition),
+	},
+	"region_group": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: externalChangesNotDetectedFieldDescription("ID of the region group where the organization account is created. To retrieve the region group ID for existing accounts in your organization, execute the [SHOW REGIONS](https://docs.snowflake.com/en/sql-reference/sql/show-regions) command."),
+	},
+	"region": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: IgnoreChangeToCurrentSnowflakeValueInShow("snowflake_region"),
+		Description:      "[Snowflake Region ID](https://docs.snowflake.com/en/user-guide/admin-account-identifier.html#label-snowflake-region-ids) of the region where the organization account is created. If no value is provided, Snowflake creates the account in the same Snowflake Region as the current account.",
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the organization account.",
+	},
+	"resource_monitor": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      notReadBackFieldDescription("Parameter that specifies the name of the resource monitor used to control all virtual warehouses created in the organization account."),
+		ValidateDiagFunc: IsValidIdentifier[sdk.AccountObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"password_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      notReadBackFieldDescription(relatedResourceDescription("Specifies [password policy](https://docs.snowflake.com/en/user-guide/password-authentication#label-using-password-policies) for the organization account.", resources.PasswordPolicy)),
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"session_policy": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      notReadBackFieldDescription("Specifies [session policy](https://docs.snowflake.com/en/user-guide/session-policies-using) for the organization account."),
+		ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW ORGANIZATION ACCOUNTS` for the given organization account.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowOrganizationAccountSchema,
+		},
+	},
+}
+
+// organizationAccountParametersSchema reuses the account parameters, but without marking them as computed.
+// The resource monitor, the policies, and the parameters can be read only for the account the provider is connected to
+// (SHOW ORGANIZATION ACCOUNTS does not return the resource monitor, and both POLICY_REFERENCES and SHOW PARAMETERS IN ACCOUNT
+// work only for the current account), so they are set through ALTER ORGANIZATION ACCOUNT and their values are not read back.
+func organizationAccountParametersSchema() map[string]*schema.Schema {
+	parametersSchema := make(map[string]*schema.Schema, len(accountParametersSchema))
+	for key, value := range accountParametersSchema {
+		parameterSchema := *value
+		parameterSchema.Computed = false
+		parameterSchema.Description = notReadBackFieldDescription(value.Description)
+		parametersSchema[key] = &parameterSchema
+	}
+	return parametersSchema
+}
+
+func OrganizationAccount() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Resource used to create and manage organization accounts. For more information, check [organization account documentation](https://docs.snowflake.com/en/user-guide/organization-accounts). To manage the organization account you are connected to, use the [snowflake_current_organization_account](./current_organization_account) resource instead. Snowflake does not support dropping organization accounts, so destroying this resource only removes it from the Terraform state.",
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.OrganizationAccountResource), TrackingCreateWrapper(resources.OrganizationAccount, CreateOrganizationAccount)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.OrganizationAccountResource), TrackingReadWrapper(resources.OrganizationAccount, ReadOrganizationAccount)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.OrganizationAccountResource), TrackingUpdateWrapper(resources.OrganizationAccount, UpdateOrganizationAccount)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.OrganizationAccountResource), TrackingDeleteWrapper(resources.OrganizationAccount, DeleteOrganizationAccount)),
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.OrganizationAccount, customdiff.All(
+			ComputedIfAnyAttributeChanged(organizationAccountSchema, FullyQualifiedNameAttributeName, "name"),
+			ComputedIfAnyAttributeChanged(organizationAccountSchema, ShowOutputAttributeName, "name", "comment"),
+		)),
+
+		Schema: collections.MergeMaps(organizationAccountSchema, organizationAccountParametersSchema()),
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.OrganizationAccount, ImportOrganizationAccount),
+		},
+
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func ImportOrganizationAccount(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	client := meta.(*provider.Context).Client
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	organizationAccount, err := client.OrganizationAccounts.ShowByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := errors.Join(
+		d.Set("name", id.Name()),
+		d.Set("edition", string(organizationAccount.Edition)),
+		d.Set("region", organizationAccount.SnowflakeRegion),
+		d.Set("must_change_password", BooleanDefault),
+	); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func CreateOrganizationAccount(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	edition, err := sdk.ToOrganizationAccountEdition(d.Get("edition").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := sdk.NewCreateOrganizationAccountRequest(id, d.Get("admin_name").(string), d.Get("email").(string), edition)
+	if errs := errors.Join(
+		stringAttributeCreateBuilder(d, "admin_password", req.WithAdminPassword),
+		stringAttributeCreateBuilder(d, "admin_rsa_public_key", req.WithAdminRsaPublicKey),
+		stringAttributeCreateBuilder(d, "first_name", req.WithFirstName),
+		stringAttributeCreateBuilder(d, "last_name", req.WithLastName),
+		booleanStringAttributeCreateBuilder(d, "must_change_password", req.WithMustChangePassword),
+		stringAttributeCreateBuilder(d, "region_group", req.WithRegionGroup),
+		stringAttributeCreateBuilder(d, "region", req.WithRegion),
+		stringAttributeCreateBuilder(d, "comment", req.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.OrganizationAccounts.Create(ctx, req); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := util.Retry(5, 3*time.Second, func() (error, bool) {
+		_, err = client.OrganizationAccounts.ShowByID(ctx, id)
+		if err != nil {
+			log.Printf("[DEBUG] retryable operation resulted in error: %v", err)
+			if errors.Is(err, sdk.ErrObjectNotFound) || errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+				return nil, false
+			} else {
+				return err, true
+			}
+		}
+		return nil, true
+	}); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to query organization account (%s) after creation, err: %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+
+	if diags := updateOrganizationAccountAttachmentsAndParameters(ctx, client, id, d); diags != nil {
+		return diags
+	}
+
+	return ReadOrganizationAccount(ctx, d, meta)
+}
+
+func ReadOrganizationAccount(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	organizationAccount, err := client.OrganizationAccounts.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query organization account. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Organization account: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	var comment string
+	if organizationAccount.Comment != nil {
+		comment = *organizationAccount.Comment
+	}
+
+	if errs := errors.Join(
+		d.Set("edition", string(organizationAccount.Edition)),
+		d.Set("comment", comment),
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.OrganizationAccountToSchema(organizationAccount)}),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	return nil
+}
+
+func UpdateOrganizationAccount(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId, err := sdk.ParseAccountObjectIdentifier(d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if err := client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest().
+			WithName(id).
+			WithRenameTo(*sdk.NewOrganizationAccountRenameRequest(&newId))); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming organization account %v to %v, err = %w", id.FullyQualifiedName(), newId.FullyQualifiedName(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	if d.HasChange("comment") {
+		if newComment := d.Get("comment").(string); newComment != "" {
+			if err := client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest().
+				WithName(id).
+				WithSet(*sdk.NewOrganizationAccountSetRequest().WithComment(newComment))); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err := client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest().
+				WithName(id).
+				WithUnset(*sdk.NewOrganizationAccountUnsetRequest().WithComment(true))); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if diags := updateOrganizationAccountAttachmentsAndParameters(ctx, client, id, d); diags != nil {
+		return diags
+	}
+
+	return ReadOrganizationAccount(ctx, d, meta)
+}
+
+// DeleteOrganizationAccount only removes the organization account from the state, because Snowflake does not support dropping organization accounts.
+func DeleteOrganizationAccount(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	id, err := sdk.ParseAccountObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[WARN] organization account %s cannot be dropped, removing it only from the state", id.FullyQualifiedName())
+	d.SetId("")
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Organization account was removed only from the state.",
+			Detail:   fmt.Sprintf("Snowflake does not support dropping organization accounts, so the organization account %s still exists in Snowflake.", id.FullyQualifiedName()),
+		},
+	}
+}
+
+// updateOrganizationAccountAttachmentsAndParameters applies the changes of resource monitor, policies and parameters.
+// It is used both in create and update, because these fields cannot be set in CREATE ORGANIZATION ACCOUNT.
+func updateOrganizationAccountAttachmentsAndParameters(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, d *schema.ResourceData) diag.Diagnostics {
+	if d.HasChange("resource_monitor") {
+		if v, ok := d.GetOk("resource_monitor"); ok {
+			resourceMonitorId, err := sdk.ParseAccountObjectIdentifier(v.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if err := client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest().
+				WithName(id).
+				WithSet(*sdk.NewOrganizationAccountSetRequest().WithResourceMonitor(resourceMonitorId))); err != nil {
+				return diag.FromErr(err)
+			}
+		} else {
+			if err := client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest().
+				WithName(id).
+				WithUnset(*sdk.NewOrganizationAccountUnsetRequest().WithResourceMonitor(true))); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	for _, policyKind := range []sdk.PolicyKind{sdk.PolicyKindPasswordPolicy, sdk.PolicyKindSessionPolicy} {
+		key := strings.ToLower(string(policyKind))
+		if !d.HasChange(key) {
+			continue
+		}
+		if err := unsetOrganizationAccountPolicy(ctx, client, id, policyKind); err != nil {
+			return diag.FromErr(err)
+		}
+		if v, ok := d.GetOk(key); ok {
+			policyId, err := sdk.ParseSchemaObjectIdentifier(v.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			set := sdk.NewOrganizationAccountSetRequest()
+			switch policyKind {
+			case sdk.PolicyKindPasswordPolicy:
+				set.WithPasswordPolicy(policyId)
+			case sdk.PolicyKindSessionPolicy:
+				set.WithSessionPolicy(policyId)
+			}
+			if err := client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest().WithName(id).WithSet(*set)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	setParameters := new(sdk.AccountParameters)
+	unsetParameters := new(sdk.AccountParametersUnset)
+	if diags := handleAccountParametersUpdate(d, setParameters, unsetParameters); diags != nil {
+		return diags
+	}
+	if *setParameters != (sdk.AccountParameters{}) {
+		if err := client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest().
+			WithName(id).
+			WithSet(*sdk.NewOrganizationAccountSetRequest().WithParameters(*setParameters))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if *unsetParameters != (sdk.AccountParametersUnset{}) {
+		if err := client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest().
+			WithName(id).
+			WithUnset(*sdk.NewOrganizationAccountUnsetRequest().WithParameters(*unsetParameters))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// unsetOrganizationAccountPolicy unsets the policy of the given kind on the organization account.
+// Snowflake returns an error when the policy is not attached, so it is ignored.
+func unsetOrganizationAccountPolicy(ctx context.Context, client *sdk.Client, id sdk.AccountObjectIdentifier, kind sdk.PolicyKind) error {
+	unset := sdk.NewOrganizationAccountUnsetRequest()
+	switch kind {
+	case sdk.PolicyKindPasswordPolicy:
+		unset.WithPasswordPolicy(true)
+	case sdk.PolicyKindSessionPolicy:
+		unset.WithSessionPolicy(true)
+	default:
+		return fmt.Errorf("policy kind %s is not supported for organization account policies", kind)
+	}
+	err := client.OrganizationAccounts.Alter(ctx, sdk.NewAlterOrganizationAccountRequest().WithName(id).WithUnset(*unset))
+	if errors.Is(err, sdk.ErrPolicyNotAttachedToAccount) {
+		return nil
+	}
+	return err
+}
@@ -92,7 +92,7 @@ func Pipe() *schema.Resource {
 		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] { return client.Pipes.DropSafely },
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypePipe, helpers.DecodeSnowflakeIDErrLegacy[sdk.SchemaObjectIdentifier], &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.PipeResource), TrackingCreateWrapper(resources.Pipe, CreatePipe)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.PipeResource), TrackingReadWrapper(resources.Pipe, ReadPipe)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.PipeResource), TrackingUpdateWrapper(resources.Pipe, UpdatePipe)),
@@ -103,7 +103,7 @@ func Pipe() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func pipeCopyStatementDiffSuppress(_, o, n string, _ *schema.ResourceData) bool {
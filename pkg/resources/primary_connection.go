@@ -27,7 +27,7 @@ var primaryConnectionSchema = map[string]*schema.Schema{
 	"is_primary": {
 		Type:        schema.TypeBool,
 		Computed:    true,
-		Description: "Indicates if the connection is primary. When Terraform detects that the connection is not primary, the resource is recreated.",
+		Description: "Indicates if the connection is primary. When Terraform detects that the connection is not primary and `primary_account` is not set, the resource is recreated.",
 	},
 	"enable_failover_to_accounts": {
 		Type:        schema.TypeList,
@@ -38,6 +38,7 @@ var primaryConnectionSchema = map[string]*schema.Schema{
 			DiffSuppressFunc: suppressIdentifierQuoting,
 		},
 	},
+	"primary_account": primaryAccountSchema("connection"),
 	"comment": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -62,8 +63,9 @@ func PrimaryConnection() *schema.Resource {
 		DeleteContext: TrackingDeleteWrapper(resources.PrimaryConnection, DeleteConnection),
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.PrimaryConnection, customdiff.All(
-			ComputedIfAnyAttributeChanged(primaryConnectionSchema, ShowOutputAttributeName, "comment", "is_primary", "enable_failover_to_accounts"),
-			RecreateWhenResourceBoolFieldChangedExternally("is_primary", true),
+			ComputedIfAnyAttributeChanged(primaryConnectionSchema, ShowOutputAttributeName, "comment", "is_primary", "enable_failover_to_accounts", "primary_account"),
+			ComputedIfAnyAttributeChanged(primaryConnectionSchema, "is_primary", "primary_account"),
+			customdiff.If(primaryAccountNotSetInConfig, RecreateWhenResourceBoolFieldChangedExternally("is_primary", true)),
 		)),
 
 		Description: "Resource used to manage primary connections. For managing replicated connection check resource [snowflake_secondary_connection](./secondary_connection). For more information, check [connection documentation](https://docs.snowflake.com/en/sql-reference/sql/create-connection.html).",
@@ -150,6 +152,7 @@ func ReadContextPrimaryConnection(ctx context.Context, d *schema.ResourceData, m
 		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
 		d.Set(ShowOutputAttributeName, []map[string]any{schemas.ConnectionToSchema(connection)}),
 		d.Set("comment", connection.Comment),
+		d.Set("primary_account", connection.Primary.AccountIdentifier().Name()),
 	)
 	if errs != nil {
 		return diag.FromErr(errs)
@@ -259,5 +262,11 @@ func UpdateContextPrimaryConnection(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChange("primary_account") {
+		if err := promoteConnectionIfPrimaryInCurrentAccount(ctx, d, client, id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ReadContextPrimaryConnection(ctx, d, meta)
 }
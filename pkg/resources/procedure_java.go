@@ -21,7 +21,7 @@ import (
 )
 
 func ProcedureJava() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeProcedure, sdk.ParseSchemaObjectIdentifierWithArguments, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ProcedureJavaResource), TrackingCreateWrapper(resources.ProcedureJava, CreateContextProcedureJava)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ProcedureJavaResource), TrackingReadWrapper(resources.ProcedureJava, ReadContextProcedureJava)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ProcedureJavaResource), TrackingUpdateWrapper(resources.ProcedureJava, UpdateProcedure("JAVA", ReadContextProcedureJava))),
@@ -45,7 +45,7 @@ func ProcedureJava() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.ProcedureJava, ImportProcedure),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateContextProcedureJava(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -20,7 +20,7 @@ import (
 )
 
 func ProcedureJavascript() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeProcedure, sdk.ParseSchemaObjectIdentifierWithArguments, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ProcedureJavascriptResource), TrackingCreateWrapper(resources.ProcedureJavascript, CreateContextProcedureJavascript)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ProcedureJavascriptResource), TrackingReadWrapper(resources.ProcedureJavascript, ReadContextProcedureJavascript)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ProcedureJavascriptResource), TrackingUpdateWrapper(resources.ProcedureJavascript, UpdateProcedure("JAVASCRIPT", ReadContextProcedureJavascript))),
@@ -44,7 +44,7 @@ func ProcedureJavascript() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.ProcedureJavascript, ImportProcedure),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateContextProcedureJavascript(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
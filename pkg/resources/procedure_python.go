@@ -21,7 +21,7 @@ import (
 )
 
 func ProcedurePython() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeProcedure, sdk.ParseSchemaObjectIdentifierWithArguments, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ProcedurePythonResource), TrackingCreateWrapper(resources.ProcedurePython, CreateContextProcedurePython)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ProcedurePythonResource), TrackingReadWrapper(resources.ProcedurePython, ReadContextProcedurePython)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ProcedurePythonResource), TrackingUpdateWrapper(resources.ProcedurePython, UpdateProcedure("PYTHON", ReadContextProcedurePython))),
@@ -45,7 +45,7 @@ func ProcedurePython() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.ProcedurePython, ImportProcedure),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateContextProcedurePython(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
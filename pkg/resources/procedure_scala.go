@@ -21,7 +21,7 @@ import (
 )
 
 func ProcedureScala() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeProcedure, sdk.ParseSchemaObjectIdentifierWithArguments, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ProcedureScalaResource), TrackingCreateWrapper(resources.ProcedureScala, CreateContextProcedureScala)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ProcedureScalaResource), TrackingReadWrapper(resources.ProcedureScala, ReadContextProcedureScala)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ProcedureScalaResource), TrackingUpdateWrapper(resources.ProcedureScala, UpdateProcedure("SCALA", ReadContextProcedureScala))),
@@ -45,7 +45,7 @@ func ProcedureScala() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.ProcedureScala, ImportProcedure),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateContextProcedureScala(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -20,7 +20,7 @@ import (
 )
 
 func ProcedureSql() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeProcedure, sdk.ParseSchemaObjectIdentifierWithArguments, &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ProcedureSqlResource), TrackingCreateWrapper(resources.ProcedureSql, CreateContextProcedureSql)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ProcedureSqlResource), TrackingReadWrapper(resources.ProcedureSql, ReadContextProcedureSql)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ProcedureSqlResource), TrackingUpdateWrapper(resources.ProcedureSql, UpdateProcedure("SQL", ReadContextProcedureSql))),
@@ -44,7 +44,7 @@ func ProcedureSql() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.ProcedureSql, ImportProcedure),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateContextProcedureSql(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -0,0 +1,198 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/schemas"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var projectionPolicySchema = map[string]*schema.Schema{
+	"name": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("Specifies the identifier for the projection policy; must be unique for the database and schema in which the projection policy is created."),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"schema": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("The schema in which to create the projection policy."),
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"database": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      blocklistedCharactersFieldDescription("The database in which to create the projection policy."),
+		ForceNew:         true,
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	},
+	"body": {
+		Type:             schema.TypeString,
+		Required:         true,
+		Description:      diffSuppressStatementFieldDescription("Specifies the SQL expression that determines whether a column can be projected. The expression must return `PROJECTION_CONSTRAINT(ALLOW => true)` or `PROJECTION_CONSTRAINT(ALLOW => false)`."),
+		DiffSuppressFunc: DiffSuppressStatement,
+		ValidateFunc:     validation.StringIsNotEmpty,
+	},
+	"comment": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Specifies a comment for the projection policy.",
+	},
+	ShowOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `SHOW PROJECTION POLICIES` for the given projection policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowProjectionPolicySchema,
+		},
+	},
+	DescribeOutputAttributeName: {
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Outputs the result of `DESCRIBE PROJECTION POLICY` for the given projection policy.",
+		Elem: &schema.Resource{
+			Schema: schemas.ShowProjectionPolicyDetailsSchema,
+		},
+	},
+	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
+}
+
+func ProjectionPolicy() *schema.Resource {
+	deleteFunc := ResourceDeleteContextFunc(
+		sdk.ParseSchemaObjectIdentifier,
+		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] {
+			return client.ProjectionPolicies.DropSafely
+		},
+	)
+
+	return &schema.Resource{
+		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.ProjectionPolicyResource), TrackingCreateWrapper(resources.ProjectionPolicy, CreateProjectionPolicy)),
+		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.ProjectionPolicyResource), TrackingReadWrapper(resources.ProjectionPolicy, ReadProjectionPolicy)),
+		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.ProjectionPolicyResource), TrackingUpdateWrapper(resources.ProjectionPolicy, UpdateProjectionPolicy)),
+		DeleteContext: PreviewFeatureDeleteContextWrapper(string(previewfeatures.ProjectionPolicyResource), TrackingDeleteWrapper(resources.ProjectionPolicy, deleteFunc)),
+		Description:   "Resource used to manage projection policy objects. For more information, check [projection policy documentation](https://docs.snowflake.com/en/sql-reference/sql/create-projection-policy).",
+
+		Schema: projectionPolicySchema,
+		Importer: &schema.ResourceImporter{
+			StateContext: TrackingImportWrapper(resources.ProjectionPolicy, ImportName[sdk.SchemaObjectIdentifier]),
+		},
+
+		CustomizeDiff: TrackingCustomDiffWrapper(resources.ProjectionPolicy, customdiff.All(
+			ComputedIfAnyAttributeChanged(projectionPolicySchema, ShowOutputAttributeName, "name", "comment"),
+			ComputedIfAnyAttributeChanged(projectionPolicySchema, DescribeOutputAttributeName, "name", "body"),
+			ComputedIfAnyAttributeChanged(projectionPolicySchema, FullyQualifiedNameAttributeName, "name"),
+		)),
+		Timeouts: defaultTimeouts,
+	}
+}
+
+func CreateProjectionPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+
+	databaseName := d.Get("database").(string)
+	schemaName := d.Get("schema").(string)
+	name := d.Get("name").(string)
+	id := sdk.NewSchemaObjectIdentifier(databaseName, schemaName, name)
+
+	request := sdk.NewCreateProjectionPolicyRequest(id, d.Get("body").(string))
+
+	if errs := errors.Join(
+		stringAttributeCreateBuilder(d, "comment", request.WithComment),
+	); errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	if err := client.ProjectionPolicies.Create(ctx, request); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating projection policy %v, err = %w", id.FullyQualifiedName(), err))
+	}
+
+	d.SetId(helpers.EncodeResourceIdentifier(id))
+	return ReadProjectionPolicy(ctx, d, meta)
+}
+
+func ReadProjectionPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	projectionPolicy, err := client.ProjectionPolicies.ShowByIDSafely(ctx, id)
+	if err != nil {
+		if errors.Is(err, sdk.ErrObjectNotFound) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to query projection policy. Marking the resource as removed.",
+					Detail:   fmt.Sprintf("Projection policy id: %s, Err: %s", id.FullyQualifiedName(), err),
+				},
+			}
+		}
+		return diag.FromErr(err)
+	}
+	projectionPolicyDetails, err := client.ProjectionPolicies.Describe(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	errs := errors.Join(
+		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
+		d.Set("body", projectionPolicyDetails.Body),
+		d.Set("comment", projectionPolicy.Comment),
+		d.Set(ShowOutputAttributeName, []map[string]any{schemas.ProjectionPolicyToSchema(projectionPolicy)}),
+		d.Set(DescribeOutputAttributeName, []map[string]any{schemas.ProjectionPolicyDetailsToSchema(projectionPolicyDetails)}),
+	)
+	return diag.FromErr(errs)
+}
+
+func UpdateProjectionPolicy(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*provider.Context).Client
+	id, err := sdk.ParseSchemaObjectIdentifier(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") {
+		newId := sdk.NewSchemaObjectIdentifierInSchema(id.SchemaId(), d.Get("name").(string))
+
+		if err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithRenameTo(newId)); err != nil {
+			return diag.FromErr(fmt.Errorf("error renaming projection policy from %v to %v, err = %w", id.FullyQualifiedName(), newId.FullyQualifiedName(), err))
+		}
+
+		d.SetId(helpers.EncodeResourceIdentifier(newId))
+		id = newId
+	}
+
+	if d.HasChange("body") {
+		if err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithSetBody(d.Get("body").(string))); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating projection policy %v body, err = %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if d.HasChange("comment") {
+		if comment := d.Get("comment").(string); comment != "" {
+			if err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithSetComment(comment)); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting comment for projection policy %v, err = %w", id.FullyQualifiedName(), err))
+			}
+		} else {
+			if err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithUnsetComment(true)); err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting comment for projection policy %v, err = %w", id.FullyQualifiedName(), err))
+			}
+		}
+	}
+
+	return ReadProjectionPolicy(ctx, d, meta)
+}
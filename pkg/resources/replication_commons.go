@@ -0,0 +1,33 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// primaryAccountSchema returns the schema of the primary_account attribute shared by the replicated objects (failover groups and connections).
+func primaryAccountSchema(objectName string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		Description: fmt.Sprintf("Specifies the account holding the primary %[1]s, in the form `<org_name>.<account_name>`. "+
+			"When set to the account in which the %[1]s is managed and the %[1]s is not primary yet, the provider promotes it to serve as the primary %[1]s. "+
+			"Setting it to a different account does not run any statement; the promotion is done by the configuration managing that account. "+
+			"When set, the primary status of the %[1]s can change between accounts without recreating the resource.", objectName),
+		DiffSuppressFunc: suppressIdentifierQuoting,
+	}
+}
+
+// isCurrentAccount checks if the account in the form `<org_name>.<account_name>` points to the account described by the given organization and account names.
+func isCurrentAccount(account string, organizationName string, accountName string) bool {
+	return sdk.NewAccountIdentifierFromFullyQualifiedName(account).FullyQualifiedName() == sdk.NewAccountIdentifier(organizationName, accountName).FullyQualifiedName()
+}
+
+// primaryAccountNotSetInConfig is used to keep the recreation on external primary status changes for configurations that do not manage the primary account.
+func primaryAccountNotSetInConfig(_ context.Context, d *schema.ResourceDiff, _ any) bool {
+	return d.GetRawConfig().AsValueMap()["primary_account"].IsNull()
+}
@@ -0,0 +1,27 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsCurrentAccount(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Account  string
+		Expected bool
+	}{
+		{Name: "same account", Account: "ORG.ACCOUNT", Expected: true},
+		{Name: "same account quoted", Account: `"ORG"."ACCOUNT"`, Expected: true},
+		{Name: "different account", Account: "ORG.OTHER_ACCOUNT", Expected: false},
+		{Name: "different organization", Account: "OTHER_ORG.ACCOUNT", Expected: false},
+		{Name: "different case", Account: "org.account", Expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			assert.Equal(t, tc.Expected, isCurrentAccount(tc.Account, "ORG", "ACCOUNT"))
+		})
+	}
+}
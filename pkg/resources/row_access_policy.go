@@ -106,7 +106,7 @@ func RowAccessPolicy() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeRowAccessPolicy, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		SchemaVersion: 2,
 
 		CreateContext: TrackingCreateWrapper(resources.RowAccessPolicy, CreateRowAccessPolicy),
@@ -141,7 +141,7 @@ func RowAccessPolicy() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportRowAccessPolicy(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -153,7 +153,7 @@ var saml2IntegrationSchema = map[string]*schema.Schema{
 }
 
 func SAML2Integration() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.Saml2SecurityIntegration, CreateContextSAML2Integration),
 		ReadContext:   TrackingReadWrapper(resources.Saml2SecurityIntegration, ReadContextSAML2Integration(true)),
 		UpdateContext: TrackingUpdateWrapper(resources.Saml2SecurityIntegration, UpdateContextSAML2Integration),
@@ -178,7 +178,7 @@ func SAML2Integration() *schema.Resource {
 				"allowed_email_patterns"),
 		)),
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportSaml2Integration(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -96,7 +96,7 @@ func Schema() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeSchema, sdk.ParseDatabaseObjectIdentifier, &schema.Resource{
 		SchemaVersion: 2,
 
 		CreateContext: TrackingCreateWrapper(resources.Schema, CreateContextSchema),
@@ -134,7 +134,7 @@ func Schema() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportSchema(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -30,7 +30,7 @@ var secondaryConnectionSchema = map[string]*schema.Schema{
 	"is_primary": {
 		Type:        schema.TypeBool,
 		Computed:    true,
-		Description: "Indicates if the connection primary status has been changed. If change is detected, resource will be recreated.",
+		Description: "Indicates if the connection primary status has been changed. If change is detected and `primary_account` is not set, resource will be recreated.",
 	},
 	"as_replica_of": {
 		Type:             schema.TypeString,
@@ -39,6 +39,7 @@ var secondaryConnectionSchema = map[string]*schema.Schema{
 		Description:      relatedResourceDescription("Specifies the identifier for a primary connection from which to create a replica (i.e. a secondary connection).", resources.PrimaryConnection),
 		DiffSuppressFunc: suppressIdentifierQuoting,
 	},
+	"primary_account": primaryAccountSchema("connection"),
 	"comment": {
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -64,8 +65,9 @@ func SecondaryConnection() *schema.Resource {
 		Description:   "Resource used to manage secondary (replicated) connections. To manage primary connection check resource [snowflake_primary_connection](./primary_connection). For more information, check [connection documentation](https://docs.snowflake.com/en/sql-reference/sql/create-connection.html).",
 
 		CustomizeDiff: TrackingCustomDiffWrapper(resources.SecondaryConnection, customdiff.All(
-			ComputedIfAnyAttributeChanged(secondaryConnectionSchema, ShowOutputAttributeName, "comment", "is_primary"),
-			RecreateWhenResourceBoolFieldChangedExternally("is_primary", false),
+			ComputedIfAnyAttributeChanged(secondaryConnectionSchema, ShowOutputAttributeName, "comment", "is_primary", "primary_account"),
+			ComputedIfAnyAttributeChanged(secondaryConnectionSchema, "is_primary", "primary_account"),
+			customdiff.If(primaryAccountNotSetInConfig, RecreateWhenResourceBoolFieldChangedExternally("is_primary", false)),
 		)),
 
 		Schema: secondaryConnectionSchema,
@@ -112,6 +114,10 @@ func CreateContextSecondaryConnection(ctx context.Context, d *schema.ResourceDat
 
 	d.SetId(helpers.EncodeResourceIdentifier(id))
 
+	if err := promoteConnectionIfPrimaryInCurrentAccount(ctx, d, client, id); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return ReadContextSecondaryConnection(ctx, d, meta)
 }
 
@@ -143,13 +149,25 @@ func ReadContextSecondaryConnection(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	return diag.FromErr(errors.Join(
+	errs := errors.Join(
 		d.Set("is_primary", connection.IsPrimary),
 		d.Set(FullyQualifiedNameAttributeName, id.FullyQualifiedName()),
 		d.Set(ShowOutputAttributeName, []map[string]any{schemas.ConnectionToSchema(connection)}),
 		d.Set("comment", connection.Comment),
-		d.Set("as_replica_of", connection.Primary.FullyQualifiedName()),
-	))
+		d.Set("primary_account", connection.Primary.AccountIdentifier().Name()),
+	)
+	if errs != nil {
+		return diag.FromErr(errs)
+	}
+
+	// After a promotion, the primary connection points to this connection, so as_replica_of is left untouched to prevent the recreation.
+	if !connection.IsPrimary {
+		if err := d.Set("as_replica_of", connection.Primary.FullyQualifiedName()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
 }
 
 func UpdateContextSecondaryConnection(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -185,5 +203,11 @@ func UpdateContextSecondaryConnection(ctx context.Context, d *schema.ResourceDat
 		}
 	}
 
+	if d.HasChange("primary_account") {
+		if err := promoteConnectionIfPrimaryInCurrentAccount(ctx, d, client, id); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return ReadContextSecondaryConnection(ctx, d, meta)
 }
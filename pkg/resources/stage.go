@@ -107,7 +107,7 @@ func Stage() *schema.Resource {
 		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] { return client.Stages.DropSafely },
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeStage, helpers.DecodeSnowflakeIDErrLegacy[sdk.SchemaObjectIdentifier], &schema.Resource{
 		DeprecationMessage: deprecatedResourceDescription(string(resources.InternalStage), string(resources.ExternalS3Stage), string(resources.ExternalS3CompatibleStage), string(resources.ExternalGcsStage), string(resources.ExternalAzureStage)),
 
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.StageResource), TrackingCreateWrapper(resources.Stage, CreateStage)),
@@ -120,7 +120,7 @@ func Stage() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateStage(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
@@ -146,7 +146,7 @@ func StorageIntegration() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		DeprecationMessage: deprecatedResourceDescription("snowflake_storage_integration_aws", "snowflake_storage_integration_azure", "snowflake_storage_integration_gcs"),
 
 		SchemaVersion: 1,
@@ -171,7 +171,7 @@ func StorageIntegration() *schema.Resource {
 				Upgrade: v2_7_0_StorageIntegrationUsePrivatelinkEndpointUpgrader,
 			},
 		},
-	}
+	})
 }
 
 func GetReadStorageIntegrationFunc(withExternalChangesMarking bool) schema.ReadContextFunc {
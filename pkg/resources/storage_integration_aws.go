@@ -115,7 +115,7 @@ func StorageIntegrationAws() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.StorageIntegrationAws, CreateStorageIntegrationAws),
 		ReadContext:   TrackingReadWrapper(resources.StorageIntegrationAws, GetReadStorageIntegrationAwsFunc(true)),
 		UpdateContext: TrackingUpdateWrapper(resources.StorageIntegrationAws, UpdateStorageIntegrationAws),
@@ -131,7 +131,7 @@ func StorageIntegrationAws() *schema.Resource {
 			ComputedIfAnyAttributeChanged(storageIntegrationAwsSchema, ShowOutputAttributeName, "enabled", "comment"),
 			ComputedIfAnyAttributeChanged(storageIntegrationAwsSchema, DescribeOutputAttributeName, "enabled", "storage_allowed_locations", "storage_blocked_locations", "comment", "use_privatelink_endpoint", "storage_aws_role_arn", "storage_aws_external_id", "storage_aws_object_acl"),
 		),
-	}
+	})
 }
 
 func ImportStorageIntegrationAws(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
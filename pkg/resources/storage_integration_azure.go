@@ -94,7 +94,7 @@ func StorageIntegrationAzure() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.StorageIntegrationAzure, CreateStorageIntegrationAzure),
 		ReadContext:   TrackingReadWrapper(resources.StorageIntegrationAzure, GetReadStorageIntegrationAzureFunc(true)),
 		UpdateContext: TrackingUpdateWrapper(resources.StorageIntegrationAzure, UpdateStorageIntegrationAzure),
@@ -110,7 +110,7 @@ func StorageIntegrationAzure() *schema.Resource {
 			ComputedIfAnyAttributeChanged(storageIntegrationAzureSchema, ShowOutputAttributeName, "enabled", "comment"),
 			ComputedIfAnyAttributeChanged(storageIntegrationAzureSchema, DescribeOutputAttributeName, "enabled", "storage_allowed_locations", "storage_blocked_locations", "comment", "use_privatelink_endpoint", "azure_tenant_id"),
 		),
-	}
+	})
 }
 
 func ImportStorageIntegrationAzure(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
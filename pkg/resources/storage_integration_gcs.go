@@ -78,7 +78,7 @@ func StorageIntegrationGcs() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeIntegration, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.StorageIntegrationGcs, CreateStorageIntegrationGcs),
 		ReadContext:   TrackingReadWrapper(resources.StorageIntegrationGcs, GetReadStorageIntegrationGcsFunc(true)),
 		UpdateContext: TrackingUpdateWrapper(resources.StorageIntegrationGcs, UpdateStorageIntegrationGcs),
@@ -94,7 +94,7 @@ func StorageIntegrationGcs() *schema.Resource {
 			ComputedIfAnyAttributeChanged(storageIntegrationGcsSchema, ShowOutputAttributeName, "enabled", "comment"),
 			ComputedIfAnyAttributeChanged(storageIntegrationGcsSchema, DescribeOutputAttributeName, "enabled", "storage_allowed_locations", "storage_blocked_locations", "comment"),
 		),
-	}
+	})
 }
 
 func ImportStorageIntegrationGcs(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -33,7 +33,7 @@ var streamOnDirectoryTableSchema = func() map[string]*schema.Schema {
 }()
 
 func StreamOnDirectoryTable() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeStream, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.StreamOnDirectoryTable, CreateStreamOnDirectoryTable(false)),
 		ReadContext:   TrackingReadWrapper(resources.StreamOnDirectoryTable, ReadStreamOnDirectoryTable(true)),
 		UpdateContext: TrackingUpdateWrapper(resources.StreamOnDirectoryTable, UpdateStreamOnDirectoryTable),
@@ -64,7 +64,7 @@ func StreamOnDirectoryTable() *schema.Resource {
 		},
 
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func CreateStreamOnDirectoryTable(orReplace bool) schema.CreateContextFunc {
@@ -44,7 +44,7 @@ var streamOnExternalTableSchema = func() map[string]*schema.Schema {
 }()
 
 func StreamOnExternalTable() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeStream, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.StreamOnExternalTable, CreateStreamOnExternalTable(false)),
 		ReadContext:   TrackingReadWrapper(resources.StreamOnExternalTable, ReadStreamOnExternalTable(true)),
 		UpdateContext: TrackingUpdateWrapper(resources.StreamOnExternalTable, UpdateStreamOnExternalTable),
@@ -64,7 +64,7 @@ func StreamOnExternalTable() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.StreamOnExternalTable, ImportStreamOnExternalTable),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportStreamOnExternalTable(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
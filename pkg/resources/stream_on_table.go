@@ -52,7 +52,7 @@ var streamOnTableSchema = func() map[string]*schema.Schema {
 }()
 
 func StreamOnTable() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeStream, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.StreamOnTable, CreateStreamOnTable(false)),
 		ReadContext:   TrackingReadWrapper(resources.StreamOnTable, ReadStreamOnTable(true)),
 		UpdateContext: TrackingUpdateWrapper(resources.StreamOnTable, UpdateStreamOnTable),
@@ -72,7 +72,7 @@ func StreamOnTable() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.StreamOnTable, ImportStreamOnTable),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportStreamOnTable(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -52,7 +52,7 @@ var StreamOnViewSchema = func() map[string]*schema.Schema {
 }()
 
 func StreamOnView() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeStream, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.StreamOnView, CreateStreamOnView(false)),
 		ReadContext:   TrackingReadWrapper(resources.StreamOnView, ReadStreamOnView(true)),
 		UpdateContext: TrackingUpdateWrapper(resources.StreamOnView, UpdateStreamOnView),
@@ -72,7 +72,7 @@ func StreamOnView() *schema.Resource {
 			StateContext: TrackingImportWrapper(resources.StreamOnView, ImportStreamOnView),
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportStreamOnView(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/experimentalfeatures"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
@@ -144,6 +145,13 @@ var tableSchema = map[string]*schema.Schema{
 					Default:     "",
 					Description: "Masking policy to apply on column. It has to be a fully qualified name.",
 				},
+				"projection_policy": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          "",
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					Description:      relatedResourceDescription("Projection policy to apply on column. It has to be a fully qualified name.", resources.ProjectionPolicy),
+				},
 				"collate": {
 					Type:        schema.TypeString,
 					Optional:    true,
@@ -206,6 +214,9 @@ var tableSchema = map[string]*schema.Schema{
 		Default:     false,
 		Description: "Specifies whether to enable change tracking on the table. Default false.",
 	},
+	"row_access_policy":             rowAccessPolicyFieldSchema("table"),
+	"aggregation_policy":            aggregationPolicySchema("table"),
+	"join_policy":                   joinPolicyFieldSchema("table"),
 	"tag":                           tagReferenceSchema,
 	FullyQualifiedNameAttributeName: schemas.FullyQualifiedNameSchema,
 }
@@ -218,7 +229,7 @@ func Table() *schema.Resource {
 		},
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeTable, helpers.DecodeSnowflakeIDErrLegacy[sdk.SchemaObjectIdentifier], &schema.Resource{
 		CreateContext: PreviewFeatureCreateContextWrapper(string(previewfeatures.TableResource), TrackingCreateWrapper(resources.Table, CreateTable)),
 		ReadContext:   PreviewFeatureReadContextWrapper(string(previewfeatures.TableResource), TrackingReadWrapper(resources.Table, ReadTable)),
 		UpdateContext: PreviewFeatureUpdateContextWrapper(string(previewfeatures.TableResource), TrackingUpdateWrapper(resources.Table, UpdateTable)),
@@ -235,7 +246,7 @@ func Table() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 type columnDefault struct {
@@ -266,14 +277,15 @@ type columnIdentity struct {
 }
 
 type column struct {
-	name          string
-	dataType      string
-	nullable      bool
-	_default      *columnDefault
-	identity      *columnIdentity
-	comment       string
-	maskingPolicy string
-	collate       string
+	name             string
+	dataType         string
+	nullable         bool
+	_default         *columnDefault
+	identity         *columnIdentity
+	comment          string
+	maskingPolicy    string
+	projectionPolicy string
+	collate          string
 }
 
 type columns []column
@@ -281,20 +293,21 @@ type columns []column
 type changedColumns []changedColumn
 
 type changedColumn struct {
-	newColumn             column // our new column
-	changedDataType       bool
-	changedNullConstraint bool
-	droppedDefault        bool
-	changedComment        bool
-	changedMaskingPolicy  bool
-	changedCollate        bool
+	newColumn               column // our new column
+	changedDataType         bool
+	changedNullConstraint   bool
+	droppedDefault          bool
+	changedComment          bool
+	changedMaskingPolicy    bool
+	changedProjectionPolicy bool
+	changedCollate          bool
 }
 
 func (c columns) getChangedColumnProperties(new columns) (changed changedColumns) {
 	changed = make(changedColumns, 0, len(new)*len(c))
 	for _, cO := range c {
 		for _, cN := range new {
-			changeColumn := changedColumn{cN, false, false, false, false, false, false}
+			changeColumn := changedColumn{cN, false, false, false, false, false, false, false}
 			if cO.name == cN.name && cO.dataType != cN.dataType {
 				changeColumn.changedDataType = true
 			}
@@ -313,6 +326,10 @@ func (c columns) getChangedColumnProperties(new columns) (changed changedColumns
 				changeColumn.changedMaskingPolicy = true
 			}
 
+			if cO.name == cN.name && cO.projectionPolicy != cN.projectionPolicy {
+				changeColumn.changedProjectionPolicy = true
+			}
+
 			if cO.name == cN.name && cO.collate != cN.collate {
 				changeColumn.changedCollate = true
 			}
@@ -381,14 +398,15 @@ func getColumn(from any) (to column) {
 	}
 
 	return column{
-		name:          c["name"].(string),
-		dataType:      c["type"].(string),
-		nullable:      c["nullable"].(bool),
-		_default:      cd,
-		identity:      id,
-		comment:       c["comment"].(string),
-		collate:       c["collate"].(string),
-		maskingPolicy: c["masking_policy"].(string),
+		name:             c["name"].(string),
+		dataType:         c["type"].(string),
+		nullable:         c["nullable"].(bool),
+		_default:         cd,
+		identity:         id,
+		comment:          c["comment"].(string),
+		collate:          c["collate"].(string),
+		maskingPolicy:    c["masking_policy"].(string),
+		projectionPolicy: c["projection_policy"].(string),
 	}
 }
 
@@ -491,7 +509,7 @@ func getPrimaryKey(from any) (to primarykey) {
 	return to
 }
 
-func toColumnConfig(descriptions []sdk.TableColumnDetails) []any {
+func toColumnConfig(descriptions []sdk.TableColumnDetails, projectionPolicies map[string]sdk.PolicyReference) []any {
 	flattened := make([]any, 0)
 	for _, td := range descriptions {
 		if td.Kind != "COLUMN" {
@@ -516,6 +534,10 @@ func toColumnConfig(descriptions []sdk.TableColumnDetails) []any {
 			flat["masking_policy"] = sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(*td.PolicyName).FullyQualifiedName()
 		}
 
+		if p, ok := projectionPolicies[td.Name]; ok && p.PolicyDb != nil && p.PolicySchema != nil {
+			flat["projection_policy"] = sdk.NewSchemaObjectIdentifier(*p.PolicyDb, *p.PolicySchema, p.PolicyName).FullyQualifiedName()
+		}
+
 		identity := toColumnIdentityConfig(td)
 		if identity != nil {
 			flat["identity"] = []any{identity}
@@ -644,6 +666,12 @@ func CreateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 		createRequest.WithTags(tagAssociationRequests)
 	}
 
+	if policyId, columns, ok, err := rowAccessPolicyCreateRequest(d); err != nil {
+		return diag.FromErr(err)
+	} else if ok {
+		createRequest.WithRowAccessPolicy(&sdk.RowAccessPolicyRequest{Name: policyId, On: quotedPolicyColumns(columns)})
+	}
+
 	err = client.TablesLegacy.Create(ctx, createRequest)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating table %v err = %w", name, err))
@@ -651,9 +679,45 @@ func CreateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 
 	d.SetId(helpers.EncodeSnowflakeID(id))
 
+	// AGGREGATION POLICY, JOIN POLICY and column PROJECTION POLICY are not a part of CREATE TABLE in the SDK, so they are set right after the table is created.
+	if _, ok := d.GetOk("aggregation_policy"); ok {
+		setReq, _, err := aggregationPolicyUpdateRequests(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := client.TablesLegacy.Alter(ctx, sdk.NewAlterTableRequest(id).WithSetAggregationPolicy(setReq)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting aggregation policy for table %v: %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	if _, ok := d.GetOk("join_policy"); ok {
+		setReq, _, err := joinPolicyUpdateRequests(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := client.TablesLegacy.Alter(ctx, sdk.NewAlterTableRequest(id).WithSetJoinPolicy(setReq)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting join policy for table %v: %w", id.FullyQualifiedName(), err))
+		}
+	}
+
+	for _, c := range getColumns(d.Get("column")) {
+		if c.projectionPolicy == "" {
+			continue
+		}
+		setReq := sdk.NewTableSetColumnProjectionPolicyRequest(c.name, sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(c.projectionPolicy))
+		if err := client.TablesLegacy.Alter(ctx, sdk.NewAlterTableRequest(id).WithSetProjectionPolicyOnColumn(setReq)); err != nil {
+			return diag.FromErr(fmt.Errorf("error setting projection policy on column %v for table %v: %w", c.name, id.FullyQualifiedName(), err))
+		}
+	}
+
 	return ReadTable(ctx, d, meta)
 }
 
+// quotedPolicyColumns converts the policy columns into the quoted column names used by the legacy table requests.
+func quotedPolicyColumns(columns []sdk.Column) []string {
+	return snowflake.QuoteStringList(collections.Map(columns, func(c sdk.Column) string { return c.Value }))
+}
+
 func ReadTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	client := meta.(*provider.Context).Client
 
@@ -702,6 +766,15 @@ func ReadTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagn
 		return diag.FromErr(err)
 	}
 
+	policyRefs, err := readRootLevelPolicies(ctx, client, id, sdk.PolicyEntityDomainTable, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := handleJoinPolicyReferences(policyRefs, d); err != nil {
+		return diag.FromErr(err)
+	}
+	_, projectionPolicies := buildColumnPolicyLookups(policyRefs)
+
 	// Set the relevant data in the state
 	toSet := map[string]any{
 		"name":            table.Name,
@@ -709,7 +782,7 @@ func ReadTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagn
 		"database":        table.DatabaseName,
 		"schema":          table.SchemaName,
 		"comment":         table.Comment,
-		"column":          toColumnConfig(tableDescription),
+		"column":          toColumnConfig(tableDescription, projectionPolicies),
 		"cluster_by":      table.GetClusterByKeys(),
 		"change_tracking": table.ChangeTracking,
 	}
@@ -876,6 +949,13 @@ func UpdateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 			if err != nil {
 				return diag.FromErr(fmt.Errorf("error adding column: %w", err))
 			}
+
+			if cA.projectionPolicy != "" {
+				setReq := sdk.NewTableSetColumnProjectionPolicyRequest(cA.name, sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(cA.projectionPolicy))
+				if err := client.TablesLegacy.Alter(ctx, sdk.NewAlterTableRequest(id).WithSetProjectionPolicyOnColumn(setReq)); err != nil {
+					return diag.FromErr(fmt.Errorf("error setting projection policy on column %v: %w", cA.name, err))
+				}
+			}
 		}
 		for _, cA := range changed {
 			if cA.changedDataType || cA.changedCollate {
@@ -931,6 +1011,18 @@ func UpdateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 					return diag.FromErr(fmt.Errorf("error changing property on %v: err %w", d.Id(), err))
 				}
 			}
+			if cA.changedProjectionPolicy {
+				alterRequest := sdk.NewAlterTableRequest(id)
+				if strings.TrimSpace(cA.newColumn.projectionPolicy) == "" {
+					alterRequest.WithUnsetProjectionPolicyOnColumn(sdk.NewTableUnsetColumnProjectionPolicyRequest(cA.newColumn.name))
+				} else {
+					alterRequest.WithSetProjectionPolicyOnColumn(sdk.NewTableSetColumnProjectionPolicyRequest(cA.newColumn.name, sdk.NewSchemaObjectIdentifierFromFullyQualifiedName(cA.newColumn.projectionPolicy)).WithForce(true))
+				}
+				err := client.TablesLegacy.Alter(ctx, alterRequest)
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("error changing property on %v: err %w", d.Id(), err))
+				}
+			}
 		}
 	}
 
@@ -987,5 +1079,58 @@ func UpdateTable(ctx context.Context, d *schema.ResourceData, meta any) diag.Dia
 		}
 	}
 
+	if d.HasChange("row_access_policy") {
+		addReq, dropReq, err := rowAccessPolicyUpdateRequests(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req := sdk.NewAlterTableRequest(id)
+		if addReq != nil && dropReq != nil { // nolint
+			req.WithDropAndAddRowAccessPolicy(&sdk.TableDropAndAddRowAccessPolicy{
+				Drop: sdk.TableDropRowAccessPolicy{RowAccessPolicy: dropReq.RowAccessPolicy},
+				Add:  sdk.TableAddRowAccessPolicy{RowAccessPolicy: addReq.RowAccessPolicy, On: quotedPolicyColumns(addReq.On)},
+			})
+		} else if addReq != nil {
+			req.WithAddRowAccessPolicy(sdk.NewTableAddRowAccessPolicyRequest(addReq.RowAccessPolicy, quotedPolicyColumns(addReq.On)))
+		} else if dropReq != nil {
+			req.WithDropRowAccessPolicy(sdk.NewTableDropRowAccessPolicyRequest(dropReq.RowAccessPolicy))
+		}
+		if err := client.TablesLegacy.Alter(ctx, req); err != nil {
+			return diag.FromErr(fmt.Errorf("error altering row_access_policy for table %v: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("aggregation_policy") {
+		setReq, unsetReq, err := aggregationPolicyUpdateRequests(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if setReq != nil {
+			if err := client.TablesLegacy.Alter(ctx, sdk.NewAlterTableRequest(id).WithSetAggregationPolicy(setReq)); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting aggregation policy for table %v: %w", d.Id(), err))
+			}
+		} else {
+			if err := client.TablesLegacy.Alter(ctx, sdk.NewAlterTableRequest(id).WithUnsetAggregationPolicy(unsetReq)); err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting aggregation policy for table %v: %w", d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("join_policy") {
+		setReq, unsetReq, err := joinPolicyUpdateRequests(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if setReq != nil {
+			if err := client.TablesLegacy.Alter(ctx, sdk.NewAlterTableRequest(id).WithSetJoinPolicy(setReq)); err != nil {
+				return diag.FromErr(fmt.Errorf("error setting join policy for table %v: %w", d.Id(), err))
+			}
+		} else {
+			if err := client.TablesLegacy.Alter(ctx, sdk.NewAlterTableRequest(id).WithUnsetJoinPolicy(unsetReq)); err != nil {
+				return diag.FromErr(fmt.Errorf("error unsetting join policy for table %v: %w", d.Id(), err))
+			}
+		}
+	}
+
 	return ReadTable(ctx, d, meta)
 }
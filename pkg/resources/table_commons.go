@@ -403,6 +403,27 @@ func aggregationPolicySchema(objectKind string) *schema.Schema {
 	}
 }
 
+// joinPolicyFieldSchema builds the join_policy schema.
+func joinPolicyFieldSchema(objectKind string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"policy_name": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppressIdentifierQuoting,
+					ValidateDiagFunc: IsValidIdentifier[sdk.SchemaObjectIdentifier](),
+					Description:      relatedResourceDescription("Join policy name.", resources.JoinPolicy),
+				},
+			},
+		},
+		Description: fmt.Sprintf("Specifies the join policy to set on a %s.", objectKind),
+	}
+}
+
 func extractPolicyWithColumnsSet(v any, columnsKey string) (sdk.SchemaObjectIdentifier, []sdk.Column, error) {
 	policyConfig := v.([]any)[0].(map[string]any)
 	id, err := sdk.ParseSchemaObjectIdentifier(policyConfig["policy_name"].(string))
@@ -499,6 +520,35 @@ func aggregationPolicyUpdateRequests(d *schema.ResourceData) (set *sdk.ViewSetAg
 	return set.WithForce(true), nil, nil
 }
 
+// joinPolicyUpdateRequests computes the ready-to-use TableSetJoinPolicyRequest/TableUnsetJoinPolicyRequest
+// for the desired join_policy state.
+func joinPolicyUpdateRequests(d *schema.ResourceData) (set *sdk.TableSetJoinPolicyRequest, unset *sdk.TableUnsetJoinPolicyRequest, err error) {
+	v, ok := d.GetOk("join_policy")
+	if !ok {
+		return nil, sdk.NewTableUnsetJoinPolicyRequest(), nil
+	}
+	id, err := sdk.ParseSchemaObjectIdentifier(v.([]any)[0].(map[string]any)["policy_name"].(string))
+	if err != nil {
+		return nil, nil, err
+	}
+	return sdk.NewTableSetJoinPolicyRequest(id).WithForce(true), nil, nil
+}
+
+// handleJoinPolicyReferences populates join_policy from the given policy references. It is kept separate from
+// handlePolicyReferences, because not every object using the root-level policies supports join policies.
+func handleJoinPolicyReferences(policyRefs []sdk.PolicyReference, d *schema.ResourceData) error {
+	var joinPolicies []map[string]any
+	for _, p := range policyRefs {
+		if p.PolicyKind != sdk.PolicyKindJoinPolicy || p.PolicyDb == nil || p.PolicySchema == nil {
+			continue
+		}
+		joinPolicies = append(joinPolicies, map[string]any{
+			"policy_name": sdk.NewSchemaObjectIdentifier(*p.PolicyDb, *p.PolicySchema, p.PolicyName).FullyQualifiedName(),
+		})
+	}
+	return d.Set("join_policy", joinPolicies)
+}
+
 // handlePolicyReferences populates row_access_policy and aggregation_policy from the given policy references.
 func handlePolicyReferences(policyRefs []sdk.PolicyReference, d *schema.ResourceData) error {
 	var aggregationPolicies []map[string]any
@@ -0,0 +1,321 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	TagsAttributeName    = "tags"
+	TagsAllAttributeName = "tags_all"
+
+	legacyTagAttributeName = "tag"
+)
+
+// tagReferenceDomains maps the object types supporting default tags to the domains used by the TAG_REFERENCES function.
+var tagReferenceDomains = map[sdk.ObjectType]sdk.TagReferenceObjectDomain{
+	sdk.ObjectTypeAlert:            sdk.TagReferenceObjectDomainAlert,
+	sdk.ObjectTypeComputePool:      sdk.TagReferenceObjectDomainComputePool,
+	sdk.ObjectTypeDatabase:         sdk.TagReferenceObjectDomainDatabase,
+	sdk.ObjectTypeDatabaseRole:     sdk.TagReferenceObjectDomainDatabaseRole,
+	sdk.ObjectTypeEventTable:       sdk.TagReferenceObjectDomainTable,
+	sdk.ObjectTypeExternalTable:    sdk.TagReferenceObjectDomainTable,
+	sdk.ObjectTypeFunction:         sdk.TagReferenceObjectDomainFunction,
+	sdk.ObjectTypeIcebergTable:     sdk.TagReferenceObjectDomainTable,
+	sdk.ObjectTypeIntegration:      sdk.TagReferenceObjectDomainIntegration,
+	sdk.ObjectTypeMaterializedView: sdk.TagReferenceObjectDomainTable,
+	sdk.ObjectTypeProcedure:        sdk.TagReferenceObjectDomainProcedure,
+	sdk.ObjectTypeRole:             sdk.TagReferenceObjectDomainRole,
+	sdk.ObjectTypeSchema:           sdk.TagReferenceObjectDomainSchema,
+	sdk.ObjectTypeStage:            sdk.TagReferenceObjectDomainStage,
+	sdk.ObjectTypeStream:           sdk.TagReferenceObjectDomainStream,
+	sdk.ObjectTypeTable:            sdk.TagReferenceObjectDomainTable,
+	sdk.ObjectTypeTask:             sdk.TagReferenceObjectDomainTask,
+	sdk.ObjectTypeUser:             sdk.TagReferenceObjectDomainUser,
+	sdk.ObjectTypeView:             sdk.TagReferenceObjectDomainTable,
+	sdk.ObjectTypeWarehouse:        sdk.TagReferenceObjectDomainWarehouse,
+}
+
+// writeOnlyTagObjectTypes lists the object types supporting default tags that have no domain in the TAG_REFERENCES function.
+// The tags are set on these objects, but they are not read back, so changes made outside of Terraform are not detected.
+var writeOnlyTagObjectTypes = []sdk.ObjectType{
+	sdk.ObjectTypeMaskingPolicy,
+	sdk.ObjectTypePipe,
+	sdk.ObjectTypeRowAccessPolicy,
+}
+
+var tagsSchema = &schema.Schema{
+	Type:             schema.TypeMap,
+	Optional:         true,
+	Elem:             &schema.Schema{Type: schema.TypeString},
+	ValidateDiagFunc: isValidTagsMap,
+	Description:      "Tags set directly on the object. The keys are the fully qualified names of the tags (e.g. `\"\\\"<db_name>\\\".\\\"<schema_name>\\\".\\\"<tag_name>\\\"\"`) and the values are the tag values. They are merged with the `default_tags` from the provider configuration; the values from this field take precedence. Tags set on the object outside of Terraform (e.g. by `snowflake_tag_association`) are not affected, as long as they are not present in this field or in the provider's `default_tags`.",
+}
+
+var tagsAllSchema = &schema.Schema{
+	Type:        schema.TypeMap,
+	Computed:    true,
+	Elem:        &schema.Schema{Type: schema.TypeString},
+	Description: "All tags managed on the object by the provider: the `default_tags` from the provider configuration merged with `tags`. Holds the values set in Snowflake, so changes made outside of Terraform are detected.",
+}
+
+var writeOnlyTagsAllSchema = &schema.Schema{
+	Type:        schema.TypeMap,
+	Computed:    true,
+	Elem:        &schema.Schema{Type: schema.TypeString},
+	Description: "All tags managed on the object by the provider: the `default_tags` from the provider configuration merged with `tags`. Snowflake does not report the tags of this object type in the `TAG_REFERENCES` function, so changes made outside of Terraform are not detected.",
+}
+
+func isValidTagsMap(value any, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for tagName := range value.(map[string]any) {
+		if _, err := sdk.ParseSchemaObjectIdentifier(tagName); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid tag name",
+				Detail:        fmt.Sprintf("Tag name %s is not a valid fully qualified name, err = %s", tagName, err),
+				AttributePath: path,
+			})
+		}
+	}
+	return diags
+}
+
+// WithObjectTags extends the resource with tags and tags_all attributes. The tags from the provider's default_tags and the resource's tags are set on the object
+// after it is created and kept in sync on every update. The resource id is decoded with parseFunc into the identifier of the object of the given type.
+// The deprecated tag attribute, if the resource has one, cannot be used together with tags, and cannot hold the tags from the provider's default_tags.
+func WithObjectTags[ID sdk.ObjectIdentifierConstraint](objectType sdk.ObjectType, parseFunc func(string) (ID, error), resource *schema.Resource) *schema.Resource {
+	if _, ok := tagReferenceDomains[objectType]; !ok && !slices.Contains(writeOnlyTagObjectTypes, objectType) {
+		panic(fmt.Sprintf("object type %s does not support default tags", objectType))
+	}
+
+	// the schema maps are shared between resources, so they are copied before extending
+	resource.Schema = maps.Clone(resource.Schema)
+	resource.Schema[TagsAttributeName] = tagsSchema
+	if slices.Contains(writeOnlyTagObjectTypes, objectType) {
+		resource.Schema[TagsAllAttributeName] = writeOnlyTagsAllSchema
+	} else {
+		resource.Schema[TagsAllAttributeName] = tagsAllSchema
+	}
+
+	customizeDiffs := []schema.CustomizeDiffFunc{tagsAllCustomizeDiff}
+	if legacyTagSchema, ok := resource.Schema[legacyTagAttributeName]; ok {
+		legacyTagSchema := *legacyTagSchema
+		legacyTagSchema.ConflictsWith = []string{TagsAttributeName}
+		resource.Schema[legacyTagAttributeName] = &legacyTagSchema
+		customizeDiffs = append(customizeDiffs, legacyTagsCustomizeDiff)
+	}
+
+	parseObjectId := func(id string) (sdk.ObjectIdentifier, error) {
+		objectId, err := parseFunc(id)
+		if err != nil {
+			return nil, err
+		}
+		return any(objectId).(sdk.ObjectIdentifier), nil
+	}
+
+	createFunc, readFunc, updateFunc := resource.CreateContext, resource.ReadContext, resource.UpdateContext
+	resource.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		diags := createFunc(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		if err := updateObjectTags(ctx, d, meta, objectType, parseObjectId); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return append(diags, readObjectTags(ctx, d, meta, objectType, parseObjectId)...)
+	}
+	resource.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		diags := readFunc(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		return append(diags, readObjectTags(ctx, d, meta, objectType, parseObjectId)...)
+	}
+	resource.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		diags := updateFunc(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		if err := updateObjectTags(ctx, d, meta, objectType, parseObjectId); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return append(diags, readObjectTags(ctx, d, meta, objectType, parseObjectId)...)
+	}
+
+	if resource.CustomizeDiff != nil {
+		customizeDiffs = append([]schema.CustomizeDiffFunc{resource.CustomizeDiff}, customizeDiffs...)
+	}
+	resource.CustomizeDiff = customdiff.All(customizeDiffs...)
+
+	return resource
+}
+
+// tagsAllCustomizeDiff plans tags_all as the provider's default tags merged with the resource's tags.
+func tagsAllCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown(TagsAttributeName) {
+		return d.SetNewComputed(TagsAllAttributeName)
+	}
+	expected, err := mergeTags(defaultTags(meta), d.Get(TagsAttributeName).(map[string]any))
+	if err != nil {
+		return err
+	}
+	if !maps.Equal(expected, expandTagsMap(d.Get(TagsAllAttributeName).(map[string]any))) {
+		return d.SetNew(TagsAllAttributeName, expected)
+	}
+	return nil
+}
+
+// legacyTagsCustomizeDiff rejects the deprecated tag attribute holding the tags from the provider's default_tags, as both would manage the same tag on the object.
+func legacyTagsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	defaults := defaultTags(meta)
+	var errs []error
+	for _, legacyTag := range d.Get(legacyTagAttributeName).([]any) {
+		tagId := getTagObjectIdentifier(legacyTag.(map[string]any))
+		if _, ok := defaults[tagId.FullyQualifiedName()]; ok {
+			errs = append(errs, fmt.Errorf("tag %s is set both in the provider's default_tags and in the deprecated %s attribute; use the %s attribute to override its value", tagId.FullyQualifiedName(), legacyTagAttributeName, TagsAttributeName))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func defaultTags(meta any) map[string]string {
+	if providerCtx, ok := meta.(*provider.Context); ok {
+		return providerCtx.DefaultTags
+	}
+	return nil
+}
+
+// mergeTags merges the default tags with the resource tags. The keys are normalized to fully qualified tag names.
+func mergeTags(defaults map[string]string, tags map[string]any) (map[string]string, error) {
+	merged := make(map[string]string, len(defaults)+len(tags))
+	for tagName, value := range defaults {
+		merged[tagName] = value
+	}
+	for tagName, value := range tags {
+		tagId, err := sdk.ParseSchemaObjectIdentifier(tagName)
+		if err != nil {
+			return nil, err
+		}
+		merged[tagId.FullyQualifiedName()] = value.(string)
+	}
+	return merged, nil
+}
+
+func expandTagsMap(tags map[string]any) map[string]string {
+	expanded := make(map[string]string, len(tags))
+	for tagName, value := range tags {
+		expanded[tagName] = value.(string)
+	}
+	return expanded
+}
+
+// diffTags returns the tags to set (new or with changed values) and the tags to unset (no longer managed).
+func diffTags(oldTags, newTags map[string]string) (setTags []sdk.TagAssociation, unsetTags []sdk.ObjectIdentifier, err error) {
+	for _, tagName := range slices.Sorted(maps.Keys(newTags)) {
+		if oldValue, ok := oldTags[tagName]; ok && oldValue == newTags[tagName] {
+			continue
+		}
+		tagId, err := sdk.ParseSchemaObjectIdentifier(tagName)
+		if err != nil {
+			return nil, nil, err
+		}
+		setTags = append(setTags, sdk.TagAssociation{Name: tagId, Value: newTags[tagName]})
+	}
+	for _, tagName := range slices.Sorted(maps.Keys(oldTags)) {
+		if _, ok := newTags[tagName]; ok {
+			continue
+		}
+		tagId, err := sdk.ParseSchemaObjectIdentifier(tagName)
+		if err != nil {
+			return nil, nil, err
+		}
+		unsetTags = append(unsetTags, tagId)
+	}
+	return setTags, unsetTags, nil
+}
+
+func updateObjectTags(ctx context.Context, d *schema.ResourceData, meta any, objectType sdk.ObjectType, parseObjectId func(string) (sdk.ObjectIdentifier, error)) error {
+	if !d.HasChange(TagsAllAttributeName) {
+		return nil
+	}
+	client := meta.(*provider.Context).Client
+
+	objectId, err := parseObjectId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	oldTags, newTags := d.GetChange(TagsAllAttributeName)
+	setTags, unsetTags, err := diffTags(expandTagsMap(oldTags.(map[string]any)), expandTagsMap(newTags.(map[string]any)))
+	if err != nil {
+		return err
+	}
+
+	if len(setTags) > 0 {
+		if err := client.Tags.Set(ctx, sdk.NewSetTagRequest(objectType, objectId).WithSetTags(setTags)); err != nil {
+			return fmt.Errorf("error setting tags on %s %s, err = %w", objectType, objectId.FullyQualifiedName(), err)
+		}
+	}
+	if len(unsetTags) > 0 {
+		if err := client.Tags.Unset(ctx, sdk.NewUnsetTagRequest(objectType, objectId).WithUnsetTags(unsetTags)); err != nil {
+			return fmt.Errorf("error unsetting tags on %s %s, err = %w", objectType, objectId.FullyQualifiedName(), err)
+		}
+	}
+	return nil
+}
+
+// readObjectTags sets tags_all to the values of the managed tags set directly on the object.
+// The managed tags are the ones from the state, the resource's tags, and the provider's default tags.
+// For the object types without a TAG_REFERENCES domain, tags_all is left as it is in the state.
+func readObjectTags(ctx context.Context, d *schema.ResourceData, meta any, objectType sdk.ObjectType, parseObjectId func(string) (sdk.ObjectIdentifier, error)) diag.Diagnostics {
+	domain, ok := tagReferenceDomains[objectType]
+	if !ok {
+		return nil
+	}
+	client := meta.(*provider.Context).Client
+
+	objectId, err := parseObjectId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	managedTags, err := mergeTags(defaultTags(meta), d.Get(TagsAttributeName).(map[string]any))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	for tagName := range d.Get(TagsAllAttributeName).(map[string]any) {
+		managedTags[tagName] = ""
+	}
+	if len(managedTags) == 0 {
+		return diag.FromErr(d.Set(TagsAllAttributeName, map[string]string{}))
+	}
+
+	references, err := client.TagReferences.GetForEntity(ctx, sdk.NewGetForEntityTagReferenceRequestFull(objectId.FullyQualifiedName(), domain))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading tags of %s %s, err = %w", objectType, objectId.FullyQualifiedName(), err))
+	}
+
+	tagsAll := make(map[string]string)
+	for _, reference := range references {
+		// tags inherited from the parent objects or propagated from other objects are not managed by the resource
+		if reference.ApplyMethod != sdk.TagReferenceApplyMethodManual {
+			continue
+		}
+		tagName := reference.TagId().FullyQualifiedName()
+		if _, ok := managedTags[tagName]; ok {
+			tagsAll[tagName] = reference.TagValue
+		}
+	}
+	return diag.FromErr(errors.Join(d.Set(TagsAllAttributeName, tagsAll)))
+}
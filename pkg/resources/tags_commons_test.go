@@ -0,0 +1,91 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_mergeTags(t *testing.T) {
+	t.Run("resource tags override default tags", func(t *testing.T) {
+		merged, err := mergeTags(
+			map[string]string{
+				`"DB"."SCHEMA"."ENV"`:   "dev",
+				`"DB"."SCHEMA"."OWNER"`: "team",
+			},
+			map[string]any{
+				`DB.SCHEMA.ENV`:        "prod",
+				`"DB"."SCHEMA"."COST"`: "123",
+			},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			`"DB"."SCHEMA"."ENV"`:   "prod",
+			`"DB"."SCHEMA"."OWNER"`: "team",
+			`"DB"."SCHEMA"."COST"`:  "123",
+		}, merged)
+	})
+
+	t.Run("no tags", func(t *testing.T) {
+		merged, err := mergeTags(nil, nil)
+		require.NoError(t, err)
+		assert.Empty(t, merged)
+	})
+
+	t.Run("invalid tag name", func(t *testing.T) {
+		_, err := mergeTags(nil, map[string]any{"TAG": "value"})
+		require.Error(t, err)
+	})
+}
+
+func Test_diffTags(t *testing.T) {
+	envId := sdk.NewSchemaObjectIdentifier("DB", "SCHEMA", "ENV")
+	ownerId := sdk.NewSchemaObjectIdentifier("DB", "SCHEMA", "OWNER")
+	costId := sdk.NewSchemaObjectIdentifier("DB", "SCHEMA", "COST")
+
+	setTags, unsetTags, err := diffTags(
+		map[string]string{
+			envId.FullyQualifiedName():   "dev",
+			ownerId.FullyQualifiedName(): "team",
+		},
+		map[string]string{
+			envId.FullyQualifiedName():  "prod",
+			costId.FullyQualifiedName(): "123",
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []sdk.TagAssociation{
+		{Name: costId, Value: "123"},
+		{Name: envId, Value: "prod"},
+	}, setTags)
+	assert.Equal(t, []sdk.ObjectIdentifier{ownerId}, unsetTags)
+
+	t.Run("no changes", func(t *testing.T) {
+		setTags, unsetTags, err := diffTags(
+			map[string]string{envId.FullyQualifiedName(): "dev"},
+			map[string]string{envId.FullyQualifiedName(): "dev"},
+		)
+		require.NoError(t, err)
+		assert.Empty(t, setTags)
+		assert.Empty(t, unsetTags)
+	})
+}
+
+func Test_WithObjectTags(t *testing.T) {
+	t.Run("legacy tag attribute conflicts with tags", func(t *testing.T) {
+		resource := Table()
+
+		require.Contains(t, resource.Schema, TagsAttributeName)
+		require.Contains(t, resource.Schema, TagsAllAttributeName)
+		assert.Equal(t, []string{TagsAttributeName}, resource.Schema[legacyTagAttributeName].ConflictsWith)
+		assert.Empty(t, tagReferenceSchema.ConflictsWith)
+	})
+
+	t.Run("unsupported object type", func(t *testing.T) {
+		assert.Panics(t, func() {
+			WithObjectTags(sdk.ObjectTypeSequence, sdk.ParseSchemaObjectIdentifier, Sequence())
+		})
+	})
+}
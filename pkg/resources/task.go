@@ -221,7 +221,7 @@ var taskSchema = map[string]*schema.Schema{
 }
 
 func Task() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeTask, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.Task, CreateTask),
 		UpdateContext: TrackingUpdateWrapper(resources.Task, UpdateTask),
 		ReadContext:   TrackingReadWrapper(resources.Task, ReadTask(true)),
@@ -249,7 +249,7 @@ func Task() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportTask(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
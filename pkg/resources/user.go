@@ -184,7 +184,7 @@ var userSchema = map[string]*schema.Schema{
 }
 
 func User() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeUser, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		SchemaVersion: 1,
 
 		CreateContext: TrackingCreateWrapper(resources.User, GetCreateUserFunc(sdk.UserTypePerson)),
@@ -215,11 +215,11 @@ func User() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ServiceUser() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeUser, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.ServiceUser, GetCreateUserFunc(sdk.UserTypeService)),
 		UpdateContext: TrackingUpdateWrapper(resources.ServiceUser, GetUpdateUserFunc(sdk.UserTypeService)),
 		ReadContext:   TrackingReadWrapper(resources.ServiceUser, GetReadUserFunc(sdk.UserTypeService, true)),
@@ -238,11 +238,11 @@ func ServiceUser() *schema.Resource {
 			userParametersCustomDiff,
 			RecreateWhenUserTypeChangedExternally(sdk.UserTypeService),
 		)),
-	}
+	})
 }
 
 func LegacyServiceUser() *schema.Resource {
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeUser, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		CreateContext: TrackingCreateWrapper(resources.LegacyServiceUser, GetCreateUserFunc(sdk.UserTypeLegacyService)),
 		UpdateContext: TrackingUpdateWrapper(resources.LegacyServiceUser, GetUpdateUserFunc(sdk.UserTypeLegacyService)),
 		ReadContext:   TrackingReadWrapper(resources.LegacyServiceUser, GetReadUserFunc(sdk.UserTypeLegacyService, true)),
@@ -261,7 +261,7 @@ func LegacyServiceUser() *schema.Resource {
 			userParametersCustomDiff,
 			RecreateWhenUserTypeChangedExternally(sdk.UserTypeLegacyService),
 		)),
-	}
+	})
 }
 
 func GetImportUserFunc(userType sdk.UserType) func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
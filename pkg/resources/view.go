@@ -239,7 +239,7 @@ func View() *schema.Resource {
 		func(client *sdk.Client) DropSafelyFunc[sdk.SchemaObjectIdentifier] { return client.Views.DropSafely },
 	)
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeView, sdk.ParseSchemaObjectIdentifier, &schema.Resource{
 		SchemaVersion: 1,
 
 		CreateContext: TrackingCreateWrapper(resources.View, CreateView(false)),
@@ -267,7 +267,7 @@ func View() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportView(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
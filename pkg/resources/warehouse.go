@@ -228,7 +228,7 @@ func Warehouse() *schema.Resource {
 		})
 	}
 
-	return &schema.Resource{
+	return WithObjectTags(sdk.ObjectTypeWarehouse, sdk.ParseAccountObjectIdentifier, &schema.Resource{
 		SchemaVersion: 2,
 
 		CreateContext: TrackingCreateWrapper(resources.Warehouse, CreateWarehouse),
@@ -276,7 +276,7 @@ func Warehouse() *schema.Resource {
 			},
 		},
 		Timeouts: defaultTimeouts,
-	}
+	})
 }
 
 func ImportWarehouse(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
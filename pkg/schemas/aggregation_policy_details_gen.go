@@ -0,0 +1,41 @@
+// Code generated by SDK to schema generator (v0.1.0); DO NOT EDIT.
+
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ShowAggregationPolicyDetailsSchema represents output of SHOW query for the single AggregationPolicyDetails.
+var ShowAggregationPolicyDetailsSchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"signature": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"return_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"body": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = ShowAggregationPolicyDetailsSchema
+
+func AggregationPolicyDetailsToSchema(aggregationPolicyDetails *sdk.AggregationPolicyDetails) map[string]any {
+	aggregationPolicyDetailsSchema := make(map[string]any)
+	aggregationPolicyDetailsSchema["name"] = aggregationPolicyDetails.Name
+	aggregationPolicyDetailsSchema["signature"] = aggregationPolicyDetails.Signature
+	aggregationPolicyDetailsSchema["return_type"] = aggregationPolicyDetails.ReturnType
+	aggregationPolicyDetailsSchema["body"] = aggregationPolicyDetails.Body
+	return aggregationPolicyDetailsSchema
+}
+
+var _ = AggregationPolicyDetailsToSchema
@@ -0,0 +1,66 @@
+// Code generated by SDK to schema generator (v0.1.0); DO NOT EDIT.
+
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ShowAggregationPolicySchema represents output of SHOW query for the single AggregationPolicy.
+var ShowAggregationPolicySchema = map[string]*schema.Schema{
+	"created_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"database_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"schema_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"kind": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"options": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner_role_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = ShowAggregationPolicySchema
+
+func AggregationPolicyToSchema(aggregationPolicy *sdk.AggregationPolicy) map[string]any {
+	aggregationPolicySchema := make(map[string]any)
+	aggregationPolicySchema["created_on"] = aggregationPolicy.CreatedOn.String()
+	aggregationPolicySchema["name"] = aggregationPolicy.Name
+	aggregationPolicySchema["database_name"] = aggregationPolicy.DatabaseName
+	aggregationPolicySchema["schema_name"] = aggregationPolicy.SchemaName
+	aggregationPolicySchema["kind"] = aggregationPolicy.Kind
+	aggregationPolicySchema["owner"] = aggregationPolicy.Owner
+	aggregationPolicySchema["comment"] = aggregationPolicy.Comment
+	aggregationPolicySchema["options"] = aggregationPolicy.Options
+	aggregationPolicySchema["owner_role_type"] = aggregationPolicy.OwnerRoleType
+	return aggregationPolicySchema
+}
+
+var _ = AggregationPolicyToSchema
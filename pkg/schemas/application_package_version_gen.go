@@ -0,0 +1,61 @@
+// Code generated by SDK to schema generator (v0.1.0); DO NOT EDIT.
+
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ShowApplicationPackageVersionSchema represents output of SHOW query for the single ApplicationPackageVersion.
+var ShowApplicationPackageVersionSchema = map[string]*schema.Schema{
+	"version": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"patch": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"label": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"created_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"dropped_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"state": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"review_status": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = ShowApplicationPackageVersionSchema
+
+func ApplicationPackageVersionToSchema(applicationPackageVersion *sdk.ApplicationPackageVersion) map[string]any {
+	applicationPackageVersionSchema := make(map[string]any)
+	applicationPackageVersionSchema["version"] = applicationPackageVersion.Version
+	applicationPackageVersionSchema["patch"] = applicationPackageVersion.Patch
+	applicationPackageVersionSchema["label"] = applicationPackageVersion.Label
+	applicationPackageVersionSchema["comment"] = applicationPackageVersion.Comment
+	applicationPackageVersionSchema["created_on"] = applicationPackageVersion.CreatedOn.String()
+	applicationPackageVersionSchema["dropped_on"] = applicationPackageVersion.DroppedOn
+	applicationPackageVersionSchema["state"] = applicationPackageVersion.State
+	applicationPackageVersionSchema["review_status"] = applicationPackageVersion.ReviewStatus
+	return applicationPackageVersionSchema
+}
+
+var _ = ApplicationPackageVersionToSchema
@@ -0,0 +1,77 @@
+// Code generated by SDK to schema generator (v0.1.0); DO NOT EDIT.
+
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ShowBackupPolicyDetailsSchema represents output of SHOW query for the single BackupPolicyDetails.
+var ShowBackupPolicyDetailsSchema = map[string]*schema.Schema{
+	"created_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"database_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"schema_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner_role_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"schedule": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"expire_after_days": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"has_retention_lock": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+}
+
+var _ = ShowBackupPolicyDetailsSchema
+
+func BackupPolicyDetailsToSchema(backupPolicyDetails *sdk.BackupPolicyDetails) map[string]any {
+	backupPolicyDetailsSchema := make(map[string]any)
+	backupPolicyDetailsSchema["created_on"] = backupPolicyDetails.CreatedOn.String()
+	backupPolicyDetailsSchema["name"] = backupPolicyDetails.Name
+	backupPolicyDetailsSchema["database_name"] = backupPolicyDetails.DatabaseName
+	backupPolicyDetailsSchema["schema_name"] = backupPolicyDetails.SchemaName
+	backupPolicyDetailsSchema["owner"] = backupPolicyDetails.Owner
+	backupPolicyDetailsSchema["owner_role_type"] = backupPolicyDetails.OwnerRoleType
+	if backupPolicyDetails.Comment != nil {
+		backupPolicyDetailsSchema["comment"] = (*backupPolicyDetails.Comment)
+	}
+	if backupPolicyDetails.Schedule != nil {
+		backupPolicyDetailsSchema["schedule"] = (*backupPolicyDetails.Schedule)
+	}
+	if backupPolicyDetails.ExpireAfterDays != nil {
+		backupPolicyDetailsSchema["expire_after_days"] = (*backupPolicyDetails.ExpireAfterDays)
+	}
+	backupPolicyDetailsSchema["has_retention_lock"] = backupPolicyDetails.HasRetentionLock
+	return backupPolicyDetailsSchema
+}
+
+var _ = BackupPolicyDetailsToSchema
@@ -0,0 +1,77 @@
+// Code generated by SDK to schema generator (v0.1.0); DO NOT EDIT.
+
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ShowBackupPolicySchema represents output of SHOW query for the single BackupPolicy.
+var ShowBackupPolicySchema = map[string]*schema.Schema{
+	"created_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"database_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"schema_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner_role_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"schedule": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"expire_after_days": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"has_retention_lock": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+}
+
+var _ = ShowBackupPolicySchema
+
+func BackupPolicyToSchema(backupPolicy *sdk.BackupPolicy) map[string]any {
+	backupPolicySchema := make(map[string]any)
+	backupPolicySchema["created_on"] = backupPolicy.CreatedOn.String()
+	backupPolicySchema["name"] = backupPolicy.Name
+	backupPolicySchema["database_name"] = backupPolicy.DatabaseName
+	backupPolicySchema["schema_name"] = backupPolicy.SchemaName
+	backupPolicySchema["owner"] = backupPolicy.Owner
+	backupPolicySchema["owner_role_type"] = backupPolicy.OwnerRoleType
+	if backupPolicy.Comment != nil {
+		backupPolicySchema["comment"] = (*backupPolicy.Comment)
+	}
+	if backupPolicy.Schedule != nil {
+		backupPolicySchema["schedule"] = (*backupPolicy.Schedule)
+	}
+	if backupPolicy.ExpireAfterDays != nil {
+		backupPolicySchema["expire_after_days"] = (*backupPolicy.ExpireAfterDays)
+	}
+	backupPolicySchema["has_retention_lock"] = backupPolicy.HasRetentionLock
+	return backupPolicySchema
+}
+
+var _ = BackupPolicyToSchema
@@ -0,0 +1,89 @@
+// Code generated by SDK to schema generator (v0.1.0); DO NOT EDIT.
+
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ShowBackupSetSchema represents output of SHOW query for the single BackupSet.
+var ShowBackupSetSchema = map[string]*schema.Schema{
+	"created_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"database_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"schema_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"object_kind": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"object_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"object_database_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"object_schema_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"backup_policy_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner_role_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = ShowBackupSetSchema
+
+func BackupSetToSchema(backupSet *sdk.BackupSet) map[string]any {
+	backupSetSchema := make(map[string]any)
+	backupSetSchema["created_on"] = backupSet.CreatedOn.String()
+	backupSetSchema["name"] = backupSet.Name
+	backupSetSchema["database_name"] = backupSet.DatabaseName
+	backupSetSchema["schema_name"] = backupSet.SchemaName
+	if backupSet.Comment != nil {
+		backupSetSchema["comment"] = (*backupSet.Comment)
+	}
+	backupSetSchema["object_kind"] = backupSet.ObjectKind
+	backupSetSchema["object_name"] = backupSet.ObjectName
+	if backupSet.ObjectDatabaseName != nil {
+		backupSetSchema["object_database_name"] = (*backupSet.ObjectDatabaseName)
+	}
+	if backupSet.ObjectSchemaName != nil {
+		backupSetSchema["object_schema_name"] = (*backupSet.ObjectSchemaName)
+	}
+	if backupSet.BackupPolicyName != nil {
+		backupSetSchema["backup_policy_name"] = (*backupSet.BackupPolicyName)
+	}
+	backupSetSchema["owner"] = backupSet.Owner
+	backupSetSchema["owner_role_type"] = backupSet.OwnerRoleType
+	return backupSetSchema
+}
+
+var _ = BackupSetToSchema
@@ -0,0 +1,63 @@
+// Code generated by SDK to schema generator (v0.1.0); DO NOT EDIT.
+
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ShowBudgetSchema represents output of SHOW query for the single Budget.
+var ShowBudgetSchema = map[string]*schema.Schema{
+	"created_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"database_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"schema_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"current_version": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner_role_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = ShowBudgetSchema
+
+func BudgetToSchema(budget *sdk.Budget) map[string]any {
+	budgetSchema := make(map[string]any)
+	budgetSchema["created_on"] = budget.CreatedOn.String()
+	budgetSchema["name"] = budget.Name
+	budgetSchema["database_name"] = budget.DatabaseName
+	budgetSchema["schema_name"] = budget.SchemaName
+	budgetSchema["current_version"] = budget.CurrentVersion
+	if budget.Comment != nil {
+		budgetSchema["comment"] = (*budget.Comment)
+	}
+	budgetSchema["owner"] = budget.Owner
+	budgetSchema["owner_role_type"] = budget.OwnerRoleType
+	return budgetSchema
+}
+
+var _ = BudgetToSchema
@@ -0,0 +1,77 @@
+// Code generated by SDK to schema generator (v0.1.0); DO NOT EDIT.
+
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ShowContactSchema represents output of SHOW query for the single Contact.
+var ShowContactSchema = map[string]*schema.Schema{
+	"created_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"database_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"schema_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"users": {
+		// Adjusted manually.
+		Type:     schema.TypeList,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Computed: true,
+	},
+	"email_distribution_list": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"url": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner_role_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = ShowContactSchema
+
+func ContactToSchema(contact *sdk.Contact) map[string]any {
+	contactSchema := make(map[string]any)
+	contactSchema["created_on"] = contact.CreatedOn.String()
+	contactSchema["name"] = contact.Name
+	contactSchema["database_name"] = contact.DatabaseName
+	contactSchema["schema_name"] = contact.SchemaName
+	contactSchema["owner"] = contact.Owner
+	contactSchema["comment"] = contact.Comment
+	contactSchema["users"] = contact.Users
+	if contact.EmailDistributionList != nil {
+		contactSchema["email_distribution_list"] = (*contact.EmailDistributionList)
+	}
+	if contact.Url != nil {
+		contactSchema["url"] = (*contact.Url)
+	}
+	contactSchema["owner_role_type"] = contact.OwnerRoleType
+	return contactSchema
+}
+
+var _ = ContactToSchema
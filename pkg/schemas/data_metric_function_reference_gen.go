@@ -0,0 +1,109 @@
+// Code generated by SDK to schema generator (v0.1.0); DO NOT EDIT.
+
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ShowDataMetricFunctionReferenceSchema represents output of SHOW query for the single DataMetricFunctionReference.
+var ShowDataMetricFunctionReferenceSchema = map[string]*schema.Schema{
+	"metric_database_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"metric_schema_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"metric_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"argument_signature": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"data_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"ref_entity_database_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"ref_entity_schema_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"ref_entity_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"ref_entity_domain": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"ref_arguments": {
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"domain": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	},
+	"ref_id": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"schedule": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"schedule_status": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = ShowDataMetricFunctionReferenceSchema
+
+func DataMetricFunctionReferenceToSchema(dataMetricFunctionReference *sdk.DataMetricFunctionReference) map[string]any {
+	dataMetricFunctionReferenceSchema := make(map[string]any)
+	dataMetricFunctionReferenceSchema["metric_database_name"] = dataMetricFunctionReference.MetricDatabaseName
+	dataMetricFunctionReferenceSchema["metric_schema_name"] = dataMetricFunctionReference.MetricSchemaName
+	dataMetricFunctionReferenceSchema["metric_name"] = dataMetricFunctionReference.MetricName
+	dataMetricFunctionReferenceSchema["argument_signature"] = dataMetricFunctionReference.ArgumentSignature
+	dataMetricFunctionReferenceSchema["data_type"] = dataMetricFunctionReference.DataType
+	dataMetricFunctionReferenceSchema["ref_entity_database_name"] = dataMetricFunctionReference.RefEntityDatabaseName
+	dataMetricFunctionReferenceSchema["ref_entity_schema_name"] = dataMetricFunctionReference.RefEntitySchemaName
+	dataMetricFunctionReferenceSchema["ref_entity_name"] = dataMetricFunctionReference.RefEntityName
+	dataMetricFunctionReferenceSchema["ref_entity_domain"] = dataMetricFunctionReference.RefEntityDomain
+	dataMetricFunctionReferenceSchema["ref_arguments"] = collections.Map(dataMetricFunctionReference.RefArguments, func(argument sdk.DataMetricFunctionRefArgument) map[string]any {
+		return map[string]any{
+			"domain": argument.Domain,
+			"id":     argument.Id,
+			"name":   argument.Name,
+		}
+	})
+	dataMetricFunctionReferenceSchema["ref_id"] = dataMetricFunctionReference.RefId
+	dataMetricFunctionReferenceSchema["schedule"] = dataMetricFunctionReference.Schedule
+	dataMetricFunctionReferenceSchema["schedule_status"] = dataMetricFunctionReference.ScheduleStatus
+	return dataMetricFunctionReferenceSchema
+}
+
+var _ = DataMetricFunctionReferenceToSchema
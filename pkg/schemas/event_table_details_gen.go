@@ -0,0 +1,41 @@
+// Code generated by SDK to schema generator (v0.1.0); DO NOT EDIT.
+
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ShowEventTableDetailsSchema represents output of SHOW query for the single EventTableDetails.
+var ShowEventTableDetailsSchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"kind": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = ShowEventTableDetailsSchema
+
+func EventTableDetailsToSchema(eventTableDetails *sdk.EventTableDetails) map[string]any {
+	eventTableDetailsSchema := make(map[string]any)
+	eventTableDetailsSchema["name"] = eventTableDetails.Name
+	eventTableDetailsSchema["type"] = eventTableDetails.Type
+	eventTableDetailsSchema["kind"] = eventTableDetails.Kind
+	eventTableDetailsSchema["comment"] = eventTableDetails.Comment
+	return eventTableDetailsSchema
+}
+
+var _ = EventTableDetailsToSchema
@@ -4,33 +4,47 @@ import "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 
 var SdkShowResultStructs = []any{
 	sdk.Account{},
+	sdk.AggregationPolicy{},
 	sdk.Alert{},
 	sdk.ApiIntegration{},
 	sdk.ApplicationPackage{},
+	sdk.ApplicationPackageVersion{},
 	sdk.ApplicationRole{},
 	sdk.Application{},
+	sdk.ApplicationProperty{},
 	sdk.AuthenticationPolicy{},
+	sdk.BackupPolicy{},
+	sdk.BackupSet{},
+	sdk.Budget{},
 	sdk.CatalogIntegration{},
 	sdk.ComputePool{},
 	sdk.Connection{},
+	sdk.Contact{},
 	sdk.CortexAgent{},
+	sdk.DataMetricFunctionReference{},
 	sdk.DatabaseRole{},
 	sdk.Database{},
 	sdk.DynamicTable{},
 	sdk.EventTable{},
+	sdk.EventTableDetails{},
 	sdk.ExternalAccessIntegration{},
 	sdk.ExternalFunction{},
 	sdk.ExternalTable{},
 	sdk.ExternalVolume{},
 	sdk.FailoverGroup{},
+	sdk.FailoverGroupDatabase{},
+	sdk.FailoverGroupShare{},
 	sdk.FileFormatLegacy{},
 	sdk.Function{},
+	sdk.GitBranch{},
 	sdk.GitRepository{},
+	sdk.GitTag{},
 	sdk.Grant{},
 	sdk.HybridTable{},
 	sdk.HybridTableConstraint{},
 	sdk.IcebergTable{},
 	sdk.ImageRepository{},
+	sdk.JoinPolicy{},
 	sdk.Listing{},
 	sdk.ManagedAccount{},
 	sdk.MaskingPolicy{},
@@ -40,6 +54,12 @@ var SdkShowResultStructs = []any{
 	sdk.NetworkRule{},
 	sdk.Notebook{},
 	sdk.NotificationIntegration{},
+	sdk.OpenflowConnector{},
+	sdk.OpenflowConnectorDetails{},
+	sdk.OpenflowDeployment{},
+	sdk.OpenflowDeploymentDetails{},
+	sdk.OpenflowRuntime{},
+	sdk.OpenflowRuntimeDetails{},
 	sdk.OrganizationAccount{},
 	sdk.Parameter{},
 	sdk.PasswordPolicy{},
@@ -47,6 +67,7 @@ var SdkShowResultStructs = []any{
 	sdk.PolicyReference{},
 	sdk.PostgresInstance{},
 	sdk.Procedure{},
+	sdk.ProjectionPolicy{},
 	sdk.ReplicationAccount{},
 	sdk.ReplicationDatabase{},
 	sdk.Region{},
@@ -77,12 +98,16 @@ var SdkShowResultStructs = []any{
 // TODO [SNOW-1501905]: currently all these structs have the "Show" added to the schema, while these are not show outputs
 // TODO [SNOW-1501905]: temporary struct, may be refactored with addition to generation of describe results; for now used to some structs needing a schema representation
 var AdditionalStructs = []any{
+	sdk.AggregationPolicyDetails{},
+	sdk.BackupPolicyDetails{},
 	sdk.CatalogIntegrationAwsGlueDetails{},
 	sdk.CatalogIntegrationObjectStorageDetails{},
 	sdk.CortexAgentDetails{},
 	sdk.IcebergTableDetails{},
+	sdk.JoinPolicyDetails{},
 	sdk.McpServerDetails{},
 	sdk.PasswordPolicyDetails{},
+	sdk.ProjectionPolicyDetails{},
 	sdk.SecurityIntegrationProperty{},
 	sdk.SessionPolicyDetails{},
 	sdk.StorageIntegrationAllDetails{},
@@ -0,0 +1,41 @@
+// Code generated by SDK to schema generator (v0.1.0); DO NOT EDIT.
+
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ShowJoinPolicyDetailsSchema represents output of SHOW query for the single JoinPolicyDetails.
+var ShowJoinPolicyDetailsSchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"signature": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"return_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"body": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = ShowJoinPolicyDetailsSchema
+
+func JoinPolicyDetailsToSchema(joinPolicyDetails *sdk.JoinPolicyDetails) map[string]any {
+	joinPolicyDetailsSchema := make(map[string]any)
+	joinPolicyDetailsSchema["name"] = joinPolicyDetails.Name
+	joinPolicyDetailsSchema["signature"] = joinPolicyDetails.Signature
+	joinPolicyDetailsSchema["return_type"] = joinPolicyDetails.ReturnType
+	joinPolicyDetailsSchema["body"] = joinPolicyDetails.Body
+	return joinPolicyDetailsSchema
+}
+
+var _ = JoinPolicyDetailsToSchema
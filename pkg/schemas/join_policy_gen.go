@@ -0,0 +1,66 @@
+// Code generated by SDK to schema generator (v0.1.0); DO NOT EDIT.
+
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ShowJoinPolicySchema represents output of SHOW query for the single JoinPolicy.
+var ShowJoinPolicySchema = map[string]*schema.Schema{
+	"created_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"database_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"schema_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"kind": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"options": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner_role_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = ShowJoinPolicySchema
+
+func JoinPolicyToSchema(joinPolicy *sdk.JoinPolicy) map[string]any {
+	joinPolicySchema := make(map[string]any)
+	joinPolicySchema["created_on"] = joinPolicy.CreatedOn.String()
+	joinPolicySchema["name"] = joinPolicy.Name
+	joinPolicySchema["database_name"] = joinPolicy.DatabaseName
+	joinPolicySchema["schema_name"] = joinPolicy.SchemaName
+	joinPolicySchema["kind"] = joinPolicy.Kind
+	joinPolicySchema["owner"] = joinPolicy.Owner
+	joinPolicySchema["comment"] = joinPolicy.Comment
+	joinPolicySchema["options"] = joinPolicy.Options
+	joinPolicySchema["owner_role_type"] = joinPolicy.OwnerRoleType
+	return joinPolicySchema
+}
+
+var _ = JoinPolicyToSchema
@@ -0,0 +1,110 @@
+// Code generated by SDK to schema generator (v0.1.0); DO NOT EDIT.
+
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ShowOpenflowDeploymentDetailsSchema represents output of SHOW query for the single OpenflowDeploymentDetails.
+var ShowOpenflowDeploymentDetailsSchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"status": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"vpc_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"display_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"use_private_link": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"use_user_auth_over_private_link": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"custom_ingress_hostname": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"openflow_key": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"created_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"updated_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"error_code": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"status_message": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = ShowOpenflowDeploymentDetailsSchema
+
+func OpenflowDeploymentDetailsToSchema(openflowDeploymentDetails *sdk.OpenflowDeploymentDetails) map[string]any {
+	openflowDeploymentDetailsSchema := make(map[string]any)
+	openflowDeploymentDetailsSchema["name"] = openflowDeploymentDetails.Name
+	openflowDeploymentDetailsSchema["type"] = string(openflowDeploymentDetails.Type)
+	openflowDeploymentDetailsSchema["status"] = string(openflowDeploymentDetails.Status)
+	if openflowDeploymentDetails.VpcType != nil {
+		openflowDeploymentDetailsSchema["vpc_type"] = string((*openflowDeploymentDetails.VpcType))
+	}
+	if openflowDeploymentDetails.DisplayName != nil {
+		openflowDeploymentDetailsSchema["display_name"] = (*openflowDeploymentDetails.DisplayName)
+	}
+	openflowDeploymentDetailsSchema["use_private_link"] = openflowDeploymentDetails.UsePrivateLink
+	openflowDeploymentDetailsSchema["use_user_auth_over_private_link"] = openflowDeploymentDetails.UseUserAuthOverPrivateLink
+	if openflowDeploymentDetails.CustomIngressHostname != nil {
+		openflowDeploymentDetailsSchema["custom_ingress_hostname"] = (*openflowDeploymentDetails.CustomIngressHostname)
+	}
+	if openflowDeploymentDetails.OpenflowKey != nil {
+		openflowDeploymentDetailsSchema["openflow_key"] = (*openflowDeploymentDetails.OpenflowKey)
+	}
+	openflowDeploymentDetailsSchema["owner"] = openflowDeploymentDetails.Owner
+	if openflowDeploymentDetails.Comment != nil {
+		openflowDeploymentDetailsSchema["comment"] = (*openflowDeploymentDetails.Comment)
+	}
+	openflowDeploymentDetailsSchema["created_on"] = openflowDeploymentDetails.CreatedOn.String()
+	openflowDeploymentDetailsSchema["updated_on"] = openflowDeploymentDetails.UpdatedOn.String()
+	if openflowDeploymentDetails.ErrorCode != nil {
+		openflowDeploymentDetailsSchema["error_code"] = (*openflowDeploymentDetails.ErrorCode)
+	}
+	if openflowDeploymentDetails.StatusMessage != nil {
+		openflowDeploymentDetailsSchema["status_message"] = (*openflowDeploymentDetails.StatusMessage)
+	}
+	return openflowDeploymentDetailsSchema
+}
+
+var _ = OpenflowDeploymentDetailsToSchema
@@ -0,0 +1,86 @@
+// Code generated by SDK to schema generator (v0.1.0); DO NOT EDIT.
+
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ShowOpenflowDeploymentSchema represents output of SHOW query for the single OpenflowDeployment.
+var ShowOpenflowDeploymentSchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"status": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"vpc_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"display_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"use_private_link": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"use_user_auth_over_private_link": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"custom_ingress_hostname": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"openflow_key": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = ShowOpenflowDeploymentSchema
+
+func OpenflowDeploymentToSchema(openflowDeployment *sdk.OpenflowDeployment) map[string]any {
+	openflowDeploymentSchema := make(map[string]any)
+	openflowDeploymentSchema["name"] = openflowDeployment.Name
+	openflowDeploymentSchema["type"] = string(openflowDeployment.Type)
+	openflowDeploymentSchema["status"] = string(openflowDeployment.Status)
+	if openflowDeployment.VpcType != nil {
+		openflowDeploymentSchema["vpc_type"] = string((*openflowDeployment.VpcType))
+	}
+	if openflowDeployment.DisplayName != nil {
+		openflowDeploymentSchema["display_name"] = (*openflowDeployment.DisplayName)
+	}
+	openflowDeploymentSchema["use_private_link"] = openflowDeployment.UsePrivateLink
+	openflowDeploymentSchema["use_user_auth_over_private_link"] = openflowDeployment.UseUserAuthOverPrivateLink
+	if openflowDeployment.CustomIngressHostname != nil {
+		openflowDeploymentSchema["custom_ingress_hostname"] = (*openflowDeployment.CustomIngressHostname)
+	}
+	if openflowDeployment.OpenflowKey != nil {
+		openflowDeploymentSchema["openflow_key"] = (*openflowDeployment.OpenflowKey)
+	}
+	openflowDeploymentSchema["owner"] = openflowDeployment.Owner
+	if openflowDeployment.Comment != nil {
+		openflowDeploymentSchema["comment"] = (*openflowDeployment.Comment)
+	}
+	return openflowDeploymentSchema
+}
+
+var _ = OpenflowDeploymentToSchema
@@ -0,0 +1,98 @@
+// Code generated by SDK to schema generator (v0.1.0); DO NOT EDIT.
+
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ShowOpenflowRuntimeSchema represents output of SHOW query for the single OpenflowRuntime.
+var ShowOpenflowRuntimeSchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"status": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"deployment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"min_nodes": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"max_nodes": {
+		Type:     schema.TypeInt,
+		Computed: true,
+	},
+	"node_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"display_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"external_access_integrations": {
+		// Adjusted manually.
+		Type:     schema.TypeSet,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Computed: true,
+	},
+	"initially_suspended": {
+		Type:     schema.TypeBool,
+		Computed: true,
+	},
+	"execute_as_role": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"created_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"updated_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = ShowOpenflowRuntimeSchema
+
+func OpenflowRuntimeToSchema(openflowRuntime *sdk.OpenflowRuntime) map[string]any {
+	openflowRuntimeSchema := make(map[string]any)
+	openflowRuntimeSchema["name"] = openflowRuntime.Name
+	openflowRuntimeSchema["status"] = string(openflowRuntime.Status)
+	openflowRuntimeSchema["deployment"] = openflowRuntime.Deployment
+	openflowRuntimeSchema["min_nodes"] = openflowRuntime.MinNodes
+	openflowRuntimeSchema["max_nodes"] = openflowRuntime.MaxNodes
+	openflowRuntimeSchema["node_type"] = string(openflowRuntime.NodeType)
+	if openflowRuntime.DisplayName != nil {
+		openflowRuntimeSchema["display_name"] = (*openflowRuntime.DisplayName)
+	}
+	openflowRuntimeSchema["external_access_integrations"] = collections.Map(openflowRuntime.ExternalAccessIntegrations, sdk.AccountObjectIdentifier.Name)
+	openflowRuntimeSchema["initially_suspended"] = openflowRuntime.InitiallySuspended
+	openflowRuntimeSchema["execute_as_role"] = openflowRuntime.ExecuteAsRole
+	openflowRuntimeSchema["owner"] = openflowRuntime.Owner
+	if openflowRuntime.Comment != nil {
+		openflowRuntimeSchema["comment"] = (*openflowRuntime.Comment)
+	}
+	openflowRuntimeSchema["created_on"] = openflowRuntime.CreatedOn.String()
+	openflowRuntimeSchema["updated_on"] = openflowRuntime.UpdatedOn.String()
+	return openflowRuntimeSchema
+}
+
+var _ = OpenflowRuntimeToSchema
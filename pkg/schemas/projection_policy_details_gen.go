@@ -0,0 +1,41 @@
+// Code generated by SDK to schema generator (v0.1.0); DO NOT EDIT.
+
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ShowProjectionPolicyDetailsSchema represents output of SHOW query for the single ProjectionPolicyDetails.
+var ShowProjectionPolicyDetailsSchema = map[string]*schema.Schema{
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"signature": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"return_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"body": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = ShowProjectionPolicyDetailsSchema
+
+func ProjectionPolicyDetailsToSchema(projectionPolicyDetails *sdk.ProjectionPolicyDetails) map[string]any {
+	projectionPolicyDetailsSchema := make(map[string]any)
+	projectionPolicyDetailsSchema["name"] = projectionPolicyDetails.Name
+	projectionPolicyDetailsSchema["signature"] = projectionPolicyDetails.Signature
+	projectionPolicyDetailsSchema["return_type"] = projectionPolicyDetails.ReturnType
+	projectionPolicyDetailsSchema["body"] = projectionPolicyDetails.Body
+	return projectionPolicyDetailsSchema
+}
+
+var _ = ProjectionPolicyDetailsToSchema
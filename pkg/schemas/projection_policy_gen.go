@@ -0,0 +1,66 @@
+// Code generated by SDK to schema generator (v0.1.0); DO NOT EDIT.
+
+package schemas
+
+import (
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ShowProjectionPolicySchema represents output of SHOW query for the single ProjectionPolicy.
+var ShowProjectionPolicySchema = map[string]*schema.Schema{
+	"created_on": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"database_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"schema_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"kind": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"comment": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"options": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"owner_role_type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+var _ = ShowProjectionPolicySchema
+
+func ProjectionPolicyToSchema(projectionPolicy *sdk.ProjectionPolicy) map[string]any {
+	projectionPolicySchema := make(map[string]any)
+	projectionPolicySchema["created_on"] = projectionPolicy.CreatedOn.String()
+	projectionPolicySchema["name"] = projectionPolicy.Name
+	projectionPolicySchema["database_name"] = projectionPolicy.DatabaseName
+	projectionPolicySchema["schema_name"] = projectionPolicy.SchemaName
+	projectionPolicySchema["kind"] = projectionPolicy.Kind
+	projectionPolicySchema["owner"] = projectionPolicy.Owner
+	projectionPolicySchema["comment"] = projectionPolicy.Comment
+	projectionPolicySchema["options"] = projectionPolicy.Options
+	projectionPolicySchema["owner_role_type"] = projectionPolicy.OwnerRoleType
+	return projectionPolicySchema
+}
+
+var _ = ProjectionPolicyToSchema
@@ -0,0 +1,112 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+func NewCreateAggregationPolicyRequest(
+	name SchemaObjectIdentifier,
+	body string,
+) *CreateAggregationPolicyRequest {
+	s := CreateAggregationPolicyRequest{}
+	s.name = name
+	s.body = body
+	return &s
+}
+
+func (s *CreateAggregationPolicyRequest) WithOrReplace(orReplace bool) *CreateAggregationPolicyRequest {
+	s.OrReplace = &orReplace
+	return s
+}
+
+func (s *CreateAggregationPolicyRequest) WithIfNotExists(ifNotExists bool) *CreateAggregationPolicyRequest {
+	s.IfNotExists = &ifNotExists
+	return s
+}
+
+func (s *CreateAggregationPolicyRequest) WithComment(comment string) *CreateAggregationPolicyRequest {
+	s.Comment = &comment
+	return s
+}
+
+func NewAlterAggregationPolicyRequest(
+	name SchemaObjectIdentifier,
+) *AlterAggregationPolicyRequest {
+	s := AlterAggregationPolicyRequest{}
+	s.name = name
+	return &s
+}
+
+func (s *AlterAggregationPolicyRequest) WithIfExists(ifExists bool) *AlterAggregationPolicyRequest {
+	s.IfExists = &ifExists
+	return s
+}
+
+func (s *AlterAggregationPolicyRequest) WithRenameTo(renameTo SchemaObjectIdentifier) *AlterAggregationPolicyRequest {
+	s.RenameTo = &renameTo
+	return s
+}
+
+func (s *AlterAggregationPolicyRequest) WithSetBody(setBody string) *AlterAggregationPolicyRequest {
+	s.SetBody = &setBody
+	return s
+}
+
+func (s *AlterAggregationPolicyRequest) WithSetTags(setTags []TagAssociation) *AlterAggregationPolicyRequest {
+	s.SetTags = setTags
+	return s
+}
+
+func (s *AlterAggregationPolicyRequest) WithUnsetTags(unsetTags []ObjectIdentifier) *AlterAggregationPolicyRequest {
+	s.UnsetTags = unsetTags
+	return s
+}
+
+func (s *AlterAggregationPolicyRequest) WithSetComment(setComment string) *AlterAggregationPolicyRequest {
+	s.SetComment = &setComment
+	return s
+}
+
+func (s *AlterAggregationPolicyRequest) WithUnsetComment(unsetComment bool) *AlterAggregationPolicyRequest {
+	s.UnsetComment = &unsetComment
+	return s
+}
+
+func NewDropAggregationPolicyRequest(
+	name SchemaObjectIdentifier,
+) *DropAggregationPolicyRequest {
+	s := DropAggregationPolicyRequest{}
+	s.name = name
+	return &s
+}
+
+func (s *DropAggregationPolicyRequest) WithIfExists(ifExists bool) *DropAggregationPolicyRequest {
+	s.IfExists = &ifExists
+	return s
+}
+
+func NewShowAggregationPolicyRequest() *ShowAggregationPolicyRequest {
+	s := ShowAggregationPolicyRequest{}
+	return &s
+}
+
+func (s *ShowAggregationPolicyRequest) WithLike(like Like) *ShowAggregationPolicyRequest {
+	s.Like = &like
+	return s
+}
+
+func (s *ShowAggregationPolicyRequest) WithIn(in In) *ShowAggregationPolicyRequest {
+	s.In = &in
+	return s
+}
+
+func (s *ShowAggregationPolicyRequest) WithLimit(limit LimitFrom) *ShowAggregationPolicyRequest {
+	s.Limit = &limit
+	return s
+}
+
+func NewDescribeAggregationPolicyRequest(
+	name SchemaObjectIdentifier,
+) *DescribeAggregationPolicyRequest {
+	s := DescribeAggregationPolicyRequest{}
+	s.name = name
+	return &s
+}
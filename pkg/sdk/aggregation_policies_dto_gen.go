@@ -0,0 +1,45 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+var (
+	_ optionsProvider[CreateAggregationPolicyOptions]   = new(CreateAggregationPolicyRequest)
+	_ optionsProvider[AlterAggregationPolicyOptions]    = new(AlterAggregationPolicyRequest)
+	_ optionsProvider[DropAggregationPolicyOptions]     = new(DropAggregationPolicyRequest)
+	_ optionsProvider[ShowAggregationPolicyOptions]     = new(ShowAggregationPolicyRequest)
+	_ optionsProvider[DescribeAggregationPolicyOptions] = new(DescribeAggregationPolicyRequest)
+)
+
+type CreateAggregationPolicyRequest struct {
+	OrReplace   *bool
+	IfNotExists *bool
+	name        SchemaObjectIdentifier // required
+	body        string                 // required
+	Comment     *string
+}
+
+type AlterAggregationPolicyRequest struct {
+	IfExists     *bool
+	name         SchemaObjectIdentifier // required
+	RenameTo     *SchemaObjectIdentifier
+	SetBody      *string
+	SetTags      []TagAssociation
+	UnsetTags    []ObjectIdentifier
+	SetComment   *string
+	UnsetComment *bool
+}
+
+type DropAggregationPolicyRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier // required
+}
+
+type ShowAggregationPolicyRequest struct {
+	Like  *Like
+	In    *In
+	Limit *LimitFrom
+}
+
+type DescribeAggregationPolicyRequest struct {
+	name SchemaObjectIdentifier // required
+}
@@ -0,0 +1,137 @@
+package sdk
+
+func init() {
+	id := aggregationPoliciesTestIdSchemaObjectIdentifier
+	renameTarget := randomSchemaObjectIdentifier()
+	tagId := NewAccountObjectIdentifier("tag1")
+	tagId2 := NewAccountObjectIdentifier("tag2")
+
+	aggregationPoliciesTests.Create.
+		withDefaultOpts(func() *CreateAggregationPolicyOptions {
+			return &CreateAggregationPolicyOptions{
+				name: id,
+				body: "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)",
+			}
+		}).
+		withExpectedSqlf(
+			case_AggregationPolicies_sql_Create_basic,
+			"CREATE AGGREGATION POLICY %s AS () RETURNS AGGREGATION_CONSTRAINT -> AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)",
+			id.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_AggregationPolicies_sql_Create_all,
+			func(opts *CreateAggregationPolicyOptions) {
+				opts.OrReplace = new(true)
+				opts.Comment = new("some comment")
+			},
+			"CREATE OR REPLACE AGGREGATION POLICY %s AS () RETURNS AGGREGATION_CONSTRAINT -> AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5) COMMENT = 'some comment'",
+			id.FullyQualifiedName(),
+		)
+
+	aggregationPoliciesTests.Create.
+		withAdditionalSqlCasef(
+			"sql_Create_ifNotExists",
+			func(opts *CreateAggregationPolicyOptions) {
+				opts.IfNotExists = new(true)
+			},
+			"CREATE AGGREGATION POLICY IF NOT EXISTS %s AS () RETURNS AGGREGATION_CONSTRAINT -> AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)",
+			id.FullyQualifiedName(),
+		)
+
+	aggregationPoliciesTests.Alter.
+		withModifyAndExpectedSqlf(
+			case_AggregationPolicies_sql_Alter_RenameTo,
+			func(opts *AlterAggregationPolicyOptions) {
+				opts.IfExists = new(true)
+				opts.RenameTo = &renameTarget
+			},
+			"ALTER AGGREGATION POLICY IF EXISTS %s RENAME TO %s",
+			id.FullyQualifiedName(), renameTarget.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_AggregationPolicies_sql_Alter_SetBody,
+			func(opts *AlterAggregationPolicyOptions) {
+				opts.SetBody = new("AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)")
+			},
+			"ALTER AGGREGATION POLICY %s SET BODY -> AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)", id.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_AggregationPolicies_sql_Alter_SetTags,
+			func(opts *AlterAggregationPolicyOptions) {
+				opts.SetTags = []TagAssociation{
+					{Name: tagId, Value: "value1"},
+					{Name: tagId2, Value: "value2"},
+				}
+			},
+			"ALTER AGGREGATION POLICY %s SET TAG %s = 'value1', %s = 'value2'",
+			id.FullyQualifiedName(), tagId.FullyQualifiedName(), tagId2.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_AggregationPolicies_sql_Alter_UnsetTags,
+			func(opts *AlterAggregationPolicyOptions) {
+				opts.UnsetTags = []ObjectIdentifier{tagId, tagId2}
+			},
+			"ALTER AGGREGATION POLICY %s UNSET TAG %s, %s",
+			id.FullyQualifiedName(), tagId.FullyQualifiedName(), tagId2.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_AggregationPolicies_sql_Alter_SetComment,
+			func(opts *AlterAggregationPolicyOptions) { opts.SetComment = new("comment") },
+			"ALTER AGGREGATION POLICY %s SET COMMENT = 'comment'", id.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_AggregationPolicies_sql_Alter_UnsetComment,
+			func(opts *AlterAggregationPolicyOptions) { opts.UnsetComment = new(true) },
+			"ALTER AGGREGATION POLICY %s UNSET COMMENT", id.FullyQualifiedName(),
+		)
+
+	aggregationPoliciesTests.Drop.
+		withExpectedSqlf(
+			case_AggregationPolicies_sql_Drop_basic,
+			"DROP AGGREGATION POLICY %s", id.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_AggregationPolicies_sql_Drop_all,
+			func(opts *DropAggregationPolicyOptions) { opts.IfExists = new(true) },
+			"DROP AGGREGATION POLICY IF EXISTS %s", id.FullyQualifiedName(),
+		)
+
+	aggregationPoliciesTests.Show.
+		withExpectedSql(case_AggregationPolicies_sql_Show_basic, "SHOW AGGREGATION POLICIES").
+		withModifyAndExpectedSqlf(
+			case_AggregationPolicies_sql_Show_all,
+			func(opts *ShowAggregationPolicyOptions) {
+				opts.Like = &Like{Pattern: new("pattern")}
+				opts.In = &In{Schema: id.SchemaId()}
+				opts.Limit = &LimitFrom{Rows: new(10), From: new("foo")}
+			},
+			"SHOW AGGREGATION POLICIES LIKE 'pattern' IN SCHEMA %s LIMIT 10 FROM 'foo'", id.SchemaId().FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_AggregationPolicies_sql_Show_Like,
+			func(opts *ShowAggregationPolicyOptions) {
+				opts.Like = &Like{Pattern: new("pattern")}
+			},
+			"SHOW AGGREGATION POLICIES LIKE 'pattern'",
+		).
+		withModifyAndExpectedSqlf(
+			case_AggregationPolicies_sql_Show_In,
+			func(opts *ShowAggregationPolicyOptions) {
+				opts.In = &In{Account: new(true)}
+			},
+			"SHOW AGGREGATION POLICIES IN ACCOUNT",
+		).
+		withModifyAndExpectedSqlf(
+			case_AggregationPolicies_sql_Show_Limit,
+			func(opts *ShowAggregationPolicyOptions) {
+				opts.Limit = &LimitFrom{Rows: new(10)}
+			},
+			"SHOW AGGREGATION POLICIES LIMIT 10",
+		)
+
+	aggregationPoliciesTests.Describe.
+		withExpectedSqlf(
+			case_AggregationPolicies_sql_Describe_basic,
+			"DESCRIBE AGGREGATION POLICY %s", id.FullyQualifiedName(),
+		)
+}
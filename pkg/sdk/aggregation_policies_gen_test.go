@@ -0,0 +1,244 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+import (
+	"testing"
+)
+
+var aggregationPoliciesTestIdSchemaObjectIdentifier = randomSchemaObjectIdentifier()
+
+const (
+	case_AggregationPolicies_validation_Create_name_ValidIdentifier                  testCaseName = "validation_Create_name_ValidIdentifier"
+	case_AggregationPolicies_validation_Create_body_ValidateValueSet                 testCaseName = "validation_Create_body_ValidateValueSet"
+	case_AggregationPolicies_validation_Create_opts_ConflictingFields                testCaseName = "validation_Create_opts_ConflictingFields"
+	case_AggregationPolicies_sql_Create_basic                                        testCaseName = "sql_Create_basic"
+	case_AggregationPolicies_sql_Create_all                                          testCaseName = "sql_Create_all"
+	case_AggregationPolicies_validation_Alter_name_ValidIdentifier                   testCaseName = "validation_Alter_name_ValidIdentifier"
+	case_AggregationPolicies_validation_Alter_opts_ExactlyOneValueSet_NoneSet        testCaseName = "validation_Alter_opts_ExactlyOneValueSet_NoneSet"
+	case_AggregationPolicies_validation_Alter_opts_ExactlyOneValueSet_MoreThanOneSet testCaseName = "validation_Alter_opts_ExactlyOneValueSet_MoreThanOneSet"
+	case_AggregationPolicies_sql_Alter_RenameTo                                      testCaseName = "sql_Alter_RenameTo"
+	case_AggregationPolicies_sql_Alter_SetBody                                       testCaseName = "sql_Alter_SetBody"
+	case_AggregationPolicies_sql_Alter_SetTags                                       testCaseName = "sql_Alter_SetTags"
+	case_AggregationPolicies_sql_Alter_UnsetTags                                     testCaseName = "sql_Alter_UnsetTags"
+	case_AggregationPolicies_sql_Alter_SetComment                                    testCaseName = "sql_Alter_SetComment"
+	case_AggregationPolicies_sql_Alter_UnsetComment                                  testCaseName = "sql_Alter_UnsetComment"
+	case_AggregationPolicies_validation_Drop_name_ValidIdentifier                    testCaseName = "validation_Drop_name_ValidIdentifier"
+	case_AggregationPolicies_sql_Drop_basic                                          testCaseName = "sql_Drop_basic"
+	case_AggregationPolicies_sql_Drop_all                                            testCaseName = "sql_Drop_all"
+	case_AggregationPolicies_sql_Show_basic                                          testCaseName = "sql_Show_basic"
+	case_AggregationPolicies_sql_Show_all                                            testCaseName = "sql_Show_all"
+	case_AggregationPolicies_sql_Show_Like                                           testCaseName = "sql_Show_Like"
+	case_AggregationPolicies_sql_Show_In                                             testCaseName = "sql_Show_In"
+	case_AggregationPolicies_sql_Show_Limit                                          testCaseName = "sql_Show_Limit"
+	case_AggregationPolicies_validation_Describe_name_ValidIdentifier                testCaseName = "validation_Describe_name_ValidIdentifier"
+	case_AggregationPolicies_sql_Describe_basic                                      testCaseName = "sql_Describe_basic"
+)
+
+type AggregationPoliciesTestsContext struct {
+	Create   *sdkTestCtx[*CreateAggregationPolicyOptions]
+	Alter    *sdkTestCtx[*AlterAggregationPolicyOptions]
+	Drop     *sdkTestCtx[*DropAggregationPolicyOptions]
+	Show     *sdkTestCtx[*ShowAggregationPolicyOptions]
+	Describe *sdkTestCtx[*DescribeAggregationPolicyOptions]
+}
+
+var aggregationPoliciesTests = AggregationPoliciesTestsContext{
+	Create: newSdkTestCtx[*CreateAggregationPolicyOptions](
+		"AggregationPolicies", "Create",
+	).
+		withDefaultOpts(func() *CreateAggregationPolicyOptions {
+			return &CreateAggregationPolicyOptions{
+				name: aggregationPoliciesTestIdSchemaObjectIdentifier,
+			}
+		}).
+		withValidationCases(
+			validationCase[*CreateAggregationPolicyOptions]{
+				Name:        case_AggregationPolicies_validation_Create_name_ValidIdentifier,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *CreateAggregationPolicyOptions) {
+					opts.name = emptySchemaObjectIdentifier
+				},
+			},
+			validationCase[*CreateAggregationPolicyOptions]{
+				Name:        case_AggregationPolicies_validation_Create_body_ValidateValueSet,
+				ExpectedErr: errNotSet("CreateAggregationPolicyOptions", "body"),
+				DefaultModify: func(opts *CreateAggregationPolicyOptions) {
+					opts.body = ""
+				},
+			},
+			validationCase[*CreateAggregationPolicyOptions]{
+				Name:        case_AggregationPolicies_validation_Create_opts_ConflictingFields,
+				ExpectedErr: errOneOf("CreateAggregationPolicyOptions", "OrReplace", "IfNotExists"),
+				DefaultModify: func(opts *CreateAggregationPolicyOptions) {
+					opts.OrReplace = new(true)
+					opts.IfNotExists = new(true)
+				},
+			},
+		).
+		withSqlCases(
+			sqlCase[*CreateAggregationPolicyOptions]{
+				Name:           case_AggregationPolicies_sql_Create_basic,
+				NoModifyNeeded: true,
+			},
+			sqlCase[*CreateAggregationPolicyOptions]{
+				Name: case_AggregationPolicies_sql_Create_all,
+			},
+		),
+	Alter: newSdkTestCtx[*AlterAggregationPolicyOptions](
+		"AggregationPolicies", "Alter",
+	).
+		withDefaultOpts(func() *AlterAggregationPolicyOptions {
+			return &AlterAggregationPolicyOptions{
+				name: aggregationPoliciesTestIdSchemaObjectIdentifier,
+			}
+		}).
+		withValidationCases(
+			validationCase[*AlterAggregationPolicyOptions]{
+				Name:        case_AggregationPolicies_validation_Alter_name_ValidIdentifier,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *AlterAggregationPolicyOptions) {
+					opts.name = emptySchemaObjectIdentifier
+				},
+			},
+			validationCase[*AlterAggregationPolicyOptions]{
+				Name:        case_AggregationPolicies_validation_Alter_opts_ExactlyOneValueSet_NoneSet,
+				ExpectedErr: errExactlyOneOf("AlterAggregationPolicyOptions", "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"),
+				DefaultModify: func(opts *AlterAggregationPolicyOptions) {
+					opts.RenameTo = nil
+					opts.SetBody = nil
+					opts.SetTags = nil
+					opts.UnsetTags = nil
+					opts.SetComment = nil
+					opts.UnsetComment = nil
+				},
+			},
+			validationCase[*AlterAggregationPolicyOptions]{
+				Name:        case_AggregationPolicies_validation_Alter_opts_ExactlyOneValueSet_MoreThanOneSet,
+				ExpectedErr: errExactlyOneOf("AlterAggregationPolicyOptions", "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"),
+				DefaultModify: func(opts *AlterAggregationPolicyOptions) {
+					opts.RenameTo = new(randomSchemaObjectIdentifier())
+					opts.SetBody = new("foo")
+				},
+			},
+		).
+		withSqlCases(
+			sqlCase[*AlterAggregationPolicyOptions]{
+				Name: case_AggregationPolicies_sql_Alter_RenameTo,
+			},
+			sqlCase[*AlterAggregationPolicyOptions]{
+				Name: case_AggregationPolicies_sql_Alter_SetBody,
+			},
+			sqlCase[*AlterAggregationPolicyOptions]{
+				Name: case_AggregationPolicies_sql_Alter_SetTags,
+			},
+			sqlCase[*AlterAggregationPolicyOptions]{
+				Name: case_AggregationPolicies_sql_Alter_UnsetTags,
+			},
+			sqlCase[*AlterAggregationPolicyOptions]{
+				Name: case_AggregationPolicies_sql_Alter_SetComment,
+			},
+			sqlCase[*AlterAggregationPolicyOptions]{
+				Name: case_AggregationPolicies_sql_Alter_UnsetComment,
+			},
+		),
+	Drop: newSdkTestCtx[*DropAggregationPolicyOptions](
+		"AggregationPolicies", "Drop",
+	).
+		withDefaultOpts(func() *DropAggregationPolicyOptions {
+			return &DropAggregationPolicyOptions{
+				name: aggregationPoliciesTestIdSchemaObjectIdentifier,
+			}
+		}).
+		withValidationCases(
+			validationCase[*DropAggregationPolicyOptions]{
+				Name:        case_AggregationPolicies_validation_Drop_name_ValidIdentifier,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *DropAggregationPolicyOptions) {
+					opts.name = emptySchemaObjectIdentifier
+				},
+			},
+		).
+		withSqlCases(
+			sqlCase[*DropAggregationPolicyOptions]{
+				Name:           case_AggregationPolicies_sql_Drop_basic,
+				NoModifyNeeded: true,
+			},
+			sqlCase[*DropAggregationPolicyOptions]{
+				Name: case_AggregationPolicies_sql_Drop_all,
+			},
+		),
+	Show: newSdkTestCtx[*ShowAggregationPolicyOptions](
+		"AggregationPolicies", "Show",
+	).
+		withDefaultOpts(func() *ShowAggregationPolicyOptions {
+			return &ShowAggregationPolicyOptions{}
+		}).
+		withValidationCases().
+		withSqlCases(
+			sqlCase[*ShowAggregationPolicyOptions]{
+				Name:           case_AggregationPolicies_sql_Show_basic,
+				NoModifyNeeded: true,
+			},
+			sqlCase[*ShowAggregationPolicyOptions]{
+				Name: case_AggregationPolicies_sql_Show_all,
+			},
+			sqlCase[*ShowAggregationPolicyOptions]{
+				Name: case_AggregationPolicies_sql_Show_Like,
+			},
+			sqlCase[*ShowAggregationPolicyOptions]{
+				Name: case_AggregationPolicies_sql_Show_In,
+			},
+			sqlCase[*ShowAggregationPolicyOptions]{
+				Name: case_AggregationPolicies_sql_Show_Limit,
+			},
+		),
+	Describe: newSdkTestCtx[*DescribeAggregationPolicyOptions](
+		"AggregationPolicies", "Describe",
+	).
+		withDefaultOpts(func() *DescribeAggregationPolicyOptions {
+			return &DescribeAggregationPolicyOptions{
+				name: aggregationPoliciesTestIdSchemaObjectIdentifier,
+			}
+		}).
+		withValidationCases(
+			validationCase[*DescribeAggregationPolicyOptions]{
+				Name:        case_AggregationPolicies_validation_Describe_name_ValidIdentifier,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *DescribeAggregationPolicyOptions) {
+					opts.name = emptySchemaObjectIdentifier
+				},
+			},
+		).
+		withSqlCases(
+			sqlCase[*DescribeAggregationPolicyOptions]{
+				Name:           case_AggregationPolicies_sql_Describe_basic,
+				NoModifyNeeded: true,
+			},
+		),
+}
+
+func TestAggregationPolicies_Create(t *testing.T) {
+	aggregationPoliciesTests.Create.RunValidationCases(t)
+	aggregationPoliciesTests.Create.RunSqlCases(t)
+}
+
+func TestAggregationPolicies_Alter(t *testing.T) {
+	aggregationPoliciesTests.Alter.RunValidationCases(t)
+	aggregationPoliciesTests.Alter.RunSqlCases(t)
+}
+
+func TestAggregationPolicies_Drop(t *testing.T) {
+	aggregationPoliciesTests.Drop.RunValidationCases(t)
+	aggregationPoliciesTests.Drop.RunSqlCases(t)
+}
+
+func TestAggregationPolicies_Show(t *testing.T) {
+	aggregationPoliciesTests.Show.RunValidationCases(t)
+	aggregationPoliciesTests.Show.RunSqlCases(t)
+}
+
+func TestAggregationPolicies_Describe(t *testing.T) {
+	aggregationPoliciesTests.Describe.RunValidationCases(t)
+	aggregationPoliciesTests.Describe.RunSqlCases(t)
+}
@@ -0,0 +1,147 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var (
+	_ AggregationPolicies                      = (*aggregationPolicies)(nil)
+	_ convertibleRow[AggregationPolicy]        = new(aggregationPolicyDBRow)
+	_ convertibleRow[AggregationPolicyDetails] = new(describeAggregationPolicyDBRow)
+)
+
+type aggregationPolicies struct {
+	client *Client
+}
+
+func (v *aggregationPolicies) Create(ctx context.Context, request *CreateAggregationPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *aggregationPolicies) Alter(ctx context.Context, request *AlterAggregationPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *aggregationPolicies) Drop(ctx context.Context, request *DropAggregationPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *aggregationPolicies) DropSafely(ctx context.Context, id SchemaObjectIdentifier) error {
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, NewDropAggregationPolicyRequest(id).WithIfExists(true)) }, ctx, id)
+}
+
+func (v *aggregationPolicies) Show(ctx context.Context, request *ShowAggregationPolicyRequest) ([]AggregationPolicy, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[aggregationPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[aggregationPolicyDBRow, AggregationPolicy](dbRows)
+}
+
+func (v *aggregationPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*AggregationPolicy, error) {
+	request := NewShowAggregationPolicyRequest().
+		WithLike(Like{Pattern: String(id.Name())}).
+		WithIn(In{Schema: id.SchemaId()})
+	aggregationPolicies, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(aggregationPolicies, func(r AggregationPolicy) bool { return r.Name == id.Name() })
+}
+
+func (v *aggregationPolicies) ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*AggregationPolicy, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+func (v *aggregationPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*AggregationPolicyDetails, error) {
+	opts := &DescribeAggregationPolicyOptions{
+		name: id,
+	}
+	result, err := validateAndQueryOne[describeAggregationPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return conversionErrorWrapped(result.convert())
+}
+
+func (r *CreateAggregationPolicyRequest) toOpts() *CreateAggregationPolicyOptions {
+	opts := &CreateAggregationPolicyOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+		body:        r.body,
+		Comment:     r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterAggregationPolicyRequest) toOpts() *AlterAggregationPolicyOptions {
+	opts := &AlterAggregationPolicyOptions{
+		IfExists:     r.IfExists,
+		name:         r.name,
+		RenameTo:     r.RenameTo,
+		SetBody:      r.SetBody,
+		SetTags:      r.SetTags,
+		UnsetTags:    r.UnsetTags,
+		SetComment:   r.SetComment,
+		UnsetComment: r.UnsetComment,
+	}
+	return opts
+}
+
+func (r *DropAggregationPolicyRequest) toOpts() *DropAggregationPolicyOptions {
+	opts := &DropAggregationPolicyOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowAggregationPolicyRequest) toOpts() *ShowAggregationPolicyOptions {
+	opts := &ShowAggregationPolicyOptions{
+		Like:  r.Like,
+		In:    r.In,
+		Limit: r.Limit,
+	}
+	return opts
+}
+
+func (r aggregationPolicyDBRow) convert() (*AggregationPolicy, error) {
+	result := &AggregationPolicy{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		DatabaseName:  r.DatabaseName,
+		SchemaName:    r.SchemaName,
+		Kind:          r.Kind,
+		Owner:         r.Owner,
+		Options:       r.Options,
+		OwnerRoleType: r.OwnerRoleType,
+	}
+	mapNullStringToNonNullableField(&result.Comment, r.Comment)
+	return result, nil
+}
+
+func (r *DescribeAggregationPolicyRequest) toOpts() *DescribeAggregationPolicyOptions {
+	opts := &DescribeAggregationPolicyOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r describeAggregationPolicyDBRow) convert() (*AggregationPolicyDetails, error) {
+	result := &AggregationPolicyDetails{
+		Name:       r.Name,
+		Signature:  r.Signature,
+		ReturnType: r.ReturnType,
+		Body:       r.Body,
+	}
+	return result, nil
+}
@@ -40,6 +40,11 @@ func (s *CreateApplicationPackageRequest) WithDistribution(distribution Distribu
 	return s
 }
 
+func (s *CreateApplicationPackageRequest) WithEnableReleaseChannels(enableReleaseChannels bool) *CreateApplicationPackageRequest {
+	s.EnableReleaseChannels = &enableReleaseChannels
+	return s
+}
+
 func (s *CreateApplicationPackageRequest) WithTag(tag []TagAssociation) *CreateApplicationPackageRequest {
 	s.Tag = tag
 	return s
@@ -290,3 +295,16 @@ func (s *ShowApplicationPackageRequest) WithLimit(limit LimitFrom) *ShowApplicat
 	s.Limit = &limit
 	return s
 }
+
+func NewShowVersionsApplicationPackageRequest(
+	name AccountObjectIdentifier,
+) *ShowVersionsApplicationPackageRequest {
+	s := ShowVersionsApplicationPackageRequest{}
+	s.name = name
+	return &s
+}
+
+func (s *ShowVersionsApplicationPackageRequest) WithLike(like Like) *ShowVersionsApplicationPackageRequest {
+	s.Like = &like
+	return s
+}
@@ -3,10 +3,11 @@
 package sdk
 
 var (
-	_ optionsProvider[CreateApplicationPackageOptions] = new(CreateApplicationPackageRequest)
-	_ optionsProvider[AlterApplicationPackageOptions]  = new(AlterApplicationPackageRequest)
-	_ optionsProvider[DropApplicationPackageOptions]   = new(DropApplicationPackageRequest)
-	_ optionsProvider[ShowApplicationPackageOptions]   = new(ShowApplicationPackageRequest)
+	_ optionsProvider[CreateApplicationPackageOptions]       = new(CreateApplicationPackageRequest)
+	_ optionsProvider[AlterApplicationPackageOptions]        = new(AlterApplicationPackageRequest)
+	_ optionsProvider[DropApplicationPackageOptions]         = new(DropApplicationPackageRequest)
+	_ optionsProvider[ShowApplicationPackageOptions]         = new(ShowApplicationPackageRequest)
+	_ optionsProvider[ShowVersionsApplicationPackageOptions] = new(ShowVersionsApplicationPackageRequest)
 )
 
 type CreateApplicationPackageRequest struct {
@@ -17,6 +18,7 @@ type CreateApplicationPackageRequest struct {
 	DefaultDdlCollation        *string
 	Comment                    *string
 	Distribution               *Distribution
+	EnableReleaseChannels      *bool
 	Tag                        []TagAssociation
 }
 
@@ -100,3 +102,8 @@ type ShowApplicationPackageRequest struct {
 	StartsWith *string
 	Limit      *LimitFrom
 }
+
+type ShowVersionsApplicationPackageRequest struct {
+	Like *Like
+	name AccountObjectIdentifier // required
+}
@@ -16,6 +16,7 @@ type ApplicationPackages interface {
 	Show(ctx context.Context, request *ShowApplicationPackageRequest) ([]ApplicationPackage, error)
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*ApplicationPackage, error)
 	ShowByIDSafely(ctx context.Context, id AccountObjectIdentifier) (*ApplicationPackage, error)
+	ShowVersions(ctx context.Context, request *ShowVersionsApplicationPackageRequest) ([]ApplicationPackageVersion, error)
 }
 
 // CreateApplicationPackageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-application-package.
@@ -29,6 +30,7 @@ type CreateApplicationPackageOptions struct {
 	DefaultDdlCollation        *string                 `ddl:"parameter,single_quotes" sql:"DEFAULT_DDL_COLLATION"`
 	Comment                    *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
 	Distribution               *Distribution           `ddl:"parameter" sql:"DISTRIBUTION"`
+	EnableReleaseChannels      *bool                   `ddl:"parameter" sql:"ENABLE_RELEASE_CHANNELS"`
 	Tag                        []TagAssociation        `ddl:"keyword,parentheses" sql:"TAG"`
 }
 
@@ -157,3 +159,33 @@ func (v *ApplicationPackage) ID() AccountObjectIdentifier {
 func (v *ApplicationPackage) ObjectType() ObjectType {
 	return ObjectTypeApplicationPackage
 }
+
+// ShowVersionsApplicationPackageOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-versions.
+type ShowVersionsApplicationPackageOptions struct {
+	showVersions         bool                    `ddl:"static" sql:"SHOW VERSIONS"`
+	Like                 *Like                   `ddl:"keyword" sql:"LIKE"`
+	inApplicationPackage bool                    `ddl:"static" sql:"IN APPLICATION PACKAGE"`
+	name                 AccountObjectIdentifier `ddl:"identifier"`
+}
+
+type applicationPackageVersionRow struct {
+	Version      string         `db:"version"`
+	Patch        int            `db:"patch"`
+	Label        sql.NullString `db:"label"`
+	Comment      sql.NullString `db:"comment"`
+	CreatedOn    time.Time      `db:"created_on"`
+	DroppedOn    sql.NullString `db:"dropped_on"`
+	State        string         `db:"state"`
+	ReviewStatus string         `db:"review_status"`
+}
+
+type ApplicationPackageVersion struct {
+	Version      string
+	Patch        int
+	Label        string
+	Comment      string
+	CreatedOn    time.Time
+	DroppedOn    string
+	State        string
+	ReviewStatus string
+}
@@ -39,6 +39,7 @@ func TestApplicationPackages_Create(t *testing.T) {
 		opts.DefaultDdlCollation = String("en_US")
 		opts.Comment = String("comment")
 		opts.Distribution = Pointer(DistributionInternal)
+		opts.EnableReleaseChannels = Bool(false)
 		t1 := randomSchemaObjectIdentifier()
 		opts.Tag = []TagAssociation{
 			{
@@ -46,7 +47,7 @@ func TestApplicationPackages_Create(t *testing.T) {
 				Value: "v1",
 			},
 		}
-		assertOptsValidAndSQLEquals(t, opts, "CREATE APPLICATION PACKAGE IF NOT EXISTS %s DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 1 DEFAULT_DDL_COLLATION = 'en_US' COMMENT = 'comment' DISTRIBUTION = INTERNAL TAG (%s = 'v1')", id.FullyQualifiedName(), t1.FullyQualifiedName())
+		assertOptsValidAndSQLEquals(t, opts, "CREATE APPLICATION PACKAGE IF NOT EXISTS %s DATA_RETENTION_TIME_IN_DAYS = 1 MAX_DATA_EXTENSION_TIME_IN_DAYS = 1 DEFAULT_DDL_COLLATION = 'en_US' COMMENT = 'comment' DISTRIBUTION = INTERNAL ENABLE_RELEASE_CHANNELS = false TAG (%s = 'v1')", id.FullyQualifiedName(), t1.FullyQualifiedName())
 	})
 }
 
@@ -281,3 +282,38 @@ func TestApplicationPackages_Show(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `SHOW APPLICATION PACKAGES LIKE 'pattern' STARTS WITH 'A' LIMIT 1 FROM 'B'`)
 	})
 }
+
+func TestApplicationPackages_ShowVersions(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+
+	// Minimal valid ShowVersionsApplicationPackageOptions
+	defaultOpts := func() *ShowVersionsApplicationPackageOptions {
+		return &ShowVersionsApplicationPackageOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		opts := (*ShowVersionsApplicationPackageOptions)(nil)
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `SHOW VERSIONS IN APPLICATION PACKAGE %s`, id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("V1%"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW VERSIONS LIKE 'V1%%' IN APPLICATION PACKAGE %s`, id.FullyQualifiedName())
+	})
+}
@@ -9,8 +9,9 @@ import (
 )
 
 var (
-	_ ApplicationPackages                = (*applicationPackages)(nil)
-	_ convertibleRow[ApplicationPackage] = new(applicationPackageRow)
+	_ ApplicationPackages                       = (*applicationPackages)(nil)
+	_ convertibleRow[ApplicationPackage]        = new(applicationPackageRow)
+	_ convertibleRow[ApplicationPackageVersion] = new(applicationPackageVersionRow)
 )
 
 type applicationPackages struct {
@@ -59,6 +60,15 @@ func (v *applicationPackages) ShowByIDSafely(ctx context.Context, id AccountObje
 	return SafeShowById(v.client, v.ShowByID, ctx, id)
 }
 
+func (v *applicationPackages) ShowVersions(ctx context.Context, request *ShowVersionsApplicationPackageRequest) ([]ApplicationPackageVersion, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[applicationPackageVersionRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[applicationPackageVersionRow, ApplicationPackageVersion](dbRows)
+}
+
 func (r *CreateApplicationPackageRequest) toOpts() *CreateApplicationPackageOptions {
 	opts := &CreateApplicationPackageOptions{
 		IfNotExists:                r.IfNotExists,
@@ -68,6 +78,7 @@ func (r *CreateApplicationPackageRequest) toOpts() *CreateApplicationPackageOpti
 		DefaultDdlCollation:        r.DefaultDdlCollation,
 		Comment:                    r.Comment,
 		Distribution:               r.Distribution,
+		EnableReleaseChannels:      r.EnableReleaseChannels,
 		Tag:                        r.Tag,
 	}
 	return opts
@@ -179,3 +190,25 @@ func (r applicationPackageRow) convert() (*ApplicationPackage, error) {
 	mapNullStringToNonNullableField(&result.ApplicationClass, r.ApplicationClass)
 	return result, nil
 }
+
+func (r *ShowVersionsApplicationPackageRequest) toOpts() *ShowVersionsApplicationPackageOptions {
+	opts := &ShowVersionsApplicationPackageOptions{
+		Like: r.Like,
+		name: r.name,
+	}
+	return opts
+}
+
+func (r applicationPackageVersionRow) convert() (*ApplicationPackageVersion, error) {
+	result := &ApplicationPackageVersion{
+		Version:      r.Version,
+		Patch:        r.Patch,
+		CreatedOn:    r.CreatedOn,
+		State:        r.State,
+		ReviewStatus: r.ReviewStatus,
+	}
+	mapNullStringToNonNullableField(&result.Label, r.Label)
+	mapNullStringToNonNullableField(&result.Comment, r.Comment)
+	mapNullStringToNonNullableField(&result.DroppedOn, r.DroppedOn)
+	return result, nil
+}
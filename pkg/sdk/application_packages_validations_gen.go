@@ -7,6 +7,7 @@ var (
 	_ validatable = new(AlterApplicationPackageOptions)
 	_ validatable = new(DropApplicationPackageOptions)
 	_ validatable = new(ShowApplicationPackageOptions)
+	_ validatable = new(ShowVersionsApplicationPackageOptions)
 )
 
 func (opts *CreateApplicationPackageOptions) validate() error {
@@ -57,3 +58,14 @@ func (opts *ShowApplicationPackageOptions) validate() error {
 	var errs []error
 	return JoinErrors(errs...)
 }
+
+func (opts *ShowVersionsApplicationPackageOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
@@ -57,6 +57,26 @@ func NewVersionAndPatchRequest(
 	return &s
 }
 
+func NewCreateFromListingApplicationRequest(
+	name AccountObjectIdentifier,
+	listingName AccountObjectIdentifier,
+) *CreateFromListingApplicationRequest {
+	s := CreateFromListingApplicationRequest{}
+	s.name = name
+	s.ListingName = listingName
+	return &s
+}
+
+func (s *CreateFromListingApplicationRequest) WithComment(comment string) *CreateFromListingApplicationRequest {
+	s.Comment = &comment
+	return s
+}
+
+func (s *CreateFromListingApplicationRequest) WithTag(tag []TagAssociation) *CreateFromListingApplicationRequest {
+	s.Tag = tag
+	return s
+}
+
 func NewDropApplicationRequest(
 	name AccountObjectIdentifier,
 ) *DropApplicationRequest {
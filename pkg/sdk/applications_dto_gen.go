@@ -3,11 +3,12 @@
 package sdk
 
 var (
-	_ optionsProvider[CreateApplicationOptions]   = new(CreateApplicationRequest)
-	_ optionsProvider[DropApplicationOptions]     = new(DropApplicationRequest)
-	_ optionsProvider[AlterApplicationOptions]    = new(AlterApplicationRequest)
-	_ optionsProvider[ShowApplicationOptions]     = new(ShowApplicationRequest)
-	_ optionsProvider[DescribeApplicationOptions] = new(DescribeApplicationRequest)
+	_ optionsProvider[CreateApplicationOptions]            = new(CreateApplicationRequest)
+	_ optionsProvider[CreateFromListingApplicationOptions] = new(CreateFromListingApplicationRequest)
+	_ optionsProvider[DropApplicationOptions]              = new(DropApplicationRequest)
+	_ optionsProvider[AlterApplicationOptions]             = new(AlterApplicationRequest)
+	_ optionsProvider[ShowApplicationOptions]              = new(ShowApplicationRequest)
+	_ optionsProvider[DescribeApplicationOptions]          = new(DescribeApplicationRequest)
 )
 
 type CreateApplicationRequest struct {
@@ -29,6 +30,13 @@ type VersionAndPatchRequest struct {
 	Patch   *int   // required
 }
 
+type CreateFromListingApplicationRequest struct {
+	name        AccountObjectIdentifier // required
+	ListingName AccountObjectIdentifier // required
+	Comment     *string
+	Tag         []TagAssociation
+}
+
 type DropApplicationRequest struct {
 	IfExists *bool
 	name     AccountObjectIdentifier // required
@@ -10,6 +10,7 @@ import (
 
 type Applications interface {
 	Create(ctx context.Context, request *CreateApplicationRequest) error
+	CreateFromListing(ctx context.Context, request *CreateFromListingApplicationRequest) error
 	Drop(ctx context.Context, request *DropApplicationRequest) error
 	DropSafely(ctx context.Context, id AccountObjectIdentifier) error
 	Alter(ctx context.Context, request *AlterApplicationRequest) error
@@ -42,6 +43,17 @@ type VersionAndPatch struct {
 	Patch   *int   `ddl:"parameter,no_equals" sql:"PATCH"`
 }
 
+// CreateFromListingApplicationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-application.
+type CreateFromListingApplicationOptions struct {
+	create      bool                    `ddl:"static" sql:"CREATE"`
+	application bool                    `ddl:"static" sql:"APPLICATION"`
+	name        AccountObjectIdentifier `ddl:"identifier"`
+	fromListing bool                    `ddl:"static" sql:"FROM LISTING"`
+	ListingName AccountObjectIdentifier `ddl:"identifier"`
+	Comment     *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+	Tag         []TagAssociation        `ddl:"keyword,parentheses" sql:"TAG"`
+}
+
 // DropApplicationOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-application.
 type DropApplicationOptions struct {
 	drop        bool                    `ddl:"static" sql:"DROP"`
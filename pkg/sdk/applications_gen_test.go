@@ -110,6 +110,54 @@ func TestApplications_Create(t *testing.T) {
 	})
 }
 
+func TestApplications_CreateFromListing(t *testing.T) {
+	id := randomAccountObjectIdentifier()
+	listingId := randomAccountObjectIdentifier()
+
+	// Minimal valid CreateFromListingApplicationOptions
+	defaultOpts := func() *CreateFromListingApplicationOptions {
+		return &CreateFromListingApplicationOptions{
+			name:        id,
+			ListingName: listingId,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		opts := (*CreateFromListingApplicationOptions)(nil)
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.ListingName]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ListingName = emptyAccountObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `CREATE APPLICATION %s FROM LISTING %s`, id.FullyQualifiedName(), listingId.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		tid := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.Comment = String("test")
+		opts.Tag = []TagAssociation{
+			{
+				Name:  tid,
+				Value: "v1",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `CREATE APPLICATION %s FROM LISTING %s COMMENT = 'test' TAG (%s = 'v1')`, id.FullyQualifiedName(), listingId.FullyQualifiedName(), tid.FullyQualifiedName())
+	})
+}
+
 func TestApplications_Drop(t *testing.T) {
 	id := randomAccountObjectIdentifier()
 	// Minimal valid DropApplicationOptions
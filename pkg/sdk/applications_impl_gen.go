@@ -23,6 +23,11 @@ func (v *applications) Create(ctx context.Context, request *CreateApplicationReq
 	return validateAndExec(v.client, ctx, opts)
 }
 
+func (v *applications) CreateFromListing(ctx context.Context, request *CreateFromListingApplicationRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
 func (v *applications) Drop(ctx context.Context, request *DropApplicationRequest) error {
 	opts := request.toOpts()
 	return validateAndExec(v.client, ctx, opts)
@@ -93,6 +98,16 @@ func (r *CreateApplicationRequest) toOpts() *CreateApplicationOptions {
 	return opts
 }
 
+func (r *CreateFromListingApplicationRequest) toOpts() *CreateFromListingApplicationOptions {
+	opts := &CreateFromListingApplicationOptions{
+		name:        r.name,
+		ListingName: r.ListingName,
+		Comment:     r.Comment,
+		Tag:         r.Tag,
+	}
+	return opts
+}
+
 func (r *DropApplicationRequest) toOpts() *DropApplicationOptions {
 	opts := &DropApplicationOptions{
 		IfExists: r.IfExists,
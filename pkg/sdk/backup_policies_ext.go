@@ -0,0 +1,5 @@
+package sdk
+
+func (d *BackupPolicyDetails) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(d.DatabaseName, d.SchemaName, d.Name)
+}
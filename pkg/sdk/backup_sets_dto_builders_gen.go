@@ -0,0 +1,192 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+func NewCreateBackupSetRequest(
+	name SchemaObjectIdentifier,
+	forObject BackupSetForRequest,
+) *CreateBackupSetRequest {
+	s := CreateBackupSetRequest{}
+	s.name = name
+	s.ForObject = forObject
+	return &s
+}
+
+func (s *CreateBackupSetRequest) WithOrReplace(orReplace bool) *CreateBackupSetRequest {
+	s.OrReplace = &orReplace
+	return s
+}
+
+func (s *CreateBackupSetRequest) WithIfNotExists(ifNotExists bool) *CreateBackupSetRequest {
+	s.IfNotExists = &ifNotExists
+	return s
+}
+
+func (s *CreateBackupSetRequest) WithBackupPolicy(backupPolicy SchemaObjectIdentifier) *CreateBackupSetRequest {
+	s.BackupPolicy = &backupPolicy
+	return s
+}
+
+func (s *CreateBackupSetRequest) WithTag(tag []TagAssociation) *CreateBackupSetRequest {
+	s.Tag = tag
+	return s
+}
+
+func (s *CreateBackupSetRequest) WithComment(comment string) *CreateBackupSetRequest {
+	s.Comment = &comment
+	return s
+}
+
+func NewBackupSetForRequest() *BackupSetForRequest {
+	s := BackupSetForRequest{}
+	return &s
+}
+
+func (s *BackupSetForRequest) WithTable(table SchemaObjectIdentifier) *BackupSetForRequest {
+	s.Table = &table
+	return s
+}
+
+func (s *BackupSetForRequest) WithDynamicTable(dynamicTable SchemaObjectIdentifier) *BackupSetForRequest {
+	s.DynamicTable = &dynamicTable
+	return s
+}
+
+func (s *BackupSetForRequest) WithSchema(schema DatabaseObjectIdentifier) *BackupSetForRequest {
+	s.Schema = &schema
+	return s
+}
+
+func (s *BackupSetForRequest) WithDatabase(database AccountObjectIdentifier) *BackupSetForRequest {
+	s.Database = &database
+	return s
+}
+
+func NewAlterBackupSetRequest(
+	name SchemaObjectIdentifier,
+) *AlterBackupSetRequest {
+	s := AlterBackupSetRequest{}
+	s.name = name
+	return &s
+}
+
+func (s *AlterBackupSetRequest) WithIfExists(ifExists bool) *AlterBackupSetRequest {
+	s.IfExists = &ifExists
+	return s
+}
+
+func (s *AlterBackupSetRequest) WithAddBackup(addBackup bool) *AlterBackupSetRequest {
+	s.AddBackup = &addBackup
+	return s
+}
+
+func (s *AlterBackupSetRequest) WithDeleteBackupIdentifier(deleteBackupIdentifier string) *AlterBackupSetRequest {
+	s.DeleteBackupIdentifier = &deleteBackupIdentifier
+	return s
+}
+
+func (s *AlterBackupSetRequest) WithApplyBackupPolicy(applyBackupPolicy BackupSetApplyBackupPolicyRequest) *AlterBackupSetRequest {
+	s.ApplyBackupPolicy = &applyBackupPolicy
+	return s
+}
+
+func (s *AlterBackupSetRequest) WithSuspendBackupPolicy(suspendBackupPolicy bool) *AlterBackupSetRequest {
+	s.SuspendBackupPolicy = &suspendBackupPolicy
+	return s
+}
+
+func (s *AlterBackupSetRequest) WithResumeBackupPolicy(resumeBackupPolicy bool) *AlterBackupSetRequest {
+	s.ResumeBackupPolicy = &resumeBackupPolicy
+	return s
+}
+
+func (s *AlterBackupSetRequest) WithSet(set BackupSetSetRequest) *AlterBackupSetRequest {
+	s.Set = &set
+	return s
+}
+
+func (s *AlterBackupSetRequest) WithSetTags(setTags []TagAssociation) *AlterBackupSetRequest {
+	s.SetTags = setTags
+	return s
+}
+
+func (s *AlterBackupSetRequest) WithUnset(unset BackupSetUnsetRequest) *AlterBackupSetRequest {
+	s.Unset = &unset
+	return s
+}
+
+func (s *AlterBackupSetRequest) WithUnsetTags(unsetTags []ObjectIdentifier) *AlterBackupSetRequest {
+	s.UnsetTags = unsetTags
+	return s
+}
+
+func NewBackupSetApplyBackupPolicyRequest(
+	backupPolicy SchemaObjectIdentifier,
+) *BackupSetApplyBackupPolicyRequest {
+	s := BackupSetApplyBackupPolicyRequest{}
+	s.BackupPolicy = backupPolicy
+	return &s
+}
+
+func (s *BackupSetApplyBackupPolicyRequest) WithForce(force bool) *BackupSetApplyBackupPolicyRequest {
+	s.Force = &force
+	return s
+}
+
+func NewBackupSetSetRequest() *BackupSetSetRequest {
+	s := BackupSetSetRequest{}
+	return &s
+}
+
+func (s *BackupSetSetRequest) WithComment(comment string) *BackupSetSetRequest {
+	s.Comment = &comment
+	return s
+}
+
+func NewBackupSetUnsetRequest() *BackupSetUnsetRequest {
+	s := BackupSetUnsetRequest{}
+	return &s
+}
+
+func (s *BackupSetUnsetRequest) WithComment(comment bool) *BackupSetUnsetRequest {
+	s.Comment = &comment
+	return s
+}
+
+func NewDropBackupSetRequest(
+	name SchemaObjectIdentifier,
+) *DropBackupSetRequest {
+	s := DropBackupSetRequest{}
+	s.name = name
+	return &s
+}
+
+func (s *DropBackupSetRequest) WithIfExists(ifExists bool) *DropBackupSetRequest {
+	s.IfExists = &ifExists
+	return s
+}
+
+func NewShowBackupSetRequest() *ShowBackupSetRequest {
+	s := ShowBackupSetRequest{}
+	return &s
+}
+
+func (s *ShowBackupSetRequest) WithLike(like Like) *ShowBackupSetRequest {
+	s.Like = &like
+	return s
+}
+
+func (s *ShowBackupSetRequest) WithIn(in In) *ShowBackupSetRequest {
+	s.In = &in
+	return s
+}
+
+func (s *ShowBackupSetRequest) WithStartsWith(startsWith string) *ShowBackupSetRequest {
+	s.StartsWith = &startsWith
+	return s
+}
+
+func (s *ShowBackupSetRequest) WithLimit(limit LimitFrom) *ShowBackupSetRequest {
+	s.Limit = &limit
+	return s
+}
@@ -0,0 +1,66 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+var (
+	_ optionsProvider[CreateBackupSetOptions] = new(CreateBackupSetRequest)
+	_ optionsProvider[AlterBackupSetOptions]  = new(AlterBackupSetRequest)
+	_ optionsProvider[DropBackupSetOptions]   = new(DropBackupSetRequest)
+	_ optionsProvider[ShowBackupSetOptions]   = new(ShowBackupSetRequest)
+)
+
+type CreateBackupSetRequest struct {
+	OrReplace    *bool
+	IfNotExists  *bool
+	name         SchemaObjectIdentifier // required
+	ForObject    BackupSetForRequest    // required
+	BackupPolicy *SchemaObjectIdentifier
+	Tag          []TagAssociation
+	Comment      *string
+}
+
+type BackupSetForRequest struct {
+	Table        *SchemaObjectIdentifier
+	DynamicTable *SchemaObjectIdentifier
+	Schema       *DatabaseObjectIdentifier
+	Database     *AccountObjectIdentifier
+}
+
+type AlterBackupSetRequest struct {
+	IfExists               *bool
+	name                   SchemaObjectIdentifier // required
+	AddBackup              *bool
+	DeleteBackupIdentifier *string
+	ApplyBackupPolicy      *BackupSetApplyBackupPolicyRequest
+	SuspendBackupPolicy    *bool
+	ResumeBackupPolicy     *bool
+	Set                    *BackupSetSetRequest
+	SetTags                []TagAssociation
+	Unset                  *BackupSetUnsetRequest
+	UnsetTags              []ObjectIdentifier
+}
+
+type BackupSetApplyBackupPolicyRequest struct {
+	BackupPolicy SchemaObjectIdentifier // required
+	Force        *bool
+}
+
+type BackupSetSetRequest struct {
+	Comment *string
+}
+
+type BackupSetUnsetRequest struct {
+	Comment *bool
+}
+
+type DropBackupSetRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier // required
+}
+
+type ShowBackupSetRequest struct {
+	Like       *Like
+	In         *In
+	StartsWith *string
+	Limit      *LimitFrom
+}
@@ -0,0 +1,40 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+import (
+	"fmt"
+	"strings"
+)
+
+type BackupSetObjectKind string
+
+const (
+	BackupSetObjectKindTable        BackupSetObjectKind = "TABLE"
+	BackupSetObjectKindDynamicTable BackupSetObjectKind = "DYNAMIC TABLE"
+	BackupSetObjectKindSchema       BackupSetObjectKind = "SCHEMA"
+	BackupSetObjectKindDatabase     BackupSetObjectKind = "DATABASE"
+)
+
+var AllBackupSetObjectKinds = []BackupSetObjectKind{
+	BackupSetObjectKindTable,
+	BackupSetObjectKindDynamicTable,
+	BackupSetObjectKindSchema,
+	BackupSetObjectKindDatabase,
+}
+
+func ToBackupSetObjectKind(s string) (BackupSetObjectKind, error) {
+	s = strings.ToUpper(s)
+	switch s {
+	case string(BackupSetObjectKindTable):
+		return BackupSetObjectKindTable, nil
+	case string(BackupSetObjectKindDynamicTable):
+		return BackupSetObjectKindDynamicTable, nil
+	case string(BackupSetObjectKindSchema):
+		return BackupSetObjectKindSchema, nil
+	case string(BackupSetObjectKindDatabase):
+		return BackupSetObjectKindDatabase, nil
+	default:
+		return "", fmt.Errorf("invalid backup set object kind: %s", s)
+	}
+}
@@ -0,0 +1,125 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type BackupSets interface {
+	Create(ctx context.Context, request *CreateBackupSetRequest) error
+	Alter(ctx context.Context, request *AlterBackupSetRequest) error
+	Drop(ctx context.Context, request *DropBackupSetRequest) error
+	DropSafely(ctx context.Context, id SchemaObjectIdentifier) error
+	Show(ctx context.Context, request *ShowBackupSetRequest) ([]BackupSet, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*BackupSet, error)
+	ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*BackupSet, error)
+}
+
+// CreateBackupSetOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-backup-set.
+type CreateBackupSetOptions struct {
+	create       bool                    `ddl:"static" sql:"CREATE"`
+	OrReplace    *bool                   `ddl:"keyword" sql:"OR REPLACE"`
+	backupSet    bool                    `ddl:"static" sql:"BACKUP SET"`
+	IfNotExists  *bool                   `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name         SchemaObjectIdentifier  `ddl:"identifier"`
+	ForObject    BackupSetFor            `ddl:"keyword" sql:"FOR"`
+	BackupPolicy *SchemaObjectIdentifier `ddl:"identifier" sql:"WITH BACKUP POLICY"`
+	Tag          []TagAssociation        `ddl:"keyword,parentheses" sql:"TAG"`
+	Comment      *string                 `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type BackupSetFor struct {
+	Table        *SchemaObjectIdentifier   `ddl:"identifier" sql:"TABLE"`
+	DynamicTable *SchemaObjectIdentifier   `ddl:"identifier" sql:"DYNAMIC TABLE"`
+	Schema       *DatabaseObjectIdentifier `ddl:"identifier" sql:"SCHEMA"`
+	Database     *AccountObjectIdentifier  `ddl:"identifier" sql:"DATABASE"`
+}
+
+// AlterBackupSetOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-backup-set.
+type AlterBackupSetOptions struct {
+	alter                  bool                        `ddl:"static" sql:"ALTER"`
+	backupSet              bool                        `ddl:"static" sql:"BACKUP SET"`
+	IfExists               *bool                       `ddl:"keyword" sql:"IF EXISTS"`
+	name                   SchemaObjectIdentifier      `ddl:"identifier"`
+	AddBackup              *bool                       `ddl:"keyword" sql:"ADD BACKUP"`
+	DeleteBackupIdentifier *string                     `ddl:"parameter,single_quotes,no_equals" sql:"DELETE BACKUP IDENTIFIER"`
+	ApplyBackupPolicy      *BackupSetApplyBackupPolicy `ddl:"keyword" sql:"APPLY BACKUP POLICY"`
+	SuspendBackupPolicy    *bool                       `ddl:"keyword" sql:"SUSPEND BACKUP POLICY"`
+	ResumeBackupPolicy     *bool                       `ddl:"keyword" sql:"RESUME BACKUP POLICY"`
+	Set                    *BackupSetSet               `ddl:"keyword" sql:"SET"`
+	SetTags                []TagAssociation            `ddl:"keyword" sql:"SET TAG"`
+	Unset                  *BackupSetUnset             `ddl:"list,no_parentheses" sql:"UNSET"`
+	UnsetTags              []ObjectIdentifier          `ddl:"keyword" sql:"UNSET TAG"`
+}
+
+type BackupSetApplyBackupPolicy struct {
+	BackupPolicy SchemaObjectIdentifier `ddl:"identifier"`
+	Force        *bool                  `ddl:"keyword" sql:"FORCE"`
+}
+
+type BackupSetSet struct {
+	Comment *string `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type BackupSetUnset struct {
+	Comment *bool `ddl:"keyword" sql:"COMMENT"`
+}
+
+// DropBackupSetOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-backup-set.
+type DropBackupSetOptions struct {
+	drop      bool                   `ddl:"static" sql:"DROP"`
+	backupSet bool                   `ddl:"static" sql:"BACKUP SET"`
+	IfExists  *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name      SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowBackupSetOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-backup-sets.
+type ShowBackupSetOptions struct {
+	show       bool       `ddl:"static" sql:"SHOW"`
+	backupSets bool       `ddl:"static" sql:"BACKUP SETS"`
+	Like       *Like      `ddl:"keyword" sql:"LIKE"`
+	In         *In        `ddl:"keyword" sql:"IN"`
+	StartsWith *string    `ddl:"parameter,single_quotes,no_equals" sql:"STARTS WITH"`
+	Limit      *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+}
+
+type backupSetDBRow struct {
+	CreatedOn          time.Time      `db:"created_on"`
+	Name               string         `db:"name"`
+	DatabaseName       string         `db:"database_name"`
+	SchemaName         string         `db:"schema_name"`
+	Comment            sql.NullString `db:"comment"`
+	ObjectKind         string         `db:"object_kind"`
+	ObjectName         string         `db:"object_name"`
+	ObjectDatabaseName sql.NullString `db:"object_database_name"`
+	ObjectSchemaName   sql.NullString `db:"object_schema_name"`
+	BackupPolicyName   sql.NullString `db:"backup_policy_name"`
+	Owner              string         `db:"owner"`
+	OwnerRoleType      string         `db:"owner_role_type"`
+}
+
+type BackupSet struct {
+	CreatedOn          time.Time
+	Name               string
+	DatabaseName       string
+	SchemaName         string
+	Comment            *string
+	ObjectKind         string
+	ObjectName         string
+	ObjectDatabaseName *string
+	ObjectSchemaName   *string
+	BackupPolicyName   *string
+	Owner              string
+	OwnerRoleType      string
+}
+
+func (v *BackupSet) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *BackupSet) ObjectType() ObjectType {
+	return ObjectTypeBackupSet
+}
@@ -0,0 +1,287 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+import (
+	"testing"
+)
+
+func TestBackupSets_Create(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	tableId := randomSchemaObjectIdentifier()
+	// Minimal valid CreateBackupSetOptions
+	defaultOpts := func() *CreateBackupSetOptions {
+		return &CreateBackupSetOptions{
+			// adjusted manually
+			name: id,
+			ForObject: BackupSetFor{
+				Table: &tableId,
+			},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		opts := (*CreateBackupSetOptions)(nil)
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: valid identifier for [opts.BackupPolicy] if set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.BackupPolicy = &emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: conflicting fields for [opts.OrReplace opts.IfNotExists]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.OrReplace = new(true)
+		opts.IfNotExists = new(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("CreateBackupSetOptions", "OrReplace", "IfNotExists"))
+	})
+
+	t.Run("validation: exactly one field from [opts.ForObject.Table opts.ForObject.DynamicTable opts.ForObject.Schema opts.ForObject.Database] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ForObject = BackupSetFor{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateBackupSetOptions.ForObject", "Table", "DynamicTable", "Schema", "Database"))
+	})
+
+	t.Run("validation: exactly one field from [opts.ForObject.Table opts.ForObject.DynamicTable opts.ForObject.Schema opts.ForObject.Database] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ForObject.Database = new(randomAccountObjectIdentifier())
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CreateBackupSetOptions.ForObject", "Table", "DynamicTable", "Schema", "Database"))
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "CREATE BACKUP SET %s FOR TABLE %s", id.FullyQualifiedName(), tableId.FullyQualifiedName())
+	})
+
+	// variants added manually
+	t.Run("for dynamic table", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ForObject = BackupSetFor{DynamicTable: &tableId}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE BACKUP SET %s FOR DYNAMIC TABLE %s", id.FullyQualifiedName(), tableId.FullyQualifiedName())
+	})
+
+	t.Run("for schema", func(t *testing.T) {
+		schemaId := randomDatabaseObjectIdentifier()
+		opts := defaultOpts()
+		opts.ForObject = BackupSetFor{Schema: &schemaId}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE BACKUP SET %s FOR SCHEMA %s", id.FullyQualifiedName(), schemaId.FullyQualifiedName())
+	})
+
+	t.Run("for database", func(t *testing.T) {
+		databaseId := randomAccountObjectIdentifier()
+		opts := defaultOpts()
+		opts.ForObject = BackupSetFor{Database: &databaseId}
+		assertOptsValidAndSQLEquals(t, opts, "CREATE BACKUP SET %s FOR DATABASE %s", id.FullyQualifiedName(), databaseId.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		backupPolicyId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.OrReplace = new(true)
+		opts.BackupPolicy = &backupPolicyId
+		opts.Tag = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("tag1"),
+				Value: "value1",
+			},
+		}
+		opts.Comment = new("some comment")
+		assertOptsValidAndSQLEquals(t, opts, `CREATE OR REPLACE BACKUP SET %s FOR TABLE %s WITH BACKUP POLICY %s TAG ("tag1" = 'value1') COMMENT = 'some comment'`, id.FullyQualifiedName(), tableId.FullyQualifiedName(), backupPolicyId.FullyQualifiedName())
+	})
+}
+
+func TestBackupSets_Alter(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	// Minimal valid AlterBackupSetOptions
+	defaultOpts := func() *AlterBackupSetOptions {
+		return &AlterBackupSetOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		opts := (*AlterBackupSetOptions)(nil)
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.AddBackup opts.DeleteBackupIdentifier opts.ApplyBackupPolicy opts.SuspendBackupPolicy opts.ResumeBackupPolicy opts.Set opts.SetTags opts.Unset opts.UnsetTags] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterBackupSetOptions", "AddBackup", "DeleteBackupIdentifier", "ApplyBackupPolicy", "SuspendBackupPolicy", "ResumeBackupPolicy", "Set", "SetTags", "Unset", "UnsetTags"))
+	})
+
+	t.Run("validation: exactly one field from [opts.AddBackup opts.DeleteBackupIdentifier opts.ApplyBackupPolicy opts.SuspendBackupPolicy opts.ResumeBackupPolicy opts.Set opts.SetTags opts.Unset opts.UnsetTags] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SuspendBackupPolicy = new(true)
+		opts.ResumeBackupPolicy = new(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterBackupSetOptions", "AddBackup", "DeleteBackupIdentifier", "ApplyBackupPolicy", "SuspendBackupPolicy", "ResumeBackupPolicy", "Set", "SetTags", "Unset", "UnsetTags"))
+	})
+
+	t.Run("validation: valid identifier for [opts.ApplyBackupPolicy.BackupPolicy]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.ApplyBackupPolicy = &BackupSetApplyBackupPolicy{
+			BackupPolicy: emptySchemaObjectIdentifier,
+		}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: at least one of the fields [opts.Set.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &BackupSetSet{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterBackupSetOptions.Set", "Comment"))
+	})
+
+	t.Run("validation: at least one of the fields [opts.Unset.Comment] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &BackupSetUnset{}
+		assertOptsInvalidJoinedErrors(t, opts, errAtLeastOneOf("AlterBackupSetOptions.Unset", "Comment"))
+	})
+
+	// variants added manually
+	t.Run("add backup", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.AddBackup = new(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER BACKUP SET %s ADD BACKUP", id.FullyQualifiedName())
+	})
+
+	t.Run("delete backup", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.DeleteBackupIdentifier = new("backup-id")
+		assertOptsValidAndSQLEquals(t, opts, "ALTER BACKUP SET %s DELETE BACKUP IDENTIFIER 'backup-id'", id.FullyQualifiedName())
+	})
+
+	t.Run("apply backup policy", func(t *testing.T) {
+		backupPolicyId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.ApplyBackupPolicy = &BackupSetApplyBackupPolicy{
+			BackupPolicy: backupPolicyId,
+			Force:        new(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER BACKUP SET %s APPLY BACKUP POLICY %s FORCE", id.FullyQualifiedName(), backupPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("suspend backup policy", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SuspendBackupPolicy = new(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER BACKUP SET %s SUSPEND BACKUP POLICY", id.FullyQualifiedName())
+	})
+
+	t.Run("resume backup policy", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = new(true)
+		opts.ResumeBackupPolicy = new(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER BACKUP SET IF EXISTS %s RESUME BACKUP POLICY", id.FullyQualifiedName())
+	})
+
+	t.Run("set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &BackupSetSet{
+			Comment: new("some comment"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER BACKUP SET %s SET COMMENT = 'some comment'", id.FullyQualifiedName())
+	})
+
+	t.Run("set tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetTags = []TagAssociation{
+			{
+				Name:  NewAccountObjectIdentifier("tag1"),
+				Value: "value1",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER BACKUP SET %s SET TAG "tag1" = 'value1'`, id.FullyQualifiedName())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &BackupSetUnset{
+			Comment: new(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER BACKUP SET %s UNSET COMMENT", id.FullyQualifiedName())
+	})
+
+	t.Run("unset tags", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetTags = []ObjectIdentifier{
+			NewAccountObjectIdentifier("tag1"),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER BACKUP SET %s UNSET TAG "tag1"`, id.FullyQualifiedName())
+	})
+}
+
+func TestBackupSets_Drop(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	// Minimal valid DropBackupSetOptions
+	defaultOpts := func() *DropBackupSetOptions {
+		return &DropBackupSetOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		opts := (*DropBackupSetOptions)(nil)
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "DROP BACKUP SET %s", id.FullyQualifiedName())
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.IfExists = new(true)
+		assertOptsValidAndSQLEquals(t, opts, "DROP BACKUP SET IF EXISTS %s", id.FullyQualifiedName())
+	})
+}
+
+func TestBackupSets_Show(t *testing.T) {
+	// Minimal valid ShowBackupSetOptions
+	defaultOpts := func() *ShowBackupSetOptions {
+		return &ShowBackupSetOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		opts := (*ShowBackupSetOptions)(nil)
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW BACKUP SETS")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Like = &Like{
+			Pattern: String("like-pattern"),
+		}
+		opts.In = &In{
+			Account: new(true),
+		}
+		opts.StartsWith = String("abc")
+		opts.Limit = &LimitFrom{
+			Rows: Int(10),
+		}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW BACKUP SETS LIKE 'like-pattern' IN ACCOUNT STARTS WITH 'abc' LIMIT 10")
+	})
+}
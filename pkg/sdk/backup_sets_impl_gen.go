@@ -0,0 +1,145 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var (
+	_ BackupSets                = (*backupSets)(nil)
+	_ convertibleRow[BackupSet] = new(backupSetDBRow)
+)
+
+type backupSets struct {
+	client *Client
+}
+
+func (v *backupSets) Create(ctx context.Context, request *CreateBackupSetRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *backupSets) Alter(ctx context.Context, request *AlterBackupSetRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *backupSets) Drop(ctx context.Context, request *DropBackupSetRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *backupSets) DropSafely(ctx context.Context, id SchemaObjectIdentifier) error {
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, NewDropBackupSetRequest(id).WithIfExists(true)) }, ctx, id)
+}
+
+func (v *backupSets) Show(ctx context.Context, request *ShowBackupSetRequest) ([]BackupSet, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[backupSetDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[backupSetDBRow, BackupSet](dbRows)
+}
+
+func (v *backupSets) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*BackupSet, error) {
+	request := NewShowBackupSetRequest().
+		WithLike(Like{Pattern: String(id.Name())}).
+		WithIn(In{Schema: id.SchemaId()})
+	backupSets, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(backupSets, func(r BackupSet) bool { return r.Name == id.Name() })
+}
+
+func (v *backupSets) ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*BackupSet, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+func (r *CreateBackupSetRequest) toOpts() *CreateBackupSetOptions {
+	opts := &CreateBackupSetOptions{
+		OrReplace:    r.OrReplace,
+		IfNotExists:  r.IfNotExists,
+		name:         r.name,
+		BackupPolicy: r.BackupPolicy,
+		Tag:          r.Tag,
+		Comment:      r.Comment,
+	}
+	opts.ForObject = BackupSetFor{
+		Table:        r.ForObject.Table,
+		DynamicTable: r.ForObject.DynamicTable,
+		Schema:       r.ForObject.Schema,
+		Database:     r.ForObject.Database,
+	}
+	return opts
+}
+
+func (r *AlterBackupSetRequest) toOpts() *AlterBackupSetOptions {
+	opts := &AlterBackupSetOptions{
+		IfExists:               r.IfExists,
+		name:                   r.name,
+		AddBackup:              r.AddBackup,
+		DeleteBackupIdentifier: r.DeleteBackupIdentifier,
+		SuspendBackupPolicy:    r.SuspendBackupPolicy,
+		ResumeBackupPolicy:     r.ResumeBackupPolicy,
+		SetTags:                r.SetTags,
+		UnsetTags:              r.UnsetTags,
+	}
+	if r.ApplyBackupPolicy != nil {
+		opts.ApplyBackupPolicy = &BackupSetApplyBackupPolicy{
+			BackupPolicy: r.ApplyBackupPolicy.BackupPolicy,
+			Force:        r.ApplyBackupPolicy.Force,
+		}
+	}
+	if r.Set != nil {
+		opts.Set = &BackupSetSet{
+			Comment: r.Set.Comment,
+		}
+	}
+	if r.Unset != nil {
+		opts.Unset = &BackupSetUnset{
+			Comment: r.Unset.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropBackupSetRequest) toOpts() *DropBackupSetOptions {
+	opts := &DropBackupSetOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowBackupSetRequest) toOpts() *ShowBackupSetOptions {
+	opts := &ShowBackupSetOptions{
+		Like:       r.Like,
+		In:         r.In,
+		StartsWith: r.StartsWith,
+		Limit:      r.Limit,
+	}
+	return opts
+}
+
+func (r backupSetDBRow) convert() (*BackupSet, error) {
+	result := &BackupSet{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		DatabaseName:  r.DatabaseName,
+		SchemaName:    r.SchemaName,
+		ObjectKind:    r.ObjectKind,
+		ObjectName:    r.ObjectName,
+		Owner:         r.Owner,
+		OwnerRoleType: r.OwnerRoleType,
+	}
+	mapNullString(&result.Comment, r.Comment)
+	mapNullString(&result.ObjectDatabaseName, r.ObjectDatabaseName)
+	mapNullString(&result.ObjectSchemaName, r.ObjectSchemaName)
+	mapNullString(&result.BackupPolicyName, r.BackupPolicyName)
+	return result, nil
+}
@@ -0,0 +1,80 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+var (
+	_ validatable = new(CreateBackupSetOptions)
+	_ validatable = new(AlterBackupSetOptions)
+	_ validatable = new(DropBackupSetOptions)
+	_ validatable = new(ShowBackupSetOptions)
+)
+
+func (opts *CreateBackupSetOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.BackupPolicy != nil && !ValidObjectIdentifier(opts.BackupPolicy) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateBackupSetOptions", "OrReplace", "IfNotExists"))
+	}
+	if valueSet(opts.ForObject) {
+		if !exactlyOneValueSet(opts.ForObject.Table, opts.ForObject.DynamicTable, opts.ForObject.Schema, opts.ForObject.Database) {
+			errs = append(errs, errExactlyOneOf("CreateBackupSetOptions.ForObject", "Table", "DynamicTable", "Schema", "Database"))
+		}
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterBackupSetOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.AddBackup, opts.DeleteBackupIdentifier, opts.ApplyBackupPolicy, opts.SuspendBackupPolicy, opts.ResumeBackupPolicy, opts.Set, opts.SetTags, opts.Unset, opts.UnsetTags) {
+		errs = append(errs, errExactlyOneOf("AlterBackupSetOptions", "AddBackup", "DeleteBackupIdentifier", "ApplyBackupPolicy", "SuspendBackupPolicy", "ResumeBackupPolicy", "Set", "SetTags", "Unset", "UnsetTags"))
+	}
+	if valueSet(opts.ApplyBackupPolicy) {
+		if !ValidObjectIdentifier(opts.ApplyBackupPolicy.BackupPolicy) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterBackupSetOptions.Set", "Comment"))
+		}
+	}
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterBackupSetOptions.Unset", "Comment"))
+		}
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *DropBackupSetOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowBackupSetOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	return JoinErrors(errs...)
+}
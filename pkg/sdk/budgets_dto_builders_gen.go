@@ -38,6 +38,21 @@ func (s *DropBudgetRequest) WithIfExists(ifExists bool) *DropBudgetRequest {
 	return s
 }
 
+func NewShowBudgetRequest() *ShowBudgetRequest {
+	s := ShowBudgetRequest{}
+	return &s
+}
+
+func (s *ShowBudgetRequest) WithLike(like Like) *ShowBudgetRequest {
+	s.Like = &like
+	return s
+}
+
+func (s *ShowBudgetRequest) WithIn(in In) *ShowBudgetRequest {
+	s.In = &in
+	return s
+}
+
 func NewSetSpendingLimitBudgetRequest(
 	name SchemaObjectIdentifier,
 	args BudgetSetSpendingLimitArgsRequest,
@@ -146,3 +161,65 @@ func NewGetCycleStartActionBudgetRequest(
 	s.name = name
 	return &s
 }
+
+func NewRemoveCycleStartActionBudgetRequest(
+	name SchemaObjectIdentifier,
+) *RemoveCycleStartActionBudgetRequest {
+	s := RemoveCycleStartActionBudgetRequest{}
+	s.name = name
+	return &s
+}
+
+func NewAddResourceBudgetRequest(
+	name SchemaObjectIdentifier,
+	args BudgetAddResourceArgsRequest,
+) *AddResourceBudgetRequest {
+	s := AddResourceBudgetRequest{}
+	s.name = name
+	s.args = args
+	return &s
+}
+
+func NewBudgetAddResourceArgsRequest(
+	reference BudgetResourceReferenceRequest,
+) *BudgetAddResourceArgsRequest {
+	s := BudgetAddResourceArgsRequest{}
+	s.Reference = reference
+	return &s
+}
+
+func NewBudgetResourceReferenceRequest(
+	objectType string,
+	objectName string,
+) *BudgetResourceReferenceRequest {
+	s := BudgetResourceReferenceRequest{}
+	s.ObjectType = objectType
+	s.ObjectName = objectName
+	return &s
+}
+
+func NewRemoveResourceBudgetRequest(
+	name SchemaObjectIdentifier,
+	args BudgetRemoveResourceArgsRequest,
+) *RemoveResourceBudgetRequest {
+	s := RemoveResourceBudgetRequest{}
+	s.name = name
+	s.args = args
+	return &s
+}
+
+func NewBudgetRemoveResourceArgsRequest(
+	reference BudgetResourceReferenceRequest,
+) *BudgetRemoveResourceArgsRequest {
+	s := BudgetRemoveResourceArgsRequest{}
+	s.Reference = reference
+	return &s
+}
+
+func NewGetLinkedResourcesBudgetRequest(
+	name SchemaObjectIdentifier,
+) *GetLinkedResourcesBudgetRequest {
+	s := GetLinkedResourcesBudgetRequest{}
+	s.name = name
+	return &s
+}
@@ -5,6 +5,7 @@ package sdk
 var (
 	_ optionsProvider[CreateBudgetOptions]                         = new(CreateBudgetRequest)
 	_ optionsProvider[DropBudgetOptions]                           = new(DropBudgetRequest)
+	_ optionsProvider[ShowBudgetOptions]                           = new(ShowBudgetRequest)
 	_ optionsProvider[SetSpendingLimitBudgetOptions]               = new(SetSpendingLimitBudgetRequest)
 	_ optionsProvider[GetSpendingLimitBudgetOptions]               = new(GetSpendingLimitBudgetRequest)
 	_ optionsProvider[SetEmailNotificationsBudgetOptions]          = new(SetEmailNotificationsBudgetRequest)
@@ -13,6 +14,10 @@ var (
 	_ optionsProvider[GetNotificationIntegrationNameBudgetOptions] = new(GetNotificationIntegrationNameBudgetRequest)
 	_ optionsProvider[SetCycleStartActionBudgetOptions]            = new(SetCycleStartActionBudgetRequest)
 	_ optionsProvider[GetCycleStartActionBudgetOptions]            = new(GetCycleStartActionBudgetRequest)
+	_ optionsProvider[RemoveCycleStartActionBudgetOptions]         = new(RemoveCycleStartActionBudgetRequest)
+	_ optionsProvider[AddResourceBudgetOptions]                    = new(AddResourceBudgetRequest)
+	_ optionsProvider[RemoveResourceBudgetOptions]                 = new(RemoveResourceBudgetRequest)
+	_ optionsProvider[GetLinkedResourcesBudgetOptions]             = new(GetLinkedResourcesBudgetRequest)
 )
 
 type CreateBudgetRequest struct {
@@ -27,6 +32,11 @@ type DropBudgetRequest struct {
 	name     SchemaObjectIdentifier // required
 }
 
+type ShowBudgetRequest struct {
+	Like *Like
+	In   *In
+}
+
 type SetSpendingLimitBudgetRequest struct {
 	name SchemaObjectIdentifier            // required
 	args BudgetSetSpendingLimitArgsRequest // required
@@ -79,3 +89,34 @@ type BudgetSetCycleStartActionArgsRequest struct {
 type GetCycleStartActionBudgetRequest struct {
 	name SchemaObjectIdentifier // required
 }
+
+type RemoveCycleStartActionBudgetRequest struct {
+	name SchemaObjectIdentifier // required
+}
+
+type AddResourceBudgetRequest struct {
+	name SchemaObjectIdentifier       // required
+	args BudgetAddResourceArgsRequest // required
+}
+
+type BudgetAddResourceArgsRequest struct {
+	Reference BudgetResourceReferenceRequest // required
+}
+
+type BudgetResourceReferenceRequest struct {
+	ObjectType string // required
+	ObjectName string // required
+}
+
+type RemoveResourceBudgetRequest struct {
+	name SchemaObjectIdentifier          // required
+	args BudgetRemoveResourceArgsRequest // required
+}
+
+type BudgetRemoveResourceArgsRequest struct {
+	Reference BudgetResourceReferenceRequest // required
+}
+
+type GetLinkedResourcesBudgetRequest struct {
+	name SchemaObjectIdentifier // required
+}
@@ -1,7 +1,114 @@
 package sdk
 
-import "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+// BudgetTrackedObjectTypes lists the object types that can be added to a custom budget with ADD_RESOURCE.
+var BudgetTrackedObjectTypes = []ObjectType{
+	ObjectTypeAlert,
+	ObjectTypeComputePool,
+	ObjectTypeDatabase,
+	ObjectTypeMaterializedView,
+	ObjectTypePipe,
+	ObjectTypeSchema,
+	ObjectTypeTable,
+	ObjectTypeTask,
+	ObjectTypeWarehouse,
+}
+
+func ToBudgetTrackedObjectType(s string) (ObjectType, error) {
+	objectType := ObjectType(strings.ReplaceAll(strings.ToUpper(s), "_", " "))
+	if !slices.Contains(BudgetTrackedObjectTypes, objectType) {
+		return "", fmt.Errorf("invalid budget tracked object type: %s", s)
+	}
+	return objectType, nil
+}
 
 func NewBudgetSetEmailNotificationsArgsRequestFromEmails(emails ...string) *BudgetSetEmailNotificationsArgsRequest {
 	return NewBudgetSetEmailNotificationsArgsRequest(collections.Map(emails, func(email string) BudgetEmailRequest { return BudgetEmailRequest{email} }))
 }
+
+// GetEmailNotificationRecipients returns the email addresses that receive the budget notifications.
+// It returns an empty list for the budgets without the email notifications, for which GET_NOTIFICATION_EMAIL returns NULL.
+func (v *budgets) GetEmailNotificationRecipients(ctx context.Context, id SchemaObjectIdentifier) ([]string, error) {
+	emails, err := validateAndQueryOne[sql.NullString](v.client, ctx, NewGetNotificationEmailBudgetRequest(id).toOpts())
+	if err != nil {
+		return nil, err
+	}
+	if !emails.Valid || emails.String == "" {
+		return []string{}, nil
+	}
+	return ParseCommaSeparatedStringArray(emails.String, false), nil
+}
+
+// GetEmailNotificationIntegration returns the notification integration used to send the budget notifications
+// or nil if it is not set (GET_NOTIFICATION_INTEGRATION_NAME returns NULL then).
+func (v *budgets) GetEmailNotificationIntegration(ctx context.Context, id SchemaObjectIdentifier) (*AccountObjectIdentifier, error) {
+	integrationName, err := validateAndQueryOne[sql.NullString](v.client, ctx, NewGetNotificationIntegrationNameBudgetRequest(id).toOpts())
+	if err != nil {
+		return nil, err
+	}
+	if !integrationName.Valid || integrationName.String == "" {
+		return nil, nil
+	}
+	return Pointer(NewAccountObjectIdentifier(integrationName.String)), nil
+}
+
+// NewBudgetSetCycleStartActionArgsRequestFromValues creates the SET_CYCLE_START_ACTION arguments passing the given values to the procedure as strings.
+// The procedure arguments are passed as a single array, and every value is escaped, so it is never evaluated as a SQL expression.
+func NewBudgetSetCycleStartActionArgsRequestFromValues(procedureId SchemaObjectIdentifierWithArguments, values ...string) *BudgetSetCycleStartActionArgsRequest {
+	quotedValues := collections.Map(values, func(value string) string { return SingleQuotes.Modify(value) })
+	return NewBudgetSetCycleStartActionArgsRequest(procedureId, []string{fmt.Sprintf("ARRAY_CONSTRUCT(%s)", strings.Join(quotedValues, ", "))})
+}
+
+// ArgumentValues returns the values of the procedure arguments. GET_CYCLE_START_ACTION returns them as the JSON array elements,
+// so the strings are decoded, and the other values (numbers, booleans, and nulls) are returned as they are.
+func (v *BudgetCycleStartAction) ArgumentValues() []string {
+	return collections.Map(v.ProcedureArgs, func(argument string) string {
+		var text string
+		if strings.HasPrefix(argument, `"`) && json.Unmarshal([]byte(argument), &text) == nil {
+			return text
+		}
+		return argument
+	})
+}
+
+// NewBudgetResourceReferenceRequestFromIdentifier creates the reference to the object tracked by the budget (used in ADD_RESOURCE and REMOVE_RESOURCE).
+func NewBudgetResourceReferenceRequestFromIdentifier(objectType ObjectType, id ObjectIdentifier) *BudgetResourceReferenceRequest {
+	return NewBudgetResourceReferenceRequest(objectType.String(), id.FullyQualifiedName())
+}
+
+// ObjectType returns the type of the linked resource. GET_LINKED_RESOURCES returns the multi-word domains with underscores (e.g. MATERIALIZED_VIEW).
+func (v *BudgetLinkedResource) ObjectType() (ObjectType, error) {
+	return ToBudgetTrackedObjectType(v.Domain)
+}
+
+// ObjectId returns the identifier of the linked resource with the number of parts matching its domain.
+func (v *BudgetLinkedResource) ObjectId() (ObjectIdentifier, error) {
+	objectType, err := v.ObjectType()
+	if err != nil {
+		return nil, err
+	}
+	switch objectType {
+	case ObjectTypeComputePool, ObjectTypeDatabase, ObjectTypeWarehouse:
+		return NewAccountObjectIdentifier(v.Name), nil
+	case ObjectTypeSchema:
+		if v.DatabaseName == nil {
+			return nil, fmt.Errorf("database name is missing for the linked %s %s", objectType, v.Name)
+		}
+		return NewDatabaseObjectIdentifier(*v.DatabaseName, v.Name), nil
+	default:
+		if v.DatabaseName == nil || v.SchemaName == nil {
+			return nil, fmt.Errorf("database or schema name is missing for the linked %s %s", objectType, v.Name)
+		}
+		return NewSchemaObjectIdentifier(*v.DatabaseName, *v.SchemaName, v.Name), nil
+	}
+}
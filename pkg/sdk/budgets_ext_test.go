@@ -0,0 +1,106 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToBudgetTrackedObjectType(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected ObjectType
+	}{
+		{input: "TABLE", expected: ObjectTypeTable},
+		{input: "warehouse", expected: ObjectTypeWarehouse},
+		{input: "MATERIALIZED VIEW", expected: ObjectTypeMaterializedView},
+		{input: "MATERIALIZED_VIEW", expected: ObjectTypeMaterializedView},
+		{input: "compute_pool", expected: ObjectTypeComputePool},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			objectType, err := ToBudgetTrackedObjectType(tc.input)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, objectType)
+		})
+	}
+
+	t.Run("unsupported type", func(t *testing.T) {
+		_, err := ToBudgetTrackedObjectType("USER")
+		require.ErrorContains(t, err, "invalid budget tracked object type: USER")
+	})
+}
+
+func TestBudgetLinkedResource_ObjectId(t *testing.T) {
+	t.Run("account object", func(t *testing.T) {
+		linkedResource := BudgetLinkedResource{Name: "WH", Domain: "WAREHOUSE"}
+
+		id, err := linkedResource.ObjectId()
+		require.NoError(t, err)
+		assert.Equal(t, NewAccountObjectIdentifier("WH"), id)
+	})
+
+	t.Run("schema", func(t *testing.T) {
+		linkedResource := BudgetLinkedResource{Name: "SCHEMA", Domain: "SCHEMA", DatabaseName: String("DB")}
+
+		id, err := linkedResource.ObjectId()
+		require.NoError(t, err)
+		assert.Equal(t, NewDatabaseObjectIdentifier("DB", "SCHEMA"), id)
+	})
+
+	t.Run("schema object", func(t *testing.T) {
+		linkedResource := BudgetLinkedResource{Name: "VIEW", Domain: "MATERIALIZED_VIEW", DatabaseName: String("DB"), SchemaName: String("SCHEMA")}
+
+		id, err := linkedResource.ObjectId()
+		require.NoError(t, err)
+		assert.Equal(t, NewSchemaObjectIdentifier("DB", "SCHEMA", "VIEW"), id)
+	})
+
+	t.Run("schema object without schema", func(t *testing.T) {
+		linkedResource := BudgetLinkedResource{Name: "TABLE", Domain: "TABLE", DatabaseName: String("DB")}
+
+		_, err := linkedResource.ObjectId()
+		require.ErrorContains(t, err, "database or schema name is missing for the linked TABLE TABLE")
+	})
+
+	t.Run("unsupported domain", func(t *testing.T) {
+		linkedResource := BudgetLinkedResource{Name: "USER", Domain: "USER"}
+
+		_, err := linkedResource.ObjectId()
+		require.ErrorContains(t, err, "invalid budget tracked object type: USER")
+	})
+}
+
+func TestNewBudgetSetCycleStartActionArgsRequestFromValues(t *testing.T) {
+	procedureId := NewSchemaObjectIdentifierWithArguments("DB", "SCHEMA", "PROC", DataTypeVARCHAR)
+
+	t.Run("no values", func(t *testing.T) {
+		request := NewBudgetSetCycleStartActionArgsRequestFromValues(procedureId)
+		assert.Equal(t, []string{"ARRAY_CONSTRUCT()"}, request.Arguments)
+	})
+
+	t.Run("values escaped", func(t *testing.T) {
+		request := NewBudgetSetCycleStartActionArgsRequestFromValues(procedureId, "abc", "it's", "CURRENT_DATE()", "'); DROP TABLE T; --")
+		assert.Equal(t, []string{`ARRAY_CONSTRUCT('abc', 'it\'s', 'CURRENT_DATE()', '\'); DROP TABLE T; --')`}, request.Arguments)
+	})
+}
+
+func TestBudgetCycleStartAction_ArgumentValues(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    []string
+		expected []string
+	}{
+		{name: "no arguments", input: []string{}, expected: []string{}},
+		{name: "string", input: []string{`"abc"`}, expected: []string{`abc`}},
+		{name: "string with quotes", input: []string{`"it's \"quoted\""`}, expected: []string{`it's "quoted"`}},
+		{name: "number, boolean and null", input: []string{`1`, `2.5`, `true`, `null`}, expected: []string{`1`, `2.5`, `true`, `null`}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			action := BudgetCycleStartAction{ProcedureArgs: tc.input}
+			assert.Equal(t, tc.expected, action.ArgumentValues())
+		})
+	}
+}
@@ -4,6 +4,7 @@ package sdk
 
 import (
 	"context"
+	"database/sql"
 	"time"
 )
 
@@ -11,6 +12,9 @@ type Budgets interface {
 	Create(ctx context.Context, request *CreateBudgetRequest) error
 	Drop(ctx context.Context, request *DropBudgetRequest) error
 	DropSafely(ctx context.Context, id SchemaObjectIdentifier) error
+	Show(ctx context.Context, request *ShowBudgetRequest) ([]Budget, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Budget, error)
+	ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*Budget, error)
 	SetSpendingLimit(ctx context.Context, request *SetSpendingLimitBudgetRequest) (*string, error)
 	GetSpendingLimit(ctx context.Context, request *GetSpendingLimitBudgetRequest) (*int, error)
 	SetEmailNotifications(ctx context.Context, request *SetEmailNotificationsBudgetRequest) (*string, error)
@@ -19,6 +23,12 @@ type Budgets interface {
 	GetNotificationIntegrationName(ctx context.Context, request *GetNotificationIntegrationNameBudgetRequest) (*string, error)
 	SetCycleStartAction(ctx context.Context, request *SetCycleStartActionBudgetRequest) (*string, error)
 	GetCycleStartAction(ctx context.Context, request *GetCycleStartActionBudgetRequest) (*BudgetCycleStartAction, error)
+	RemoveCycleStartAction(ctx context.Context, request *RemoveCycleStartActionBudgetRequest) (*string, error)
+	AddResource(ctx context.Context, request *AddResourceBudgetRequest) (*string, error)
+	RemoveResource(ctx context.Context, request *RemoveResourceBudgetRequest) (*string, error)
+	GetLinkedResources(ctx context.Context, request *GetLinkedResourcesBudgetRequest) ([]BudgetLinkedResource, error)
+	GetEmailNotificationRecipients(ctx context.Context, id SchemaObjectIdentifier) ([]string, error)
+	GetEmailNotificationIntegration(ctx context.Context, id SchemaObjectIdentifier) (*AccountObjectIdentifier, error)
 }
 
 // CreateBudgetOptions is based on https://docs.snowflake.com/en/sql-reference/classes/budget/commands/create-budget.
@@ -40,6 +50,44 @@ type DropBudgetOptions struct {
 	name                SchemaObjectIdentifier `ddl:"identifier"`
 }
 
+// ShowBudgetOptions is based on https://docs.snowflake.com/en/sql-reference/classes/budget/commands/show-budget.
+type ShowBudgetOptions struct {
+	show                         bool  `ddl:"static" sql:"SHOW"`
+	snowflakeCoreBudgetInstances bool  `ddl:"static" sql:"SNOWFLAKE.CORE.BUDGET INSTANCES"`
+	Like                         *Like `ddl:"keyword" sql:"LIKE"`
+	In                           *In   `ddl:"keyword" sql:"IN"`
+}
+
+type budgetRow struct {
+	CreatedOn      time.Time      `db:"created_on"`
+	Name           string         `db:"name"`
+	DatabaseName   string         `db:"database_name"`
+	SchemaName     string         `db:"schema_name"`
+	CurrentVersion string         `db:"current_version"`
+	Comment        sql.NullString `db:"comment"`
+	Owner          string         `db:"owner"`
+	OwnerRoleType  string         `db:"owner_role_type"`
+}
+
+type Budget struct {
+	CreatedOn      time.Time
+	Name           string
+	DatabaseName   string
+	SchemaName     string
+	CurrentVersion string
+	Comment        *string
+	Owner          string
+	OwnerRoleType  string
+}
+
+func (v *Budget) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *Budget) ObjectType() ObjectType {
+	return ObjectTypeBudget
+}
+
 // SetSpendingLimitBudgetOptions is based on https://docs.snowflake.com/en/sql-reference/classes/budget/method/set_spending_limit.
 type SetSpendingLimitBudgetOptions struct {
 	call bool                       `ddl:"static" sql:"CALL"`
@@ -151,3 +199,66 @@ type BudgetCycleStartAction struct {
 }
 
 type budgetGetCycleStartActionArgs struct{}
+
+// RemoveCycleStartActionBudgetOptions is based on https://docs.snowflake.com/en/sql-reference/classes/budget/methods/remove_cycle_start_action.
+type RemoveCycleStartActionBudgetOptions struct {
+	call bool                             `ddl:"static" sql:"CALL"`
+	name SchemaObjectIdentifier           `ddl:"identifier,instance_method" sql:"REMOVE_CYCLE_START_ACTION"`
+	args budgetRemoveCycleStartActionArgs `ddl:"list,must_parentheses"`
+}
+
+type budgetRemoveCycleStartActionArgs struct{}
+
+// AddResourceBudgetOptions is based on https://docs.snowflake.com/en/sql-reference/classes/budget/methods/add_resource.
+type AddResourceBudgetOptions struct {
+	call bool                   `ddl:"static" sql:"CALL"`
+	name SchemaObjectIdentifier `ddl:"identifier,instance_method" sql:"ADD_RESOURCE"`
+	args BudgetAddResourceArgs  `ddl:"list,must_parentheses"`
+}
+
+type BudgetAddResourceArgs struct {
+	Reference BudgetResourceReference `ddl:"list,must_parentheses" sql:"SYSTEM$REFERENCE"`
+}
+
+type BudgetResourceReference struct {
+	ObjectType  string `ddl:"keyword,single_quotes"`
+	ObjectName  string `ddl:"keyword,single_quotes"`
+	session     bool   `ddl:"static" sql:"'SESSION'"`
+	applyBudget bool   `ddl:"static" sql:"'APPLYBUDGET'"`
+}
+
+// RemoveResourceBudgetOptions is based on https://docs.snowflake.com/en/sql-reference/classes/budget/methods/remove_resource.
+type RemoveResourceBudgetOptions struct {
+	call bool                     `ddl:"static" sql:"CALL"`
+	name SchemaObjectIdentifier   `ddl:"identifier,instance_method" sql:"REMOVE_RESOURCE"`
+	args BudgetRemoveResourceArgs `ddl:"list,must_parentheses"`
+}
+
+type BudgetRemoveResourceArgs struct {
+	Reference BudgetResourceReference `ddl:"list,must_parentheses" sql:"SYSTEM$REFERENCE"`
+}
+
+// GetLinkedResourcesBudgetOptions is based on https://docs.snowflake.com/en/sql-reference/classes/budget/methods/get_linked_resources.
+type GetLinkedResourcesBudgetOptions struct {
+	call bool                         `ddl:"static" sql:"CALL"`
+	name SchemaObjectIdentifier       `ddl:"identifier,instance_method" sql:"GET_LINKED_RESOURCES"`
+	args budgetGetLinkedResourcesArgs `ddl:"list,must_parentheses"`
+}
+
+type getLinkedResourcesRow struct {
+	ResourceId   int            `db:"resource_id"`
+	Name         string         `db:"name"`
+	Domain       string         `db:"domain"`
+	SchemaName   sql.NullString `db:"schema_name"`
+	DatabaseName sql.NullString `db:"database_name"`
+}
+
+type BudgetLinkedResource struct {
+	ResourceId   int
+	Name         string
+	Domain       string
+	SchemaName   *string
+	DatabaseName *string
+}
+
+type budgetGetLinkedResourcesArgs struct{}
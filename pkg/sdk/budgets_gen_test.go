@@ -85,6 +85,32 @@ func TestBudgets_Drop(t *testing.T) {
 	})
 }
 
+func TestBudgets_Show(t *testing.T) {
+	// Minimal valid ShowBudgetOptions
+	defaultOpts := func() *ShowBudgetOptions {
+		return &ShowBudgetOptions{}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		opts := (*ShowBudgetOptions)(nil)
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, "SHOW SNOWFLAKE.CORE.BUDGET INSTANCES")
+	})
+
+	t.Run("all options", func(t *testing.T) {
+		opts := defaultOpts()
+		// manually adjusted
+		schemaId := randomDatabaseObjectIdentifier()
+		opts.Like = &Like{Pattern: String("pattern")}
+		opts.In = &In{Schema: schemaId}
+		assertOptsValidAndSQLEquals(t, opts, "SHOW SNOWFLAKE.CORE.BUDGET INSTANCES LIKE 'pattern' IN SCHEMA %s", schemaId.FullyQualifiedName())
+	})
+}
+
 func TestBudgets_SetSpendingLimit(t *testing.T) {
 	id := randomSchemaObjectIdentifier()
 	// Minimal valid SetSpendingLimitBudgetOptions
@@ -344,3 +370,131 @@ func TestBudgets_GetCycleStartAction(t *testing.T) {
 
 	// all options removed manually
 }
+
+func TestBudgets_RemoveCycleStartAction(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	// Minimal valid RemoveCycleStartActionBudgetOptions
+	defaultOpts := func() *RemoveCycleStartActionBudgetOptions {
+		return &RemoveCycleStartActionBudgetOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		opts := (*RemoveCycleStartActionBudgetOptions)(nil)
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		// manually adjusted
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		// manually adjusted
+		assertOptsValidAndSQLEquals(t, opts, "CALL %s!REMOVE_CYCLE_START_ACTION ()", id.FullyQualifiedName())
+	})
+
+	// all options removed manually
+}
+
+func TestBudgets_AddResource(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	tableId := randomSchemaObjectIdentifier()
+	// Minimal valid AddResourceBudgetOptions
+	defaultOpts := func() *AddResourceBudgetOptions {
+		return &AddResourceBudgetOptions{
+			name: id,
+			args: BudgetAddResourceArgs{
+				Reference: BudgetResourceReference{ObjectType: "TABLE", ObjectName: tableId.FullyQualifiedName()},
+			},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		opts := (*AddResourceBudgetOptions)(nil)
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		// manually adjusted
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		// manually adjusted
+		assertOptsValidAndSQLEquals(t, opts, "CALL %s!ADD_RESOURCE (SYSTEM$REFERENCE ('TABLE', '\\\"%s\\\".\\\"%s\\\".\\\"%s\\\"', 'SESSION', 'APPLYBUDGET'))", id.FullyQualifiedName(), tableId.DatabaseName(), tableId.SchemaName(), tableId.Name())
+	})
+
+	// all options removed manually
+}
+
+func TestBudgets_RemoveResource(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	tableId := randomSchemaObjectIdentifier()
+	// Minimal valid RemoveResourceBudgetOptions
+	defaultOpts := func() *RemoveResourceBudgetOptions {
+		return &RemoveResourceBudgetOptions{
+			name: id,
+			args: BudgetRemoveResourceArgs{
+				Reference: BudgetResourceReference{ObjectType: "TABLE", ObjectName: tableId.FullyQualifiedName()},
+			},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		opts := (*RemoveResourceBudgetOptions)(nil)
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		// manually adjusted
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		// manually adjusted
+		assertOptsValidAndSQLEquals(t, opts, "CALL %s!REMOVE_RESOURCE (SYSTEM$REFERENCE ('TABLE', '\\\"%s\\\".\\\"%s\\\".\\\"%s\\\"', 'SESSION', 'APPLYBUDGET'))", id.FullyQualifiedName(), tableId.DatabaseName(), tableId.SchemaName(), tableId.Name())
+	})
+
+	// all options removed manually
+}
+
+func TestBudgets_GetLinkedResources(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	// Minimal valid GetLinkedResourcesBudgetOptions
+	defaultOpts := func() *GetLinkedResourcesBudgetOptions {
+		return &GetLinkedResourcesBudgetOptions{
+			name: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		opts := (*GetLinkedResourcesBudgetOptions)(nil)
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.name]", func(t *testing.T) {
+		opts := defaultOpts()
+		// manually adjusted
+		opts.name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("basic", func(t *testing.T) {
+		opts := defaultOpts()
+		// manually adjusted
+		assertOptsValidAndSQLEquals(t, opts, "CALL %s!GET_LINKED_RESOURCES ()", id.FullyQualifiedName())
+	})
+
+	// all options removed manually
+}
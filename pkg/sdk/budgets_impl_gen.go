@@ -4,12 +4,16 @@ package sdk
 
 import (
 	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
 )
 
 var (
 	_ Budgets                                       = (*budgets)(nil)
+	_ convertibleRow[Budget]                        = new(budgetRow)
 	_ convertibleRow[BudgetNotificationIntegration] = new(getNotificationIntegrationsRow)
 	_ convertibleRow[BudgetCycleStartAction]        = new(getCycleStartActionRow)
+	_ convertibleRow[BudgetLinkedResource]          = new(getLinkedResourcesRow)
 )
 
 type budgets struct {
@@ -30,6 +34,30 @@ func (v *budgets) DropSafely(ctx context.Context, id SchemaObjectIdentifier) err
 	return SafeDrop(v.client, func() error { return v.Drop(ctx, NewDropBudgetRequest(id).WithIfExists(true)) }, ctx, id)
 }
 
+func (v *budgets) Show(ctx context.Context, request *ShowBudgetRequest) ([]Budget, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[budgetRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[budgetRow, Budget](dbRows)
+}
+
+func (v *budgets) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Budget, error) {
+	request := NewShowBudgetRequest().
+		WithIn(In{Schema: id.SchemaId()}).
+		WithLike(Like{Pattern: String(id.Name())})
+	budgets, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(budgets, func(r Budget) bool { return r.Name == id.Name() })
+}
+
+func (v *budgets) ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*Budget, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
 func (v *budgets) SetSpendingLimit(ctx context.Context, request *SetSpendingLimitBudgetRequest) (*string, error) {
 	return validateAndQueryOne[string](v.client, ctx, request.toOpts())
 }
@@ -72,6 +100,27 @@ func (v *budgets) GetCycleStartAction(ctx context.Context, request *GetCycleStar
 	return conversionErrorWrapped(result.convert())
 }
 
+func (v *budgets) RemoveCycleStartAction(ctx context.Context, request *RemoveCycleStartActionBudgetRequest) (*string, error) {
+	return validateAndQueryOne[string](v.client, ctx, request.toOpts())
+}
+
+func (v *budgets) AddResource(ctx context.Context, request *AddResourceBudgetRequest) (*string, error) {
+	return validateAndQueryOne[string](v.client, ctx, request.toOpts())
+}
+
+func (v *budgets) RemoveResource(ctx context.Context, request *RemoveResourceBudgetRequest) (*string, error) {
+	return validateAndQueryOne[string](v.client, ctx, request.toOpts())
+}
+
+func (v *budgets) GetLinkedResources(ctx context.Context, request *GetLinkedResourcesBudgetRequest) ([]BudgetLinkedResource, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[getLinkedResourcesRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[getLinkedResourcesRow, BudgetLinkedResource](dbRows)
+}
+
 func (r *CreateBudgetRequest) toOpts() *CreateBudgetOptions {
 	opts := &CreateBudgetOptions{
 		OrReplace:   r.OrReplace,
@@ -90,6 +139,28 @@ func (r *DropBudgetRequest) toOpts() *DropBudgetOptions {
 	return opts
 }
 
+func (r *ShowBudgetRequest) toOpts() *ShowBudgetOptions {
+	opts := &ShowBudgetOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
+	return opts
+}
+
+func (r budgetRow) convert() (*Budget, error) {
+	result := &Budget{
+		CreatedOn:      r.CreatedOn,
+		Name:           r.Name,
+		DatabaseName:   r.DatabaseName,
+		SchemaName:     r.SchemaName,
+		CurrentVersion: r.CurrentVersion,
+		Owner:          r.Owner,
+		OwnerRoleType:  r.OwnerRoleType,
+	}
+	mapNullString(&result.Comment, r.Comment)
+	return result, nil
+}
+
 func (r *SetSpendingLimitBudgetRequest) toOpts() *SetSpendingLimitBudgetOptions {
 	opts := &SetSpendingLimitBudgetOptions{
 		name: r.name,
@@ -184,3 +255,52 @@ func (r getCycleStartActionRow) convert() (*BudgetCycleStartAction, error) {
 	result.ProcedureArgs = ParseCommaSeparatedStringArray(r.ProcedureArgs, false)
 	return result, nil
 }
+
+func (r *RemoveCycleStartActionBudgetRequest) toOpts() *RemoveCycleStartActionBudgetOptions {
+	opts := &RemoveCycleStartActionBudgetOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r *AddResourceBudgetRequest) toOpts() *AddResourceBudgetOptions {
+	opts := &AddResourceBudgetOptions{
+		name: r.name,
+	}
+	opts.args = BudgetAddResourceArgs{}
+	opts.args.Reference = BudgetResourceReference{
+		ObjectType: r.args.Reference.ObjectType,
+		ObjectName: r.args.Reference.ObjectName,
+	}
+	return opts
+}
+
+func (r *RemoveResourceBudgetRequest) toOpts() *RemoveResourceBudgetOptions {
+	opts := &RemoveResourceBudgetOptions{
+		name: r.name,
+	}
+	opts.args = BudgetRemoveResourceArgs{}
+	opts.args.Reference = BudgetResourceReference{
+		ObjectType: r.args.Reference.ObjectType,
+		ObjectName: r.args.Reference.ObjectName,
+	}
+	return opts
+}
+
+func (r *GetLinkedResourcesBudgetRequest) toOpts() *GetLinkedResourcesBudgetOptions {
+	opts := &GetLinkedResourcesBudgetOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r getLinkedResourcesRow) convert() (*BudgetLinkedResource, error) {
+	result := &BudgetLinkedResource{
+		ResourceId: r.ResourceId,
+		Name:       r.Name,
+		Domain:     r.Domain,
+	}
+	mapNullString(&result.SchemaName, r.SchemaName)
+	mapNullString(&result.DatabaseName, r.DatabaseName)
+	return result, nil
+}
@@ -5,6 +5,7 @@ package sdk
 var (
 	_ validatable = new(CreateBudgetOptions)
 	_ validatable = new(DropBudgetOptions)
+	_ validatable = new(ShowBudgetOptions)
 	_ validatable = new(SetSpendingLimitBudgetOptions)
 	_ validatable = new(GetSpendingLimitBudgetOptions)
 	_ validatable = new(SetEmailNotificationsBudgetOptions)
@@ -13,6 +14,10 @@ var (
 	_ validatable = new(GetNotificationIntegrationNameBudgetOptions)
 	_ validatable = new(SetCycleStartActionBudgetOptions)
 	_ validatable = new(GetCycleStartActionBudgetOptions)
+	_ validatable = new(RemoveCycleStartActionBudgetOptions)
+	_ validatable = new(AddResourceBudgetOptions)
+	_ validatable = new(RemoveResourceBudgetOptions)
+	_ validatable = new(GetLinkedResourcesBudgetOptions)
 )
 
 func (opts *CreateBudgetOptions) validate() error {
@@ -40,6 +45,14 @@ func (opts *DropBudgetOptions) validate() error {
 	return JoinErrors(errs...)
 }
 
+func (opts *ShowBudgetOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	return JoinErrors(errs...)
+}
+
 func (opts *SetSpendingLimitBudgetOptions) validate() error {
 	if opts == nil {
 		return ErrNilOptions
@@ -127,3 +140,47 @@ func (opts *GetCycleStartActionBudgetOptions) validate() error {
 	}
 	return JoinErrors(errs...)
 }
+
+func (opts *RemoveCycleStartActionBudgetOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *AddResourceBudgetOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *RemoveResourceBudgetOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *GetLinkedResourcesBudgetOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
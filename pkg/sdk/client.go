@@ -3,8 +3,12 @@ package sdk
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"fmt"
+	"io"
 	"log"
+	"reflect"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
 	"github.com/jmoiron/sqlx"
@@ -16,6 +20,11 @@ type Client struct {
 	db             *sqlx.DB
 	sessionID      string
 	accountLocator string
+	retryPolicy    RetryPolicy
+	queryTag       QueryTagOptions
+
+	statementRecorder StatementRecorder
+	dryRun            bool
 
 	// System-Defined Functions
 	ContextFunctions     ContextFunctions
@@ -24,6 +33,7 @@ type Client struct {
 
 	// DDL Commands
 	Accounts                     Accounts
+	AggregationPolicies          AggregationPolicies
 	Alerts                       Alerts
 	ApiIntegrations              ApiIntegrations
 	ApplicationPackages          ApplicationPackages
@@ -31,10 +41,12 @@ type Client struct {
 	Applications                 Applications
 	AuthenticationPolicies       AuthenticationPolicies
 	BackupPolicies               BackupPolicies
+	BackupSets                   BackupSets
 	Budgets                      Budgets
 	CatalogIntegrations          CatalogIntegrations
 	ComputePools                 ComputePools
 	Connections                  Connections
+	Contacts                     Contacts
 	CortexAgents                 CortexAgents
 	CortexSearchServices         CortexSearchServices
 	DatabaseRoles                DatabaseRoles
@@ -55,6 +67,7 @@ type Client struct {
 	HybridTables                 HybridTables
 	IcebergTables                IcebergTables
 	ImageRepositories            ImageRepositories
+	JoinPolicies                 JoinPolicies
 	Listings                     Listings
 	ManagedAccounts              ManagedAccounts
 	MaskingPolicies              MaskingPolicies
@@ -74,6 +87,7 @@ type Client struct {
 	PolicyReferences             PolicyReferences
 	PostgresInstances            PostgresInstances
 	Procedures                   Procedures
+	ProjectionPolicies           ProjectionPolicies
 	ResourceMonitors             ResourceMonitors
 	Roles                        Roles
 	RowAccessPolicies            RowAccessPolicies
@@ -114,12 +128,37 @@ func (c *Client) GetConn() *sqlx.DB {
 	return c.db
 }
 
+// QueryTagOptions configures the QUERY_TAG set on every statement run by the client.
+type QueryTagOptions struct {
+	// QueryTag is the base query tag (it is also set as the session's QUERY_TAG parameter by the provider).
+	QueryTag string
+	// Workspace is the name of the Terraform workspace included in the query tag metadata.
+	Workspace string
+	// IncludeMetadata enables the query tag built from the tracking metadata (resource or data source, and operation) for the statements run with it in the context.
+	IncludeMetadata bool
+}
+
+// SetQueryTagOptions sets the query tag configuration used for the statements run by the client.
+func (c *Client) SetQueryTagOptions(options QueryTagOptions) {
+	c.queryTag = options
+}
+
+// SetRetryPolicy sets the policy used to retry the failed statements. Nil disables the retries.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	c.retryPolicy = policy
+}
+
 func NewDefaultClient(opts ...func(*FileReaderConfig)) (*Client, error) {
 	return NewClient(nil, opts...)
 }
 
 func NewClient(cfg *gosnowflake.Config, opts ...func(*FileReaderConfig)) (*Client, error) {
-	var err error
+	return NewClientWithRetryPolicy(cfg, NoRetryPolicy, opts...)
+}
+
+// NewClientWithRetryPolicy creates the client using the given policy for all its statements.
+// The policy is also used while connecting, so that, e.g., the HTTP 429 responses from the login endpoint are retried.
+func NewClientWithRetryPolicy(cfg *gosnowflake.Config, retryPolicy RetryPolicy, opts ...func(*FileReaderConfig)) (*Client, error) {
 	if cfg == nil {
 		log.Printf("[DEBUG] Searching for default config in credentials chain...")
 		cfg = DefaultConfig(opts...)
@@ -135,23 +174,28 @@ func NewClient(cfg *gosnowflake.Config, opts ...func(*FileReaderConfig)) (*Clien
 		return nil, err
 	}
 
-	db, err := sqlx.Connect("snowflake", dsn)
+	db, err := sqlx.Open("snowflake", dsn)
 	if err != nil {
 		return nil, fmt.Errorf("open snowflake connection: %w", err)
 	}
 
+	return connect(context.Background(), db, cfg, retryPolicy)
+}
+
+// connect logs in (with the first ping) and fetches the session details, retrying the failures according to the retryPolicy.
+func connect(ctx context.Context, db *sqlx.DB, cfg *gosnowflake.Config, retryPolicy RetryPolicy) (*Client, error) {
 	client := &Client{
 		// snowflake does not adhere to the normal sql driver interface, so we have to use unsafe
-		db:     db.Unsafe(),
-		config: cfg,
+		db:          db.Unsafe(),
+		config:      cfg,
+		retryPolicy: retryPolicy,
 	}
 	client.initialize()
 
-	err = client.Ping()
-	if err != nil {
+	if err := withRetries(ctx, retryPolicy, client.Ping); err != nil {
+		_ = db.Close()
 		return nil, fmt.Errorf("ping snowflake: %w", err)
 	}
-	ctx := context.Background()
 	currentAccount, err := client.ContextFunctions.CurrentAccount(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("get current account: %w", err)
@@ -170,6 +214,7 @@ func NewClient(cfg *gosnowflake.Config, opts ...func(*FileReaderConfig)) (*Clien
 
 func (c *Client) initialize() {
 	c.Accounts = &accounts{client: c}
+	c.AggregationPolicies = &aggregationPolicies{client: c}
 	c.Alerts = &alerts{client: c}
 	c.ApiIntegrations = &apiIntegrations{client: c}
 	c.ApplicationPackages = &applicationPackages{client: c}
@@ -177,10 +222,12 @@ func (c *Client) initialize() {
 	c.Applications = &applications{client: c}
 	c.AuthenticationPolicies = &authenticationPolicies{client: c}
 	c.BackupPolicies = &backupPolicies{client: c}
+	c.BackupSets = &backupSets{client: c}
 	c.Budgets = &budgets{client: c}
 	c.CatalogIntegrations = &catalogIntegrations{client: c}
 	c.ComputePools = &computePools{client: c}
 	c.Connections = &connections{client: c}
+	c.Contacts = &contacts{client: c}
 	c.ContextFunctions = &contextFunctions{client: c}
 	c.CortexAgents = &cortexAgents{client: c}
 	c.CortexSearchServices = &cortexSearchServices{client: c}
@@ -202,6 +249,7 @@ func (c *Client) initialize() {
 	c.HybridTables = &hybridTables{client: c}
 	c.IcebergTables = &icebergTables{client: c}
 	c.ImageRepositories = &imageRepositories{client: c}
+	c.JoinPolicies = &joinPolicies{client: c}
 	c.Listings = &listings{client: c}
 	c.ManagedAccounts = &managedAccounts{client: c}
 	c.MaskingPolicies = &maskingPolicies{client: c}
@@ -221,6 +269,7 @@ func (c *Client) initialize() {
 	c.PolicyReferences = &policyReference{client: c}
 	c.PostgresInstances = &postgresInstances{client: c}
 	c.Procedures = &procedures{client: c}
+	c.ProjectionPolicies = &projectionPolicies{client: c}
 	c.ReplicationFunctions = &replicationFunctions{client: c}
 	c.ResourceMonitors = &resourceMonitors{client: c}
 	c.Roles = &roles{client: c}
@@ -256,10 +305,14 @@ func (c *Client) Ping() error {
 }
 
 func (c *Client) Close() error {
+	var errs []error
+	if closer, ok := c.statementRecorder.(io.Closer); ok {
+		errs = append(errs, closer.Close())
+	}
 	if c.db != nil {
-		return c.db.Close()
+		errs = append(errs, c.db.Close())
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 type accountLocatorContextKey struct{}
@@ -267,25 +320,69 @@ type accountLocatorContextKey struct{}
 var snowflakeAccountLocatorContextKey accountLocatorContextKey
 
 // Exec executes a query that does not return rows.
-func (c *Client) exec(ctx context.Context, sql string) (sql.Result, error) {
+func (c *Client) exec(ctx context.Context, statement string) (sql.Result, error) {
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
-	sql = appendQueryMetadata(ctx, sql)
-	result, err := c.db.ExecContext(ctx, sql)
-	return result, decodeDriverError(err)
+	ctx = c.withQueryTag(ctx)
+	c.recordStatement(ctx, statement)
+	if c.dryRun {
+		return driver.RowsAffected(0), nil
+	}
+	retryPolicy := execRetryPolicy{policy: c.retryPolicy, statement: statement}
+	statement = appendQueryMetadata(ctx, statement)
+	var result sql.Result
+	err := withRetries(ctx, retryPolicy, func() error {
+		var err error
+		result, err = c.db.ExecContext(ctx, statement)
+		return decodeDriverError(err)
+	})
+	return result, err
 }
 
 // query runs a query and returns the rows. dest is expected to be a slice of structs.
 func (c *Client) query(ctx context.Context, dest any, sql string) error {
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
+	ctx = c.withQueryTag(ctx)
+	c.recordStatement(ctx, sql)
 	sql = appendQueryMetadata(ctx, sql)
-	return decodeDriverError(c.db.SelectContext(ctx, dest, sql))
+	return withRetries(ctx, c.retryPolicy, func() error {
+		err := c.db.SelectContext(ctx, dest, sql)
+		if err != nil {
+			// the rows scanned before the failure cannot be appended again by the next attempt
+			if v := reflect.ValueOf(dest); v.Kind() == reflect.Pointer && v.Elem().Kind() == reflect.Slice {
+				v.Elem().SetLen(0)
+			}
+		}
+		return decodeDriverError(err)
+	})
 }
 
 // queryOne runs a query and returns one row. dest is expected to be a pointer to a struct.
 func (c *Client) queryOne(ctx context.Context, dest any, sql string) error {
 	ctx = context.WithValue(ctx, snowflakeAccountLocatorContextKey, c.accountLocator)
+	ctx = c.withQueryTag(ctx)
+	c.recordStatement(ctx, sql)
 	sql = appendQueryMetadata(ctx, sql)
-	return decodeDriverError(c.db.GetContext(ctx, dest, sql))
+	return withRetries(ctx, c.retryPolicy, func() error {
+		return decodeDriverError(c.db.GetContext(ctx, dest, sql))
+	})
+}
+
+// withQueryTag sets the query tag built from the tracking metadata for the statement run with the returned context.
+// Without the metadata in the context, the statement is tagged with the session's QUERY_TAG.
+func (c *Client) withQueryTag(ctx context.Context) context.Context {
+	if !c.queryTag.IncludeMetadata {
+		return ctx
+	}
+	metadata, ok := tracking.FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	queryTag, err := tracking.NewQueryTag(c.queryTag.QueryTag, c.queryTag.Workspace, metadata).Render()
+	if err != nil {
+		log.Printf("[ERROR] failed to build the query tag, the statement is tagged with the session's QUERY_TAG: %v", err)
+		return ctx
+	}
+	return gosnowflake.WithQueryTag(ctx, queryTag)
 }
 
 func appendQueryMetadata(ctx context.Context, sql string) string {
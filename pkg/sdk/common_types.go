@@ -349,6 +349,22 @@ var (
 	DistributionExternal Distribution = "EXTERNAL"
 )
 
+func ToDistribution(value string) (Distribution, error) {
+	switch strings.ToUpper(value) {
+	case string(DistributionInternal):
+		return DistributionInternal, nil
+	case string(DistributionExternal):
+		return DistributionExternal, nil
+	default:
+		return "", fmt.Errorf("unknown distribution: %s", value)
+	}
+}
+
+var AllDistributions = []Distribution{
+	DistributionInternal,
+	DistributionExternal,
+}
+
 type LogLevel string
 
 const (
@@ -227,6 +227,38 @@ func TestToStorageSerializationPolicy(t *testing.T) {
 	}
 }
 
+func TestToDistribution(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected Distribution
+		Error    string
+	}{
+		{Input: string(DistributionInternal), Expected: DistributionInternal},
+		{Input: string(DistributionExternal), Expected: DistributionExternal},
+		{Name: "validation: incorrect distribution", Input: "incorrect", Error: "unknown distribution: incorrect"},
+		{Name: "validation: empty input", Input: "", Error: "unknown distribution: "},
+		{Name: "validation: lower case input", Input: "external", Expected: DistributionExternal},
+	}
+
+	for _, testCase := range testCases {
+		name := testCase.Name
+		if name == "" {
+			name = fmt.Sprintf("%v distribution", testCase.Input)
+		}
+		t.Run(name, func(t *testing.T) {
+			value, err := ToDistribution(testCase.Input)
+			if testCase.Error != "" {
+				assert.Empty(t, value)
+				assert.ErrorContains(t, err, testCase.Error)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, testCase.Expected, value)
+			}
+		})
+	}
+}
+
 func TestToLogLevel(t *testing.T) {
 	testCases := []struct {
 		Name     string
@@ -0,0 +1,186 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+func NewCreateContactRequest(
+	name SchemaObjectIdentifier,
+) *CreateContactRequest {
+	s := CreateContactRequest{}
+	s.name = name
+	return &s
+}
+
+func (s *CreateContactRequest) WithOrReplace(orReplace bool) *CreateContactRequest {
+	s.OrReplace = &orReplace
+	return s
+}
+
+func (s *CreateContactRequest) WithIfNotExists(ifNotExists bool) *CreateContactRequest {
+	s.IfNotExists = &ifNotExists
+	return s
+}
+
+func (s *CreateContactRequest) WithUsers(users []ContactUser) *CreateContactRequest {
+	s.Users = users
+	return s
+}
+
+func (s *CreateContactRequest) WithEmailDistributionList(emailDistributionList string) *CreateContactRequest {
+	s.EmailDistributionList = &emailDistributionList
+	return s
+}
+
+func (s *CreateContactRequest) WithUrl(url string) *CreateContactRequest {
+	s.Url = &url
+	return s
+}
+
+func (s *CreateContactRequest) WithComment(comment string) *CreateContactRequest {
+	s.Comment = &comment
+	return s
+}
+
+func NewAlterContactRequest(
+	name SchemaObjectIdentifier,
+) *AlterContactRequest {
+	s := AlterContactRequest{}
+	s.name = name
+	return &s
+}
+
+func (s *AlterContactRequest) WithIfExists(ifExists bool) *AlterContactRequest {
+	s.IfExists = &ifExists
+	return s
+}
+
+func (s *AlterContactRequest) WithSet(set ContactSetRequest) *AlterContactRequest {
+	s.Set = &set
+	return s
+}
+
+func (s *AlterContactRequest) WithUnsetComment(unsetComment bool) *AlterContactRequest {
+	s.UnsetComment = &unsetComment
+	return s
+}
+
+func (s *AlterContactRequest) WithRenameTo(renameTo SchemaObjectIdentifier) *AlterContactRequest {
+	s.RenameTo = &renameTo
+	return s
+}
+
+func NewContactSetRequest() *ContactSetRequest {
+	s := ContactSetRequest{}
+	return &s
+}
+
+func (s *ContactSetRequest) WithUsers(users []ContactUser) *ContactSetRequest {
+	s.Users = users
+	return s
+}
+
+func (s *ContactSetRequest) WithEmailDistributionList(emailDistributionList string) *ContactSetRequest {
+	s.EmailDistributionList = &emailDistributionList
+	return s
+}
+
+func (s *ContactSetRequest) WithUrl(url string) *ContactSetRequest {
+	s.Url = &url
+	return s
+}
+
+func (s *ContactSetRequest) WithComment(comment string) *ContactSetRequest {
+	s.Comment = &comment
+	return s
+}
+
+func NewDropContactRequest(
+	name SchemaObjectIdentifier,
+) *DropContactRequest {
+	s := DropContactRequest{}
+	s.name = name
+	return &s
+}
+
+func (s *DropContactRequest) WithIfExists(ifExists bool) *DropContactRequest {
+	s.IfExists = &ifExists
+	return s
+}
+
+func NewShowContactRequest() *ShowContactRequest {
+	s := ShowContactRequest{}
+	return &s
+}
+
+func (s *ShowContactRequest) WithLike(like Like) *ShowContactRequest {
+	s.Like = &like
+	return s
+}
+
+func (s *ShowContactRequest) WithIn(in In) *ShowContactRequest {
+	s.In = &in
+	return s
+}
+
+func NewSetOnObjectContactRequest(
+	objectType ObjectType,
+	objectName ObjectIdentifier,
+	set ContactsOnObjectSetRequest,
+) *SetOnObjectContactRequest {
+	s := SetOnObjectContactRequest{}
+	s.objectType = objectType
+	s.objectName = objectName
+	s.Set = set
+	return &s
+}
+
+func NewContactsOnObjectSetRequest() *ContactsOnObjectSetRequest {
+	s := ContactsOnObjectSetRequest{}
+	return &s
+}
+
+func (s *ContactsOnObjectSetRequest) WithSteward(steward SchemaObjectIdentifier) *ContactsOnObjectSetRequest {
+	s.Steward = &steward
+	return s
+}
+
+func (s *ContactsOnObjectSetRequest) WithSupport(support SchemaObjectIdentifier) *ContactsOnObjectSetRequest {
+	s.Support = &support
+	return s
+}
+
+func (s *ContactsOnObjectSetRequest) WithAccessApproval(accessApproval SchemaObjectIdentifier) *ContactsOnObjectSetRequest {
+	s.AccessApproval = &accessApproval
+	return s
+}
+
+func NewUnsetOnObjectContactRequest(
+	objectType ObjectType,
+	objectName ObjectIdentifier,
+	unset ContactsOnObjectUnsetRequest,
+) *UnsetOnObjectContactRequest {
+	s := UnsetOnObjectContactRequest{}
+	s.objectType = objectType
+	s.objectName = objectName
+	s.Unset = unset
+	return &s
+}
+
+func NewContactsOnObjectUnsetRequest() *ContactsOnObjectUnsetRequest {
+	s := ContactsOnObjectUnsetRequest{}
+	return &s
+}
+
+func (s *ContactsOnObjectUnsetRequest) WithSteward(steward bool) *ContactsOnObjectUnsetRequest {
+	s.Steward = &steward
+	return s
+}
+
+func (s *ContactsOnObjectUnsetRequest) WithSupport(support bool) *ContactsOnObjectUnsetRequest {
+	s.Support = &support
+	return s
+}
+
+func (s *ContactsOnObjectUnsetRequest) WithAccessApproval(accessApproval bool) *ContactsOnObjectUnsetRequest {
+	s.AccessApproval = &accessApproval
+	return s
+}
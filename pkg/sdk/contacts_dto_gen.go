@@ -0,0 +1,71 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+var (
+	_ optionsProvider[CreateContactOptions]        = new(CreateContactRequest)
+	_ optionsProvider[AlterContactOptions]         = new(AlterContactRequest)
+	_ optionsProvider[DropContactOptions]          = new(DropContactRequest)
+	_ optionsProvider[ShowContactOptions]          = new(ShowContactRequest)
+	_ optionsProvider[SetOnObjectContactOptions]   = new(SetOnObjectContactRequest)
+	_ optionsProvider[UnsetOnObjectContactOptions] = new(UnsetOnObjectContactRequest)
+)
+
+type CreateContactRequest struct {
+	OrReplace             *bool
+	IfNotExists           *bool
+	name                  SchemaObjectIdentifier // required
+	Users                 []ContactUser
+	EmailDistributionList *string
+	Url                   *string
+	Comment               *string
+}
+
+type AlterContactRequest struct {
+	IfExists     *bool
+	name         SchemaObjectIdentifier // required
+	Set          *ContactSetRequest
+	UnsetComment *bool
+	RenameTo     *SchemaObjectIdentifier
+}
+
+type ContactSetRequest struct {
+	Users                 []ContactUser
+	EmailDistributionList *string
+	Url                   *string
+	Comment               *string
+}
+
+type DropContactRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier // required
+}
+
+type ShowContactRequest struct {
+	Like *Like
+	In   *In
+}
+
+type SetOnObjectContactRequest struct {
+	objectType ObjectType                 // required
+	objectName ObjectIdentifier           // required
+	Set        ContactsOnObjectSetRequest // required
+}
+
+type ContactsOnObjectSetRequest struct {
+	Steward        *SchemaObjectIdentifier
+	Support        *SchemaObjectIdentifier
+	AccessApproval *SchemaObjectIdentifier
+}
+
+type UnsetOnObjectContactRequest struct {
+	objectType ObjectType                   // required
+	objectName ObjectIdentifier             // required
+	Unset      ContactsOnObjectUnsetRequest // required
+}
+
+type ContactsOnObjectUnsetRequest struct {
+	Steward        *bool
+	Support        *bool
+	AccessApproval *bool
+}
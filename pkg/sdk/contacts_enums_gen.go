@@ -0,0 +1,36 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ContactPurpose string
+
+const (
+	ContactPurposeSteward        ContactPurpose = "STEWARD"
+	ContactPurposeSupport        ContactPurpose = "SUPPORT"
+	ContactPurposeAccessApproval ContactPurpose = "ACCESS_APPROVAL"
+)
+
+var AllContactPurposes = []ContactPurpose{
+	ContactPurposeSteward,
+	ContactPurposeSupport,
+	ContactPurposeAccessApproval,
+}
+
+func ToContactPurpose(s string) (ContactPurpose, error) {
+	s = strings.ToUpper(s)
+	switch s {
+	case string(ContactPurposeSteward):
+		return ContactPurposeSteward, nil
+	case string(ContactPurposeSupport):
+		return ContactPurposeSupport, nil
+	case string(ContactPurposeAccessApproval):
+		return ContactPurposeAccessApproval, nil
+	default:
+		return "", fmt.Errorf("invalid contact purpose: %s", s)
+	}
+}
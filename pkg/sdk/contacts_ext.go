@@ -0,0 +1,94 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+func (r *CreateContactRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
+
+// AllContactAttachableObjectTypes lists object types that contacts can be associated with.
+var AllContactAttachableObjectTypes = []ObjectType{
+	ObjectTypeDatabase,
+	ObjectTypeSchema,
+	ObjectTypeTable,
+	ObjectTypeView,
+}
+
+func ToContactAttachableObjectType(s string) (ObjectType, error) {
+	objectType := ObjectType(strings.ToUpper(s))
+	if !slices.Contains(AllContactAttachableObjectTypes, objectType) {
+		return "", fmt.Errorf("invalid contact attachable object type: %s", s)
+	}
+	return objectType, nil
+}
+
+// additionalConvert handles the users column in SHOW CONTACTS output which is returned as a list of double-quoted names.
+func (r contactRow) additionalConvert(result *Contact) error {
+	if r.Users.Valid {
+		result.Users = ParseCommaSeparatedStringArray(r.Users.String, true)
+	}
+	return nil
+}
+
+func (opts *SetOnObjectContactOptions) additionalValidations() error {
+	if !slices.Contains(AllContactAttachableObjectTypes, opts.objectType) {
+		return fmt.Errorf("contacts are not supported for object type %s", opts.objectType)
+	}
+	return nil
+}
+
+func (opts *UnsetOnObjectContactOptions) additionalValidations() error {
+	if !slices.Contains(AllContactAttachableObjectTypes, opts.objectType) {
+		return fmt.Errorf("contacts are not supported for object type %s", opts.objectType)
+	}
+	return nil
+}
+
+// ContactReference describes a single contact associated with an object for the given purpose.
+// Inherited is true when the contact is not set directly on the object, but on one of its parents (e.g. on a database for a schema).
+type ContactReference struct {
+	Purpose   ContactPurpose
+	Contact   SchemaObjectIdentifier
+	Inherited bool
+}
+
+type contactReferenceRow struct {
+	Purpose   string       `db:"PURPOSE"`
+	Contact   string       `db:"CONTACT"`
+	Inherited sql.NullBool `db:"INHERITED"`
+}
+
+func (r contactReferenceRow) convert() (*ContactReference, error) {
+	purpose, err := ToContactPurpose(r.Purpose)
+	if err != nil {
+		return nil, err
+	}
+	contactId, err := ParseSchemaObjectIdentifier(r.Contact)
+	if err != nil {
+		return nil, err
+	}
+	return &ContactReference{
+		Purpose:   purpose,
+		Contact:   contactId,
+		Inherited: r.Inherited.Valid && r.Inherited.Bool,
+	}, nil
+}
+
+// GetForObject is based on https://docs.snowflake.com/en/sql-reference/functions/get_contacts.
+func (v *contacts) GetForObject(ctx context.Context, objectType ObjectType, objectId ObjectIdentifier) ([]ContactReference, error) {
+	if !slices.Contains(AllContactAttachableObjectTypes, objectType) {
+		return nil, fmt.Errorf("contacts are not supported for object type %s", objectType)
+	}
+	var rows []contactReferenceRow
+	query := fmt.Sprintf(`SELECT * FROM TABLE(SNOWFLAKE.CORE.GET_CONTACTS('%s', '%s'))`, objectId.FullyQualifiedName(), objectType)
+	if err := v.client.query(ctx, &rows, query); err != nil {
+		return nil, err
+	}
+	return convertRows[contactReferenceRow, ContactReference](rows)
+}
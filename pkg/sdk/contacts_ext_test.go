@@ -0,0 +1,192 @@
+package sdk
+
+import (
+	"errors"
+)
+
+func init() {
+	id := contactsTestIdSchemaObjectIdentifier
+	renameTarget := randomSchemaObjectIdentifierInSchema(id.SchemaId())
+	stewardId := randomSchemaObjectIdentifier()
+	supportId := randomSchemaObjectIdentifier()
+	accessApprovalId := randomSchemaObjectIdentifier()
+	databaseId := randomAccountObjectIdentifier()
+
+	contactsTests.Create.
+		withExpectedSqlf(
+			case_Contacts_sql_Create_basic,
+			"CREATE CONTACT %s", id.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_Contacts_sql_Create_all,
+			func(opts *CreateContactOptions) {
+				opts.OrReplace = new(true)
+				opts.Users = []ContactUser{{Name: "user1"}, {Name: "user2"}}
+				opts.Comment = new("some comment")
+			},
+			"CREATE OR REPLACE CONTACT %s USERS = ('user1', 'user2') COMMENT = 'some comment'", id.FullyQualifiedName(),
+		).
+		withModify(
+			case_Contacts_validation_Create_opts_MoreThanOneValueSet_MoreThanOneSet,
+			func(opts *CreateContactOptions) {
+				opts.Users = []ContactUser{{Name: "user1"}}
+				opts.EmailDistributionList = new("team@example.com")
+				opts.Url = new("https://example.com")
+			},
+		).
+		withAdditionalSqlCasef(
+			"sql_Create_emailDistributionList",
+			func(opts *CreateContactOptions) {
+				opts.IfNotExists = new(true)
+				opts.EmailDistributionList = new("team@example.com")
+			},
+			"CREATE CONTACT IF NOT EXISTS %s EMAIL_DISTRIBUTION_LIST = 'team@example.com'", id.FullyQualifiedName(),
+		).
+		withAdditionalSqlCasef(
+			"sql_Create_url",
+			func(opts *CreateContactOptions) {
+				opts.Url = new("https://example.com")
+			},
+			"CREATE CONTACT %s URL = 'https://example.com'", id.FullyQualifiedName(),
+		)
+
+	contactsTests.Alter.
+		withModify(
+			case_Contacts_validation_Alter_opts_Set_MoreThanOneValueSet_MoreThanOneSet,
+			func(opts *AlterContactOptions) {
+				opts.Set = &ContactSet{
+					Users:                 []ContactUser{{Name: "user1"}},
+					EmailDistributionList: new("team@example.com"),
+				}
+			},
+		).
+		withModifyAndExpectedSqlf(
+			case_Contacts_sql_Alter_Set,
+			func(opts *AlterContactOptions) {
+				opts.IfExists = new(true)
+				opts.Set = &ContactSet{
+					Users:   []ContactUser{{Name: "user1"}},
+					Comment: new("comment"),
+				}
+			},
+			"ALTER CONTACT IF EXISTS %s SET USERS = ('user1'), COMMENT = 'comment'", id.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_Contacts_sql_Alter_UnsetComment,
+			func(opts *AlterContactOptions) { opts.UnsetComment = new(true) },
+			"ALTER CONTACT %s UNSET COMMENT", id.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_Contacts_sql_Alter_RenameTo,
+			func(opts *AlterContactOptions) { opts.RenameTo = &renameTarget },
+			"ALTER CONTACT %s RENAME TO %s", id.FullyQualifiedName(), renameTarget.FullyQualifiedName(),
+		).
+		withAdditionalSqlCasef(
+			"sql_Alter_SetUrl",
+			func(opts *AlterContactOptions) {
+				opts.Set = &ContactSet{Url: new("https://example.com")}
+			},
+			"ALTER CONTACT %s SET URL = 'https://example.com'", id.FullyQualifiedName(),
+		)
+
+	contactsTests.Drop.
+		withExpectedSqlf(
+			case_Contacts_sql_Drop_basic,
+			"DROP CONTACT %s", id.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_Contacts_sql_Drop_all,
+			func(opts *DropContactOptions) { opts.IfExists = new(true) },
+			"DROP CONTACT IF EXISTS %s", id.FullyQualifiedName(),
+		)
+
+	contactsTests.Show.
+		withExpectedSql(case_Contacts_sql_Show_basic, "SHOW CONTACTS").
+		withModifyAndExpectedSqlf(
+			case_Contacts_sql_Show_all,
+			func(opts *ShowContactOptions) {
+				opts.Like = &Like{Pattern: new("pattern")}
+				opts.In = &In{Schema: id.SchemaId()}
+			},
+			"SHOW CONTACTS LIKE 'pattern' IN SCHEMA %s", id.SchemaId().FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_Contacts_sql_Show_Like,
+			func(opts *ShowContactOptions) {
+				opts.Like = &Like{Pattern: new("pattern")}
+			},
+			"SHOW CONTACTS LIKE 'pattern'",
+		).
+		withModifyAndExpectedSqlf(
+			case_Contacts_sql_Show_In,
+			func(opts *ShowContactOptions) {
+				opts.In = &In{Account: new(true)}
+			},
+			"SHOW CONTACTS IN ACCOUNT",
+		)
+
+	contactsTests.SetOnObject.
+		withDefaultOpts(func() *SetOnObjectContactOptions {
+			return &SetOnObjectContactOptions{
+				objectType: ObjectTypeDatabase,
+				objectName: databaseId,
+				Set: ContactsOnObjectSet{
+					Steward: &stewardId,
+				},
+			}
+		}).
+		withExpectedSqlf(
+			case_Contacts_sql_SetOnObject_basic,
+			"ALTER DATABASE %s SET CONTACT STEWARD = %s", databaseId.FullyQualifiedName(), stewardId.FullyQualifiedName(),
+		).
+		withAdditionalSqlCasef(
+			"sql_SetOnObject_all",
+			func(opts *SetOnObjectContactOptions) {
+				opts.objectType = ObjectTypeTable
+				opts.objectName = id
+				opts.Set.Support = &supportId
+				opts.Set.AccessApproval = &accessApprovalId
+			},
+			"ALTER TABLE %s SET CONTACT STEWARD = %s, SUPPORT = %s, ACCESS_APPROVAL = %s",
+			id.FullyQualifiedName(), stewardId.FullyQualifiedName(), supportId.FullyQualifiedName(), accessApprovalId.FullyQualifiedName(),
+		).
+		withAdditionalValidationCase(
+			"validation_SetOnObject_unsupportedObjectType",
+			func(opts *SetOnObjectContactOptions) {
+				opts.objectType = ObjectTypeWarehouse
+			},
+			errors.New("contacts are not supported for object type WAREHOUSE"),
+		)
+
+	contactsTests.UnsetOnObject.
+		withDefaultOpts(func() *UnsetOnObjectContactOptions {
+			return &UnsetOnObjectContactOptions{
+				objectType: ObjectTypeSchema,
+				objectName: id.SchemaId(),
+				Unset: ContactsOnObjectUnset{
+					Steward: new(true),
+				},
+			}
+		}).
+		withExpectedSqlf(
+			case_Contacts_sql_UnsetOnObject_basic,
+			"ALTER SCHEMA %s UNSET CONTACT STEWARD", id.SchemaId().FullyQualifiedName(),
+		).
+		withAdditionalSqlCasef(
+			"sql_UnsetOnObject_all",
+			func(opts *UnsetOnObjectContactOptions) {
+				opts.objectType = ObjectTypeView
+				opts.objectName = id
+				opts.Unset.Support = new(true)
+				opts.Unset.AccessApproval = new(true)
+			},
+			"ALTER VIEW %s UNSET CONTACT STEWARD, SUPPORT, ACCESS_APPROVAL", id.FullyQualifiedName(),
+		).
+		withAdditionalValidationCase(
+			"validation_UnsetOnObject_unsupportedObjectType",
+			func(opts *UnsetOnObjectContactOptions) {
+				opts.objectType = ObjectTypeWarehouse
+			},
+			errors.New("contacts are not supported for object type WAREHOUSE"),
+		)
+}
@@ -0,0 +1,135 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type Contacts interface {
+	Create(ctx context.Context, request *CreateContactRequest) error
+	Alter(ctx context.Context, request *AlterContactRequest) error
+	Drop(ctx context.Context, request *DropContactRequest) error
+	DropSafely(ctx context.Context, id SchemaObjectIdentifier) error
+	Show(ctx context.Context, request *ShowContactRequest) ([]Contact, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Contact, error)
+	ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*Contact, error)
+	SetOnObject(ctx context.Context, request *SetOnObjectContactRequest) error
+	UnsetOnObject(ctx context.Context, request *UnsetOnObjectContactRequest) error
+	GetForObject(ctx context.Context, objectType ObjectType, objectId ObjectIdentifier) ([]ContactReference, error)
+}
+
+// CreateContactOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-contact.
+type CreateContactOptions struct {
+	create                bool                   `ddl:"static" sql:"CREATE"`
+	OrReplace             *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	contact               bool                   `ddl:"static" sql:"CONTACT"`
+	IfNotExists           *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                  SchemaObjectIdentifier `ddl:"identifier"`
+	Users                 []ContactUser          `ddl:"parameter,parentheses" sql:"USERS"`
+	EmailDistributionList *string                `ddl:"parameter,single_quotes" sql:"EMAIL_DISTRIBUTION_LIST"`
+	Url                   *string                `ddl:"parameter,single_quotes" sql:"URL"`
+	Comment               *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+type ContactUser struct {
+	Name string `ddl:"keyword,single_quotes"`
+}
+
+// AlterContactOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-contact.
+type AlterContactOptions struct {
+	alter        bool                    `ddl:"static" sql:"ALTER"`
+	contact      bool                    `ddl:"static" sql:"CONTACT"`
+	IfExists     *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name         SchemaObjectIdentifier  `ddl:"identifier"`
+	Set          *ContactSet             `ddl:"list" sql:"SET"`
+	UnsetComment *bool                   `ddl:"keyword" sql:"UNSET COMMENT"`
+	RenameTo     *SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+}
+
+type ContactSet struct {
+	Users                 []ContactUser `ddl:"parameter,parentheses" sql:"USERS"`
+	EmailDistributionList *string       `ddl:"parameter,single_quotes" sql:"EMAIL_DISTRIBUTION_LIST"`
+	Url                   *string       `ddl:"parameter,single_quotes" sql:"URL"`
+	Comment               *string       `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+// DropContactOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-contact.
+type DropContactOptions struct {
+	drop     bool                   `ddl:"static" sql:"DROP"`
+	contact  bool                   `ddl:"static" sql:"CONTACT"`
+	IfExists *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name     SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowContactOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-contacts.
+type ShowContactOptions struct {
+	show     bool  `ddl:"static" sql:"SHOW"`
+	contacts bool  `ddl:"static" sql:"CONTACTS"`
+	Like     *Like `ddl:"keyword" sql:"LIKE"`
+	In       *In   `ddl:"keyword" sql:"IN"`
+}
+
+type contactRow struct {
+	CreatedOn             time.Time      `db:"created_on"`
+	Name                  string         `db:"name"`
+	DatabaseName          string         `db:"database_name"`
+	SchemaName            string         `db:"schema_name"`
+	Owner                 string         `db:"owner"`
+	Comment               sql.NullString `db:"comment"`
+	Users                 sql.NullString `db:"users"`
+	EmailDistributionList sql.NullString `db:"email_distribution_list"`
+	Url                   sql.NullString `db:"url"`
+	OwnerRoleType         string         `db:"owner_role_type"`
+}
+
+type Contact struct {
+	CreatedOn             time.Time
+	Name                  string
+	DatabaseName          string
+	SchemaName            string
+	Owner                 string
+	Comment               string
+	Users                 []string
+	EmailDistributionList *string
+	Url                   *string
+	OwnerRoleType         string
+}
+
+func (v *Contact) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *Contact) ObjectType() ObjectType {
+	return ObjectTypeContact
+}
+
+// SetOnObjectContactOptions is based on https://docs.snowflake.com/en/sql-reference/contacts-using.
+type SetOnObjectContactOptions struct {
+	alter      bool                `ddl:"static" sql:"ALTER"`
+	objectType ObjectType          `ddl:"keyword"`
+	objectName ObjectIdentifier    `ddl:"identifier"`
+	Set        ContactsOnObjectSet `ddl:"list" sql:"SET CONTACT"`
+}
+
+type ContactsOnObjectSet struct {
+	Steward        *SchemaObjectIdentifier `ddl:"identifier,equals" sql:"STEWARD"`
+	Support        *SchemaObjectIdentifier `ddl:"identifier,equals" sql:"SUPPORT"`
+	AccessApproval *SchemaObjectIdentifier `ddl:"identifier,equals" sql:"ACCESS_APPROVAL"`
+}
+
+// UnsetOnObjectContactOptions is based on https://docs.snowflake.com/en/sql-reference/contacts-using.
+type UnsetOnObjectContactOptions struct {
+	alter      bool                  `ddl:"static" sql:"ALTER"`
+	objectType ObjectType            `ddl:"keyword"`
+	objectName ObjectIdentifier      `ddl:"identifier"`
+	Unset      ContactsOnObjectUnset `ddl:"list" sql:"UNSET CONTACT"`
+}
+
+type ContactsOnObjectUnset struct {
+	Steward        *bool `ddl:"keyword" sql:"STEWARD"`
+	Support        *bool `ddl:"keyword" sql:"SUPPORT"`
+	AccessApproval *bool `ddl:"keyword" sql:"ACCESS_APPROVAL"`
+}
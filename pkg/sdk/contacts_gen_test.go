@@ -0,0 +1,322 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+import (
+	"testing"
+)
+
+func init() {
+	allEnumConversionTests = append(allEnumConversionTests, typedEnumTestProvider[ContactPurpose]{"ContactPurpose", AllContactPurposes, ToContactPurpose})
+}
+
+var contactsTestIdSchemaObjectIdentifier = randomSchemaObjectIdentifier()
+
+const (
+	case_Contacts_validation_Create_name_ValidIdentifier                         testCaseName = "validation_Create_name_ValidIdentifier"
+	case_Contacts_validation_Create_opts_ConflictingFields                       testCaseName = "validation_Create_opts_ConflictingFields"
+	case_Contacts_validation_Create_opts_MoreThanOneValueSet_MoreThanOneSet      testCaseName = "validation_Create_opts_MoreThanOneValueSet_MoreThanOneSet"
+	case_Contacts_sql_Create_basic                                               testCaseName = "sql_Create_basic"
+	case_Contacts_sql_Create_all                                                 testCaseName = "sql_Create_all"
+	case_Contacts_validation_Alter_name_ValidIdentifier                          testCaseName = "validation_Alter_name_ValidIdentifier"
+	case_Contacts_validation_Alter_RenameTo_ValidIdentifierIfSet                 testCaseName = "validation_Alter_RenameTo_ValidIdentifierIfSet"
+	case_Contacts_validation_Alter_opts_ExactlyOneValueSet_NoneSet               testCaseName = "validation_Alter_opts_ExactlyOneValueSet_NoneSet"
+	case_Contacts_validation_Alter_opts_ExactlyOneValueSet_MoreThanOneSet        testCaseName = "validation_Alter_opts_ExactlyOneValueSet_MoreThanOneSet"
+	case_Contacts_validation_Alter_opts_Set_AtLeastOneValueSet                   testCaseName = "validation_Alter_opts_Set_AtLeastOneValueSet"
+	case_Contacts_validation_Alter_opts_Set_MoreThanOneValueSet_MoreThanOneSet   testCaseName = "validation_Alter_opts_Set_MoreThanOneValueSet_MoreThanOneSet"
+	case_Contacts_sql_Alter_Set                                                  testCaseName = "sql_Alter_Set"
+	case_Contacts_sql_Alter_UnsetComment                                         testCaseName = "sql_Alter_UnsetComment"
+	case_Contacts_sql_Alter_RenameTo                                             testCaseName = "sql_Alter_RenameTo"
+	case_Contacts_validation_Drop_name_ValidIdentifier                           testCaseName = "validation_Drop_name_ValidIdentifier"
+	case_Contacts_sql_Drop_basic                                                 testCaseName = "sql_Drop_basic"
+	case_Contacts_sql_Drop_all                                                   testCaseName = "sql_Drop_all"
+	case_Contacts_sql_Show_basic                                                 testCaseName = "sql_Show_basic"
+	case_Contacts_sql_Show_all                                                   testCaseName = "sql_Show_all"
+	case_Contacts_sql_Show_Like                                                  testCaseName = "sql_Show_Like"
+	case_Contacts_sql_Show_In                                                    testCaseName = "sql_Show_In"
+	case_Contacts_validation_SetOnObject_objectName_ValidIdentifier              testCaseName = "validation_SetOnObject_objectName_ValidIdentifier"
+	case_Contacts_validation_SetOnObject_Set_Steward_ValidIdentifierIfSet        testCaseName = "validation_SetOnObject_Set_Steward_ValidIdentifierIfSet"
+	case_Contacts_validation_SetOnObject_Set_Support_ValidIdentifierIfSet        testCaseName = "validation_SetOnObject_Set_Support_ValidIdentifierIfSet"
+	case_Contacts_validation_SetOnObject_Set_AccessApproval_ValidIdentifierIfSet testCaseName = "validation_SetOnObject_Set_AccessApproval_ValidIdentifierIfSet"
+	case_Contacts_validation_SetOnObject_opts_Set_AtLeastOneValueSet             testCaseName = "validation_SetOnObject_opts_Set_AtLeastOneValueSet"
+	case_Contacts_sql_SetOnObject_basic                                          testCaseName = "sql_SetOnObject_basic"
+	case_Contacts_validation_UnsetOnObject_objectName_ValidIdentifier            testCaseName = "validation_UnsetOnObject_objectName_ValidIdentifier"
+	case_Contacts_validation_UnsetOnObject_opts_Unset_AtLeastOneValueSet         testCaseName = "validation_UnsetOnObject_opts_Unset_AtLeastOneValueSet"
+	case_Contacts_sql_UnsetOnObject_basic                                        testCaseName = "sql_UnsetOnObject_basic"
+)
+
+type ContactsTestsContext struct {
+	Create        *sdkTestCtx[*CreateContactOptions]
+	Alter         *sdkTestCtx[*AlterContactOptions]
+	Drop          *sdkTestCtx[*DropContactOptions]
+	Show          *sdkTestCtx[*ShowContactOptions]
+	SetOnObject   *sdkTestCtx[*SetOnObjectContactOptions]
+	UnsetOnObject *sdkTestCtx[*UnsetOnObjectContactOptions]
+}
+
+var contactsTests = ContactsTestsContext{
+	Create: newSdkTestCtx[*CreateContactOptions](
+		"Contacts", "Create",
+	).
+		withDefaultOpts(func() *CreateContactOptions {
+			return &CreateContactOptions{
+				name: contactsTestIdSchemaObjectIdentifier,
+			}
+		}).
+		withValidationCases(
+			validationCase[*CreateContactOptions]{
+				Name:        case_Contacts_validation_Create_name_ValidIdentifier,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *CreateContactOptions) {
+					opts.name = emptySchemaObjectIdentifier
+				},
+			},
+			validationCase[*CreateContactOptions]{
+				Name:        case_Contacts_validation_Create_opts_ConflictingFields,
+				ExpectedErr: errOneOf("CreateContactOptions", "OrReplace", "IfNotExists"),
+				DefaultModify: func(opts *CreateContactOptions) {
+					opts.OrReplace = new(true)
+					opts.IfNotExists = new(true)
+				},
+			},
+			validationCase[*CreateContactOptions]{
+				Name:        case_Contacts_validation_Create_opts_MoreThanOneValueSet_MoreThanOneSet,
+				ExpectedErr: errMoreThanOneOf("CreateContactOptions", "Users", "EmailDistributionList", "Url"),
+			},
+		).
+		withSqlCases(
+			sqlCase[*CreateContactOptions]{
+				Name:           case_Contacts_sql_Create_basic,
+				NoModifyNeeded: true,
+			},
+			sqlCase[*CreateContactOptions]{
+				Name: case_Contacts_sql_Create_all,
+			},
+		),
+	Alter: newSdkTestCtx[*AlterContactOptions](
+		"Contacts", "Alter",
+	).
+		withDefaultOpts(func() *AlterContactOptions {
+			return &AlterContactOptions{
+				name: contactsTestIdSchemaObjectIdentifier,
+			}
+		}).
+		withValidationCases(
+			validationCase[*AlterContactOptions]{
+				Name:        case_Contacts_validation_Alter_name_ValidIdentifier,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *AlterContactOptions) {
+					opts.name = emptySchemaObjectIdentifier
+				},
+			},
+			validationCase[*AlterContactOptions]{
+				Name:        case_Contacts_validation_Alter_RenameTo_ValidIdentifierIfSet,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *AlterContactOptions) {
+					opts.RenameTo = new(emptySchemaObjectIdentifier)
+				},
+			},
+			validationCase[*AlterContactOptions]{
+				Name:        case_Contacts_validation_Alter_opts_ExactlyOneValueSet_NoneSet,
+				ExpectedErr: errExactlyOneOf("AlterContactOptions", "Set", "UnsetComment", "RenameTo"),
+				DefaultModify: func(opts *AlterContactOptions) {
+					opts.Set = nil
+					opts.UnsetComment = nil
+					opts.RenameTo = nil
+				},
+			},
+			validationCase[*AlterContactOptions]{
+				Name:        case_Contacts_validation_Alter_opts_ExactlyOneValueSet_MoreThanOneSet,
+				ExpectedErr: errExactlyOneOf("AlterContactOptions", "Set", "UnsetComment", "RenameTo"),
+				DefaultModify: func(opts *AlterContactOptions) {
+					opts.Set = &ContactSet{}
+					opts.UnsetComment = new(true)
+				},
+			},
+			validationCase[*AlterContactOptions]{
+				Name:        case_Contacts_validation_Alter_opts_Set_AtLeastOneValueSet,
+				ExpectedErr: errAtLeastOneOf("AlterContactOptions.Set", "Users", "EmailDistributionList", "Url", "Comment"),
+				DefaultModify: func(opts *AlterContactOptions) {
+					opts.Set = &ContactSet{}
+					opts.Set.Users = nil
+					opts.Set.EmailDistributionList = nil
+					opts.Set.Url = nil
+					opts.Set.Comment = nil
+				},
+			},
+			validationCase[*AlterContactOptions]{
+				Name:        case_Contacts_validation_Alter_opts_Set_MoreThanOneValueSet_MoreThanOneSet,
+				ExpectedErr: errMoreThanOneOf("AlterContactOptions.Set", "Users", "EmailDistributionList", "Url"),
+			},
+		).
+		withSqlCases(
+			sqlCase[*AlterContactOptions]{
+				Name: case_Contacts_sql_Alter_Set,
+			},
+			sqlCase[*AlterContactOptions]{
+				Name: case_Contacts_sql_Alter_UnsetComment,
+			},
+			sqlCase[*AlterContactOptions]{
+				Name: case_Contacts_sql_Alter_RenameTo,
+			},
+		),
+	Drop: newSdkTestCtx[*DropContactOptions](
+		"Contacts", "Drop",
+	).
+		withDefaultOpts(func() *DropContactOptions {
+			return &DropContactOptions{
+				name: contactsTestIdSchemaObjectIdentifier,
+			}
+		}).
+		withValidationCases(
+			validationCase[*DropContactOptions]{
+				Name:        case_Contacts_validation_Drop_name_ValidIdentifier,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *DropContactOptions) {
+					opts.name = emptySchemaObjectIdentifier
+				},
+			},
+		).
+		withSqlCases(
+			sqlCase[*DropContactOptions]{
+				Name:           case_Contacts_sql_Drop_basic,
+				NoModifyNeeded: true,
+			},
+			sqlCase[*DropContactOptions]{
+				Name: case_Contacts_sql_Drop_all,
+			},
+		),
+	Show: newSdkTestCtx[*ShowContactOptions](
+		"Contacts", "Show",
+	).
+		withDefaultOpts(func() *ShowContactOptions {
+			return &ShowContactOptions{}
+		}).
+		withValidationCases().
+		withSqlCases(
+			sqlCase[*ShowContactOptions]{
+				Name:           case_Contacts_sql_Show_basic,
+				NoModifyNeeded: true,
+			},
+			sqlCase[*ShowContactOptions]{
+				Name: case_Contacts_sql_Show_all,
+			},
+			sqlCase[*ShowContactOptions]{
+				Name: case_Contacts_sql_Show_Like,
+			},
+			sqlCase[*ShowContactOptions]{
+				Name: case_Contacts_sql_Show_In,
+			},
+		),
+	SetOnObject: newSdkTestCtx[*SetOnObjectContactOptions](
+		"Contacts", "SetOnObject",
+	).
+		withDefaultOpts(func() *SetOnObjectContactOptions {
+			return &SetOnObjectContactOptions{}
+		}).
+		withValidationCases(
+			validationCase[*SetOnObjectContactOptions]{
+				Name:        case_Contacts_validation_SetOnObject_objectName_ValidIdentifier,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *SetOnObjectContactOptions) {
+					opts.objectName = emptyAccountObjectIdentifier
+				},
+			},
+			validationCase[*SetOnObjectContactOptions]{
+				Name:        case_Contacts_validation_SetOnObject_Set_Steward_ValidIdentifierIfSet,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *SetOnObjectContactOptions) {
+					opts.Set.Steward = new(emptySchemaObjectIdentifier)
+				},
+			},
+			validationCase[*SetOnObjectContactOptions]{
+				Name:        case_Contacts_validation_SetOnObject_Set_Support_ValidIdentifierIfSet,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *SetOnObjectContactOptions) {
+					opts.Set.Support = new(emptySchemaObjectIdentifier)
+				},
+			},
+			validationCase[*SetOnObjectContactOptions]{
+				Name:        case_Contacts_validation_SetOnObject_Set_AccessApproval_ValidIdentifierIfSet,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *SetOnObjectContactOptions) {
+					opts.Set.AccessApproval = new(emptySchemaObjectIdentifier)
+				},
+			},
+			validationCase[*SetOnObjectContactOptions]{
+				Name:        case_Contacts_validation_SetOnObject_opts_Set_AtLeastOneValueSet,
+				ExpectedErr: errAtLeastOneOf("SetOnObjectContactOptions.Set", "Steward", "Support", "AccessApproval"),
+				DefaultModify: func(opts *SetOnObjectContactOptions) {
+					opts.Set.Steward = nil
+					opts.Set.Support = nil
+					opts.Set.AccessApproval = nil
+				},
+			},
+		).
+		withSqlCases(
+			sqlCase[*SetOnObjectContactOptions]{
+				Name:           case_Contacts_sql_SetOnObject_basic,
+				NoModifyNeeded: true,
+			},
+		),
+	UnsetOnObject: newSdkTestCtx[*UnsetOnObjectContactOptions](
+		"Contacts", "UnsetOnObject",
+	).
+		withDefaultOpts(func() *UnsetOnObjectContactOptions {
+			return &UnsetOnObjectContactOptions{}
+		}).
+		withValidationCases(
+			validationCase[*UnsetOnObjectContactOptions]{
+				Name:        case_Contacts_validation_UnsetOnObject_objectName_ValidIdentifier,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *UnsetOnObjectContactOptions) {
+					opts.objectName = emptyAccountObjectIdentifier
+				},
+			},
+			validationCase[*UnsetOnObjectContactOptions]{
+				Name:        case_Contacts_validation_UnsetOnObject_opts_Unset_AtLeastOneValueSet,
+				ExpectedErr: errAtLeastOneOf("UnsetOnObjectContactOptions.Unset", "Steward", "Support", "AccessApproval"),
+				DefaultModify: func(opts *UnsetOnObjectContactOptions) {
+					opts.Unset.Steward = nil
+					opts.Unset.Support = nil
+					opts.Unset.AccessApproval = nil
+				},
+			},
+		).
+		withSqlCases(
+			sqlCase[*UnsetOnObjectContactOptions]{
+				Name:           case_Contacts_sql_UnsetOnObject_basic,
+				NoModifyNeeded: true,
+			},
+		),
+}
+
+func TestContacts_Create(t *testing.T) {
+	contactsTests.Create.RunValidationCases(t)
+	contactsTests.Create.RunSqlCases(t)
+}
+
+func TestContacts_Alter(t *testing.T) {
+	contactsTests.Alter.RunValidationCases(t)
+	contactsTests.Alter.RunSqlCases(t)
+}
+
+func TestContacts_Drop(t *testing.T) {
+	contactsTests.Drop.RunValidationCases(t)
+	contactsTests.Drop.RunSqlCases(t)
+}
+
+func TestContacts_Show(t *testing.T) {
+	contactsTests.Show.RunValidationCases(t)
+	contactsTests.Show.RunSqlCases(t)
+}
+
+func TestContacts_SetOnObject(t *testing.T) {
+	contactsTests.SetOnObject.RunValidationCases(t)
+	contactsTests.SetOnObject.RunSqlCases(t)
+}
+
+func TestContacts_UnsetOnObject(t *testing.T) {
+	contactsTests.UnsetOnObject.RunValidationCases(t)
+	contactsTests.UnsetOnObject.RunSqlCases(t)
+}
@@ -0,0 +1,162 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var (
+	_ Contacts                = (*contacts)(nil)
+	_ convertibleRow[Contact] = new(contactRow)
+)
+
+type contacts struct {
+	client *Client
+}
+
+func (v *contacts) Create(ctx context.Context, request *CreateContactRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *contacts) Alter(ctx context.Context, request *AlterContactRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *contacts) Drop(ctx context.Context, request *DropContactRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *contacts) DropSafely(ctx context.Context, id SchemaObjectIdentifier) error {
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, NewDropContactRequest(id).WithIfExists(true)) }, ctx, id)
+}
+
+func (v *contacts) Show(ctx context.Context, request *ShowContactRequest) ([]Contact, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[contactRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[contactRow, Contact](dbRows)
+}
+
+func (v *contacts) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*Contact, error) {
+	request := NewShowContactRequest().
+		WithLike(Like{Pattern: String(id.Name())}).
+		WithIn(In{Schema: id.SchemaId()})
+	contacts, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(contacts, func(r Contact) bool { return r.Name == id.Name() })
+}
+
+func (v *contacts) ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*Contact, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+func (v *contacts) SetOnObject(ctx context.Context, request *SetOnObjectContactRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *contacts) UnsetOnObject(ctx context.Context, request *UnsetOnObjectContactRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (r *CreateContactRequest) toOpts() *CreateContactOptions {
+	opts := &CreateContactOptions{
+		OrReplace:             r.OrReplace,
+		IfNotExists:           r.IfNotExists,
+		name:                  r.name,
+		Users:                 r.Users,
+		EmailDistributionList: r.EmailDistributionList,
+		Url:                   r.Url,
+		Comment:               r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterContactRequest) toOpts() *AlterContactOptions {
+	opts := &AlterContactOptions{
+		IfExists:     r.IfExists,
+		name:         r.name,
+		UnsetComment: r.UnsetComment,
+		RenameTo:     r.RenameTo,
+	}
+	if r.Set != nil {
+		opts.Set = &ContactSet{
+			Users:                 r.Set.Users,
+			EmailDistributionList: r.Set.EmailDistributionList,
+			Url:                   r.Set.Url,
+			Comment:               r.Set.Comment,
+		}
+	}
+	return opts
+}
+
+func (r *DropContactRequest) toOpts() *DropContactOptions {
+	opts := &DropContactOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowContactRequest) toOpts() *ShowContactOptions {
+	opts := &ShowContactOptions{
+		Like: r.Like,
+		In:   r.In,
+	}
+	return opts
+}
+
+func (r contactRow) convert() (*Contact, error) {
+	result := &Contact{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		DatabaseName:  r.DatabaseName,
+		SchemaName:    r.SchemaName,
+		Owner:         r.Owner,
+		OwnerRoleType: r.OwnerRoleType,
+	}
+	mapNullStringToNonNullableField(&result.Comment, r.Comment)
+	mapNullString(&result.EmailDistributionList, r.EmailDistributionList)
+	mapNullString(&result.Url, r.Url)
+	if err := r.additionalConvert(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (r *SetOnObjectContactRequest) toOpts() *SetOnObjectContactOptions {
+	opts := &SetOnObjectContactOptions{
+		objectType: r.objectType,
+		objectName: r.objectName,
+	}
+	opts.Set = ContactsOnObjectSet{
+		Steward:        r.Set.Steward,
+		Support:        r.Set.Support,
+		AccessApproval: r.Set.AccessApproval,
+	}
+	return opts
+}
+
+func (r *UnsetOnObjectContactRequest) toOpts() *UnsetOnObjectContactOptions {
+	opts := &UnsetOnObjectContactOptions{
+		objectType: r.objectType,
+		objectName: r.objectName,
+	}
+	opts.Unset = ContactsOnObjectUnset{
+		Steward:        r.Unset.Steward,
+		Support:        r.Unset.Support,
+		AccessApproval: r.Unset.AccessApproval,
+	}
+	return opts
+}
@@ -0,0 +1,116 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+var (
+	_ validatable = new(CreateContactOptions)
+	_ validatable = new(AlterContactOptions)
+	_ validatable = new(DropContactOptions)
+	_ validatable = new(ShowContactOptions)
+	_ validatable = new(SetOnObjectContactOptions)
+	_ validatable = new(UnsetOnObjectContactOptions)
+)
+
+func (opts *CreateContactOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.OrReplace, opts.IfNotExists) {
+		errs = append(errs, errOneOf("CreateContactOptions", "OrReplace", "IfNotExists"))
+	}
+	if moreThanOneValueSet(opts.Users, opts.EmailDistributionList, opts.Url) {
+		errs = append(errs, errMoreThanOneOf("CreateContactOptions", "Users", "EmailDistributionList", "Url"))
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *AlterContactOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if opts.RenameTo != nil && !ValidObjectIdentifier(opts.RenameTo) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Set, opts.UnsetComment, opts.RenameTo) {
+		errs = append(errs, errExactlyOneOf("AlterContactOptions", "Set", "UnsetComment", "RenameTo"))
+	}
+	if valueSet(opts.Set) {
+		if !anyValueSet(opts.Set.Users, opts.Set.EmailDistributionList, opts.Set.Url, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterContactOptions.Set", "Users", "EmailDistributionList", "Url", "Comment"))
+		}
+		if moreThanOneValueSet(opts.Set.Users, opts.Set.EmailDistributionList, opts.Set.Url) {
+			errs = append(errs, errMoreThanOneOf("AlterContactOptions.Set", "Users", "EmailDistributionList", "Url"))
+		}
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *DropContactOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *ShowContactOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	return JoinErrors(errs...)
+}
+
+func (opts *SetOnObjectContactOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.objectName) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	errs = append(errs, opts.additionalValidations())
+	if valueSet(opts.Set) {
+		if opts.Set.Steward != nil && !ValidObjectIdentifier(opts.Set.Steward) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+		if opts.Set.Support != nil && !ValidObjectIdentifier(opts.Set.Support) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+		if opts.Set.AccessApproval != nil && !ValidObjectIdentifier(opts.Set.AccessApproval) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+		if !anyValueSet(opts.Set.Steward, opts.Set.Support, opts.Set.AccessApproval) {
+			errs = append(errs, errAtLeastOneOf("SetOnObjectContactOptions.Set", "Steward", "Support", "AccessApproval"))
+		}
+	}
+	return JoinErrors(errs...)
+}
+
+func (opts *UnsetOnObjectContactOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.objectName) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	errs = append(errs, opts.additionalValidations())
+	if valueSet(opts.Unset) {
+		if !anyValueSet(opts.Unset.Steward, opts.Unset.Support, opts.Unset.AccessApproval) {
+			errs = append(errs, errAtLeastOneOf("UnsetOnObjectContactOptions.Unset", "Steward", "Support", "AccessApproval"))
+		}
+	}
+	return JoinErrors(errs...)
+}
@@ -27,3 +27,70 @@ func NewdataMetricFunctionReferenceFunctionArgumentsRequest(
 	s.RefEntityDomain = refEntityDomain
 	return &s
 }
+
+func NewAlterOnObjectDataMetricFunctionReferenceRequest(
+	objectType ObjectType,
+	objectName SchemaObjectIdentifier,
+) *AlterOnObjectDataMetricFunctionReferenceRequest {
+	s := AlterOnObjectDataMetricFunctionReferenceRequest{}
+	s.objectType = objectType
+	s.objectName = objectName
+	return &s
+}
+
+func (s *AlterOnObjectDataMetricFunctionReferenceRequest) WithAdd(add DataMetricFunctionOnObjectAddRequest) *AlterOnObjectDataMetricFunctionReferenceRequest {
+	s.Add = &add
+	return s
+}
+
+func (s *AlterOnObjectDataMetricFunctionReferenceRequest) WithDrop(drop DataMetricFunctionOnObjectDropRequest) *AlterOnObjectDataMetricFunctionReferenceRequest {
+	s.Drop = &drop
+	return s
+}
+
+func (s *AlterOnObjectDataMetricFunctionReferenceRequest) WithModify(modify DataMetricFunctionOnObjectModifyRequest) *AlterOnObjectDataMetricFunctionReferenceRequest {
+	s.Modify = &modify
+	return s
+}
+
+func (s *AlterOnObjectDataMetricFunctionReferenceRequest) WithSetSchedule(setSchedule DataMetricFunctionOnObjectSetScheduleRequest) *AlterOnObjectDataMetricFunctionReferenceRequest {
+	s.SetSchedule = &setSchedule
+	return s
+}
+
+func (s *AlterOnObjectDataMetricFunctionReferenceRequest) WithUnsetSchedule(unsetSchedule bool) *AlterOnObjectDataMetricFunctionReferenceRequest {
+	s.UnsetSchedule = &unsetSchedule
+	return s
+}
+
+func NewDataMetricFunctionOnObjectAddRequest(
+	dataMetricFunction []ViewDataMetricFunction,
+) *DataMetricFunctionOnObjectAddRequest {
+	s := DataMetricFunctionOnObjectAddRequest{}
+	s.DataMetricFunction = dataMetricFunction
+	return &s
+}
+
+func NewDataMetricFunctionOnObjectDropRequest(
+	dataMetricFunction []ViewDataMetricFunction,
+) *DataMetricFunctionOnObjectDropRequest {
+	s := DataMetricFunctionOnObjectDropRequest{}
+	s.DataMetricFunction = dataMetricFunction
+	return &s
+}
+
+func NewDataMetricFunctionOnObjectModifyRequest(
+	dataMetricFunction []ViewModifyDataMetricFunction,
+) *DataMetricFunctionOnObjectModifyRequest {
+	s := DataMetricFunctionOnObjectModifyRequest{}
+	s.DataMetricFunction = dataMetricFunction
+	return &s
+}
+
+func NewDataMetricFunctionOnObjectSetScheduleRequest(
+	dataMetricSchedule string,
+) *DataMetricFunctionOnObjectSetScheduleRequest {
+	s := DataMetricFunctionOnObjectSetScheduleRequest{}
+	s.DataMetricSchedule = dataMetricSchedule
+	return &s
+}
@@ -2,7 +2,10 @@
 
 package sdk
 
-var _ optionsProvider[GetForEntityDataMetricFunctionReferenceOptions] = new(GetForEntityDataMetricFunctionReferenceRequest)
+var (
+	_ optionsProvider[GetForEntityDataMetricFunctionReferenceOptions]  = new(GetForEntityDataMetricFunctionReferenceRequest)
+	_ optionsProvider[AlterOnObjectDataMetricFunctionReferenceOptions] = new(AlterOnObjectDataMetricFunctionReferenceRequest)
+)
 
 type GetForEntityDataMetricFunctionReferenceRequest struct {
 	parameters *dataMetricFunctionReferenceParametersRequest // required
@@ -16,3 +19,29 @@ type dataMetricFunctionReferenceFunctionArgumentsRequest struct {
 	refEntityName   []ObjectIdentifier                       // required
 	RefEntityDomain *DataMetricFunctionRefEntityDomainOption // required
 }
+
+type AlterOnObjectDataMetricFunctionReferenceRequest struct {
+	objectType    ObjectType             // required
+	objectName    SchemaObjectIdentifier // required
+	Add           *DataMetricFunctionOnObjectAddRequest
+	Drop          *DataMetricFunctionOnObjectDropRequest
+	Modify        *DataMetricFunctionOnObjectModifyRequest
+	SetSchedule   *DataMetricFunctionOnObjectSetScheduleRequest
+	UnsetSchedule *bool
+}
+
+type DataMetricFunctionOnObjectAddRequest struct {
+	DataMetricFunction []ViewDataMetricFunction // required
+}
+
+type DataMetricFunctionOnObjectDropRequest struct {
+	DataMetricFunction []ViewDataMetricFunction // required
+}
+
+type DataMetricFunctionOnObjectModifyRequest struct {
+	DataMetricFunction []ViewModifyDataMetricFunction // required
+}
+
+type DataMetricFunctionOnObjectSetScheduleRequest struct {
+	DataMetricSchedule string // required
+}
@@ -1,6 +1,10 @@
 package sdk
 
-import "strings"
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
 
 func (r dataMetricFunctionReferencesRow) additionalConvert(result *DataMetricFunctionReference) error {
 	result.MetricDatabaseName = strings.Trim(r.MetricDatabaseName, `"`)
@@ -31,3 +35,38 @@ func NewGetForEntityDataMetricFunctionReferenceRequestCustom(
 		),
 	)
 }
+
+// AllDataMetricFunctionAttachableObjectTypes lists object types that data metric functions can be attached to.
+var AllDataMetricFunctionAttachableObjectTypes = []ObjectType{
+	ObjectTypeTable,
+	ObjectTypeView,
+	ObjectTypeDynamicTable,
+	ObjectTypeIcebergTable,
+}
+
+func ToDataMetricFunctionAttachableObjectType(s string) (ObjectType, error) {
+	objectType := ObjectType(strings.ReplaceAll(strings.ToUpper(s), "_", " "))
+	if !slices.Contains(AllDataMetricFunctionAttachableObjectTypes, objectType) {
+		return "", fmt.Errorf("invalid data metric function attachable object type: %s", s)
+	}
+	return objectType, nil
+}
+
+// DataMetricFunctionRefEntityDomainForObjectType returns the REF_ENTITY_DOMAIN value used to look up data metric function references for the given object type.
+func DataMetricFunctionRefEntityDomainForObjectType(objectType ObjectType) (DataMetricFunctionRefEntityDomainOption, error) {
+	switch objectType {
+	case ObjectTypeView:
+		return DataMetricFunctionRefEntityDomainOptionView, nil
+	case ObjectTypeTable, ObjectTypeDynamicTable, ObjectTypeIcebergTable:
+		return DataMetricFunctionRefEntityDomainOptionTable, nil
+	default:
+		return "", fmt.Errorf("data metric functions are not supported for object type %s", objectType)
+	}
+}
+
+func (opts *AlterOnObjectDataMetricFunctionReferenceOptions) additionalValidations() error {
+	if !slices.Contains(AllDataMetricFunctionAttachableObjectTypes, opts.objectType) {
+		return fmt.Errorf("data metric functions are not supported for object type %s", opts.objectType)
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 
 type DataMetricFunctionReferences interface {
 	GetForEntity(ctx context.Context, request *GetForEntityDataMetricFunctionReferenceRequest) ([]DataMetricFunctionReference, error)
+	AlterOnObject(ctx context.Context, request *AlterOnObjectDataMetricFunctionReferenceRequest) error
 }
 
 // GetForEntityDataMetricFunctionReferenceOptions is based on https://docs.snowflake.com/en/sql-reference/functions/data_metric_function_references.
@@ -57,3 +58,35 @@ type dataMetricFunctionReferenceParameters struct {
 	functionFullyQualifiedName bool                                          `ddl:"static" sql:"SNOWFLAKE.INFORMATION_SCHEMA.DATA_METRIC_FUNCTION_REFERENCES"`
 	arguments                  *dataMetricFunctionReferenceFunctionArguments `ddl:"list,parentheses"`
 }
+
+// AlterOnObjectDataMetricFunctionReferenceOptions is based on https://docs.snowflake.com/en/user-guide/data-quality-working.
+type AlterOnObjectDataMetricFunctionReferenceOptions struct {
+	alter         bool                                   `ddl:"static" sql:"ALTER"`
+	objectType    ObjectType                             `ddl:"keyword"`
+	objectName    SchemaObjectIdentifier                 `ddl:"identifier"`
+	Add           *DataMetricFunctionOnObjectAdd         `ddl:"keyword"`
+	Drop          *DataMetricFunctionOnObjectDrop        `ddl:"keyword"`
+	Modify        *DataMetricFunctionOnObjectModify      `ddl:"keyword"`
+	SetSchedule   *DataMetricFunctionOnObjectSetSchedule `ddl:"keyword"`
+	UnsetSchedule *bool                                  `ddl:"keyword" sql:"UNSET DATA_METRIC_SCHEDULE"`
+}
+
+type DataMetricFunctionOnObjectAdd struct {
+	add                bool                     `ddl:"static" sql:"ADD"`
+	DataMetricFunction []ViewDataMetricFunction `ddl:"parameter,no_equals" sql:"DATA METRIC FUNCTION"`
+}
+
+type DataMetricFunctionOnObjectDrop struct {
+	drop               bool                     `ddl:"static" sql:"DROP"`
+	DataMetricFunction []ViewDataMetricFunction `ddl:"parameter,no_equals" sql:"DATA METRIC FUNCTION"`
+}
+
+type DataMetricFunctionOnObjectModify struct {
+	modify             bool                           `ddl:"static" sql:"MODIFY"`
+	DataMetricFunction []ViewModifyDataMetricFunction `ddl:"parameter,no_equals" sql:"DATA METRIC FUNCTION"`
+}
+
+type DataMetricFunctionOnObjectSetSchedule struct {
+	set                bool   `ddl:"static" sql:"SET"`
+	DataMetricSchedule string `ddl:"parameter,single_quotes" sql:"DATA_METRIC_SCHEDULE"`
+}
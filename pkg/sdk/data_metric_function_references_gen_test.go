@@ -3,6 +3,7 @@
 package sdk
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -63,4 +64,99 @@ func TestDataMetricFunctionReferences_GetForEntity(t *testing.T) {
 		}
 		assertOptsValidAndSQLEquals(t, opts, `SELECT * FROM TABLE (SNOWFLAKE.INFORMATION_SCHEMA.DATA_METRIC_FUNCTION_REFERENCES (REF_ENTITY_NAME => '\"a\".\"b\".\"c\"', REF_ENTITY_DOMAIN => 'VIEW'))`)
 	})
+
+	t.Run("table domain", func(t *testing.T) {
+		opts := &GetForEntityDataMetricFunctionReferenceOptions{
+			parameters: &dataMetricFunctionReferenceParameters{
+				arguments: &dataMetricFunctionReferenceFunctionArguments{
+					refEntityName:   []ObjectIdentifier{NewSchemaObjectIdentifier("a", "b", "c")},
+					RefEntityDomain: Pointer(DataMetricFunctionRefEntityDomainOptionTable),
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SELECT * FROM TABLE (SNOWFLAKE.INFORMATION_SCHEMA.DATA_METRIC_FUNCTION_REFERENCES (REF_ENTITY_NAME => '\"a\".\"b\".\"c\"', REF_ENTITY_DOMAIN => 'TABLE'))`)
+	})
+}
+
+func TestDataMetricFunctionReferences_AlterOnObject(t *testing.T) {
+	id := randomSchemaObjectIdentifier()
+	dmfId := randomSchemaObjectIdentifier()
+
+	// Minimal valid AlterOnObjectDataMetricFunctionReferenceOptions
+	defaultOpts := func() *AlterOnObjectDataMetricFunctionReferenceOptions {
+		return &AlterOnObjectDataMetricFunctionReferenceOptions{
+			objectType: ObjectTypeTable,
+			objectName: id,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		opts := (*AlterOnObjectDataMetricFunctionReferenceOptions)(nil)
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: valid identifier for [opts.objectName]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.objectName = emptySchemaObjectIdentifier
+		opts.UnsetSchedule = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one field from [opts.Add opts.Drop opts.Modify opts.SetSchedule opts.UnsetSchedule] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterOnObjectDataMetricFunctionReferenceOptions", "Add", "Drop", "Modify", "SetSchedule", "UnsetSchedule"))
+	})
+
+	t.Run("validation: exactly one field from [opts.Add opts.Drop opts.Modify opts.SetSchedule opts.UnsetSchedule] should be present - more present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetSchedule = &DataMetricFunctionOnObjectSetSchedule{DataMetricSchedule: "5 MINUTE"}
+		opts.UnsetSchedule = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("AlterOnObjectDataMetricFunctionReferenceOptions", "Add", "Drop", "Modify", "SetSchedule", "UnsetSchedule"))
+	})
+
+	t.Run("validation: unsupported object type", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.objectType = ObjectTypeStage
+		opts.UnsetSchedule = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, fmt.Errorf("data metric functions are not supported for object type %s", ObjectTypeStage))
+	})
+
+	t.Run("add", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Add = &DataMetricFunctionOnObjectAdd{
+			DataMetricFunction: []ViewDataMetricFunction{{DataMetricFunction: dmfId, On: []Column{{"a"}, {"b"}}}},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s ADD DATA METRIC FUNCTION %s ON (\"a\", \"b\")", id.FullyQualifiedName(), dmfId.FullyQualifiedName())
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.objectType = ObjectTypeDynamicTable
+		opts.Drop = &DataMetricFunctionOnObjectDrop{
+			DataMetricFunction: []ViewDataMetricFunction{{DataMetricFunction: dmfId, On: []Column{{"a"}}}},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER DYNAMIC TABLE %s DROP DATA METRIC FUNCTION %s ON (\"a\")", id.FullyQualifiedName(), dmfId.FullyQualifiedName())
+	})
+
+	t.Run("modify", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.objectType = ObjectTypeIcebergTable
+		opts.Modify = &DataMetricFunctionOnObjectModify{
+			DataMetricFunction: []ViewModifyDataMetricFunction{{DataMetricFunction: dmfId, On: []Column{{"a"}}, ViewDataMetricScheduleStatusOperationOption: ViewDataMetricScheduleStatusOperationOptionSuspend}},
+		}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER ICEBERG TABLE %s MODIFY DATA METRIC FUNCTION %s ON (\"a\") SUSPEND", id.FullyQualifiedName(), dmfId.FullyQualifiedName())
+	})
+
+	t.Run("set schedule", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.objectType = ObjectTypeView
+		opts.SetSchedule = &DataMetricFunctionOnObjectSetSchedule{DataMetricSchedule: "USING CRON 0 8 * * * UTC"}
+		assertOptsValidAndSQLEquals(t, opts, "ALTER VIEW %s SET DATA_METRIC_SCHEDULE = 'USING CRON 0 8 * * * UTC'", id.FullyQualifiedName())
+	})
+
+	t.Run("unset schedule", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.UnsetSchedule = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, "ALTER TABLE %s UNSET DATA_METRIC_SCHEDULE", id.FullyQualifiedName())
+	})
 }
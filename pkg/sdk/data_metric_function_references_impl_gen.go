@@ -26,6 +26,11 @@ func (v *dataMetricFunctionReferences) GetForEntity(ctx context.Context, request
 	return convertRows[dataMetricFunctionReferencesRow, DataMetricFunctionReference](dbRows)
 }
 
+func (v *dataMetricFunctionReferences) AlterOnObject(ctx context.Context, request *AlterOnObjectDataMetricFunctionReferenceRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
 func (r *GetForEntityDataMetricFunctionReferenceRequest) toOpts() *GetForEntityDataMetricFunctionReferenceOptions {
 	opts := &GetForEntityDataMetricFunctionReferenceOptions{}
 	if r.parameters != nil {
@@ -57,3 +62,32 @@ func (r dataMetricFunctionReferencesRow) convert() (*DataMetricFunctionReference
 	}
 	return result, nil
 }
+
+func (r *AlterOnObjectDataMetricFunctionReferenceRequest) toOpts() *AlterOnObjectDataMetricFunctionReferenceOptions {
+	opts := &AlterOnObjectDataMetricFunctionReferenceOptions{
+		objectType:    r.objectType,
+		objectName:    r.objectName,
+		UnsetSchedule: r.UnsetSchedule,
+	}
+	if r.Add != nil {
+		opts.Add = &DataMetricFunctionOnObjectAdd{
+			DataMetricFunction: r.Add.DataMetricFunction,
+		}
+	}
+	if r.Drop != nil {
+		opts.Drop = &DataMetricFunctionOnObjectDrop{
+			DataMetricFunction: r.Drop.DataMetricFunction,
+		}
+	}
+	if r.Modify != nil {
+		opts.Modify = &DataMetricFunctionOnObjectModify{
+			DataMetricFunction: r.Modify.DataMetricFunction,
+		}
+	}
+	if r.SetSchedule != nil {
+		opts.SetSchedule = &DataMetricFunctionOnObjectSetSchedule{
+			DataMetricSchedule: r.SetSchedule.DataMetricSchedule,
+		}
+	}
+	return opts
+}
@@ -2,7 +2,10 @@
 
 package sdk
 
-var _ validatable = new(GetForEntityDataMetricFunctionReferenceOptions)
+var (
+	_ validatable = new(GetForEntityDataMetricFunctionReferenceOptions)
+	_ validatable = new(AlterOnObjectDataMetricFunctionReferenceOptions)
+)
 
 func (opts *GetForEntityDataMetricFunctionReferenceOptions) validate() error {
 	if opts == nil {
@@ -27,3 +30,18 @@ func (opts *GetForEntityDataMetricFunctionReferenceOptions) validate() error {
 	}
 	return JoinErrors(errs...)
 }
+
+func (opts *AlterOnObjectDataMetricFunctionReferenceOptions) validate() error {
+	if opts == nil {
+		return ErrNilOptions
+	}
+	var errs []error
+	if !ValidObjectIdentifier(opts.objectName) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if !exactlyOneValueSet(opts.Add, opts.Drop, opts.Modify, opts.SetSchedule, opts.UnsetSchedule) {
+		errs = append(errs, errExactlyOneOf("AlterOnObjectDataMetricFunctionReferenceOptions", "Add", "Drop", "Modify", "SetSchedule", "UnsetSchedule"))
+	}
+	errs = append(errs, opts.additionalValidations())
+	return JoinErrors(errs...)
+}
@@ -556,6 +556,11 @@ func (s *DatabaseSetRequest) WithEnableConsoleOutput(enableConsoleOutput bool) *
 	return s
 }
 
+func (s *DatabaseSetRequest) WithEventTable(eventTable SchemaObjectIdentifier) *DatabaseSetRequest {
+	s.EventTable = &eventTable
+	return s
+}
+
 func (s *DatabaseSetRequest) WithComment(comment string) *DatabaseSetRequest {
 	s.Comment = &comment
 	return s
@@ -661,6 +666,11 @@ func (s *DatabaseUnsetRequest) WithEnableConsoleOutput(enableConsoleOutput bool)
 	return s
 }
 
+func (s *DatabaseUnsetRequest) WithEventTable(eventTable bool) *DatabaseUnsetRequest {
+	s.EventTable = &eventTable
+	return s
+}
+
 func (s *DatabaseUnsetRequest) WithComment(comment bool) *DatabaseUnsetRequest {
 	s.Comment = &comment
 	return s
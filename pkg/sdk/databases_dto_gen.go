@@ -143,6 +143,7 @@ type DatabaseSetRequest struct {
 	UserTaskMinimumTriggerIntervalInSeconds *int
 	QuotedIdentifiersIgnoreCase             *bool
 	EnableConsoleOutput                     *bool
+	EventTable                              *SchemaObjectIdentifier
 	Comment                                 *string
 }
 
@@ -166,6 +167,7 @@ type DatabaseUnsetRequest struct {
 	UserTaskMinimumTriggerIntervalInSeconds *bool
 	QuotedIdentifiersIgnoreCase             *bool
 	EnableConsoleOutput                     *bool
+	EventTable                              *bool
 	Comment                                 *bool
 }
 
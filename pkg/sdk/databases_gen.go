@@ -175,6 +175,7 @@ type DatabaseSet struct {
 	UserTaskMinimumTriggerIntervalInSeconds *int                        `ddl:"parameter" sql:"USER_TASK_MINIMUM_TRIGGER_INTERVAL_IN_SECONDS"`
 	QuotedIdentifiersIgnoreCase             *bool                       `ddl:"parameter" sql:"QUOTED_IDENTIFIERS_IGNORE_CASE"`
 	EnableConsoleOutput                     *bool                       `ddl:"parameter" sql:"ENABLE_CONSOLE_OUTPUT"`
+	EventTable                              *SchemaObjectIdentifier     `ddl:"identifier,equals" sql:"EVENT_TABLE"`
 	Comment                                 *string                     `ddl:"parameter,single_quotes" sql:"COMMENT"`
 }
 
@@ -198,6 +199,7 @@ type DatabaseUnset struct {
 	UserTaskMinimumTriggerIntervalInSeconds *bool `ddl:"keyword" sql:"USER_TASK_MINIMUM_TRIGGER_INTERVAL_IN_SECONDS"`
 	QuotedIdentifiersIgnoreCase             *bool `ddl:"keyword" sql:"QUOTED_IDENTIFIERS_IGNORE_CASE"`
 	EnableConsoleOutput                     *bool `ddl:"keyword" sql:"ENABLE_CONSOLE_OUTPUT"`
+	EventTable                              *bool `ddl:"keyword" sql:"EVENT_TABLE"`
 	Comment                                 *bool `ddl:"keyword" sql:"COMMENT"`
 }
 
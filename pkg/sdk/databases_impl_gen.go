@@ -235,6 +235,7 @@ func (r *AlterDatabaseRequest) toOpts() *AlterDatabaseOptions {
 			UserTaskMinimumTriggerIntervalInSeconds: r.Set.UserTaskMinimumTriggerIntervalInSeconds,
 			QuotedIdentifiersIgnoreCase:             r.Set.QuotedIdentifiersIgnoreCase,
 			EnableConsoleOutput:                     r.Set.EnableConsoleOutput,
+			EventTable:                              r.Set.EventTable,
 			Comment:                                 r.Set.Comment,
 		}
 	}
@@ -259,6 +260,7 @@ func (r *AlterDatabaseRequest) toOpts() *AlterDatabaseOptions {
 			UserTaskMinimumTriggerIntervalInSeconds: r.Unset.UserTaskMinimumTriggerIntervalInSeconds,
 			QuotedIdentifiersIgnoreCase:             r.Unset.QuotedIdentifiersIgnoreCase,
 			EnableConsoleOutput:                     r.Unset.EnableConsoleOutput,
+			EventTable:                              r.Unset.EventTable,
 			Comment:                                 r.Unset.Comment,
 		}
 	}
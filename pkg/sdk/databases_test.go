@@ -373,6 +373,7 @@ func TestDatabasesAlter(t *testing.T) {
 			"UserTaskMinimumTriggerIntervalInSeconds",
 			"QuotedIdentifiersIgnoreCase",
 			"EnableConsoleOutput",
+			"EventTable",
 			"Comment",
 		))
 	})
@@ -401,6 +402,7 @@ func TestDatabasesAlter(t *testing.T) {
 			"UserTaskMinimumTriggerIntervalInSeconds",
 			"QuotedIdentifiersIgnoreCase",
 			"EnableConsoleOutput",
+			"EventTable",
 			"Comment",
 		))
 	})
@@ -486,6 +488,31 @@ func TestDatabasesAlter(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `ALTER DATABASE %s UNSET DATA_RETENTION_TIME_IN_DAYS, MAX_DATA_EXTENSION_TIME_IN_DAYS, EXTERNAL_VOLUME, CATALOG, REPLACE_INVALID_CHARACTERS, DEFAULT_DDL_COLLATION, DEFAULT_NOTEBOOK_COMPUTE_POOL_CPU, DEFAULT_NOTEBOOK_COMPUTE_POOL_GPU, STORAGE_SERIALIZATION_POLICY, LOG_LEVEL, TRACE_LEVEL, COMMENT`, opts.name.FullyQualifiedName())
 	})
 
+	t.Run("validation: invalid event table identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Set = &DatabaseSet{
+			EventTable: Pointer(emptySchemaObjectIdentifier),
+		}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("set event table", func(t *testing.T) {
+		eventTableId := randomSchemaObjectIdentifier()
+		opts := defaultOpts()
+		opts.Set = &DatabaseSet{
+			EventTable: &eventTableId,
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DATABASE %s SET EVENT_TABLE = %s`, opts.name.FullyQualifiedName(), eventTableId.FullyQualifiedName())
+	})
+
+	t.Run("unset event table", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Unset = &DatabaseUnset{
+			EventTable: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER DATABASE %s UNSET EVENT_TABLE`, opts.name.FullyQualifiedName())
+	})
+
 	t.Run("with set tag", func(t *testing.T) {
 		tagId1 := randomSchemaObjectIdentifier()
 		tagId2 := randomSchemaObjectIdentifierInSchema(tagId1.SchemaId())
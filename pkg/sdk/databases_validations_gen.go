@@ -133,13 +133,16 @@ func (opts *AlterDatabaseOptions) validate() error {
 		if opts.Set.Catalog != nil && !ValidObjectIdentifier(opts.Set.Catalog) {
 			errs = append(errs, ErrInvalidObjectIdentifier)
 		}
-		if !anyValueSet(opts.Set.DataRetentionTimeInDays, opts.Set.MaxDataExtensionTimeInDays, opts.Set.ExternalVolume, opts.Set.Catalog, opts.Set.ReplaceInvalidCharacters, opts.Set.DefaultDdlCollation, opts.Set.DefaultNotebookComputePoolCpu, opts.Set.DefaultNotebookComputePoolGpu, opts.Set.StorageSerializationPolicy, opts.Set.LogLevel, opts.Set.LogEventLevel, opts.Set.TraceLevel, opts.Set.SuspendTaskAfterNumFailures, opts.Set.TaskAutoRetryAttempts, opts.Set.UserTaskManagedInitialWarehouseSize, opts.Set.UserTaskTimeoutMs, opts.Set.UserTaskMinimumTriggerIntervalInSeconds, opts.Set.QuotedIdentifiersIgnoreCase, opts.Set.EnableConsoleOutput, opts.Set.Comment) {
-			errs = append(errs, errAtLeastOneOf("AlterDatabaseOptions.Set", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ExternalVolume", "Catalog", "ReplaceInvalidCharacters", "DefaultDdlCollation", "DefaultNotebookComputePoolCpu", "DefaultNotebookComputePoolGpu", "StorageSerializationPolicy", "LogLevel", "LogEventLevel", "TraceLevel", "SuspendTaskAfterNumFailures", "TaskAutoRetryAttempts", "UserTaskManagedInitialWarehouseSize", "UserTaskTimeoutMs", "UserTaskMinimumTriggerIntervalInSeconds", "QuotedIdentifiersIgnoreCase", "EnableConsoleOutput", "Comment"))
+		if opts.Set.EventTable != nil && !ValidObjectIdentifier(opts.Set.EventTable) {
+			errs = append(errs, ErrInvalidObjectIdentifier)
+		}
+		if !anyValueSet(opts.Set.DataRetentionTimeInDays, opts.Set.MaxDataExtensionTimeInDays, opts.Set.ExternalVolume, opts.Set.Catalog, opts.Set.ReplaceInvalidCharacters, opts.Set.DefaultDdlCollation, opts.Set.DefaultNotebookComputePoolCpu, opts.Set.DefaultNotebookComputePoolGpu, opts.Set.StorageSerializationPolicy, opts.Set.LogLevel, opts.Set.LogEventLevel, opts.Set.TraceLevel, opts.Set.SuspendTaskAfterNumFailures, opts.Set.TaskAutoRetryAttempts, opts.Set.UserTaskManagedInitialWarehouseSize, opts.Set.UserTaskTimeoutMs, opts.Set.UserTaskMinimumTriggerIntervalInSeconds, opts.Set.QuotedIdentifiersIgnoreCase, opts.Set.EnableConsoleOutput, opts.Set.EventTable, opts.Set.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterDatabaseOptions.Set", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ExternalVolume", "Catalog", "ReplaceInvalidCharacters", "DefaultDdlCollation", "DefaultNotebookComputePoolCpu", "DefaultNotebookComputePoolGpu", "StorageSerializationPolicy", "LogLevel", "LogEventLevel", "TraceLevel", "SuspendTaskAfterNumFailures", "TaskAutoRetryAttempts", "UserTaskManagedInitialWarehouseSize", "UserTaskTimeoutMs", "UserTaskMinimumTriggerIntervalInSeconds", "QuotedIdentifiersIgnoreCase", "EnableConsoleOutput", "EventTable", "Comment"))
 		}
 	}
 	if valueSet(opts.Unset) {
-		if !anyValueSet(opts.Unset.DataRetentionTimeInDays, opts.Unset.MaxDataExtensionTimeInDays, opts.Unset.ExternalVolume, opts.Unset.Catalog, opts.Unset.ReplaceInvalidCharacters, opts.Unset.DefaultDdlCollation, opts.Unset.DefaultNotebookComputePoolCpu, opts.Unset.DefaultNotebookComputePoolGpu, opts.Unset.StorageSerializationPolicy, opts.Unset.LogLevel, opts.Unset.LogEventLevel, opts.Unset.TraceLevel, opts.Unset.SuspendTaskAfterNumFailures, opts.Unset.TaskAutoRetryAttempts, opts.Unset.UserTaskManagedInitialWarehouseSize, opts.Unset.UserTaskTimeoutMs, opts.Unset.UserTaskMinimumTriggerIntervalInSeconds, opts.Unset.QuotedIdentifiersIgnoreCase, opts.Unset.EnableConsoleOutput, opts.Unset.Comment) {
-			errs = append(errs, errAtLeastOneOf("AlterDatabaseOptions.Unset", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ExternalVolume", "Catalog", "ReplaceInvalidCharacters", "DefaultDdlCollation", "DefaultNotebookComputePoolCpu", "DefaultNotebookComputePoolGpu", "StorageSerializationPolicy", "LogLevel", "LogEventLevel", "TraceLevel", "SuspendTaskAfterNumFailures", "TaskAutoRetryAttempts", "UserTaskManagedInitialWarehouseSize", "UserTaskTimeoutMs", "UserTaskMinimumTriggerIntervalInSeconds", "QuotedIdentifiersIgnoreCase", "EnableConsoleOutput", "Comment"))
+		if !anyValueSet(opts.Unset.DataRetentionTimeInDays, opts.Unset.MaxDataExtensionTimeInDays, opts.Unset.ExternalVolume, opts.Unset.Catalog, opts.Unset.ReplaceInvalidCharacters, opts.Unset.DefaultDdlCollation, opts.Unset.DefaultNotebookComputePoolCpu, opts.Unset.DefaultNotebookComputePoolGpu, opts.Unset.StorageSerializationPolicy, opts.Unset.LogLevel, opts.Unset.LogEventLevel, opts.Unset.TraceLevel, opts.Unset.SuspendTaskAfterNumFailures, opts.Unset.TaskAutoRetryAttempts, opts.Unset.UserTaskManagedInitialWarehouseSize, opts.Unset.UserTaskTimeoutMs, opts.Unset.UserTaskMinimumTriggerIntervalInSeconds, opts.Unset.QuotedIdentifiersIgnoreCase, opts.Unset.EnableConsoleOutput, opts.Unset.EventTable, opts.Unset.Comment) {
+			errs = append(errs, errAtLeastOneOf("AlterDatabaseOptions.Unset", "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ExternalVolume", "Catalog", "ReplaceInvalidCharacters", "DefaultDdlCollation", "DefaultNotebookComputePoolCpu", "DefaultNotebookComputePoolGpu", "StorageSerializationPolicy", "LogLevel", "LogEventLevel", "TraceLevel", "SuspendTaskAfterNumFailures", "TaskAutoRetryAttempts", "UserTaskManagedInitialWarehouseSize", "UserTaskTimeoutMs", "UserTaskMinimumTriggerIntervalInSeconds", "QuotedIdentifiersIgnoreCase", "EnableConsoleOutput", "EventTable", "Comment"))
 		}
 	}
 	return JoinErrors(errs...)
@@ -1,13 +1,19 @@
 package datatypes
 
+import "fmt"
+
 // ArrayDataType is based on https://docs.snowflake.com/en/sql-reference/data-types-semistructured#array
-// It does not have synonyms. It does not have any attributes.
+// and https://docs.snowflake.com/en/sql-reference/data-types-structured#specifying-a-structured-array-type.
+// It does not have synonyms.
+// The semi-structured array does not have any attributes. The structured array has the element type that can be marked as NOT NULL.
 type ArrayDataType struct {
+	elementType    DataType
+	elementNotNull bool
 	underlyingType string
 }
 
 func (t *ArrayDataType) ToSql() string {
-	return t.underlyingType
+	return t.format(t.underlyingType, func(dt DataType) string { return dt.ToSql() })
 }
 
 func (t *ArrayDataType) ToLegacyDataTypeSql() string {
@@ -15,15 +21,66 @@ func (t *ArrayDataType) ToLegacyDataTypeSql() string {
 }
 
 func (t *ArrayDataType) Canonical() string {
-	return ArrayLegacyDataType
+	return t.format(ArrayLegacyDataType, func(dt DataType) string { return dt.Canonical() })
 }
 
 func (t *ArrayDataType) ToSqlWithoutUnknowns() string {
-	return t.ToSql()
+	return t.format(t.underlyingType, func(dt DataType) string { return dt.ToSqlWithoutUnknowns() })
+}
+
+func (t *ArrayDataType) format(arrayType string, formatType func(DataType) string) string {
+	if !t.IsStructured() {
+		return arrayType
+	}
+	return fmt.Sprintf("%s(%s)", arrayType, formatStructuredTypeElement(t.elementType, t.elementNotNull, formatType))
+}
+
+// IsStructured returns true for the arrays with the element type specified.
+func (t *ArrayDataType) IsStructured() bool {
+	return t.elementType != nil
+}
+
+// ElementType returns nil for the semi-structured arrays.
+func (t *ArrayDataType) ElementType() DataType {
+	return t.elementType
+}
+
+func (t *ArrayDataType) ElementNotNull() bool {
+	return t.elementNotNull
 }
 
 var ArrayDataTypeSynonyms = []string{ArrayLegacyDataType}
 
 func parseArrayDataTypeRaw(raw sanitizedDataTypeRaw) (*ArrayDataType, error) {
-	return &ArrayDataType{raw.matchedByType}, nil
+	args, structured, err := structuredTypeArguments(raw, "ARRAY(element_type [NOT NULL])")
+	if err != nil {
+		return nil, err
+	}
+	if !structured {
+		return &ArrayDataType{underlyingType: raw.matchedByType}, nil
+	}
+	elementType, elementNotNull, err := parseStructuredTypeElement(args)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the array's element type: %w", err)
+	}
+	return &ArrayDataType{
+		elementType:    elementType,
+		elementNotNull: elementNotNull,
+		underlyingType: raw.matchedByType,
+	}, nil
+}
+
+// areArrayDataTypesTheSame compares element types of the structured arrays; semi-structured arrays are different from structured ones.
+func areArrayDataTypesTheSame(a, b *ArrayDataType) bool {
+	if a.IsStructured() != b.IsStructured() {
+		return false
+	}
+	return a.elementNotNull == b.elementNotNull && AreTheSame(a.elementType, b.elementType)
+}
+
+func areArrayDataTypesDefinitelyDifferent(a, b *ArrayDataType) bool {
+	if a.IsStructured() != b.IsStructured() {
+		return true
+	}
+	return a.elementNotNull != b.elementNotNull || AreDefinitelyDifferent(a.elementType, b.elementType)
 }
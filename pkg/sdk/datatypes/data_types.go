@@ -70,11 +70,15 @@ func ParseDataType(raw string) (DataType, error) {
 	if slices.Contains(VariantDataTypeSynonyms, dataTypeRaw) {
 		return parseVariantDataTypeRaw(sanitizedDataTypeRaw{dataTypeRaw, dataTypeRaw})
 	}
-	if slices.Contains(ObjectDataTypeSynonyms, dataTypeRaw) {
-		return parseObjectDataTypeRaw(sanitizedDataTypeRaw{dataTypeRaw, dataTypeRaw})
+	// structured types are parsed from the raw input because the object keys are case-sensitive
+	if idx := slices.IndexFunc(ObjectDataTypeSynonyms, func(s string) bool { return strings.HasPrefix(dataTypeRaw, s) }); idx >= 0 {
+		return parseObjectDataTypeRaw(sanitizedDataTypeRaw{strings.TrimSpace(raw), ObjectDataTypeSynonyms[idx]})
 	}
-	if slices.Contains(ArrayDataTypeSynonyms, dataTypeRaw) {
-		return parseArrayDataTypeRaw(sanitizedDataTypeRaw{dataTypeRaw, dataTypeRaw})
+	if idx := slices.IndexFunc(ArrayDataTypeSynonyms, func(s string) bool { return strings.HasPrefix(dataTypeRaw, s) }); idx >= 0 {
+		return parseArrayDataTypeRaw(sanitizedDataTypeRaw{strings.TrimSpace(raw), ArrayDataTypeSynonyms[idx]})
+	}
+	if idx := slices.IndexFunc(MapDataTypeSynonyms, func(s string) bool { return strings.HasPrefix(dataTypeRaw, s) }); idx >= 0 {
+		return parseMapDataTypeRaw(sanitizedDataTypeRaw{strings.TrimSpace(raw), MapDataTypeSynonyms[idx]})
 	}
 	if slices.Contains(GeographyDataTypeSynonyms, dataTypeRaw) {
 		return parseGeographyDataTypeRaw(sanitizedDataTypeRaw{dataTypeRaw, dataTypeRaw})
@@ -109,7 +113,7 @@ func AreTheSame(a DataType, b DataType) bool {
 	}
 	switch v := a.(type) {
 	case *ArrayDataType:
-		return castSuccessfully(v, b, noArgsDataTypesAreTheSame)
+		return castSuccessfully(v, b, areArrayDataTypesTheSame)
 	case *BinaryDataType:
 		return castSuccessfully(v, b, areBinaryDataTypesTheSame)
 	case *BooleanDataType:
@@ -126,8 +130,10 @@ func AreTheSame(a DataType, b DataType) bool {
 		return castSuccessfully(v, b, noArgsDataTypesAreTheSame)
 	case *NumberDataType:
 		return castSuccessfully(v, b, areNumberDataTypesTheSame)
+	case *MapDataType:
+		return castSuccessfully(v, b, areMapDataTypesTheSame)
 	case *ObjectDataType:
-		return castSuccessfully(v, b, noArgsDataTypesAreTheSame)
+		return castSuccessfully(v, b, areObjectDataTypesTheSame)
 	case *TableDataType:
 		return castSuccessfully(v, b, areTableDataTypesTheSame)
 	case *TextDataType:
@@ -189,7 +195,7 @@ func AreDefinitelyDifferent(a DataType, b DataType) bool {
 	}
 	switch v := a.(type) {
 	case *ArrayDataType:
-		return castSuccessfully(v, b, noArgsDataTypesAreDefinitelyDifferent)
+		return castSuccessfully(v, b, areArrayDataTypesDefinitelyDifferent)
 	case *BinaryDataType:
 		return castSuccessfully(v, b, areBinaryDataTypesDefinitelyDifferent)
 	case *BooleanDataType:
@@ -206,8 +212,10 @@ func AreDefinitelyDifferent(a DataType, b DataType) bool {
 		return castSuccessfully(v, b, noArgsDataTypesAreDefinitelyDifferent)
 	case *NumberDataType:
 		return castSuccessfully(v, b, areNumberDataTypesDefinitelyDifferent)
+	case *MapDataType:
+		return castSuccessfully(v, b, areMapDataTypesDefinitelyDifferent)
 	case *ObjectDataType:
-		return castSuccessfully(v, b, noArgsDataTypesAreDefinitelyDifferent)
+		return castSuccessfully(v, b, areObjectDataTypesDefinitelyDifferent)
 	case *TableDataType:
 		return castSuccessfully(v, b, areTableDataTypesDefinitelyDifferent)
 	case *TextDataType:
@@ -894,6 +894,7 @@ func Test_ParseDataType_Object(t *testing.T) {
 			assert.Equal(t, ObjectLegacyDataType, parsed.ToLegacyDataTypeSql())
 			assert.Equal(t, tc.expectedUnderlyingType, parsed.ToSql())
 			assert.Equal(t, ObjectLegacyDataType, parsed.Canonical())
+			assert.False(t, parsed.(*ObjectDataType).IsStructured())
 		})
 	}
 
@@ -907,6 +908,58 @@ func Test_ParseDataType_Object(t *testing.T) {
 	}
 }
 
+func Test_ParseDataType_StructuredObject(t *testing.T) {
+	type test struct {
+		input             string
+		expectedSql       string
+		expectedCanonical string
+		expectedKeys      []string
+	}
+
+	positiveTestCases := []test{
+		{input: "OBJECT(city VARCHAR)", expectedSql: "OBJECT(city VARCHAR(16777216))", expectedCanonical: "OBJECT(city VARCHAR(16777216))", expectedKeys: []string{"city"}},
+		{input: "object(city varchar, zip number(5, 0))", expectedSql: "OBJECT(city VARCHAR(16777216), zip NUMBER(5, 0))", expectedCanonical: "OBJECT(city VARCHAR(16777216), zip NUMBER(5,0))", expectedKeys: []string{"city", "zip"}},
+		{input: "  OBJECT( City VARCHAR NOT NULL , Zip INT )  ", expectedSql: "OBJECT(City VARCHAR(16777216) NOT NULL, Zip INT)", expectedCanonical: "OBJECT(City VARCHAR(16777216) NOT NULL, Zip NUMBER(38,0))", expectedKeys: []string{"City", "Zip"}},
+		{input: `OBJECT("my key" NUMBER(38, 0))`, expectedSql: `OBJECT("my key" NUMBER(38, 0))`, expectedCanonical: `OBJECT("my key" NUMBER(38,0))`, expectedKeys: []string{`"my key"`}},
+		{input: "OBJECT(a OBJECT(b ARRAY(NUMBER)), c MAP(VARCHAR, NUMBER))", expectedSql: "OBJECT(a OBJECT(b ARRAY(NUMBER(38, 0))), c MAP(VARCHAR(16777216), NUMBER(38, 0)))", expectedCanonical: "OBJECT(a OBJECT(b ARRAY(NUMBER(38,0))), c MAP(VARCHAR(16777216), NUMBER(38,0)))", expectedKeys: []string{"a", "c"}},
+	}
+
+	negativeTestCases := []string{
+		"OBJECT(city)",
+		"OBJECT(city UNKNOWN)",
+		"OBJECT(city VARCHAR,)",
+		`OBJECT("city VARCHAR)`,
+		"OBJECT(city VARCHAR",
+		"OBJECTS",
+	}
+
+	for _, tc := range positiveTestCases {
+		t.Run(tc.input, func(t *testing.T) {
+			parsed, err := ParseDataType(tc.input)
+
+			require.NoError(t, err)
+			require.IsType(t, &ObjectDataType{}, parsed)
+
+			objectDataType := parsed.(*ObjectDataType)
+			assert.True(t, objectDataType.IsStructured())
+			assert.Equal(t, tc.expectedKeys, collections.Map(objectDataType.Fields(), func(f ObjectDataTypeField) string { return f.Key() }))
+
+			assert.Equal(t, ObjectLegacyDataType, parsed.ToLegacyDataTypeSql())
+			assert.Equal(t, tc.expectedSql, parsed.ToSql())
+			assert.Equal(t, tc.expectedCanonical, parsed.Canonical())
+		})
+	}
+
+	for _, input := range negativeTestCases {
+		t.Run(input, func(t *testing.T) {
+			parsed, err := ParseDataType(input)
+
+			require.Error(t, err)
+			require.Nil(t, parsed)
+		})
+	}
+}
+
 func Test_ParseDataType_Array(t *testing.T) {
 	type test struct {
 		input                  string
@@ -949,6 +1002,7 @@ func Test_ParseDataType_Array(t *testing.T) {
 			assert.Equal(t, ArrayLegacyDataType, parsed.ToLegacyDataTypeSql())
 			assert.Equal(t, tc.expectedUnderlyingType, parsed.ToSql())
 			assert.Equal(t, ArrayLegacyDataType, parsed.Canonical())
+			assert.False(t, parsed.(*ArrayDataType).IsStructured())
 		})
 	}
 
@@ -962,6 +1016,105 @@ func Test_ParseDataType_Array(t *testing.T) {
 	}
 }
 
+func Test_ParseDataType_StructuredArray(t *testing.T) {
+	type test struct {
+		input                  string
+		expectedSql            string
+		expectedCanonical      string
+		expectedElementNotNull bool
+	}
+
+	positiveTestCases := []test{
+		{input: "ARRAY(NUMBER)", expectedSql: "ARRAY(NUMBER(38, 0))", expectedCanonical: "ARRAY(NUMBER(38,0))"},
+		{input: "array(varchar(10))", expectedSql: "ARRAY(VARCHAR(10))", expectedCanonical: "ARRAY(VARCHAR(10))"},
+		{input: "  ARRAY( INT NOT NULL )  ", expectedSql: "ARRAY(INT NOT NULL)", expectedCanonical: "ARRAY(NUMBER(38,0) NOT NULL)", expectedElementNotNull: true},
+		{input: "ARRAY(ARRAY(TIMESTAMP_NTZ))", expectedSql: "ARRAY(ARRAY(TIMESTAMP_NTZ(9)))", expectedCanonical: "ARRAY(ARRAY(TIMESTAMP_NTZ(9)))"},
+		{input: "ARRAY(OBJECT(name VARCHAR, tags ARRAY(VARCHAR)))", expectedSql: "ARRAY(OBJECT(name VARCHAR(16777216), tags ARRAY(VARCHAR(16777216))))", expectedCanonical: "ARRAY(OBJECT(name VARCHAR(16777216), tags ARRAY(VARCHAR(16777216))))"},
+	}
+
+	negativeTestCases := []string{
+		"ARRAY(UNKNOWN)",
+		"ARRAY(NUMBER, VARCHAR)",
+		"ARRAY(NUMBER",
+		"ARRAYS",
+	}
+
+	for _, tc := range positiveTestCases {
+		t.Run(tc.input, func(t *testing.T) {
+			parsed, err := ParseDataType(tc.input)
+
+			require.NoError(t, err)
+			require.IsType(t, &ArrayDataType{}, parsed)
+
+			arrayDataType := parsed.(*ArrayDataType)
+			assert.True(t, arrayDataType.IsStructured())
+			assert.NotNil(t, arrayDataType.ElementType())
+			assert.Equal(t, tc.expectedElementNotNull, arrayDataType.ElementNotNull())
+
+			assert.Equal(t, ArrayLegacyDataType, parsed.ToLegacyDataTypeSql())
+			assert.Equal(t, tc.expectedSql, parsed.ToSql())
+			assert.Equal(t, tc.expectedCanonical, parsed.Canonical())
+		})
+	}
+
+	for _, input := range negativeTestCases {
+		t.Run(input, func(t *testing.T) {
+			parsed, err := ParseDataType(input)
+
+			require.Error(t, err)
+			require.Nil(t, parsed)
+		})
+	}
+}
+
+func Test_ParseDataType_Map(t *testing.T) {
+	type test struct {
+		input             string
+		expectedSql       string
+		expectedCanonical string
+	}
+
+	positiveTestCases := []test{
+		{input: "MAP(VARCHAR, NUMBER)", expectedSql: "MAP(VARCHAR(16777216), NUMBER(38, 0))", expectedCanonical: "MAP(VARCHAR(16777216), NUMBER(38,0))"},
+		{input: "map(number(10, 0), varchar(20))", expectedSql: "MAP(NUMBER(10, 0), VARCHAR(20))", expectedCanonical: "MAP(NUMBER(10,0), VARCHAR(20))"},
+		{input: "  MAP( VARCHAR , INT NOT NULL )  ", expectedSql: "MAP(VARCHAR(16777216), INT NOT NULL)", expectedCanonical: "MAP(VARCHAR(16777216), NUMBER(38,0) NOT NULL)"},
+		{input: "MAP(VARCHAR, MAP(NUMBER, ARRAY(OBJECT(a VARCHAR))))", expectedSql: "MAP(VARCHAR(16777216), MAP(NUMBER(38, 0), ARRAY(OBJECT(a VARCHAR(16777216)))))", expectedCanonical: "MAP(VARCHAR(16777216), MAP(NUMBER(38,0), ARRAY(OBJECT(a VARCHAR(16777216)))))"},
+	}
+
+	negativeTestCases := []string{
+		"MAP",
+		"MAP()",
+		"MAP(VARCHAR)",
+		"MAP(VARCHAR, NUMBER, NUMBER)",
+		"MAP(VARIANT, NUMBER)",
+		"MAP(VARCHAR NOT NULL, NUMBER)",
+		"MAP(VARCHAR, UNKNOWN)",
+		"M A P(VARCHAR, NUMBER)",
+	}
+
+	for _, tc := range positiveTestCases {
+		t.Run(tc.input, func(t *testing.T) {
+			parsed, err := ParseDataType(tc.input)
+
+			require.NoError(t, err)
+			require.IsType(t, &MapDataType{}, parsed)
+
+			assert.Equal(t, MapLegacyDataType, parsed.ToLegacyDataTypeSql())
+			assert.Equal(t, tc.expectedSql, parsed.ToSql())
+			assert.Equal(t, tc.expectedCanonical, parsed.Canonical())
+		})
+	}
+
+	for _, input := range negativeTestCases {
+		t.Run(input, func(t *testing.T) {
+			parsed, err := ParseDataType(input)
+
+			require.Error(t, err)
+			require.Nil(t, parsed)
+		})
+	}
+}
+
 func Test_ParseDataType_Geography(t *testing.T) {
 	type test struct {
 		input                  string
@@ -1305,6 +1458,20 @@ func Test_AreTheSame(t *testing.T) {
 		{d1: "TABLE(A NUMBER(24,2), B VARCHAR)", d2: "TABLE(A NUMBER(24,2), B VARCHAR)", expectedOutcome: true},
 		{d1: "TABLE(A NUMBER(24,2), B VARCHAR)", d2: "TABLE(A NUMBER(38,0), B VARCHAR)", expectedOutcome: false},
 		{d1: "TABLE(A NUMBER(24,2), B VARCHAR(100))", d2: "TABLE(A NUMBER(24,2), B VARCHAR(200))", expectedOutcome: false},
+		{d1: "ARRAY", d2: "ARRAY", expectedOutcome: true},
+		{d1: "ARRAY", d2: "ARRAY(NUMBER)", expectedOutcome: false},
+		{d1: "ARRAY(NUMBER)", d2: "ARRAY(NUMBER(38, 0))", expectedOutcome: true},
+		{d1: "ARRAY(NUMBER)", d2: "ARRAY(VARCHAR)", expectedOutcome: false},
+		{d1: "ARRAY(NUMBER)", d2: "ARRAY(NUMBER NOT NULL)", expectedOutcome: false},
+		{d1: "OBJECT", d2: "OBJECT(a NUMBER)", expectedOutcome: false},
+		{d1: "OBJECT(a NUMBER, b VARCHAR)", d2: "OBJECT(a NUMBER(38,0), b VARCHAR(16777216))", expectedOutcome: true},
+		{d1: "OBJECT(a NUMBER)", d2: "OBJECT(A NUMBER)", expectedOutcome: false},
+		{d1: "OBJECT(a NUMBER)", d2: "OBJECT(a NUMBER, b VARCHAR)", expectedOutcome: false},
+		{d1: "OBJECT(a ARRAY(NUMBER))", d2: "OBJECT(a ARRAY(VARCHAR))", expectedOutcome: false},
+		{d1: "MAP(VARCHAR, NUMBER)", d2: "MAP(VARCHAR(16777216), NUMBER(38, 0))", expectedOutcome: true},
+		{d1: "MAP(VARCHAR, NUMBER)", d2: "MAP(NUMBER, NUMBER)", expectedOutcome: false},
+		{d1: "MAP(VARCHAR, NUMBER)", d2: "MAP(VARCHAR, NUMBER NOT NULL)", expectedOutcome: false},
+		{d1: "MAP(VARCHAR, NUMBER)", d2: "OBJECT(a NUMBER)", expectedOutcome: false},
 	}
 
 	for _, tc := range testCases {
@@ -1398,6 +1565,18 @@ func Test_AreDefinitelyDifferent(t *testing.T) {
 		{d1: "TABLE(A NUMBER(24,2), B VARCHAR)", d2: "TABLE(A NUMBER(24,2), B VARCHAR)", expectedOutcome: false},
 		{d1: "TABLE(A NUMBER(24,2), B VARCHAR)", d2: "TABLE(A NUMBER(38,0), B VARCHAR)", expectedOutcome: true},
 		{d1: "TABLE(A NUMBER(24,2), B VARCHAR(100))", d2: "TABLE(A NUMBER(24,2), B VARCHAR(200))", expectedOutcome: true},
+		{d1: "ARRAY", d2: "ARRAY", expectedOutcome: false},
+		{d1: "ARRAY", d2: "ARRAY(NUMBER)", expectedOutcome: true},
+		{d1: "ARRAY(NUMBER)", d2: "ARRAY(NUMBER(20, 2))", expectedOutcome: false},
+		{d1: "ARRAY(NUMBER(20, 1))", d2: "ARRAY(NUMBER(20, 2))", expectedOutcome: true},
+		{d1: "ARRAY(NUMBER)", d2: "ARRAY(NUMBER NOT NULL)", expectedOutcome: true},
+		{d1: "OBJECT", d2: "OBJECT(a NUMBER)", expectedOutcome: true},
+		{d1: "OBJECT(a NUMBER, b VARCHAR)", d2: "OBJECT(a NUMBER(38,0), b VARCHAR(100))", expectedOutcome: false},
+		{d1: "OBJECT(a NUMBER)", d2: "OBJECT(A NUMBER)", expectedOutcome: true},
+		{d1: "OBJECT(a NUMBER)", d2: "OBJECT(a VARCHAR)", expectedOutcome: true},
+		{d1: "MAP(VARCHAR, NUMBER)", d2: "MAP(VARCHAR(100), NUMBER(20, 2))", expectedOutcome: false},
+		{d1: "MAP(VARCHAR, NUMBER)", d2: "MAP(NUMBER, NUMBER)", expectedOutcome: true},
+		{d1: "MAP(VARCHAR, NUMBER)", d2: "MAP(VARCHAR, NUMBER NOT NULL)", expectedOutcome: true},
 	}
 
 	for _, tc := range testCases {
@@ -1461,6 +1640,13 @@ func Test_ToSqlWithoutUnknowns(t *testing.T) {
 		{dt: "TABLE()"},
 		{dt: "TABLE(A NUMBER)"},
 		{dt: "TABLE(A NUMBER, B INT, C VARCHAR)"},
+		{dt: "ARRAY"},
+		{dt: "ARRAY(NUMBER)"},
+		{dt: "ARRAY(VARCHAR(10) NOT NULL)"},
+		{dt: "OBJECT"},
+		{dt: "OBJECT(a NUMBER(20), b ARRAY(VARCHAR) NOT NULL)"},
+		{dt: "MAP(VARCHAR, NUMBER(20, 4))"},
+		{dt: "MAP(NUMBER, OBJECT(a TIMESTAMPNTZ) NOT NULL)"},
 	}
 
 	for _, tc := range testCases {
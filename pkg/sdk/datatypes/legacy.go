@@ -20,4 +20,6 @@ const (
 	TableLegacyDataType = "TABLE"
 	// DecfloatLegacyDataType was not a value of legacy data type in the old implementation. Left for now for an easier implementation.
 	DecfloatLegacyDataType = "DECFLOAT"
+	// MapLegacyDataType was not a value of legacy data type in the old implementation. Left for now for an easier implementation.
+	MapLegacyDataType = "MAP"
 )
@@ -0,0 +1,92 @@
+package datatypes
+
+import (
+	"fmt"
+)
+
+// MapDataType is based on https://docs.snowflake.com/en/sql-reference/data-types-structured#specifying-a-map-type
+// It does not have synonyms. It does have key type and value type required attributes; the value type can be marked as NOT NULL.
+// The key type has to be either a text or a number data type.
+type MapDataType struct {
+	keyType        DataType
+	valueType      DataType
+	valueNotNull   bool
+	underlyingType string
+}
+
+func (t *MapDataType) ToSql() string {
+	return t.format(t.underlyingType, func(dt DataType) string { return dt.ToSql() })
+}
+
+// ToLegacyDataTypeSql for map returns the base type only, similarly to the other structured types.
+func (t *MapDataType) ToLegacyDataTypeSql() string {
+	return MapLegacyDataType
+}
+
+func (t *MapDataType) Canonical() string {
+	return t.format(MapLegacyDataType, func(dt DataType) string { return dt.Canonical() })
+}
+
+func (t *MapDataType) ToSqlWithoutUnknowns() string {
+	return t.format(t.underlyingType, func(dt DataType) string { return dt.ToSqlWithoutUnknowns() })
+}
+
+func (t *MapDataType) format(mapType string, formatType func(DataType) string) string {
+	return fmt.Sprintf("%s(%s, %s)", mapType, formatType(t.keyType), formatStructuredTypeElement(t.valueType, t.valueNotNull, formatType))
+}
+
+func (t *MapDataType) KeyType() DataType {
+	return t.keyType
+}
+
+func (t *MapDataType) ValueType() DataType {
+	return t.valueType
+}
+
+func (t *MapDataType) ValueNotNull() bool {
+	return t.valueNotNull
+}
+
+var MapDataTypeSynonyms = []string{MapLegacyDataType}
+
+func parseMapDataTypeRaw(raw sanitizedDataTypeRaw) (*MapDataType, error) {
+	format := "MAP(key_type, value_type [NOT NULL])"
+	args, structured, err := structuredTypeArguments(raw, format)
+	if err != nil {
+		return nil, err
+	}
+	if !structured {
+		return nil, fmt.Errorf(`map %s could not be parsed, key and value types are required; use "%s" format`, raw.raw, format)
+	}
+	parts := splitColumnDefs(args)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf(`map cannot have %d arguments: "%s"; use "%s" format`, len(parts), args, format)
+	}
+	keyType, err := ParseDataType(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the map's key type: %w", err)
+	}
+	switch keyType.(type) {
+	case *TextDataType, *NumberDataType:
+	default:
+		return nil, fmt.Errorf(`map's key type has to be a text or a number data type, got: "%s"`, keyType.ToSql())
+	}
+	valueType, valueNotNull, err := parseStructuredTypeElement(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the map's value type: %w", err)
+	}
+	return &MapDataType{
+		keyType:        keyType,
+		valueType:      valueType,
+		valueNotNull:   valueNotNull,
+		underlyingType: raw.matchedByType,
+	}, nil
+}
+
+func areMapDataTypesTheSame(a, b *MapDataType) bool {
+	return a.valueNotNull == b.valueNotNull && AreTheSame(a.keyType, b.keyType) && AreTheSame(a.valueType, b.valueType)
+}
+
+func areMapDataTypesDefinitelyDifferent(a, b *MapDataType) bool {
+	return a.valueNotNull != b.valueNotNull || AreDefinitelyDifferent(a.keyType, b.keyType) || AreDefinitelyDifferent(a.valueType, b.valueType)
+}
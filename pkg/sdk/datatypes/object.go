@@ -1,13 +1,42 @@
 package datatypes
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
 // ObjectDataType is based on https://docs.snowflake.com/en/sql-reference/data-types-semistructured#object
-// It does not have synonyms. It does not have any attributes.
+// and https://docs.snowflake.com/en/sql-reference/data-types-structured#specifying-a-structured-object-type.
+// It does not have synonyms.
+// The semi-structured object does not have any attributes. The structured object consists of a non-empty list of fields;
+// each field has a key (case-sensitive) and a value type that can be marked as NOT NULL.
 type ObjectDataType struct {
+	fields         []ObjectDataTypeField
 	underlyingType string
 }
 
+type ObjectDataTypeField struct {
+	key      string
+	dataType DataType
+	notNull  bool
+}
+
+func (f *ObjectDataTypeField) Key() string {
+	return f.key
+}
+
+func (f *ObjectDataTypeField) FieldType() DataType {
+	return f.dataType
+}
+
+func (f *ObjectDataTypeField) NotNull() bool {
+	return f.notNull
+}
+
 func (t *ObjectDataType) ToSql() string {
-	return t.underlyingType
+	return t.format(t.underlyingType, func(dt DataType) string { return dt.ToSql() })
 }
 
 func (t *ObjectDataType) ToLegacyDataTypeSql() string {
@@ -15,15 +44,106 @@ func (t *ObjectDataType) ToLegacyDataTypeSql() string {
 }
 
 func (t *ObjectDataType) Canonical() string {
-	return ObjectLegacyDataType
+	return t.format(ObjectLegacyDataType, func(dt DataType) string { return dt.Canonical() })
 }
 
 func (t *ObjectDataType) ToSqlWithoutUnknowns() string {
-	return t.ToSql()
+	return t.format(t.underlyingType, func(dt DataType) string { return dt.ToSqlWithoutUnknowns() })
+}
+
+func (t *ObjectDataType) format(objectType string, formatType func(DataType) string) string {
+	if !t.IsStructured() {
+		return objectType
+	}
+	fields := strings.Join(collections.Map(t.fields, func(f ObjectDataTypeField) string {
+		return fmt.Sprintf("%s %s", f.key, formatStructuredTypeElement(f.dataType, f.notNull, formatType))
+	}), ", ")
+	return fmt.Sprintf("%s(%s)", objectType, fields)
+}
+
+// IsStructured returns true for the objects with the fields specified.
+func (t *ObjectDataType) IsStructured() bool {
+	return len(t.fields) > 0
+}
+
+func (t *ObjectDataType) Fields() []ObjectDataTypeField {
+	return t.fields
 }
 
 var ObjectDataTypeSynonyms = []string{ObjectLegacyDataType}
 
 func parseObjectDataTypeRaw(raw sanitizedDataTypeRaw) (*ObjectDataType, error) {
-	return &ObjectDataType{raw.matchedByType}, nil
+	args, structured, err := structuredTypeArguments(raw, "OBJECT(key value_type [NOT NULL], ...)")
+	if err != nil {
+		return nil, err
+	}
+	if !structured {
+		return &ObjectDataType{underlyingType: raw.matchedByType}, nil
+	}
+	fields, err := collections.MapErr(splitColumnDefs(args), parseObjectDataTypeField)
+	if err != nil {
+		return nil, err
+	}
+	return &ObjectDataType{
+		fields:         fields,
+		underlyingType: raw.matchedByType,
+	}, nil
+}
+
+// parseObjectDataTypeField parses the "<key> <value_type> [ NOT NULL ]" field; the key can be a double-quoted string containing spaces.
+func parseObjectDataTypeField(raw string) (ObjectDataTypeField, error) {
+	trimmed := strings.TrimSpace(raw)
+	var keyEnd int
+	if strings.HasPrefix(trimmed, `"`) {
+		closingQuoteIdx := strings.Index(trimmed[1:], `"`)
+		if closingQuoteIdx == -1 {
+			return ObjectDataTypeField{}, fmt.Errorf("could not parse object field: %s, the key is missing the closing double quote", raw)
+		}
+		keyEnd = closingQuoteIdx + 2
+	} else {
+		keyEnd = strings.IndexAny(trimmed, " \t\n")
+	}
+	if keyEnd <= 0 || keyEnd >= len(trimmed) {
+		return ObjectDataTypeField{}, fmt.Errorf("could not parse object field: %s, it should contain the following format `<key> <value_type>`", raw)
+	}
+	dataType, notNull, err := parseStructuredTypeElement(trimmed[keyEnd:])
+	if err != nil {
+		return ObjectDataTypeField{}, fmt.Errorf("could not parse the value type of the object field %s: %w", trimmed[:keyEnd], err)
+	}
+	return ObjectDataTypeField{
+		key:      trimmed[:keyEnd],
+		dataType: dataType,
+		notNull:  notNull,
+	}, nil
+}
+
+// areObjectDataTypesTheSame compares fields of the structured objects; semi-structured objects are different from structured ones.
+func areObjectDataTypesTheSame(a, b *ObjectDataType) bool {
+	if len(a.fields) != len(b.fields) {
+		return false
+	}
+	for i := range a.fields {
+		aField, bField := a.fields[i], b.fields[i]
+		if aField.key != bField.key || aField.notNull != bField.notNull || !AreTheSame(aField.dataType, bField.dataType) {
+			return false
+		}
+	}
+	return true
+}
+
+// objects are different if:
+// - they have different numbers of fields (including semi-structured objects without any fields)
+// - key or NOT NULL differs for at least one field
+// - value type is definitely different for at least one field
+func areObjectDataTypesDefinitelyDifferent(a, b *ObjectDataType) bool {
+	if len(a.fields) != len(b.fields) {
+		return true
+	}
+	for i := range a.fields {
+		aField, bField := a.fields[i], b.fields[i]
+		if aField.key != bField.key || aField.notNull != bField.notNull || AreDefinitelyDifferent(aField.dataType, bField.dataType) {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,49 @@
+package datatypes
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Structured types are based on https://docs.snowflake.com/en/sql-reference/data-types-structured.
+// The elements of the structured ARRAY, the values of the structured OBJECT, and the values of MAP can be marked as NOT NULL.
+
+const structuredTypeNotNullSuffix = " NOT NULL"
+
+// structuredTypeArguments returns the content of the parentheses following the matched type, e.g. "NUMBER, VARCHAR" for "MAP(NUMBER, VARCHAR)".
+// It returns false if the matched type is not followed by any arguments (a semi-structured type).
+func structuredTypeArguments(raw sanitizedDataTypeRaw, format string) (string, bool, error) {
+	r := strings.TrimSpace(raw.raw[len(raw.matchedByType):])
+	if r == "" {
+		return "", false, nil
+	}
+	if !strings.HasPrefix(r, "(") || !strings.HasSuffix(r, ")") {
+		return "", false, fmt.Errorf(`%s could not be parsed, use "%s" format`, raw.raw, format)
+	}
+	args := strings.TrimSpace(r[1 : len(r)-1])
+	if args == "" {
+		return "", false, fmt.Errorf(`%s could not be parsed, arguments can't be empty; use "%s" format`, raw.raw, format)
+	}
+	return args, true, nil
+}
+
+// parseStructuredTypeElement parses the "<type> [ NOT NULL ]" part of the structured type.
+func parseStructuredTypeElement(raw string) (DataType, bool, error) {
+	trimmed := strings.TrimSpace(raw)
+	notNull := strings.HasSuffix(strings.ToUpper(trimmed), structuredTypeNotNullSuffix)
+	if notNull {
+		trimmed = strings.TrimSpace(trimmed[:len(trimmed)-len(structuredTypeNotNullSuffix)])
+	}
+	dataType, err := ParseDataType(trimmed)
+	if err != nil {
+		return nil, false, err
+	}
+	return dataType, notNull, nil
+}
+
+func formatStructuredTypeElement(dataType DataType, notNull bool, formatType func(DataType) string) string {
+	if notNull {
+		return formatType(dataType) + structuredTypeNotNullSuffix
+	}
+	return formatType(dataType)
+}
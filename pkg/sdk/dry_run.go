@@ -0,0 +1,77 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// NewDryRunClient returns the client that records the statements with the given recorder without running them in Snowflake.
+// It does not connect to Snowflake at all: the statements run with exec succeed, and the queries return no rows
+// (so e.g. ShowByID returns ErrObjectNotFound and the context functions fail with sql.ErrNoRows).
+// It is meant for rendering the SQL of the SDK operations offline, e.g.:
+//
+//	recorder := sdk.NewInMemoryStatementRecorder()
+//	client := sdk.NewDryRunClient(recorder)
+//	_ = client.Databases.Create(ctx, sdk.NewCreateDatabaseRequest(id).WithComment("comment"))
+//	// recorder.Statements() contains CREATE DATABASE "<name>" COMMENT = 'comment'
+func NewDryRunClient(recorder StatementRecorder) *Client {
+	// the driver name is used by sqlx only to choose the bind variables type
+	db := sqlx.NewDb(sql.OpenDB(dryRunConnector{}), "snowflake")
+	client := &Client{
+		db:                db.Unsafe(),
+		statementRecorder: recorder,
+		dryRun:            true,
+	}
+	client.initialize()
+	return client
+}
+
+// SetDryRun enables (or disables) the dry-run mode of the client. In the dry-run mode, the statements run with exec
+// (i.e., the statements changing the objects, like CREATE, ALTER, DROP, or GRANT) are only recorded with the statement recorder,
+// and they are not run in Snowflake. The queries (e.g., SHOW or DESCRIBE) are still run, so the client reads the current state of the objects.
+func (c *Client) SetDryRun(dryRun bool) {
+	c.dryRun = dryRun
+}
+
+var (
+	_ driver.Connector      = dryRunConnector{}
+	_ driver.ExecerContext  = dryRunConn{}
+	_ driver.QueryerContext = dryRunConn{}
+)
+
+type dryRunConnector struct{}
+
+func (c dryRunConnector) Connect(context.Context) (driver.Conn, error) { return dryRunConn{}, nil }
+func (c dryRunConnector) Driver() driver.Driver                        { return c }
+func (c dryRunConnector) Open(string) (driver.Conn, error)             { return dryRunConn{}, nil }
+
+type dryRunConn struct{}
+
+func (c dryRunConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported by the dry-run client")
+}
+
+func (c dryRunConn) Close() error { return nil }
+
+func (c dryRunConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported by the dry-run client")
+}
+
+func (c dryRunConn) ExecContext(context.Context, string, []driver.NamedValue) (driver.Result, error) {
+	return driver.RowsAffected(0), nil
+}
+
+func (c dryRunConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	return dryRunRows{}, nil
+}
+
+type dryRunRows struct{}
+
+func (r dryRunRows) Columns() []string         { return []string{} }
+func (r dryRunRows) Close() error              { return nil }
+func (r dryRunRows) Next([]driver.Value) error { return io.EOF }
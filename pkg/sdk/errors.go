@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/snowflakedb/gosnowflake/v2"
 )
 
 var (
@@ -25,6 +26,12 @@ var (
 	ErrGrantPartiallyExecuted                   = NewError("grant partially executed")
 	ErrPatNotFound                              = NewError("programmatic access token not found")
 	ErrTableNotClustered                        = NewError("table is not clustered")
+	ErrInsufficientPrivileges                   = NewError("insufficient privileges")
+	ErrObjectAlreadyExists                      = NewError("object already exists")
+	ErrConcurrentDdl                            = NewError("object is locked by a concurrent operation")
+	ErrTransient                                = NewError("transient snowflake failure")
+	ErrConnectionFailure                        = NewError("could not reach snowflake")
+	ErrReplicationGroupRefreshInProgress        = NewError("replication group is being refreshed")
 
 	// snowflake-sdk errors.
 	ErrInvalidObjectIdentifier = NewError("invalid object identifier")
@@ -104,30 +111,140 @@ var errorRegexes = map[*regexp.Regexp]error{
 	regexp.MustCompile(`Any policy of kind [a-zA-z_]+ is not attached to ACCOUNT`): ErrPolicyNotAttachedToAccount,
 }
 
-func decodeDriverError(err error) error {
-	if err == nil {
-		return nil
+// Snowflake error codes (SnowflakeError.Number) used for error classification.
+// Codes are more stable than messages, so they take precedence over the message matching in decodeDriverError.
+const (
+	errorCodeSqlExecutionInternalError                = 603
+	errorCodeStatementAborted                         = 604
+	errorCodeLockWaitersLimitExceeded                 = 625
+	errorCodeStatementTimeout                         = 630
+	errorCodeObjectAlreadyExists                      = 2002
+	errorCodeObjectDoesNotExistOrNotAuthorized        = 2003
+	errorCodeObjectDoesNotExistOrOperationNotPossible = 2043
+	errorCodeInsufficientPrivileges                   = 3001
+	errorCodeReplicationGroupAlreadyRefreshing        = 3101
+	errorCodeReplicationGroupPromotionDuringRefresh   = 3122
+	errorCodeDriverServiceUnavailable                 = 260007
+	errorCodeDriverFailedToPostQuery                  = 261000
+)
+
+var errorCodes = map[int]error{
+	errorCodeSqlExecutionInternalError:                ErrTransient,
+	errorCodeStatementAborted:                         ErrTransient,
+	errorCodeLockWaitersLimitExceeded:                 ErrConcurrentDdl,
+	errorCodeStatementTimeout:                         ErrTransient,
+	errorCodeObjectAlreadyExists:                      ErrObjectAlreadyExists,
+	errorCodeObjectDoesNotExistOrNotAuthorized:        ErrObjectNotExistOrAuthorized,
+	errorCodeObjectDoesNotExistOrOperationNotPossible: ErrDoesNotExistOrOperationCannotBePerformed,
+	errorCodeInsufficientPrivileges:                   ErrInsufficientPrivileges,
+	errorCodeReplicationGroupAlreadyRefreshing:        ErrReplicationGroupRefreshInProgress,
+	errorCodeReplicationGroupPromotionDuringRefresh:   ErrReplicationGroupRefreshInProgress,
+	errorCodeDriverServiceUnavailable:                 ErrConnectionFailure,
+	errorCodeDriverFailedToPostQuery:                  ErrConnectionFailure,
+}
+
+var errorMessages = map[string]error{
+	"Object does not exist, or operation cannot be performed": ErrDoesNotExistOrOperationCannotBePerformed,
+	"does not exist or not authorized":                        ErrObjectNotExistOrAuthorized,
+	"account is empty":                                        ErrAccountIsEmpty,
+	"Grant partially executed":                                ErrGrantPartiallyExecuted,
+	"is not clustered":                                        ErrTableNotClustered,
+}
+
+// SnowflakeError is returned by the client for every error reported by the driver.
+// It keeps the details of the original gosnowflake.SnowflakeError (error code, SQLSTATE, and query ID),
+// which allows looking the failed statement up in QUERY_HISTORY. It also matches (with errors.Is)
+// the predefined error it was classified as, e.g., ErrObjectNotExistOrAuthorized or ErrInsufficientPrivileges.
+type SnowflakeError struct {
+	Number   int
+	SQLState string
+	QueryID  string
+	Message  string
+
+	classifiedAs error
+	err          *gosnowflake.SnowflakeError
+}
+
+func (e *SnowflakeError) Error() string {
+	builder := new(strings.Builder)
+	if e.classifiedAs != nil {
+		builder.WriteString(e.classifiedAs.Error() + ": ")
 	}
-	log.Printf("[DEBUG] err: %v", err)
-	m := map[string]error{
-		"Object does not exist, or operation cannot be performed": ErrDoesNotExistOrOperationCannotBePerformed,
-		"does not exist or not authorized":                        ErrObjectNotExistOrAuthorized,
-		"account is empty":                                        ErrAccountIsEmpty,
-		"Grant partially executed":                                ErrGrantPartiallyExecuted,
-		"is not clustered":                                        ErrTableNotClustered,
+	builder.WriteString(e.err.Error())
+	if e.QueryID != "" {
+		builder.WriteString(fmt.Sprintf(" (query ID: %s)", e.QueryID))
+	}
+	return builder.String()
+}
+
+func (e *SnowflakeError) Unwrap() error {
+	return e.err
+}
+
+func (e *SnowflakeError) Is(target error) bool {
+	return e.classifiedAs != nil && e.classifiedAs == target
+}
+
+// ClassifiedAs returns the predefined error this error was classified as or nil if it was not recognized.
+func (e *SnowflakeError) ClassifiedAs() error {
+	return e.classifiedAs
+}
+
+func newSnowflakeError(err *gosnowflake.SnowflakeError) *SnowflakeError {
+	classifiedAs, ok := errorCodes[err.Number]
+	if !ok {
+		classifiedAs = classifyErrorMessage(err.Error())
 	}
-	for k, v := range m {
-		if strings.Contains(err.Error(), k) {
+	return &SnowflakeError{
+		Number:       err.Number,
+		SQLState:     err.SQLState,
+		QueryID:      err.QueryID,
+		Message:      err.Error(),
+		classifiedAs: classifiedAs,
+		err:          err,
+	}
+}
+
+// AsSnowflakeError returns the SnowflakeError from the err chain, if present.
+func AsSnowflakeError(err error) (*SnowflakeError, bool) {
+	var snowflakeErr *SnowflakeError
+	if errors.As(err, &snowflakeErr) {
+		return snowflakeErr, true
+	}
+	return nil, false
+}
+
+func classifyErrorMessage(message string) error {
+	for k, v := range errorMessages {
+		if strings.Contains(message, k) {
 			return v
 		}
 	}
 
 	for regex, v := range errorRegexes {
-		if regex.MatchString(err.Error()) {
+		if regex.MatchString(message) {
 			return v
 		}
 	}
 
+	return nil
+}
+
+func decodeDriverError(err error) error {
+	if err == nil {
+		return nil
+	}
+	log.Printf("[DEBUG] err: %v", err)
+
+	var driverErr *gosnowflake.SnowflakeError
+	if errors.As(err, &driverErr) {
+		return newSnowflakeError(driverErr)
+	}
+
+	if classifiedAs := classifyErrorMessage(err.Error()); classifiedAs != nil {
+		return classifiedAs
+	}
+
 	return err
 }
 
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/snowflakedb/gosnowflake/v2"
 	"github.com/stretchr/testify/require"
 )
 
@@ -191,3 +192,96 @@ func TestDecodeDriverError(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeDriverError_SnowflakeError(t *testing.T) {
+	driverError := func(number int, sqlState string, message string) *gosnowflake.SnowflakeError {
+		return &gosnowflake.SnowflakeError{
+			Number:   number,
+			SQLState: sqlState,
+			QueryID:  "01bc2b3a-0000-1a2b-0000-000123456789",
+			Message:  message,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		input        *gosnowflake.SnowflakeError
+		classifiedAs error
+	}{
+		{
+			name:         "classify by code: object does not exist or not authorized",
+			input:        driverError(2003, "02000", "SQL compilation error:\nDatabase 'ABC' does not exist or not authorized."),
+			classifiedAs: ErrObjectNotExistOrAuthorized,
+		},
+		{
+			name:         "classify by code: object does not exist, or operation cannot be performed",
+			input:        driverError(2043, "02000", "SQL compilation error:\nObject does not exist, or operation cannot be performed."),
+			classifiedAs: ErrDoesNotExistOrOperationCannotBePerformed,
+		},
+		{
+			name:         "classify by code: insufficient privileges",
+			input:        driverError(3001, "42501", "SQL access control error:\nInsufficient privileges to operate on schema 'ABC'"),
+			classifiedAs: ErrInsufficientPrivileges,
+		},
+		{
+			name:         "classify by code: already exists",
+			input:        driverError(2002, "42710", "SQL compilation error:\nObject 'ABC' already exists."),
+			classifiedAs: ErrObjectAlreadyExists,
+		},
+		{
+			name:         "classify by code: concurrent ddl",
+			input:        driverError(625, "57014", "Statement '01bc' has locked table 'ABC' in transaction 123 and this lock has not yet been released."),
+			classifiedAs: ErrConcurrentDdl,
+		},
+		{
+			name:         "classify by code: transient",
+			input:        driverError(630, "57014", "Statement reached its statement or warehouse timeout of 5 second(s) and was canceled."),
+			classifiedAs: ErrTransient,
+		},
+		{
+			name:         "classify by code: statement aborted",
+			input:        driverError(604, "57014", "Statement '01bc' was aborted."),
+			classifiedAs: ErrTransient,
+		},
+		{
+			name:         "classify by code: replication group already being refreshed",
+			input:        driverError(3101, "55000", "Replication group \"ABC\" is already being refreshed. Only one refresh statement can execute at a time."),
+			classifiedAs: ErrReplicationGroupRefreshInProgress,
+		},
+		{
+			name:         "classify by code: replication group promoted while being refreshed",
+			input:        driverError(3122, "55000", "Replication group \"ABC\" cannot currently be set as primary because it is being refreshed. Either wait for the refresh to finish or cancel the refresh and try again."),
+			classifiedAs: ErrReplicationGroupRefreshInProgress,
+		},
+		{
+			name:         "classify by message: unknown code",
+			input:        driverError(1, "22000", "Programmatic access token ABC not found"),
+			classifiedAs: ErrPatNotFound,
+		},
+		{
+			name:  "unclassified",
+			input: driverError(1003, "42000", "SQL compilation error:\nsyntax error line 1 at position 0 unexpected 'ABC'."),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := decodeDriverError(fmt.Errorf("wrapped: %w", tt.input))
+
+			snowflakeErr, ok := AsSnowflakeError(got)
+			require.True(t, ok)
+			require.Equal(t, tt.input.Number, snowflakeErr.Number)
+			require.Equal(t, tt.input.SQLState, snowflakeErr.SQLState)
+			require.Equal(t, tt.input.QueryID, snowflakeErr.QueryID)
+			require.Equal(t, tt.input.Error(), snowflakeErr.Message)
+			require.Equal(t, tt.classifiedAs, snowflakeErr.ClassifiedAs())
+			require.ErrorIs(t, got, tt.input)
+			require.Contains(t, got.Error(), tt.input.Error())
+			require.Contains(t, got.Error(), fmt.Sprintf("query ID: %s", tt.input.QueryID))
+			if tt.classifiedAs != nil {
+				require.ErrorIs(t, got, tt.classifiedAs)
+				require.Contains(t, got.Error(), tt.classifiedAs.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package sdk
+
+import "context"
+
+// ShowParameters returns the parameters visible at the TABLE level for the given event table.
+func (v *eventTables) ShowParameters(ctx context.Context, id SchemaObjectIdentifier) ([]*Parameter, error) {
+	return v.client.Parameters.ShowParameters(ctx, &ShowParametersOptions{
+		In: &ParametersIn{
+			Table: id,
+		},
+	})
+}
@@ -47,15 +47,15 @@ func (v *eventTables) ShowByIDSafely(ctx context.Context, id SchemaObjectIdentif
 	return SafeShowById(v.client, v.ShowByID, ctx, id)
 }
 
-func (v *eventTables) Describe(ctx context.Context, id SchemaObjectIdentifier) (*EventTableDetails, error) {
+func (v *eventTables) Describe(ctx context.Context, id SchemaObjectIdentifier) ([]EventTableDetails, error) {
 	opts := &DescribeEventTableOptions{
 		name: id,
 	}
-	result, err := validateAndQueryOne[eventTableDetailsRow](v.client, ctx, opts)
+	rows, err := validateAndQuery[eventTableDetailsRow](v.client, ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	return conversionErrorWrapped(result.convert())
+	return convertRows[eventTableDetailsRow, EventTableDetails](rows)
 }
 
 func (v *eventTables) Drop(ctx context.Context, request *DropEventTableRequest) error {
@@ -124,6 +124,7 @@ func (r *DescribeEventTableRequest) toOpts() *DescribeEventTableOptions {
 func (r eventTableDetailsRow) convert() (*EventTableDetails, error) {
 	result := &EventTableDetails{
 		Name:    r.Name,
+		Type:    r.Type,
 		Kind:    r.Kind,
 		Comment: r.Comment,
 	}
@@ -0,0 +1,128 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// fakeDriver is a database/sql driver that records the executed statements and fails them with the injected errors.
+// Every statement consumes one injected error (if any are left); the statements run after that succeed.
+// Queries return a single row with a single "value" column.
+// The connection attempts (e.g., the login) fail with the injected connectFailures in the same way.
+type fakeDriver struct {
+	mu              sync.Mutex
+	statements      []string
+	failures        []error
+	connectAttempts int
+	connectFailures []error
+}
+
+func newFakeDriver(failures ...error) *fakeDriver {
+	return &fakeDriver{failures: failures}
+}
+
+// newFakeClient returns the client running its statements against the given driver.
+func newFakeClient(t *testing.T, fakeDriver *fakeDriver) *Client {
+	t.Helper()
+	db := sqlx.NewDb(sql.OpenDB(fakeDriver), "snowflake")
+	t.Cleanup(func() { _ = db.Close() })
+	client := &Client{db: db.Unsafe()}
+	client.initialize()
+	return client
+}
+
+// withConnectFailures injects the errors returned by the consecutive connection attempts.
+func (d *fakeDriver) withConnectFailures(failures ...error) *fakeDriver {
+	d.connectFailures = failures
+	return d
+}
+
+func (d *fakeDriver) ConnectAttempts() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.connectAttempts
+}
+
+func (d *fakeDriver) Statements() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return append([]string{}, d.statements...)
+}
+
+func (d *fakeDriver) run(statement string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.statements = append(d.statements, statement)
+	if len(d.failures) > 0 {
+		err := d.failures[0]
+		d.failures = d.failures[1:]
+		return err
+	}
+	return nil
+}
+
+func (d *fakeDriver) Connect(context.Context) (driver.Conn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.connectAttempts++
+	if len(d.connectFailures) > 0 {
+		err := d.connectFailures[0]
+		d.connectFailures = d.connectFailures[1:]
+		return nil, err
+	}
+	return &fakeConn{driver: d}, nil
+}
+
+func (d *fakeDriver) Driver() driver.Driver            { return d }
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return d.Connect(context.Background()) }
+
+type fakeConn struct {
+	driver *fakeDriver
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepared statements are not supported by the fake driver")
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported by the fake driver")
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	if err := c.driver.run(query); err != nil {
+		return nil, err
+	}
+	return driver.RowsAffected(0), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	if err := c.driver.run(query); err != nil {
+		return nil, err
+	}
+	return &fakeRows{values: []string{"value"}}, nil
+}
+
+type fakeRows struct {
+	values []string
+}
+
+func (r *fakeRows) Columns() []string { return []string{"value"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	dest[0] = r.values[0]
+	r.values = r.values[1:]
+	return nil
+}
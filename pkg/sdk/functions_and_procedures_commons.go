@@ -89,10 +89,13 @@ func parseFunctionOrProcedureSignature(signature string) ([]NormalizedArgument,
 	if !strings.HasPrefix(trimmed, "(") || !strings.HasSuffix(trimmed, ")") {
 		return normalizedArguments, fmt.Errorf("could not parse signature from Snowflake: %s, wrapping parentheses not found", trimmed)
 	}
-	raw := trimmed[1 : len(trimmed)-1]
-	args := strings.SplitSeq(raw, ",")
+	// arguments are split respecting the parentheses, so the data types with attributes (e.g. NUMBER(30, 2) or MAP(VARCHAR, NUMBER)) are kept intact
+	args, err := splitArgs(trimmed[1 : len(trimmed)-1])
+	if err != nil {
+		return nil, fmt.Errorf("could not parse signature from Snowflake: %s, err: %w", trimmed, err)
+	}
 
-	for arg := range args {
+	for _, arg := range args {
 		a, err := parseFunctionOrProcedureArgument(arg)
 		if err != nil {
 			return nil, fmt.Errorf("could not parse signature from Snowflake: %s, err: %w", trimmed, err)
@@ -629,6 +629,10 @@ func Test_parseFunctionOrProcedureSignature(t *testing.T) {
 		{"(abc DOUBLE PRECISION)", []NormalizedArgument{{"abc", dataTypeDoublePrecision}}},
 		{"(abc double precision)", []NormalizedArgument{{"abc", dataTypeDoublePrecision}}},
 		{"(abc TIMESTAMP WITHOUT TIME ZONE(5))", []NormalizedArgument{{"abc", dataTypeTimestampWithoutTimeZone_5}}},
+		{"(abc NUMBER(30,2))", []NormalizedArgument{{"abc", dataTypeNumber_30_2}}},
+		{"(abc NUMBER(30, 2), def CHAR)", []NormalizedArgument{{"abc", dataTypeNumber_30_2}, {"def", dataTypeChar}}},
+		{"(abc ARRAY(NUMBER(30, 2)))", []NormalizedArgument{{"abc", dataTypeStructuredArray}}},
+		{"(abc OBJECT(a NUMBER(30, 2), b ARRAY(NUMBER(30, 2))), def MAP(VARCHAR, NUMBER(30, 2)))", []NormalizedArgument{{"abc", dataTypeStructuredObject}, {"def", dataTypeMap}}},
 	}
 
 	badInputs := []struct {
@@ -644,9 +648,9 @@ func Test_parseFunctionOrProcedureSignature(t *testing.T) {
 		{"(abc CHA(123))", "invalid data type"},
 		{"(abc CHAR(1) DEFAULT)", "cannot be parsed"},
 		{"(abc CHAR(1) DEFAULT 'a')", "cannot be parsed"},
-		// TODO [SNOW-1850370]: Snowflake currently does not return concrete data types so we can fail on them currently but it should be improved in the future
-		{"(abc NUMBER(30,2))", "cannot be parsed"},
-		{"(abc NUMBER(30, 2))", "cannot be parsed"},
+		{"(abc ARRAY(NUMBER)", "parentheses do not match"},
+		{"(abc MAP(VARCHAR, NUMBER)))", "parentheses do not match"},
+		{"(abc MAP(VARCHAR))", "cannot be parsed"},
 	}
 
 	for _, tc := range inputs {
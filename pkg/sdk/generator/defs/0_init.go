@@ -12,6 +12,7 @@ func init() {
 	gen.AllSdkObjectDefinitions = append(
 		gen.AllSdkObjectDefinitions,
 		accountsDef,
+		aggregationPoliciesDef,
 		alertsDef,
 		apiIntegrationsDef,
 		applicationPackagesDef,
@@ -19,10 +20,12 @@ func init() {
 		applicationsDef,
 		authenticationPoliciesDef,
 		backupPoliciesDef,
+		backupSetsDef,
 		budgetsDef,
 		catalogIntegrationsDef,
 		computePoolsDef,
 		connectionsDef,
+		contactsDef,
 		cortexAgentsDef,
 		cortexSearchServicesDef,
 		databaseRolesDef,
@@ -40,6 +43,7 @@ func init() {
 		hybridTablesDef,
 		icebergTablesDef,
 		imageRepositoriesDef,
+		joinPoliciesDef,
 		listingsDef,
 		managedAccountsDef,
 		maskingPoliciesDef,
@@ -57,6 +61,7 @@ func init() {
 		pipesDef,
 		postgresInstancesDef,
 		proceduresDef,
+		projectionPoliciesDef,
 		resourceMonitorsDef,
 		rolesDef,
 		rowAccessPoliciesDef,
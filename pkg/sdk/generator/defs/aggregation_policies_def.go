@@ -0,0 +1,90 @@
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var aggregationPoliciesDef = g.NewInterface(
+	"AggregationPolicies",
+	"AggregationPolicy",
+	g.KindOfT[sdkcommons.SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-aggregation-policy",
+		g.NewQueryStruct("CreateAggregationPolicy").
+			Create().
+			OrReplace().
+			SQL("AGGREGATION POLICY").
+			IfNotExists().
+			Name().
+			SQLWithCustomFieldName("signature", "AS ()").
+			SQL("RETURNS AGGREGATION_CONSTRAINT").
+			BodyWithPrecedingArrow().
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidateValueSet, "body").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-aggregation-policy",
+		g.NewQueryStruct("AlterAggregationPolicy").
+			Alter().
+			SQL("AGGREGATION POLICY").
+			IfExists().
+			Name().
+			RenameTo().
+			OptionalSetBodyWithPrecedingArrow().
+			OptionalSetTags().
+			OptionalUnsetTags().
+			OptionalTextAssignment("SET COMMENT", g.ParameterOptions().SingleQuotes()).
+			OptionalSQL("UNSET COMMENT").
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-aggregation-policy",
+		g.NewQueryStruct("DropAggregationPolicy").
+			Drop().
+			SQL("AGGREGATION POLICY").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperationWithPairedStructs(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-aggregation-policies",
+		g.StructPair("aggregationPolicyDBRow", "AggregationPolicy").
+			Time("created_on").
+			Text("name").
+			Text("database_name").
+			Text("schema_name").
+			Text("kind").
+			Text("owner").
+			OptionalText("comment", g.WithRequiredInPlain()).
+			Text("options").
+			Text("owner_role_type"),
+		g.NewQueryStruct("ShowAggregationPolicies").
+			Show().
+			SQL("AGGREGATION POLICIES").
+			OptionalLike().
+			OptionalIn().
+			OptionalLimitFrom(),
+		g.ShowByIDInFiltering,
+		g.ShowByIDLikeFiltering,
+	).
+	DescribeOperationWithPairedStructs(
+		g.DescriptionMappingKindSingleValue,
+		"https://docs.snowflake.com/en/sql-reference/sql/desc-aggregation-policy",
+		g.StructPair("describeAggregationPolicyDBRow", "AggregationPolicyDetails").
+			Text("name").
+			Text("signature").
+			Text("return_type").
+			Text("body"),
+		g.NewQueryStruct("DescribeAggregationPolicy").
+			Describe().
+			SQL("AGGREGATION POLICY").
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	WithEnabledGenerationParts(g.PartUnitTests)
@@ -52,6 +52,16 @@ var applicationPackageUnset = g.NewQueryStruct("ApplicationPackageUnset").
 	OptionalSQL("DISTRIBUTION").
 	WithValidation(g.AtLeastOneValueSet, "DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "DefaultDdlCollation", "Comment", "Distribution")
 
+var applicationPackageVersionPairs = g.StructPair("applicationPackageVersionRow", "ApplicationPackageVersion").
+	Text("version").
+	Number("patch").
+	OptionalText("label", g.WithRequiredInPlain()).
+	OptionalText("comment", g.WithRequiredInPlain()).
+	Time("created_on").
+	OptionalText("dropped_on", g.WithRequiredInPlain()).
+	Text("state").
+	Text("review_status")
+
 var applicationPackagesDef = g.NewInterface(
 	"ApplicationPackages",
 	"ApplicationPackage",
@@ -68,6 +78,7 @@ var applicationPackagesDef = g.NewInterface(
 		OptionalTextAssignment("DEFAULT_DDL_COLLATION", g.ParameterOptions().SingleQuotes()).
 		OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
 		PredefinedQueryStructField("Distribution", "*Distribution", g.ParameterOptions().SQL("DISTRIBUTION")).
+		OptionalBooleanAssignment("ENABLE_RELEASE_CHANNELS", g.ParameterOptions()).
 		OptionalTags().
 		WithValidation(g.ValidIdentifier, "name"),
 ).AlterOperation(
@@ -154,4 +165,15 @@ var applicationPackagesDef = g.NewInterface(
 		OptionalLike().
 		OptionalStartsWith().
 		OptionalLimit(),
+).CustomShowOperationWithPairedStructs(
+	"ShowVersions",
+	g.ShowMappingKindSlice,
+	"https://docs.snowflake.com/en/sql-reference/sql/show-versions",
+	applicationPackageVersionPairs,
+	g.NewQueryStruct("ShowVersionsApplicationPackage").
+		SQL("SHOW VERSIONS").
+		OptionalLike().
+		SQL("IN APPLICATION PACKAGE").
+		Name().
+		WithValidation(g.ValidIdentifier, "name"),
 )
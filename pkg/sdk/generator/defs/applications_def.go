@@ -6,10 +6,6 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
 )
 
-/*
- * 	todo: add definition for `CREATE APPLICATION <name> FROM LISTING <listing_name> [ COMMENT = '<string_literal>' ] [ WITH TAG ( <tag_name> = '<tag_value>' [ , ... ] ) ]`
- */
-
 var versionAndPatch = g.NewQueryStruct("VersionAndPatch").
 	TextAssignment("VERSION", g.ParameterOptions().NoEquals().NoQuotes().Required()).
 	OptionalNumberAssignment("PATCH", g.ParameterOptions().NoEquals().Required())
@@ -60,6 +56,19 @@ var applicationsDef = g.NewInterface(
 		WithValidation(g.ValidIdentifier, "name").
 		WithValidation(g.ValidIdentifier, "PackageName").
 		WithAdditionalValidations(),
+).CustomOperation(
+	"CreateFromListing",
+	"https://docs.snowflake.com/en/sql-reference/sql/create-application",
+	g.NewQueryStruct("CreateApplicationFromListing").
+		Create().
+		SQL("APPLICATION").
+		Name().
+		SQL("FROM LISTING").
+		Identifier("ListingName", g.KindOfT[sdkcommons.AccountObjectIdentifier](), g.IdentifierOptions().Required()).
+		OptionalTextAssignment("COMMENT", g.ParameterOptions().SingleQuotes()).
+		OptionalTags().
+		WithValidation(g.ValidIdentifier, "name").
+		WithValidation(g.ValidIdentifier, "ListingName"),
 ).DropOperation(
 	"https://docs.snowflake.com/en/sql-reference/sql/drop-application",
 	g.NewQueryStruct("DropApplication").
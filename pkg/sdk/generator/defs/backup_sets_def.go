@@ -0,0 +1,116 @@
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var BackupSetObjectKindEnumDef = g.NewEnum(
+	"BackupSetObjectKind", "BackupSetObjectKinds",
+	"TABLE", "DYNAMIC TABLE", "SCHEMA", "DATABASE",
+)
+
+var backupSetsDef = g.NewInterface(
+	"BackupSets",
+	"BackupSet",
+	g.KindOfT[sdkcommons.SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-backup-set",
+		g.NewQueryStruct("CreateBackupSet").
+			Create().
+			OrReplace().
+			SQL("BACKUP SET").
+			IfNotExists().
+			Name().
+			QueryStructField(
+				"ForObject",
+				g.NewQueryStruct("BackupSetFor").
+					OptionalIdentifier("Table", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("TABLE")).
+					OptionalIdentifier("DynamicTable", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("DYNAMIC TABLE")).
+					OptionalIdentifier("Schema", g.KindOfT[sdkcommons.DatabaseObjectIdentifier](), g.IdentifierOptions().SQL("SCHEMA")).
+					OptionalIdentifier("Database", g.KindOfT[sdkcommons.AccountObjectIdentifier](), g.IdentifierOptions().SQL("DATABASE")).
+					WithValidation(g.ExactlyOneValueSet, "Table", "DynamicTable", "Schema", "Database"),
+				g.KeywordOptions().SQL("FOR").Required(),
+			).
+			OptionalIdentifier("BackupPolicy", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("WITH BACKUP POLICY")).
+			OptionalTags().
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "BackupPolicy").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists"),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-backup-set",
+		g.NewQueryStruct("AlterBackupSet").
+			Alter().
+			SQL("BACKUP SET").
+			IfExists().
+			Name().
+			OptionalSQL("ADD BACKUP").
+			OptionalTextAssignment("DELETE BACKUP IDENTIFIER", g.ParameterOptions().SingleQuotes().NoEquals()).
+			OptionalQueryStructField(
+				"ApplyBackupPolicy",
+				g.NewQueryStruct("BackupSetApplyBackupPolicy").
+					Identifier("BackupPolicy", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().Required()).
+					OptionalSQL("FORCE").
+					WithValidation(g.ValidIdentifier, "BackupPolicy"),
+				g.KeywordOptions().SQL("APPLY BACKUP POLICY"),
+			).
+			OptionalSQL("SUSPEND BACKUP POLICY").
+			OptionalSQL("RESUME BACKUP POLICY").
+			OptionalQueryStructField(
+				"Set",
+				g.NewQueryStruct("BackupSetSet").
+					OptionalComment().
+					WithValidation(g.AtLeastOneValueSet, "Comment"),
+				g.KeywordOptions().SQL("SET"),
+			).
+			OptionalSetTags().
+			OptionalQueryStructField(
+				"Unset",
+				g.NewQueryStruct("BackupSetUnset").
+					OptionalSQL("COMMENT").
+					WithValidation(g.AtLeastOneValueSet, "Comment"),
+				g.ListOptions().NoParentheses().SQL("UNSET"),
+			).
+			OptionalUnsetTags().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ExactlyOneValueSet, "AddBackup", "DeleteBackupIdentifier", "ApplyBackupPolicy", "SuspendBackupPolicy", "ResumeBackupPolicy", "Set", "SetTags", "Unset", "UnsetTags"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-backup-set",
+		g.NewQueryStruct("DropBackupSet").
+			Drop().
+			SQL("BACKUP SET").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperationWithPairedStructs(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-backup-sets",
+		g.StructPair("backupSetDBRow", "BackupSet").
+			Time("created_on").
+			Text("name").
+			Text("database_name").
+			Text("schema_name").
+			OptionalText("comment").
+			Text("object_kind").
+			Text("object_name").
+			OptionalText("object_database_name").
+			OptionalText("object_schema_name").
+			OptionalText("backup_policy_name").
+			Text("owner").
+			Text("owner_role_type"),
+		g.NewQueryStruct("ShowBackupSets").
+			Show().
+			SQL("BACKUP SETS").
+			OptionalLike().
+			OptionalIn().
+			OptionalStartsWith().
+			OptionalLimit(),
+		g.ShowByIDInFiltering,
+		g.ShowByIDLikeFiltering,
+	).
+	WithEnums(BackupSetObjectKindEnumDef)
@@ -38,6 +38,26 @@ var getCycleStartActionResult = g.StructPair(
 	Time("ADDED_TIMESTAMP").
 	Time("LAST_TRIGGERED_TIMESTAMP")
 
+var budgetResourceReference = g.NewQueryStruct("BudgetResourceReference").
+	Text("ObjectType", g.KeywordOptions().SingleQuotes().Required()).
+	Text("ObjectName", g.KeywordOptions().SingleQuotes().Required()).
+	SQLWithCustomFieldName("session", "'SESSION'").
+	SQLWithCustomFieldName("applyBudget", "'APPLYBUDGET'")
+
+var budgetResourceArgs = func(name string) *g.QueryStruct {
+	return g.NewQueryStruct(name).
+		QueryStructField("Reference", budgetResourceReference, g.ListOptions().Required().MustParentheses().SQL("SYSTEM$REFERENCE"))
+}
+
+var getLinkedResourcesResult = g.StructPair(
+	"getLinkedResourcesRow",
+	"BudgetLinkedResource",
+).Number("resource_id").
+	Text("name").
+	Text("domain").
+	OptionalText("schema_name").
+	OptionalText("database_name")
+
 var budgetsDef = g.NewInterface(
 	"Budgets",
 	"Budget",
@@ -62,6 +82,24 @@ var budgetsDef = g.NewInterface(
 		IfExists().
 		Name().
 		WithValidation(g.ValidIdentifier, "name"),
+).ShowOperationWithPairedStructs(
+	"https://docs.snowflake.com/en/sql-reference/classes/budget/commands/show-budget",
+	g.StructPair("budgetRow", "Budget").
+		Time("created_on").
+		Text("name").
+		Text("database_name").
+		Text("schema_name").
+		Text("current_version").
+		OptionalText("comment").
+		Text("owner").
+		Text("owner_role_type"),
+	g.NewQueryStruct("ShowBudgets").
+		Show().
+		SQLWithCustomFieldName("snowflakeCoreBudgetInstances", "SNOWFLAKE.CORE.BUDGET INSTANCES").
+		OptionalLike().
+		OptionalIn(),
+	g.ShowByIDLikeFiltering,
+	g.ShowByIDInFiltering,
 ).InstanceMethodOperationScalar(
 	"https://docs.snowflake.com/en/sql-reference/classes/budget/method/set_spending_limit",
 	"SET_SPENDING_LIMIT",
@@ -104,4 +142,37 @@ var budgetsDef = g.NewInterface(
 	nil,
 	getCycleStartActionResult,
 	g.InstanceMethodKindSingleValue,
-)
+).InstanceMethodOperationScalar(
+	"https://docs.snowflake.com/en/sql-reference/classes/budget/methods/remove_cycle_start_action",
+	"REMOVE_CYCLE_START_ACTION",
+	nil,
+	"string",
+).InstanceMethodOperationScalar(
+	"https://docs.snowflake.com/en/sql-reference/classes/budget/methods/add_resource",
+	"ADD_RESOURCE",
+	budgetResourceArgs("AddResourceArgs"),
+	"string",
+).InstanceMethodOperationScalar(
+	"https://docs.snowflake.com/en/sql-reference/classes/budget/methods/remove_resource",
+	"REMOVE_RESOURCE",
+	budgetResourceArgs("RemoveResourceArgs"),
+	"string",
+).InstanceMethodOperation(
+	"https://docs.snowflake.com/en/sql-reference/classes/budget/methods/get_linked_resources",
+	"GET_LINKED_RESOURCES",
+	nil,
+	getLinkedResourcesResult,
+	g.InstanceMethodKindSlice,
+).
+	WithCustomInterfaceMethod(
+		"GetEmailNotificationRecipients",
+		"",
+		[]*g.MethodParameter{g.NewMethodParameter("id", g.KindOfT[sdkcommons.SchemaObjectIdentifier]())},
+		"[]string", "error",
+	).
+	WithCustomInterfaceMethod(
+		"GetEmailNotificationIntegration",
+		"",
+		[]*g.MethodParameter{g.NewMethodParameter("id", g.KindOfT[sdkcommons.SchemaObjectIdentifier]())},
+		"*AccountObjectIdentifier", "error",
+	)
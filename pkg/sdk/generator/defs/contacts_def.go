@@ -0,0 +1,145 @@
+package defs
+
+import (
+	g "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk/generator/gen/sdkcommons"
+)
+
+var ContactPurposeEnumDef = g.NewEnum(
+	"ContactPurpose", "ContactPurposes",
+	"STEWARD", "SUPPORT", "ACCESS_APPROVAL",
+)
+
+func contactUser() *g.QueryStruct {
+	return g.NewQueryStruct("ContactUser").
+		Text("Name", g.KeywordOptions().SingleQuotes().Required())
+}
+
+func contactSet() *g.QueryStruct {
+	return g.NewQueryStruct("ContactSet").
+		ListAssignment("USERS", "ContactUser", g.ParameterOptions().Parentheses()).
+		OptionalTextAssignment("EMAIL_DISTRIBUTION_LIST", g.ParameterOptions().SingleQuotes()).
+		OptionalTextAssignment("URL", g.ParameterOptions().SingleQuotes()).
+		OptionalComment().
+		WithValidation(g.AtLeastOneValueSet, "Users", "EmailDistributionList", "Url", "Comment").
+		WithValidation(g.MoreThanOneValueSet, "Users", "EmailDistributionList", "Url")
+}
+
+func contactsOnObjectSet() *g.QueryStruct {
+	return g.NewQueryStruct("ContactsOnObjectSet").
+		OptionalIdentifier("Steward", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().Equals().SQL("STEWARD")).
+		OptionalIdentifier("Support", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().Equals().SQL("SUPPORT")).
+		OptionalIdentifier("AccessApproval", g.KindOfT[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().Equals().SQL("ACCESS_APPROVAL")).
+		WithValidation(g.ValidIdentifierIfSet, "Steward").
+		WithValidation(g.ValidIdentifierIfSet, "Support").
+		WithValidation(g.ValidIdentifierIfSet, "AccessApproval").
+		WithValidation(g.AtLeastOneValueSet, "Steward", "Support", "AccessApproval")
+}
+
+func contactsOnObjectUnset() *g.QueryStruct {
+	return g.NewQueryStruct("ContactsOnObjectUnset").
+		OptionalSQL("STEWARD").
+		OptionalSQL("SUPPORT").
+		OptionalSQL("ACCESS_APPROVAL").
+		WithValidation(g.AtLeastOneValueSet, "Steward", "Support", "AccessApproval")
+}
+
+var contactsDef = g.NewInterface(
+	"Contacts",
+	"Contact",
+	g.KindOfT[sdkcommons.SchemaObjectIdentifier](),
+).
+	CreateOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/create-contact",
+		g.NewQueryStruct("CreateContact").
+			Create().
+			OrReplace().
+			SQL("CONTACT").
+			IfNotExists().
+			Name().
+			ListAssignment("USERS", "ContactUser", g.ParameterOptions().Parentheses()).
+			OptionalTextAssignment("EMAIL_DISTRIBUTION_LIST", g.ParameterOptions().SingleQuotes()).
+			OptionalTextAssignment("URL", g.ParameterOptions().SingleQuotes()).
+			OptionalComment().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ConflictingFields, "OrReplace", "IfNotExists").
+			WithValidation(g.MoreThanOneValueSet, "Users", "EmailDistributionList", "Url"),
+		contactUser(),
+	).
+	AlterOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/alter-contact",
+		g.NewQueryStruct("AlterContact").
+			Alter().
+			SQL("CONTACT").
+			IfExists().
+			Name().
+			OptionalQueryStructField("Set", contactSet(), g.ListOptions().SQL("SET")).
+			OptionalSQL("UNSET COMMENT").
+			RenameTo().
+			WithValidation(g.ValidIdentifier, "name").
+			WithValidation(g.ValidIdentifierIfSet, "RenameTo").
+			WithValidation(g.ExactlyOneValueSet, "Set", "UnsetComment", "RenameTo"),
+	).
+	DropOperation(
+		"https://docs.snowflake.com/en/sql-reference/sql/drop-contact",
+		g.NewQueryStruct("DropContact").
+			Drop().
+			SQL("CONTACT").
+			IfExists().
+			Name().
+			WithValidation(g.ValidIdentifier, "name"),
+	).
+	ShowOperationWithPairedStructs(
+		"https://docs.snowflake.com/en/sql-reference/sql/show-contacts",
+		g.StructPair("contactRow", "Contact").
+			Time("created_on").
+			Text("name").
+			Text("database_name").
+			Text("schema_name").
+			Text("owner").
+			OptionalText("comment", g.WithRequiredInPlain()).
+			OptionalPlainField("users", "[]string", g.WithManualConvert()).
+			OptionalText("email_distribution_list").
+			OptionalText("url").
+			Text("owner_role_type"),
+		g.NewQueryStruct("ShowContacts").
+			Show().
+			SQL("CONTACTS").
+			OptionalLike().
+			OptionalIn(),
+		g.ShowByIDInFiltering,
+		g.ShowByIDLikeFiltering,
+	).
+	CustomOperation(
+		"SetOnObject",
+		"https://docs.snowflake.com/en/sql-reference/contacts-using",
+		g.NewQueryStruct("SetContactsOnObject").
+			SQL("ALTER").
+			PredefinedQueryStructField("objectType", "ObjectType", g.KeywordOptions().Required()).
+			PredefinedQueryStructField("objectName", "ObjectIdentifier", g.IdentifierOptions().Required()).
+			QueryStructField("Set", contactsOnObjectSet(), g.ListOptions().SQL("SET CONTACT").Required()).
+			WithValidation(g.ValidIdentifier, "objectName").
+			WithAdditionalValidations(),
+	).
+	CustomOperation(
+		"UnsetOnObject",
+		"https://docs.snowflake.com/en/sql-reference/contacts-using",
+		g.NewQueryStruct("UnsetContactsOnObject").
+			SQL("ALTER").
+			PredefinedQueryStructField("objectType", "ObjectType", g.KeywordOptions().Required()).
+			PredefinedQueryStructField("objectName", "ObjectIdentifier", g.IdentifierOptions().Required()).
+			QueryStructField("Unset", contactsOnObjectUnset(), g.ListOptions().SQL("UNSET CONTACT").Required()).
+			WithValidation(g.ValidIdentifier, "objectName").
+			WithAdditionalValidations(),
+	).
+	WithCustomInterfaceMethod(
+		"GetForObject", "",
+		[]*g.MethodParameter{
+			g.NewMethodParameter("objectType", "ObjectType"),
+			g.NewMethodParameter("objectId", "ObjectIdentifier"),
+		},
+		"[]ContactReference", "error",
+	).
+	WithEnums(ContactPurposeEnumDef).
+	WithEnabledGenerationParts(g.PartUnitTests)
@@ -8,6 +8,7 @@ import (
 
 var DataMetricFunctionRefEntityDomainOptionEnumDef = g.NewEnum(
 	"DataMetricFunctionRefEntityDomainOption", "DataMetricFunctionRefEntityDomainOptions",
+	"TABLE",
 	"VIEW",
 )
 
@@ -40,6 +41,22 @@ var dataMetricFunctionReferencePairs = g.StructPair("dataMetricFunctionReference
 	Text("SCHEDULE").
 	Text("SCHEDULE_STATUS")
 
+var dataMetricFunctionOnObjectAdd = g.NewQueryStruct("DataMetricFunctionOnObjectAdd").
+	SQL("ADD").
+	ListAssignment("DATA METRIC FUNCTION", "ViewDataMetricFunction", g.ParameterOptions().NoEquals().Required())
+
+var dataMetricFunctionOnObjectDrop = g.NewQueryStruct("DataMetricFunctionOnObjectDrop").
+	SQL("DROP").
+	ListAssignment("DATA METRIC FUNCTION", "ViewDataMetricFunction", g.ParameterOptions().NoEquals().Required())
+
+var dataMetricFunctionOnObjectModify = g.NewQueryStruct("DataMetricFunctionOnObjectModify").
+	SQL("MODIFY").
+	ListAssignment("DATA METRIC FUNCTION", "ViewModifyDataMetricFunction", g.ParameterOptions().NoEquals().Required())
+
+var dataMetricFunctionOnObjectSetSchedule = g.NewQueryStruct("DataMetricFunctionOnObjectSetSchedule").
+	SQL("SET").
+	TextAssignment("DATA_METRIC_SCHEDULE", g.ParameterOptions().SingleQuotes().Required())
+
 var dataMetricFunctionReferencesDef = g.NewInterface(
 	"DataMetricFunctionReferences",
 	"DataMetricFunctionReference",
@@ -57,6 +74,21 @@ var dataMetricFunctionReferencesDef = g.NewInterface(
 			g.ListOptions().Parentheses().NoComma().Required(),
 		).WithValidation(g.ValidateValueSet, "parameters"),
 	dataMetricFunctionReferenceFunctionArgumentsDef,
+).CustomOperation(
+	"AlterOnObject",
+	"https://docs.snowflake.com/en/user-guide/data-quality-working",
+	g.NewQueryStruct("AlterDataMetricFunctionsOnObject").
+		SQL("ALTER").
+		PredefinedQueryStructField("objectType", "ObjectType", g.KeywordOptions().Required()).
+		PredefinedQueryStructField("objectName", "SchemaObjectIdentifier", g.IdentifierOptions().Required()).
+		OptionalQueryStructField("Add", dataMetricFunctionOnObjectAdd, g.KeywordOptions()).
+		OptionalQueryStructField("Drop", dataMetricFunctionOnObjectDrop, g.KeywordOptions()).
+		OptionalQueryStructField("Modify", dataMetricFunctionOnObjectModify, g.KeywordOptions()).
+		OptionalQueryStructField("SetSchedule", dataMetricFunctionOnObjectSetSchedule, g.KeywordOptions()).
+		OptionalSQLWithCustomFieldName("UnsetSchedule", "UNSET DATA_METRIC_SCHEDULE").
+		WithValidation(g.ValidIdentifier, "objectName").
+		WithValidation(g.ExactlyOneValueSet, "Add", "Drop", "Modify", "SetSchedule", "UnsetSchedule").
+		WithAdditionalValidations(),
 ).WithEnums(
 	DataMetricFunctionRefEntityDomainOptionEnumDef,
 )
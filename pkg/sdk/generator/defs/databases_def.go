@@ -50,9 +50,11 @@ var databaseSetStruct = g.NewQueryStruct("DatabaseSet").
 	OptionalNumberAssignment("USER_TASK_MINIMUM_TRIGGER_INTERVAL_IN_SECONDS", g.ParameterOptions()).
 	OptionalBooleanAssignment("QUOTED_IDENTIFIERS_IGNORE_CASE", nil).
 	OptionalBooleanAssignment("ENABLE_CONSOLE_OUTPUT", nil).
+	OptionalIdentifier("EventTable", g.KindOfTPointer[sdkcommons.SchemaObjectIdentifier](), g.IdentifierOptions().SQL("EVENT_TABLE").Equals()).
 	OptionalComment().
 	WithValidation(g.ValidIdentifierIfSet, "ExternalVolume").
 	WithValidation(g.ValidIdentifierIfSet, "Catalog").
+	WithValidation(g.ValidIdentifierIfSet, "EventTable").
 	WithValidation(g.AtLeastOneValueSet,
 		"DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ExternalVolume", "Catalog",
 		"ReplaceInvalidCharacters", "DefaultDdlCollation", "DefaultNotebookComputePoolCpu", "DefaultNotebookComputePoolGpu",
@@ -60,7 +62,7 @@ var databaseSetStruct = g.NewQueryStruct("DatabaseSet").
 		"LogLevel", "LogEventLevel", "TraceLevel",
 		"SuspendTaskAfterNumFailures", "TaskAutoRetryAttempts", "UserTaskManagedInitialWarehouseSize",
 		"UserTaskTimeoutMs", "UserTaskMinimumTriggerIntervalInSeconds",
-		"QuotedIdentifiersIgnoreCase", "EnableConsoleOutput", "Comment")
+		"QuotedIdentifiersIgnoreCase", "EnableConsoleOutput", "EventTable", "Comment")
 
 var databaseUnsetStruct = g.NewQueryStruct("DatabaseUnset").
 	OptionalSQL("DATA_RETENTION_TIME_IN_DAYS").
@@ -82,6 +84,7 @@ var databaseUnsetStruct = g.NewQueryStruct("DatabaseUnset").
 	OptionalSQL("USER_TASK_MINIMUM_TRIGGER_INTERVAL_IN_SECONDS").
 	OptionalSQL("QUOTED_IDENTIFIERS_IGNORE_CASE").
 	OptionalSQL("ENABLE_CONSOLE_OUTPUT").
+	OptionalSQL("EVENT_TABLE").
 	OptionalSQL("COMMENT").
 	WithValidation(g.AtLeastOneValueSet,
 		"DataRetentionTimeInDays", "MaxDataExtensionTimeInDays", "ExternalVolume", "Catalog",
@@ -90,7 +93,7 @@ var databaseUnsetStruct = g.NewQueryStruct("DatabaseUnset").
 		"LogLevel", "LogEventLevel", "TraceLevel",
 		"SuspendTaskAfterNumFailures", "TaskAutoRetryAttempts", "UserTaskManagedInitialWarehouseSize",
 		"UserTaskTimeoutMs", "UserTaskMinimumTriggerIntervalInSeconds",
-		"QuotedIdentifiersIgnoreCase", "EnableConsoleOutput", "Comment")
+		"QuotedIdentifiersIgnoreCase", "EnableConsoleOutput", "EventTable", "Comment")
 
 var databasesDef = g.NewInterface(
 	"Databases",
@@ -99,10 +99,11 @@ var eventTablesDef = g.NewInterface(
 	g.ShowByIDInFiltering,
 	g.ShowByIDLikeFiltering,
 ).DescribeOperationWithPairedStructs(
-	g.DescriptionMappingKindSingleValue,
+	g.DescriptionMappingKindSlice,
 	"https://docs.snowflake.com/en/sql-reference/sql/desc-event-table",
 	g.StructPair("eventTableDetailsRow", "EventTableDetails").
 		Text("name").
+		Text("type").
 		Text("kind").
 		Text("comment"),
 	g.NewQueryStruct("DescribeEventTable").
@@ -155,4 +156,4 @@ var eventTablesDef = g.NewInterface(
 		RenameTo().
 		WithValidation(g.ValidIdentifier, "name").
 		WithValidation(g.ExactlyOneValueSet, "RenameTo", "Set", "Unset", "SetTags", "UnsetTags", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllRowAccessPolicies", "ClusteringAction", "SearchOptimizationAction"),
-)
+).ShowParameters(g.KindOfT[sdkcommons.SchemaObjectIdentifier]())
@@ -136,4 +136,14 @@ var openflowConnectorsDef = g.NewInterface(
 		WithValidation(g.ValidIdentifier, "name"),
 ).WithEnums(
 	OpenflowConnectorStatusEnumDef,
+).WithCustomInterfaceMethod(
+	"CreateSafely",
+	"CreateSafely creates the connector and polls until it finishes provisioning.\nThe caller should set a deadline on ctx via context.WithTimeout.\nImplemented in openflow_connectors_ext.go.",
+	[]*g.MethodParameter{g.NewMethodParameter("request", "*CreateOpenflowConnectorRequest")},
+	"*OpenflowConnector", "error",
+).WithCustomInterfaceMethod(
+	"DropSafelyAndWait",
+	"DropSafelyAndWait drops the connector and polls until it is deleted.\nThe caller should set a deadline on ctx via context.WithTimeout.\nImplemented in openflow_connectors_ext.go.",
+	[]*g.MethodParameter{g.NewMethodParameter("id", g.KindOfT[sdkcommons.SchemaObjectIdentifier]())},
+	"error",
 )
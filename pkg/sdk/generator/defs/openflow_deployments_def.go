@@ -127,4 +127,14 @@ var openflowDeploymentsDef = g.NewInterface(
 	OpenflowDeploymentTypeEnumDef,
 	OpenflowVpcTypeEnumDef,
 	OpenflowDeploymentStatusEnumDef,
+).WithCustomInterfaceMethod(
+	"CreateSafely",
+	"CreateSafely creates the deployment and polls until it finishes provisioning.\nThe caller should set a deadline on ctx via context.WithTimeout.\nImplemented in openflow_deployments_ext.go.",
+	[]*g.MethodParameter{g.NewMethodParameter("request", "*CreateOpenflowDeploymentRequest")},
+	"*OpenflowDeployment", "error",
+).WithCustomInterfaceMethod(
+	"DropSafelyAndWait",
+	"DropSafelyAndWait drops the deployment and polls until it is deleted.\nThe caller should set a deadline on ctx via context.WithTimeout.\nImplemented in openflow_deployments_ext.go.",
+	[]*g.MethodParameter{g.NewMethodParameter("id", g.KindOfT[sdkcommons.AccountObjectIdentifier]())},
+	"error",
 )
@@ -148,4 +148,19 @@ var openflowRuntimesDef = g.NewInterface(
 ).WithEnums(
 	OpenflowRuntimeNodeTypeEnumDef,
 	OpenflowRuntimeStatusEnumDef,
+).WithCustomInterfaceMethod(
+	"CreateSafely",
+	"CreateSafely creates the runtime and polls until it finishes provisioning.\nThe caller should set a deadline on ctx via context.WithTimeout.\nImplemented in openflow_runtimes_ext.go.",
+	[]*g.MethodParameter{g.NewMethodParameter("request", "*CreateOpenflowRuntimeRequest")},
+	"*OpenflowRuntime", "error",
+).WithCustomInterfaceMethod(
+	"AlterSafely",
+	"AlterSafely waits until the runtime is not in a transitional status, alters it, and polls until the change is applied.\nThe caller should set a deadline on ctx via context.WithTimeout.\nImplemented in openflow_runtimes_ext.go.",
+	[]*g.MethodParameter{g.NewMethodParameter("request", "*AlterOpenflowRuntimeRequest")},
+	"error",
+).WithCustomInterfaceMethod(
+	"DropSafelyAndWait",
+	"DropSafelyAndWait drops the runtime and polls until it is deleted.\nThe caller should set a deadline on ctx via context.WithTimeout.\nImplemented in openflow_runtimes_ext.go.",
+	[]*g.MethodParameter{g.NewMethodParameter("id", g.KindOfT[sdkcommons.SchemaObjectIdentifier]())},
+	"error",
 )
@@ -21,6 +21,15 @@ type Grants interface {
 	RevokePrivilegesFromDatabaseRoleSafely(ctx context.Context, privileges *DatabaseRoleGrantPrivileges, on *DatabaseRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromDatabaseRoleOptions) error
 	RevokeInheritedPrivilegesFromDatabaseRole(ctx context.Context, privileges InheritedDatabaseRoleGrantPrivileges, onAll PluralObjectType, in InheritedDatabaseRoleGrantIn, role DatabaseObjectIdentifier) error
 	RevokeInheritedPrivilegesFromDatabaseRoleSafely(ctx context.Context, privileges InheritedDatabaseRoleGrantPrivileges, onAll PluralObjectType, in InheritedDatabaseRoleGrantIn, role DatabaseObjectIdentifier) error
+	GrantPrivilegesToApplicationRole(ctx context.Context, privileges *ApplicationRoleGrantPrivileges, on *ApplicationRoleGrantOn, role DatabaseObjectIdentifier, opts *GrantPrivilegesToApplicationRoleOptions) error
+	RevokePrivilegesFromApplicationRole(ctx context.Context, privileges *ApplicationRoleGrantPrivileges, on *ApplicationRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromApplicationRoleOptions) error
+	RevokePrivilegesFromApplicationRoleSafely(ctx context.Context, privileges *ApplicationRoleGrantPrivileges, on *ApplicationRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromApplicationRoleOptions) error
+	GrantCaller(ctx context.Context, privileges CallerGrantPrivileges, on Object, to CallerGrantTo) error
+	GrantInheritedCaller(ctx context.Context, privileges CallerGrantPrivileges, onAll PluralObjectType, in InheritedCallerGrantIn, to CallerGrantTo) error
+	RevokeCaller(ctx context.Context, privileges CallerGrantPrivileges, on Object, from CallerGrantTo) error
+	RevokeCallerSafely(ctx context.Context, privileges CallerGrantPrivileges, on Object, from CallerGrantTo) error
+	RevokeInheritedCaller(ctx context.Context, privileges CallerGrantPrivileges, onAll PluralObjectType, in InheritedCallerGrantIn, from CallerGrantTo) error
+	RevokeInheritedCallerSafely(ctx context.Context, privileges CallerGrantPrivileges, onAll PluralObjectType, in InheritedCallerGrantIn, from CallerGrantTo) error
 	GrantPrivilegeToShare(ctx context.Context, privileges []ObjectPrivilege, on *ShareGrantOn, to AccountObjectIdentifier) error
 	RevokePrivilegeFromShare(ctx context.Context, privileges []ObjectPrivilege, on *ShareGrantOn, from AccountObjectIdentifier) error
 	RevokePrivilegeFromShareSafely(ctx context.Context, privileges []ObjectPrivilege, on *ShareGrantOn, from AccountObjectIdentifier) error
@@ -28,6 +37,7 @@ type Grants interface {
 	RevokeOwnership(ctx context.Context, on RevokeOwnershipGrantOn, from OwnershipGrantTo, opts *RevokeOwnershipOptions) error
 
 	Show(ctx context.Context, opts *ShowGrantOptions) ([]Grant, error)
+	ShowCallerGrants(ctx context.Context, opts *ShowCallerGrantOptions) ([]Grant, error)
 }
 
 // GrantPrivilegesToAccountRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-privilege#syntax.
@@ -207,6 +217,100 @@ type revokeInheritedPrivilegesFromDatabaseRoleOptions struct {
 	databaseRole    DatabaseObjectIdentifier             `ddl:"identifier" sql:"FROM DATABASE ROLE"`
 }
 
+// GrantPrivilegesToApplicationRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-privilege#syntax.
+type GrantPrivilegesToApplicationRoleOptions struct {
+	grant           bool                            `ddl:"static" sql:"GRANT"`
+	privileges      *ApplicationRoleGrantPrivileges `ddl:"-"`
+	on              *ApplicationRoleGrantOn         `ddl:"keyword" sql:"ON"`
+	applicationRole DatabaseObjectIdentifier        `ddl:"identifier" sql:"TO APPLICATION ROLE"`
+	WithGrantOption *bool                           `ddl:"keyword" sql:"WITH GRANT OPTION"`
+}
+
+type ApplicationRoleGrantPrivileges struct {
+	GlobalPrivileges        []GlobalPrivilege        `ddl:"-"`
+	AccountObjectPrivileges []AccountObjectPrivilege `ddl:"-"`
+	SchemaPrivileges        []SchemaPrivilege        `ddl:"-"`
+	SchemaObjectPrivileges  []SchemaObjectPrivilege  `ddl:"-"`
+	AllPrivileges           *bool                    `ddl:"keyword" sql:"ALL PRIVILEGES"`
+}
+
+type ApplicationRoleGrantOn struct {
+	Account       *bool                 `ddl:"keyword" sql:"ACCOUNT"`
+	AccountObject *GrantOnAccountObject `ddl:"-"`
+	Schema        *GrantOnSchema        `ddl:"-"`
+	SchemaObject  *GrantOnSchemaObject  `ddl:"-"`
+}
+
+// RevokePrivilegesFromApplicationRoleOptions is based on https://docs.snowflake.com/en/sql-reference/sql/revoke-privilege#syntax.
+type RevokePrivilegesFromApplicationRoleOptions struct {
+	revoke          bool                            `ddl:"static" sql:"REVOKE"`
+	GrantOptionFor  *bool                           `ddl:"keyword" sql:"GRANT OPTION FOR"`
+	privileges      *ApplicationRoleGrantPrivileges `ddl:"-"`
+	on              *ApplicationRoleGrantOn         `ddl:"keyword" sql:"ON"`
+	applicationRole DatabaseObjectIdentifier        `ddl:"identifier" sql:"FROM APPLICATION ROLE"`
+	Restrict        *bool                           `ddl:"keyword" sql:"RESTRICT"`
+	Cascade         *bool                           `ddl:"keyword" sql:"CASCADE"`
+}
+
+// grantCallerOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-caller#syntax.
+type grantCallerOptions struct {
+	grant               bool              `ddl:"static" sql:"GRANT"`
+	caller              *bool             `ddl:"keyword" sql:"CALLER"`
+	privileges          []ObjectPrivilege `ddl:"-"`
+	allCallerPrivileges *bool             `ddl:"keyword" sql:"ALL CALLER PRIVILEGES"`
+	on                  Object            `ddl:"keyword" sql:"ON"`
+	to                  CallerGrantTo     `ddl:"keyword" sql:"TO"`
+}
+
+// CallerGrantPrivileges holds either a list of caller privileges or all caller privileges.
+type CallerGrantPrivileges struct {
+	Privileges    []ObjectPrivilege
+	AllPrivileges *bool
+}
+
+type CallerGrantTo struct {
+	AccountRole  *AccountObjectIdentifier  `ddl:"identifier" sql:"ROLE"`
+	DatabaseRole *DatabaseObjectIdentifier `ddl:"identifier" sql:"DATABASE ROLE"`
+}
+
+// grantInheritedCallerOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-caller#syntax.
+type grantInheritedCallerOptions struct {
+	grant                        bool                   `ddl:"static" sql:"GRANT"`
+	inheritedCaller              *bool                  `ddl:"keyword" sql:"INHERITED CALLER"`
+	privileges                   []ObjectPrivilege      `ddl:"-"`
+	allInheritedCallerPrivileges *bool                  `ddl:"keyword" sql:"ALL INHERITED CALLER PRIVILEGES"`
+	onAll                        PluralObjectType       `ddl:"parameter,no_equals" sql:"ON ALL"`
+	in                           InheritedCallerGrantIn `ddl:"keyword" sql:"IN"`
+	to                           CallerGrantTo          `ddl:"keyword" sql:"TO"`
+}
+
+type InheritedCallerGrantIn struct {
+	Account  *bool                     `ddl:"keyword" sql:"ACCOUNT"`
+	Database *AccountObjectIdentifier  `ddl:"identifier" sql:"DATABASE"`
+	Schema   *DatabaseObjectIdentifier `ddl:"identifier" sql:"SCHEMA"`
+}
+
+// revokeCallerOptions is based on https://docs.snowflake.com/en/sql-reference/sql/revoke-caller#syntax.
+type revokeCallerOptions struct {
+	revoke              bool              `ddl:"static" sql:"REVOKE"`
+	caller              *bool             `ddl:"keyword" sql:"CALLER"`
+	privileges          []ObjectPrivilege `ddl:"-"`
+	allCallerPrivileges *bool             `ddl:"keyword" sql:"ALL CALLER PRIVILEGES"`
+	on                  Object            `ddl:"keyword" sql:"ON"`
+	from                CallerGrantTo     `ddl:"keyword" sql:"FROM"`
+}
+
+// revokeInheritedCallerOptions is based on https://docs.snowflake.com/en/sql-reference/sql/revoke-caller#syntax.
+type revokeInheritedCallerOptions struct {
+	revoke                       bool                   `ddl:"static" sql:"REVOKE"`
+	inheritedCaller              *bool                  `ddl:"keyword" sql:"INHERITED CALLER"`
+	privileges                   []ObjectPrivilege      `ddl:"-"`
+	allInheritedCallerPrivileges *bool                  `ddl:"keyword" sql:"ALL INHERITED CALLER PRIVILEGES"`
+	onAll                        PluralObjectType       `ddl:"parameter,no_equals" sql:"ON ALL"`
+	in                           InheritedCallerGrantIn `ddl:"keyword" sql:"IN"`
+	from                         CallerGrantTo          `ddl:"keyword" sql:"FROM"`
+}
+
 // grantPrivilegeToShareOptions is based on https://docs.snowflake.com/en/sql-reference/sql/grant-privilege-share.
 type grantPrivilegeToShareOptions struct {
 	grant      bool                    `ddl:"static" sql:"GRANT"`
@@ -286,6 +390,18 @@ type ShowGrantsOf struct {
 	Share           AccountObjectIdentifier  `ddl:"identifier" sql:"SHARE"`
 }
 
+// ShowCallerGrantOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-caller-grants.
+type ShowCallerGrantOptions struct {
+	showCallerGrants bool                `ddl:"static" sql:"SHOW CALLER GRANTS"`
+	On               *ShowCallerGrantsOn `ddl:"keyword" sql:"ON"`
+	To               *CallerGrantTo      `ddl:"keyword" sql:"TO"`
+}
+
+type ShowCallerGrantsOn struct {
+	Account *bool `ddl:"keyword" sql:"ACCOUNT"`
+	Object  *Object
+}
+
 type grantRow struct {
 	CreatedOn   time.Time `db:"created_on"`
 	Privilege   string    `db:"privilege"`
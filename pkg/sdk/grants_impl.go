@@ -298,6 +298,172 @@ func (v *grants) revokeInheritedPrivilegesFromDatabaseRole(
 	})
 }
 
+func (v *grants) GrantPrivilegesToApplicationRole(ctx context.Context, privileges *ApplicationRoleGrantPrivileges, on *ApplicationRoleGrantOn, role DatabaseObjectIdentifier, opts *GrantPrivilegesToApplicationRoleOptions) error {
+	if opts == nil {
+		opts = &GrantPrivilegesToApplicationRoleOptions{}
+	}
+	opts.privileges = privileges
+	opts.on = on
+	opts.applicationRole = role
+
+	// Snowflake doesn't allow bulk operations on Pipes. Because of that, when SDK user
+	// issues "grant x on all pipes" operation, we'll go and grant specified privileges
+	// to every Pipe one by one.
+	if on != nil &&
+		on.SchemaObject != nil &&
+		on.SchemaObject.All != nil &&
+		on.SchemaObject.All.PluralObjectType == PluralObjectTypePipes {
+		return v.runOnAllPipes(
+			ctx,
+			on.SchemaObject.All.InDatabase,
+			on.SchemaObject.All.InSchema,
+			func(pipe Pipe) error {
+				return v.client.Grants.GrantPrivilegesToApplicationRole(
+					ctx,
+					privileges,
+					&ApplicationRoleGrantOn{
+						SchemaObject: &GrantOnSchemaObject{
+							SchemaObject: &Object{
+								ObjectType: ObjectTypePipe,
+								Name:       pipe.ID(),
+							},
+						},
+					},
+					role,
+					opts,
+				)
+			},
+		)
+	}
+
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *grants) RevokePrivilegesFromApplicationRole(ctx context.Context, privileges *ApplicationRoleGrantPrivileges, on *ApplicationRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromApplicationRoleOptions) error {
+	return v.revokePrivilegesFromApplicationRole(ctx, privileges, on, role, opts, noopExecWrapper)
+}
+
+func (v *grants) RevokePrivilegesFromApplicationRoleSafely(ctx context.Context, privileges *ApplicationRoleGrantPrivileges, on *ApplicationRoleGrantOn, role DatabaseObjectIdentifier, opts *RevokePrivilegesFromApplicationRoleOptions) error {
+	return v.revokePrivilegesFromApplicationRole(ctx, privileges, on, role, opts, SafeRevokePrivileges)
+}
+
+func (v *grants) revokePrivilegesFromApplicationRole(
+	ctx context.Context,
+	privileges *ApplicationRoleGrantPrivileges,
+	on *ApplicationRoleGrantOn,
+	role DatabaseObjectIdentifier,
+	opts *RevokePrivilegesFromApplicationRoleOptions,
+	execWrapper func(func() error) error,
+) error {
+	if opts == nil {
+		opts = &RevokePrivilegesFromApplicationRoleOptions{}
+	}
+	opts.privileges = privileges
+	opts.on = on
+	opts.applicationRole = role
+
+	// Snowflake doesn't allow bulk operations on Pipes. Because of that, when SDK user
+	// issues "revoke x on all pipes" operation, we'll go and revoke specified privileges
+	// from every Pipe one by one.
+	if on != nil &&
+		on.SchemaObject != nil &&
+		on.SchemaObject.All != nil &&
+		on.SchemaObject.All.PluralObjectType == PluralObjectTypePipes {
+		return v.runOnAllPipes(
+			ctx,
+			on.SchemaObject.All.InDatabase,
+			on.SchemaObject.All.InSchema,
+			func(pipe Pipe) error {
+				return v.revokePrivilegesFromApplicationRole(
+					ctx,
+					privileges,
+					&ApplicationRoleGrantOn{
+						SchemaObject: &GrantOnSchemaObject{
+							SchemaObject: &Object{
+								ObjectType: ObjectTypePipe,
+								Name:       pipe.ID(),
+							},
+						},
+					},
+					role,
+					opts,
+					execWrapper,
+				)
+			},
+		)
+	}
+
+	return execWrapper(func() error {
+		return validateAndExec(v.client, ctx, opts)
+	})
+}
+
+func (v *grants) GrantCaller(ctx context.Context, privileges CallerGrantPrivileges, on Object, to CallerGrantTo) error {
+	opts := &grantCallerOptions{
+		privileges: privileges.Privileges,
+		on:         on,
+		to:         to,
+	}
+	if len(privileges.Privileges) > 0 {
+		opts.caller = Bool(true)
+	}
+	opts.allCallerPrivileges = privileges.AllPrivileges
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *grants) GrantInheritedCaller(ctx context.Context, privileges CallerGrantPrivileges, onAll PluralObjectType, in InheritedCallerGrantIn, to CallerGrantTo) error {
+	opts := &grantInheritedCallerOptions{
+		privileges: privileges.Privileges,
+		onAll:      onAll,
+		in:         in,
+		to:         to,
+	}
+	if len(privileges.Privileges) > 0 {
+		opts.inheritedCaller = Bool(true)
+	}
+	opts.allInheritedCallerPrivileges = privileges.AllPrivileges
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *grants) RevokeCaller(ctx context.Context, privileges CallerGrantPrivileges, on Object, from CallerGrantTo) error {
+	opts := &revokeCallerOptions{
+		privileges: privileges.Privileges,
+		on:         on,
+		from:       from,
+	}
+	if len(privileges.Privileges) > 0 {
+		opts.caller = Bool(true)
+	}
+	opts.allCallerPrivileges = privileges.AllPrivileges
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *grants) RevokeCallerSafely(ctx context.Context, privileges CallerGrantPrivileges, on Object, from CallerGrantTo) error {
+	return SafeRevokePrivileges(func() error {
+		return v.RevokeCaller(ctx, privileges, on, from)
+	})
+}
+
+func (v *grants) RevokeInheritedCaller(ctx context.Context, privileges CallerGrantPrivileges, onAll PluralObjectType, in InheritedCallerGrantIn, from CallerGrantTo) error {
+	opts := &revokeInheritedCallerOptions{
+		privileges: privileges.Privileges,
+		onAll:      onAll,
+		in:         in,
+		from:       from,
+	}
+	if len(privileges.Privileges) > 0 {
+		opts.inheritedCaller = Bool(true)
+	}
+	opts.allInheritedCallerPrivileges = privileges.AllPrivileges
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *grants) RevokeInheritedCallerSafely(ctx context.Context, privileges CallerGrantPrivileges, onAll PluralObjectType, in InheritedCallerGrantIn, from CallerGrantTo) error {
+	return SafeRevokePrivileges(func() error {
+		return v.RevokeInheritedCaller(ctx, privileges, onAll, in, from)
+	})
+}
+
 func (v *grants) GrantPrivilegeToShare(ctx context.Context, privileges []ObjectPrivilege, on *ShareGrantOn, to AccountObjectIdentifier) error {
 	opts := &grantPrivilegeToShareOptions{
 		privileges: privileges,
@@ -424,6 +590,37 @@ func (v *grants) Show(ctx context.Context, opts *ShowGrantOptions) ([]Grant, err
 	return resultList, nil
 }
 
+func (v *grants) ShowCallerGrants(ctx context.Context, opts *ShowCallerGrantOptions) ([]Grant, error) {
+	if opts == nil {
+		opts = &ShowCallerGrantOptions{}
+	}
+
+	dbRows, err := validateAndQuery[grantRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	resultList, err := convertRows[grantRow, Grant](dbRows)
+	if err != nil {
+		return nil, err
+	}
+	for i, grant := range resultList {
+		granteeNameRaw := dbRows[i].GranteeName
+		if grant.GrantedTo == ObjectTypeDatabaseRole {
+			// The database role grantee may be returned without the database name, so it is reconstructed from the queried role.
+			if id, err := ParseDatabaseObjectIdentifier(granteeNameRaw); err == nil {
+				resultList[i].GranteeName = id
+			} else if opts.To != nil && opts.To.DatabaseRole != nil {
+				resultList[i].GranteeName = NewDatabaseObjectIdentifier(opts.To.DatabaseRole.DatabaseName(), granteeNameRaw)
+			} else {
+				resultList[i].GranteeName = NewAccountObjectIdentifier(granteeNameRaw)
+			}
+		} else {
+			resultList[i].GranteeName = NewAccountObjectIdentifier(granteeNameRaw)
+		}
+	}
+	return resultList, nil
+}
+
 // grantOwnershipOnPipe execution sequence
 //  1. Get the current role.
 //  2. Show grants on the pipe.
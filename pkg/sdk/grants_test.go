@@ -799,6 +799,235 @@ func TestGrants_RevokePrivilegesFromDatabaseRoleRole(t *testing.T) {
 	})
 }
 
+func TestGrants_GrantPrivilegesToApplicationRole(t *testing.T) {
+	applicationRoleId := randomDatabaseObjectIdentifier()
+	dbId := randomAccountObjectIdentifier()
+	schemaId := randomDatabaseObjectIdentifierInDatabase(dbId)
+	tableId := randomSchemaObjectIdentifierInSchema(schemaId)
+
+	defaultOpts := func() *GrantPrivilegesToApplicationRoleOptions {
+		return &GrantPrivilegesToApplicationRoleOptions{
+			privileges: &ApplicationRoleGrantPrivileges{
+				SchemaObjectPrivileges: []SchemaObjectPrivilege{SchemaObjectPrivilegeSelect},
+			},
+			on: &ApplicationRoleGrantOn{
+				SchemaObject: &GrantOnSchemaObject{
+					SchemaObject: &Object{
+						ObjectType: ObjectTypeTable,
+						Name:       tableId,
+					},
+				},
+			},
+			applicationRole: applicationRoleId,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *GrantPrivilegesToApplicationRoleOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: privilege with disallowed characters", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = &ApplicationRoleGrantPrivileges{
+			SchemaObjectPrivileges: []SchemaObjectPrivilege{"SELECT--"},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, fmt.Errorf("invalid privilege: %s contains disallowed characters; it must follow this regex: %s", "SELECT--", allowedUnquotedCharactersRegex.String()))
+	})
+
+	t.Run("validation: nil privileges set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("GrantPrivilegesToApplicationRoleOptions", "privileges"))
+	})
+
+	t.Run("validation: no privileges set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = &ApplicationRoleGrantPrivileges{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ApplicationRoleGrantPrivileges", "AllPrivileges", "GlobalPrivileges", "AccountObjectPrivileges", "SchemaPrivileges", "SchemaObjectPrivileges"))
+	})
+
+	t.Run("validation: nil on set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.on = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("GrantPrivilegesToApplicationRoleOptions", "on"))
+	})
+
+	t.Run("validation: too many ons set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.on.Account = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ApplicationRoleGrantOn", "Account", "AccountObject", "Schema", "SchemaObject"))
+	})
+
+	t.Run("validation: grant on schema object - all", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.on.SchemaObject = &GrantOnSchemaObject{
+			All: &GrantOnSchemaObjectIn{
+				PluralObjectType: PluralObjectTypeTables,
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("GrantOnSchemaObjectIn", "InDatabase", "InSchema"))
+	})
+
+	t.Run("validation: invalid application role identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.applicationRole = emptyDatabaseObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("on account", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = &ApplicationRoleGrantPrivileges{
+			GlobalPrivileges: []GlobalPrivilege{GlobalPrivilegeExecuteTask},
+		}
+		opts.on = &ApplicationRoleGrantOn{
+			Account: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT EXECUTE TASK ON ACCOUNT TO APPLICATION ROLE %s`, applicationRoleId.FullyQualifiedName())
+	})
+
+	t.Run("on account object", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = &ApplicationRoleGrantPrivileges{
+			AccountObjectPrivileges: []AccountObjectPrivilege{AccountObjectPrivilegeUsage},
+		}
+		opts.on = &ApplicationRoleGrantOn{
+			AccountObject: &GrantOnAccountObject{
+				Database: Pointer(dbId),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT USAGE ON DATABASE %s TO APPLICATION ROLE %s`, dbId.FullyQualifiedName(), applicationRoleId.FullyQualifiedName())
+	})
+
+	t.Run("on all schemas in database", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = &ApplicationRoleGrantPrivileges{
+			SchemaPrivileges: []SchemaPrivilege{SchemaPrivilegeUsage},
+		}
+		opts.on = &ApplicationRoleGrantOn{
+			Schema: &GrantOnSchema{
+				AllSchemasInDatabase: Pointer(dbId),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT USAGE ON ALL SCHEMAS IN DATABASE %s TO APPLICATION ROLE %s`, dbId.FullyQualifiedName(), applicationRoleId.FullyQualifiedName())
+	})
+
+	t.Run("on schema object with grant option", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.WithGrantOption = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `GRANT SELECT ON TABLE %s TO APPLICATION ROLE %s WITH GRANT OPTION`, tableId.FullyQualifiedName(), applicationRoleId.FullyQualifiedName())
+	})
+
+	t.Run("on future schema objects in schema", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.on.SchemaObject = &GrantOnSchemaObject{
+			Future: &GrantOnSchemaObjectIn{
+				PluralObjectType: PluralObjectTypeTables,
+				InSchema:         Pointer(schemaId),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT SELECT ON FUTURE TABLES IN SCHEMA %s TO APPLICATION ROLE %s`, schemaId.FullyQualifiedName(), applicationRoleId.FullyQualifiedName())
+	})
+
+	t.Run("grant all privileges", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = &ApplicationRoleGrantPrivileges{
+			AllPrivileges: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT ALL PRIVILEGES ON TABLE %s TO APPLICATION ROLE %s`, tableId.FullyQualifiedName(), applicationRoleId.FullyQualifiedName())
+	})
+}
+
+func TestGrants_RevokePrivilegesFromApplicationRole(t *testing.T) {
+	applicationRoleId := randomDatabaseObjectIdentifier()
+	dbId := randomAccountObjectIdentifier()
+	schemaId := randomDatabaseObjectIdentifierInDatabase(dbId)
+	tableId := randomSchemaObjectIdentifierInSchema(schemaId)
+
+	defaultOpts := func() *RevokePrivilegesFromApplicationRoleOptions {
+		return &RevokePrivilegesFromApplicationRoleOptions{
+			privileges: &ApplicationRoleGrantPrivileges{
+				SchemaObjectPrivileges: []SchemaObjectPrivilege{SchemaObjectPrivilegeSelect, SchemaObjectPrivilegeUpdate},
+			},
+			on: &ApplicationRoleGrantOn{
+				SchemaObject: &GrantOnSchemaObject{
+					SchemaObject: &Object{
+						ObjectType: ObjectTypeTable,
+						Name:       tableId,
+					},
+				},
+			},
+			applicationRole: applicationRoleId,
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *RevokePrivilegesFromApplicationRoleOptions = nil
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: nil privileges set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = nil
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("RevokePrivilegesFromApplicationRoleOptions", "privileges"))
+	})
+
+	t.Run("validation: no on set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.on = &ApplicationRoleGrantOn{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ApplicationRoleGrantOn", "Account", "AccountObject", "Schema", "SchemaObject"))
+	})
+
+	t.Run("validation: restrict and cascade", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.Restrict = Bool(true)
+		opts.Cascade = Bool(true)
+		assertOptsInvalidJoinedErrors(t, opts, errOneOf("RevokePrivilegesFromApplicationRoleOptions", "Restrict", "Cascade"))
+	})
+
+	t.Run("on account", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = &ApplicationRoleGrantPrivileges{
+			GlobalPrivileges: []GlobalPrivilege{GlobalPrivilegeExecuteTask},
+		}
+		opts.on = &ApplicationRoleGrantOn{
+			Account: Bool(true),
+		}
+		assertOptsValidAndSQLEquals(t, opts, `REVOKE EXECUTE TASK ON ACCOUNT FROM APPLICATION ROLE %s`, applicationRoleId.FullyQualifiedName())
+	})
+
+	t.Run("on schema", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = &ApplicationRoleGrantPrivileges{
+			SchemaPrivileges: []SchemaPrivilege{SchemaPrivilegeUsage},
+		}
+		opts.on = &ApplicationRoleGrantOn{
+			Schema: &GrantOnSchema{
+				Schema: Pointer(schemaId),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `REVOKE USAGE ON SCHEMA %s FROM APPLICATION ROLE %s`, schemaId.FullyQualifiedName(), applicationRoleId.FullyQualifiedName())
+	})
+
+	t.Run("grant option for on schema object + cascade", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.GrantOptionFor = Bool(true)
+		opts.Cascade = Bool(true)
+		assertOptsValidAndSQLEquals(t, opts, `REVOKE GRANT OPTION FOR SELECT, UPDATE ON TABLE %s FROM APPLICATION ROLE %s CASCADE`, tableId.FullyQualifiedName(), applicationRoleId.FullyQualifiedName())
+	})
+
+	t.Run("on future schema objects in database", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.on.SchemaObject = &GrantOnSchemaObject{
+			Future: &GrantOnSchemaObjectIn{
+				PluralObjectType: PluralObjectTypeTables,
+				InDatabase:       Pointer(dbId),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `REVOKE SELECT, UPDATE ON FUTURE TABLES IN DATABASE %s FROM APPLICATION ROLE %s`, dbId.FullyQualifiedName(), applicationRoleId.FullyQualifiedName())
+	})
+}
+
 func TestGrantPrivilegeToShare(t *testing.T) {
 	id := randomAccountObjectIdentifier()
 	t.Run("validation: privilege with disallowed characters", func(t *testing.T) {
@@ -1740,3 +1969,262 @@ func TestRevokeInheritedPrivilegesFromDatabaseRole(t *testing.T) {
 		assertOptsValidAndSQLEquals(t, opts, `REVOKE INHERITED ALL PRIVILEGES ON ALL TABLES IN DATABASE %s FROM DATABASE ROLE %s`, dbId.FullyQualifiedName(), databaseRoleId.FullyQualifiedName())
 	})
 }
+
+func TestGrantCaller(t *testing.T) {
+	tableId := randomSchemaObjectIdentifier()
+	roleId := randomAccountObjectIdentifier()
+	databaseRoleId := randomDatabaseObjectIdentifier()
+
+	defaultOpts := func() *grantCallerOptions {
+		return &grantCallerOptions{
+			caller:     new(true),
+			privileges: []ObjectPrivilege{ObjectPrivilegeSelect},
+			on: Object{
+				ObjectType: ObjectTypeTable,
+				Name:       tableId,
+			},
+			to: CallerGrantTo{AccountRole: new(roleId)},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *grantCallerOptions
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: exactly one of privileges or all caller privileges should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.allCallerPrivileges = new(true)
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("grantCallerOptions", "privileges", "allCallerPrivileges"))
+	})
+
+	t.Run("validation: object type should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.on.ObjectType = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("Object", "ObjectType"))
+	})
+
+	t.Run("validation: valid identifier for [opts.on.Name]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.on.Name = emptySchemaObjectIdentifier
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("validation: exactly one of the fields [opts.to.AccountRole opts.to.DatabaseRole] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.to = CallerGrantTo{AccountRole: new(roleId), DatabaseRole: new(databaseRoleId)}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CallerGrantTo", "AccountRole", "DatabaseRole"))
+	})
+
+	t.Run("validation: valid identifier for [opts.to.DatabaseRole]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.to = CallerGrantTo{DatabaseRole: new(emptyDatabaseObjectIdentifier)}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("to account role", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `GRANT CALLER SELECT ON TABLE %s TO ROLE %s`, tableId.FullyQualifiedName(), roleId.FullyQualifiedName())
+	})
+
+	t.Run("multiple privileges to database role", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.privileges = []ObjectPrivilege{ObjectPrivilegeSelect, ObjectPrivilege("INSERT")}
+		opts.to = CallerGrantTo{DatabaseRole: new(databaseRoleId)}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT CALLER SELECT, INSERT ON TABLE %s TO DATABASE ROLE %s`, tableId.FullyQualifiedName(), databaseRoleId.FullyQualifiedName())
+	})
+
+	t.Run("all caller privileges", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.caller = nil
+		opts.privileges = nil
+		opts.allCallerPrivileges = new(true)
+		assertOptsValidAndSQLEquals(t, opts, `GRANT ALL CALLER PRIVILEGES ON TABLE %s TO ROLE %s`, tableId.FullyQualifiedName(), roleId.FullyQualifiedName())
+	})
+}
+
+func TestGrantInheritedCaller(t *testing.T) {
+	dbId := randomAccountObjectIdentifier()
+	schemaId := randomDatabaseObjectIdentifierInDatabase(dbId)
+	roleId := randomAccountObjectIdentifier()
+
+	defaultOpts := func() *grantInheritedCallerOptions {
+		return &grantInheritedCallerOptions{
+			inheritedCaller: new(true),
+			privileges:      []ObjectPrivilege{ObjectPrivilegeSelect},
+			onAll:           PluralObjectTypeTables,
+			in:              InheritedCallerGrantIn{Database: new(dbId)},
+			to:              CallerGrantTo{AccountRole: new(roleId)},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *grantInheritedCallerOptions
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: exactly one of privileges or all inherited caller privileges should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.inheritedCaller = nil
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("grantInheritedCallerOptions", "privileges", "allInheritedCallerPrivileges"))
+	})
+
+	t.Run("validation: [opts.onAll] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.onAll = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("grantInheritedCallerOptions", "onAll"))
+	})
+
+	t.Run("validation: exactly one of the fields [opts.in.Account opts.in.Database opts.in.Schema] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.in = InheritedCallerGrantIn{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("InheritedCallerGrantIn", "Account", "Database", "Schema"))
+	})
+
+	t.Run("validation: valid identifier for [opts.in.Schema]", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.in = InheritedCallerGrantIn{Schema: new(emptyDatabaseObjectIdentifier)}
+		assertOptsInvalidJoinedErrors(t, opts, ErrInvalidObjectIdentifier)
+	})
+
+	t.Run("on all tables in account", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.in = InheritedCallerGrantIn{Account: new(true)}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT INHERITED CALLER SELECT ON ALL TABLES IN ACCOUNT TO ROLE %s`, roleId.FullyQualifiedName())
+	})
+
+	t.Run("on all tables in database", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `GRANT INHERITED CALLER SELECT ON ALL TABLES IN DATABASE %s TO ROLE %s`, dbId.FullyQualifiedName(), roleId.FullyQualifiedName())
+	})
+
+	t.Run("all privileges on all tables in schema", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.inheritedCaller = nil
+		opts.privileges = nil
+		opts.allInheritedCallerPrivileges = new(true)
+		opts.in = InheritedCallerGrantIn{Schema: new(schemaId)}
+		assertOptsValidAndSQLEquals(t, opts, `GRANT ALL INHERITED CALLER PRIVILEGES ON ALL TABLES IN SCHEMA %s TO ROLE %s`, schemaId.FullyQualifiedName(), roleId.FullyQualifiedName())
+	})
+}
+
+func TestRevokeCaller(t *testing.T) {
+	tableId := randomSchemaObjectIdentifier()
+	databaseRoleId := randomDatabaseObjectIdentifier()
+
+	defaultOpts := func() *revokeCallerOptions {
+		return &revokeCallerOptions{
+			caller:     new(true),
+			privileges: []ObjectPrivilege{ObjectPrivilegeSelect},
+			on: Object{
+				ObjectType: ObjectTypeTable,
+				Name:       tableId,
+			},
+			from: CallerGrantTo{DatabaseRole: new(databaseRoleId)},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *revokeCallerOptions
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: exactly one of the fields [opts.from.AccountRole opts.from.DatabaseRole] should be present", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.from = CallerGrantTo{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("CallerGrantTo", "AccountRole", "DatabaseRole"))
+	})
+
+	t.Run("from database role", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `REVOKE CALLER SELECT ON TABLE %s FROM DATABASE ROLE %s`, tableId.FullyQualifiedName(), databaseRoleId.FullyQualifiedName())
+	})
+
+	t.Run("all caller privileges", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.caller = nil
+		opts.privileges = nil
+		opts.allCallerPrivileges = new(true)
+		assertOptsValidAndSQLEquals(t, opts, `REVOKE ALL CALLER PRIVILEGES ON TABLE %s FROM DATABASE ROLE %s`, tableId.FullyQualifiedName(), databaseRoleId.FullyQualifiedName())
+	})
+}
+
+func TestRevokeInheritedCaller(t *testing.T) {
+	dbId := randomAccountObjectIdentifier()
+	roleId := randomAccountObjectIdentifier()
+
+	defaultOpts := func() *revokeInheritedCallerOptions {
+		return &revokeInheritedCallerOptions{
+			inheritedCaller: new(true),
+			privileges:      []ObjectPrivilege{ObjectPrivilegeSelect},
+			onAll:           PluralObjectTypeViews,
+			in:              InheritedCallerGrantIn{Database: new(dbId)},
+			from:            CallerGrantTo{AccountRole: new(roleId)},
+		}
+	}
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *revokeInheritedCallerOptions
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: [opts.onAll] should be set", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.onAll = ""
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("revokeInheritedCallerOptions", "onAll"))
+	})
+
+	t.Run("on all views in database", func(t *testing.T) {
+		opts := defaultOpts()
+		assertOptsValidAndSQLEquals(t, opts, `REVOKE INHERITED CALLER SELECT ON ALL VIEWS IN DATABASE %s FROM ROLE %s`, dbId.FullyQualifiedName(), roleId.FullyQualifiedName())
+	})
+
+	t.Run("all privileges", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.inheritedCaller = nil
+		opts.privileges = nil
+		opts.allInheritedCallerPrivileges = new(true)
+		assertOptsValidAndSQLEquals(t, opts, `REVOKE ALL INHERITED CALLER PRIVILEGES ON ALL VIEWS IN DATABASE %s FROM ROLE %s`, dbId.FullyQualifiedName(), roleId.FullyQualifiedName())
+	})
+}
+
+func TestShowCallerGrants(t *testing.T) {
+	roleId := randomAccountObjectIdentifier()
+	databaseRoleId := randomDatabaseObjectIdentifier()
+	tableId := randomSchemaObjectIdentifier()
+
+	t.Run("validation: nil options", func(t *testing.T) {
+		var opts *ShowCallerGrantOptions
+		assertOptsInvalidJoinedErrors(t, opts, ErrNilOptions)
+	})
+
+	t.Run("validation: exactly one of the fields [opts.On opts.To] should be present", func(t *testing.T) {
+		opts := &ShowCallerGrantOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ShowCallerGrantOptions", "On", "To"))
+	})
+
+	t.Run("validation: exactly one of the fields [opts.On.Account opts.On.Object] should be present", func(t *testing.T) {
+		opts := &ShowCallerGrantOptions{On: &ShowCallerGrantsOn{}}
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("ShowCallerGrantsOn", "Account", "Object"))
+	})
+
+	t.Run("on account", func(t *testing.T) {
+		opts := &ShowCallerGrantOptions{On: &ShowCallerGrantsOn{Account: new(true)}}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW CALLER GRANTS ON ACCOUNT`)
+	})
+
+	t.Run("on object", func(t *testing.T) {
+		opts := &ShowCallerGrantOptions{On: &ShowCallerGrantsOn{Object: &Object{ObjectType: ObjectTypeTable, Name: tableId}}}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW CALLER GRANTS ON TABLE %s`, tableId.FullyQualifiedName())
+	})
+
+	t.Run("to role", func(t *testing.T) {
+		opts := &ShowCallerGrantOptions{To: &CallerGrantTo{AccountRole: new(roleId)}}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW CALLER GRANTS TO ROLE %s`, roleId.FullyQualifiedName())
+	})
+
+	t.Run("to database role", func(t *testing.T) {
+		opts := &ShowCallerGrantOptions{To: &CallerGrantTo{DatabaseRole: new(databaseRoleId)}}
+		assertOptsValidAndSQLEquals(t, opts, `SHOW CALLER GRANTS TO DATABASE ROLE %s`, databaseRoleId.FullyQualifiedName())
+	})
+}
@@ -17,11 +17,18 @@ var (
 	_ validatable = new(grantInheritedPrivilegesToDatabaseRoleOptions)
 	_ validatable = new(RevokePrivilegesFromDatabaseRoleOptions)
 	_ validatable = new(revokeInheritedPrivilegesFromDatabaseRoleOptions)
+	_ validatable = new(GrantPrivilegesToApplicationRoleOptions)
+	_ validatable = new(RevokePrivilegesFromApplicationRoleOptions)
+	_ validatable = new(grantCallerOptions)
+	_ validatable = new(grantInheritedCallerOptions)
+	_ validatable = new(revokeCallerOptions)
+	_ validatable = new(revokeInheritedCallerOptions)
 	_ validatable = new(grantPrivilegeToShareOptions)
 	_ validatable = new(revokePrivilegeFromShareOptions)
 	_ validatable = new(GrantOwnershipOptions)
 	_ validatable = new(RevokeOwnershipOptions)
 	_ validatable = new(ShowGrantOptions)
+	_ validatable = new(ShowCallerGrantOptions)
 )
 
 // based on https://docs.snowflake.com/en/sql-reference/sql/grant-ownership#required-parameters
@@ -648,6 +655,195 @@ func (opts *revokeInheritedPrivilegesFromDatabaseRoleOptions) validate() error {
 	return errors.Join(errs...)
 }
 
+func (opts *GrantPrivilegesToApplicationRoleOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !valueSet(opts.privileges) {
+		errs = append(errs, errNotSet("GrantPrivilegesToApplicationRoleOptions", "privileges"))
+	} else {
+		if err := opts.privileges.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !valueSet(opts.on) {
+		errs = append(errs, errNotSet("GrantPrivilegesToApplicationRoleOptions", "on"))
+	} else {
+		if err := opts.on.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !ValidObjectIdentifier(opts.applicationRole) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
+
+func (v *ApplicationRoleGrantPrivileges) validate() error {
+	if !exactlyOneValueSet(v.AllPrivileges, v.GlobalPrivileges, v.AccountObjectPrivileges, v.SchemaPrivileges, v.SchemaObjectPrivileges) {
+		return errExactlyOneOf("ApplicationRoleGrantPrivileges", "AllPrivileges", "GlobalPrivileges", "AccountObjectPrivileges", "SchemaPrivileges", "SchemaObjectPrivileges")
+	}
+	return errors.Join(
+		validatePrivileges(v.GlobalPrivileges),
+		validatePrivileges(v.AccountObjectPrivileges),
+		validatePrivileges(v.SchemaPrivileges),
+		validatePrivileges(v.SchemaObjectPrivileges),
+	)
+}
+
+func (v *ApplicationRoleGrantOn) validate() error {
+	var errs []error
+	if !exactlyOneValueSet(v.Account, v.AccountObject, v.Schema, v.SchemaObject) {
+		errs = append(errs, errExactlyOneOf("ApplicationRoleGrantOn", "Account", "AccountObject", "Schema", "SchemaObject"))
+	}
+	if valueSet(v.AccountObject) {
+		if err := v.AccountObject.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if valueSet(v.Schema) {
+		if err := v.Schema.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if valueSet(v.SchemaObject) {
+		if err := v.SchemaObject.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *RevokePrivilegesFromApplicationRoleOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !valueSet(opts.privileges) {
+		errs = append(errs, errNotSet("RevokePrivilegesFromApplicationRoleOptions", "privileges"))
+	} else {
+		if err := opts.privileges.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !valueSet(opts.on) {
+		errs = append(errs, errNotSet("RevokePrivilegesFromApplicationRoleOptions", "on"))
+	} else {
+		if err := opts.on.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if !ValidObjectIdentifier(opts.applicationRole) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if everyValueSet(opts.Restrict, opts.Cascade) {
+		errs = append(errs, errOneOf("RevokePrivilegesFromApplicationRoleOptions", "Restrict", "Cascade"))
+	}
+	return errors.Join(errs...)
+}
+
+func (opts *grantCallerOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !exactlyOneValueSet(opts.caller, opts.allCallerPrivileges) {
+		errs = append(errs, errExactlyOneOf("grantCallerOptions", "privileges", "allCallerPrivileges"))
+	}
+	errs = append(errs, validatePrivileges(opts.privileges))
+	errs = append(errs, validateCallerGrantObject(opts.on))
+	errs = append(errs, opts.to.validate())
+	return errors.Join(errs...)
+}
+
+func (opts *grantInheritedCallerOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !exactlyOneValueSet(opts.inheritedCaller, opts.allInheritedCallerPrivileges) {
+		errs = append(errs, errExactlyOneOf("grantInheritedCallerOptions", "privileges", "allInheritedCallerPrivileges"))
+	}
+	errs = append(errs, validatePrivileges(opts.privileges))
+	if opts.onAll == "" {
+		errs = append(errs, errNotSet("grantInheritedCallerOptions", "onAll"))
+	}
+	errs = append(errs, opts.in.validate())
+	errs = append(errs, opts.to.validate())
+	return errors.Join(errs...)
+}
+
+func (opts *revokeCallerOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !exactlyOneValueSet(opts.caller, opts.allCallerPrivileges) {
+		errs = append(errs, errExactlyOneOf("revokeCallerOptions", "privileges", "allCallerPrivileges"))
+	}
+	errs = append(errs, validatePrivileges(opts.privileges))
+	errs = append(errs, validateCallerGrantObject(opts.on))
+	errs = append(errs, opts.from.validate())
+	return errors.Join(errs...)
+}
+
+func (opts *revokeInheritedCallerOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !exactlyOneValueSet(opts.inheritedCaller, opts.allInheritedCallerPrivileges) {
+		errs = append(errs, errExactlyOneOf("revokeInheritedCallerOptions", "privileges", "allInheritedCallerPrivileges"))
+	}
+	errs = append(errs, validatePrivileges(opts.privileges))
+	if opts.onAll == "" {
+		errs = append(errs, errNotSet("revokeInheritedCallerOptions", "onAll"))
+	}
+	errs = append(errs, opts.in.validate())
+	errs = append(errs, opts.from.validate())
+	return errors.Join(errs...)
+}
+
+func validateCallerGrantObject(on Object) error {
+	var errs []error
+	if on.ObjectType == "" {
+		errs = append(errs, errNotSet("Object", "ObjectType"))
+	}
+	if on.Name == nil || !ValidObjectIdentifier(on.Name) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
+
+func (v CallerGrantTo) validate() error {
+	var errs []error
+	if !exactlyOneValueSet(v.AccountRole, v.DatabaseRole) {
+		errs = append(errs, errExactlyOneOf("CallerGrantTo", "AccountRole", "DatabaseRole"))
+	}
+	if v.AccountRole != nil && !ValidObjectIdentifier(*v.AccountRole) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if v.DatabaseRole != nil && !ValidObjectIdentifier(*v.DatabaseRole) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
+
+func (v InheritedCallerGrantIn) validate() error {
+	var errs []error
+	if !exactlyOneValueSet(v.Account, v.Database, v.Schema) {
+		errs = append(errs, errExactlyOneOf("InheritedCallerGrantIn", "Account", "Database", "Schema"))
+	}
+	if v.Database != nil && !ValidObjectIdentifier(*v.Database) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	if v.Schema != nil && !ValidObjectIdentifier(*v.Schema) {
+		errs = append(errs, ErrInvalidObjectIdentifier)
+	}
+	return errors.Join(errs...)
+}
+
 func (opts *grantPrivilegeToShareOptions) validate() error {
 	if opts == nil {
 		return errors.Join(ErrNilOptions)
@@ -798,3 +994,20 @@ func (opts *ShowGrantOptions) validate() error {
 	}
 	return errors.Join(errs...)
 }
+
+func (opts *ShowCallerGrantOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !exactlyOneValueSet(opts.On, opts.To) {
+		errs = append(errs, errExactlyOneOf("ShowCallerGrantOptions", "On", "To"))
+	}
+	if valueSet(opts.On) && !exactlyOneValueSet(opts.On.Account, opts.On.Object) {
+		errs = append(errs, errExactlyOneOf("ShowCallerGrantsOn", "Account", "Object"))
+	}
+	if valueSet(opts.To) {
+		errs = append(errs, opts.To.validate())
+	}
+	return errors.Join(errs...)
+}
@@ -223,9 +223,8 @@ func (v *icebergTables) ShowParameters(ctx context.Context, id SchemaObjectIdent
 }
 
 // additionalConvert populates DataTypeRaw with the raw DESC output for every column and only
-// sets the parsed Type when datatypes.ParseDataType recognizes it - e.g. structured types like
-// OBJECT(...)/ARRAY(...)/MAP(...) aren't modeled by datatypes.DataType, so Type is left nil for
-// those instead of failing the whole Describe call.
+// sets the parsed Type when datatypes.ParseDataType recognizes it, so a data type not modeled by datatypes.DataType
+// leaves Type nil instead of failing the whole Describe call.
 func (r icebergTableDetailsRow) additionalConvert(result *IcebergTableDetails) error {
 	result.DataTypeRaw = r.Type
 	v, err := datatypes.ParseDataType(r.Type)
@@ -0,0 +1,112 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+func NewCreateJoinPolicyRequest(
+	name SchemaObjectIdentifier,
+	body string,
+) *CreateJoinPolicyRequest {
+	s := CreateJoinPolicyRequest{}
+	s.name = name
+	s.body = body
+	return &s
+}
+
+func (s *CreateJoinPolicyRequest) WithOrReplace(orReplace bool) *CreateJoinPolicyRequest {
+	s.OrReplace = &orReplace
+	return s
+}
+
+func (s *CreateJoinPolicyRequest) WithIfNotExists(ifNotExists bool) *CreateJoinPolicyRequest {
+	s.IfNotExists = &ifNotExists
+	return s
+}
+
+func (s *CreateJoinPolicyRequest) WithComment(comment string) *CreateJoinPolicyRequest {
+	s.Comment = &comment
+	return s
+}
+
+func NewAlterJoinPolicyRequest(
+	name SchemaObjectIdentifier,
+) *AlterJoinPolicyRequest {
+	s := AlterJoinPolicyRequest{}
+	s.name = name
+	return &s
+}
+
+func (s *AlterJoinPolicyRequest) WithIfExists(ifExists bool) *AlterJoinPolicyRequest {
+	s.IfExists = &ifExists
+	return s
+}
+
+func (s *AlterJoinPolicyRequest) WithRenameTo(renameTo SchemaObjectIdentifier) *AlterJoinPolicyRequest {
+	s.RenameTo = &renameTo
+	return s
+}
+
+func (s *AlterJoinPolicyRequest) WithSetBody(setBody string) *AlterJoinPolicyRequest {
+	s.SetBody = &setBody
+	return s
+}
+
+func (s *AlterJoinPolicyRequest) WithSetTags(setTags []TagAssociation) *AlterJoinPolicyRequest {
+	s.SetTags = setTags
+	return s
+}
+
+func (s *AlterJoinPolicyRequest) WithUnsetTags(unsetTags []ObjectIdentifier) *AlterJoinPolicyRequest {
+	s.UnsetTags = unsetTags
+	return s
+}
+
+func (s *AlterJoinPolicyRequest) WithSetComment(setComment string) *AlterJoinPolicyRequest {
+	s.SetComment = &setComment
+	return s
+}
+
+func (s *AlterJoinPolicyRequest) WithUnsetComment(unsetComment bool) *AlterJoinPolicyRequest {
+	s.UnsetComment = &unsetComment
+	return s
+}
+
+func NewDropJoinPolicyRequest(
+	name SchemaObjectIdentifier,
+) *DropJoinPolicyRequest {
+	s := DropJoinPolicyRequest{}
+	s.name = name
+	return &s
+}
+
+func (s *DropJoinPolicyRequest) WithIfExists(ifExists bool) *DropJoinPolicyRequest {
+	s.IfExists = &ifExists
+	return s
+}
+
+func NewShowJoinPolicyRequest() *ShowJoinPolicyRequest {
+	s := ShowJoinPolicyRequest{}
+	return &s
+}
+
+func (s *ShowJoinPolicyRequest) WithLike(like Like) *ShowJoinPolicyRequest {
+	s.Like = &like
+	return s
+}
+
+func (s *ShowJoinPolicyRequest) WithIn(in In) *ShowJoinPolicyRequest {
+	s.In = &in
+	return s
+}
+
+func (s *ShowJoinPolicyRequest) WithLimit(limit LimitFrom) *ShowJoinPolicyRequest {
+	s.Limit = &limit
+	return s
+}
+
+func NewDescribeJoinPolicyRequest(
+	name SchemaObjectIdentifier,
+) *DescribeJoinPolicyRequest {
+	s := DescribeJoinPolicyRequest{}
+	s.name = name
+	return &s
+}
@@ -0,0 +1,45 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+var (
+	_ optionsProvider[CreateJoinPolicyOptions]   = new(CreateJoinPolicyRequest)
+	_ optionsProvider[AlterJoinPolicyOptions]    = new(AlterJoinPolicyRequest)
+	_ optionsProvider[DropJoinPolicyOptions]     = new(DropJoinPolicyRequest)
+	_ optionsProvider[ShowJoinPolicyOptions]     = new(ShowJoinPolicyRequest)
+	_ optionsProvider[DescribeJoinPolicyOptions] = new(DescribeJoinPolicyRequest)
+)
+
+type CreateJoinPolicyRequest struct {
+	OrReplace   *bool
+	IfNotExists *bool
+	name        SchemaObjectIdentifier // required
+	body        string                 // required
+	Comment     *string
+}
+
+type AlterJoinPolicyRequest struct {
+	IfExists     *bool
+	name         SchemaObjectIdentifier // required
+	RenameTo     *SchemaObjectIdentifier
+	SetBody      *string
+	SetTags      []TagAssociation
+	UnsetTags    []ObjectIdentifier
+	SetComment   *string
+	UnsetComment *bool
+}
+
+type DropJoinPolicyRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier // required
+}
+
+type ShowJoinPolicyRequest struct {
+	Like  *Like
+	In    *In
+	Limit *LimitFrom
+}
+
+type DescribeJoinPolicyRequest struct {
+	name SchemaObjectIdentifier // required
+}
@@ -0,0 +1,135 @@
+package sdk
+
+func init() {
+	id := joinPoliciesTestIdSchemaObjectIdentifier
+	renameTarget := randomSchemaObjectIdentifier()
+	tagId := NewAccountObjectIdentifier("tag1")
+	tagId2 := NewAccountObjectIdentifier("tag2")
+
+	joinPoliciesTests.Create.
+		withDefaultOpts(func() *CreateJoinPolicyOptions {
+			return &CreateJoinPolicyOptions{
+				name: id,
+				body: "JOIN_CONSTRAINT(JOIN_REQUIRED => true)",
+			}
+		}).
+		withExpectedSqlf(
+			case_JoinPolicies_sql_Create_basic,
+			"CREATE JOIN POLICY %s AS () RETURNS JOIN_CONSTRAINT -> JOIN_CONSTRAINT(JOIN_REQUIRED => true)",
+			id.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_JoinPolicies_sql_Create_all,
+			func(opts *CreateJoinPolicyOptions) {
+				opts.OrReplace = new(true)
+				opts.Comment = new("some comment")
+			},
+			"CREATE OR REPLACE JOIN POLICY %s AS () RETURNS JOIN_CONSTRAINT -> JOIN_CONSTRAINT(JOIN_REQUIRED => true) COMMENT = 'some comment'",
+			id.FullyQualifiedName(),
+		)
+
+	joinPoliciesTests.Create.
+		withAdditionalSqlCasef(
+			"sql_Create_ifNotExists",
+			func(opts *CreateJoinPolicyOptions) {
+				opts.IfNotExists = new(true)
+			},
+			"CREATE JOIN POLICY IF NOT EXISTS %s AS () RETURNS JOIN_CONSTRAINT -> JOIN_CONSTRAINT(JOIN_REQUIRED => true)",
+			id.FullyQualifiedName(),
+		)
+
+	joinPoliciesTests.Alter.
+		withModifyAndExpectedSqlf(
+			case_JoinPolicies_sql_Alter_RenameTo,
+			func(opts *AlterJoinPolicyOptions) {
+				opts.IfExists = new(true)
+				opts.RenameTo = &renameTarget
+			},
+			"ALTER JOIN POLICY IF EXISTS %s RENAME TO %s",
+			id.FullyQualifiedName(), renameTarget.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_JoinPolicies_sql_Alter_SetBody,
+			func(opts *AlterJoinPolicyOptions) { opts.SetBody = new("JOIN_CONSTRAINT(JOIN_REQUIRED => true)") },
+			"ALTER JOIN POLICY %s SET BODY -> JOIN_CONSTRAINT(JOIN_REQUIRED => true)", id.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_JoinPolicies_sql_Alter_SetTags,
+			func(opts *AlterJoinPolicyOptions) {
+				opts.SetTags = []TagAssociation{
+					{Name: tagId, Value: "value1"},
+					{Name: tagId2, Value: "value2"},
+				}
+			},
+			"ALTER JOIN POLICY %s SET TAG %s = 'value1', %s = 'value2'",
+			id.FullyQualifiedName(), tagId.FullyQualifiedName(), tagId2.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_JoinPolicies_sql_Alter_UnsetTags,
+			func(opts *AlterJoinPolicyOptions) {
+				opts.UnsetTags = []ObjectIdentifier{tagId, tagId2}
+			},
+			"ALTER JOIN POLICY %s UNSET TAG %s, %s",
+			id.FullyQualifiedName(), tagId.FullyQualifiedName(), tagId2.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_JoinPolicies_sql_Alter_SetComment,
+			func(opts *AlterJoinPolicyOptions) { opts.SetComment = new("comment") },
+			"ALTER JOIN POLICY %s SET COMMENT = 'comment'", id.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_JoinPolicies_sql_Alter_UnsetComment,
+			func(opts *AlterJoinPolicyOptions) { opts.UnsetComment = new(true) },
+			"ALTER JOIN POLICY %s UNSET COMMENT", id.FullyQualifiedName(),
+		)
+
+	joinPoliciesTests.Drop.
+		withExpectedSqlf(
+			case_JoinPolicies_sql_Drop_basic,
+			"DROP JOIN POLICY %s", id.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_JoinPolicies_sql_Drop_all,
+			func(opts *DropJoinPolicyOptions) { opts.IfExists = new(true) },
+			"DROP JOIN POLICY IF EXISTS %s", id.FullyQualifiedName(),
+		)
+
+	joinPoliciesTests.Show.
+		withExpectedSql(case_JoinPolicies_sql_Show_basic, "SHOW JOIN POLICIES").
+		withModifyAndExpectedSqlf(
+			case_JoinPolicies_sql_Show_all,
+			func(opts *ShowJoinPolicyOptions) {
+				opts.Like = &Like{Pattern: new("pattern")}
+				opts.In = &In{Schema: id.SchemaId()}
+				opts.Limit = &LimitFrom{Rows: new(10), From: new("foo")}
+			},
+			"SHOW JOIN POLICIES LIKE 'pattern' IN SCHEMA %s LIMIT 10 FROM 'foo'", id.SchemaId().FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_JoinPolicies_sql_Show_Like,
+			func(opts *ShowJoinPolicyOptions) {
+				opts.Like = &Like{Pattern: new("pattern")}
+			},
+			"SHOW JOIN POLICIES LIKE 'pattern'",
+		).
+		withModifyAndExpectedSqlf(
+			case_JoinPolicies_sql_Show_In,
+			func(opts *ShowJoinPolicyOptions) {
+				opts.In = &In{Account: new(true)}
+			},
+			"SHOW JOIN POLICIES IN ACCOUNT",
+		).
+		withModifyAndExpectedSqlf(
+			case_JoinPolicies_sql_Show_Limit,
+			func(opts *ShowJoinPolicyOptions) {
+				opts.Limit = &LimitFrom{Rows: new(10)}
+			},
+			"SHOW JOIN POLICIES LIMIT 10",
+		)
+
+	joinPoliciesTests.Describe.
+		withExpectedSqlf(
+			case_JoinPolicies_sql_Describe_basic,
+			"DESCRIBE JOIN POLICY %s", id.FullyQualifiedName(),
+		)
+}
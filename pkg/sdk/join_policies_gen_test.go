@@ -0,0 +1,244 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+import (
+	"testing"
+)
+
+var joinPoliciesTestIdSchemaObjectIdentifier = randomSchemaObjectIdentifier()
+
+const (
+	case_JoinPolicies_validation_Create_name_ValidIdentifier                  testCaseName = "validation_Create_name_ValidIdentifier"
+	case_JoinPolicies_validation_Create_body_ValidateValueSet                 testCaseName = "validation_Create_body_ValidateValueSet"
+	case_JoinPolicies_validation_Create_opts_ConflictingFields                testCaseName = "validation_Create_opts_ConflictingFields"
+	case_JoinPolicies_sql_Create_basic                                        testCaseName = "sql_Create_basic"
+	case_JoinPolicies_sql_Create_all                                          testCaseName = "sql_Create_all"
+	case_JoinPolicies_validation_Alter_name_ValidIdentifier                   testCaseName = "validation_Alter_name_ValidIdentifier"
+	case_JoinPolicies_validation_Alter_opts_ExactlyOneValueSet_NoneSet        testCaseName = "validation_Alter_opts_ExactlyOneValueSet_NoneSet"
+	case_JoinPolicies_validation_Alter_opts_ExactlyOneValueSet_MoreThanOneSet testCaseName = "validation_Alter_opts_ExactlyOneValueSet_MoreThanOneSet"
+	case_JoinPolicies_sql_Alter_RenameTo                                      testCaseName = "sql_Alter_RenameTo"
+	case_JoinPolicies_sql_Alter_SetBody                                       testCaseName = "sql_Alter_SetBody"
+	case_JoinPolicies_sql_Alter_SetTags                                       testCaseName = "sql_Alter_SetTags"
+	case_JoinPolicies_sql_Alter_UnsetTags                                     testCaseName = "sql_Alter_UnsetTags"
+	case_JoinPolicies_sql_Alter_SetComment                                    testCaseName = "sql_Alter_SetComment"
+	case_JoinPolicies_sql_Alter_UnsetComment                                  testCaseName = "sql_Alter_UnsetComment"
+	case_JoinPolicies_validation_Drop_name_ValidIdentifier                    testCaseName = "validation_Drop_name_ValidIdentifier"
+	case_JoinPolicies_sql_Drop_basic                                          testCaseName = "sql_Drop_basic"
+	case_JoinPolicies_sql_Drop_all                                            testCaseName = "sql_Drop_all"
+	case_JoinPolicies_sql_Show_basic                                          testCaseName = "sql_Show_basic"
+	case_JoinPolicies_sql_Show_all                                            testCaseName = "sql_Show_all"
+	case_JoinPolicies_sql_Show_Like                                           testCaseName = "sql_Show_Like"
+	case_JoinPolicies_sql_Show_In                                             testCaseName = "sql_Show_In"
+	case_JoinPolicies_sql_Show_Limit                                          testCaseName = "sql_Show_Limit"
+	case_JoinPolicies_validation_Describe_name_ValidIdentifier                testCaseName = "validation_Describe_name_ValidIdentifier"
+	case_JoinPolicies_sql_Describe_basic                                      testCaseName = "sql_Describe_basic"
+)
+
+type JoinPoliciesTestsContext struct {
+	Create   *sdkTestCtx[*CreateJoinPolicyOptions]
+	Alter    *sdkTestCtx[*AlterJoinPolicyOptions]
+	Drop     *sdkTestCtx[*DropJoinPolicyOptions]
+	Show     *sdkTestCtx[*ShowJoinPolicyOptions]
+	Describe *sdkTestCtx[*DescribeJoinPolicyOptions]
+}
+
+var joinPoliciesTests = JoinPoliciesTestsContext{
+	Create: newSdkTestCtx[*CreateJoinPolicyOptions](
+		"JoinPolicies", "Create",
+	).
+		withDefaultOpts(func() *CreateJoinPolicyOptions {
+			return &CreateJoinPolicyOptions{
+				name: joinPoliciesTestIdSchemaObjectIdentifier,
+			}
+		}).
+		withValidationCases(
+			validationCase[*CreateJoinPolicyOptions]{
+				Name:        case_JoinPolicies_validation_Create_name_ValidIdentifier,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *CreateJoinPolicyOptions) {
+					opts.name = emptySchemaObjectIdentifier
+				},
+			},
+			validationCase[*CreateJoinPolicyOptions]{
+				Name:        case_JoinPolicies_validation_Create_body_ValidateValueSet,
+				ExpectedErr: errNotSet("CreateJoinPolicyOptions", "body"),
+				DefaultModify: func(opts *CreateJoinPolicyOptions) {
+					opts.body = ""
+				},
+			},
+			validationCase[*CreateJoinPolicyOptions]{
+				Name:        case_JoinPolicies_validation_Create_opts_ConflictingFields,
+				ExpectedErr: errOneOf("CreateJoinPolicyOptions", "OrReplace", "IfNotExists"),
+				DefaultModify: func(opts *CreateJoinPolicyOptions) {
+					opts.OrReplace = new(true)
+					opts.IfNotExists = new(true)
+				},
+			},
+		).
+		withSqlCases(
+			sqlCase[*CreateJoinPolicyOptions]{
+				Name:           case_JoinPolicies_sql_Create_basic,
+				NoModifyNeeded: true,
+			},
+			sqlCase[*CreateJoinPolicyOptions]{
+				Name: case_JoinPolicies_sql_Create_all,
+			},
+		),
+	Alter: newSdkTestCtx[*AlterJoinPolicyOptions](
+		"JoinPolicies", "Alter",
+	).
+		withDefaultOpts(func() *AlterJoinPolicyOptions {
+			return &AlterJoinPolicyOptions{
+				name: joinPoliciesTestIdSchemaObjectIdentifier,
+			}
+		}).
+		withValidationCases(
+			validationCase[*AlterJoinPolicyOptions]{
+				Name:        case_JoinPolicies_validation_Alter_name_ValidIdentifier,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *AlterJoinPolicyOptions) {
+					opts.name = emptySchemaObjectIdentifier
+				},
+			},
+			validationCase[*AlterJoinPolicyOptions]{
+				Name:        case_JoinPolicies_validation_Alter_opts_ExactlyOneValueSet_NoneSet,
+				ExpectedErr: errExactlyOneOf("AlterJoinPolicyOptions", "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"),
+				DefaultModify: func(opts *AlterJoinPolicyOptions) {
+					opts.RenameTo = nil
+					opts.SetBody = nil
+					opts.SetTags = nil
+					opts.UnsetTags = nil
+					opts.SetComment = nil
+					opts.UnsetComment = nil
+				},
+			},
+			validationCase[*AlterJoinPolicyOptions]{
+				Name:        case_JoinPolicies_validation_Alter_opts_ExactlyOneValueSet_MoreThanOneSet,
+				ExpectedErr: errExactlyOneOf("AlterJoinPolicyOptions", "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"),
+				DefaultModify: func(opts *AlterJoinPolicyOptions) {
+					opts.RenameTo = new(randomSchemaObjectIdentifier())
+					opts.SetBody = new("foo")
+				},
+			},
+		).
+		withSqlCases(
+			sqlCase[*AlterJoinPolicyOptions]{
+				Name: case_JoinPolicies_sql_Alter_RenameTo,
+			},
+			sqlCase[*AlterJoinPolicyOptions]{
+				Name: case_JoinPolicies_sql_Alter_SetBody,
+			},
+			sqlCase[*AlterJoinPolicyOptions]{
+				Name: case_JoinPolicies_sql_Alter_SetTags,
+			},
+			sqlCase[*AlterJoinPolicyOptions]{
+				Name: case_JoinPolicies_sql_Alter_UnsetTags,
+			},
+			sqlCase[*AlterJoinPolicyOptions]{
+				Name: case_JoinPolicies_sql_Alter_SetComment,
+			},
+			sqlCase[*AlterJoinPolicyOptions]{
+				Name: case_JoinPolicies_sql_Alter_UnsetComment,
+			},
+		),
+	Drop: newSdkTestCtx[*DropJoinPolicyOptions](
+		"JoinPolicies", "Drop",
+	).
+		withDefaultOpts(func() *DropJoinPolicyOptions {
+			return &DropJoinPolicyOptions{
+				name: joinPoliciesTestIdSchemaObjectIdentifier,
+			}
+		}).
+		withValidationCases(
+			validationCase[*DropJoinPolicyOptions]{
+				Name:        case_JoinPolicies_validation_Drop_name_ValidIdentifier,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *DropJoinPolicyOptions) {
+					opts.name = emptySchemaObjectIdentifier
+				},
+			},
+		).
+		withSqlCases(
+			sqlCase[*DropJoinPolicyOptions]{
+				Name:           case_JoinPolicies_sql_Drop_basic,
+				NoModifyNeeded: true,
+			},
+			sqlCase[*DropJoinPolicyOptions]{
+				Name: case_JoinPolicies_sql_Drop_all,
+			},
+		),
+	Show: newSdkTestCtx[*ShowJoinPolicyOptions](
+		"JoinPolicies", "Show",
+	).
+		withDefaultOpts(func() *ShowJoinPolicyOptions {
+			return &ShowJoinPolicyOptions{}
+		}).
+		withValidationCases().
+		withSqlCases(
+			sqlCase[*ShowJoinPolicyOptions]{
+				Name:           case_JoinPolicies_sql_Show_basic,
+				NoModifyNeeded: true,
+			},
+			sqlCase[*ShowJoinPolicyOptions]{
+				Name: case_JoinPolicies_sql_Show_all,
+			},
+			sqlCase[*ShowJoinPolicyOptions]{
+				Name: case_JoinPolicies_sql_Show_Like,
+			},
+			sqlCase[*ShowJoinPolicyOptions]{
+				Name: case_JoinPolicies_sql_Show_In,
+			},
+			sqlCase[*ShowJoinPolicyOptions]{
+				Name: case_JoinPolicies_sql_Show_Limit,
+			},
+		),
+	Describe: newSdkTestCtx[*DescribeJoinPolicyOptions](
+		"JoinPolicies", "Describe",
+	).
+		withDefaultOpts(func() *DescribeJoinPolicyOptions {
+			return &DescribeJoinPolicyOptions{
+				name: joinPoliciesTestIdSchemaObjectIdentifier,
+			}
+		}).
+		withValidationCases(
+			validationCase[*DescribeJoinPolicyOptions]{
+				Name:        case_JoinPolicies_validation_Describe_name_ValidIdentifier,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *DescribeJoinPolicyOptions) {
+					opts.name = emptySchemaObjectIdentifier
+				},
+			},
+		).
+		withSqlCases(
+			sqlCase[*DescribeJoinPolicyOptions]{
+				Name:           case_JoinPolicies_sql_Describe_basic,
+				NoModifyNeeded: true,
+			},
+		),
+}
+
+func TestJoinPolicies_Create(t *testing.T) {
+	joinPoliciesTests.Create.RunValidationCases(t)
+	joinPoliciesTests.Create.RunSqlCases(t)
+}
+
+func TestJoinPolicies_Alter(t *testing.T) {
+	joinPoliciesTests.Alter.RunValidationCases(t)
+	joinPoliciesTests.Alter.RunSqlCases(t)
+}
+
+func TestJoinPolicies_Drop(t *testing.T) {
+	joinPoliciesTests.Drop.RunValidationCases(t)
+	joinPoliciesTests.Drop.RunSqlCases(t)
+}
+
+func TestJoinPolicies_Show(t *testing.T) {
+	joinPoliciesTests.Show.RunValidationCases(t)
+	joinPoliciesTests.Show.RunSqlCases(t)
+}
+
+func TestJoinPolicies_Describe(t *testing.T) {
+	joinPoliciesTests.Describe.RunValidationCases(t)
+	joinPoliciesTests.Describe.RunSqlCases(t)
+}
@@ -103,6 +103,8 @@ const (
 	ObjectTypeOpenflowConnector      ObjectType = "OPENFLOW CONNECTOR"
 	ObjectTypeSnowflakeIntelligence  ObjectType = "SNOWFLAKE INTELLIGENCE"
 	ObjectTypeBackupPolicy           ObjectType = "BACKUP POLICY"
+	ObjectTypeBackupSet              ObjectType = "BACKUP SET"
+	ObjectTypeContact                ObjectType = "CONTACT"
 	// ObjectTypeProgrammaticAccessToken is a pseudo-object, as it does not support the usual operations in Snowflake, but it is handled by user functions.
 	// Programmatic access tokens do not have grants and cannot be tagged.
 	ObjectTypeProgrammaticAccessToken ObjectType = "PROGRAMMATIC ACCESS TOKEN" //nolint:gosec
@@ -222,6 +224,8 @@ var allObjectTypes = []ObjectType{
 	ObjectTypeProgrammaticAccessToken,
 	ObjectTypeCatalogIntegration,
 	ObjectTypeBackupPolicy,
+	ObjectTypeBackupSet,
+	ObjectTypeContact,
 }
 
 // TODO(SNOW-1834370): use ToObjectType in other places with type conversion (instead of sdk.ObjectType)
@@ -341,6 +345,8 @@ func objectTypeSingularToPluralMap() map[ObjectType]PluralObjectType {
 		ObjectTypeOpenflowConnector:       PluralObjectTypeOpenflowConnectors,
 		ObjectTypeCatalogIntegration:      PluralObjectTypeCatalogIntegrations,
 		ObjectTypeBackupPolicy:            PluralObjectTypeBackupPolicies,
+		ObjectTypeBackupSet:               PluralObjectTypeBackupSets,
+		ObjectTypeContact:                 PluralObjectTypeContacts,
 	}
 }
 
@@ -476,6 +482,8 @@ const (
 	PluralObjectTypeOpenflowConnectors       PluralObjectType = "OPENFLOW CONNECTORS"
 	PluralObjectTypeSnowflakeIntelligences   PluralObjectType = "SNOWFLAKE INTELLIGENCES"
 	PluralObjectTypeBackupPolicies           PluralObjectType = "BACKUP POLICIES"
+	PluralObjectTypeBackupSets               PluralObjectType = "BACKUP SETS"
+	PluralObjectTypeContacts                 PluralObjectType = "CONTACTS"
 )
 
 func (p PluralObjectType) String() string {
@@ -92,6 +92,7 @@ func Test_ToObjectType(t *testing.T) {
 		{input: "STORAGE LIFECYCLE POLICY", want: ObjectTypeStorageLifecyclePolicy},
 		{input: "WORKSPACE", want: ObjectTypeWorkspace},
 		{input: "CATALOG INTEGRATION", want: ObjectTypeCatalogIntegration},
+		{input: "CONTACT", want: ObjectTypeContact},
 	}
 
 	invalid := []test{
@@ -0,0 +1,78 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// openflowPollingInterval is the time between consecutive SHOW calls while waiting for Openflow objects,
+// which are provisioned, altered, and deleted asynchronously.
+var openflowPollingInterval = 10 * time.Second
+
+// pollUntilOpenflowStatusSettles polls doShowByID until the object leaves all the pendingStatuses.
+// It returns an error when the object reaches one of the failedStatuses or ctx is canceled.
+// Context cancellation is respected between polls.
+func pollUntilOpenflowStatusSettles[T any, S ~string](
+	ctx context.Context,
+	objectKind string,
+	doShowByID func() (*T, error),
+	statusOf func(*T) S,
+	pendingStatuses []S,
+	failedStatuses []S,
+) (*T, error) {
+	for {
+		object, err := doShowByID()
+		if err != nil {
+			return nil, err
+		}
+		status := statusOf(object)
+		if slices.Contains(failedStatuses, status) {
+			return nil, fmt.Errorf("%s reached %s status", objectKind, status)
+		}
+		if !slices.Contains(pendingStatuses, status) {
+			return object, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%s is still in %s status: %w", objectKind, status, ctx.Err())
+		case <-time.After(openflowPollingInterval):
+		}
+	}
+}
+
+// pollUntilOpenflowObjectDropped polls doShowByID until the object is no longer returned or reaches the deletedStatus.
+// It returns an error when the object reaches one of the failedStatuses or ctx is canceled.
+// Context cancellation is respected between polls.
+func pollUntilOpenflowObjectDropped[T any, S ~string](
+	ctx context.Context,
+	objectKind string,
+	doShowByID func() (*T, error),
+	statusOf func(*T) S,
+	deletedStatus S,
+	failedStatuses []S,
+) error {
+	for {
+		object, err := doShowByID()
+		if err != nil {
+			if errors.Is(err, ErrObjectNotFound) {
+				return nil
+			}
+			return err
+		}
+		status := statusOf(object)
+		if status == deletedStatus {
+			return nil
+		}
+		if slices.Contains(failedStatuses, status) {
+			return fmt.Errorf("%s reached %s status", objectKind, status)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s was not deleted, current status: %s: %w", objectKind, status, ctx.Err())
+		case <-time.After(openflowPollingInterval):
+		}
+	}
+}
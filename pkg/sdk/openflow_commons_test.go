@@ -0,0 +1,135 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubOpenflowRuntimes is a minimal test double for testing the Openflow polling logic without a live SDK client.
+type stubOpenflowRuntimes struct {
+	showStatuses []OpenflowRuntimeStatus // sequence of statuses returned by successive ShowByID calls
+	showIdx      int
+	showErr      error
+}
+
+func (s *stubOpenflowRuntimes) showByID() (*OpenflowRuntime, error) {
+	if s.showErr != nil {
+		return nil, s.showErr
+	}
+	if s.showIdx >= len(s.showStatuses) {
+		return nil, ErrObjectNotFound
+	}
+	status := s.showStatuses[s.showIdx]
+	s.showIdx++
+	return &OpenflowRuntime{Name: "test", Status: status}, nil
+}
+
+func withShortOpenflowPollingInterval(t *testing.T) {
+	t.Helper()
+	previous := openflowPollingInterval
+	openflowPollingInterval = time.Millisecond
+	t.Cleanup(func() { openflowPollingInterval = previous })
+}
+
+func TestPollUntilOpenflowStatusSettles(t *testing.T) {
+	withShortOpenflowPollingInterval(t)
+
+	poll := func(ctx context.Context, stub *stubOpenflowRuntimes) (*OpenflowRuntime, error) {
+		return pollUntilOpenflowStatusSettles(ctx, "openflow runtime", stub.showByID, openflowRuntimeStatus, openflowRuntimePendingStatuses, openflowRuntimeCreateFailedStatuses)
+	}
+
+	t.Run("returns object when immediately settled", func(t *testing.T) {
+		stub := &stubOpenflowRuntimes{showStatuses: []OpenflowRuntimeStatus{OpenflowRuntimeStatusActive}}
+		runtime, err := poll(context.Background(), stub)
+		require.NoError(t, err)
+		assert.Equal(t, OpenflowRuntimeStatusActive, runtime.Status)
+	})
+
+	t.Run("returns object after polling through pending statuses", func(t *testing.T) {
+		stub := &stubOpenflowRuntimes{
+			showStatuses: []OpenflowRuntimeStatus{
+				OpenflowRuntimeStatusCreating,
+				OpenflowRuntimeStatusActivating,
+				OpenflowRuntimeStatusActive,
+			},
+		}
+		runtime, err := poll(context.Background(), stub)
+		require.NoError(t, err)
+		assert.Equal(t, OpenflowRuntimeStatusActive, runtime.Status)
+		assert.Equal(t, 3, stub.showIdx)
+	})
+
+	t.Run("returns error on failed status", func(t *testing.T) {
+		stub := &stubOpenflowRuntimes{
+			showStatuses: []OpenflowRuntimeStatus{
+				OpenflowRuntimeStatusCreating,
+				OpenflowRuntimeStatusCreateFailed,
+			},
+		}
+		_, err := poll(context.Background(), stub)
+		require.ErrorContains(t, err, "openflow runtime reached CREATE_FAILED status")
+	})
+
+	t.Run("returns error when context is canceled before settling", func(t *testing.T) {
+		stub := &stubOpenflowRuntimes{showStatuses: []OpenflowRuntimeStatus{OpenflowRuntimeStatusCreating}}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := poll(ctx, stub)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("propagates ShowByID error", func(t *testing.T) {
+		showErr := errors.New("show failed")
+		stub := &stubOpenflowRuntimes{showErr: showErr}
+		_, err := poll(context.Background(), stub)
+		require.ErrorIs(t, err, showErr)
+	})
+}
+
+func TestPollUntilOpenflowObjectDropped(t *testing.T) {
+	withShortOpenflowPollingInterval(t)
+
+	poll := func(ctx context.Context, stub *stubOpenflowRuntimes) error {
+		return pollUntilOpenflowObjectDropped(ctx, "openflow runtime", stub.showByID, openflowRuntimeStatus, OpenflowRuntimeStatusDeleted, []OpenflowRuntimeStatus{OpenflowRuntimeStatusDeleteFailed})
+	}
+
+	t.Run("returns when object is not found", func(t *testing.T) {
+		stub := &stubOpenflowRuntimes{}
+		require.NoError(t, poll(context.Background(), stub))
+	})
+
+	t.Run("returns after polling through deleting status", func(t *testing.T) {
+		stub := &stubOpenflowRuntimes{
+			showStatuses: []OpenflowRuntimeStatus{
+				OpenflowRuntimeStatusDeleting,
+				OpenflowRuntimeStatusDeleting,
+				OpenflowRuntimeStatusDeleted,
+			},
+		}
+		require.NoError(t, poll(context.Background(), stub))
+		assert.Equal(t, 3, stub.showIdx)
+	})
+
+	t.Run("returns error on failed status", func(t *testing.T) {
+		stub := &stubOpenflowRuntimes{showStatuses: []OpenflowRuntimeStatus{OpenflowRuntimeStatusDeleteFailed}}
+		require.ErrorContains(t, poll(context.Background(), stub), "openflow runtime reached DELETE_FAILED status")
+	})
+
+	t.Run("returns error when context is canceled before deletion", func(t *testing.T) {
+		stub := &stubOpenflowRuntimes{showStatuses: []OpenflowRuntimeStatus{OpenflowRuntimeStatusDeleting}}
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		require.ErrorIs(t, poll(ctx, stub), context.Canceled)
+	})
+
+	t.Run("propagates ShowByID error", func(t *testing.T) {
+		showErr := errors.New("show failed")
+		stub := &stubOpenflowRuntimes{showErr: showErr}
+		require.ErrorIs(t, poll(context.Background(), stub), showErr)
+	})
+}
@@ -1,5 +1,52 @@
 package sdk
 
+import "context"
+
 func (r *CreateOpenflowConnectorRequest) GetName() SchemaObjectIdentifier {
 	return r.name
 }
+
+var (
+	openflowConnectorPendingStatuses = []OpenflowConnectorStatus{
+		OpenflowConnectorStatusCreating,
+		OpenflowConnectorStatusStarting,
+		OpenflowConnectorStatusStopping,
+		OpenflowConnectorStatusUpdating,
+	}
+	openflowConnectorCreateFailedStatuses = []OpenflowConnectorStatus{
+		OpenflowConnectorStatusCreateFailed,
+	}
+)
+
+// CreateSafely creates an Openflow connector and polls ShowByID until the connector leaves all
+// the transitional statuses. The caller controls the wait budget via ctx — use
+// context.WithTimeout to set a deadline.
+func (v *openflowConnectors) CreateSafely(ctx context.Context, req *CreateOpenflowConnectorRequest) (*OpenflowConnector, error) {
+	if err := v.Create(ctx, req); err != nil {
+		return nil, err
+	}
+	return pollUntilOpenflowStatusSettles(
+		ctx,
+		"openflow connector",
+		func() (*OpenflowConnector, error) { return v.ShowByID(ctx, req.GetName()) },
+		func(c *OpenflowConnector) OpenflowConnectorStatus { return c.Status },
+		openflowConnectorPendingStatuses,
+		openflowConnectorCreateFailedStatuses,
+	)
+}
+
+// DropSafelyAndWait drops an Openflow connector and polls ShowByID until the connector is gone
+// or reaches the DELETED status. The caller controls the wait budget via ctx.
+func (v *openflowConnectors) DropSafelyAndWait(ctx context.Context, id SchemaObjectIdentifier) error {
+	if err := v.DropSafely(ctx, id); err != nil {
+		return err
+	}
+	return pollUntilOpenflowObjectDropped(
+		ctx,
+		"openflow connector",
+		func() (*OpenflowConnector, error) { return v.ShowByID(ctx, id) },
+		func(c *OpenflowConnector) OpenflowConnectorStatus { return c.Status },
+		OpenflowConnectorStatusDeleted,
+		[]OpenflowConnectorStatus{OpenflowConnectorStatusDeleteFailed},
+	)
+}
@@ -17,6 +17,14 @@ type OpenflowConnectors interface {
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*OpenflowConnector, error)
 	ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*OpenflowConnector, error)
 	Describe(ctx context.Context, id SchemaObjectIdentifier) (*OpenflowConnectorDetails, error)
+	// CreateSafely creates the connector and polls until it finishes provisioning.
+	// The caller should set a deadline on ctx via context.WithTimeout.
+	// Implemented in openflow_connectors_ext.go.
+	CreateSafely(ctx context.Context, request *CreateOpenflowConnectorRequest) (*OpenflowConnector, error)
+	// DropSafelyAndWait drops the connector and polls until it is deleted.
+	// The caller should set a deadline on ctx via context.WithTimeout.
+	// Implemented in openflow_connectors_ext.go.
+	DropSafelyAndWait(ctx context.Context, id SchemaObjectIdentifier) error
 }
 
 // CreateOpenflowConnectorOptions is based on TODO: add link when public docs are available.
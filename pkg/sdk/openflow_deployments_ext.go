@@ -1,5 +1,50 @@
 package sdk
 
+import "context"
+
 func (r *CreateOpenflowDeploymentRequest) GetName() AccountObjectIdentifier {
 	return r.name
 }
+
+var (
+	openflowDeploymentPendingStatuses = []OpenflowDeploymentStatus{
+		OpenflowDeploymentStatusCreating,
+		OpenflowDeploymentStatusProvisioning,
+	}
+	openflowDeploymentCreateFailedStatuses = []OpenflowDeploymentStatus{
+		OpenflowDeploymentStatusCreateFailed,
+	}
+)
+
+// CreateSafely creates an Openflow deployment and polls ShowByID until the deployment leaves
+// the CREATING and PROVISIONING statuses. The caller controls the wait budget via ctx — use
+// context.WithTimeout to set a deadline.
+func (v *openflowDeployments) CreateSafely(ctx context.Context, req *CreateOpenflowDeploymentRequest) (*OpenflowDeployment, error) {
+	if err := v.Create(ctx, req); err != nil {
+		return nil, err
+	}
+	return pollUntilOpenflowStatusSettles(
+		ctx,
+		"openflow deployment",
+		func() (*OpenflowDeployment, error) { return v.ShowByID(ctx, req.GetName()) },
+		func(d *OpenflowDeployment) OpenflowDeploymentStatus { return d.Status },
+		openflowDeploymentPendingStatuses,
+		openflowDeploymentCreateFailedStatuses,
+	)
+}
+
+// DropSafelyAndWait drops an Openflow deployment and polls ShowByID until the deployment is gone
+// or reaches the DELETED status. The caller controls the wait budget via ctx.
+func (v *openflowDeployments) DropSafelyAndWait(ctx context.Context, id AccountObjectIdentifier) error {
+	if err := v.DropSafely(ctx, id); err != nil {
+		return err
+	}
+	return pollUntilOpenflowObjectDropped(
+		ctx,
+		"openflow deployment",
+		func() (*OpenflowDeployment, error) { return v.ShowByID(ctx, id) },
+		func(d *OpenflowDeployment) OpenflowDeploymentStatus { return d.Status },
+		OpenflowDeploymentStatusDeleted,
+		[]OpenflowDeploymentStatus{OpenflowDeploymentStatusDeleteFailed},
+	)
+}
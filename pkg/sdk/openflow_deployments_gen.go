@@ -17,6 +17,14 @@ type OpenflowDeployments interface {
 	ShowByID(ctx context.Context, id AccountObjectIdentifier) (*OpenflowDeployment, error)
 	ShowByIDSafely(ctx context.Context, id AccountObjectIdentifier) (*OpenflowDeployment, error)
 	Describe(ctx context.Context, id AccountObjectIdentifier) (*OpenflowDeploymentDetails, error)
+	// CreateSafely creates the deployment and polls until it finishes provisioning.
+	// The caller should set a deadline on ctx via context.WithTimeout.
+	// Implemented in openflow_deployments_ext.go.
+	CreateSafely(ctx context.Context, request *CreateOpenflowDeploymentRequest) (*OpenflowDeployment, error)
+	// DropSafelyAndWait drops the deployment and polls until it is deleted.
+	// The caller should set a deadline on ctx via context.WithTimeout.
+	// Implemented in openflow_deployments_ext.go.
+	DropSafelyAndWait(ctx context.Context, id AccountObjectIdentifier) error
 }
 
 // CreateOpenflowDeploymentOptions is based on TODO: add link when public docs are available.
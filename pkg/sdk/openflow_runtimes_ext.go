@@ -1,9 +1,93 @@
 package sdk
 
+import "context"
+
 func (r *CreateOpenflowRuntimeRequest) GetName() SchemaObjectIdentifier {
 	return r.name
 }
 
+func (r *AlterOpenflowRuntimeRequest) GetName() SchemaObjectIdentifier {
+	return r.name
+}
+
 // OpenflowRuntime intentionally has no ID() method. SHOW OPENFLOW RUNTIMES does not return
 // database_name or schema_name columns, so a SchemaObjectIdentifier cannot be reconstructed
 // from the row alone. ShowByID threads the caller's identifier context to work around this.
+
+var (
+	openflowRuntimePendingStatuses = []OpenflowRuntimeStatus{
+		OpenflowRuntimeStatusCreating,
+		OpenflowRuntimeStatusActivating,
+		OpenflowRuntimeStatusUpdating,
+		OpenflowRuntimeStatusSuspending,
+		OpenflowRuntimeStatusRestarting,
+		OpenflowRuntimeStatusUpgrading,
+		OpenflowRuntimeStatusCancelRequested,
+		OpenflowRuntimeStatusCleaningUp,
+		OpenflowRuntimeStatusGeneratingDiagnosticBundle,
+	}
+	openflowRuntimeCreateFailedStatuses = []OpenflowRuntimeStatus{
+		OpenflowRuntimeStatusCreateFailed,
+		OpenflowRuntimeStatusActivateFailed,
+	}
+	openflowRuntimeAlterFailedStatuses = []OpenflowRuntimeStatus{
+		OpenflowRuntimeStatusUpdateFailed,
+	}
+)
+
+func openflowRuntimeStatus(r *OpenflowRuntime) OpenflowRuntimeStatus {
+	return r.Status
+}
+
+// CreateSafely creates an Openflow runtime and polls ShowByID until the runtime leaves all
+// the transitional statuses. The caller controls the wait budget via ctx — use
+// context.WithTimeout to set a deadline.
+func (v *openflowRuntimes) CreateSafely(ctx context.Context, req *CreateOpenflowRuntimeRequest) (*OpenflowRuntime, error) {
+	if err := v.Create(ctx, req); err != nil {
+		return nil, err
+	}
+	return pollUntilOpenflowStatusSettles(
+		ctx,
+		"openflow runtime",
+		func() (*OpenflowRuntime, error) { return v.ShowByID(ctx, req.GetName()) },
+		openflowRuntimeStatus,
+		openflowRuntimePendingStatuses,
+		openflowRuntimeCreateFailedStatuses,
+	)
+}
+
+// AlterSafely waits until the runtime leaves all the transitional statuses (Snowflake rejects
+// changes of a runtime that is still being provisioned or scaled), alters it, and waits again
+// until the change is applied. The caller controls the wait budget via ctx.
+func (v *openflowRuntimes) AlterSafely(ctx context.Context, req *AlterOpenflowRuntimeRequest) error {
+	doShowByID := func() (*OpenflowRuntime, error) { return v.ShowByID(ctx, req.GetName()) }
+	if _, err := pollUntilOpenflowStatusSettles(ctx, "openflow runtime", doShowByID, openflowRuntimeStatus, openflowRuntimePendingStatuses, nil); err != nil {
+		return err
+	}
+	if err := v.Alter(ctx, req); err != nil {
+		return err
+	}
+	if req.RenameTo != nil {
+		newId := *req.RenameTo
+		doShowByID = func() (*OpenflowRuntime, error) { return v.ShowByID(ctx, newId) }
+	}
+	_, err := pollUntilOpenflowStatusSettles(ctx, "openflow runtime", doShowByID, openflowRuntimeStatus, openflowRuntimePendingStatuses, openflowRuntimeAlterFailedStatuses)
+	return err
+}
+
+// DropSafelyAndWait drops an Openflow runtime and polls ShowByID until the runtime is gone
+// or reaches the DELETED status. The deployment can be dropped only after all its runtimes are deleted.
+// The caller controls the wait budget via ctx.
+func (v *openflowRuntimes) DropSafelyAndWait(ctx context.Context, id SchemaObjectIdentifier) error {
+	if err := v.DropSafely(ctx, id); err != nil {
+		return err
+	}
+	return pollUntilOpenflowObjectDropped(
+		ctx,
+		"openflow runtime",
+		func() (*OpenflowRuntime, error) { return v.ShowByID(ctx, id) },
+		openflowRuntimeStatus,
+		OpenflowRuntimeStatusDeleted,
+		[]OpenflowRuntimeStatus{OpenflowRuntimeStatusDeleteFailed},
+	)
+}
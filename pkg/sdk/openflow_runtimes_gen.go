@@ -17,6 +17,18 @@ type OpenflowRuntimes interface {
 	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*OpenflowRuntime, error)
 	ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*OpenflowRuntime, error)
 	Describe(ctx context.Context, id SchemaObjectIdentifier) (*OpenflowRuntimeDetails, error)
+	// CreateSafely creates the runtime and polls until it finishes provisioning.
+	// The caller should set a deadline on ctx via context.WithTimeout.
+	// Implemented in openflow_runtimes_ext.go.
+	CreateSafely(ctx context.Context, request *CreateOpenflowRuntimeRequest) (*OpenflowRuntime, error)
+	// AlterSafely waits until the runtime is not in a transitional status, alters it, and polls until the change is applied.
+	// The caller should set a deadline on ctx via context.WithTimeout.
+	// Implemented in openflow_runtimes_ext.go.
+	AlterSafely(ctx context.Context, request *AlterOpenflowRuntimeRequest) error
+	// DropSafelyAndWait drops the runtime and polls until it is deleted.
+	// The caller should set a deadline on ctx via context.WithTimeout.
+	// Implemented in openflow_runtimes_ext.go.
+	DropSafelyAndWait(ctx context.Context, id SchemaObjectIdentifier) error
 }
 
 // CreateOpenflowRuntimeOptions is based on TODO: add link when public docs are available.
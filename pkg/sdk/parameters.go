@@ -855,6 +855,7 @@ const (
 	ObjectParameterDefaultNotebookComputePoolCpu           ObjectParameter = "DEFAULT_NOTEBOOK_COMPUTE_POOL_CPU"
 	ObjectParameterDefaultNotebookComputePoolGpu           ObjectParameter = "DEFAULT_NOTEBOOK_COMPUTE_POOL_GPU"
 	ObjectParameterEnableConsoleOutput                     ObjectParameter = "ENABLE_CONSOLE_OUTPUT"
+	ObjectParameterEventTable                              ObjectParameter = "EVENT_TABLE"
 
 	// User Parameters
 	ObjectParameterEnableNotebookCreationInPersonalDb ObjectParameter = "ENABLE_NOTEBOOK_CREATION_IN_PERSONAL_DB"
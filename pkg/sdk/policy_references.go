@@ -9,6 +9,7 @@ import (
 
 type PolicyReferences interface {
 	GetForEntity(ctx context.Context, request *GetForEntityPolicyReferenceRequest) ([]PolicyReference, error)
+	GetForPolicy(ctx context.Context, request *GetForPolicyPolicyReferenceRequest) ([]PolicyReference, error)
 }
 
 type getForEntityPolicyReferenceOptions struct {
@@ -21,6 +22,21 @@ type policyReferenceParameters struct {
 	arguments                  *policyReferenceFunctionArguments `ddl:"list,parentheses"`
 }
 
+// getForPolicyPolicyReferenceOptions is based on https://docs.snowflake.com/en/sql-reference/functions/policy_references.
+type getForPolicyPolicyReferenceOptions struct {
+	selectEverythingFrom bool                                `ddl:"static" sql:"SELECT * FROM TABLE"`
+	parameters           *policyReferenceForPolicyParameters `ddl:"list,parentheses,no_comma"`
+}
+
+type policyReferenceForPolicyParameters struct {
+	functionFullyQualifiedName bool                                       `ddl:"static" sql:"SNOWFLAKE.INFORMATION_SCHEMA.POLICY_REFERENCES"`
+	arguments                  *policyReferenceForPolicyFunctionArguments `ddl:"list,parentheses"`
+}
+
+type policyReferenceForPolicyFunctionArguments struct {
+	policyName []ObjectIdentifier `ddl:"parameter,single_quotes,arrow_equals" sql:"POLICY_NAME"`
+}
+
 type PolicyEntityDomain string
 
 const (
@@ -80,7 +96,9 @@ const (
 	PolicyKindAggregationPolicy      PolicyKind = "AGGREGATION_POLICY"
 	PolicyKindAuthenticationPolicy   PolicyKind = "AUTHENTICATION_POLICY"
 	PolicyKindFeaturePolicy          PolicyKind = "FEATURE_POLICY"
+	PolicyKindJoinPolicy             PolicyKind = "JOIN_POLICY"
 	PolicyKindMaskingPolicy          PolicyKind = "MASKING_POLICY"
+	PolicyKindNetworkPolicy          PolicyKind = "NETWORK_POLICY"
 	PolicyKindPackagesPolicy         PolicyKind = "PACKAGES_POLICY"
 	PolicyKindPasswordPolicy         PolicyKind = "PASSWORD_POLICY"
 	PolicyKindProjectionPolicy       PolicyKind = "PROJECTION_POLICY"
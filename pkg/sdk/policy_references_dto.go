@@ -1,6 +1,9 @@
 package sdk
 
-var _ optionsProvider[getForEntityPolicyReferenceOptions] = new(GetForEntityPolicyReferenceRequest)
+var (
+	_ optionsProvider[getForEntityPolicyReferenceOptions] = new(GetForEntityPolicyReferenceRequest)
+	_ optionsProvider[getForPolicyPolicyReferenceOptions] = new(GetForPolicyPolicyReferenceRequest)
+)
 
 //go:generate go run ./dto-builder-generator/main.go
 
@@ -19,3 +22,17 @@ func (request *GetForEntityPolicyReferenceRequest) toOpts() *getForEntityPolicyR
 		},
 	}
 }
+
+type GetForPolicyPolicyReferenceRequest struct {
+	PolicyName ObjectIdentifier // required
+}
+
+func (request *GetForPolicyPolicyReferenceRequest) toOpts() *getForPolicyPolicyReferenceOptions {
+	return &getForPolicyPolicyReferenceOptions{
+		parameters: &policyReferenceForPolicyParameters{
+			arguments: &policyReferenceForPolicyFunctionArguments{
+				policyName: []ObjectIdentifier{request.PolicyName},
+			},
+		},
+	}
+}
@@ -2,6 +2,8 @@
 
 package sdk
 
+import ()
+
 func NewGetForEntityPolicyReferenceRequest(
 	RefEntityName ObjectIdentifier,
 	RefEntityDomain PolicyEntityDomain,
@@ -11,3 +13,11 @@ func NewGetForEntityPolicyReferenceRequest(
 	s.RefEntityDomain = RefEntityDomain
 	return &s
 }
+
+func NewGetForPolicyPolicyReferenceRequest(
+	PolicyName ObjectIdentifier,
+) *GetForPolicyPolicyReferenceRequest {
+	s := GetForPolicyPolicyReferenceRequest{}
+	s.PolicyName = PolicyName
+	return &s
+}
@@ -19,3 +19,12 @@ func (v *policyReference) GetForEntity(ctx context.Context, request *GetForEntit
 	}
 	return convertRows[policyReferenceDBRow, PolicyReference](dbRows)
 }
+
+func (v *policyReference) GetForPolicy(ctx context.Context, request *GetForPolicyPolicyReferenceRequest) ([]PolicyReference, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[policyReferenceDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[policyReferenceDBRow, PolicyReference](dbRows)
+}
@@ -116,6 +116,52 @@ func TestPolicyReferencesGetForEntity(t *testing.T) {
 	})
 }
 
+func TestPolicyReferencesGetForPolicy(t *testing.T) {
+	t.Run("validation: missing parameters", func(t *testing.T) {
+		opts := &getForPolicyPolicyReferenceOptions{}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("getForPolicyPolicyReferenceOptions", "parameters"))
+	})
+
+	t.Run("validation: missing arguments", func(t *testing.T) {
+		opts := &getForPolicyPolicyReferenceOptions{
+			parameters: &policyReferenceForPolicyParameters{},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("policyReferenceForPolicyParameters", "arguments"))
+	})
+
+	t.Run("validation: missing policyName", func(t *testing.T) {
+		opts := &getForPolicyPolicyReferenceOptions{
+			parameters: &policyReferenceForPolicyParameters{
+				arguments: &policyReferenceForPolicyFunctionArguments{},
+			},
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errNotSet("policyReferenceForPolicyFunctionArguments", "policyName"))
+	})
+
+	t.Run("schema-level policy", func(t *testing.T) {
+		id := randomSchemaObjectIdentifier()
+		opts := &getForPolicyPolicyReferenceOptions{
+			parameters: &policyReferenceForPolicyParameters{
+				arguments: &policyReferenceForPolicyFunctionArguments{
+					policyName: []ObjectIdentifier{id},
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SELECT * FROM TABLE (SNOWFLAKE.INFORMATION_SCHEMA.POLICY_REFERENCES (POLICY_NAME => '%s'))`, temporaryReplace(id))
+	})
+
+	t.Run("account-level policy", func(t *testing.T) {
+		opts := &getForPolicyPolicyReferenceOptions{
+			parameters: &policyReferenceForPolicyParameters{
+				arguments: &policyReferenceForPolicyFunctionArguments{
+					policyName: []ObjectIdentifier{NewAccountObjectIdentifier("network_policy_name")},
+				},
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `SELECT * FROM TABLE (SNOWFLAKE.INFORMATION_SCHEMA.POLICY_REFERENCES (POLICY_NAME => '\"network_policy_name\"'))`)
+	})
+}
+
 // TODO [SNOW-1569516]: make nicer during the identifiers rework follow up
 func temporaryReplace(id SchemaObjectIdentifier) string {
 	return strings.ReplaceAll(id.FullyQualifiedName(), `"`, `\"`)
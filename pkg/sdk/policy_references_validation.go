@@ -4,7 +4,10 @@ import (
 	"errors"
 )
 
-var _ validatable = new(getForEntityPolicyReferenceOptions)
+var (
+	_ validatable = new(getForEntityPolicyReferenceOptions)
+	_ validatable = new(getForPolicyPolicyReferenceOptions)
+)
 
 func (opts *getForEntityPolicyReferenceOptions) validate() error {
 	if opts == nil {
@@ -27,3 +30,22 @@ func (opts *getForEntityPolicyReferenceOptions) validate() error {
 	}
 	return errors.Join(errs...)
 }
+
+func (opts *getForPolicyPolicyReferenceOptions) validate() error {
+	if opts == nil {
+		return errors.Join(ErrNilOptions)
+	}
+	var errs []error
+	if !valueSet(opts.parameters) {
+		errs = append(errs, errNotSet("getForPolicyPolicyReferenceOptions", "parameters"))
+	} else {
+		if !valueSet(opts.parameters.arguments) {
+			errs = append(errs, errNotSet("policyReferenceForPolicyParameters", "arguments"))
+		} else {
+			if opts.parameters.arguments.policyName == nil {
+				errs = append(errs, errNotSet("policyReferenceForPolicyFunctionArguments", "policyName"))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,112 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+func NewCreateProjectionPolicyRequest(
+	name SchemaObjectIdentifier,
+	body string,
+) *CreateProjectionPolicyRequest {
+	s := CreateProjectionPolicyRequest{}
+	s.name = name
+	s.body = body
+	return &s
+}
+
+func (s *CreateProjectionPolicyRequest) WithOrReplace(orReplace bool) *CreateProjectionPolicyRequest {
+	s.OrReplace = &orReplace
+	return s
+}
+
+func (s *CreateProjectionPolicyRequest) WithIfNotExists(ifNotExists bool) *CreateProjectionPolicyRequest {
+	s.IfNotExists = &ifNotExists
+	return s
+}
+
+func (s *CreateProjectionPolicyRequest) WithComment(comment string) *CreateProjectionPolicyRequest {
+	s.Comment = &comment
+	return s
+}
+
+func NewAlterProjectionPolicyRequest(
+	name SchemaObjectIdentifier,
+) *AlterProjectionPolicyRequest {
+	s := AlterProjectionPolicyRequest{}
+	s.name = name
+	return &s
+}
+
+func (s *AlterProjectionPolicyRequest) WithIfExists(ifExists bool) *AlterProjectionPolicyRequest {
+	s.IfExists = &ifExists
+	return s
+}
+
+func (s *AlterProjectionPolicyRequest) WithRenameTo(renameTo SchemaObjectIdentifier) *AlterProjectionPolicyRequest {
+	s.RenameTo = &renameTo
+	return s
+}
+
+func (s *AlterProjectionPolicyRequest) WithSetBody(setBody string) *AlterProjectionPolicyRequest {
+	s.SetBody = &setBody
+	return s
+}
+
+func (s *AlterProjectionPolicyRequest) WithSetTags(setTags []TagAssociation) *AlterProjectionPolicyRequest {
+	s.SetTags = setTags
+	return s
+}
+
+func (s *AlterProjectionPolicyRequest) WithUnsetTags(unsetTags []ObjectIdentifier) *AlterProjectionPolicyRequest {
+	s.UnsetTags = unsetTags
+	return s
+}
+
+func (s *AlterProjectionPolicyRequest) WithSetComment(setComment string) *AlterProjectionPolicyRequest {
+	s.SetComment = &setComment
+	return s
+}
+
+func (s *AlterProjectionPolicyRequest) WithUnsetComment(unsetComment bool) *AlterProjectionPolicyRequest {
+	s.UnsetComment = &unsetComment
+	return s
+}
+
+func NewDropProjectionPolicyRequest(
+	name SchemaObjectIdentifier,
+) *DropProjectionPolicyRequest {
+	s := DropProjectionPolicyRequest{}
+	s.name = name
+	return &s
+}
+
+func (s *DropProjectionPolicyRequest) WithIfExists(ifExists bool) *DropProjectionPolicyRequest {
+	s.IfExists = &ifExists
+	return s
+}
+
+func NewShowProjectionPolicyRequest() *ShowProjectionPolicyRequest {
+	s := ShowProjectionPolicyRequest{}
+	return &s
+}
+
+func (s *ShowProjectionPolicyRequest) WithLike(like Like) *ShowProjectionPolicyRequest {
+	s.Like = &like
+	return s
+}
+
+func (s *ShowProjectionPolicyRequest) WithIn(in In) *ShowProjectionPolicyRequest {
+	s.In = &in
+	return s
+}
+
+func (s *ShowProjectionPolicyRequest) WithLimit(limit LimitFrom) *ShowProjectionPolicyRequest {
+	s.Limit = &limit
+	return s
+}
+
+func NewDescribeProjectionPolicyRequest(
+	name SchemaObjectIdentifier,
+) *DescribeProjectionPolicyRequest {
+	s := DescribeProjectionPolicyRequest{}
+	s.name = name
+	return &s
+}
@@ -0,0 +1,45 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+var (
+	_ optionsProvider[CreateProjectionPolicyOptions]   = new(CreateProjectionPolicyRequest)
+	_ optionsProvider[AlterProjectionPolicyOptions]    = new(AlterProjectionPolicyRequest)
+	_ optionsProvider[DropProjectionPolicyOptions]     = new(DropProjectionPolicyRequest)
+	_ optionsProvider[ShowProjectionPolicyOptions]     = new(ShowProjectionPolicyRequest)
+	_ optionsProvider[DescribeProjectionPolicyOptions] = new(DescribeProjectionPolicyRequest)
+)
+
+type CreateProjectionPolicyRequest struct {
+	OrReplace   *bool
+	IfNotExists *bool
+	name        SchemaObjectIdentifier // required
+	body        string                 // required
+	Comment     *string
+}
+
+type AlterProjectionPolicyRequest struct {
+	IfExists     *bool
+	name         SchemaObjectIdentifier // required
+	RenameTo     *SchemaObjectIdentifier
+	SetBody      *string
+	SetTags      []TagAssociation
+	UnsetTags    []ObjectIdentifier
+	SetComment   *string
+	UnsetComment *bool
+}
+
+type DropProjectionPolicyRequest struct {
+	IfExists *bool
+	name     SchemaObjectIdentifier // required
+}
+
+type ShowProjectionPolicyRequest struct {
+	Like  *Like
+	In    *In
+	Limit *LimitFrom
+}
+
+type DescribeProjectionPolicyRequest struct {
+	name SchemaObjectIdentifier // required
+}
@@ -0,0 +1,135 @@
+package sdk
+
+func init() {
+	id := projectionPoliciesTestIdSchemaObjectIdentifier
+	renameTarget := randomSchemaObjectIdentifier()
+	tagId := NewAccountObjectIdentifier("tag1")
+	tagId2 := NewAccountObjectIdentifier("tag2")
+
+	projectionPoliciesTests.Create.
+		withDefaultOpts(func() *CreateProjectionPolicyOptions {
+			return &CreateProjectionPolicyOptions{
+				name: id,
+				body: "PROJECTION_CONSTRAINT(ALLOW => false)",
+			}
+		}).
+		withExpectedSqlf(
+			case_ProjectionPolicies_sql_Create_basic,
+			"CREATE PROJECTION POLICY %s AS () RETURNS PROJECTION_CONSTRAINT -> PROJECTION_CONSTRAINT(ALLOW => false)",
+			id.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_ProjectionPolicies_sql_Create_all,
+			func(opts *CreateProjectionPolicyOptions) {
+				opts.OrReplace = new(true)
+				opts.Comment = new("some comment")
+			},
+			"CREATE OR REPLACE PROJECTION POLICY %s AS () RETURNS PROJECTION_CONSTRAINT -> PROJECTION_CONSTRAINT(ALLOW => false) COMMENT = 'some comment'",
+			id.FullyQualifiedName(),
+		)
+
+	projectionPoliciesTests.Create.
+		withAdditionalSqlCasef(
+			"sql_Create_ifNotExists",
+			func(opts *CreateProjectionPolicyOptions) {
+				opts.IfNotExists = new(true)
+			},
+			"CREATE PROJECTION POLICY IF NOT EXISTS %s AS () RETURNS PROJECTION_CONSTRAINT -> PROJECTION_CONSTRAINT(ALLOW => false)",
+			id.FullyQualifiedName(),
+		)
+
+	projectionPoliciesTests.Alter.
+		withModifyAndExpectedSqlf(
+			case_ProjectionPolicies_sql_Alter_RenameTo,
+			func(opts *AlterProjectionPolicyOptions) {
+				opts.IfExists = new(true)
+				opts.RenameTo = &renameTarget
+			},
+			"ALTER PROJECTION POLICY IF EXISTS %s RENAME TO %s",
+			id.FullyQualifiedName(), renameTarget.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_ProjectionPolicies_sql_Alter_SetBody,
+			func(opts *AlterProjectionPolicyOptions) { opts.SetBody = new("PROJECTION_CONSTRAINT(ALLOW => false)") },
+			"ALTER PROJECTION POLICY %s SET BODY -> PROJECTION_CONSTRAINT(ALLOW => false)", id.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_ProjectionPolicies_sql_Alter_SetTags,
+			func(opts *AlterProjectionPolicyOptions) {
+				opts.SetTags = []TagAssociation{
+					{Name: tagId, Value: "value1"},
+					{Name: tagId2, Value: "value2"},
+				}
+			},
+			"ALTER PROJECTION POLICY %s SET TAG %s = 'value1', %s = 'value2'",
+			id.FullyQualifiedName(), tagId.FullyQualifiedName(), tagId2.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_ProjectionPolicies_sql_Alter_UnsetTags,
+			func(opts *AlterProjectionPolicyOptions) {
+				opts.UnsetTags = []ObjectIdentifier{tagId, tagId2}
+			},
+			"ALTER PROJECTION POLICY %s UNSET TAG %s, %s",
+			id.FullyQualifiedName(), tagId.FullyQualifiedName(), tagId2.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_ProjectionPolicies_sql_Alter_SetComment,
+			func(opts *AlterProjectionPolicyOptions) { opts.SetComment = new("comment") },
+			"ALTER PROJECTION POLICY %s SET COMMENT = 'comment'", id.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_ProjectionPolicies_sql_Alter_UnsetComment,
+			func(opts *AlterProjectionPolicyOptions) { opts.UnsetComment = new(true) },
+			"ALTER PROJECTION POLICY %s UNSET COMMENT", id.FullyQualifiedName(),
+		)
+
+	projectionPoliciesTests.Drop.
+		withExpectedSqlf(
+			case_ProjectionPolicies_sql_Drop_basic,
+			"DROP PROJECTION POLICY %s", id.FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_ProjectionPolicies_sql_Drop_all,
+			func(opts *DropProjectionPolicyOptions) { opts.IfExists = new(true) },
+			"DROP PROJECTION POLICY IF EXISTS %s", id.FullyQualifiedName(),
+		)
+
+	projectionPoliciesTests.Show.
+		withExpectedSql(case_ProjectionPolicies_sql_Show_basic, "SHOW PROJECTION POLICIES").
+		withModifyAndExpectedSqlf(
+			case_ProjectionPolicies_sql_Show_all,
+			func(opts *ShowProjectionPolicyOptions) {
+				opts.Like = &Like{Pattern: new("pattern")}
+				opts.In = &In{Schema: id.SchemaId()}
+				opts.Limit = &LimitFrom{Rows: new(10), From: new("foo")}
+			},
+			"SHOW PROJECTION POLICIES LIKE 'pattern' IN SCHEMA %s LIMIT 10 FROM 'foo'", id.SchemaId().FullyQualifiedName(),
+		).
+		withModifyAndExpectedSqlf(
+			case_ProjectionPolicies_sql_Show_Like,
+			func(opts *ShowProjectionPolicyOptions) {
+				opts.Like = &Like{Pattern: new("pattern")}
+			},
+			"SHOW PROJECTION POLICIES LIKE 'pattern'",
+		).
+		withModifyAndExpectedSqlf(
+			case_ProjectionPolicies_sql_Show_In,
+			func(opts *ShowProjectionPolicyOptions) {
+				opts.In = &In{Account: new(true)}
+			},
+			"SHOW PROJECTION POLICIES IN ACCOUNT",
+		).
+		withModifyAndExpectedSqlf(
+			case_ProjectionPolicies_sql_Show_Limit,
+			func(opts *ShowProjectionPolicyOptions) {
+				opts.Limit = &LimitFrom{Rows: new(10)}
+			},
+			"SHOW PROJECTION POLICIES LIMIT 10",
+		)
+
+	projectionPoliciesTests.Describe.
+		withExpectedSqlf(
+			case_ProjectionPolicies_sql_Describe_basic,
+			"DESCRIBE PROJECTION POLICY %s", id.FullyQualifiedName(),
+		)
+}
@@ -0,0 +1,117 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+type ProjectionPolicies interface {
+	Create(ctx context.Context, request *CreateProjectionPolicyRequest) error
+	Alter(ctx context.Context, request *AlterProjectionPolicyRequest) error
+	Drop(ctx context.Context, request *DropProjectionPolicyRequest) error
+	DropSafely(ctx context.Context, id SchemaObjectIdentifier) error
+	Show(ctx context.Context, request *ShowProjectionPolicyRequest) ([]ProjectionPolicy, error)
+	ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*ProjectionPolicy, error)
+	ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*ProjectionPolicy, error)
+	Describe(ctx context.Context, id SchemaObjectIdentifier) (*ProjectionPolicyDetails, error)
+}
+
+// CreateProjectionPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/create-projection-policy.
+type CreateProjectionPolicyOptions struct {
+	create                      bool                   `ddl:"static" sql:"CREATE"`
+	OrReplace                   *bool                  `ddl:"keyword" sql:"OR REPLACE"`
+	projectionPolicy            bool                   `ddl:"static" sql:"PROJECTION POLICY"`
+	IfNotExists                 *bool                  `ddl:"keyword" sql:"IF NOT EXISTS"`
+	name                        SchemaObjectIdentifier `ddl:"identifier"`
+	signature                   bool                   `ddl:"static" sql:"AS ()"`
+	returnsProjectionConstraint bool                   `ddl:"static" sql:"RETURNS PROJECTION_CONSTRAINT"`
+	body                        string                 `ddl:"parameter,no_quotes,no_equals" sql:"->"`
+	Comment                     *string                `ddl:"parameter,single_quotes" sql:"COMMENT"`
+}
+
+// AlterProjectionPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/alter-projection-policy.
+type AlterProjectionPolicyOptions struct {
+	alter            bool                    `ddl:"static" sql:"ALTER"`
+	projectionPolicy bool                    `ddl:"static" sql:"PROJECTION POLICY"`
+	IfExists         *bool                   `ddl:"keyword" sql:"IF EXISTS"`
+	name             SchemaObjectIdentifier  `ddl:"identifier"`
+	RenameTo         *SchemaObjectIdentifier `ddl:"identifier" sql:"RENAME TO"`
+	SetBody          *string                 `ddl:"parameter,no_quotes,no_equals" sql:"SET BODY ->"`
+	SetTags          []TagAssociation        `ddl:"keyword" sql:"SET TAG"`
+	UnsetTags        []ObjectIdentifier      `ddl:"keyword" sql:"UNSET TAG"`
+	SetComment       *string                 `ddl:"parameter,single_quotes" sql:"SET COMMENT"`
+	UnsetComment     *bool                   `ddl:"keyword" sql:"UNSET COMMENT"`
+}
+
+// DropProjectionPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/drop-projection-policy.
+type DropProjectionPolicyOptions struct {
+	drop             bool                   `ddl:"static" sql:"DROP"`
+	projectionPolicy bool                   `ddl:"static" sql:"PROJECTION POLICY"`
+	IfExists         *bool                  `ddl:"keyword" sql:"IF EXISTS"`
+	name             SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+// ShowProjectionPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/show-projection-policies.
+type ShowProjectionPolicyOptions struct {
+	show               bool       `ddl:"static" sql:"SHOW"`
+	projectionPolicies bool       `ddl:"static" sql:"PROJECTION POLICIES"`
+	Like               *Like      `ddl:"keyword" sql:"LIKE"`
+	In                 *In        `ddl:"keyword" sql:"IN"`
+	Limit              *LimitFrom `ddl:"keyword" sql:"LIMIT"`
+}
+
+type projectionPolicyDBRow struct {
+	CreatedOn     time.Time      `db:"created_on"`
+	Name          string         `db:"name"`
+	DatabaseName  string         `db:"database_name"`
+	SchemaName    string         `db:"schema_name"`
+	Kind          string         `db:"kind"`
+	Owner         string         `db:"owner"`
+	Comment       sql.NullString `db:"comment"`
+	Options       string         `db:"options"`
+	OwnerRoleType string         `db:"owner_role_type"`
+}
+
+type ProjectionPolicy struct {
+	CreatedOn     time.Time
+	Name          string
+	DatabaseName  string
+	SchemaName    string
+	Kind          string
+	Owner         string
+	Comment       string
+	Options       string
+	OwnerRoleType string
+}
+
+func (v *ProjectionPolicy) ID() SchemaObjectIdentifier {
+	return NewSchemaObjectIdentifier(v.DatabaseName, v.SchemaName, v.Name)
+}
+
+func (v *ProjectionPolicy) ObjectType() ObjectType {
+	return ObjectTypeProjectionPolicy
+}
+
+// DescribeProjectionPolicyOptions is based on https://docs.snowflake.com/en/sql-reference/sql/desc-projection-policy.
+type DescribeProjectionPolicyOptions struct {
+	describe         bool                   `ddl:"static" sql:"DESCRIBE"`
+	projectionPolicy bool                   `ddl:"static" sql:"PROJECTION POLICY"`
+	name             SchemaObjectIdentifier `ddl:"identifier"`
+}
+
+type describeProjectionPolicyDBRow struct {
+	Name       string `db:"name"`
+	Signature  string `db:"signature"`
+	ReturnType string `db:"return_type"`
+	Body       string `db:"body"`
+}
+
+type ProjectionPolicyDetails struct {
+	Name       string
+	Signature  string
+	ReturnType string
+	Body       string
+}
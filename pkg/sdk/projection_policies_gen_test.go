@@ -0,0 +1,244 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+import (
+	"testing"
+)
+
+var projectionPoliciesTestIdSchemaObjectIdentifier = randomSchemaObjectIdentifier()
+
+const (
+	case_ProjectionPolicies_validation_Create_name_ValidIdentifier                  testCaseName = "validation_Create_name_ValidIdentifier"
+	case_ProjectionPolicies_validation_Create_body_ValidateValueSet                 testCaseName = "validation_Create_body_ValidateValueSet"
+	case_ProjectionPolicies_validation_Create_opts_ConflictingFields                testCaseName = "validation_Create_opts_ConflictingFields"
+	case_ProjectionPolicies_sql_Create_basic                                        testCaseName = "sql_Create_basic"
+	case_ProjectionPolicies_sql_Create_all                                          testCaseName = "sql_Create_all"
+	case_ProjectionPolicies_validation_Alter_name_ValidIdentifier                   testCaseName = "validation_Alter_name_ValidIdentifier"
+	case_ProjectionPolicies_validation_Alter_opts_ExactlyOneValueSet_NoneSet        testCaseName = "validation_Alter_opts_ExactlyOneValueSet_NoneSet"
+	case_ProjectionPolicies_validation_Alter_opts_ExactlyOneValueSet_MoreThanOneSet testCaseName = "validation_Alter_opts_ExactlyOneValueSet_MoreThanOneSet"
+	case_ProjectionPolicies_sql_Alter_RenameTo                                      testCaseName = "sql_Alter_RenameTo"
+	case_ProjectionPolicies_sql_Alter_SetBody                                       testCaseName = "sql_Alter_SetBody"
+	case_ProjectionPolicies_sql_Alter_SetTags                                       testCaseName = "sql_Alter_SetTags"
+	case_ProjectionPolicies_sql_Alter_UnsetTags                                     testCaseName = "sql_Alter_UnsetTags"
+	case_ProjectionPolicies_sql_Alter_SetComment                                    testCaseName = "sql_Alter_SetComment"
+	case_ProjectionPolicies_sql_Alter_UnsetComment                                  testCaseName = "sql_Alter_UnsetComment"
+	case_ProjectionPolicies_validation_Drop_name_ValidIdentifier                    testCaseName = "validation_Drop_name_ValidIdentifier"
+	case_ProjectionPolicies_sql_Drop_basic                                          testCaseName = "sql_Drop_basic"
+	case_ProjectionPolicies_sql_Drop_all                                            testCaseName = "sql_Drop_all"
+	case_ProjectionPolicies_sql_Show_basic                                          testCaseName = "sql_Show_basic"
+	case_ProjectionPolicies_sql_Show_all                                            testCaseName = "sql_Show_all"
+	case_ProjectionPolicies_sql_Show_Like                                           testCaseName = "sql_Show_Like"
+	case_ProjectionPolicies_sql_Show_In                                             testCaseName = "sql_Show_In"
+	case_ProjectionPolicies_sql_Show_Limit                                          testCaseName = "sql_Show_Limit"
+	case_ProjectionPolicies_validation_Describe_name_ValidIdentifier                testCaseName = "validation_Describe_name_ValidIdentifier"
+	case_ProjectionPolicies_sql_Describe_basic                                      testCaseName = "sql_Describe_basic"
+)
+
+type ProjectionPoliciesTestsContext struct {
+	Create   *sdkTestCtx[*CreateProjectionPolicyOptions]
+	Alter    *sdkTestCtx[*AlterProjectionPolicyOptions]
+	Drop     *sdkTestCtx[*DropProjectionPolicyOptions]
+	Show     *sdkTestCtx[*ShowProjectionPolicyOptions]
+	Describe *sdkTestCtx[*DescribeProjectionPolicyOptions]
+}
+
+var projectionPoliciesTests = ProjectionPoliciesTestsContext{
+	Create: newSdkTestCtx[*CreateProjectionPolicyOptions](
+		"ProjectionPolicies", "Create",
+	).
+		withDefaultOpts(func() *CreateProjectionPolicyOptions {
+			return &CreateProjectionPolicyOptions{
+				name: projectionPoliciesTestIdSchemaObjectIdentifier,
+			}
+		}).
+		withValidationCases(
+			validationCase[*CreateProjectionPolicyOptions]{
+				Name:        case_ProjectionPolicies_validation_Create_name_ValidIdentifier,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *CreateProjectionPolicyOptions) {
+					opts.name = emptySchemaObjectIdentifier
+				},
+			},
+			validationCase[*CreateProjectionPolicyOptions]{
+				Name:        case_ProjectionPolicies_validation_Create_body_ValidateValueSet,
+				ExpectedErr: errNotSet("CreateProjectionPolicyOptions", "body"),
+				DefaultModify: func(opts *CreateProjectionPolicyOptions) {
+					opts.body = ""
+				},
+			},
+			validationCase[*CreateProjectionPolicyOptions]{
+				Name:        case_ProjectionPolicies_validation_Create_opts_ConflictingFields,
+				ExpectedErr: errOneOf("CreateProjectionPolicyOptions", "OrReplace", "IfNotExists"),
+				DefaultModify: func(opts *CreateProjectionPolicyOptions) {
+					opts.OrReplace = new(true)
+					opts.IfNotExists = new(true)
+				},
+			},
+		).
+		withSqlCases(
+			sqlCase[*CreateProjectionPolicyOptions]{
+				Name:           case_ProjectionPolicies_sql_Create_basic,
+				NoModifyNeeded: true,
+			},
+			sqlCase[*CreateProjectionPolicyOptions]{
+				Name: case_ProjectionPolicies_sql_Create_all,
+			},
+		),
+	Alter: newSdkTestCtx[*AlterProjectionPolicyOptions](
+		"ProjectionPolicies", "Alter",
+	).
+		withDefaultOpts(func() *AlterProjectionPolicyOptions {
+			return &AlterProjectionPolicyOptions{
+				name: projectionPoliciesTestIdSchemaObjectIdentifier,
+			}
+		}).
+		withValidationCases(
+			validationCase[*AlterProjectionPolicyOptions]{
+				Name:        case_ProjectionPolicies_validation_Alter_name_ValidIdentifier,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *AlterProjectionPolicyOptions) {
+					opts.name = emptySchemaObjectIdentifier
+				},
+			},
+			validationCase[*AlterProjectionPolicyOptions]{
+				Name:        case_ProjectionPolicies_validation_Alter_opts_ExactlyOneValueSet_NoneSet,
+				ExpectedErr: errExactlyOneOf("AlterProjectionPolicyOptions", "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"),
+				DefaultModify: func(opts *AlterProjectionPolicyOptions) {
+					opts.RenameTo = nil
+					opts.SetBody = nil
+					opts.SetTags = nil
+					opts.UnsetTags = nil
+					opts.SetComment = nil
+					opts.UnsetComment = nil
+				},
+			},
+			validationCase[*AlterProjectionPolicyOptions]{
+				Name:        case_ProjectionPolicies_validation_Alter_opts_ExactlyOneValueSet_MoreThanOneSet,
+				ExpectedErr: errExactlyOneOf("AlterProjectionPolicyOptions", "RenameTo", "SetBody", "SetTags", "UnsetTags", "SetComment", "UnsetComment"),
+				DefaultModify: func(opts *AlterProjectionPolicyOptions) {
+					opts.RenameTo = new(randomSchemaObjectIdentifier())
+					opts.SetBody = new("foo")
+				},
+			},
+		).
+		withSqlCases(
+			sqlCase[*AlterProjectionPolicyOptions]{
+				Name: case_ProjectionPolicies_sql_Alter_RenameTo,
+			},
+			sqlCase[*AlterProjectionPolicyOptions]{
+				Name: case_ProjectionPolicies_sql_Alter_SetBody,
+			},
+			sqlCase[*AlterProjectionPolicyOptions]{
+				Name: case_ProjectionPolicies_sql_Alter_SetTags,
+			},
+			sqlCase[*AlterProjectionPolicyOptions]{
+				Name: case_ProjectionPolicies_sql_Alter_UnsetTags,
+			},
+			sqlCase[*AlterProjectionPolicyOptions]{
+				Name: case_ProjectionPolicies_sql_Alter_SetComment,
+			},
+			sqlCase[*AlterProjectionPolicyOptions]{
+				Name: case_ProjectionPolicies_sql_Alter_UnsetComment,
+			},
+		),
+	Drop: newSdkTestCtx[*DropProjectionPolicyOptions](
+		"ProjectionPolicies", "Drop",
+	).
+		withDefaultOpts(func() *DropProjectionPolicyOptions {
+			return &DropProjectionPolicyOptions{
+				name: projectionPoliciesTestIdSchemaObjectIdentifier,
+			}
+		}).
+		withValidationCases(
+			validationCase[*DropProjectionPolicyOptions]{
+				Name:        case_ProjectionPolicies_validation_Drop_name_ValidIdentifier,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *DropProjectionPolicyOptions) {
+					opts.name = emptySchemaObjectIdentifier
+				},
+			},
+		).
+		withSqlCases(
+			sqlCase[*DropProjectionPolicyOptions]{
+				Name:           case_ProjectionPolicies_sql_Drop_basic,
+				NoModifyNeeded: true,
+			},
+			sqlCase[*DropProjectionPolicyOptions]{
+				Name: case_ProjectionPolicies_sql_Drop_all,
+			},
+		),
+	Show: newSdkTestCtx[*ShowProjectionPolicyOptions](
+		"ProjectionPolicies", "Show",
+	).
+		withDefaultOpts(func() *ShowProjectionPolicyOptions {
+			return &ShowProjectionPolicyOptions{}
+		}).
+		withValidationCases().
+		withSqlCases(
+			sqlCase[*ShowProjectionPolicyOptions]{
+				Name:           case_ProjectionPolicies_sql_Show_basic,
+				NoModifyNeeded: true,
+			},
+			sqlCase[*ShowProjectionPolicyOptions]{
+				Name: case_ProjectionPolicies_sql_Show_all,
+			},
+			sqlCase[*ShowProjectionPolicyOptions]{
+				Name: case_ProjectionPolicies_sql_Show_Like,
+			},
+			sqlCase[*ShowProjectionPolicyOptions]{
+				Name: case_ProjectionPolicies_sql_Show_In,
+			},
+			sqlCase[*ShowProjectionPolicyOptions]{
+				Name: case_ProjectionPolicies_sql_Show_Limit,
+			},
+		),
+	Describe: newSdkTestCtx[*DescribeProjectionPolicyOptions](
+		"ProjectionPolicies", "Describe",
+	).
+		withDefaultOpts(func() *DescribeProjectionPolicyOptions {
+			return &DescribeProjectionPolicyOptions{
+				name: projectionPoliciesTestIdSchemaObjectIdentifier,
+			}
+		}).
+		withValidationCases(
+			validationCase[*DescribeProjectionPolicyOptions]{
+				Name:        case_ProjectionPolicies_validation_Describe_name_ValidIdentifier,
+				ExpectedErr: ErrInvalidObjectIdentifier,
+				DefaultModify: func(opts *DescribeProjectionPolicyOptions) {
+					opts.name = emptySchemaObjectIdentifier
+				},
+			},
+		).
+		withSqlCases(
+			sqlCase[*DescribeProjectionPolicyOptions]{
+				Name:           case_ProjectionPolicies_sql_Describe_basic,
+				NoModifyNeeded: true,
+			},
+		),
+}
+
+func TestProjectionPolicies_Create(t *testing.T) {
+	projectionPoliciesTests.Create.RunValidationCases(t)
+	projectionPoliciesTests.Create.RunSqlCases(t)
+}
+
+func TestProjectionPolicies_Alter(t *testing.T) {
+	projectionPoliciesTests.Alter.RunValidationCases(t)
+	projectionPoliciesTests.Alter.RunSqlCases(t)
+}
+
+func TestProjectionPolicies_Drop(t *testing.T) {
+	projectionPoliciesTests.Drop.RunValidationCases(t)
+	projectionPoliciesTests.Drop.RunSqlCases(t)
+}
+
+func TestProjectionPolicies_Show(t *testing.T) {
+	projectionPoliciesTests.Show.RunValidationCases(t)
+	projectionPoliciesTests.Show.RunSqlCases(t)
+}
+
+func TestProjectionPolicies_Describe(t *testing.T) {
+	projectionPoliciesTests.Describe.RunValidationCases(t)
+	projectionPoliciesTests.Describe.RunSqlCases(t)
+}
@@ -0,0 +1,147 @@
+// Code generated by SDK builder generator (v0.1.0); DO NOT EDIT.
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+)
+
+var (
+	_ ProjectionPolicies                      = (*projectionPolicies)(nil)
+	_ convertibleRow[ProjectionPolicy]        = new(projectionPolicyDBRow)
+	_ convertibleRow[ProjectionPolicyDetails] = new(describeProjectionPolicyDBRow)
+)
+
+type projectionPolicies struct {
+	client *Client
+}
+
+func (v *projectionPolicies) Create(ctx context.Context, request *CreateProjectionPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *projectionPolicies) Alter(ctx context.Context, request *AlterProjectionPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *projectionPolicies) Drop(ctx context.Context, request *DropProjectionPolicyRequest) error {
+	opts := request.toOpts()
+	return validateAndExec(v.client, ctx, opts)
+}
+
+func (v *projectionPolicies) DropSafely(ctx context.Context, id SchemaObjectIdentifier) error {
+	return SafeDrop(v.client, func() error { return v.Drop(ctx, NewDropProjectionPolicyRequest(id).WithIfExists(true)) }, ctx, id)
+}
+
+func (v *projectionPolicies) Show(ctx context.Context, request *ShowProjectionPolicyRequest) ([]ProjectionPolicy, error) {
+	opts := request.toOpts()
+	dbRows, err := validateAndQuery[projectionPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return convertRows[projectionPolicyDBRow, ProjectionPolicy](dbRows)
+}
+
+func (v *projectionPolicies) ShowByID(ctx context.Context, id SchemaObjectIdentifier) (*ProjectionPolicy, error) {
+	request := NewShowProjectionPolicyRequest().
+		WithLike(Like{Pattern: String(id.Name())}).
+		WithIn(In{Schema: id.SchemaId()})
+	projectionPolicies, err := v.Show(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	return collections.FindFirst(projectionPolicies, func(r ProjectionPolicy) bool { return r.Name == id.Name() })
+}
+
+func (v *projectionPolicies) ShowByIDSafely(ctx context.Context, id SchemaObjectIdentifier) (*ProjectionPolicy, error) {
+	return SafeShowById(v.client, v.ShowByID, ctx, id)
+}
+
+func (v *projectionPolicies) Describe(ctx context.Context, id SchemaObjectIdentifier) (*ProjectionPolicyDetails, error) {
+	opts := &DescribeProjectionPolicyOptions{
+		name: id,
+	}
+	result, err := validateAndQueryOne[describeProjectionPolicyDBRow](v.client, ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return conversionErrorWrapped(result.convert())
+}
+
+func (r *CreateProjectionPolicyRequest) toOpts() *CreateProjectionPolicyOptions {
+	opts := &CreateProjectionPolicyOptions{
+		OrReplace:   r.OrReplace,
+		IfNotExists: r.IfNotExists,
+		name:        r.name,
+		body:        r.body,
+		Comment:     r.Comment,
+	}
+	return opts
+}
+
+func (r *AlterProjectionPolicyRequest) toOpts() *AlterProjectionPolicyOptions {
+	opts := &AlterProjectionPolicyOptions{
+		IfExists:     r.IfExists,
+		name:         r.name,
+		RenameTo:     r.RenameTo,
+		SetBody:      r.SetBody,
+		SetTags:      r.SetTags,
+		UnsetTags:    r.UnsetTags,
+		SetComment:   r.SetComment,
+		UnsetComment: r.UnsetComment,
+	}
+	return opts
+}
+
+func (r *DropProjectionPolicyRequest) toOpts() *DropProjectionPolicyOptions {
+	opts := &DropProjectionPolicyOptions{
+		IfExists: r.IfExists,
+		name:     r.name,
+	}
+	return opts
+}
+
+func (r *ShowProjectionPolicyRequest) toOpts() *ShowProjectionPolicyOptions {
+	opts := &ShowProjectionPolicyOptions{
+		Like:  r.Like,
+		In:    r.In,
+		Limit: r.Limit,
+	}
+	return opts
+}
+
+func (r projectionPolicyDBRow) convert() (*ProjectionPolicy, error) {
+	result := &ProjectionPolicy{
+		CreatedOn:     r.CreatedOn,
+		Name:          r.Name,
+		DatabaseName:  r.DatabaseName,
+		SchemaName:    r.SchemaName,
+		Kind:          r.Kind,
+		Owner:         r.Owner,
+		Options:       r.Options,
+		OwnerRoleType: r.OwnerRoleType,
+	}
+	mapNullStringToNonNullableField(&result.Comment, r.Comment)
+	return result, nil
+}
+
+func (r *DescribeProjectionPolicyRequest) toOpts() *DescribeProjectionPolicyOptions {
+	opts := &DescribeProjectionPolicyOptions{
+		name: r.name,
+	}
+	return opts
+}
+
+func (r describeProjectionPolicyDBRow) convert() (*ProjectionPolicyDetails, error) {
+	result := &ProjectionPolicyDetails{
+		Name:       r.Name,
+		Signature:  r.Signature,
+		ReturnType: r.ReturnType,
+		Body:       r.Body,
+	}
+	return result, nil
+}
@@ -0,0 +1,43 @@
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
+	"github.com/snowflakedb/gosnowflake/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClient_withQueryTag(t *testing.T) {
+	metadata := tracking.Metadata{Version: "v1.0.0", Resource: "snowflake_database", Operation: tracking.CreateOperation}
+	queryTagFrom := func(ctx context.Context) any {
+		return ctx.Value(gosnowflake.ContextKey("QUERY_TAG"))
+	}
+
+	t.Run("metadata not included by default", func(t *testing.T) {
+		client := &Client{}
+		ctx := tracking.NewContext(context.Background(), metadata)
+
+		assert.Nil(t, queryTagFrom(client.withQueryTag(ctx)))
+	})
+
+	t.Run("no metadata in context", func(t *testing.T) {
+		client := &Client{}
+		client.SetQueryTagOptions(QueryTagOptions{QueryTag: "tag", IncludeMetadata: true})
+
+		assert.Nil(t, queryTagFrom(client.withQueryTag(context.Background())))
+	})
+
+	t.Run("metadata included", func(t *testing.T) {
+		client := &Client{}
+		client.SetQueryTagOptions(QueryTagOptions{QueryTag: "tag", Workspace: "prod", IncludeMetadata: true})
+		ctx := tracking.NewContext(context.Background(), metadata)
+
+		assert.Equal(
+			t,
+			`{"query_tag":"tag","workspace":"prod","resource":"snowflake_database","operation":"create","version":"v1.0.0"}`,
+			queryTagFrom(client.withQueryTag(ctx)),
+		)
+	})
+}
@@ -35,6 +35,10 @@ var (
 	dataTypeChar_100, _                   = datatypes.ParseDataType("CHAR(100)")
 	dataTypeDoublePrecision, _            = datatypes.ParseDataType("DOUBLE PRECISION")
 	dataTypeTimestampWithoutTimeZone_5, _ = datatypes.ParseDataType("TIMESTAMP WITHOUT TIME ZONE(5)")
+	dataTypeNumber_30_2, _                = datatypes.ParseDataType("NUMBER(30, 2)")
+	dataTypeStructuredArray, _            = datatypes.ParseDataType("ARRAY(NUMBER(30, 2))")
+	dataTypeStructuredObject, _           = datatypes.ParseDataType("OBJECT(a NUMBER(30, 2), b ARRAY(NUMBER(30, 2)))")
+	dataTypeMap, _                        = datatypes.ParseDataType("MAP(VARCHAR, NUMBER(30, 2))")
 )
 
 func randomSchemaObjectIdentifierWithArguments(argumentDataTypes ...DataType) SchemaObjectIdentifierWithArguments {
@@ -0,0 +1,177 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"math/rand/v2"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultRetryMaxAttempts    = 1
+	DefaultRetryInitialBackoff = 1 * time.Second
+	DefaultRetryMaxBackoff     = 30 * time.Second
+	DefaultRetryMultiplier     = 2.0
+	DefaultRetryJitter         = 0.2
+)
+
+// RetryPolicy decides if (and when) a statement that failed should be executed again.
+// It is used by the Client for every statement run through query and queryOne, and for the statements run through exec
+// that are safe to be executed again (check isSafeToRetryExec).
+type RetryPolicy interface {
+	// NextBackoff returns the delay before the next attempt and true if the statement should be retried.
+	// The attempt is the number of the attempt that has just failed with err (starting from 1).
+	NextBackoff(attempt int, err error) (time.Duration, bool)
+}
+
+// ErrorClassifier returns true if the given error is worth retrying.
+type ErrorClassifier func(err error) bool
+
+// IsRetryableError is the default ErrorClassifier. It accepts errors classified as ErrTransient, ErrConcurrentDdl, or ErrConnectionFailure
+// and the errors returned by the driver after it gave up on the requests rejected with the HTTP 429 status
+// (e.g., by the login endpoint).
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrTransient) || errors.Is(err, ErrConcurrentDdl) || errors.Is(err, ErrConnectionFailure) {
+		return true
+	}
+	return isTooManyRequestsError(err)
+}
+
+func isTooManyRequestsError(err error) bool {
+	return strings.Contains(err.Error(), "HTTP Status: 429")
+}
+
+// isSafeToRetryExec returns true if the statement run through exec that failed with err can be executed again.
+// The statements that do not return rows (mostly DDL) may have already taken effect when they failed with a transient error
+// (e.g., an aborted statement), so running them again could fail (e.g., with "already exists") or apply the change twice.
+// That is why they are retried only when the error means that the statement was not run (the concurrent DDL lock,
+// the connection failure, or HTTP 429), or when the statement is idempotent.
+func isSafeToRetryExec(statement string, err error) bool {
+	if errors.Is(err, ErrConcurrentDdl) || errors.Is(err, ErrConnectionFailure) || isTooManyRequestsError(err) {
+		return true
+	}
+	return isIdempotentStatement(statement)
+}
+
+var idempotentStatementRegex = regexp.MustCompile(`(?is)^\s*(CREATE\s+OR\s+REPLACE\s|CREATE\s.*\sIF\s+NOT\s+EXISTS\s|DROP\s.*\sIF\s+EXISTS\s|USE\s|SHOW\s|DESC(RIBE)?\s|SELECT\s)`)
+
+// isIdempotentStatement returns true for the statements that have the same effect when they are executed more than once.
+func isIdempotentStatement(statement string) bool {
+	return idempotentStatementRegex.MatchString(statement)
+}
+
+// execRetryPolicy limits the retries of the wrapped policy to the statements run through exec that are safe to be executed again.
+type execRetryPolicy struct {
+	policy    RetryPolicy
+	statement string
+}
+
+func (p execRetryPolicy) NextBackoff(attempt int, err error) (time.Duration, bool) {
+	if p.policy == nil || !isSafeToRetryExec(p.statement, err) {
+		return 0, false
+	}
+	return p.policy.NextBackoff(attempt, err)
+}
+
+type noRetryPolicy struct{}
+
+func (noRetryPolicy) NextBackoff(int, error) (time.Duration, bool) {
+	return 0, false
+}
+
+// NoRetryPolicy never retries the failed statements. It is the default policy of the Client.
+var NoRetryPolicy RetryPolicy = noRetryPolicy{}
+
+var _ RetryPolicy = new(ExponentialBackoffRetryPolicy)
+
+// ExponentialBackoffRetryPolicy retries the errors accepted by IsRetryable, waiting
+// InitialBackoff * Multiplier^(attempt-1) (limited by MaxBackoff) between the attempts.
+// The delay is randomized by ±Jitter (a fraction of the delay) so that the concurrent operations
+// failing on the same object (e.g., the concurrent DDL on the same schema) do not retry in lockstep.
+type ExponentialBackoffRetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	Jitter         float64
+	IsRetryable    ErrorClassifier
+
+	// random returns a number from [0, 1); it is replaceable for the testing purposes.
+	random func() float64
+}
+
+// NewExponentialBackoffRetryPolicy creates the policy with the default multiplier, jitter, and error classifier.
+func NewExponentialBackoffRetryPolicy(maxAttempts int, initialBackoff time.Duration, maxBackoff time.Duration) *ExponentialBackoffRetryPolicy {
+	return &ExponentialBackoffRetryPolicy{
+		MaxAttempts:    maxAttempts,
+		InitialBackoff: initialBackoff,
+		MaxBackoff:     maxBackoff,
+		Multiplier:     DefaultRetryMultiplier,
+		Jitter:         DefaultRetryJitter,
+		IsRetryable:    IsRetryableError,
+	}
+}
+
+func (p *ExponentialBackoffRetryPolicy) NextBackoff(attempt int, err error) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	isRetryable := p.IsRetryable
+	if isRetryable == nil {
+		isRetryable = IsRetryableError
+	}
+	if !isRetryable(err) {
+		return 0, false
+	}
+	return p.backoff(attempt), true
+}
+
+func (p *ExponentialBackoffRetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	backoff := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		random := p.random
+		if random == nil {
+			random = rand.Float64
+		}
+		backoff += backoff * p.Jitter * (2*random() - 1)
+	}
+	return time.Duration(backoff)
+}
+
+// withRetries runs the operation until it succeeds, the policy stops retrying, or the context is done.
+func withRetries(ctx context.Context, policy RetryPolicy, operation func() error) error {
+	if policy == nil {
+		policy = NoRetryPolicy
+	}
+	for attempt := 1; ; attempt++ {
+		err := operation()
+		if err == nil {
+			return nil
+		}
+		backoff, retry := policy.NextBackoff(attempt, err)
+		if !retry {
+			return err
+		}
+		log.Printf("[DEBUG] attempt %d failed with retryable error, retrying in %v: %v", attempt, backoff, err)
+		select {
+		case <-ctx.Done():
+			return errors.Join(err, fmt.Errorf("giving up retries after %d attempts: %w", attempt, ctx.Err()))
+		case <-time.After(backoff):
+		}
+	}
+}
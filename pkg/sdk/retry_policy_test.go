@@ -0,0 +1,278 @@
+package sdk
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/snowflakedb/gosnowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsRetryableError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil", err: nil, expected: false},
+		{name: "concurrent ddl", err: decodeDriverError(&gosnowflake.SnowflakeError{Number: 625}), expected: true},
+		{name: "statement timeout", err: decodeDriverError(&gosnowflake.SnowflakeError{Number: 630}), expected: true},
+		{name: "internal error", err: decodeDriverError(&gosnowflake.SnowflakeError{Number: 603}), expected: true},
+		{name: "service unavailable", err: decodeDriverError(&gosnowflake.SnowflakeError{Number: 260007}), expected: true},
+		{name: "too many requests", err: errors.New("timeout after 1m0s and 7 attempts. HTTP Status: 429. Hanging?"), expected: true},
+		{name: "object does not exist", err: decodeDriverError(&gosnowflake.SnowflakeError{Number: 2003}), expected: false},
+		{name: "insufficient privileges", err: decodeDriverError(&gosnowflake.SnowflakeError{Number: 3001}), expected: false},
+		{name: "syntax error", err: decodeDriverError(&gosnowflake.SnowflakeError{Number: 1003}), expected: false},
+		{name: "other error", err: errors.New("some error"), expected: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsRetryableError(tc.err))
+		})
+	}
+}
+
+func TestIsSafeToRetryExec(t *testing.T) {
+	abortedErr := decodeDriverError(&gosnowflake.SnowflakeError{Number: 604})
+	testCases := []struct {
+		name      string
+		statement string
+		err       error
+		expected  bool
+	}{
+		{name: "concurrent ddl", statement: "ALTER TABLE T ADD COLUMN C INT", err: decodeDriverError(&gosnowflake.SnowflakeError{Number: 625}), expected: true},
+		{name: "connection failure", statement: "ALTER TABLE T ADD COLUMN C INT", err: decodeDriverError(&gosnowflake.SnowflakeError{Number: 261000}), expected: true},
+		{name: "too many requests", statement: "ALTER TABLE T ADD COLUMN C INT", err: errors.New("HTTP Status: 429"), expected: true},
+		{name: "aborted create", statement: "CREATE TABLE T (C INT)", err: abortedErr, expected: false},
+		{name: "aborted alter", statement: "ALTER TABLE T ADD COLUMN C INT", err: abortedErr, expected: false},
+		{name: "aborted create or replace", statement: "CREATE OR REPLACE TABLE T (C INT)", err: abortedErr, expected: true},
+		{name: "aborted create if not exists", statement: "CREATE TABLE IF NOT EXISTS T (C INT)", err: abortedErr, expected: true},
+		{name: "aborted drop if exists", statement: "DROP TABLE IF EXISTS T", err: abortedErr, expected: true},
+		{name: "aborted drop", statement: "DROP TABLE T", err: abortedErr, expected: false},
+		{name: "aborted use", statement: "USE WAREHOUSE W", err: abortedErr, expected: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, isSafeToRetryExec(tc.statement, tc.err))
+		})
+	}
+}
+
+func TestExponentialBackoffRetryPolicy_NextBackoff(t *testing.T) {
+	retryableErr := decodeDriverError(&gosnowflake.SnowflakeError{Number: 625})
+	policy := func(jitter float64, random float64) *ExponentialBackoffRetryPolicy {
+		p := NewExponentialBackoffRetryPolicy(5, time.Second, 5*time.Second)
+		p.Jitter = jitter
+		p.random = func() float64 { return random }
+		return p
+	}
+
+	t.Run("exponential growth limited by max backoff", func(t *testing.T) {
+		p := policy(0, 0)
+		expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}
+		for i, e := range expected {
+			backoff, retry := p.NextBackoff(i+1, retryableErr)
+			require.True(t, retry)
+			assert.Equal(t, e, backoff)
+		}
+	})
+
+	t.Run("jitter", func(t *testing.T) {
+		backoff, _ := policy(0.2, 0).NextBackoff(2, retryableErr)
+		assert.Equal(t, 1600*time.Millisecond, backoff)
+
+		backoff, _ = policy(0.2, 0.5).NextBackoff(2, retryableErr)
+		assert.Equal(t, 2*time.Second, backoff)
+
+		backoff, _ = policy(0.2, 1).NextBackoff(2, retryableErr)
+		assert.Equal(t, 2400*time.Millisecond, backoff)
+	})
+
+	t.Run("max attempts reached", func(t *testing.T) {
+		_, retry := policy(0, 0).NextBackoff(5, retryableErr)
+		assert.False(t, retry)
+	})
+
+	t.Run("non-retryable error", func(t *testing.T) {
+		_, retry := policy(0, 0).NextBackoff(1, errors.New("some error"))
+		assert.False(t, retry)
+	})
+
+	t.Run("custom error classifier", func(t *testing.T) {
+		p := policy(0, 0)
+		p.IsRetryable = func(err error) bool { return err.Error() == "some error" }
+
+		_, retry := p.NextBackoff(1, errors.New("some error"))
+		assert.True(t, retry)
+
+		_, retry = p.NextBackoff(1, retryableErr)
+		assert.False(t, retry)
+	})
+}
+
+func TestClient_RetryPolicy(t *testing.T) {
+	concurrentDdlErr := &gosnowflake.SnowflakeError{Number: 625, SQLState: "57014", Message: "Statement has locked table"}
+	notFoundErr := &gosnowflake.SnowflakeError{Number: 2003, SQLState: "02000", Message: "Database 'ABC' does not exist or not authorized."}
+	abortedErr := &gosnowflake.SnowflakeError{Number: 604, SQLState: "57014", Message: "Statement '01bc' was aborted."}
+	policy := func(maxAttempts int) RetryPolicy {
+		p := NewExponentialBackoffRetryPolicy(maxAttempts, time.Millisecond, time.Millisecond)
+		p.Jitter = 0
+		return p
+	}
+
+	t.Run("no retries by default", func(t *testing.T) {
+		fakeDriver := newFakeDriver(concurrentDdlErr)
+		client := newFakeClient(t, fakeDriver)
+
+		_, err := client.exec(context.Background(), "CREATE TABLE T")
+
+		require.ErrorIs(t, err, ErrConcurrentDdl)
+		assert.Len(t, fakeDriver.Statements(), 1)
+	})
+
+	t.Run("exec retried until success", func(t *testing.T) {
+		fakeDriver := newFakeDriver(concurrentDdlErr, concurrentDdlErr)
+		client := newFakeClient(t, fakeDriver)
+		client.SetRetryPolicy(policy(3))
+
+		_, err := client.exec(context.Background(), "CREATE TABLE T")
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"CREATE TABLE T", "CREATE TABLE T", "CREATE TABLE T"}, fakeDriver.Statements())
+	})
+
+	t.Run("exec gives up after max attempts", func(t *testing.T) {
+		fakeDriver := newFakeDriver(concurrentDdlErr, concurrentDdlErr, concurrentDdlErr)
+		client := newFakeClient(t, fakeDriver)
+		client.SetRetryPolicy(policy(2))
+
+		_, err := client.exec(context.Background(), "CREATE TABLE T")
+
+		require.ErrorIs(t, err, ErrConcurrentDdl)
+		assert.Len(t, fakeDriver.Statements(), 2)
+	})
+
+	t.Run("non-retryable error is not retried", func(t *testing.T) {
+		fakeDriver := newFakeDriver(notFoundErr)
+		client := newFakeClient(t, fakeDriver)
+		client.SetRetryPolicy(policy(3))
+
+		_, err := client.exec(context.Background(), "DROP TABLE T")
+
+		require.ErrorIs(t, err, ErrObjectNotExistOrAuthorized)
+		assert.Len(t, fakeDriver.Statements(), 1)
+	})
+
+	t.Run("exec not retried after the statement was aborted", func(t *testing.T) {
+		fakeDriver := newFakeDriver(abortedErr)
+		client := newFakeClient(t, fakeDriver)
+		client.SetRetryPolicy(policy(3))
+
+		_, err := client.exec(context.Background(), "CREATE TABLE T")
+
+		require.ErrorIs(t, err, ErrTransient)
+		assert.Len(t, fakeDriver.Statements(), 1)
+	})
+
+	t.Run("idempotent exec retried after the statement was aborted", func(t *testing.T) {
+		fakeDriver := newFakeDriver(abortedErr)
+		client := newFakeClient(t, fakeDriver)
+		client.SetRetryPolicy(policy(3))
+
+		_, err := client.exec(context.Background(), "CREATE OR REPLACE TABLE T")
+
+		require.NoError(t, err)
+		assert.Len(t, fakeDriver.Statements(), 2)
+	})
+
+	t.Run("query retried until success", func(t *testing.T) {
+		fakeDriver := newFakeDriver(concurrentDdlErr)
+		client := newFakeClient(t, fakeDriver)
+		client.SetRetryPolicy(policy(3))
+		var rows []struct {
+			Value string `db:"value"`
+		}
+
+		err := client.query(context.Background(), &rows, "SHOW TABLES")
+
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		assert.Equal(t, "value", rows[0].Value)
+		assert.Len(t, fakeDriver.Statements(), 2)
+	})
+
+	t.Run("queryOne retried until success", func(t *testing.T) {
+		fakeDriver := newFakeDriver(concurrentDdlErr)
+		client := newFakeClient(t, fakeDriver)
+		client.SetRetryPolicy(policy(3))
+		var row struct {
+			Value string `db:"value"`
+		}
+
+		err := client.queryOne(context.Background(), &row, "SELECT 1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "value", row.Value)
+		assert.Len(t, fakeDriver.Statements(), 2)
+	})
+
+	t.Run("retries stopped by the context", func(t *testing.T) {
+		fakeDriver := newFakeDriver(concurrentDdlErr, concurrentDdlErr)
+		client := newFakeClient(t, fakeDriver)
+		ctx, cancel := context.WithCancel(context.Background())
+		client.SetRetryPolicy(cancelingRetryPolicy{cancel: cancel})
+
+		_, err := client.exec(ctx, "CREATE TABLE T")
+
+		require.ErrorIs(t, err, ErrConcurrentDdl)
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Len(t, fakeDriver.Statements(), 1)
+	})
+}
+
+func TestConnect_RetryPolicy(t *testing.T) {
+	loginThrottledErr := errors.New("failed to auth for unknown reason. HTTP Status: 429. Body: ")
+	newDb := func(t *testing.T, fakeDriver *fakeDriver) *sqlx.DB {
+		t.Helper()
+		db := sqlx.NewDb(sql.OpenDB(fakeDriver), "snowflake")
+		t.Cleanup(func() { _ = db.Close() })
+		return db
+	}
+
+	t.Run("login retried until success", func(t *testing.T) {
+		fakeDriver := newFakeDriver().withConnectFailures(loginThrottledErr, loginThrottledErr)
+		policy := NewExponentialBackoffRetryPolicy(3, time.Millisecond, time.Millisecond)
+
+		client, err := connect(context.Background(), newDb(t, fakeDriver), nil, policy)
+
+		require.NoError(t, err)
+		assert.Equal(t, policy, client.retryPolicy)
+		assert.Equal(t, 3, fakeDriver.ConnectAttempts())
+		assert.Equal(t, []string{"SELECT CURRENT_ACCOUNT() as CURRENT_ACCOUNT", "SELECT CURRENT_SESSION() as CURRENT_SESSION"}, fakeDriver.Statements())
+	})
+
+	t.Run("login not retried without the policy", func(t *testing.T) {
+		fakeDriver := newFakeDriver().withConnectFailures(loginThrottledErr)
+
+		_, err := connect(context.Background(), newDb(t, fakeDriver), nil, NoRetryPolicy)
+
+		require.ErrorContains(t, err, "HTTP Status: 429")
+		assert.Equal(t, 1, fakeDriver.ConnectAttempts())
+		assert.Empty(t, fakeDriver.Statements())
+	})
+}
+
+// cancelingRetryPolicy cancels the context right before the backoff.
+type cancelingRetryPolicy struct {
+	cancel context.CancelFunc
+}
+
+func (p cancelingRetryPolicy) NextBackoff(int, error) (time.Duration, bool) {
+	p.cancel()
+	return time.Hour, true
+}
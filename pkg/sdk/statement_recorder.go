@@ -0,0 +1,167 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
+)
+
+// RecordedStatement is a SQL statement rendered by the Client, annotated with the resource (or data source) and operation it was run for.
+// ResourceId identifies the resource instance; it is empty for the statements run before the instance has an ID (e.g., the statement creating the object).
+type RecordedStatement struct {
+	Timestamp         time.Time
+	Resource          string
+	ResourceId        string
+	Datasource        string
+	EphemeralResource string
+	Operation         tracking.Operation
+	Statement         string
+}
+
+func newRecordedStatement(ctx context.Context, statement string) RecordedStatement {
+	recorded := RecordedStatement{
+		Timestamp: time.Now().UTC(),
+		Statement: statement,
+	}
+	if metadata, ok := tracking.FromContext(ctx); ok {
+		recorded.Resource = metadata.Resource
+		recorded.Datasource = metadata.Datasource
+		recorded.EphemeralResource = metadata.EphemeralResource
+		recorded.Operation = metadata.Operation
+	}
+	if resourceId, ok := tracking.ResourceIdFromContext(ctx); ok {
+		recorded.ResourceId = resourceId
+	}
+	return recorded
+}
+
+// String returns the statement preceded by the comment with its annotations, e.g.:
+//
+//	-- 2025-01-01T00:00:00Z resource=snowflake_database resource_id="DB" operation=update
+//	ALTER DATABASE "DB" SET COMMENT = 'comment';
+func (s RecordedStatement) String() string {
+	annotations := []string{s.Timestamp.Format(time.RFC3339)}
+	if s.Resource != "" {
+		annotations = append(annotations, fmt.Sprintf("resource=%s", s.Resource))
+	}
+	if s.ResourceId != "" {
+		annotations = append(annotations, fmt.Sprintf("resource_id=%s", s.ResourceId))
+	}
+	if s.Datasource != "" {
+		annotations = append(annotations, fmt.Sprintf("datasource=%s", s.Datasource))
+	}
+	if s.EphemeralResource != "" {
+		annotations = append(annotations, fmt.Sprintf("ephemeral_resource=%s", s.EphemeralResource))
+	}
+	if s.Operation != "" {
+		annotations = append(annotations, fmt.Sprintf("operation=%s", s.Operation))
+	}
+	return fmt.Sprintf("-- %s\n%s;\n", strings.Join(annotations, " "), strings.TrimSuffix(strings.TrimSpace(s.Statement), ";"))
+}
+
+// StatementRecorder records the statements run by the Client. It is called once per statement (retries are not recorded), before the statement is run.
+// If the recorder implements io.Closer, it is closed together with the Client.
+type StatementRecorder interface {
+	Record(statement RecordedStatement) error
+}
+
+var (
+	_ StatementRecorder = new(FileStatementRecorder)
+	_ io.Closer         = new(FileStatementRecorder)
+)
+
+// FileStatementRecorder appends the recorded statements to the file (creating it if needed).
+// The file is opened once, in the append mode, so it can be shared by the consecutive provider runs (e.g. plan and apply).
+// Every statement is synced to the disk right after it is written, as the provider process may exit without closing the Client.
+type FileStatementRecorder struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+}
+
+func NewFileStatementRecorder(path string) (*FileStatementRecorder, error) {
+	if strings.TrimSpace(path) == "" {
+		return nil, fmt.Errorf("statement log path must not be empty")
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("could not open the statement log file %s: %w", path, err)
+	}
+	return &FileStatementRecorder{path: path, file: file}, nil
+}
+
+func (r *FileStatementRecorder) Record(statement RecordedStatement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return fmt.Errorf("statement log file %s is already closed", r.path)
+	}
+	if _, err := r.file.WriteString(statement.String()); err != nil {
+		return fmt.Errorf("could not write to the statement log file %s: %w", r.path, err)
+	}
+	if err := r.file.Sync(); err != nil {
+		return fmt.Errorf("could not sync the statement log file %s: %w", r.path, err)
+	}
+	return nil
+}
+
+func (r *FileStatementRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+var _ StatementRecorder = new(InMemoryStatementRecorder)
+
+// InMemoryStatementRecorder keeps the recorded statements in memory. It is meant to be used with the dry-run client (see NewDryRunClient).
+type InMemoryStatementRecorder struct {
+	mu         sync.Mutex
+	statements []RecordedStatement
+}
+
+func NewInMemoryStatementRecorder() *InMemoryStatementRecorder {
+	return &InMemoryStatementRecorder{}
+}
+
+func (r *InMemoryStatementRecorder) Record(statement RecordedStatement) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.statements = append(r.statements, statement)
+	return nil
+}
+
+// Statements returns the recorded statements in the order they were run.
+func (r *InMemoryStatementRecorder) Statements() []RecordedStatement {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]RecordedStatement{}, r.statements...)
+}
+
+// SetStatementRecorder sets the recorder of the statements run by the client. Nil disables the recording.
+func (c *Client) SetStatementRecorder(recorder StatementRecorder) {
+	c.statementRecorder = recorder
+}
+
+// recordStatement records the statement; the recording failures are logged, so they never fail the statement itself.
+func (c *Client) recordStatement(ctx context.Context, statement string) {
+	if c.statementRecorder == nil {
+		return
+	}
+	if err := c.statementRecorder.Record(newRecordedStatement(ctx, statement)); err != nil {
+		log.Printf("[ERROR] failed to record the statement: %v", err)
+	}
+}
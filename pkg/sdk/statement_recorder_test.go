@@ -0,0 +1,143 @@
+package sdk
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/internal/tracking"
+	"github.com/snowflakedb/gosnowflake/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordedStatement_String(t *testing.T) {
+	timestamp := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("resource", func(t *testing.T) {
+		statement := RecordedStatement{Timestamp: timestamp, Resource: "snowflake_database", Operation: tracking.CreateOperation, Statement: `CREATE DATABASE "DB"`}
+
+		assert.Equal(t, "-- 2025-01-01T00:00:00Z resource=snowflake_database operation=create\nCREATE DATABASE \"DB\";\n", statement.String())
+	})
+
+	t.Run("resource with id", func(t *testing.T) {
+		statement := RecordedStatement{Timestamp: timestamp, Resource: "snowflake_database", ResourceId: `"DB"`, Operation: tracking.UpdateOperation, Statement: `ALTER DATABASE "DB" SET COMMENT = 'comment'`}
+
+		assert.Equal(t, "-- 2025-01-01T00:00:00Z resource=snowflake_database resource_id=\"DB\" operation=update\nALTER DATABASE \"DB\" SET COMMENT = 'comment';\n", statement.String())
+	})
+
+	t.Run("data source", func(t *testing.T) {
+		statement := RecordedStatement{Timestamp: timestamp, Datasource: "snowflake_databases", Operation: tracking.ReadOperation, Statement: "SHOW DATABASES;"}
+
+		assert.Equal(t, "-- 2025-01-01T00:00:00Z datasource=snowflake_databases operation=read\nSHOW DATABASES;\n", statement.String())
+	})
+
+	t.Run("no metadata", func(t *testing.T) {
+		statement := RecordedStatement{Timestamp: timestamp, Statement: "SELECT CURRENT_ACCOUNT()"}
+
+		assert.Equal(t, "-- 2025-01-01T00:00:00Z\nSELECT CURRENT_ACCOUNT();\n", statement.String())
+	})
+}
+
+func TestFileStatementRecorder(t *testing.T) {
+	t.Run("empty path", func(t *testing.T) {
+		_, err := NewFileStatementRecorder(" ")
+
+		require.ErrorContains(t, err, "statement log path must not be empty")
+	})
+
+	t.Run("statements appended", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "statements.sql")
+		require.NoError(t, os.WriteFile(path, []byte("-- previous run\n"), 0o600))
+		recorder, err := NewFileStatementRecorder(path)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, recorder.Close()) })
+		timestamp := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		require.NoError(t, recorder.Record(RecordedStatement{Timestamp: timestamp, Statement: `CREATE DATABASE "DB"`}))
+		require.NoError(t, recorder.Record(RecordedStatement{Timestamp: timestamp, Statement: `DROP DATABASE "DB"`}))
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "-- previous run\n-- 2025-01-01T00:00:00Z\nCREATE DATABASE \"DB\";\n-- 2025-01-01T00:00:00Z\nDROP DATABASE \"DB\";\n", string(content))
+	})
+}
+
+func TestClient_StatementRecorder(t *testing.T) {
+	ctx := tracking.NewContext(context.Background(), tracking.Metadata{Version: "v1.0.0", Resource: "snowflake_database", Operation: tracking.CreateOperation})
+
+	t.Run("statements recorded once with the metadata", func(t *testing.T) {
+		fakeDriver := newFakeDriver(&gosnowflake.SnowflakeError{Number: 625})
+		client := newFakeClient(t, fakeDriver)
+		client.SetRetryPolicy(NewExponentialBackoffRetryPolicy(2, time.Millisecond, time.Millisecond))
+		recorder := NewInMemoryStatementRecorder()
+		client.SetStatementRecorder(recorder)
+
+		_, err := client.exec(ctx, "CREATE DATABASE DB")
+		require.NoError(t, err)
+
+		statements := recorder.Statements()
+		require.Len(t, statements, 1)
+		assert.Equal(t, "CREATE DATABASE DB", statements[0].Statement)
+		assert.Equal(t, "snowflake_database", statements[0].Resource)
+		assert.Equal(t, tracking.CreateOperation, statements[0].Operation)
+		assert.Empty(t, statements[0].ResourceId)
+		assert.Len(t, fakeDriver.Statements(), 2)
+	})
+
+	t.Run("statements recorded with the resource id", func(t *testing.T) {
+		client := newFakeClient(t, newFakeDriver())
+		recorder := NewInMemoryStatementRecorder()
+		client.SetStatementRecorder(recorder)
+		ctx := tracking.NewResourceIdContext(ctx, func() string { return `"DB"` })
+
+		_, err := client.exec(ctx, "ALTER DATABASE DB SET COMMENT = 'comment'")
+		require.NoError(t, err)
+
+		statements := recorder.Statements()
+		require.Len(t, statements, 1)
+		assert.Equal(t, `"DB"`, statements[0].ResourceId)
+	})
+	t.Run("dry-run client", func(t *testing.T) {
+		recorder := NewInMemoryStatementRecorder()
+		client := NewDryRunClient(recorder)
+		id := NewAccountObjectIdentifier("DB")
+
+		err := client.Databases.Create(ctx, NewCreateDatabaseRequest(id).WithComment("comment"))
+		require.NoError(t, err)
+
+		_, err = client.Databases.ShowByID(ctx, id)
+		require.ErrorIs(t, err, ErrObjectNotFound)
+
+		statements := recorder.Statements()
+		require.Len(t, statements, 2)
+		assert.Equal(t, `CREATE DATABASE "DB" COMMENT = 'comment'`, statements[0].Statement)
+		assert.Equal(t, `SHOW DATABASES LIKE 'DB'`, statements[1].Statement)
+	})
+
+	t.Run("dry-run mode runs only the queries", func(t *testing.T) {
+		fakeDriver := newFakeDriver()
+		client := newFakeClient(t, fakeDriver)
+		client.SetDryRun(true)
+		recorder := NewInMemoryStatementRecorder()
+		client.SetStatementRecorder(recorder)
+		var rows []struct {
+			Value string `db:"value"`
+		}
+
+		_, err := client.exec(ctx, "DROP DATABASE DB")
+		require.NoError(t, err)
+		err = client.query(ctx, &rows, "SHOW DATABASES")
+		require.NoError(t, err)
+
+		statements := recorder.Statements()
+		require.Len(t, statements, 2)
+		assert.Equal(t, "DROP DATABASE DB", statements[0].Statement)
+		assert.Equal(t, "SHOW DATABASES", statements[1].Statement)
+		require.Len(t, fakeDriver.Statements(), 1)
+		assert.True(t, strings.HasPrefix(fakeDriver.Statements()[0], "SHOW DATABASES"))
+	})
+}
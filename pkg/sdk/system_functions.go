@@ -26,6 +26,9 @@ type SystemFunctions interface {
 	// clustering key is used; otherwise the given expressions are used as the clustering key for the calculation.
 	// See more in https://docs.snowflake.com/en/sql-reference/functions/system_clustering_information.
 	GetClusteringInformation(ctx context.Context, id SchemaObjectIdentifier, columns ...string) (*ClusteringInformation, error)
+	// GenerateScimAccessToken returns a new access token for the given SCIM security integration.
+	// See more in https://docs.snowflake.com/en/sql-reference/functions/system_generate_scim_access_token.
+	GenerateScimAccessToken(ctx context.Context, integrationId AccountObjectIdentifier) (string, error)
 }
 
 var _ SystemFunctions = (*systemFunctions)(nil)
@@ -210,6 +213,34 @@ func (c *systemFunctions) BehaviorChangeBundleStatus(ctx context.Context, bundle
 	return ToBehaviorChangeBundleStatus(row.StatusRaw)
 }
 
+func (c *systemFunctions) GenerateScimAccessToken(ctx context.Context, integrationId AccountObjectIdentifier) (string, error) {
+	row := &struct {
+		Token string `db:"TOKEN"`
+	}{}
+	opts := &generateScimAccessTokenOptions{
+		arguments: &generateScimAccessTokenArgs{IntegrationName: integrationId.Name()},
+	}
+	sql, err := structToSQL(opts)
+	if err != nil {
+		return "", err
+	}
+	if err := c.client.queryOne(ctx, row, sql); err != nil {
+		return "", err
+	}
+	return row.Token, nil
+}
+
+type generateScimAccessTokenOptions struct {
+	selectSystemGenerateScimAccessToken bool                         `ddl:"static" sql:"SELECT SYSTEM$GENERATE_SCIM_ACCESS_TOKEN"`
+	arguments                           *generateScimAccessTokenArgs `ddl:"list,parentheses,must_parentheses"`
+	as                                  bool                         `ddl:"static" sql:"AS \"TOKEN\""`
+}
+
+// generateScimAccessTokenArgs holds the integration name; SYSTEM$GENERATE_SCIM_ACCESS_TOKEN expects it as a string literal, not as an identifier.
+type generateScimAccessTokenArgs struct {
+	IntegrationName string `ddl:"keyword,single_quotes"`
+}
+
 type icebergTableInformationDbStruct struct {
 	MetadataLocation string `json:"metadataLocation"`
 	Status           string `json:"status"`
@@ -84,6 +84,26 @@ func Test_getClusteringInformationOptions_SQL(t *testing.T) {
 	})
 }
 
+func Test_generateScimAccessTokenOptions_SQL(t *testing.T) {
+	t.Run("basic", func(t *testing.T) {
+		opts := &generateScimAccessTokenOptions{
+			arguments: &generateScimAccessTokenArgs{IntegrationName: "SCIM_INTEGRATION"},
+		}
+		got, err := structToSQL(opts)
+		require.NoError(t, err)
+		require.Equal(t, `SELECT SYSTEM$GENERATE_SCIM_ACCESS_TOKEN ('SCIM_INTEGRATION') AS "TOKEN"`, got)
+	})
+
+	t.Run("name with single quote is escaped", func(t *testing.T) {
+		opts := &generateScimAccessTokenOptions{
+			arguments: &generateScimAccessTokenArgs{IntegrationName: `we'ird`},
+		}
+		got, err := structToSQL(opts)
+		require.NoError(t, err)
+		require.Equal(t, `SELECT SYSTEM$GENERATE_SCIM_ACCESS_TOKEN ('we\'ird') AS "TOKEN"`, got)
+	})
+}
+
 func Test_parseClusteringInformation(t *testing.T) {
 	t.Run("valid output", func(t *testing.T) {
 		// Output captured from SYSTEM$CLUSTERING_INFORMATION on a clustered table.
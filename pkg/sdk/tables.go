@@ -208,23 +208,29 @@ type alterTableOptions struct {
 	name     SchemaObjectIdentifier `ddl:"identifier"`
 
 	// One of
-	NewName                    *SchemaObjectIdentifier              `ddl:"identifier" sql:"RENAME TO"`
-	SwapWith                   *SchemaObjectIdentifier              `ddl:"identifier" sql:"SWAP WITH"`
-	ClusteringAction           *TableClusteringAction               `ddl:"keyword"`
-	ColumnAction               *TableColumnAction                   `ddl:"keyword"`
-	ConstraintAction           *TableConstraintAction               `ddl:"keyword"`
-	ExternalTableAction        *TableExternalTableAction            `ddl:"keyword"`
-	SearchOptimizationAction   *TableSearchOptimizationActionLegacy `ddl:"keyword"`
-	Set                        *TableSet                            `ddl:"keyword" sql:"SET"`
-	SetTags                    []TagAssociation                     `ddl:"parameter,no_equals" sql:"SET TAG"`
-	UnsetTags                  []ObjectIdentifier                   `ddl:"keyword" sql:"UNSET TAG"`
-	Unset                      *TableUnset                          `ddl:"keyword" sql:"UNSET"`
-	AddRowAccessPolicy         *TableAddRowAccessPolicy             `ddl:"keyword"`
-	DropRowAccessPolicy        *TableDropRowAccessPolicy            `ddl:"keyword"`
-	DropAndAddRowAccessPolicy  *TableDropAndAddRowAccessPolicy      `ddl:"list,no_parentheses"`
-	DropAllAccessRowPolicies   *bool                                `ddl:"keyword" sql:"DROP ALL ROW ACCESS POLICIES"`
-	AddStorageLifecyclePolicy  *TableAddStorageLifecyclePolicy      `ddl:"keyword"`
-	DropStorageLifecyclePolicy *bool                                `ddl:"keyword" sql:"DROP STORAGE LIFECYCLE POLICY"`
+	NewName                       *SchemaObjectIdentifier              `ddl:"identifier" sql:"RENAME TO"`
+	SwapWith                      *SchemaObjectIdentifier              `ddl:"identifier" sql:"SWAP WITH"`
+	ClusteringAction              *TableClusteringAction               `ddl:"keyword"`
+	ColumnAction                  *TableColumnAction                   `ddl:"keyword"`
+	ConstraintAction              *TableConstraintAction               `ddl:"keyword"`
+	ExternalTableAction           *TableExternalTableAction            `ddl:"keyword"`
+	SearchOptimizationAction      *TableSearchOptimizationActionLegacy `ddl:"keyword"`
+	Set                           *TableSet                            `ddl:"keyword" sql:"SET"`
+	SetTags                       []TagAssociation                     `ddl:"parameter,no_equals" sql:"SET TAG"`
+	UnsetTags                     []ObjectIdentifier                   `ddl:"keyword" sql:"UNSET TAG"`
+	Unset                         *TableUnset                          `ddl:"keyword" sql:"UNSET"`
+	AddRowAccessPolicy            *TableAddRowAccessPolicy             `ddl:"keyword"`
+	DropRowAccessPolicy           *TableDropRowAccessPolicy            `ddl:"keyword"`
+	DropAndAddRowAccessPolicy     *TableDropAndAddRowAccessPolicy      `ddl:"list,no_parentheses"`
+	DropAllAccessRowPolicies      *bool                                `ddl:"keyword" sql:"DROP ALL ROW ACCESS POLICIES"`
+	AddStorageLifecyclePolicy     *TableAddStorageLifecyclePolicy      `ddl:"keyword"`
+	DropStorageLifecyclePolicy    *bool                                `ddl:"keyword" sql:"DROP STORAGE LIFECYCLE POLICY"`
+	SetAggregationPolicy          *ViewSetAggregationPolicy            `ddl:"keyword"`
+	UnsetAggregationPolicy        *ViewUnsetAggregationPolicy          `ddl:"keyword"`
+	SetJoinPolicy                 *TableSetJoinPolicy                  `ddl:"keyword"`
+	UnsetJoinPolicy               *TableUnsetJoinPolicy                `ddl:"keyword"`
+	SetProjectionPolicyOnColumn   *TableSetColumnProjectionPolicy      `ddl:"keyword"`
+	UnsetProjectionPolicyOnColumn *TableUnsetColumnProjectionPolicy    `ddl:"keyword"`
 }
 
 type TableClusteringAction struct {
@@ -171,25 +171,31 @@ type OutOfLineForeignKeyRequest struct {
 }
 
 type AlterTableRequest struct {
-	IfExists                   *bool
-	name                       SchemaObjectIdentifier // required
-	NewName                    *SchemaObjectIdentifier
-	SwapWith                   *SchemaObjectIdentifier
-	ClusteringAction           *TableClusteringActionRequest
-	ColumnAction               *TableColumnActionRequest
-	ConstraintAction           *TableConstraintActionRequest
-	ExternalTableAction        *TableExternalTableActionRequest
-	SearchOptimizationAction   *TableSearchOptimizationActionLegacyRequest
-	Set                        *TableSetRequest
-	SetTags                    []TagAssociationRequest
-	UnsetTags                  []ObjectIdentifier
-	Unset                      *TableUnsetRequest
-	AddRowAccessPolicy         *TableAddRowAccessPolicyRequest
-	DropRowAccessPolicy        *TableDropRowAccessPolicyRequest
-	DropAndAddRowAccessPolicy  *TableDropAndAddRowAccessPolicy
-	DropAllAccessRowPolicies   *bool
-	AddStorageLifecyclePolicy  *TableAddStorageLifecyclePolicyRequest
-	DropStorageLifecyclePolicy *bool
+	IfExists                      *bool
+	name                          SchemaObjectIdentifier // required
+	NewName                       *SchemaObjectIdentifier
+	SwapWith                      *SchemaObjectIdentifier
+	ClusteringAction              *TableClusteringActionRequest
+	ColumnAction                  *TableColumnActionRequest
+	ConstraintAction              *TableConstraintActionRequest
+	ExternalTableAction           *TableExternalTableActionRequest
+	SearchOptimizationAction      *TableSearchOptimizationActionLegacyRequest
+	Set                           *TableSetRequest
+	SetTags                       []TagAssociationRequest
+	UnsetTags                     []ObjectIdentifier
+	Unset                         *TableUnsetRequest
+	AddRowAccessPolicy            *TableAddRowAccessPolicyRequest
+	DropRowAccessPolicy           *TableDropRowAccessPolicyRequest
+	DropAndAddRowAccessPolicy     *TableDropAndAddRowAccessPolicy
+	DropAllAccessRowPolicies      *bool
+	AddStorageLifecyclePolicy     *TableAddStorageLifecyclePolicyRequest
+	DropStorageLifecyclePolicy    *bool
+	SetAggregationPolicy          *ViewSetAggregationPolicyRequest
+	UnsetAggregationPolicy        *ViewUnsetAggregationPolicyRequest
+	SetJoinPolicy                 *TableSetJoinPolicyRequest
+	UnsetJoinPolicy               *TableUnsetJoinPolicyRequest
+	SetProjectionPolicyOnColumn   *TableSetColumnProjectionPolicyRequest
+	UnsetProjectionPolicyOnColumn *TableUnsetColumnProjectionPolicyRequest
 }
 
 type DropTableRequest struct {
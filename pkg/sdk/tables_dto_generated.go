@@ -664,6 +664,36 @@ func (s *AlterTableRequest) WithDropStorageLifecyclePolicy(dropStorageLifecycleP
 	return s
 }
 
+func (s *AlterTableRequest) WithSetAggregationPolicy(setAggregationPolicy *ViewSetAggregationPolicyRequest) *AlterTableRequest {
+	s.SetAggregationPolicy = setAggregationPolicy
+	return s
+}
+
+func (s *AlterTableRequest) WithUnsetAggregationPolicy(unsetAggregationPolicy *ViewUnsetAggregationPolicyRequest) *AlterTableRequest {
+	s.UnsetAggregationPolicy = unsetAggregationPolicy
+	return s
+}
+
+func (s *AlterTableRequest) WithSetJoinPolicy(setJoinPolicy *TableSetJoinPolicyRequest) *AlterTableRequest {
+	s.SetJoinPolicy = setJoinPolicy
+	return s
+}
+
+func (s *AlterTableRequest) WithUnsetJoinPolicy(unsetJoinPolicy *TableUnsetJoinPolicyRequest) *AlterTableRequest {
+	s.UnsetJoinPolicy = unsetJoinPolicy
+	return s
+}
+
+func (s *AlterTableRequest) WithSetProjectionPolicyOnColumn(setProjectionPolicyOnColumn *TableSetColumnProjectionPolicyRequest) *AlterTableRequest {
+	s.SetProjectionPolicyOnColumn = setProjectionPolicyOnColumn
+	return s
+}
+
+func (s *AlterTableRequest) WithUnsetProjectionPolicyOnColumn(unsetProjectionPolicyOnColumn *TableUnsetColumnProjectionPolicyRequest) *AlterTableRequest {
+	s.UnsetProjectionPolicyOnColumn = unsetProjectionPolicyOnColumn
+	return s
+}
+
 func NewDropTableRequest(
 	name SchemaObjectIdentifier,
 ) *DropTableRequest {
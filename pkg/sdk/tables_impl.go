@@ -205,27 +205,66 @@ func (s *AlterTableRequest) toOpts() *alterTableOptions {
 			On:                     s.AddStorageLifecyclePolicy.On,
 		}
 	}
+	var setAggregationPolicy *ViewSetAggregationPolicy
+	if s.SetAggregationPolicy != nil {
+		setAggregationPolicy = s.SetAggregationPolicy.toOpts()
+	}
+	var unsetAggregationPolicy *ViewUnsetAggregationPolicy
+	if s.UnsetAggregationPolicy != nil {
+		unsetAggregationPolicy = s.UnsetAggregationPolicy.toOpts()
+	}
+	var setJoinPolicy *TableSetJoinPolicy
+	if s.SetJoinPolicy != nil {
+		setJoinPolicy = &TableSetJoinPolicy{
+			JoinPolicy: s.SetJoinPolicy.JoinPolicy,
+			Force:      s.SetJoinPolicy.Force,
+		}
+	}
+	var unsetJoinPolicy *TableUnsetJoinPolicy
+	if s.UnsetJoinPolicy != nil {
+		unsetJoinPolicy = &TableUnsetJoinPolicy{}
+	}
+	var setProjectionPolicyOnColumn *TableSetColumnProjectionPolicy
+	if s.SetProjectionPolicyOnColumn != nil {
+		setProjectionPolicyOnColumn = &TableSetColumnProjectionPolicy{
+			Name:             s.SetProjectionPolicyOnColumn.Name,
+			ProjectionPolicy: s.SetProjectionPolicyOnColumn.ProjectionPolicy,
+			Force:            s.SetProjectionPolicyOnColumn.Force,
+		}
+	}
+	var unsetProjectionPolicyOnColumn *TableUnsetColumnProjectionPolicy
+	if s.UnsetProjectionPolicyOnColumn != nil {
+		unsetProjectionPolicyOnColumn = &TableUnsetColumnProjectionPolicy{
+			Name: s.UnsetProjectionPolicyOnColumn.Name,
+		}
+	}
 
 	return &alterTableOptions{
-		IfExists:                   s.IfExists,
-		name:                       s.name,
-		NewName:                    s.NewName,
-		SwapWith:                   s.SwapWith,
-		ClusteringAction:           clusteringAction,
-		ColumnAction:               columnAction,
-		ConstraintAction:           constraintAction,
-		ExternalTableAction:        externalTableAction,
-		SearchOptimizationAction:   searchOptimizationAction,
-		Set:                        tableSet,
-		SetTags:                    tagAssociations,
-		UnsetTags:                  s.UnsetTags,
-		Unset:                      tableUnset,
-		AddRowAccessPolicy:         addRowAccessPolicy,
-		DropRowAccessPolicy:        dropRowAccessPolicy,
-		DropAndAddRowAccessPolicy:  dropAndAddRowAccessPolicy,
-		DropAllAccessRowPolicies:   s.DropAllAccessRowPolicies,
-		AddStorageLifecyclePolicy:  addStorageLifecyclePolicy,
-		DropStorageLifecyclePolicy: s.DropStorageLifecyclePolicy,
+		IfExists:                      s.IfExists,
+		name:                          s.name,
+		NewName:                       s.NewName,
+		SwapWith:                      s.SwapWith,
+		ClusteringAction:              clusteringAction,
+		ColumnAction:                  columnAction,
+		ConstraintAction:              constraintAction,
+		ExternalTableAction:           externalTableAction,
+		SearchOptimizationAction:      searchOptimizationAction,
+		Set:                           tableSet,
+		SetTags:                       tagAssociations,
+		UnsetTags:                     s.UnsetTags,
+		Unset:                         tableUnset,
+		AddRowAccessPolicy:            addRowAccessPolicy,
+		DropRowAccessPolicy:           dropRowAccessPolicy,
+		DropAndAddRowAccessPolicy:     dropAndAddRowAccessPolicy,
+		DropAllAccessRowPolicies:      s.DropAllAccessRowPolicies,
+		AddStorageLifecyclePolicy:     addStorageLifecyclePolicy,
+		DropStorageLifecyclePolicy:    s.DropStorageLifecyclePolicy,
+		SetAggregationPolicy:          setAggregationPolicy,
+		UnsetAggregationPolicy:        unsetAggregationPolicy,
+		SetJoinPolicy:                 setJoinPolicy,
+		UnsetJoinPolicy:               unsetJoinPolicy,
+		SetProjectionPolicyOnColumn:   setProjectionPolicyOnColumn,
+		UnsetProjectionPolicyOnColumn: unsetProjectionPolicyOnColumn,
 	}
 }
 
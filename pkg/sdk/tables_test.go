@@ -737,7 +737,7 @@ func TestTableAlter(t *testing.T) {
 
 	t.Run("validation: no action", func(t *testing.T) {
 		opts := defaultOpts()
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies", "AddStorageLifecyclePolicy", "DropStorageLifecyclePolicy"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies", "AddStorageLifecyclePolicy", "DropStorageLifecyclePolicy", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetJoinPolicy", "UnsetJoinPolicy", "SetProjectionPolicyOnColumn", "UnsetProjectionPolicyOnColumn"))
 	})
 
 	t.Run("validation: incorrect identifier", func(t *testing.T) {
@@ -751,7 +751,7 @@ func TestTableAlter(t *testing.T) {
 		opts.NewName = Pointer(randomSchemaObjectIdentifier())
 		opts.SwapWith = Pointer(randomSchemaObjectIdentifier())
 
-		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies", "AddStorageLifecyclePolicy", "DropStorageLifecyclePolicy"))
+		assertOptsInvalidJoinedErrors(t, opts, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies", "AddStorageLifecyclePolicy", "DropStorageLifecyclePolicy", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetJoinPolicy", "UnsetJoinPolicy", "SetProjectionPolicyOnColumn", "UnsetProjectionPolicyOnColumn"))
 	})
 
 	t.Run("validation: NewName's incorrect identifier", func(t *testing.T) {
@@ -923,6 +923,31 @@ func TestTableAlter(t *testing.T) {
 		assertOptsInvalidJoinedErrors(t, opts, errNotSet("TableAddStorageLifecyclePolicy", "On"))
 	})
 
+	t.Run("validation: set aggregation policy with incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetAggregationPolicy = &ViewSetAggregationPolicy{
+			AggregationPolicy: emptySchemaObjectIdentifier,
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("ViewSetAggregationPolicy", "AggregationPolicy"))
+	})
+
+	t.Run("validation: set join policy with incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetJoinPolicy = &TableSetJoinPolicy{
+			JoinPolicy: emptySchemaObjectIdentifier,
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("TableSetJoinPolicy", "JoinPolicy"))
+	})
+
+	t.Run("validation: set projection policy with incorrect identifier", func(t *testing.T) {
+		opts := defaultOpts()
+		opts.SetProjectionPolicyOnColumn = &TableSetColumnProjectionPolicy{
+			Name:             "COLUMN_1",
+			ProjectionPolicy: emptySchemaObjectIdentifier,
+		}
+		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("TableSetColumnProjectionPolicy", "ProjectionPolicy"))
+	})
+
 	t.Run("empty options", func(t *testing.T) {
 		opts := &alterTableOptions{}
 		assertOptsInvalidJoinedErrors(t, opts, errInvalidIdentifier("alterTableOptions", "name"))
@@ -1529,6 +1554,73 @@ func TestTableAlter(t *testing.T) {
 		}
 		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s DROP STORAGE LIFECYCLE POLICY`, id.FullyQualifiedName())
 	})
+
+	t.Run("set aggregation policy", func(t *testing.T) {
+		aggregationPolicyId := randomSchemaObjectIdentifier()
+
+		opts := &alterTableOptions{
+			name: id,
+			SetAggregationPolicy: &ViewSetAggregationPolicy{
+				AggregationPolicy: aggregationPolicyId,
+				EntityKey:         []Column{{Value: "FIRST_COLUMN"}},
+				Force:             Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s SET AGGREGATION POLICY %s ENTITY KEY ("FIRST_COLUMN") FORCE`, id.FullyQualifiedName(), aggregationPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("unset aggregation policy", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name:                   id,
+			UnsetAggregationPolicy: &ViewUnsetAggregationPolicy{},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s UNSET AGGREGATION POLICY`, id.FullyQualifiedName())
+	})
+
+	t.Run("set join policy", func(t *testing.T) {
+		joinPolicyId := randomSchemaObjectIdentifier()
+
+		opts := &alterTableOptions{
+			name: id,
+			SetJoinPolicy: &TableSetJoinPolicy{
+				JoinPolicy: joinPolicyId,
+				Force:      Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s SET JOIN POLICY %s FORCE`, id.FullyQualifiedName(), joinPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("unset join policy", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name:            id,
+			UnsetJoinPolicy: &TableUnsetJoinPolicy{},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s UNSET JOIN POLICY`, id.FullyQualifiedName())
+	})
+
+	t.Run("set projection policy on column", func(t *testing.T) {
+		projectionPolicyId := randomSchemaObjectIdentifier()
+
+		opts := &alterTableOptions{
+			name: id,
+			SetProjectionPolicyOnColumn: &TableSetColumnProjectionPolicy{
+				Name:             "COLUMN_1",
+				ProjectionPolicy: projectionPolicyId,
+				Force:            Bool(true),
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s ALTER COLUMN "COLUMN_1" SET PROJECTION POLICY %s FORCE`, id.FullyQualifiedName(), projectionPolicyId.FullyQualifiedName())
+	})
+
+	t.Run("unset projection policy on column", func(t *testing.T) {
+		opts := &alterTableOptions{
+			name: id,
+			UnsetProjectionPolicyOnColumn: &TableUnsetColumnProjectionPolicy{
+				Name: "COLUMN_1",
+			},
+		}
+		assertOptsValidAndSQLEquals(t, opts, `ALTER TABLE %s ALTER COLUMN "COLUMN_1" UNSET PROJECTION POLICY`, id.FullyQualifiedName())
+	})
 }
 
 func TestTableDrop(t *testing.T) {
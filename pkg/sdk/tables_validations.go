@@ -161,8 +161,14 @@ func (opts *alterTableOptions) validate() error {
 		opts.DropAllAccessRowPolicies,
 		opts.AddStorageLifecyclePolicy,
 		opts.DropStorageLifecyclePolicy,
+		opts.SetAggregationPolicy,
+		opts.UnsetAggregationPolicy,
+		opts.SetJoinPolicy,
+		opts.UnsetJoinPolicy,
+		opts.SetProjectionPolicyOnColumn,
+		opts.UnsetProjectionPolicyOnColumn,
 	); !ok {
-		errs = append(errs, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies", "AddStorageLifecyclePolicy", "DropStorageLifecyclePolicy"))
+		errs = append(errs, errExactlyOneOf("alterTableOptions", "NewName", "SwapWith", "ClusteringAction", "ColumnAction", "ConstraintAction", "ExternalTableAction", "SearchOptimizationAction", "Set", "SetTags", "UnsetTags", "Unset", "AddRowAccessPolicy", "DropRowAccessPolicy", "DropAndAddRowAccessPolicy", "DropAllAccessRowPolicies", "AddStorageLifecyclePolicy", "DropStorageLifecyclePolicy", "SetAggregationPolicy", "UnsetAggregationPolicy", "SetJoinPolicy", "UnsetJoinPolicy", "SetProjectionPolicyOnColumn", "UnsetProjectionPolicyOnColumn"))
 	}
 	if opts.NewName != nil {
 		if !ValidObjectIdentifier(*opts.NewName) {
@@ -275,6 +281,21 @@ func (opts *alterTableOptions) validate() error {
 			errs = append(errs, errNotSet("TableAddStorageLifecyclePolicy", "On"))
 		}
 	}
+	if setAggregationPolicy := opts.SetAggregationPolicy; valueSet(setAggregationPolicy) {
+		if !ValidObjectIdentifier(setAggregationPolicy.AggregationPolicy) {
+			errs = append(errs, errInvalidIdentifier("ViewSetAggregationPolicy", "AggregationPolicy"))
+		}
+	}
+	if setJoinPolicy := opts.SetJoinPolicy; valueSet(setJoinPolicy) {
+		if !ValidObjectIdentifier(setJoinPolicy.JoinPolicy) {
+			errs = append(errs, errInvalidIdentifier("TableSetJoinPolicy", "JoinPolicy"))
+		}
+	}
+	if setProjectionPolicy := opts.SetProjectionPolicyOnColumn; valueSet(setProjectionPolicy) {
+		if !ValidObjectIdentifier(setProjectionPolicy.ProjectionPolicy) {
+			errs = append(errs, errInvalidIdentifier("TableSetColumnProjectionPolicy", "ProjectionPolicy"))
+		}
+	}
 	return errors.Join(errs...)
 }
 
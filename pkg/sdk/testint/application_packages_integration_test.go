@@ -160,6 +160,9 @@ func TestInt_ApplicationPackagesVersionAndReleaseDirective(t *testing.T) {
 		require.Len(t, versions, 1)
 		require.Equal(t, version, versions[0].Version)
 		require.Equal(t, 0, versions[0].Patch)
+		require.Equal(t, "add version V001", versions[0].Label)
+		require.NotEmpty(t, versions[0].State)
+		require.False(t, versions[0].CreatedOn.IsZero())
 
 		// add patch for application package version
 		pr := sdk.NewAddPatchForVersionRequest(&version, using).WithLabel("patch version V001")
@@ -0,0 +1,164 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_BackupPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	createBasic := func(t *testing.T) sdk.SchemaObjectIdentifier {
+		t.Helper()
+		backupPolicy, cleanup := testClientHelper().BackupPolicy.Create(t)
+		t.Cleanup(cleanup)
+
+		return backupPolicy.ID()
+	}
+
+	t.Run("create: minimal", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.BackupPolicies.Create(ctx, sdk.NewCreateBackupPolicyRequest(id).WithExpireAfterDays(7))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().BackupPolicy.DropFunc(t, id))
+
+		assertThatObject(
+			t, objectassert.BackupPolicy(t, id).
+				HasCreatedOnNotEmpty().
+				HasName(id.Name()).
+				HasDatabaseName(id.DatabaseName()).
+				HasSchemaName(id.SchemaName()).
+				HasOwner(snowflakeroles.Accountadmin.Name()).
+				HasOwnerRoleType("ROLE").
+				HasNoSchedule().
+				HasExpireAfterDays(7).
+				HasHasRetentionLock(false),
+		)
+		assertThatObject(
+			t, objectassert.BackupPolicyDetails(t, id).
+				HasName(id.Name()).
+				HasNoSchedule().
+				HasExpireAfterDays(7).
+				HasHasRetentionLock(false),
+		)
+	})
+
+	t.Run("create: complete", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		comment := random.Comment()
+
+		err := client.BackupPolicies.Create(ctx, sdk.NewCreateBackupPolicyRequest(id).
+			WithIfNotExists(true).
+			WithSchedule("60 MINUTE").
+			WithExpireAfterDays(30).
+			WithComment(comment))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().BackupPolicy.DropFunc(t, id))
+
+		assertThatObject(
+			t, objectassert.BackupPolicy(t, id).
+				HasName(id.Name()).
+				HasComment(comment).
+				HasSchedule("60 MINUTE").
+				HasExpireAfterDays(30).
+				HasHasRetentionLock(false),
+		)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		id := createBasic(t)
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.BackupPolicies.Alter(ctx, sdk.NewAlterBackupPolicyRequest(id).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().BackupPolicy.DropFunc(t, newId))
+
+		_, err = client.BackupPolicies.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		assertThatObject(
+			t, objectassert.BackupPolicy(t, newId).
+				HasName(newId.Name()),
+		)
+	})
+
+	t.Run("alter: set and unset", func(t *testing.T) {
+		id := createBasic(t)
+		comment := random.Comment()
+
+		err := client.BackupPolicies.Alter(ctx, sdk.NewAlterBackupPolicyRequest(id).
+			WithSet(*sdk.NewBackupPolicySetRequest().
+				WithSchedule("120 MINUTE").
+				WithExpireAfterDays(14).
+				WithComment(comment)))
+		require.NoError(t, err)
+
+		assertThatObject(
+			t, objectassert.BackupPolicy(t, id).
+				HasSchedule("120 MINUTE").
+				HasExpireAfterDays(14).
+				HasComment(comment),
+		)
+
+		err = client.BackupPolicies.Alter(ctx, sdk.NewAlterBackupPolicyRequest(id).
+			WithUnset(*sdk.NewBackupPolicyUnsetRequest().
+				WithSchedule(true).
+				WithComment(true)))
+		require.NoError(t, err)
+
+		assertThatObject(
+			t, objectassert.BackupPolicy(t, id).
+				HasNoSchedule().
+				HasExpireAfterDays(14).
+				HasNoComment(),
+		)
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		id := createBasic(t)
+
+		err := client.BackupPolicies.Drop(ctx, sdk.NewDropBackupPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.BackupPolicies.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop: if exists on non-existing", func(t *testing.T) {
+		err := client.BackupPolicies.Drop(ctx, sdk.NewDropBackupPolicyRequest(NonExistingSchemaObjectIdentifier).WithIfExists(true))
+		require.NoError(t, err)
+	})
+
+	t.Run("show: with like and in", func(t *testing.T) {
+		id1 := createBasic(t)
+		id2 := createBasic(t)
+
+		backupPolicies, err := client.BackupPolicies.Show(ctx, sdk.NewShowBackupPolicyRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(id1.Name())}).
+			WithIn(sdk.In{Schema: id1.SchemaId()}))
+		require.NoError(t, err)
+		require.Len(t, backupPolicies, 1)
+		assert.Equal(t, id1.Name(), backupPolicies[0].Name)
+
+		backupPolicies, err = client.BackupPolicies.Show(ctx, sdk.NewShowBackupPolicyRequest().
+			WithIn(sdk.In{Schema: id2.SchemaId()}))
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, len(backupPolicies), 2)
+	})
+
+	t.Run("describe: non-existing", func(t *testing.T) {
+		_, err := client.BackupPolicies.Describe(ctx, NonExistingSchemaObjectIdentifier)
+		require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
@@ -0,0 +1,157 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_BackupSets(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	table, tableCleanup := testClientHelper().Table.Create(t)
+	t.Cleanup(tableCleanup)
+
+	createBasic := func(t *testing.T) sdk.SchemaObjectIdentifier {
+		t.Helper()
+		backupSet, cleanup := testClientHelper().BackupSet.CreateForTable(t, table.ID())
+		t.Cleanup(cleanup)
+
+		return backupSet.ID()
+	}
+
+	t.Run("create: for table", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.BackupSets.Create(ctx, sdk.NewCreateBackupSetRequest(id, *sdk.NewBackupSetForRequest().WithTable(table.ID())))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().BackupSet.DropFunc(t, id))
+
+		assertThatObject(
+			t, objectassert.BackupSet(t, id).
+				HasCreatedOnNotEmpty().
+				HasName(id.Name()).
+				HasDatabaseName(id.DatabaseName()).
+				HasSchemaName(id.SchemaName()).
+				HasObjectKind("TABLE").
+				HasObjectName(table.Name).
+				HasObjectDatabaseName(table.DatabaseName).
+				HasObjectSchemaName(table.SchemaName).
+				HasNoBackupPolicyName().
+				HasOwner(snowflakeroles.Accountadmin.Name()).
+				HasOwnerRoleType("ROLE"),
+		)
+	})
+
+	t.Run("create: for schema with backup policy and comment", func(t *testing.T) {
+		schema, schemaCleanup := testClientHelper().Schema.CreateSchema(t)
+		t.Cleanup(schemaCleanup)
+		backupPolicy, backupPolicyCleanup := testClientHelper().BackupPolicy.Create(t)
+		t.Cleanup(backupPolicyCleanup)
+
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		comment := random.Comment()
+
+		err := client.BackupSets.Create(ctx, sdk.NewCreateBackupSetRequest(id, *sdk.NewBackupSetForRequest().WithSchema(schema.ID())).
+			WithIfNotExists(true).
+			WithBackupPolicy(backupPolicy.ID()).
+			WithComment(comment))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().BackupSet.DropFunc(t, id))
+
+		assertThatObject(
+			t, objectassert.BackupSet(t, id).
+				HasName(id.Name()).
+				HasObjectKind("SCHEMA").
+				HasObjectName(schema.Name).
+				HasBackupPolicyName(backupPolicy.Name).
+				HasComment(comment),
+		)
+	})
+
+	t.Run("alter: apply, suspend and resume backup policy", func(t *testing.T) {
+		id := createBasic(t)
+		backupPolicy, backupPolicyCleanup := testClientHelper().BackupPolicy.Create(t)
+		t.Cleanup(backupPolicyCleanup)
+
+		err := client.BackupSets.Alter(ctx, sdk.NewAlterBackupSetRequest(id).
+			WithApplyBackupPolicy(*sdk.NewBackupSetApplyBackupPolicyRequest(backupPolicy.ID())))
+		require.NoError(t, err)
+
+		assertThatObject(
+			t, objectassert.BackupSet(t, id).
+				HasBackupPolicyName(backupPolicy.Name),
+		)
+
+		err = client.BackupSets.Alter(ctx, sdk.NewAlterBackupSetRequest(id).WithSuspendBackupPolicy(true))
+		require.NoError(t, err)
+
+		err = client.BackupSets.Alter(ctx, sdk.NewAlterBackupSetRequest(id).WithResumeBackupPolicy(true))
+		require.NoError(t, err)
+	})
+
+	t.Run("alter: add backup", func(t *testing.T) {
+		id := createBasic(t)
+
+		err := client.BackupSets.Alter(ctx, sdk.NewAlterBackupSetRequest(id).WithAddBackup(true))
+		require.NoError(t, err)
+	})
+
+	t.Run("alter: set and unset comment", func(t *testing.T) {
+		id := createBasic(t)
+		comment := random.Comment()
+
+		err := client.BackupSets.Alter(ctx, sdk.NewAlterBackupSetRequest(id).
+			WithSet(*sdk.NewBackupSetSetRequest().WithComment(comment)))
+		require.NoError(t, err)
+
+		assertThatObject(
+			t, objectassert.BackupSet(t, id).
+				HasComment(comment),
+		)
+
+		err = client.BackupSets.Alter(ctx, sdk.NewAlterBackupSetRequest(id).
+			WithUnset(*sdk.NewBackupSetUnsetRequest().WithComment(true)))
+		require.NoError(t, err)
+
+		assertThatObject(
+			t, objectassert.BackupSet(t, id).
+				HasNoComment(),
+		)
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		id := createBasic(t)
+
+		err := client.BackupSets.Drop(ctx, sdk.NewDropBackupSetRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.BackupSets.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop: if exists on non-existing", func(t *testing.T) {
+		err := client.BackupSets.Drop(ctx, sdk.NewDropBackupSetRequest(NonExistingSchemaObjectIdentifier).WithIfExists(true))
+		require.NoError(t, err)
+	})
+
+	t.Run("show: with like and in", func(t *testing.T) {
+		id := createBasic(t)
+
+		backupSets, err := client.BackupSets.Show(ctx, sdk.NewShowBackupSetRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(id.Name())}).
+			WithIn(sdk.In{Schema: id.SchemaId()}))
+		require.NoError(t, err)
+		require.Len(t, backupSets, 1)
+		assert.Equal(t, id.Name(), backupSets[0].Name)
+	})
+}
@@ -3,9 +3,12 @@
 package testint
 
 import (
+	"database/sql"
 	"testing"
 
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectassert"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/stretchr/testify/require"
 )
@@ -69,6 +72,41 @@ func TestInt_Budgets(t *testing.T) {
 		require.Equal(t, integration.ID().Name(), *integrationName)
 	})
 
+	t.Run("GetEmailNotificationRecipients and GetEmailNotificationIntegration", func(t *testing.T) {
+		budgetId, budgetCleanup := testClientHelper().Budget.Create(t)
+		t.Cleanup(budgetCleanup)
+
+		emails, err := client.Budgets.GetEmailNotificationRecipients(ctx, budgetId)
+		require.NoError(t, err)
+		require.Empty(t, emails)
+
+		integrationId, err := client.Budgets.GetEmailNotificationIntegration(ctx, budgetId)
+		require.NoError(t, err)
+		require.Nil(t, integrationId)
+
+		integration, integrationCleanup := testClientHelper().NotificationIntegration.Create(t)
+		t.Cleanup(integrationCleanup)
+
+		revokePrivilege := testClientHelper().Grant.GrantUsageOnIntegrationToSnowflakeApplication(t, integration.ID())
+		t.Cleanup(revokePrivilege)
+
+		_, err = client.Budgets.SetEmailNotifications(ctx, sdk.NewSetEmailNotificationsBudgetRequest(
+			budgetId,
+			*sdk.NewBudgetSetEmailNotificationsArgsRequestFromEmails(helpers.VerifiedEmail).
+				WithNotificationIntegration(integration.ID()),
+		))
+		require.NoError(t, err)
+
+		emails, err = client.Budgets.GetEmailNotificationRecipients(ctx, budgetId)
+		require.NoError(t, err)
+		require.Equal(t, []string{helpers.VerifiedEmail}, emails)
+
+		integrationId, err = client.Budgets.GetEmailNotificationIntegration(ctx, budgetId)
+		require.NoError(t, err)
+		require.NotNil(t, integrationId)
+		require.Equal(t, integration.ID(), *integrationId)
+	})
+
 	// TODO [next PR]: try procedure with one arg
 	// TODO [next PR]: try procedure with 2 args (string and int)
 	// TODO [next PR]: get rid of the ARRAY_CONSTRUCT() workaround for empty list
@@ -103,4 +141,82 @@ func TestInt_Budgets(t *testing.T) {
 		require.NotEmpty(t, action.AddedTimestamp)
 		require.Empty(t, action.LastTriggeredTimestamp)
 	})
+
+	t.Run("Show and ShowByID", func(t *testing.T) {
+		budgetId, budgetCleanup := testClientHelper().Budget.Create(t)
+		t.Cleanup(budgetCleanup)
+
+		budgets, err := client.Budgets.Show(ctx, sdk.NewShowBudgetRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(budgetId.Name())}).
+			WithIn(sdk.In{Schema: budgetId.SchemaId()}))
+		require.NoError(t, err)
+		require.Len(t, budgets, 1)
+
+		budget, err := client.Budgets.ShowByID(ctx, budgetId)
+		require.NoError(t, err)
+		assertThatObject(
+			t, objectassert.BudgetFromObject(t, budget).
+				HasName(budgetId.Name()).
+				HasDatabaseName(budgetId.DatabaseName()).
+				HasSchemaName(budgetId.SchemaName()).
+				HasOwner(snowflakeroles.Accountadmin.Name()).
+				HasOwnerRoleType("ROLE"),
+		)
+	})
+
+	t.Run("AddResource, GetLinkedResources, and RemoveResource", func(t *testing.T) {
+		budgetId, budgetCleanup := testClientHelper().Budget.Create(t)
+		t.Cleanup(budgetCleanup)
+
+		table, tableCleanup := testClientHelper().Table.Create(t)
+		t.Cleanup(tableCleanup)
+
+		_, err := client.Budgets.AddResource(ctx, sdk.NewAddResourceBudgetRequest(budgetId, *sdk.NewBudgetAddResourceArgsRequest(
+			*sdk.NewBudgetResourceReferenceRequestFromIdentifier(sdk.ObjectTypeTable, table.ID()),
+		)))
+		require.NoError(t, err)
+
+		linkedResources, err := client.Budgets.GetLinkedResources(ctx, sdk.NewGetLinkedResourcesBudgetRequest(budgetId))
+		require.NoError(t, err)
+		require.Len(t, linkedResources, 1)
+
+		objectType, err := linkedResources[0].ObjectType()
+		require.NoError(t, err)
+		require.Equal(t, sdk.ObjectTypeTable, objectType)
+		objectId, err := linkedResources[0].ObjectId()
+		require.NoError(t, err)
+		require.Equal(t, table.ID().FullyQualifiedName(), objectId.FullyQualifiedName())
+
+		_, err = client.Budgets.RemoveResource(ctx, sdk.NewRemoveResourceBudgetRequest(budgetId, *sdk.NewBudgetRemoveResourceArgsRequest(
+			*sdk.NewBudgetResourceReferenceRequestFromIdentifier(sdk.ObjectTypeTable, table.ID()),
+		)))
+		require.NoError(t, err)
+
+		linkedResources, err = client.Budgets.GetLinkedResources(ctx, sdk.NewGetLinkedResourcesBudgetRequest(budgetId))
+		require.NoError(t, err)
+		require.Empty(t, linkedResources)
+	})
+
+	t.Run("RemoveCycleStartAction", func(t *testing.T) {
+		budgetId, budgetCleanup := testClientHelper().Budget.Create(t)
+		t.Cleanup(budgetCleanup)
+
+		procedure, procCleanup := testClientHelper().Procedure.Create(t)
+		t.Cleanup(procCleanup)
+
+		t.Cleanup(testClientHelper().Grant.GrantUsageOnDatabaseToSnowflakeApplication(t, procedure.ID().DatabaseId()))
+		t.Cleanup(testClientHelper().Grant.GrantUsageOnSchemaToSnowflakeApplication(t, procedure.ID().SchemaId()))
+		t.Cleanup(testClientHelper().Grant.GrantUsageOnProcedureToSnowflakeApplication(t, procedure.ID()))
+
+		_, err := client.Budgets.SetCycleStartAction(ctx, sdk.NewSetCycleStartActionBudgetRequest(
+			budgetId, *sdk.NewBudgetSetCycleStartActionArgsRequest(procedure.ID(), []string{"ARRAY_CONSTRUCT()"}),
+		))
+		require.NoError(t, err)
+
+		_, err = client.Budgets.RemoveCycleStartAction(ctx, sdk.NewRemoveCycleStartActionBudgetRequest(budgetId))
+		require.NoError(t, err)
+
+		_, err = client.Budgets.GetCycleStartAction(ctx, sdk.NewGetCycleStartActionBudgetRequest(budgetId))
+		require.ErrorIs(t, err, sql.ErrNoRows)
+	})
 }
@@ -0,0 +1,248 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_Contacts(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	email := "data-stewards@example.com"
+	url := "https://example.com/support"
+
+	createBasic := func(t *testing.T) sdk.SchemaObjectIdentifier {
+		t.Helper()
+		id, cleanup := testClientHelper().Contact.Create(t)
+		t.Cleanup(cleanup)
+
+		return id
+	}
+
+	t.Run("create: minimal", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.Contacts.Create(ctx, sdk.NewCreateContactRequest(id))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Contact.DropFunc(t, id))
+
+		assertThatObject(
+			t, objectassert.Contact(t, id).
+				HasCreatedOnNotEmpty().
+				HasName(id.Name()).
+				HasDatabaseName(id.DatabaseName()).
+				HasSchemaName(id.SchemaName()).
+				HasOwner(snowflakeroles.Accountadmin.Name()).
+				HasOwnerRoleType("ROLE").
+				HasComment("").
+				HasNoUsers().
+				HasNoEmailDistributionList().
+				HasNoUrl(),
+		)
+	})
+
+	t.Run("create: with users", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		currentUser := testClientHelper().Context.CurrentUser(t)
+		comment := random.Comment()
+
+		err := client.Contacts.Create(ctx, sdk.NewCreateContactRequest(id).
+			WithIfNotExists(true).
+			WithUsers([]sdk.ContactUser{{Name: currentUser.Name()}}).
+			WithComment(comment))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Contact.DropFunc(t, id))
+
+		assertThatObject(
+			t, objectassert.Contact(t, id).
+				HasName(id.Name()).
+				HasComment(comment).
+				HasUsers(currentUser.Name()).
+				HasNoEmailDistributionList().
+				HasNoUrl(),
+		)
+	})
+
+	t.Run("create: with email distribution list", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.Contacts.Create(ctx, sdk.NewCreateContactRequest(id).WithEmailDistributionList(email))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Contact.DropFunc(t, id))
+
+		assertThatObject(
+			t, objectassert.Contact(t, id).
+				HasEmailDistributionList(email).
+				HasNoUrl(),
+		)
+	})
+
+	t.Run("create: or replace with url", func(t *testing.T) {
+		id := createBasic(t)
+
+		err := client.Contacts.Create(ctx, sdk.NewCreateContactRequest(id).WithOrReplace(true).WithUrl(url))
+		require.NoError(t, err)
+
+		assertThatObject(
+			t, objectassert.Contact(t, id).
+				HasUrl(url).
+				HasNoEmailDistributionList(),
+		)
+	})
+
+	t.Run("alter: set and unset comment", func(t *testing.T) {
+		id := createBasic(t)
+		comment := random.Comment()
+
+		err := client.Contacts.Alter(ctx, sdk.NewAlterContactRequest(id).WithSet(*sdk.NewContactSetRequest().WithComment(comment)))
+		require.NoError(t, err)
+
+		assertThatObject(
+			t, objectassert.Contact(t, id).
+				HasComment(comment),
+		)
+
+		err = client.Contacts.Alter(ctx, sdk.NewAlterContactRequest(id).WithUnsetComment(true))
+		require.NoError(t, err)
+
+		assertThatObject(
+			t, objectassert.Contact(t, id).
+				HasComment(""),
+		)
+	})
+
+	t.Run("alter: change communication method", func(t *testing.T) {
+		id := createBasic(t)
+
+		err := client.Contacts.Alter(ctx, sdk.NewAlterContactRequest(id).WithSet(*sdk.NewContactSetRequest().WithEmailDistributionList(email)))
+		require.NoError(t, err)
+
+		assertThatObject(
+			t, objectassert.Contact(t, id).
+				HasEmailDistributionList(email).
+				HasNoUrl(),
+		)
+
+		err = client.Contacts.Alter(ctx, sdk.NewAlterContactRequest(id).WithSet(*sdk.NewContactSetRequest().WithUrl(url)))
+		require.NoError(t, err)
+
+		assertThatObject(
+			t, objectassert.Contact(t, id).
+				HasUrl(url).
+				HasNoEmailDistributionList(),
+		)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		id := createBasic(t)
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.Contacts.Alter(ctx, sdk.NewAlterContactRequest(id).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().Contact.DropFunc(t, newId))
+
+		_, err = client.Contacts.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		assertThatObject(
+			t, objectassert.Contact(t, newId).
+				HasName(newId.Name()),
+		)
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		id := createBasic(t)
+
+		err := client.Contacts.Drop(ctx, sdk.NewDropContactRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.Contacts.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop: if exists on non-existing", func(t *testing.T) {
+		err := client.Contacts.Drop(ctx, sdk.NewDropContactRequest(NonExistingSchemaObjectIdentifier).WithIfExists(true))
+		require.NoError(t, err)
+	})
+
+	t.Run("show: with like and in", func(t *testing.T) {
+		id1 := createBasic(t)
+		id2 := createBasic(t)
+
+		contacts, err := client.Contacts.Show(ctx, sdk.NewShowContactRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(id1.Name())}).
+			WithIn(sdk.In{Schema: id1.SchemaId()}))
+		require.NoError(t, err)
+		require.Len(t, contacts, 1)
+		assert.Equal(t, id1.Name(), contacts[0].Name)
+
+		contacts, err = client.Contacts.Show(ctx, sdk.NewShowContactRequest().
+			WithIn(sdk.In{Schema: id2.SchemaId()}))
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, len(contacts), 2)
+	})
+
+	t.Run("set and unset contacts on database and schema", func(t *testing.T) {
+		stewardId := createBasic(t)
+		supportId := createBasic(t)
+
+		database, databaseCleanup := testClientHelper().Database.CreateDatabase(t)
+		t.Cleanup(databaseCleanup)
+		schema, schemaCleanup := testClientHelper().Schema.CreateSchemaInDatabase(t, database.ID())
+		t.Cleanup(schemaCleanup)
+
+		err := client.Contacts.SetOnObject(ctx, sdk.NewSetOnObjectContactRequest(sdk.ObjectTypeDatabase, database.ID(), *sdk.NewContactsOnObjectSetRequest().
+			WithSteward(stewardId).
+			WithSupport(supportId)))
+		require.NoError(t, err)
+
+		references, err := client.Contacts.GetForObject(ctx, sdk.ObjectTypeDatabase, database.ID())
+		require.NoError(t, err)
+		require.Len(t, references, 2)
+		assert.ElementsMatch(t, []sdk.ContactReference{
+			{Purpose: sdk.ContactPurposeSteward, Contact: stewardId, Inherited: false},
+			{Purpose: sdk.ContactPurposeSupport, Contact: supportId, Inherited: false},
+		}, references)
+
+		references, err = client.Contacts.GetForObject(ctx, sdk.ObjectTypeSchema, schema.ID())
+		require.NoError(t, err)
+		for _, reference := range references {
+			assert.True(t, reference.Inherited)
+		}
+
+		err = client.Contacts.SetOnObject(ctx, sdk.NewSetOnObjectContactRequest(sdk.ObjectTypeSchema, schema.ID(), *sdk.NewContactsOnObjectSetRequest().
+			WithSteward(supportId)))
+		require.NoError(t, err)
+
+		references, err = client.Contacts.GetForObject(ctx, sdk.ObjectTypeSchema, schema.ID())
+		require.NoError(t, err)
+		direct := collections.Filter(references, func(r sdk.ContactReference) bool { return !r.Inherited })
+		require.Len(t, direct, 1)
+		assert.Equal(t, sdk.ContactPurposeSteward, direct[0].Purpose)
+		assert.Equal(t, supportId.FullyQualifiedName(), direct[0].Contact.FullyQualifiedName())
+
+		err = client.Contacts.UnsetOnObject(ctx, sdk.NewUnsetOnObjectContactRequest(sdk.ObjectTypeDatabase, database.ID(), *sdk.NewContactsOnObjectUnsetRequest().
+			WithSteward(true).
+			WithSupport(true)))
+		require.NoError(t, err)
+
+		references, err = client.Contacts.GetForObject(ctx, sdk.ObjectTypeDatabase, database.ID())
+		require.NoError(t, err)
+		assert.Empty(t, references)
+	})
+
+	t.Run("get for object: unsupported object type", func(t *testing.T) {
+		_, err := client.Contacts.GetForObject(ctx, sdk.ObjectTypeWarehouse, testClientHelper().Ids.WarehouseId())
+		require.ErrorContains(t, err, "contacts are not supported for object type WAREHOUSE")
+	})
+}
@@ -50,4 +50,52 @@ func TestInt_DataMetricFunctionReferences(t *testing.T) {
 		assert.Equal(t, "*/5 * * * * UTC", dmf.Schedule)
 		assert.Equal(t, string(sdk.DataMetricScheduleStatusStarted), dmf.ScheduleStatus)
 	})
+	t.Run("table domain with alter on object", func(t *testing.T) {
+		functionId := sdk.NewSchemaObjectIdentifier("SNOWFLAKE", "CORE", "NULL_COUNT")
+		table, tableCleanup := testClientHelper().Table.CreateWithColumns(t, []sdk.TableColumnRequest{
+			*sdk.NewTableColumnRequest("ID", sdk.DataTypeNumber),
+		})
+		t.Cleanup(tableCleanup)
+		dataMetricFunction := sdk.ViewDataMetricFunction{
+			DataMetricFunction: functionId,
+			On:                 []sdk.Column{{Value: "ID"}},
+		}
+
+		err := client.DataMetricFunctionReferences.AlterOnObject(ctx, sdk.NewAlterOnObjectDataMetricFunctionReferenceRequest(sdk.ObjectTypeTable, table.ID()).
+			WithSetSchedule(*sdk.NewDataMetricFunctionOnObjectSetScheduleRequest("TRIGGER_ON_CHANGES")))
+		require.NoError(t, err)
+		err = client.DataMetricFunctionReferences.AlterOnObject(ctx, sdk.NewAlterOnObjectDataMetricFunctionReferenceRequest(sdk.ObjectTypeTable, table.ID()).
+			WithAdd(*sdk.NewDataMetricFunctionOnObjectAddRequest([]sdk.ViewDataMetricFunction{dataMetricFunction})))
+		require.NoError(t, err)
+		err = client.DataMetricFunctionReferences.AlterOnObject(ctx, sdk.NewAlterOnObjectDataMetricFunctionReferenceRequest(sdk.ObjectTypeTable, table.ID()).
+			WithModify(*sdk.NewDataMetricFunctionOnObjectModifyRequest([]sdk.ViewModifyDataMetricFunction{{
+				DataMetricFunction: functionId,
+				On:                 []sdk.Column{{Value: "ID"}},
+				ViewDataMetricScheduleStatusOperationOption: sdk.ViewDataMetricScheduleStatusOperationOptionSuspend,
+			}})))
+		require.NoError(t, err)
+
+		dmfs, err := client.DataMetricFunctionReferences.GetForEntity(ctx, sdk.NewGetForEntityDataMetricFunctionReferenceRequestCustom(table.ID(), sdk.DataMetricFunctionRefEntityDomainOptionTable))
+		require.NoError(t, err)
+		require.Len(t, dmfs, 1)
+		dmf := dmfs[0]
+		assert.Equal(t, string(sdk.DataMetricFunctionRefEntityDomainOptionTable), strings.ToUpper(dmf.RefEntityDomain))
+		assert.Equal(t, functionId.Name(), dmf.MetricName)
+		assert.Equal(t, table.ID().Name(), dmf.RefEntityName)
+		require.Len(t, dmf.RefArguments, 1)
+		assert.Equal(t, "ID", dmf.RefArguments[0].Name)
+		assert.Equal(t, "TRIGGER_ON_CHANGES", dmf.Schedule)
+		assert.Equal(t, string(sdk.DataMetricScheduleStatusSuspended), dmf.ScheduleStatus)
+
+		err = client.DataMetricFunctionReferences.AlterOnObject(ctx, sdk.NewAlterOnObjectDataMetricFunctionReferenceRequest(sdk.ObjectTypeTable, table.ID()).
+			WithDrop(*sdk.NewDataMetricFunctionOnObjectDropRequest([]sdk.ViewDataMetricFunction{dataMetricFunction})))
+		require.NoError(t, err)
+		err = client.DataMetricFunctionReferences.AlterOnObject(ctx, sdk.NewAlterOnObjectDataMetricFunctionReferenceRequest(sdk.ObjectTypeTable, table.ID()).
+			WithUnsetSchedule(true))
+		require.NoError(t, err)
+
+		dmfs, err = client.DataMetricFunctionReferences.GetForEntity(ctx, sdk.NewGetForEntityDataMetricFunctionReferenceRequestCustom(table.ID(), sdk.DataMetricFunctionRefEntityDomainOptionTable))
+		require.NoError(t, err)
+		assert.Empty(t, dmfs)
+	})
 }
@@ -110,8 +110,30 @@ func TestInt_EventTables(t *testing.T) {
 
 		details, err := client.EventTables.Describe(ctx, dt.ID())
 		require.NoError(t, err)
-		assert.Equal(t, "TIMESTAMP", details.Name)
-		assert.NotEmpty(t, details.Kind)
+		require.NotEmpty(t, details)
+		assert.Equal(t, "TIMESTAMP", details[0].Name)
+		assert.NotEmpty(t, details[0].Type)
+		assert.NotEmpty(t, details[0].Kind)
+
+		names := collections.Map(details, func(d sdk.EventTableDetails) string { return d.Name })
+		assert.Contains(t, names, "RECORD_ATTRIBUTES")
+	})
+
+	t.Run("show parameters", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		err := client.EventTables.Create(ctx, sdk.NewCreateEventTableRequest(id).WithDataRetentionTimeInDays(1))
+		require.NoError(t, err)
+		t.Cleanup(cleanupTableHandle(t, id))
+
+		parameters, err := client.EventTables.ShowParameters(ctx, id)
+		require.NoError(t, err)
+
+		parameter, err := collections.FindFirst(parameters, func(p *sdk.Parameter) bool {
+			return p.Key == string(sdk.ObjectParameterDataRetentionTimeInDays)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "1", (*parameter).Value)
+		assert.Equal(t, sdk.ParameterTypeTable, (*parameter).Level)
 	})
 
 	t.Run("alter event table: set and unset comment", func(t *testing.T) {
@@ -2831,3 +2831,101 @@ func grantsToPrivileges(grants []sdk.Grant) []string {
 	}
 	return privileges
 }
+
+func TestInt_GrantAndRevokeCaller(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	schemaId := testClientHelper().Ids.SchemaId()
+
+	t.Run("on table", func(t *testing.T) {
+		role, roleCleanup := testClientHelper().Role.CreateRole(t)
+		t.Cleanup(roleCleanup)
+		table, tableCleanup := testClientHelper().Table.Create(t)
+		t.Cleanup(tableCleanup)
+
+		privileges := sdk.CallerGrantPrivileges{
+			Privileges: []sdk.ObjectPrivilege{sdk.ObjectPrivilegeSelect},
+		}
+		on := sdk.Object{ObjectType: sdk.ObjectTypeTable, Name: table.ID()}
+		to := sdk.CallerGrantTo{AccountRole: new(role.ID())}
+
+		err := client.Grants.GrantCaller(ctx, privileges, on, to)
+		require.NoError(t, err)
+
+		grants, err := client.Grants.ShowCallerGrants(ctx, &sdk.ShowCallerGrantOptions{To: &to})
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+		assert.Equal(t, sdk.ObjectPrivilegeSelect.String(), grants[0].Privilege)
+		assert.Equal(t, sdk.ObjectTypeTable, grants[0].GrantedOn)
+		assert.Equal(t, sdk.ObjectTypeRole, grants[0].GrantedTo)
+		assert.Equal(t, role.ID().Name(), grants[0].GranteeName.Name())
+
+		grants, err = client.Grants.ShowCallerGrants(ctx, &sdk.ShowCallerGrantOptions{On: &sdk.ShowCallerGrantsOn{Object: &on}})
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+		assert.Equal(t, role.ID().Name(), grants[0].GranteeName.Name())
+
+		err = client.Grants.RevokeCaller(ctx, privileges, on, to)
+		require.NoError(t, err)
+
+		grants, err = client.Grants.ShowCallerGrants(ctx, &sdk.ShowCallerGrantOptions{To: &to})
+		require.NoError(t, err)
+		assert.Empty(t, grants)
+	})
+
+	t.Run("all caller privileges on table to database role", func(t *testing.T) {
+		databaseRole, databaseRoleCleanup := testClientHelper().DatabaseRole.CreateDatabaseRole(t)
+		t.Cleanup(databaseRoleCleanup)
+		table, tableCleanup := testClientHelper().Table.Create(t)
+		t.Cleanup(tableCleanup)
+
+		privileges := sdk.CallerGrantPrivileges{AllPrivileges: new(true)}
+		on := sdk.Object{ObjectType: sdk.ObjectTypeTable, Name: table.ID()}
+		to := sdk.CallerGrantTo{DatabaseRole: new(databaseRole.ID())}
+
+		err := client.Grants.GrantCaller(ctx, privileges, on, to)
+		require.NoError(t, err)
+
+		grants, err := client.Grants.ShowCallerGrants(ctx, &sdk.ShowCallerGrantOptions{To: &to})
+		require.NoError(t, err)
+		require.NotEmpty(t, grants)
+		assert.Equal(t, sdk.ObjectTypeDatabaseRole, grants[0].GrantedTo)
+		assert.Equal(t, databaseRole.ID().FullyQualifiedName(), grants[0].GranteeName.FullyQualifiedName())
+
+		err = client.Grants.RevokeCallerSafely(ctx, privileges, on, to)
+		require.NoError(t, err)
+
+		grants, err = client.Grants.ShowCallerGrants(ctx, &sdk.ShowCallerGrantOptions{To: &to})
+		require.NoError(t, err)
+		assert.Empty(t, grants)
+	})
+
+	t.Run("inherited on all tables in schema", func(t *testing.T) {
+		role, roleCleanup := testClientHelper().Role.CreateRole(t)
+		t.Cleanup(roleCleanup)
+
+		privileges := sdk.CallerGrantPrivileges{
+			Privileges: []sdk.ObjectPrivilege{sdk.ObjectPrivilegeSelect},
+		}
+		in := sdk.InheritedCallerGrantIn{Schema: new(schemaId)}
+		to := sdk.CallerGrantTo{AccountRole: new(role.ID())}
+
+		err := client.Grants.GrantInheritedCaller(ctx, privileges, sdk.PluralObjectTypeTables, in, to)
+		require.NoError(t, err)
+
+		grants, err := client.Grants.ShowCallerGrants(ctx, &sdk.ShowCallerGrantOptions{To: &to})
+		require.NoError(t, err)
+		require.Len(t, grants, 1)
+		assert.Equal(t, sdk.ObjectPrivilegeSelect.String(), grants[0].Privilege)
+		assert.Equal(t, sdk.ObjectTypeTable, grants[0].GrantedOn)
+		assert.Equal(t, role.ID().Name(), grants[0].GranteeName.Name())
+
+		err = client.Grants.RevokeInheritedCaller(ctx, privileges, sdk.PluralObjectTypeTables, in, to)
+		require.NoError(t, err)
+
+		grants, err = client.Grants.ShowCallerGrants(ctx, &sdk.ShowCallerGrantOptions{To: &to})
+		require.NoError(t, err)
+		assert.Empty(t, grants)
+	})
+}
@@ -67,3 +67,37 @@ func TestInt_PolicyReferences(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestInt_PolicyReferencesGetForPolicy(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	passwordPolicy, passwordPolicyCleanup := testClientHelper().PasswordPolicy.CreatePasswordPolicy(t)
+	t.Cleanup(passwordPolicyCleanup)
+
+	t.Run("no references", func(t *testing.T) {
+		policyReferences, err := client.PolicyReferences.GetForPolicy(ctx, sdk.NewGetForPolicyPolicyReferenceRequest(passwordPolicy.ID()))
+		require.NoError(t, err)
+		require.Empty(t, policyReferences)
+	})
+
+	t.Run("user reference", func(t *testing.T) {
+		user, userCleanup := testClientHelper().User.CreateUser(t)
+		t.Cleanup(userCleanup)
+
+		err := client.Users.Alter(ctx, sdk.NewAlterUserRequest(user.ID()).WithSet(*sdk.NewUserSetRequest().WithPasswordPolicy(passwordPolicy.ID())))
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := client.Users.Alter(ctx, sdk.NewAlterUserRequest(user.ID()).WithUnset(*sdk.NewUserUnsetRequest().WithPasswordPolicy(true)))
+			require.NoError(t, err)
+		})
+
+		policyReferences, err := client.PolicyReferences.GetForPolicy(ctx, sdk.NewGetForPolicyPolicyReferenceRequest(passwordPolicy.ID()))
+		require.NoError(t, err)
+		require.Len(t, policyReferences, 1)
+		require.Equal(t, passwordPolicy.ID().Name(), policyReferences[0].PolicyName)
+		require.Equal(t, sdk.PolicyKindPasswordPolicy, policyReferences[0].PolicyKind)
+		require.Equal(t, user.ID().Name(), policyReferences[0].RefEntityName)
+		require.Equal(t, string(sdk.PolicyEntityDomainUser), policyReferences[0].RefEntityDomain)
+	})
+}
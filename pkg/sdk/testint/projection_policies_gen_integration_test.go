@@ -0,0 +1,173 @@
+//go:build non_account_level_tests
+
+package testint
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/collections"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt_ProjectionPolicies(t *testing.T) {
+	client := testClient(t)
+	ctx := testContext(t)
+
+	body := "PROJECTION_CONSTRAINT(ALLOW => false)"
+	newBody := "PROJECTION_CONSTRAINT(ALLOW => true)"
+
+	createBasic := func(t *testing.T) sdk.SchemaObjectIdentifier {
+		t.Helper()
+		id, cleanup := testClientHelper().ProjectionPolicy.CreateProjectionPolicy(t)
+		t.Cleanup(cleanup)
+
+		return id
+	}
+
+	assertDetails := func(t *testing.T, id sdk.SchemaObjectIdentifier, expectedBody string) {
+		t.Helper()
+		details, err := client.ProjectionPolicies.Describe(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, id.Name(), details.Name)
+		assert.Equal(t, "()", details.Signature)
+		assert.Equal(t, "PROJECTION_CONSTRAINT", details.ReturnType)
+		assert.Equal(t, expectedBody, details.Body)
+	}
+
+	t.Run("create: minimal", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.ProjectionPolicies.Create(ctx, sdk.NewCreateProjectionPolicyRequest(id, body))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ProjectionPolicy.DropProjectionPolicyFunc(t, id))
+
+		assertThatObject(
+			t, objectassert.ProjectionPolicy(t, id).
+				HasCreatedOnNotEmpty().
+				HasName(id.Name()).
+				HasDatabaseName(id.DatabaseName()).
+				HasSchemaName(id.SchemaName()).
+				HasKind("PROJECTION_POLICY").
+				HasOwner(snowflakeroles.Accountadmin.Name()).
+				HasOwnerRoleType("ROLE").
+				HasComment(""),
+		)
+		assertDetails(t, id, body)
+	})
+
+	t.Run("create: complete", func(t *testing.T) {
+		id := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+		comment := random.Comment()
+
+		err := client.ProjectionPolicies.Create(ctx, sdk.NewCreateProjectionPolicyRequest(id, body).
+			WithIfNotExists(true).
+			WithComment(comment))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ProjectionPolicy.DropProjectionPolicyFunc(t, id))
+
+		assertThatObject(
+			t, objectassert.ProjectionPolicy(t, id).
+				HasName(id.Name()).
+				HasComment(comment),
+		)
+		assertDetails(t, id, body)
+	})
+
+	t.Run("create: or replace", func(t *testing.T) {
+		id := createBasic(t)
+
+		err := client.ProjectionPolicies.Create(ctx, sdk.NewCreateProjectionPolicyRequest(id, newBody).WithOrReplace(true))
+		require.NoError(t, err)
+
+		assertDetails(t, id, newBody)
+	})
+
+	t.Run("alter: rename", func(t *testing.T) {
+		id := createBasic(t)
+		newId := testClientHelper().Ids.RandomSchemaObjectIdentifier()
+
+		err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithRenameTo(newId))
+		require.NoError(t, err)
+		t.Cleanup(testClientHelper().ProjectionPolicy.DropProjectionPolicyFunc(t, newId))
+
+		_, err = client.ProjectionPolicies.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+
+		assertThatObject(
+			t, objectassert.ProjectionPolicy(t, newId).
+				HasName(newId.Name()),
+		)
+	})
+
+	t.Run("alter: set body", func(t *testing.T) {
+		id := createBasic(t)
+
+		err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithSetBody(newBody))
+		require.NoError(t, err)
+
+		assertDetails(t, id, newBody)
+	})
+
+	t.Run("alter: set and unset comment", func(t *testing.T) {
+		id := createBasic(t)
+		comment := random.Comment()
+
+		err := client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithSetComment(comment))
+		require.NoError(t, err)
+
+		assertThatObject(
+			t, objectassert.ProjectionPolicy(t, id).
+				HasComment(comment),
+		)
+
+		err = client.ProjectionPolicies.Alter(ctx, sdk.NewAlterProjectionPolicyRequest(id).WithUnsetComment(true))
+		require.NoError(t, err)
+
+		assertThatObject(
+			t, objectassert.ProjectionPolicy(t, id).
+				HasComment(""),
+		)
+	})
+
+	t.Run("drop: existing", func(t *testing.T) {
+		id := createBasic(t)
+
+		err := client.ProjectionPolicies.Drop(ctx, sdk.NewDropProjectionPolicyRequest(id))
+		require.NoError(t, err)
+
+		_, err = client.ProjectionPolicies.ShowByID(ctx, id)
+		require.ErrorIs(t, err, collections.ErrObjectNotFound)
+	})
+
+	t.Run("drop: if exists on non-existing", func(t *testing.T) {
+		err := client.ProjectionPolicies.Drop(ctx, sdk.NewDropProjectionPolicyRequest(NonExistingSchemaObjectIdentifier).WithIfExists(true))
+		require.NoError(t, err)
+	})
+
+	t.Run("show: with like and in", func(t *testing.T) {
+		id1 := createBasic(t)
+		id2 := createBasic(t)
+
+		projectionPolicies, err := client.ProjectionPolicies.Show(ctx, sdk.NewShowProjectionPolicyRequest().
+			WithLike(sdk.Like{Pattern: sdk.String(id1.Name())}).
+			WithIn(sdk.In{Schema: id1.SchemaId()}))
+		require.NoError(t, err)
+		require.Len(t, projectionPolicies, 1)
+		assert.Equal(t, id1.Name(), projectionPolicies[0].Name)
+
+		projectionPolicies, err = client.ProjectionPolicies.Show(ctx, sdk.NewShowProjectionPolicyRequest().
+			WithIn(sdk.In{Schema: id2.SchemaId()}))
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, len(projectionPolicies), 2)
+	})
+
+	t.Run("describe: non-existing", func(t *testing.T) {
+		_, err := client.ProjectionPolicies.Describe(ctx, NonExistingSchemaObjectIdentifier)
+		require.ErrorIs(t, err, sdk.ErrObjectNotExistOrAuthorized)
+	})
+}
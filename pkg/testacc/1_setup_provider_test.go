@@ -7,6 +7,7 @@ import (
 	internalprovider "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/frameworkprovider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/oswrapper"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 )
 
 type ProviderFactory = map[string]func() (tfprotov6.ProviderServer, error)
@@ -71,6 +73,8 @@ var (
 	activeWarehouseSetOnUserProviderFactory                    = providerFactoryUsingCache("ActiveWarehouseSetOnUser")
 	inheritedGrantsProviderFactory                             = providerFactoryUsingCache("InheritedGrantsProvider")
 	strictPrivilegeManagementAndInheritedGrantsProviderFactory = providerFactoryUsingCache("StrictPrivilegeManagementAndInheritedGrantsProvider")
+	defaultTagsProviderFactory                                 = providerFactoryUsingCache("DefaultTags")
+	pluginFrameworkProviderFactory                             = providerFactoryWithPluginFrameworkUsingCache("PluginFramework")
 )
 
 // TODO [SNOW-2661409]: secondary account can have also a different configuration, so for now we need to be careful; let's add some hash check for the config or something else to mitigate
@@ -108,6 +112,20 @@ func providerFactoryUsingCacheReturningProvider(key string) (map[string]func() (
 	}, p
 }
 
+// providerFactoryWithPluginFrameworkUsingCache returns the SDKv2 provider muxed with the plugin framework provider (like in the released provider binary).
+// It is needed for the features served only by the plugin framework provider, like the ephemeral resources.
+// The echo provider is added to expose the ephemeral values in the state for the assertions.
+func providerFactoryWithPluginFrameworkUsingCache(key string) map[string]func() (tfprotov6.ProviderServer, error) {
+	_, p := providerFactoryUsingCacheReturningProvider(key)
+
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"snowflake": func() (tfprotov6.ProviderServer, error) {
+			return frameworkprovider.NewMuxServer(context.Background(), "dev", p)
+		},
+		"echo": echoprovider.NewProviderServer(),
+	}
+}
+
 // TODO [SNOW-2661409]: check which of the usages wants to really be without cache and which could utilize a dedicated cache entry
 func providerFactoryWithoutCache() map[string]func() (tfprotov6.ProviderServer, error) {
 	factory, _ := providerFactoryWithoutCacheReturningProvider()
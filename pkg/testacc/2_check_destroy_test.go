@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -12,6 +13,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/provider"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -175,6 +177,9 @@ var showByIdFunctions = map[resources.Resource]runShowByIdFunc{
 	resources.AccountRole: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Roles.ShowByID)
 	},
+	resources.AggregationPolicy: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.AggregationPolicies.ShowByID)
+	},
 	resources.Alert: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Alerts.ShowByID)
 	},
@@ -217,9 +222,24 @@ var showByIdFunctions = map[resources.Resource]runShowByIdFunc{
 	resources.ApiIntegrationGoogleCloudApiGateway: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.ApiIntegrations.ShowByID)
 	},
+	resources.Application: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.Applications.ShowByID)
+	},
+	resources.ApplicationPackage: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.ApplicationPackages.ShowByID)
+	},
 	resources.AuthenticationPolicy: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.AuthenticationPolicies.ShowByID)
 	},
+	resources.BackupPolicy: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.BackupPolicies.ShowByID)
+	},
+	resources.BackupSet: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.BackupSets.ShowByID)
+	},
+	resources.Budget: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.Budgets.ShowByID)
+	},
 	resources.CatalogIntegrationAwsGlue: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.CatalogIntegrations.ShowByID)
 	},
@@ -238,6 +258,9 @@ var showByIdFunctions = map[resources.Resource]runShowByIdFunc{
 	resources.ComputePool: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.ComputePools.ShowByID)
 	},
+	resources.Contact: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.Contacts.ShowByID)
+	},
 	resources.CortexAgent: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.CortexAgents.ShowByID)
 	},
@@ -256,6 +279,9 @@ var showByIdFunctions = map[resources.Resource]runShowByIdFunc{
 	resources.EmailNotificationIntegration: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.NotificationIntegrations.ShowByID)
 	},
+	resources.EventTable: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.EventTables.ShowByID)
+	},
 	resources.ExternalAccessIntegration: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.ExternalAccessIntegrations.ShowByID)
 	},
@@ -334,6 +360,9 @@ var showByIdFunctions = map[resources.Resource]runShowByIdFunc{
 	resources.JobService: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Services.ShowByID)
 	},
+	resources.JoinPolicy: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.JoinPolicies.ShowByID)
+	},
 	resources.LegacyServiceUser: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Users.ShowByID)
 	},
@@ -388,6 +417,15 @@ var showByIdFunctions = map[resources.Resource]runShowByIdFunc{
 	resources.OauthIntegrationForPartnerApplications: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.SecurityIntegrations.ShowByID)
 	},
+	resources.OpenflowConnector: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.OpenflowConnectors.ShowByID)
+	},
+	resources.OpenflowDeployment: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.OpenflowDeployments.ShowByID)
+	},
+	resources.OpenflowRuntime: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.OpenflowRuntimes.ShowByID)
+	},
 	resources.PasswordPolicy: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.PasswordPolicies.ShowByID)
 	},
@@ -415,6 +453,9 @@ var showByIdFunctions = map[resources.Resource]runShowByIdFunc{
 	resources.ProcedureSql: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.Procedures.ShowByID)
 	},
+	resources.ProjectionPolicy: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
+		return runShowById(ctx, id, client.ProjectionPolicies.ShowByID)
+	},
 	resources.ResourceMonitor: func(ctx context.Context, client *sdk.Client, id sdk.ObjectIdentifier) error {
 		return runShowById(ctx, id, client.ResourceMonitors.ShowByID)
 	},
@@ -667,6 +708,147 @@ func CheckDatabaseRolePrivilegesRevoked(t *testing.T) func(*terraform.State) err
 	}
 }
 
+// CheckApplicationRolePrivilegesRevoked is a custom check that should be later incorporated into generic CheckDestroy
+func CheckApplicationRolePrivilegesRevoked(t *testing.T) func(*terraform.State) error {
+	t.Helper()
+
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "snowflake_grant_privileges_to_application_role" {
+				continue
+			}
+
+			id, err := r.ParseGrantPrivilegesToApplicationRoleId(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			grants, err := testClient().Grant.ShowGrantsToApplicationRole(t, id.ApplicationRoleName)
+			if err != nil {
+				if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+					continue
+				}
+				return err
+			}
+			var grantedPrivileges []string
+			for _, grant := range grants {
+				// only privileges managed by the resource are checked, as the application grants its own privileges in the setup script
+				if slices.Contains(id.Privileges, grant.Privilege) {
+					grantedPrivileges = append(grantedPrivileges, grant.Privilege)
+				}
+			}
+			if len(grantedPrivileges) > 0 {
+				return fmt.Errorf("application role (%s) is still granted, granted privileges %v", id.ApplicationRoleName.FullyQualifiedName(), grantedPrivileges)
+			}
+		}
+		return nil
+	}
+}
+
+// CheckCallerPrivilegesRevoked is a custom checks that should be later incorporated into generic CheckDestroy
+func CheckCallerPrivilegesRevoked(t *testing.T) func(*terraform.State) error {
+	t.Helper()
+
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "snowflake_grant_caller_privileges" {
+				continue
+			}
+
+			id, err := r.ParseGrantCallerPrivilegesId(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			if id.TargetRoleKind != r.AccountRoleCallerGrantTargetRoleKind {
+				continue
+			}
+			grants, err := testClient().Grant.ShowCallerGrantsToAccountRole(t, id.AccountRoleName)
+			if err != nil {
+				if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+					continue
+				}
+				return err
+			}
+			var grantedPrivileges []string
+			for _, grant := range grants {
+				grantedPrivileges = append(grantedPrivileges, grant.Privilege)
+			}
+			if len(grantedPrivileges) > 0 {
+				return fmt.Errorf("account role (%s) is still granted caller privileges %v", id.AccountRoleName.FullyQualifiedName(), grantedPrivileges)
+			}
+		}
+		return nil
+	}
+}
+
+// CheckAccountRoleMembershipRevoked is a custom checks that should be later incorporated into generic CheckDestroy
+func CheckAccountRoleMembershipRevoked(t *testing.T) func(*terraform.State) error {
+	t.Helper()
+
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "snowflake_account_role_membership" {
+				continue
+			}
+
+			id, err := sdk.ParseAccountObjectIdentifier(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			grants, err := testClient().Grant.ShowGrantsOfAccountRole(t, id)
+			if err != nil {
+				if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+					continue
+				}
+				return err
+			}
+			var grantees []string
+			for _, grant := range grants {
+				grantees = append(grantees, grant.GranteeName.FullyQualifiedName())
+			}
+			if len(grantees) > 0 {
+				return fmt.Errorf("account role (%s) is still granted to %v", id.FullyQualifiedName(), grantees)
+			}
+		}
+		return nil
+	}
+}
+
+// CheckGrantPrivilegesMatrixRevoked is a custom checks that should be later incorporated into generic CheckDestroy
+func CheckGrantPrivilegesMatrixRevoked(t *testing.T) func(*terraform.State) error {
+	t.Helper()
+
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "snowflake_grant_privileges_matrix" {
+				continue
+			}
+
+			entriesCount, err := strconv.Atoi(rs.Primary.Attributes["entry.#"])
+			if err != nil {
+				return err
+			}
+			for i := range entriesCount {
+				id := sdk.NewAccountObjectIdentifierFromFullyQualifiedName(rs.Primary.Attributes[fmt.Sprintf("entry.%d.account_role_name", i)])
+				grants, err := testClient().Grant.ShowGrantsToAccountRole(t, id)
+				if err != nil {
+					if errors.Is(err, sdk.ErrObjectNotExistOrAuthorized) {
+						continue
+					}
+					return err
+				}
+				var grantedPrivileges []string
+				for _, grant := range grants {
+					grantedPrivileges = append(grantedPrivileges, grant.Privilege)
+				}
+				if len(grantedPrivileges) > 0 {
+					return fmt.Errorf("account role (%s) is still granted privileges %v", id.FullyQualifiedName(), grantedPrivileges)
+				}
+			}
+		}
+		return nil
+	}
+}
+
 // CheckSharePrivilegesRevoked is a custom checks that should be later incorporated into generic CheckDestroy
 func CheckSharePrivilegesRevoked(t *testing.T) func(*terraform.State) error {
 	t.Helper()
@@ -738,6 +920,106 @@ func CheckTableStorageLifecyclePolicyAttachmentDestroy(t *testing.T) func(*terra
 	}, sdk.PolicyEntityDomainTable, sdk.PolicyKindStorageLifecyclePolicy)
 }
 
+// CheckBudgetTrackedObjectDestroy is a custom check that should be later incorporated into generic CheckDestroy
+func CheckBudgetTrackedObjectDestroy(t *testing.T) func(*terraform.State) error {
+	t.Helper()
+
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resources.BudgetTrackedObject.String() {
+				continue
+			}
+			budgetId, err := sdk.ParseSchemaObjectIdentifier(rs.Primary.Attributes["budget_name"])
+			if err != nil {
+				return err
+			}
+			linkedResources, err := testClient().Budget.GetLinkedResources(t, budgetId)
+			if err != nil {
+				// the budget was dropped together with its linked resources
+				continue
+			}
+			for _, linkedResource := range linkedResources {
+				objectId, err := linkedResource.ObjectId()
+				if err != nil {
+					continue
+				}
+				if objectId.FullyQualifiedName() == rs.Primary.Attributes["object_name"] {
+					return fmt.Errorf("object %s is still tracked by budget %s", objectId.FullyQualifiedName(), budgetId.FullyQualifiedName())
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func CheckDataMetricFunctionAttachmentDestroy(t *testing.T) func(*terraform.State) error {
+	t.Helper()
+
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resources.DataMetricFunctionAttachment.String() {
+				continue
+			}
+			objectType, err := sdk.ToDataMetricFunctionAttachableObjectType(rs.Primary.Attributes["object_type"])
+			if err != nil {
+				return err
+			}
+			objectId, err := sdk.ParseSchemaObjectIdentifier(rs.Primary.Attributes["object_name"])
+			if err != nil {
+				return err
+			}
+			domain, err := sdk.DataMetricFunctionRefEntityDomainForObjectType(objectType)
+			if err != nil {
+				return err
+			}
+			references, err := atc.defaultTestEnv.client.DataMetricFunctionReferences.GetForEntity(context.Background(), sdk.NewGetForEntityDataMetricFunctionReferenceRequestCustom(objectId, domain))
+			if err != nil {
+				// the object was dropped together with its data metric functions
+				continue
+			}
+			for _, reference := range references {
+				functionId := sdk.NewSchemaObjectIdentifier(reference.MetricDatabaseName, reference.MetricSchemaName, reference.MetricName)
+				if functionId.FullyQualifiedName() == rs.Primary.Attributes["data_metric_function"] {
+					return fmt.Errorf("data metric function %s is still attached to %s %s", functionId.FullyQualifiedName(), objectType, objectId.FullyQualifiedName())
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// CheckObjectContactsDestroy is a custom check that should be later incorporated into generic CheckDestroy
+func CheckObjectContactsDestroy(t *testing.T) func(*terraform.State) error {
+	t.Helper()
+
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resources.ObjectContacts.String() {
+				continue
+			}
+			objectType, err := sdk.ToContactAttachableObjectType(rs.Primary.Attributes["object_type"])
+			if err != nil {
+				return err
+			}
+			objectId, err := sdk.ParseObjectIdentifierString(rs.Primary.Attributes["object_name"])
+			if err != nil {
+				return err
+			}
+			references, err := atc.defaultTestEnv.client.Contacts.GetForObject(context.Background(), objectType, objectId)
+			if err != nil {
+				// the object was dropped together with its contacts
+				continue
+			}
+			for _, reference := range references {
+				if !reference.Inherited {
+					return fmt.Errorf("contact %s is still set on %s %s for purpose %s", reference.Contact.FullyQualifiedName(), objectType, objectId.FullyQualifiedName(), reference.Purpose)
+				}
+			}
+		}
+		return nil
+	}
+}
+
 // CheckResourceTagUnset is a custom check that should be later incorporated into generic CheckDestroy
 func CheckResourceTagUnset(t *testing.T) func(*terraform.State) error {
 	t.Helper()
@@ -0,0 +1,145 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_AggregationPolicies_BasicUseCase(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+	body := "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)"
+
+	policyModel := model.AggregationPolicy("test", id.DatabaseName(), id.SchemaName(), id.Name(), body).
+		WithComment(comment)
+
+	aggregationPoliciesModel := datasourcemodel.AggregationPolicies("test").
+		WithLike(id.Name()).
+		WithInDatabase(id.DatabaseId()).
+		WithDependsOn(policyModel.ResourceReference())
+
+	aggregationPoliciesModelWithoutDescribe := datasourcemodel.AggregationPolicies("test").
+		WithWithDescribe(false).
+		WithLike(id.Name()).
+		WithInDatabase(id.DatabaseId()).
+		WithDependsOn(policyModel.ResourceReference())
+
+	showOutputAssertions := resourceshowoutputassert.AggregationPoliciesDatasourceShowOutput(t, aggregationPoliciesModel.DatasourceReference()).
+		HasCreatedOnNotEmpty().
+		HasName(id.Name()).
+		HasDatabaseName(id.DatabaseName()).
+		HasSchemaName(id.SchemaName()).
+		HasKind("AGGREGATION_POLICY").
+		HasOwner(snowflakeroles.Accountadmin.Name()).
+		HasOwnerRoleType("ROLE").
+		HasComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, policyModel, aggregationPoliciesModel),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(aggregationPoliciesModel.DatasourceReference(), "aggregation_policies.#", "1")),
+					showOutputAssertions,
+					assert.Check(resource.TestCheckResourceAttr(aggregationPoliciesModel.DatasourceReference(), "aggregation_policies.0.describe_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(aggregationPoliciesModel.DatasourceReference(), "aggregation_policies.0.describe_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(aggregationPoliciesModel.DatasourceReference(), "aggregation_policies.0.describe_output.0.body", body)),
+					assert.Check(resource.TestCheckResourceAttr(aggregationPoliciesModel.DatasourceReference(), "aggregation_policies.0.describe_output.0.return_type", "AGGREGATION_CONSTRAINT")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, policyModel, aggregationPoliciesModelWithoutDescribe),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(aggregationPoliciesModelWithoutDescribe.DatasourceReference(), "aggregation_policies.#", "1")),
+					showOutputAssertions,
+					assert.Check(resource.TestCheckResourceAttr(aggregationPoliciesModelWithoutDescribe.DatasourceReference(), "aggregation_policies.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_AggregationPolicies_Filtering(t *testing.T) {
+	secondSchema, secondSchemaCleanup := testClient().Schema.CreateSchemaInDatabase(t, sdk.NewAccountObjectIdentifier(TestDatabaseName))
+	t.Cleanup(secondSchemaCleanup)
+
+	prefix := random.AlphaN(4)
+	id1 := testClient().Ids.RandomSchemaObjectIdentifierWithPrefix(prefix)
+	id2 := testClient().Ids.RandomSchemaObjectIdentifierWithPrefix(prefix)
+	id3 := testClient().Ids.RandomSchemaObjectIdentifierInSchema(secondSchema.ID())
+	body := "AGGREGATION_CONSTRAINT(MIN_GROUP_SIZE => 5)"
+
+	model1 := model.AggregationPolicy("test1", id1.DatabaseName(), id1.SchemaName(), id1.Name(), body)
+	model2 := model.AggregationPolicy("test2", id2.DatabaseName(), id2.SchemaName(), id2.Name(), body)
+	model3 := model.AggregationPolicy("test3", id3.DatabaseName(), id3.SchemaName(), id3.Name(), body)
+
+	policiesModelLikeFirst := datasourcemodel.AggregationPolicies("test").
+		WithLike(id1.Name()).
+		WithInDatabase(id1.DatabaseId()).
+		WithDependsOn(model1.ResourceReference(), model2.ResourceReference(), model3.ResourceReference())
+
+	policiesModelLikePrefix := datasourcemodel.AggregationPolicies("test").
+		WithLike(prefix+"%").
+		WithInDatabase(id1.DatabaseId()).
+		WithDependsOn(model1.ResourceReference(), model2.ResourceReference(), model3.ResourceReference())
+
+	policiesModelInSchema := datasourcemodel.AggregationPolicies("test").
+		WithInSchema(id1.SchemaId()).
+		WithDependsOn(model1.ResourceReference(), model2.ResourceReference(), model3.ResourceReference())
+
+	policiesModelWithLimit := datasourcemodel.AggregationPolicies("test").
+		WithInDatabase(id1.DatabaseId()).
+		WithRowsAndFrom(1, prefix).
+		WithDependsOn(model1.ResourceReference(), model2.ResourceReference(), model3.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, model1, model2, model3, policiesModelLikeFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(policiesModelLikeFirst.DatasourceReference(), "aggregation_policies.#", "1"),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, model1, model2, model3, policiesModelLikePrefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(policiesModelLikePrefix.DatasourceReference(), "aggregation_policies.#", "2"),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, model1, model2, model3, policiesModelInSchema),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(policiesModelInSchema.DatasourceReference(), "aggregation_policies.#", "2"),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, model1, model2, model3, policiesModelWithLimit),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(policiesModelWithLimit.DatasourceReference(), "aggregation_policies.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,96 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ApplicationPackages_BasicUseCase(t *testing.T) {
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	comment := random.Comment()
+
+	applicationPackageModel := model.ApplicationPackage("test", id.Name()).
+		WithComment(comment)
+
+	applicationPackagesModel := datasourcemodel.ApplicationPackages("test").
+		WithLike(id.Name()).
+		WithDependsOn(applicationPackageModel.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, applicationPackageModel, applicationPackagesModel),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(applicationPackagesModel.DatasourceReference(), "application_packages.#", "1")),
+					resourceshowoutputassert.ApplicationPackagesDatasourceShowOutput(t, applicationPackagesModel.DatasourceReference()).
+						HasCreatedOnNotEmpty().
+						HasName(id.Name()).
+						HasDistribution(string(sdk.DistributionInternal)).
+						HasComment(comment),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ApplicationPackages_Filtering(t *testing.T) {
+	prefix := random.AlphaN(4)
+	id1 := testClient().Ids.RandomAccountObjectIdentifierWithPrefix(prefix)
+	id2 := testClient().Ids.RandomAccountObjectIdentifierWithPrefix(prefix)
+	id3 := testClient().Ids.RandomAccountObjectIdentifier()
+
+	model1 := model.ApplicationPackage("test1", id1.Name())
+	model2 := model.ApplicationPackage("test2", id2.Name())
+	model3 := model.ApplicationPackage("test3", id3.Name())
+
+	dependsOn := []string{model1.ResourceReference(), model2.ResourceReference(), model3.ResourceReference()}
+
+	likeModel := datasourcemodel.ApplicationPackages("test").
+		WithLike(id1.Name()).
+		WithDependsOn(dependsOn...)
+
+	startsWithModel := datasourcemodel.ApplicationPackages("test").
+		WithStartsWith(prefix).
+		WithDependsOn(dependsOn...)
+
+	limitModel := datasourcemodel.ApplicationPackages("test").
+		WithRowsAndFrom(1, prefix).
+		WithDependsOn(dependsOn...)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, model1, model2, model3, likeModel),
+				Check:  resource.TestCheckResourceAttr(likeModel.DatasourceReference(), "application_packages.#", "1"),
+			},
+			{
+				Config: accconfig.FromModels(t, model1, model2, model3, startsWithModel),
+				Check:  resource.TestCheckResourceAttr(startsWithModel.DatasourceReference(), "application_packages.#", "2"),
+			},
+			{
+				Config: accconfig.FromModels(t, model1, model2, model3, limitModel),
+				Check:  resource.TestCheckResourceAttr(limitModel.DatasourceReference(), "application_packages.#", "1"),
+			},
+		},
+	})
+}
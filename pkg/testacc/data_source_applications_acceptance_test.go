@@ -0,0 +1,59 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Applications_BasicUseCase(t *testing.T) {
+	application, applicationPackage := createAppReturnApplicationPackage(t)
+
+	applicationsModel := datasourcemodel.Applications("test").
+		WithLike(application.ID().Name())
+
+	applicationsModelWithoutDescribe := datasourcemodel.Applications("test").
+		WithWithDescribe(false).
+		WithLike(application.ID().Name())
+
+	showOutputAssertions := resourceshowoutputassert.ApplicationsDatasourceShowOutput(t, applicationsModel.DatasourceReference()).
+		HasCreatedOnNotEmpty().
+		HasName(application.ID().Name()).
+		HasSourceType("APPLICATION PACKAGE").
+		HasSource(applicationPackage.ID().Name()).
+		HasVersion("v1")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, applicationsModel),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(applicationsModel.DatasourceReference(), "applications.#", "1")),
+					showOutputAssertions,
+					assert.Check(resource.TestCheckResourceAttrSet(applicationsModel.DatasourceReference(), "applications.0.describe_output.0.property")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, applicationsModelWithoutDescribe),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(applicationsModelWithoutDescribe.DatasourceReference(), "applications.#", "1")),
+					showOutputAssertions,
+					assert.Check(resource.TestCheckResourceAttr(applicationsModelWithoutDescribe.DatasourceReference(), "applications.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
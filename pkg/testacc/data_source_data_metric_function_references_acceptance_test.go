@@ -0,0 +1,70 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_DataMetricFunctionReferences_BasicUseCase(t *testing.T) {
+	table, tableCleanup := testClient().Table.CreateWithColumns(t, []sdk.TableColumnRequest{
+		*sdk.NewTableColumnRequest("ID", sdk.DataTypeNumber),
+	})
+	t.Cleanup(tableCleanup)
+
+	functionId := sdk.NewSchemaObjectIdentifier("SNOWFLAKE", "CORE", "NULL_COUNT")
+
+	providerModel := providermodel.SnowflakeProvider().
+		WithPreviewFeaturesEnabled(string(previewfeatures.DataMetricFunctionAttachmentResource), string(previewfeatures.DataMetricFunctionReferencesDatasource))
+
+	attachmentModel := model.DataMetricFunctionAttachment(
+		"test",
+		functionId.FullyQualifiedName(),
+		[]sdk.DataMetricFunctionOnObjectSetScheduleRequest{{DataMetricSchedule: "USING CRON 0 8 * * * UTC"}},
+		table.ID().FullyQualifiedName(),
+		"table",
+		[]string{"ID"},
+	)
+
+	referencesModel := datasourcemodel.DataMetricFunctionReferences("test", table.ID().FullyQualifiedName(), "table").
+		WithDependsOn(attachmentModel.ResourceReference())
+	ref := referencesModel.DatasourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDataMetricFunctionAttachmentDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, providerModel, attachmentModel, referencesModel),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(ref, "data_metric_function_references.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(ref, "data_metric_function_references.0.metric_database_name", functionId.DatabaseName())),
+					assert.Check(resource.TestCheckResourceAttr(ref, "data_metric_function_references.0.metric_schema_name", functionId.SchemaName())),
+					assert.Check(resource.TestCheckResourceAttr(ref, "data_metric_function_references.0.metric_name", functionId.Name())),
+					assert.Check(resource.TestCheckResourceAttr(ref, "data_metric_function_references.0.ref_entity_database_name", table.ID().DatabaseName())),
+					assert.Check(resource.TestCheckResourceAttr(ref, "data_metric_function_references.0.ref_entity_schema_name", table.ID().SchemaName())),
+					assert.Check(resource.TestCheckResourceAttr(ref, "data_metric_function_references.0.ref_entity_name", table.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(ref, "data_metric_function_references.0.ref_arguments.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(ref, "data_metric_function_references.0.ref_arguments.0.name", "ID")),
+					assert.Check(resource.TestCheckResourceAttr(ref, "data_metric_function_references.0.schedule", "USING CRON 0 8 * * * UTC")),
+					assert.Check(resource.TestCheckResourceAttr(ref, "data_metric_function_references.0.schedule_status", string(sdk.DataMetricScheduleStatusStarted))),
+					assert.Check(resource.TestCheckResourceAttrSet(ref, "data_metric_function_references.0.ref_id")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,140 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_EventTables_BasicUseCase(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+
+	eventTableModel := model.EventTable("test", id.DatabaseName(), id.SchemaName(), id.Name()).
+		WithComment(comment)
+
+	eventTablesModel := datasourcemodel.EventTables("test").
+		WithLike(id.Name()).
+		WithInSchema(id.SchemaId()).
+		WithDependsOn(eventTableModel.ResourceReference())
+
+	eventTablesModelWithoutDescribe := datasourcemodel.EventTables("test").
+		WithWithDescribe(false).
+		WithLike(id.Name()).
+		WithInSchema(id.SchemaId()).
+		WithDependsOn(eventTableModel.ResourceReference())
+
+	showOutputAssertions := resourceshowoutputassert.EventTablesDatasourceShowOutput(t, eventTablesModel.DatasourceReference()).
+		HasCreatedOnNotEmpty().
+		HasName(id.Name()).
+		HasDatabaseName(id.DatabaseName()).
+		HasSchemaName(id.SchemaName()).
+		HasOwner(snowflakeroles.Accountadmin.Name()).
+		HasOwnerRoleType("ROLE").
+		HasComment(comment)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, eventTableModel, eventTablesModel),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(eventTablesModel.DatasourceReference(), "event_tables.#", "1")),
+					showOutputAssertions,
+					assert.Check(resource.TestCheckResourceAttr(eventTablesModel.DatasourceReference(), "event_tables.0.describe_output.0.name", "TIMESTAMP")),
+					assert.Check(resource.TestCheckResourceAttrSet(eventTablesModel.DatasourceReference(), "event_tables.0.describe_output.0.type")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, eventTableModel, eventTablesModelWithoutDescribe),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(eventTablesModelWithoutDescribe.DatasourceReference(), "event_tables.#", "1")),
+					showOutputAssertions,
+					assert.Check(resource.TestCheckResourceAttr(eventTablesModelWithoutDescribe.DatasourceReference(), "event_tables.0.describe_output.#", "0")),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_EventTables_Filtering(t *testing.T) {
+	secondSchema, secondSchemaCleanup := testClient().Schema.CreateSchemaInDatabase(t, sdk.NewAccountObjectIdentifier(TestDatabaseName))
+	t.Cleanup(secondSchemaCleanup)
+
+	prefix := random.AlphaN(4)
+	id1 := testClient().Ids.RandomSchemaObjectIdentifierWithPrefix(prefix)
+	id2 := testClient().Ids.RandomSchemaObjectIdentifierWithPrefix(prefix)
+	id3 := testClient().Ids.RandomSchemaObjectIdentifierInSchema(secondSchema.ID())
+
+	model1 := model.EventTable("test1", id1.DatabaseName(), id1.SchemaName(), id1.Name())
+	model2 := model.EventTable("test2", id2.DatabaseName(), id2.SchemaName(), id2.Name())
+	model3 := model.EventTable("test3", id3.DatabaseName(), id3.SchemaName(), id3.Name())
+
+	eventTablesModelLikeFirst := datasourcemodel.EventTables("test").
+		WithLike(id1.Name()).
+		WithInDatabase(id1.DatabaseId()).
+		WithDependsOn(model1.ResourceReference(), model2.ResourceReference(), model3.ResourceReference())
+
+	eventTablesModelStartsWith := datasourcemodel.EventTables("test").
+		WithStartsWith(prefix).
+		WithInDatabase(id1.DatabaseId()).
+		WithDependsOn(model1.ResourceReference(), model2.ResourceReference(), model3.ResourceReference())
+
+	eventTablesModelInSchema := datasourcemodel.EventTables("test").
+		WithInSchema(id1.SchemaId()).
+		WithDependsOn(model1.ResourceReference(), model2.ResourceReference(), model3.ResourceReference())
+
+	eventTablesModelLimit := datasourcemodel.EventTables("test").
+		WithRowsAndFrom(1, prefix).
+		WithInSchema(id1.SchemaId()).
+		WithDependsOn(model1.ResourceReference(), model2.ResourceReference(), model3.ResourceReference())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, model1, model2, model3, eventTablesModelLikeFirst),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(eventTablesModelLikeFirst.DatasourceReference(), "event_tables.#", "1"),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, model1, model2, model3, eventTablesModelStartsWith),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(eventTablesModelStartsWith.DatasourceReference(), "event_tables.#", "2"),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, model1, model2, model3, eventTablesModelInSchema),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(eventTablesModelInSchema.DatasourceReference(), "event_tables.#", "2"),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, model1, model2, model3, eventTablesModelLimit),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(eventTablesModelLimit.DatasourceReference(), "event_tables.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,64 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_FailoverGroupMembers_BasicUseCase(t *testing.T) {
+	// TODO [SNOW-1002023]: Unskip; Business Critical Snowflake Edition needed
+	_ = testenvs.GetOrSkipTest(t, testenvs.TestFailoverGroups)
+
+	database, databaseCleanup := testClient().Database.CreateDatabase(t)
+	t.Cleanup(databaseCleanup)
+
+	share, shareCleanup := testClient().Share.CreateShare(t)
+	t.Cleanup(shareCleanup)
+	testClient().Grant.GrantPrivilegeOnDatabaseToShare(t, database.ID(), share.ID(), []sdk.ObjectPrivilege{sdk.ObjectPrivilegeUsage})
+
+	failoverGroup, failoverGroupCleanup := testClient().FailoverGroup.CreateWithRequest(t, sdk.NewCreateFailoverGroupRequest(
+		testClient().Ids.RandomAccountObjectIdentifier(),
+		[]sdk.PluralObjectType{sdk.PluralObjectTypeDatabases, sdk.PluralObjectTypeShares},
+		[]sdk.AccountIdentifier{secondaryTestClient().Account.GetAccountIdentifier(t)},
+	).
+		WithAllowedDatabases([]sdk.AccountObjectIdentifier{database.ID()}).
+		WithAllowedShares([]sdk.AccountObjectIdentifier{share.ID()}))
+	t.Cleanup(failoverGroupCleanup)
+
+	providerModel := providermodel.SnowflakeProvider().
+		WithPreviewFeaturesEnabled(string(previewfeatures.FailoverGroupMembersDatasource))
+
+	membersModel := datasourcemodel.FailoverGroupMembers("test", failoverGroup.ID().FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, providerModel, membersModel),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(membersModel.DatasourceReference(), "failover_group", failoverGroup.ID().FullyQualifiedName())),
+					assert.Check(resource.TestCheckResourceAttr(membersModel.DatasourceReference(), "databases.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(membersModel.DatasourceReference(), "databases.0.name", database.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(membersModel.DatasourceReference(), "shares.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(membersModel.DatasourceReference(), "shares.0.name", share.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttrSet(membersModel.DatasourceReference(), "shares.0.owner_account")),
+				),
+			},
+		},
+	})
+}
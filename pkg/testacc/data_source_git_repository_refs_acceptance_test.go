@@ -0,0 +1,61 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testvars"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_GitRepositoryRefs_BasicUseCase(t *testing.T) {
+	origin := testvars.ExampleGitRepositoryOrigin
+
+	apiIntegrationId, apiIntegrationCleanup := testClient().ApiIntegration.CreateGitTokenWithAllowedOrigin(t, origin)
+	t.Cleanup(apiIntegrationCleanup)
+
+	gitRepository, gitRepositoryCleanup := testClient().GitRepository.Create(t, testClient().Ids.RandomSchemaObjectIdentifier(), origin, apiIntegrationId)
+	t.Cleanup(gitRepositoryCleanup)
+
+	providerModel := providermodel.SnowflakeProvider().
+		WithPreviewFeaturesEnabled(string(previewfeatures.GitRepositoryRefsDatasource))
+
+	refsModel := datasourcemodel.GitRepositoryRefs("test", gitRepository.ID().FullyQualifiedName())
+	filteredRefsModel := datasourcemodel.GitRepositoryRefs("test", gitRepository.ID().FullyQualifiedName()).
+		WithLike("master")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, providerModel, refsModel),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(refsModel.DatasourceReference(), "git_repository", gitRepository.ID().FullyQualifiedName())),
+					assert.Check(resource.TestCheckResourceAttrSet(refsModel.DatasourceReference(), "branches.#")),
+					assert.Check(resource.TestCheckResourceAttrSet(refsModel.DatasourceReference(), "tags.#")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, providerModel, filteredRefsModel),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(filteredRefsModel.DatasourceReference(), "branches.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(filteredRefsModel.DatasourceReference(), "branches.0.name", "master")),
+					assert.Check(resource.TestCheckResourceAttrSet(filteredRefsModel.DatasourceReference(), "branches.0.commit_hash")),
+					assert.Check(resource.TestCheckResourceAttr(filteredRefsModel.DatasourceReference(), "tags.#", "0")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,53 @@
+//go:build account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_OrganizationAccounts_BasicUseCase_DifferentFiltering(t *testing.T) {
+	testClient().EnsureValidNonProdOrganizationAccountIsUsed(t)
+
+	currentOrganizationAccount := testClient().OrganizationAccount.ShowCurrent(t)
+
+	provider := providermodel.SnowflakeProvider().WithWarehouse(testClient().Ids.WarehouseId().FullyQualifiedName())
+	organizationAccountsWithName := datasourcemodel.OrganizationAccounts("test").WithLike(currentOrganizationAccount.AccountName)
+	organizationAccountsWithNonMatchingPattern := datasourcemodel.OrganizationAccounts("test").WithLike("non-existing-" + random.AlphaN(6))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, provider, organizationAccountsWithName),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(organizationAccountsWithName.DatasourceReference(), "organization_accounts.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(organizationAccountsWithName.DatasourceReference(), "organization_accounts.0.show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(organizationAccountsWithName.DatasourceReference(), "organization_accounts.0.show_output.0.organization_name", currentOrganizationAccount.OrganizationName)),
+					assert.Check(resource.TestCheckResourceAttr(organizationAccountsWithName.DatasourceReference(), "organization_accounts.0.show_output.0.account_name", currentOrganizationAccount.AccountName)),
+					assert.Check(resource.TestCheckResourceAttr(organizationAccountsWithName.DatasourceReference(), "organization_accounts.0.show_output.0.edition", string(currentOrganizationAccount.Edition))),
+					assert.Check(resource.TestCheckResourceAttr(organizationAccountsWithName.DatasourceReference(), "organization_accounts.0.show_output.0.is_organization_account", "true")),
+				),
+			},
+			{
+				Config: config.FromModels(t, provider, organizationAccountsWithNonMatchingPattern),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(organizationAccountsWithNonMatchingPattern.DatasourceReference(), "organization_accounts.#", "0")),
+				),
+			},
+		},
+	})
+}
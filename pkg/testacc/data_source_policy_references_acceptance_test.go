@@ -0,0 +1,103 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_PolicyReferences_BasicUseCase(t *testing.T) {
+	passwordPolicy, passwordPolicyCleanup := testClient().PasswordPolicy.CreatePasswordPolicy(t)
+	t.Cleanup(passwordPolicyCleanup)
+
+	user, userCleanup := testClient().User.CreateUser(t)
+	t.Cleanup(userCleanup)
+
+	testClient().User.Alter(t, sdk.NewAlterUserRequest(user.ID()).WithSet(*sdk.NewUserSetRequest().WithPasswordPolicy(passwordPolicy.ID())))
+
+	providerModel := providermodel.SnowflakeProvider().
+		WithPreviewFeaturesEnabled(string(previewfeatures.PolicyReferencesDatasource))
+
+	forEntityModel := datasourcemodel.PolicyReferences("test").
+		WithRefEntityName(user.ID().Name()).
+		WithRefEntityDomain("user")
+	forPolicyModel := datasourcemodel.PolicyReferences("test").
+		WithPolicyName(passwordPolicy.ID().FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, providerModel, forEntityModel),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(forEntityModel.DatasourceReference(), "policy_references.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(forEntityModel.DatasourceReference(), "policy_references.0.policy_db", passwordPolicy.ID().DatabaseName())),
+					assert.Check(resource.TestCheckResourceAttr(forEntityModel.DatasourceReference(), "policy_references.0.policy_schema", passwordPolicy.ID().SchemaName())),
+					assert.Check(resource.TestCheckResourceAttr(forEntityModel.DatasourceReference(), "policy_references.0.policy_name", passwordPolicy.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(forEntityModel.DatasourceReference(), "policy_references.0.policy_kind", string(sdk.PolicyKindPasswordPolicy))),
+					assert.Check(resource.TestCheckResourceAttr(forEntityModel.DatasourceReference(), "policy_references.0.ref_entity_name", user.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(forEntityModel.DatasourceReference(), "policy_references.0.ref_entity_domain", string(sdk.PolicyEntityDomainUser))),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, providerModel, forPolicyModel),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(forPolicyModel.DatasourceReference(), "policy_references.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(forPolicyModel.DatasourceReference(), "policy_references.0.policy_name", passwordPolicy.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(forPolicyModel.DatasourceReference(), "policy_references.0.policy_kind", string(sdk.PolicyKindPasswordPolicy))),
+					assert.Check(resource.TestCheckResourceAttr(forPolicyModel.DatasourceReference(), "policy_references.0.ref_entity_name", user.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(forPolicyModel.DatasourceReference(), "policy_references.0.ref_entity_domain", string(sdk.PolicyEntityDomainUser))),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_PolicyReferences_Validations(t *testing.T) {
+	providerModel := providermodel.SnowflakeProvider().
+		WithPreviewFeaturesEnabled(string(previewfeatures.PolicyReferencesDatasource))
+
+	bothSetModel := datasourcemodel.PolicyReferences("test").
+		WithPolicyName("DB.SCHEMA.POLICY").
+		WithRefEntityName("USER_NAME").
+		WithRefEntityDomain("user")
+	noneSetModel := datasourcemodel.PolicyReferences("test")
+	missingDomainModel := datasourcemodel.PolicyReferences("test").
+		WithRefEntityName("USER_NAME")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      accconfig.FromModels(t, providerModel, bothSetModel),
+				ExpectError: regexp.MustCompile(`only one of .policy_name,ref_entity_name. can be specified`),
+			},
+			{
+				Config:      accconfig.FromModels(t, providerModel, noneSetModel),
+				ExpectError: regexp.MustCompile(`one of .policy_name,ref_entity_name. must be specified`),
+			},
+			{
+				Config:      accconfig.FromModels(t, providerModel, missingDomainModel),
+				ExpectError: regexp.MustCompile(`all of .ref_entity_domain,ref_entity_name. must be specified`),
+			},
+		},
+	})
+}
@@ -0,0 +1,67 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Regions_BasicUseCase(t *testing.T) {
+	// CURRENT_REGION may be prefixed with the region group (e.g. PUBLIC.AWS_US_WEST_2)
+	currentRegion := testClient().Context.CurrentRegion(t)
+	snowflakeRegion := currentRegion[strings.LastIndex(currentRegion, ".")+1:]
+
+	providerModel := providermodel.SnowflakeProvider().
+		WithPreviewFeaturesEnabled(string(previewfeatures.RegionsDatasource))
+
+	regionsModel := datasourcemodel.Regions("test")
+	regionsFilteredModel := datasourcemodel.Regions("test").
+		WithLike(snowflakeRegion)
+	regionsNoMatchModel := datasourcemodel.Regions("test").
+		WithLike("NON_EXISTING_REGION")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, providerModel, regionsModel),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttrSet(regionsModel.DatasourceReference(), "regions.#")),
+					assert.Check(resource.TestCheckResourceAttrSet(regionsModel.DatasourceReference(), "regions.0.show_output.0.snowflake_region")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, providerModel, regionsFilteredModel),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(regionsFilteredModel.DatasourceReference(), "regions.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(regionsFilteredModel.DatasourceReference(), "regions.0.show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(regionsFilteredModel.DatasourceReference(), "regions.0.show_output.0.snowflake_region", snowflakeRegion)),
+					assert.Check(resource.TestCheckResourceAttrSet(regionsFilteredModel.DatasourceReference(), "regions.0.show_output.0.cloud_type")),
+					assert.Check(resource.TestCheckResourceAttrSet(regionsFilteredModel.DatasourceReference(), "regions.0.show_output.0.region")),
+					assert.Check(resource.TestCheckResourceAttrSet(regionsFilteredModel.DatasourceReference(), "regions.0.show_output.0.display_name")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, providerModel, regionsNoMatchModel),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(regionsNoMatchModel.DatasourceReference(), "regions.#", "0")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,45 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ReplicationAccounts_BasicUseCase(t *testing.T) {
+	providerModel := providermodel.SnowflakeProvider().
+		WithPreviewFeaturesEnabled(string(previewfeatures.ReplicationAccountsDatasource))
+
+	replicationAccountsModel := datasourcemodel.ReplicationAccounts("test")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, providerModel, replicationAccountsModel),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttrSet(replicationAccountsModel.DatasourceReference(), "replication_accounts.#")),
+					assert.Check(resource.TestCheckResourceAttr(replicationAccountsModel.DatasourceReference(), "replication_accounts.0.show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttrSet(replicationAccountsModel.DatasourceReference(), "replication_accounts.0.show_output.0.snowflake_region")),
+					assert.Check(resource.TestCheckResourceAttrSet(replicationAccountsModel.DatasourceReference(), "replication_accounts.0.show_output.0.created_on")),
+					assert.Check(resource.TestCheckResourceAttrSet(replicationAccountsModel.DatasourceReference(), "replication_accounts.0.show_output.0.account_name")),
+					assert.Check(resource.TestCheckResourceAttrSet(replicationAccountsModel.DatasourceReference(), "replication_accounts.0.show_output.0.account_locator")),
+					assert.Check(resource.TestCheckResourceAttrSet(replicationAccountsModel.DatasourceReference(), "replication_accounts.0.show_output.0.organization_name")),
+					assert.Check(resource.TestCheckResourceAttrSet(replicationAccountsModel.DatasourceReference(), "replication_accounts.0.show_output.0.is_org_admin")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,71 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/datasourcemodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ReplicationDatabases_BasicUseCase(t *testing.T) {
+	primaryDatabase, externalPrimaryId, primaryDatabaseCleanup := secondaryTestClient().Database.CreatePrimaryDatabase(t, []sdk.AccountIdentifier{
+		testClient().Account.GetAccountIdentifier(t),
+	})
+	t.Cleanup(primaryDatabaseCleanup)
+
+	providerModel := providermodel.SnowflakeProvider().
+		WithPreviewFeaturesEnabled(string(previewfeatures.ReplicationDatabasesDatasource))
+
+	likeModel := datasourcemodel.ReplicationDatabases("test").
+		WithLike(primaryDatabase.ID().Name())
+	withPrimaryModel := datasourcemodel.ReplicationDatabases("test").
+		WithWithPrimary(externalPrimaryId.FullyQualifiedName())
+	noMatchModel := datasourcemodel.ReplicationDatabases("test").
+		WithLike(testClient().Ids.Alpha())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, providerModel, likeModel),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(likeModel.DatasourceReference(), "replication_databases.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(likeModel.DatasourceReference(), "replication_databases.0.show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(likeModel.DatasourceReference(), "replication_databases.0.show_output.0.name", primaryDatabase.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(likeModel.DatasourceReference(), "replication_databases.0.show_output.0.is_primary", "true")),
+					assert.Check(resource.TestCheckResourceAttr(likeModel.DatasourceReference(), "replication_databases.0.show_output.0.primary_database", externalPrimaryId.FullyQualifiedName())),
+					assert.Check(resource.TestCheckResourceAttrSet(likeModel.DatasourceReference(), "replication_databases.0.show_output.0.snowflake_region")),
+					assert.Check(resource.TestCheckResourceAttrSet(likeModel.DatasourceReference(), "replication_databases.0.show_output.0.replication_allowed_to_accounts")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, providerModel, withPrimaryModel),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(withPrimaryModel.DatasourceReference(), "replication_databases.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(withPrimaryModel.DatasourceReference(), "replication_databases.0.show_output.0.name", primaryDatabase.ID().Name())),
+					assert.Check(resource.TestCheckResourceAttr(withPrimaryModel.DatasourceReference(), "replication_databases.0.show_output.0.is_primary", "true")),
+				),
+			},
+			{
+				Config: accconfig.FromModels(t, providerModel, noMatchModel),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(noMatchModel.DatasourceReference(), "replication_databases.#", "0")),
+				),
+			},
+		},
+	})
+}
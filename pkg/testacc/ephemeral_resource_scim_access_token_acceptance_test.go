@@ -0,0 +1,60 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ScimAccessTokenEphemeral_BasicUseCase(t *testing.T) {
+	scimId := testClient().Ids.RandomAccountObjectIdentifier()
+	roleId := snowflakeroles.AadProvisioner
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: pluginFrameworkProviderFactory,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ScimSecurityIntegration),
+		Steps: []resource.TestStep{
+			{
+				Config: scimAccessTokenEphemeralConfig(scimId, roleId),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("integration_name"), knownvalue.StringExact(scimId.Name())),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("access_token"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func scimAccessTokenEphemeralConfig(scimId sdk.AccountObjectIdentifier, roleId sdk.AccountObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_scim_integration" "test" {
+  name        = "%[1]s"
+  enabled     = true
+  scim_client = "AZURE"
+  run_as_role = "%[2]s"
+}
+
+ephemeral "snowflake_scim_access_token" "test" {
+  integration_name = snowflake_scim_integration.test.name
+}
+
+provider "echo" {
+  data = ephemeral.snowflake_scim_access_token.test
+}
+
+resource "echo" "test" {}
+`, scimId.Name(), roleId.Name())
+}
@@ -0,0 +1,65 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_UserProgrammaticAccessTokenEphemeral_BasicUseCase(t *testing.T) {
+	user, userCleanup := testClient().User.CreateUser(t)
+	t.Cleanup(userCleanup)
+
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: pluginFrameworkProviderFactory,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: userProgrammaticAccessTokenEphemeralConfig(user.ID(), id),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("user"), knownvalue.StringExact(user.ID().Name())),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("name"), knownvalue.StringExact(id.Name())),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+				},
+				// the token is removed when the ephemeral resource is closed
+				Check: func(_ *terraform.State) error {
+					tokens := testClient().User.ShowProgrammaticAccessTokens(t, user.ID())
+					if slices.ContainsFunc(tokens, func(token sdk.ProgrammaticAccessToken) bool { return token.Name == id.Name() }) {
+						return fmt.Errorf("programmatic access token %s of user %s was not removed", id.FullyQualifiedName(), user.ID().FullyQualifiedName())
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func userProgrammaticAccessTokenEphemeralConfig(userId sdk.AccountObjectIdentifier, id sdk.AccountObjectIdentifier) string {
+	return fmt.Sprintf(`
+ephemeral "snowflake_user_programmatic_access_token" "test" {
+  user           = %[1]s
+  name           = %[2]s
+  days_to_expiry = 1
+}
+
+provider "echo" {
+  data = ephemeral.snowflake_user_programmatic_access_token.test
+}
+
+resource "echo" "test" {}
+`, userId.FullyQualifiedName(), id.FullyQualifiedName())
+}
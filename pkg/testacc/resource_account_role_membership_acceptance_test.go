@@ -0,0 +1,135 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_AccountRoleMembership_BasicUseCase(t *testing.T) {
+	role, roleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+
+	parentRole, parentRoleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(parentRoleCleanup)
+
+	otherParentRole, otherParentRoleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(otherParentRoleCleanup)
+
+	user, userCleanup := testClient().User.CreateUser(t)
+	t.Cleanup(userCleanup)
+
+	membershipModel := model.AccountRoleMembership("test", role.ID().Name()).
+		WithParentRoleNames(parentRole.ID())
+	membershipModelUpdated := model.AccountRoleMembership("test", role.ID().Name()).
+		WithParentRoleNames(parentRole.ID(), otherParentRole.ID()).
+		WithUserNames(user.ID())
+
+	resourceName := membershipModel.ResourceReference()
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckAccountRoleMembershipRevoked(t),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, membershipModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", role.ID().Name()),
+					resource.TestCheckResourceAttr(resourceName, "role_name", role.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "parent_role_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "parent_role_names.*", parentRole.ID().Name()),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "0"),
+				),
+			},
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: accconfig.FromModels(t, membershipModelUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "parent_role_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "parent_role_names.*", parentRole.ID().Name()),
+					resource.TestCheckTypeSetElemAttr(resourceName, "parent_role_names.*", otherParentRole.ID().Name()),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_names.*", user.ID().Name()),
+				),
+			},
+			{
+				Config:            accconfig.FromModels(t, membershipModelUpdated),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// grants made outside of the configuration are revoked
+			{
+				Config: accconfig.FromModels(t, membershipModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "parent_role_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "parent_role_names.*", parentRole.ID().Name()),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "0"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testClient().Role.GrantRoleToRole(t, role.ID(), otherParentRole.ID())
+					testClient().Role.GrantRoleToUser(t, role.ID(), user.ID())
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: accconfig.FromModels(t, membershipModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "parent_role_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "parent_role_names.*", parentRole.ID().Name()),
+					resource.TestCheckResourceAttr(resourceName, "user_names.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_AccountRoleMembership_RevokesExistingGrantsOnCreate(t *testing.T) {
+	role, roleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+
+	parentRole, parentRoleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(parentRoleCleanup)
+
+	otherParentRole, otherParentRoleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(otherParentRoleCleanup)
+
+	testClient().Role.GrantRoleToRole(t, role.ID(), otherParentRole.ID())
+
+	membershipModel := model.AccountRoleMembership("test", role.ID().Name()).
+		WithParentRoleNames(parentRole.ID())
+
+	resourceName := membershipModel.ResourceReference()
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckAccountRoleMembershipRevoked(t),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, membershipModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "parent_role_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "parent_role_names.*", parentRole.ID().Name()),
+				),
+			},
+		},
+	})
+}
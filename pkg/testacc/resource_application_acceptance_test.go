@@ -0,0 +1,164 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Application_BasicUseCase(t *testing.T) {
+	stage, stageCleanup := testClient().Stage.CreateStage(t)
+	t.Cleanup(stageCleanup)
+	testClient().Stage.PutOnStage(t, stage.ID(), "TestAcc_GrantApplicationRole/manifest.yml")
+	testClient().Stage.PutOnStage(t, stage.ID(), "TestAcc_GrantApplicationRole/setup.sql")
+
+	applicationPackage, applicationPackageCleanup := testClient().ApplicationPackage.CreateApplicationPackageWithReleaseChannelsDisabled(t)
+	t.Cleanup(applicationPackageCleanup)
+	testClient().ApplicationPackage.AddApplicationPackageVersion(t, applicationPackage.ID(), stage.ID(), "V1")
+	testClient().ApplicationPackage.AddApplicationPackageVersion(t, applicationPackage.ID(), stage.ID(), "V2")
+
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	comment := random.Comment()
+	externalComment := random.Comment()
+
+	basic := model.Application("t", id.Name()).
+		WithFromApplicationPackage(applicationPackage.ID().Name()).
+		WithVersion("V1")
+
+	complete := model.Application("t", id.Name()).
+		WithFromApplicationPackage(applicationPackage.ID().Name()).
+		WithVersion("V2").
+		WithPatch(0).
+		WithDebugMode(r.BooleanTrue).
+		WithComment(comment)
+
+	upgraded := model.Application("t", id.Name()).
+		WithFromApplicationPackage(applicationPackage.ID().Name()).
+		WithVersion("V2")
+
+	ref := basic.ResourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Application),
+		Steps: []resource.TestStep{
+			// Create without optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionCreate),
+					},
+				},
+				Config: config.FromModels(t, basic),
+				Check: assertThat(
+					t,
+					resourceassert.ApplicationResource(t, ref).
+						HasNameString(id.Name()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()).
+						HasFromApplicationPackageString(applicationPackage.ID().Name()).
+						HasVersionString("V1").
+						HasPatchString(r.IntDefaultString).
+						HasDebugModeString(r.BooleanDefault).
+						HasCommentString(""),
+					resourceshowoutputassert.ApplicationShowOutput(t, ref).
+						HasCreatedOnNotEmpty().
+						HasName(id.Name()).
+						HasSourceType("APPLICATION PACKAGE").
+						HasSource(applicationPackage.ID().Name()).
+						HasVersion("V1").
+						HasPatch(0).
+						HasComment(""),
+					assert.Check(resource.TestCheckResourceAttrSet(ref, "describe_output.#")),
+				),
+			},
+			// Import without optionals
+			{
+				Config:       config.FromModels(t, basic),
+				ResourceName: ref,
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(
+					t,
+					resourceassert.ImportedApplicationResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasFromApplicationPackageString(applicationPackage.ID().FullyQualifiedName()).
+						HasCommentString(""),
+				),
+			},
+			// Upgrade and set all optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, complete),
+				Check: assertThat(
+					t,
+					resourceassert.ApplicationResource(t, ref).
+						HasVersionString("V2").
+						HasPatchString("0").
+						HasDebugModeString(r.BooleanTrue).
+						HasCommentString(comment),
+					resourceshowoutputassert.ApplicationShowOutput(t, ref).
+						HasVersion("V2").
+						HasPatch(0).
+						HasComment(comment),
+				),
+			},
+			// External change is detected
+			{
+				PreConfig: func() {
+					testClient().Application.Alter(t, sdk.NewAlterApplicationRequest(id).WithSet(*sdk.NewApplicationSetRequest().WithComment(externalComment)))
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, complete),
+				Check: assertThat(
+					t,
+					resourceassert.ApplicationResource(t, ref).
+						HasCommentString(comment),
+				),
+			},
+			// Unset optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, upgraded),
+				Check: assertThat(
+					t,
+					resourceassert.ApplicationResource(t, ref).
+						HasVersionString("V2").
+						HasPatchString(r.IntDefaultString).
+						HasDebugModeString(r.BooleanDefault).
+						HasCommentString(""),
+					resourceshowoutputassert.ApplicationShowOutput(t, ref).
+						HasVersion("V2").
+						HasComment(""),
+				),
+			},
+		},
+	})
+}
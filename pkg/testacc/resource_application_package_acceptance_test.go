@@ -0,0 +1,162 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ApplicationPackage_BasicUseCase(t *testing.T) {
+	stage, stageCleanup := testClient().Stage.CreateStage(t)
+	t.Cleanup(stageCleanup)
+	testClient().Stage.PutOnStage(t, stage.ID(), "TestAcc_GrantApplicationRole/manifest.yml")
+	testClient().Stage.PutOnStage(t, stage.ID(), "TestAcc_GrantApplicationRole/setup.sql")
+	using := "@" + stage.ID().FullyQualifiedName()
+
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	comment := random.Comment()
+	externalComment := random.Comment()
+
+	basic := model.ApplicationPackage("t", id.Name()).
+		WithEnableReleaseChannels(r.BooleanFalse)
+
+	complete := model.ApplicationPackage("t", id.Name()).
+		WithEnableReleaseChannels(r.BooleanFalse).
+		WithComment(comment).
+		WithDistribution(string(sdk.DistributionInternal)).
+		WithDataRetentionTimeInDays(1).
+		WithVersion("V1", using).
+		WithDefaultReleaseDirective("V1", 0)
+
+	ref := basic.ResourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.ApplicationPackage),
+		Steps: []resource.TestStep{
+			// Create without optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionCreate),
+					},
+				},
+				Config: config.FromModels(t, basic),
+				Check: assertThat(
+					t,
+					resourceassert.ApplicationPackageResource(t, ref).
+						HasNameString(id.Name()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()).
+						HasEnableReleaseChannelsString(r.BooleanFalse).
+						HasCommentString("").
+						HasNoDistribution().
+						HasDataRetentionTimeInDaysString(r.IntDefaultString),
+					resourceshowoutputassert.ApplicationPackageShowOutput(t, ref).
+						HasCreatedOnNotEmpty().
+						HasName(id.Name()).
+						HasDistribution(string(sdk.DistributionInternal)).
+						HasComment(""),
+					assert.Check(resource.TestCheckResourceAttr(ref, "version.#", "0")),
+				),
+			},
+			// Import without optionals
+			{
+				Config:                  config.FromModels(t, basic),
+				ResourceName:            ref,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"enable_release_channels"},
+			},
+			// Set all optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, complete),
+				Check: assertThat(
+					t,
+					resourceassert.ApplicationPackageResource(t, ref).
+						HasNameString(id.Name()).
+						HasCommentString(comment).
+						HasDistributionString(string(sdk.DistributionInternal)).
+						HasDataRetentionTimeInDaysString("1"),
+					resourceshowoutputassert.ApplicationPackageShowOutput(t, ref).
+						HasName(id.Name()).
+						HasComment(comment).
+						HasRetentionTime(1),
+					assert.Check(resource.TestCheckResourceAttr(ref, "version.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(ref, "default_release_directive.0.version", "V1")),
+					assert.Check(resource.TestCheckResourceAttr(ref, "default_release_directive.0.patch", "0")),
+				),
+			},
+			// External change is detected
+			{
+				PreConfig: func() {
+					testClient().ApplicationPackage.Alter(t, sdk.NewAlterApplicationPackageRequest(id).WithSet(*sdk.NewApplicationPackageSetRequest().WithComment(externalComment)))
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, complete),
+				Check: assertThat(
+					t,
+					resourceassert.ApplicationPackageResource(t, ref).
+						HasCommentString(comment),
+					assert.Check(resource.TestCheckResourceAttr(ref, "version.#", "1")),
+				),
+			},
+			// Unset optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, basic),
+				Check: assertThat(
+					t,
+					resourceassert.ApplicationPackageResource(t, ref).
+						HasNameString(id.Name()).
+						HasCommentString("").
+						HasDataRetentionTimeInDaysString(r.IntDefaultString),
+					resourceshowoutputassert.ApplicationPackageShowOutput(t, ref).
+						HasComment(""),
+					assert.Check(resource.TestCheckResourceAttr(ref, "version.#", "0")),
+				),
+			},
+			// Import with the imported state
+			{
+				Config:       config.FromModels(t, basic),
+				ResourceName: ref,
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(
+					t,
+					resourceassert.ImportedApplicationPackageResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,224 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_BackupPolicy_BasicUseCase(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	newId := testClient().Ids.RandomSchemaObjectIdentifierInSchema(id.SchemaId())
+
+	comment := random.Comment()
+	externalComment := random.Comment()
+	schedule := "60 MINUTE"
+
+	basic := model.BackupPolicy("t", id.DatabaseName(), id.SchemaName(), id.Name()).
+		WithExpireAfterDays(7)
+
+	complete := model.BackupPolicy("t", newId.DatabaseName(), newId.SchemaName(), newId.Name()).
+		WithSchedule(schedule).
+		WithExpireAfterDays(30).
+		WithComment(comment)
+
+	ref := basic.ResourceReference()
+
+	basicAssertions := []assert.TestCheckFuncProvider{
+		resourceassert.BackupPolicyResource(t, ref).
+			HasNameString(id.Name()).
+			HasSchemaString(id.SchemaName()).
+			HasDatabaseString(id.DatabaseName()).
+			HasFullyQualifiedNameString(id.FullyQualifiedName()).
+			HasScheduleString("").
+			HasExpireAfterDaysString("7").
+			HasWithRetentionLockString("false").
+			HasCommentString(""),
+		resourceshowoutputassert.BackupPolicyShowOutput(t, ref).
+			HasCreatedOnNotEmpty().
+			HasName(id.Name()).
+			HasDatabaseName(id.DatabaseName()).
+			HasSchemaName(id.SchemaName()).
+			HasOwner(snowflakeroles.Accountadmin.Name()).
+			HasOwnerRoleType("ROLE").
+			HasComment("").
+			HasSchedule("").
+			HasExpireAfterDays(7).
+			HasHasRetentionLock(false),
+		resourceshowoutputassert.BackupPolicyDescribeOutput(t, ref).
+			HasName(id.Name()).
+			HasExpireAfterDays(7).
+			HasHasRetentionLock(false),
+	}
+
+	completeAssertions := []assert.TestCheckFuncProvider{
+		resourceassert.BackupPolicyResource(t, ref).
+			HasNameString(newId.Name()).
+			HasSchemaString(newId.SchemaName()).
+			HasDatabaseString(newId.DatabaseName()).
+			HasFullyQualifiedNameString(newId.FullyQualifiedName()).
+			HasScheduleString(schedule).
+			HasExpireAfterDaysString("30").
+			HasWithRetentionLockString("false").
+			HasCommentString(comment),
+		resourceshowoutputassert.BackupPolicyShowOutput(t, ref).
+			HasCreatedOnNotEmpty().
+			HasName(newId.Name()).
+			HasComment(comment).
+			HasSchedule(schedule).
+			HasExpireAfterDays(30).
+			HasHasRetentionLock(false),
+		resourceshowoutputassert.BackupPolicyDescribeOutput(t, ref).
+			HasName(newId.Name()).
+			HasComment(comment).
+			HasSchedule(schedule).
+			HasExpireAfterDays(30),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.BackupPolicy),
+		Steps: []resource.TestStep{
+			// Create without optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionCreate),
+					},
+				},
+				Config: config.FromModels(t, basic),
+				Check:  assertThat(t, basicAssertions...),
+			},
+			// Import without optionals
+			{
+				Config:       config.FromModels(t, basic),
+				ResourceName: ref,
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(
+					t,
+					resourceassert.ImportedBackupPolicyResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasSchemaString(id.SchemaName()).
+						HasDatabaseString(id.DatabaseName()).
+						HasScheduleString("").
+						HasExpireAfterDaysString("7").
+						HasWithRetentionLockString("false").
+						HasCommentString(""),
+				),
+			},
+			// Set all optionals and rename
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, complete),
+				Check:  assertThat(t, completeAssertions...),
+			},
+			// External change is detected
+			{
+				PreConfig: func() {
+					testClient().BackupPolicy.Alter(t, sdk.NewAlterBackupPolicyRequest(newId).
+						WithSet(*sdk.NewBackupPolicySetRequest().WithComment(externalComment)))
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, complete),
+				Check:  assertThat(t, completeAssertions...),
+			},
+			// Import with all optionals
+			{
+				Config:       config.FromModels(t, complete),
+				ResourceName: ref,
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(
+					t,
+					resourceassert.ImportedBackupPolicyResource(t, helpers.EncodeResourceIdentifier(newId)).
+						HasNameString(newId.Name()).
+						HasScheduleString(schedule).
+						HasExpireAfterDaysString("30").
+						HasWithRetentionLockString("false").
+						HasCommentString(comment),
+				),
+			},
+			// Unset optionals (back to the basic config with the original name)
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, basic),
+				Check:  assertThat(t, basicAssertions...),
+			},
+		},
+	})
+}
+
+func TestAcc_BackupPolicy_WithRetentionLock(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	withoutLock := model.BackupPolicy("t", id.DatabaseName(), id.SchemaName(), id.Name()).
+		WithExpireAfterDays(1)
+	withLock := model.BackupPolicy("t", id.DatabaseName(), id.SchemaName(), id.Name()).
+		WithExpireAfterDays(1).
+		WithWithRetentionLock(true)
+
+	ref := withoutLock.ResourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.BackupPolicy),
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, withoutLock),
+				Check: assertThat(
+					t,
+					resourceassert.BackupPolicyResource(t, ref).
+						HasWithRetentionLockString("false"),
+					resourceshowoutputassert.BackupPolicyShowOutput(t, ref).
+						HasHasRetentionLock(false),
+				),
+			},
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Config: config.FromModels(t, withLock),
+				Check: assertThat(
+					t,
+					resourceassert.BackupPolicyResource(t, ref).
+						HasWithRetentionLockString("true"),
+					resourceshowoutputassert.BackupPolicyShowOutput(t, ref).
+						HasHasRetentionLock(true),
+				),
+			},
+		},
+	})
+}
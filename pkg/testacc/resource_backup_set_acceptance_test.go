@@ -0,0 +1,203 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_BackupSet_BasicUseCase(t *testing.T) {
+	table, tableCleanup := testClient().Table.Create(t)
+	t.Cleanup(tableCleanup)
+
+	backupPolicy, backupPolicyCleanup := testClient().BackupPolicy.Create(t)
+	t.Cleanup(backupPolicyCleanup)
+
+	otherBackupPolicy, otherBackupPolicyCleanup := testClient().BackupPolicy.Create(t)
+	t.Cleanup(otherBackupPolicyCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+
+	basic := model.BackupSet("t", id.DatabaseName(), id.SchemaName(), id.Name(), table.ID().FullyQualifiedName(), string(sdk.BackupSetObjectKindTable))
+
+	complete := model.BackupSet("t", id.DatabaseName(), id.SchemaName(), id.Name(), table.ID().FullyQualifiedName(), string(sdk.BackupSetObjectKindTable)).
+		WithBackupPolicy(backupPolicy.ID().FullyQualifiedName()).
+		WithComment(comment)
+
+	completeWithOtherPolicy := model.BackupSet("t", id.DatabaseName(), id.SchemaName(), id.Name(), table.ID().FullyQualifiedName(), string(sdk.BackupSetObjectKindTable)).
+		WithBackupPolicy(otherBackupPolicy.ID().FullyQualifiedName())
+
+	ref := basic.ResourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.BackupSet),
+		Steps: []resource.TestStep{
+			// Create without optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionCreate),
+					},
+				},
+				Config: config.FromModels(t, basic),
+				Check: assertThat(
+					t,
+					resourceassert.BackupSetResource(t, ref).
+						HasNameString(id.Name()).
+						HasSchemaString(id.SchemaName()).
+						HasDatabaseString(id.DatabaseName()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()).
+						HasObjectTypeString(string(sdk.BackupSetObjectKindTable)).
+						HasObjectNameString(table.ID().FullyQualifiedName()).
+						HasBackupPolicyString("").
+						HasCommentString(""),
+					resourceshowoutputassert.BackupSetShowOutput(t, ref).
+						HasCreatedOnNotEmpty().
+						HasName(id.Name()).
+						HasDatabaseName(id.DatabaseName()).
+						HasSchemaName(id.SchemaName()).
+						HasObjectKind(string(sdk.BackupSetObjectKindTable)).
+						HasObjectName(table.ID().Name()).
+						HasObjectDatabaseName(table.ID().DatabaseName()).
+						HasObjectSchemaName(table.ID().SchemaName()).
+						HasBackupPolicyName("").
+						HasOwner(snowflakeroles.Accountadmin.Name()).
+						HasOwnerRoleType("ROLE").
+						HasComment(""),
+				),
+			},
+			// Import without optionals
+			{
+				Config:       config.FromModels(t, basic),
+				ResourceName: ref,
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(
+					t,
+					resourceassert.ImportedBackupSetResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasSchemaString(id.SchemaName()).
+						HasDatabaseString(id.DatabaseName()).
+						HasObjectTypeString(string(sdk.BackupSetObjectKindTable)).
+						HasObjectNameString(table.ID().FullyQualifiedName()).
+						HasCommentString(""),
+				),
+			},
+			// Apply the backup policy and set the comment
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, complete),
+				Check: assertThat(
+					t,
+					resourceassert.BackupSetResource(t, ref).
+						HasBackupPolicyString(backupPolicy.ID().FullyQualifiedName()).
+						HasCommentString(comment),
+					resourceshowoutputassert.BackupSetShowOutput(t, ref).
+						HasBackupPolicyName(backupPolicy.ID().Name()).
+						HasComment(comment),
+				),
+			},
+			// Replace the backup policy and unset the comment
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, completeWithOtherPolicy),
+				Check: assertThat(
+					t,
+					resourceassert.BackupSetResource(t, ref).
+						HasBackupPolicyString(otherBackupPolicy.ID().FullyQualifiedName()).
+						HasCommentString(""),
+					resourceshowoutputassert.BackupSetShowOutput(t, ref).
+						HasBackupPolicyName(otherBackupPolicy.ID().Name()).
+						HasComment(""),
+				),
+			},
+			// Removing the backup policy recreates the backup set
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Config: config.FromModels(t, basic),
+				Check: assertThat(
+					t,
+					resourceassert.BackupSetResource(t, ref).
+						HasBackupPolicyString(""),
+					resourceshowoutputassert.BackupSetShowOutput(t, ref).
+						HasBackupPolicyName(""),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_BackupSet_ForSchema(t *testing.T) {
+	schema, schemaCleanup := testClient().Schema.CreateSchema(t)
+	t.Cleanup(schemaCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	backupSetModel := model.BackupSet("t", id.DatabaseName(), id.SchemaName(), id.Name(), schema.ID().FullyQualifiedName(), "schema")
+	ref := backupSetModel.ResourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.BackupSet),
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, backupSetModel),
+				Check: assertThat(
+					t,
+					resourceassert.BackupSetResource(t, ref).
+						HasObjectTypeString("schema").
+						HasObjectNameString(schema.ID().FullyQualifiedName()),
+					resourceshowoutputassert.BackupSetShowOutput(t, ref).
+						HasObjectKind(string(sdk.BackupSetObjectKindSchema)).
+						HasObjectName(schema.ID().Name()).
+						HasObjectDatabaseName(schema.ID().DatabaseName()),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.0.object_schema_name", "")),
+				),
+			},
+			{
+				Config:       config.FromModels(t, backupSetModel),
+				ResourceName: ref,
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(
+					t,
+					resourceassert.ImportedBackupSetResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasObjectTypeString(string(sdk.BackupSetObjectKindSchema)).
+						HasObjectNameString(schema.ID().FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,264 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/objectassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	acchelpers "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Budget_BasicUseCase(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+
+	providerModel := providermodel.SnowflakeProvider().
+		WithPreviewFeaturesEnabled(string(previewfeatures.BudgetResource))
+
+	basic := model.Budget("test", id.DatabaseName(), id.SchemaName(), id.Name(), 100)
+	complete := model.Budget("test", id.DatabaseName(), id.SchemaName(), id.Name(), 200).
+		WithComment(comment)
+	ref := basic.ResourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Budget),
+		Steps: []resource.TestStep{
+			// Create without optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionCreate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, basic),
+				Check: assertThat(
+					t,
+					resourceassert.BudgetResource(t, ref).
+						HasNameString(id.Name()).
+						HasSchemaString(id.SchemaName()).
+						HasDatabaseString(id.DatabaseName()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()).
+						HasSpendingLimitString("100").
+						HasCommentEmpty().
+						HasNotificationIntegrationEmpty().
+						HasEmailRecipientsEmpty().
+						HasCycleStartActionEmpty(),
+					resourceshowoutputassert.BudgetShowOutput(t, ref).
+						HasName(id.Name()).
+						HasDatabaseName(id.DatabaseName()).
+						HasSchemaName(id.SchemaName()).
+						HasComment(""),
+				),
+			},
+			// Import without optionals
+			{
+				Config:       config.FromModels(t, providerModel, basic),
+				ResourceName: ref,
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(
+					t,
+					resourceassert.ImportedBudgetResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasSchemaString(id.SchemaName()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSpendingLimitString("100").
+						HasNotificationIntegrationEmpty().
+						HasEmailRecipientsEmpty(),
+				),
+			},
+			// Set comment (force new) and spending limit
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, complete),
+				Check: assertThat(
+					t,
+					resourceassert.BudgetResource(t, ref).
+						HasSpendingLimitString("200").
+						HasCommentString(comment),
+					resourceshowoutputassert.BudgetShowOutput(t, ref).
+						HasComment(comment),
+				),
+			},
+			// External change of the spending limit
+			{
+				PreConfig: func() {
+					testClient().Budget.SetSpendingLimit(t, id, 300)
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, complete),
+				Check: assertThat(
+					t,
+					resourceassert.BudgetResource(t, ref).
+						HasSpendingLimitString("200"),
+					objectassert.Budget(t, id).
+						HasComment(comment),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_Budget_EmailNotifications_ExternalChange(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	providerModel := providermodel.SnowflakeProvider().
+		WithPreviewFeaturesEnabled(string(previewfeatures.BudgetResource))
+
+	integration, integrationCleanup := testClient().NotificationIntegration.Create(t)
+	t.Cleanup(integrationCleanup)
+	t.Cleanup(testClient().Grant.GrantUsageOnIntegrationToSnowflakeApplication(t, integration.ID()))
+
+	basic := model.Budget("test", id.DatabaseName(), id.SchemaName(), id.Name(), 100)
+	ref := basic.ResourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Budget),
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, providerModel, basic),
+				Check: assertThat(
+					t,
+					resourceassert.BudgetResource(t, ref).
+						HasNotificationIntegrationEmpty().
+						HasEmailRecipientsEmpty(),
+				),
+			},
+			// External change of the email notifications (they can not be unset, so the budget is recreated)
+			{
+				PreConfig: func() {
+					testClient().Budget.SetEmailNotifications(t, id, integration.ID(), acchelpers.VerifiedEmail)
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, basic),
+				Check: assertThat(
+					t,
+					resourceassert.BudgetResource(t, ref).
+						HasNotificationIntegrationEmpty().
+						HasEmailRecipientsEmpty(),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_Budget_CycleStartAction(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	providerModel := providermodel.SnowflakeProvider().
+		WithPreviewFeaturesEnabled(string(previewfeatures.BudgetResource))
+
+	procedure, procedureCleanup := testClient().Procedure.Create(t, sdk.DataTypeVARCHAR)
+	t.Cleanup(procedureCleanup)
+
+	// the budget calls the procedure as the SNOWFLAKE application
+	t.Cleanup(testClient().Grant.GrantUsageOnDatabaseToSnowflakeApplication(t, procedure.ID().DatabaseId()))
+	t.Cleanup(testClient().Grant.GrantUsageOnSchemaToSnowflakeApplication(t, procedure.ID().SchemaId()))
+	t.Cleanup(testClient().Grant.GrantUsageOnProcedureToSnowflakeApplication(t, procedure.ID()))
+
+	basic := model.Budget("test", id.DatabaseName(), id.SchemaName(), id.Name(), 100)
+	withCycleStartAction := model.Budget("test", id.DatabaseName(), id.SchemaName(), id.Name(), 100).
+		WithCycleStartAction(procedure.ID().FullyQualifiedName(), "abc")
+	ref := basic.ResourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Budget),
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, providerModel, withCycleStartAction),
+				Check: assertThat(
+					t,
+					resourceassert.BudgetResource(t, ref).
+						HasSpendingLimitString("100"),
+					assert.Check(resource.TestCheckResourceAttr(ref, "cycle_start_action.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(ref, "cycle_start_action.0.procedure", procedure.ID().FullyQualifiedName())),
+					assert.Check(resource.TestCheckResourceAttr(ref, "cycle_start_action.0.arguments.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(ref, "cycle_start_action.0.arguments.0", "abc")),
+				),
+			},
+			// External change of the cycle start action arguments
+			{
+				PreConfig: func() {
+					testClient().Budget.SetCycleStartAction(t, id, procedure.ID(), "xyz")
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, withCycleStartAction),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(ref, "cycle_start_action.0.arguments.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(ref, "cycle_start_action.0.arguments.0", "abc")),
+				),
+			},
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, basic),
+				Check: assertThat(
+					t,
+					resourceassert.BudgetResource(t, ref).
+						HasCycleStartActionEmpty(),
+				),
+			},
+			// External change of the cycle start action
+			{
+				PreConfig: func() {
+					testClient().Budget.SetCycleStartAction(t, id, procedure.ID(), "abc")
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, basic),
+				Check: assertThat(
+					t,
+					resourceassert.BudgetResource(t, ref).
+						HasCycleStartActionEmpty(),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,88 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_BudgetTrackedObject_BasicUseCase(t *testing.T) {
+	budgetId, budgetCleanup := testClient().Budget.Create(t)
+	t.Cleanup(budgetCleanup)
+
+	table, tableCleanup := testClient().Table.Create(t)
+	t.Cleanup(tableCleanup)
+
+	providerModel := providermodel.SnowflakeProvider().
+		WithPreviewFeaturesEnabled(string(previewfeatures.BudgetTrackedObjectResource))
+
+	trackedObjectModel := model.BudgetTrackedObject("test", budgetId.FullyQualifiedName(), table.ID().FullyQualifiedName(), "table")
+	ref := trackedObjectModel.ResourceReference()
+
+	expectedId := helpers.EncodeResourceIdentifier(budgetId.FullyQualifiedName(), sdk.ObjectTypeTable.String(), table.ID().FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckBudgetTrackedObjectDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionCreate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, trackedObjectModel),
+				Check: assertThat(
+					t,
+					resourceassert.BudgetTrackedObjectResource(t, ref).
+						HasBudgetNameString(budgetId.FullyQualifiedName()).
+						HasObjectTypeString("table").
+						HasObjectNameString(table.ID().FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(ref, "id", expectedId)),
+				),
+			},
+			{
+				Config:       config.FromModels(t, providerModel, trackedObjectModel),
+				ResourceName: ref,
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(
+					t,
+					resourceassert.ImportedBudgetTrackedObjectResource(t, expectedId).
+						HasBudgetNameString(budgetId.FullyQualifiedName()).
+						HasObjectTypeString(sdk.ObjectTypeTable.String()).
+						HasObjectNameString(table.ID().FullyQualifiedName()),
+				),
+			},
+			// External removal of the object from the budget
+			{
+				PreConfig: testClient().Budget.RemoveResourceFunc(t, budgetId, sdk.ObjectTypeTable, table.ID()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionCreate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, trackedObjectModel),
+				Check: assertThat(
+					t,
+					resourceassert.BudgetTrackedObjectResource(t, ref).
+						HasObjectNameString(table.ID().FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
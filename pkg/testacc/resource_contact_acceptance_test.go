@@ -0,0 +1,178 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_Contact_BasicUseCase(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	newId := testClient().Ids.RandomSchemaObjectIdentifierInSchema(id.SchemaId())
+	currentUser := testClient().Context.CurrentUser(t)
+
+	comment := random.Comment()
+	externalComment := random.Comment()
+	url := "https://example.com/support"
+
+	basic := model.Contact("t", id.DatabaseName(), id.SchemaName(), id.Name()).
+		WithUrl(url)
+
+	complete := model.Contact("t", newId.DatabaseName(), newId.SchemaName(), newId.Name()).
+		WithUsers([]string{currentUser.Name()}).
+		WithComment(comment)
+
+	ref := basic.ResourceReference()
+
+	basicAssertions := []assert.TestCheckFuncProvider{
+		resourceassert.ContactResource(t, ref).
+			HasNameString(id.Name()).
+			HasSchemaString(id.SchemaName()).
+			HasDatabaseString(id.DatabaseName()).
+			HasFullyQualifiedNameString(id.FullyQualifiedName()).
+			HasUrlString(url).
+			HasEmailDistributionListString("").
+			HasUsersEmpty().
+			HasCommentString(""),
+		resourceshowoutputassert.ContactShowOutput(t, ref).
+			HasCreatedOnNotEmpty().
+			HasName(id.Name()).
+			HasDatabaseName(id.DatabaseName()).
+			HasSchemaName(id.SchemaName()).
+			HasOwner(snowflakeroles.Accountadmin.Name()).
+			HasOwnerRoleType("ROLE").
+			HasUrl(url).
+			HasComment(""),
+	}
+
+	completeAssertions := []assert.TestCheckFuncProvider{
+		resourceassert.ContactResource(t, ref).
+			HasNameString(newId.Name()).
+			HasSchemaString(newId.SchemaName()).
+			HasDatabaseString(newId.DatabaseName()).
+			HasFullyQualifiedNameString(newId.FullyQualifiedName()).
+			HasUsers(currentUser.Name()).
+			HasUrlString("").
+			HasEmailDistributionListString("").
+			HasCommentString(comment),
+		resourceshowoutputassert.ContactShowOutput(t, ref).
+			HasCreatedOnNotEmpty().
+			HasName(newId.Name()).
+			HasComment(comment),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Contact),
+		Steps: []resource.TestStep{
+			// Create with the required communication method only
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionCreate),
+					},
+				},
+				Config: config.FromModels(t, basic),
+				Check:  assertThat(t, basicAssertions...),
+			},
+			// Import
+			{
+				Config:       config.FromModels(t, basic),
+				ResourceName: ref,
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(
+					t,
+					resourceassert.ImportedContactResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasSchemaString(id.SchemaName()).
+						HasDatabaseString(id.DatabaseName()).
+						HasUrlString(url).
+						HasCommentString(""),
+				),
+			},
+			// Set comment, change communication method and rename
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, complete),
+				Check:  assertThat(t, completeAssertions...),
+			},
+			// External change is detected
+			{
+				PreConfig: func() {
+					testClient().Contact.Alter(t, sdk.NewAlterContactRequest(newId).WithSet(*sdk.NewContactSetRequest().
+						WithUrl(url).
+						WithComment(externalComment)))
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, complete),
+				Check:  assertThat(t, completeAssertions...),
+			},
+			// Unset comment (back to the basic config with the original name)
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, basic),
+				Check:  assertThat(t, basicAssertions...),
+			},
+		},
+	})
+}
+
+func TestAcc_Contact_EmailDistributionList(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	email := "data-stewards@example.com"
+
+	contactModel := model.Contact("t", id.DatabaseName(), id.SchemaName(), id.Name()).
+		WithEmailDistributionList(email)
+	ref := contactModel.ResourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Contact),
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, contactModel),
+				Check: assertThat(
+					t,
+					resourceassert.ContactResource(t, ref).
+						HasEmailDistributionListString(email).
+						HasUrlString("").
+						HasUsersEmpty(),
+					resourceshowoutputassert.ContactShowOutput(t, ref).
+						HasEmailDistributionList(email),
+				),
+			},
+		},
+	})
+}
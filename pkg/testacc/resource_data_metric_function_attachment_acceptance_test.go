@@ -0,0 +1,207 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/importchecks"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/previewfeatures"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_DataMetricFunctionAttachment_BasicUseCase(t *testing.T) {
+	table, tableCleanup := testClient().Table.CreateWithColumns(t, []sdk.TableColumnRequest{
+		*sdk.NewTableColumnRequest("ID", sdk.DataTypeNumber),
+	})
+	t.Cleanup(tableCleanup)
+
+	functionId := sdk.NewSchemaObjectIdentifier("SNOWFLAKE", "CORE", "NULL_COUNT")
+	columns := []string{"ID"}
+
+	providerModel := providermodel.SnowflakeProvider().
+		WithPreviewFeaturesEnabled(string(previewfeatures.DataMetricFunctionAttachmentResource))
+
+	attachmentModel := model.DataMetricFunctionAttachment(
+		"test",
+		functionId.FullyQualifiedName(),
+		[]sdk.DataMetricFunctionOnObjectSetScheduleRequest{{DataMetricSchedule: "USING CRON 0 8 * * * UTC"}},
+		table.ID().FullyQualifiedName(),
+		"table",
+		columns,
+	)
+	attachmentModelUpdated := model.DataMetricFunctionAttachment(
+		"test",
+		functionId.FullyQualifiedName(),
+		[]sdk.DataMetricFunctionOnObjectSetScheduleRequest{{DataMetricSchedule: "TRIGGER_ON_CHANGES"}},
+		table.ID().FullyQualifiedName(),
+		"table",
+		columns,
+	).WithScheduleStatus(string(sdk.DataMetricScheduleStatusSuspended))
+	ref := attachmentModel.ResourceReference()
+
+	expectedId := helpers.EncodeResourceIdentifier(sdk.ObjectTypeTable.String(), table.ID().FullyQualifiedName(), functionId.FullyQualifiedName(), "ID")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDataMetricFunctionAttachmentDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionCreate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, attachmentModel),
+				Check: assertThat(
+					t,
+					resourceassert.DataMetricFunctionAttachmentResource(t, ref).
+						HasObjectTypeString("table").
+						HasObjectNameString(table.ID().FullyQualifiedName()).
+						HasDataMetricFunctionString(functionId.FullyQualifiedName()).
+						HasScheduleStatusString(string(sdk.DataMetricScheduleStatusStarted)),
+					assert.Check(resource.TestCheckResourceAttr(ref, "id", expectedId)),
+					assert.Check(resource.TestCheckResourceAttr(ref, "on.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(ref, "on.0", "ID")),
+					assert.Check(resource.TestCheckResourceAttr(ref, "data_metric_schedule.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(ref, "data_metric_schedule.0.using_cron", "0 8 * * * UTC")),
+				),
+			},
+			{
+				Config:       config.FromModels(t, providerModel, attachmentModel),
+				ResourceName: ref,
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(
+					t,
+					resourceassert.ImportedDataMetricFunctionAttachmentResource(t, expectedId).
+						HasObjectTypeString(sdk.ObjectTypeTable.String()).
+						HasObjectNameString(table.ID().FullyQualifiedName()).
+						HasDataMetricFunctionString(functionId.FullyQualifiedName()).
+						HasScheduleStatusString(string(sdk.DataMetricScheduleStatusStarted)),
+					assert.CheckImport(importchecks.TestCheckResourceAttrInstanceState(expectedId, "on.#", "1")),
+					assert.CheckImport(importchecks.TestCheckResourceAttrInstanceState(expectedId, "data_metric_schedule.0.using_cron", "0 8 * * * UTC")),
+				),
+			},
+			// Update schedule and status
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, attachmentModelUpdated),
+				Check: assertThat(
+					t,
+					resourceassert.DataMetricFunctionAttachmentResource(t, ref).
+						HasScheduleStatusString(string(sdk.DataMetricScheduleStatusSuspended)),
+					assert.Check(resource.TestCheckResourceAttr(ref, "data_metric_schedule.0.trigger_on_changes", "true")),
+				),
+			},
+			// External status change
+			{
+				PreConfig: func() {
+					testClient().DataMetricFunctionReferences.AlterOnObject(t, sdk.NewAlterOnObjectDataMetricFunctionReferenceRequest(sdk.ObjectTypeTable, table.ID()).
+						WithModify(*sdk.NewDataMetricFunctionOnObjectModifyRequest([]sdk.ViewModifyDataMetricFunction{{
+							DataMetricFunction: functionId,
+							On:                 []sdk.Column{{Value: "ID"}},
+							ViewDataMetricScheduleStatusOperationOption: sdk.ViewDataMetricScheduleStatusOperationOptionResume,
+						}})))
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, attachmentModelUpdated),
+				Check: assertThat(
+					t,
+					resourceassert.DataMetricFunctionAttachmentResource(t, ref).
+						HasScheduleStatusString(string(sdk.DataMetricScheduleStatusSuspended)),
+				),
+			},
+			// External removal of the data metric function
+			{
+				PreConfig: func() {
+					testClient().DataMetricFunctionReferences.AlterOnObject(t, sdk.NewAlterOnObjectDataMetricFunctionReferenceRequest(sdk.ObjectTypeTable, table.ID()).
+						WithDrop(*sdk.NewDataMetricFunctionOnObjectDropRequest([]sdk.ViewDataMetricFunction{{
+							DataMetricFunction: functionId,
+							On:                 []sdk.Column{{Value: "ID"}},
+						}})))
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionCreate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, attachmentModelUpdated),
+				Check: assertThat(
+					t,
+					resourceassert.DataMetricFunctionAttachmentResource(t, ref).
+						HasScheduleStatusString(string(sdk.DataMetricScheduleStatusSuspended)),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_DataMetricFunctionAttachment_View(t *testing.T) {
+	view, viewCleanup := testClient().View.CreateView(t, "SELECT ROLE_NAME, ROLE_OWNER FROM INFORMATION_SCHEMA.APPLICABLE_ROLES")
+	t.Cleanup(viewCleanup)
+
+	functionId := sdk.NewSchemaObjectIdentifier("SNOWFLAKE", "CORE", "BLANK_COUNT")
+
+	providerModel := providermodel.SnowflakeProvider().
+		WithPreviewFeaturesEnabled(string(previewfeatures.DataMetricFunctionAttachmentResource))
+
+	attachmentModel := model.DataMetricFunctionAttachment(
+		"test",
+		functionId.FullyQualifiedName(),
+		[]sdk.DataMetricFunctionOnObjectSetScheduleRequest{{DataMetricSchedule: "5 MINUTE"}},
+		view.ID().FullyQualifiedName(),
+		"view",
+		[]string{"ROLE_NAME"},
+	)
+	ref := attachmentModel.ResourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDataMetricFunctionAttachmentDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, providerModel, attachmentModel),
+				Check: assertThat(
+					t,
+					resourceassert.DataMetricFunctionAttachmentResource(t, ref).
+						HasObjectTypeString("view").
+						HasObjectNameString(view.ID().FullyQualifiedName()).
+						HasDataMetricFunctionString(functionId.FullyQualifiedName()).
+						HasScheduleStatusString(string(sdk.DataMetricScheduleStatusStarted)),
+					assert.Check(resource.TestCheckResourceAttr(ref, "data_metric_schedule.0.minutes", "5")),
+				),
+			},
+			{
+				Config: config.FromModels(t, providerModel, attachmentModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
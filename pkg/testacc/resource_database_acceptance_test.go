@@ -1357,3 +1357,89 @@ func databaseConfigBasicWithExternalVolumeAndCatalog(databaseName string, extern
 		catalog = "%v"
 	}`, databaseName, externalVolumeName, catalogName)
 }
+
+func TestAcc_Database_EventTable(t *testing.T) {
+	eventTable, eventTableCleanup := testClient().EventTable.Create(t)
+	t.Cleanup(eventTableCleanup)
+	otherEventTable, otherEventTableCleanup := testClient().EventTable.Create(t)
+	t.Cleanup(otherEventTableCleanup)
+
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+
+	basic := model.Database("test", id.Name())
+	withEventTable := model.Database("test", id.Name()).
+		WithEventTable(eventTable.ID().FullyQualifiedName())
+	withOtherEventTable := model.Database("test", id.Name()).
+		WithEventTable(otherEventTable.ID().FullyQualifiedName())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Database),
+		Steps: []resource.TestStep{
+			// Create with event table
+			{
+				Config: accconfig.FromModels(t, withEventTable),
+				Check: assertThat(
+					t,
+					resourceassert.DatabaseResource(t, withEventTable.ResourceReference()).
+						HasEventTableString(eventTable.ID().FullyQualifiedName()),
+				),
+			},
+			// Import
+			{
+				Config:            accconfig.FromModels(t, withEventTable),
+				ResourceName:      withEventTable.ResourceReference(),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Change event table
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(withOtherEventTable.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: accconfig.FromModels(t, withOtherEventTable),
+				Check: assertThat(
+					t,
+					resourceassert.DatabaseResource(t, withOtherEventTable.ResourceReference()).
+						HasEventTableString(otherEventTable.ID().FullyQualifiedName()),
+				),
+			},
+			// External change is detected
+			{
+				PreConfig: func() {
+					testClient().Database.Alter(t, sdk.NewAlterDatabaseRequest(id).WithUnset(*sdk.NewDatabaseUnsetRequest().WithEventTable(true)))
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(withOtherEventTable.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: accconfig.FromModels(t, withOtherEventTable),
+				Check: assertThat(
+					t,
+					resourceassert.DatabaseResource(t, withOtherEventTable.ResourceReference()).
+						HasEventTableString(otherEventTable.ID().FullyQualifiedName()),
+				),
+			},
+			// Unset event table
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(basic.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: accconfig.FromModels(t, basic),
+				Check: assertThat(
+					t,
+					resourceassert.DatabaseResource(t, basic.ResourceReference()).
+						HasEventTableString(""),
+				),
+			},
+		},
+	})
+}
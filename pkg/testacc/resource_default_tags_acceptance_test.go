@@ -0,0 +1,257 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testdatatypes"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_DefaultTags_Database(t *testing.T) {
+	defaultTag, defaultTagCleanup := testClient().Tag.CreateTag(t)
+	t.Cleanup(defaultTagCleanup)
+	resourceTag, resourceTagCleanup := testClient().Tag.CreateTag(t)
+	t.Cleanup(resourceTagCleanup)
+
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	defaultTagKey := fmt.Sprintf("tags_all.%s", defaultTag.ID().FullyQualifiedName())
+	resourceTagKey := fmt.Sprintf("tags_all.%s", resourceTag.ID().FullyQualifiedName())
+
+	providerModel := providermodel.SnowflakeProvider().
+		WithDefaultTags(map[string]string{defaultTag.ID().FullyQualifiedName(): "default"})
+
+	databaseWithoutTags := model.Database("test", id.Name())
+	databaseWithTags := model.Database("test", id.Name()).
+		WithTags(map[string]string{resourceTag.ID().FullyQualifiedName(): "resource"})
+	databaseOverridingDefaultTag := model.Database("test", id.Name()).
+		WithTags(map[string]string{
+			defaultTag.ID().FullyQualifiedName():  "overridden",
+			resourceTag.ID().FullyQualifiedName(): "resource",
+		})
+	ref := databaseWithTags.ResourceReference()
+
+	assertTagValueInSnowflake := func(tagId sdk.SchemaObjectIdentifier, expected *string) assert.TestCheckFuncProvider {
+		return assert.Check(func(_ *terraform.State) error {
+			value, err := testClient().Tag.GetForObject(t, tagId, id, sdk.ObjectTypeDatabase)
+			if err != nil {
+				return err
+			}
+			switch {
+			case expected == nil && value != nil:
+				return fmt.Errorf("expected tag %s to be unset, got %s", tagId.FullyQualifiedName(), *value)
+			case expected != nil && (value == nil || *value != *expected):
+				return fmt.Errorf("expected tag %s to have value %s, got %v", tagId.FullyQualifiedName(), *expected, value)
+			}
+			return nil
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: defaultTagsProviderFactory,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Database),
+		Steps: []resource.TestStep{
+			// Create with default and resource tags
+			{
+				Config: config.FromModels(t, providerModel, databaseWithTags),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(ref, "tags_all.%", "2")),
+					assert.Check(resource.TestCheckResourceAttr(ref, defaultTagKey, "default")),
+					assert.Check(resource.TestCheckResourceAttr(ref, resourceTagKey, "resource")),
+					assertTagValueInSnowflake(defaultTag.ID(), sdk.String("default")),
+					assertTagValueInSnowflake(resourceTag.ID(), sdk.String("resource")),
+				),
+			},
+			// Resource tags take precedence over the default tags
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, databaseOverridingDefaultTag),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(ref, "tags_all.%", "2")),
+					assert.Check(resource.TestCheckResourceAttr(ref, defaultTagKey, "overridden")),
+					assertTagValueInSnowflake(defaultTag.ID(), sdk.String("overridden")),
+				),
+			},
+			// External change is detected
+			{
+				PreConfig: func() {
+					testClient().Tag.Set(t, sdk.ObjectTypeDatabase, id, []sdk.TagAssociation{{Name: resourceTag.ID(), Value: "external"}})
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, databaseOverridingDefaultTag),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(ref, resourceTagKey, "resource")),
+					assertTagValueInSnowflake(resourceTag.ID(), sdk.String("resource")),
+				),
+			},
+			// Removing the resource tags unsets them and restores the default tags
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, databaseWithoutTags),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(ref, "tags_all.%", "1")),
+					assert.Check(resource.TestCheckResourceAttr(ref, defaultTagKey, "default")),
+					assertTagValueInSnowflake(defaultTag.ID(), sdk.String("default")),
+					assertTagValueInSnowflake(resourceTag.ID(), nil),
+				),
+			},
+			// No changes are planned
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Config: config.FromModels(t, providerModel, databaseWithoutTags),
+			},
+		},
+	})
+}
+
+func TestAcc_DefaultTags_Table(t *testing.T) {
+	defaultTag, defaultTagCleanup := testClient().Tag.CreateTag(t)
+	t.Cleanup(defaultTagCleanup)
+	resourceTag, resourceTagCleanup := testClient().Tag.CreateTag(t)
+	t.Cleanup(resourceTagCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	columns := []sdk.TableColumnSignature{{Name: "ID", Type: testdatatypes.DataTypeNumber}}
+	defaultTagKey := fmt.Sprintf("tags_all.%s", defaultTag.ID().FullyQualifiedName())
+	resourceTagKey := fmt.Sprintf("tags_all.%s", resourceTag.ID().FullyQualifiedName())
+
+	providerModel := providermodel.SnowflakeProvider().
+		WithDefaultTags(map[string]string{defaultTag.ID().FullyQualifiedName(): "default"})
+
+	tableWithoutTags := model.TableWithId("test", id, columns)
+	tableWithTags := model.TableWithId("test", id, columns).
+		WithTags(map[string]string{resourceTag.ID().FullyQualifiedName(): "resource"})
+	tableWithTagsAndLegacyTag := model.TableWithId("test", id, columns).
+		WithTags(map[string]string{resourceTag.ID().FullyQualifiedName(): "resource"}).
+		WithLegacyTag(resourceTag.ID(), "legacy")
+	tableWithDefaultTagInLegacyTag := model.TableWithId("test", id, columns).
+		WithLegacyTag(defaultTag.ID(), "legacy")
+	ref := tableWithTags.ResourceReference()
+
+	assertTagValueInSnowflake := func(tagId sdk.SchemaObjectIdentifier, expected *string) assert.TestCheckFuncProvider {
+		return assert.Check(func(_ *terraform.State) error {
+			value, err := testClient().Tag.GetForObject(t, tagId, id, sdk.ObjectTypeTable)
+			if err != nil {
+				return err
+			}
+			switch {
+			case expected == nil && value != nil:
+				return fmt.Errorf("expected tag %s to be unset, got %s", tagId.FullyQualifiedName(), *value)
+			case expected != nil && (value == nil || *value != *expected):
+				return fmt.Errorf("expected tag %s to have value %s, got %v", tagId.FullyQualifiedName(), *expected, value)
+			}
+			return nil
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: defaultTagsProviderFactory,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Table),
+		Steps: []resource.TestStep{
+			// Create with default and resource tags
+			{
+				Config: config.FromModels(t, providerModel, tableWithTags),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(ref, "tags_all.%", "2")),
+					assert.Check(resource.TestCheckResourceAttr(ref, defaultTagKey, "default")),
+					assert.Check(resource.TestCheckResourceAttr(ref, resourceTagKey, "resource")),
+					assertTagValueInSnowflake(defaultTag.ID(), sdk.String("default")),
+					assertTagValueInSnowflake(resourceTag.ID(), sdk.String("resource")),
+				),
+			},
+			// External change is detected
+			{
+				PreConfig: func() {
+					testClient().Tag.Set(t, sdk.ObjectTypeTable, id, []sdk.TagAssociation{{Name: resourceTag.ID(), Value: "external"}})
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, tableWithTags),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(ref, resourceTagKey, "resource")),
+					assertTagValueInSnowflake(resourceTag.ID(), sdk.String("resource")),
+				),
+			},
+			// The deprecated tag attribute cannot be used together with tags
+			{
+				Config:      config.FromModels(t, providerModel, tableWithTagsAndLegacyTag),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`"tag": conflicts with tags`),
+			},
+			// The deprecated tag attribute cannot hold the default tags
+			{
+				Config:      config.FromModels(t, providerModel, tableWithDefaultTagInLegacyTag),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`is set both in the provider's default_tags and in the deprecated tag attribute`),
+			},
+			// Removing the resource tags unsets them and keeps the default tags
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, tableWithoutTags),
+				Check: assertThat(
+					t,
+					assert.Check(resource.TestCheckResourceAttr(ref, "tags_all.%", "1")),
+					assert.Check(resource.TestCheckResourceAttr(ref, defaultTagKey, "default")),
+					assertTagValueInSnowflake(defaultTag.ID(), sdk.String("default")),
+					assertTagValueInSnowflake(resourceTag.ID(), nil),
+				),
+			},
+			// No changes are planned
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Config: config.FromModels(t, providerModel, tableWithoutTags),
+			},
+		},
+	})
+}
@@ -0,0 +1,220 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_EventTable_BasicUseCase(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	comment := random.Comment()
+
+	basic := model.EventTable("test", id.DatabaseName(), id.SchemaName(), id.Name())
+
+	complete := model.EventTable("test", id.DatabaseName(), id.SchemaName(), id.Name()).
+		WithClusterBy("TIMESTAMP").
+		WithChangeTracking(r.BooleanTrue).
+		WithDataRetentionTimeInDays(2).
+		WithMaxDataExtensionTimeInDays(10).
+		WithComment(comment)
+
+	ref := basic.ResourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.EventTable),
+		Steps: []resource.TestStep{
+			// Create without optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionCreate),
+					},
+				},
+				Config: config.FromModels(t, basic),
+				Check: assertThat(
+					t,
+					resourceassert.EventTableResource(t, ref).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()).
+						HasChangeTrackingString(r.BooleanDefault).
+						HasCommentString("").
+						HasDataRetentionTimeInDaysString("1").
+						HasMaxDataExtensionTimeInDaysString("1"),
+					resourceshowoutputassert.EventTableShowOutput(t, ref).
+						HasCreatedOnNotEmpty().
+						HasName(id.Name()).
+						HasDatabaseName(id.DatabaseName()).
+						HasSchemaName(id.SchemaName()).
+						HasOwner(snowflakeroles.Accountadmin.Name()).
+						HasOwnerRoleType("ROLE").
+						HasComment(""),
+					assert.Check(resource.TestCheckResourceAttr(ref, "cluster_by.#", "0")),
+					assert.Check(resource.TestCheckResourceAttr(ref, "describe_output.0.name", "TIMESTAMP")),
+				),
+			},
+			// Import without optionals
+			{
+				Config:                  config.FromModels(t, basic),
+				ResourceName:            ref,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"change_tracking"},
+			},
+			// Set all optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, complete),
+				Check: assertThat(
+					t,
+					resourceassert.EventTableResource(t, ref).
+						HasNameString(id.Name()).
+						HasClusterBy("TIMESTAMP").
+						HasChangeTrackingString(r.BooleanTrue).
+						HasDataRetentionTimeInDaysString("2").
+						HasMaxDataExtensionTimeInDaysString("10").
+						HasCommentString(comment),
+					resourceshowoutputassert.EventTableShowOutput(t, ref).
+						HasComment(comment),
+				),
+			},
+			// Import with optionals
+			{
+				Config:            config.FromModels(t, complete),
+				ResourceName:      ref,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// External change of the parameter is detected
+			{
+				PreConfig: func() {
+					testClient().EventTable.Alter(t, sdk.NewAlterEventTableRequest(id).WithSet(*sdk.NewEventTableSetRequest().WithDataRetentionTimeInDays(5)))
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, complete),
+				Check: assertThat(
+					t,
+					resourceassert.EventTableResource(t, ref).
+						HasDataRetentionTimeInDaysString("2"),
+				),
+			},
+			// External change of the properties is detected
+			{
+				PreConfig: func() {
+					testClient().EventTable.Alter(t, sdk.NewAlterEventTableRequest(id).WithSet(*sdk.NewEventTableSetRequest().WithChangeTracking(false).WithComment(random.Comment())))
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, complete),
+				Check: assertThat(
+					t,
+					resourceassert.EventTableResource(t, ref).
+						HasChangeTrackingString(r.BooleanTrue).
+						HasCommentString(comment),
+				),
+			},
+			// Unset optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, basic),
+				Check: assertThat(
+					t,
+					resourceassert.EventTableResource(t, ref).
+						HasChangeTrackingString(r.BooleanDefault).
+						HasDataRetentionTimeInDaysString("1").
+						HasMaxDataExtensionTimeInDaysString("1").
+						HasCommentString(""),
+					assert.Check(resource.TestCheckResourceAttr(ref, "cluster_by.#", "0")),
+				),
+			},
+			// External deletion is detected
+			{
+				PreConfig: func() {
+					testClient().EventTable.DropFunc(t, id)()
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionCreate),
+					},
+				},
+				Config: config.FromModels(t, basic),
+			},
+		},
+	})
+}
+
+func TestAcc_EventTable_Rename(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	newId := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	before := model.EventTable("test", id.DatabaseName(), id.SchemaName(), id.Name())
+	after := model.EventTable("test", newId.DatabaseName(), newId.SchemaName(), newId.Name())
+	ref := before.ResourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.EventTable),
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, before),
+				Check: assertThat(
+					t,
+					resourceassert.EventTableResource(t, ref).
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+				),
+			},
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, after),
+				Check: assertThat(
+					t,
+					resourceassert.EventTableResource(t, ref).
+						HasNameString(newId.Name()).
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()),
+				),
+			},
+		},
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
@@ -58,7 +59,7 @@ func TestAcc_FailoverGroupBasic(t *testing.T) {
 				ResourceName:            "snowflake_failover_group.fg",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ignore_edition_check"},
+				ImportStateVerifyIgnore: []string{"ignore_edition_check", "refresh_after_apply"},
 			},
 		},
 	})
@@ -212,7 +213,7 @@ func TestAcc_FailoverGroupInterval(t *testing.T) {
 				ResourceName:            "snowflake_failover_group.fg",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"ignore_edition_check"},
+				ImportStateVerifyIgnore: []string{"ignore_edition_check", "refresh_after_apply"},
 			},
 		},
 	})
@@ -522,3 +523,79 @@ resource "snowflake_failover_group" "fg" {
 }
 `, name, objectTypes)
 }
+
+func TestAcc_FailoverGroup_PromotionWithPrimaryAccount(t *testing.T) {
+	// TODO [SNOW-1002023]: Unskip; Business Critical Snowflake Edition needed
+	_ = testenvs.GetOrSkipTest(t, testenvs.TestFailoverGroups)
+
+	accountId := testClient().Account.GetAccountIdentifier(t)
+	sourceAccountId := secondaryTestClient().Account.GetAccountIdentifier(t)
+
+	primaryFailoverGroup, primaryFailoverGroupCleanup := secondaryTestClient().FailoverGroup.CreateWithRequest(t, sdk.NewCreateFailoverGroupRequest(
+		secondaryTestClient().Ids.RandomAccountObjectIdentifier(),
+		[]sdk.PluralObjectType{sdk.PluralObjectTypeDatabases},
+		[]sdk.AccountIdentifier{accountId},
+	))
+	t.Cleanup(primaryFailoverGroupCleanup)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.FailoverGroup),
+		Steps: []resource.TestStep{
+			// create the secondary failover group and refresh it
+			{
+				Config: failoverGroupFromReplicaWithPrimaryAccount(primaryFailoverGroup.ID(), sourceAccountId, sourceAccountId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_failover_group.fg", "name", primaryFailoverGroup.ID().Name()),
+					resource.TestCheckResourceAttr("snowflake_failover_group.fg", "refresh_after_apply", "true"),
+					resource.TestCheckResourceAttr("snowflake_failover_group.fg", "primary_account", sourceAccountId.Name()),
+				),
+			},
+			// promote the failover group in the current account without recreating it
+			{
+				Config: failoverGroupFromReplicaWithPrimaryAccount(primaryFailoverGroup.ID(), sourceAccountId, accountId),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_failover_group.fg", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_failover_group.fg", "primary_account", accountId.Name()),
+				),
+			},
+			// fail back in the source account; the state follows without recreating the resource
+			{
+				PreConfig: func() {
+					secondaryTestClient().FailoverGroup.Promote(t, primaryFailoverGroup.ID())
+				},
+				Config: failoverGroupFromReplicaWithPrimaryAccount(primaryFailoverGroup.ID(), sourceAccountId, sourceAccountId),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_failover_group.fg", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_failover_group.fg", "primary_account", sourceAccountId.Name()),
+				),
+			},
+		},
+	})
+}
+
+func failoverGroupFromReplicaWithPrimaryAccount(primaryFailoverGroupId sdk.AccountObjectIdentifier, sourceAccountId sdk.AccountIdentifier, primaryAccountId sdk.AccountIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_failover_group" "fg" {
+	name = "%[1]s"
+	from_replica {
+		organization_name   = "%[2]s"
+		source_account_name = "%[3]s"
+		name                = "%[1]s"
+	}
+	primary_account     = "%[4]s"
+	refresh_after_apply = true
+}
+`, primaryFailoverGroupId.Name(), sourceAccountId.OrganizationName(), sourceAccountId.AccountName(), primaryAccountId.Name())
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
 	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
@@ -404,3 +405,60 @@ func TestAcc_GitRepository_CompleteUseCase(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_GitRepository_FetchTrigger(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	origin := testvars.ExampleGitRepositoryOrigin
+
+	apiIntegrationId, apiIntegrationCleanup := testClient().ApiIntegration.CreateGitTokenWithAllowedOrigin(t, origin)
+	t.Cleanup(apiIntegrationCleanup)
+
+	modelWithTrigger := model.
+		GitRepository("test", id.DatabaseName(), id.SchemaName(), id.Name(), apiIntegrationId.FullyQualifiedName(), origin).
+		WithFetchTrigger("master")
+	modelWithChangedTrigger := model.
+		GitRepository("test", id.DatabaseName(), id.SchemaName(), id.Name(), apiIntegrationId.FullyQualifiedName(), origin).
+		WithFetchTrigger("test")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.GitRepository),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, modelWithTrigger),
+				Check: assertThat(
+					t,
+					resourceassert.GitRepositoryResource(t, modelWithTrigger.ResourceReference()).
+						HasFetchTriggerString("master"),
+				),
+			},
+			// changing the trigger fetches the repository
+			{
+				Config: accconfig.FromModels(t, modelWithChangedTrigger),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(modelWithChangedTrigger.ResourceReference(), plancheck.ResourceActionUpdate),
+						plancheck.ExpectUnknownValue(modelWithChangedTrigger.ResourceReference(), tfjsonpath.New("show_output")),
+					},
+				},
+				Check: assertThat(
+					t,
+					resourceassert.GitRepositoryResource(t, modelWithChangedTrigger.ResourceReference()).
+						HasFetchTriggerString("test"),
+					assert.Check(resource.TestCheckResourceAttrSet(modelWithChangedTrigger.ResourceReference(), "show_output.0.last_fetched_at")),
+				),
+			},
+			// the trigger is not stored in Snowflake
+			{
+				Config:                  accconfig.FromModels(t, modelWithChangedTrigger),
+				ResourceName:            modelWithChangedTrigger.ResourceReference(),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"fetch_trigger"},
+			},
+		},
+	})
+}
@@ -0,0 +1,128 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"testing"
+
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_GrantCallerPrivileges_BasicUseCase_OnObject(t *testing.T) {
+	role, roleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+
+	table, tableCleanup := testClient().Table.Create(t)
+	t.Cleanup(tableCleanup)
+
+	grantModel := model.GrantCallerPrivileges("test").
+		WithAccountRoleName(role.ID().Name()).
+		WithPrivileges(string(sdk.SchemaObjectPrivilegeSelect)).
+		WithOnObject(sdk.ObjectTypeTable, table.ID().FullyQualifiedName())
+	grantModelUpdated := model.GrantCallerPrivileges("test").
+		WithAccountRoleName(role.ID().Name()).
+		WithPrivileges(string(sdk.SchemaObjectPrivilegeSelect), string(sdk.SchemaObjectPrivilegeInsert)).
+		WithOnObject(sdk.ObjectTypeTable, table.ID().FullyQualifiedName())
+
+	resourceName := grantModel.ResourceReference()
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckCallerPrivilegesRevoked(t),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, grantModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "account_role_name", role.ID().Name()),
+					resource.TestCheckResourceAttr(resourceName, "privileges.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "privileges.*", string(sdk.SchemaObjectPrivilegeSelect)),
+					resource.TestCheckResourceAttr(resourceName, "on_object.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on_object.0.object_type", string(sdk.ObjectTypeTable)),
+					resource.TestCheckResourceAttr(resourceName, "on_object.0.object_name", table.ID().FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("AccountRole|%s|SELECT|OnObject|TABLE|%s", role.ID().FullyQualifiedName(), table.ID().FullyQualifiedName())),
+				),
+			},
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: accconfig.FromModels(t, grantModelUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "privileges.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "privileges.*", string(sdk.SchemaObjectPrivilegeSelect)),
+					resource.TestCheckTypeSetElemAttr(resourceName, "privileges.*", string(sdk.SchemaObjectPrivilegeInsert)),
+				),
+			},
+			// external revoke is detected and granted back
+			{
+				PreConfig: func() {
+					testClient().Grant.RevokeCallerPrivilegesFromAccountRole(t, role.ID(), sdk.Object{ObjectType: sdk.ObjectTypeTable, Name: table.ID()}, sdk.ObjectPrivilege(sdk.SchemaObjectPrivilegeInsert))
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: accconfig.FromModels(t, grantModelUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "privileges.#", "2"),
+				),
+			},
+			{
+				Config:            accconfig.FromModels(t, grantModelUpdated),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAcc_GrantCallerPrivileges_Inherited_InSchema(t *testing.T) {
+	role, roleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+
+	schemaId := testClient().Ids.SchemaId()
+
+	grantModel := model.GrantCallerPrivileges("test").
+		WithAccountRoleName(role.ID().Name()).
+		WithPrivileges(string(sdk.SchemaObjectPrivilegeSelect)).
+		WithInheritedInSchema(sdk.PluralObjectTypeTables, schemaId.FullyQualifiedName())
+
+	resourceName := grantModel.ResourceReference()
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckCallerPrivilegesRevoked(t),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, grantModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "privileges.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inherited.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inherited.0.object_type_plural", string(sdk.PluralObjectTypeTables)),
+					resource.TestCheckResourceAttr(resourceName, "inherited.0.in_schema", schemaId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("AccountRole|%s|SELECT|Inherited|TABLES|InSchema|%s", role.ID().FullyQualifiedName(), schemaId.FullyQualifiedName())),
+				),
+			},
+			{
+				Config:            accconfig.FromModels(t, grantModel),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,97 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_GrantPrivilegesMatrix_BasicUseCase(t *testing.T) {
+	role, roleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+
+	otherRole, otherRoleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(otherRoleCleanup)
+
+	table, tableCleanup := testClient().Table.Create(t)
+	t.Cleanup(tableCleanup)
+
+	databaseId := testClient().Ids.DatabaseId()
+	schemaId := testClient().Ids.SchemaId()
+
+	matrixModel := model.GrantPrivilegesMatrix("test", []model.GrantPrivilegesMatrixEntry{
+		{AccountRoleName: role.ID().Name(), Privileges: []string{string(sdk.SchemaObjectPrivilegeSelect), string(sdk.SchemaObjectPrivilegeInsert)}, ObjectType: sdk.ObjectTypeTable, ObjectName: table.ID().FullyQualifiedName()},
+		{AccountRoleName: role.ID().Name(), Privileges: []string{string(sdk.AccountObjectPrivilegeUsage)}, ObjectType: sdk.ObjectTypeDatabase, ObjectName: databaseId.FullyQualifiedName()},
+		{AccountRoleName: otherRole.ID().Name(), Privileges: []string{string(sdk.SchemaPrivilegeUsage)}, ObjectType: sdk.ObjectTypeSchema, ObjectName: schemaId.FullyQualifiedName()},
+	})
+	matrixModelUpdated := model.GrantPrivilegesMatrix("test", []model.GrantPrivilegesMatrixEntry{
+		{AccountRoleName: role.ID().Name(), Privileges: []string{string(sdk.SchemaObjectPrivilegeSelect)}, ObjectType: sdk.ObjectTypeTable, ObjectName: table.ID().FullyQualifiedName()},
+		{AccountRoleName: otherRole.ID().Name(), Privileges: []string{string(sdk.SchemaPrivilegeUsage)}, ObjectType: sdk.ObjectTypeSchema, ObjectName: schemaId.FullyQualifiedName()},
+		{AccountRoleName: otherRole.ID().Name(), Privileges: []string{string(sdk.SchemaObjectPrivilegeSelect), string(sdk.SchemaObjectPrivilegeUpdate)}, ObjectType: sdk.ObjectTypeTable, ObjectName: table.ID().FullyQualifiedName()},
+	})
+
+	resourceName := matrixModel.ResourceReference()
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckGrantPrivilegesMatrixRevoked(t),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, matrixModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "entry.0.privileges.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "entry.0.status", string(r.GrantPrivilegesMatrixEntryStatusGranted)),
+					resource.TestCheckResourceAttr(resourceName, "entry.1.privileges.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entry.1.status", string(r.GrantPrivilegesMatrixEntryStatusGranted)),
+					resource.TestCheckResourceAttr(resourceName, "entry.2.privileges.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entry.2.status", string(r.GrantPrivilegesMatrixEntryStatusGranted)),
+				),
+			},
+			// privileges revoked outside of Terraform are detected and granted back
+			{
+				PreConfig: func() {
+					testClient().Grant.RevokePrivilegesOnSchemaObjectFromAccountRole(t, role.ID(), sdk.ObjectTypeTable, table.ID(), []sdk.SchemaObjectPrivilege{sdk.SchemaObjectPrivilegeInsert})
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: accconfig.FromModels(t, matrixModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "entry.0.privileges.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "entry.0.status", string(r.GrantPrivilegesMatrixEntryStatusGranted)),
+				),
+			},
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: accconfig.FromModels(t, matrixModelUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "entry.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "entry.0.privileges.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entry.0.status", string(r.GrantPrivilegesMatrixEntryStatusGranted)),
+					resource.TestCheckResourceAttr(resourceName, "entry.1.privileges.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entry.1.status", string(r.GrantPrivilegesMatrixEntryStatusGranted)),
+					resource.TestCheckResourceAttr(resourceName, "entry.2.privileges.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "entry.2.status", string(r.GrantPrivilegesMatrixEntryStatusGranted)),
+				),
+			},
+		},
+	})
+}
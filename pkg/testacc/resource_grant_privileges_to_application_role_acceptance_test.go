@@ -0,0 +1,153 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"fmt"
+	"testing"
+
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testdatatypes"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testvars"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_GrantPrivilegesToApplicationRole_BasicUseCase_OnAccountObject(t *testing.T) {
+	app := createApp(t)
+	applicationRoleId := sdk.NewDatabaseObjectIdentifier(app.Name, testvars.ApplicationRole1)
+	databaseId := testClient().Ids.DatabaseId()
+
+	grantModel := model.GrantPrivilegesToApplicationRole("test", applicationRoleId.FullyQualifiedName()).
+		WithPrivileges(string(sdk.AccountObjectPrivilegeUsage)).
+		WithOnAccountObject(sdk.ObjectTypeDatabase, databaseId)
+	grantModelUpdated := model.GrantPrivilegesToApplicationRole("test", applicationRoleId.FullyQualifiedName()).
+		WithPrivileges(string(sdk.AccountObjectPrivilegeCreateSchema), string(sdk.AccountObjectPrivilegeUsage)).
+		WithOnAccountObject(sdk.ObjectTypeDatabase, databaseId)
+
+	resourceName := grantModel.ResourceReference()
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckApplicationRolePrivilegesRevoked(t),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, grantModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "application_role_name", applicationRoleId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "privileges.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "privileges.*", string(sdk.AccountObjectPrivilegeUsage)),
+					resource.TestCheckResourceAttr(resourceName, "on_account_object.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on_account_object.0.object_type", string(sdk.ObjectTypeDatabase)),
+					resource.TestCheckResourceAttr(resourceName, "on_account_object.0.object_name", databaseId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "with_grant_option", "false"),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s|false|false|USAGE|OnAccountObject|DATABASE|%s", applicationRoleId.FullyQualifiedName(), databaseId.FullyQualifiedName())),
+				),
+			},
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: accconfig.FromModels(t, grantModelUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "privileges.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "privileges.*", string(sdk.AccountObjectPrivilegeCreateSchema)),
+					resource.TestCheckTypeSetElemAttr(resourceName, "privileges.*", string(sdk.AccountObjectPrivilegeUsage)),
+				),
+			},
+			{
+				Config:            accconfig.FromModels(t, grantModelUpdated),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAcc_GrantPrivilegesToApplicationRole_BasicUseCase_OnSchemaObject_OnObject(t *testing.T) {
+	app := createApp(t)
+	applicationRoleId := sdk.NewDatabaseObjectIdentifier(app.Name, testvars.ApplicationRole1)
+	tableId := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	tableModel := model.TableWithId("test", tableId, []sdk.TableColumnSignature{
+		{Name: "id", Type: testdatatypes.DataTypeNumber_38_0},
+	})
+	grantModel := model.GrantPrivilegesToApplicationRole("test", applicationRoleId.FullyQualifiedName()).
+		WithPrivileges(string(sdk.SchemaObjectPrivilegeSelect)).
+		WithOnSchemaObjectObject(sdk.ObjectTypeTable, tableId.FullyQualifiedName()).
+		WithDependsOn(tableModel.ResourceReference())
+
+	resourceName := grantModel.ResourceReference()
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckApplicationRolePrivilegesRevoked(t),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, tableModel, grantModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "application_role_name", applicationRoleId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "privileges.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "privileges.*", string(sdk.SchemaObjectPrivilegeSelect)),
+					resource.TestCheckResourceAttr(resourceName, "on_schema_object.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on_schema_object.0.object_type", string(sdk.ObjectTypeTable)),
+					resource.TestCheckResourceAttr(resourceName, "on_schema_object.0.object_name", tableId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s|false|false|SELECT|OnSchemaObject|OnObject|TABLE|%s", applicationRoleId.FullyQualifiedName(), tableId.FullyQualifiedName())),
+				),
+			},
+			{
+				Config:            accconfig.FromModels(t, tableModel, grantModel),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAcc_GrantPrivilegesToApplicationRole_OnFutureSchemaObjects(t *testing.T) {
+	app := createApp(t)
+	applicationRoleId := sdk.NewDatabaseObjectIdentifier(app.Name, testvars.ApplicationRole1)
+	schemaId := testClient().Ids.SchemaId()
+
+	grantModel := model.GrantPrivilegesToApplicationRole("test", applicationRoleId.FullyQualifiedName()).
+		WithPrivileges(string(sdk.SchemaObjectPrivilegeSelect)).
+		WithOnSchemaObjectFutureInSchema(sdk.PluralObjectTypeTables, schemaId.FullyQualifiedName())
+
+	resourceName := grantModel.ResourceReference()
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckApplicationRolePrivilegesRevoked(t),
+		Steps: []resource.TestStep{
+			{
+				Config: accconfig.FromModels(t, grantModel),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "privileges.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on_schema_object.0.future.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "on_schema_object.0.future.0.object_type_plural", string(sdk.PluralObjectTypeTables)),
+					resource.TestCheckResourceAttr(resourceName, "on_schema_object.0.future.0.in_schema", schemaId.FullyQualifiedName()),
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("%s|false|false|SELECT|OnSchemaObject|OnFuture|TABLES|InSchema|%s", applicationRoleId.FullyQualifiedName(), schemaId.FullyQualifiedName())),
+				),
+			},
+			{
+				Config:            accconfig.FromModels(t, grantModel),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
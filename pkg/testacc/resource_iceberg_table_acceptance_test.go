@@ -864,6 +864,99 @@ func TestAcc_IcebergTable_CompleteUseCase(t *testing.T) {
 	})
 }
 
+func TestAcc_IcebergTable_JoinPolicy(t *testing.T) {
+	awsBaseUrl := testenvs.GetOrSkipTest(t, testenvs.AwsExternalBucketUrl)
+	awsKeyId := testenvs.GetOrSkipTest(t, testenvs.AwsExternalKeyId)
+	awsSecretKey := testenvs.GetOrSkipTest(t, testenvs.AwsExternalSecretKey)
+
+	s3CompatBaseUrl := strings.Replace(awsBaseUrl, "s3://", "s3compat://", 1)
+	s3CompatEndpoint := "s3.us-west-2.amazonaws.com"
+
+	externalVolumeId, externalVolumeCleanup := testClient().ExternalVolume.CreateS3Compat(t, s3CompatBaseUrl, s3CompatEndpoint, awsKeyId, awsSecretKey)
+	t.Cleanup(externalVolumeCleanup)
+
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	columns := []sdk.TableColumnSignature{
+		{Name: "ID", Type: testdatatypes.DataTypeNumber_38_0},
+	}
+
+	joinPolicy, joinPolicyCleanup := testClient().JoinPolicy.Create(t)
+	t.Cleanup(joinPolicyCleanup)
+
+	joinPolicyChanged, joinPolicyChangedCleanup := testClient().JoinPolicy.Create(t)
+	t.Cleanup(joinPolicyChangedCleanup)
+
+	modelWithoutJoinPolicy := model.IcebergTableWithDefaultMeta(id.DatabaseName(), id.SchemaName(), id.Name(), columns).
+		WithCatalog("SNOWFLAKE").
+		WithExternalVolume(externalVolumeId.Name())
+	modelWithJoinPolicy := model.IcebergTableWithDefaultMeta(id.DatabaseName(), id.SchemaName(), id.Name(), columns).
+		WithCatalog("SNOWFLAKE").
+		WithExternalVolume(externalVolumeId.Name()).
+		WithJoinPolicy(joinPolicy)
+	modelWithJoinPolicyChanged := model.IcebergTableWithDefaultMeta(id.DatabaseName(), id.SchemaName(), id.Name(), columns).
+		WithCatalog("SNOWFLAKE").
+		WithExternalVolume(externalVolumeId.Name()).
+		WithJoinPolicy(joinPolicyChanged)
+
+	ref := modelWithJoinPolicy.ResourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.IcebergTable),
+		Steps: []resource.TestStep{
+			// Create with join policy
+			{
+				Config: accconfig.FromModels(t, modelWithJoinPolicy),
+				Check: assertThat(
+					t,
+					resourceassert.IcebergTableResource(t, ref).
+						HasNameString(id.Name()).
+						HasJoinPolicy(joinPolicy),
+				),
+			},
+			// Import
+			{
+				Config:                  accconfig.FromModels(t, modelWithJoinPolicy),
+				ResourceName:            ref,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"change_tracking", "error_logging", "path_layout", "iceberg_version", "base_location"},
+			},
+			// Change join policy
+			{
+				Config: accconfig.FromModels(t, modelWithJoinPolicyChanged),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(
+					t,
+					resourceassert.IcebergTableResource(t, ref).
+						HasJoinPolicy(joinPolicyChanged),
+				),
+			},
+			// Unset join policy
+			{
+				Config: accconfig.FromModels(t, modelWithoutJoinPolicy),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(
+					t,
+					resourceassert.IcebergTableResource(t, ref).
+						HasNoJoinPolicy(),
+				),
+			},
+		},
+	})
+}
+
 func TestAcc_IcebergTable_Validations(t *testing.T) {
 	id := testClient().Ids.RandomSchemaObjectIdentifier()
 	columns := []sdk.TableColumnSignature{
@@ -0,0 +1,144 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceshowoutputassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_JoinPolicy_BasicUseCase(t *testing.T) {
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	newId := testClient().Ids.RandomSchemaObjectIdentifierInSchema(id.SchemaId())
+
+	comment := random.Comment()
+	externalComment := random.Comment()
+	body := "JOIN_CONSTRAINT(JOIN_REQUIRED => true)"
+	newBody := "JOIN_CONSTRAINT(JOIN_REQUIRED => false)"
+
+	basic := model.JoinPolicy("t", id.DatabaseName(), id.SchemaName(), id.Name(), body)
+
+	complete := model.JoinPolicy("t", newId.DatabaseName(), newId.SchemaName(), newId.Name(), newBody).
+		WithComment(comment)
+
+	ref := basic.ResourceReference()
+
+	basicAssertions := []assert.TestCheckFuncProvider{
+		resourceassert.JoinPolicyResource(t, ref).
+			HasNameString(id.Name()).
+			HasSchemaString(id.SchemaName()).
+			HasDatabaseString(id.DatabaseName()).
+			HasFullyQualifiedNameString(id.FullyQualifiedName()).
+			HasBodyString(body).
+			HasCommentString(""),
+		resourceshowoutputassert.JoinPolicyShowOutput(t, ref).
+			HasCreatedOnNotEmpty().
+			HasName(id.Name()).
+			HasDatabaseName(id.DatabaseName()).
+			HasSchemaName(id.SchemaName()).
+			HasKind("JOIN_POLICY").
+			HasOwner(snowflakeroles.Accountadmin.Name()).
+			HasOwnerRoleType("ROLE").
+			HasComment(""),
+		assert.Check(resource.TestCheckResourceAttr(ref, "describe_output.0.name", id.Name())),
+		assert.Check(resource.TestCheckResourceAttr(ref, "describe_output.0.body", body)),
+		assert.Check(resource.TestCheckResourceAttr(ref, "describe_output.0.return_type", "JOIN_CONSTRAINT")),
+	}
+
+	completeAssertions := []assert.TestCheckFuncProvider{
+		resourceassert.JoinPolicyResource(t, ref).
+			HasNameString(newId.Name()).
+			HasSchemaString(newId.SchemaName()).
+			HasDatabaseString(newId.DatabaseName()).
+			HasFullyQualifiedNameString(newId.FullyQualifiedName()).
+			HasBodyString(newBody).
+			HasCommentString(comment),
+		resourceshowoutputassert.JoinPolicyShowOutput(t, ref).
+			HasCreatedOnNotEmpty().
+			HasName(newId.Name()).
+			HasComment(comment),
+		assert.Check(resource.TestCheckResourceAttr(ref, "describe_output.0.name", newId.Name())),
+		assert.Check(resource.TestCheckResourceAttr(ref, "describe_output.0.body", newBody)),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.JoinPolicy),
+		Steps: []resource.TestStep{
+			// Create without optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionCreate),
+					},
+				},
+				Config: config.FromModels(t, basic),
+				Check:  assertThat(t, basicAssertions...),
+			},
+			// Import without optionals
+			{
+				Config:       config.FromModels(t, basic),
+				ResourceName: ref,
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(
+					t,
+					resourceassert.ImportedJoinPolicyResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasSchemaString(id.SchemaName()).
+						HasDatabaseString(id.DatabaseName()).
+						HasBodyString(body).
+						HasCommentString(""),
+				),
+			},
+			// Set all optionals, change body and rename
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, complete),
+				Check:  assertThat(t, completeAssertions...),
+			},
+			// External change is detected
+			{
+				PreConfig: func() {
+					testClient().JoinPolicy.Alter(t, sdk.NewAlterJoinPolicyRequest(newId).WithSetComment(externalComment))
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, complete),
+				Check:  assertThat(t, completeAssertions...),
+			},
+			// Unset optionals (back to the basic config with the original name)
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, basic),
+				Check:  assertThat(t, basicAssertions...),
+			},
+		},
+	})
+}
@@ -0,0 +1,175 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_ObjectContacts_BasicUseCase(t *testing.T) {
+	stewardId, stewardCleanup := testClient().Contact.Create(t)
+	t.Cleanup(stewardCleanup)
+	supportId, supportCleanup := testClient().Contact.Create(t)
+	t.Cleanup(supportCleanup)
+
+	database, databaseCleanup := testClient().Database.CreateDatabase(t)
+	t.Cleanup(databaseCleanup)
+
+	basic := model.ObjectContacts("test", database.ID().FullyQualifiedName(), "database").
+		WithSteward(stewardId.FullyQualifiedName())
+
+	complete := model.ObjectContacts("test", database.ID().FullyQualifiedName(), "database").
+		WithSteward(supportId.FullyQualifiedName()).
+		WithSupport(supportId.FullyQualifiedName()).
+		WithAccessApproval(stewardId.FullyQualifiedName())
+
+	ref := basic.ResourceReference()
+	expectedId := helpers.EncodeResourceIdentifier(sdk.ObjectTypeDatabase.String(), database.ID().FullyQualifiedName())
+
+	basicAssertions := []assert.TestCheckFuncProvider{
+		resourceassert.ObjectContactsResource(t, ref).
+			HasObjectTypeString("database").
+			HasObjectNameString(database.ID().FullyQualifiedName()).
+			HasStewardString(stewardId.FullyQualifiedName()).
+			HasSupportString("").
+			HasAccessApprovalString(""),
+		assert.Check(resource.TestCheckResourceAttr(ref, "id", expectedId)),
+	}
+
+	completeAssertions := []assert.TestCheckFuncProvider{
+		resourceassert.ObjectContactsResource(t, ref).
+			HasStewardString(supportId.FullyQualifiedName()).
+			HasSupportString(supportId.FullyQualifiedName()).
+			HasAccessApprovalString(stewardId.FullyQualifiedName()),
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckObjectContactsDestroy(t),
+		Steps: []resource.TestStep{
+			// Create with a single purpose
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionCreate),
+					},
+				},
+				Config: config.FromModels(t, basic),
+				Check:  assertThat(t, basicAssertions...),
+			},
+			// Import
+			{
+				Config:       config.FromModels(t, basic),
+				ResourceName: ref,
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(
+					t,
+					resourceassert.ImportedObjectContactsResource(t, expectedId).
+						HasObjectTypeString(sdk.ObjectTypeDatabase.String()).
+						HasObjectNameString(database.ID().FullyQualifiedName()).
+						HasStewardString(stewardId.FullyQualifiedName()).
+						HasSupportString("").
+						HasAccessApprovalString(""),
+				),
+			},
+			// Set all purposes and change the steward
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, complete),
+				Check:  assertThat(t, completeAssertions...),
+			},
+			// External changes are detected
+			{
+				PreConfig: func() {
+					testClient().Contact.SetOnObject(t, sdk.ObjectTypeDatabase, database.ID(), *sdk.NewContactsOnObjectSetRequest().WithSteward(stewardId))
+					testClient().Contact.UnsetOnObject(t, sdk.ObjectTypeDatabase, database.ID(), *sdk.NewContactsOnObjectUnsetRequest().WithSupport(true))
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, complete),
+				Check:  assertThat(t, completeAssertions...),
+			},
+			// Unset purposes removed from the config
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, basic),
+				Check:  assertThat(t, basicAssertions...),
+			},
+		},
+	})
+}
+
+func TestAcc_ObjectContacts_InheritedContactsAreIgnored(t *testing.T) {
+	stewardId, stewardCleanup := testClient().Contact.Create(t)
+	t.Cleanup(stewardCleanup)
+	supportId, supportCleanup := testClient().Contact.Create(t)
+	t.Cleanup(supportCleanup)
+
+	database, databaseCleanup := testClient().Database.CreateDatabase(t)
+	t.Cleanup(databaseCleanup)
+	schema, schemaCleanup := testClient().Schema.CreateSchemaInDatabase(t, database.ID())
+	t.Cleanup(schemaCleanup)
+
+	// The support contact is inherited by the schema from its database.
+	testClient().Contact.SetOnObject(t, sdk.ObjectTypeDatabase, database.ID(), *sdk.NewContactsOnObjectSetRequest().WithSupport(supportId))
+	t.Cleanup(func() {
+		testClient().Contact.UnsetOnObject(t, sdk.ObjectTypeDatabase, database.ID(), *sdk.NewContactsOnObjectUnsetRequest().WithSupport(true))
+	})
+
+	schemaContacts := model.ObjectContacts("test", schema.ID().FullyQualifiedName(), "SCHEMA").
+		WithSteward(stewardId.FullyQualifiedName())
+	ref := schemaContacts.ResourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckObjectContactsDestroy(t),
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, schemaContacts),
+				Check: assertThat(
+					t,
+					resourceassert.ObjectContactsResource(t, ref).
+						HasObjectTypeString("SCHEMA").
+						HasStewardString(stewardId.FullyQualifiedName()).
+						HasSupportString(""),
+				),
+			},
+			// No changes are planned because of the inherited contact
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Config: config.FromModels(t, schemaContacts),
+			},
+		},
+	})
+}
@@ -0,0 +1,141 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_OpenflowConnector_BasicUseCase(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.TestOpenflow)
+	definition := testenvs.GetOrSkipTest(t, testenvs.TestOpenflowConnectorDefinition)
+
+	deploymentId := testClient().Ids.RandomAccountObjectIdentifier()
+	runtimeId := testClient().Ids.RandomSchemaObjectIdentifier()
+	id := testClient().Ids.RandomSchemaObjectIdentifierInSchema(runtimeId.SchemaId())
+	comment := random.Comment()
+	displayName := random.AlphaN(10)
+
+	role, roleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+
+	timeouts := accconfig.Timeouts{
+		Create: "30m",
+		Update: "30m",
+		Delete: "30m",
+	}
+
+	deployment := model.OpenflowDeployment("test", deploymentId.Name(), string(sdk.OpenflowDeploymentTypeSnowflake)).
+		WithTimeout(timeouts)
+	runtime := model.OpenflowRuntime("test", runtimeId.DatabaseName(), runtimeId.SchemaName(), runtimeId.Name(), deploymentId.Name(), role.ID().Name(), 1, 1, string(sdk.OpenflowRuntimeNodeTypeSmall)).
+		WithTimeout(timeouts).
+		WithDependsOn(deployment.ResourceReference())
+
+	basic := model.OpenflowConnector("test", id.DatabaseName(), id.SchemaName(), id.Name(), runtimeId.FullyQualifiedName()).
+		WithDefinition(definition).
+		WithTimeout(timeouts).
+		WithDependsOn(runtime.ResourceReference())
+
+	complete := model.OpenflowConnector("test", id.DatabaseName(), id.SchemaName(), id.Name(), runtimeId.FullyQualifiedName()).
+		WithDefinition(definition).
+		WithDisplayName(displayName).
+		WithComment(comment).
+		WithTimeout(timeouts).
+		WithDependsOn(runtime.ResourceReference())
+
+	ref := basic.ResourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.OpenflowConnector),
+		Steps: []resource.TestStep{
+			// Create without optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionCreate),
+					},
+				},
+				Config: accconfig.FromModels(t, deployment, runtime, basic),
+				Check: assertThat(
+					t,
+					resourceassert.OpenflowConnectorResource(t, ref).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasRuntimeString(runtimeId.FullyQualifiedName()).
+						HasDefinitionString(definition).
+						HasDisplayNameString("").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.0.runtime", runtimeId.Name())),
+					assert.Check(resource.TestCheckResourceAttr(ref, "describe_output.#", "1")),
+				),
+			},
+			// Import without optionals; the runtime is assumed to be in the connector's schema
+			{
+				Config:       accconfig.FromModels(t, deployment, runtime, basic),
+				ResourceName: ref,
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(
+					t,
+					resourceassert.ImportedOpenflowConnectorResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasRuntimeString(runtimeId.FullyQualifiedName()).
+						HasDefinitionString(definition),
+				),
+			},
+			// Set optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: accconfig.FromModels(t, deployment, runtime, complete),
+				Check: assertThat(
+					t,
+					resourceassert.OpenflowConnectorResource(t, ref).
+						HasDisplayNameString(displayName).
+						HasCommentString(comment),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.0.comment", comment)),
+				),
+			},
+			// Unset optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: accconfig.FromModels(t, deployment, runtime, basic),
+				Check: assertThat(
+					t,
+					resourceassert.OpenflowConnectorResource(t, ref).
+						HasDisplayNameString("").
+						HasCommentString(""),
+				),
+			},
+		},
+	})
+}
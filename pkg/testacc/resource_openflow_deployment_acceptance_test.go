@@ -0,0 +1,127 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	r "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_OpenflowDeployment_BasicUseCase(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.TestOpenflow)
+
+	id := testClient().Ids.RandomAccountObjectIdentifier()
+	newId := testClient().Ids.RandomAccountObjectIdentifier()
+	comment := random.Comment()
+	displayName := random.AlphaN(10)
+
+	timeouts := accconfig.Timeouts{
+		Create: "30m",
+		Update: "30m",
+		Delete: "30m",
+	}
+
+	basic := model.OpenflowDeployment("test", id.Name(), string(sdk.OpenflowDeploymentTypeSnowflake)).
+		WithTimeout(timeouts)
+
+	complete := model.OpenflowDeployment("test", newId.Name(), string(sdk.OpenflowDeploymentTypeSnowflake)).
+		WithDisplayName(displayName).
+		WithComment(comment).
+		WithTimeout(timeouts)
+
+	ref := basic.ResourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.OpenflowDeployment),
+		Steps: []resource.TestStep{
+			// Create without optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionCreate),
+					},
+				},
+				Config: accconfig.FromModels(t, basic),
+				Check: assertThat(
+					t,
+					resourceassert.OpenflowDeploymentResource(t, ref).
+						HasNameString(id.Name()).
+						HasDeploymentTypeString(string(sdk.OpenflowDeploymentTypeSnowflake)).
+						HasUsePrivateLinkString(r.BooleanDefault).
+						HasUseUserAuthOverPrivatelinkString(r.BooleanDefault).
+						HasDisplayNameString("").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.0.type", string(sdk.OpenflowDeploymentTypeSnowflake))),
+					assert.Check(resource.TestCheckResourceAttr(ref, "describe_output.#", "1")),
+				),
+			},
+			// Import without optionals
+			{
+				Config:       accconfig.FromModels(t, basic),
+				ResourceName: ref,
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(
+					t,
+					resourceassert.ImportedOpenflowDeploymentResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasDeploymentTypeString(string(sdk.OpenflowDeploymentTypeSnowflake)),
+				),
+			},
+			// Set optionals and rename
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: accconfig.FromModels(t, complete),
+				Check: assertThat(
+					t,
+					resourceassert.OpenflowDeploymentResource(t, ref).
+						HasNameString(newId.Name()).
+						HasDisplayNameString(displayName).
+						HasCommentString(comment).
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.0.name", newId.Name())),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.0.comment", comment)),
+				),
+			},
+			// Unset optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: accconfig.FromModels(t, model.OpenflowDeployment("test", newId.Name(), string(sdk.OpenflowDeploymentTypeSnowflake)).WithTimeout(timeouts)),
+				Check: assertThat(
+					t,
+					resourceassert.OpenflowDeploymentResource(t, ref).
+						HasNameString(newId.Name()).
+						HasDisplayNameString("").
+						HasCommentString(""),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,132 @@
+//go:build non_account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	accconfig "github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/helpers"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/provider/resources"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_OpenflowRuntime_BasicUseCase(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.TestOpenflow)
+
+	deploymentId := testClient().Ids.RandomAccountObjectIdentifier()
+	id := testClient().Ids.RandomSchemaObjectIdentifier()
+	newId := testClient().Ids.RandomSchemaObjectIdentifierInSchema(id.SchemaId())
+	comment := random.Comment()
+	displayName := random.AlphaN(10)
+
+	role, roleCleanup := testClient().Role.CreateRole(t)
+	t.Cleanup(roleCleanup)
+
+	timeouts := accconfig.Timeouts{
+		Create: "30m",
+		Update: "30m",
+		Delete: "30m",
+	}
+
+	deployment := model.OpenflowDeployment("test", deploymentId.Name(), string(sdk.OpenflowDeploymentTypeSnowflake)).
+		WithTimeout(timeouts)
+
+	basic := model.OpenflowRuntime("test", id.DatabaseName(), id.SchemaName(), id.Name(), deploymentId.Name(), role.ID().Name(), 1, 1, string(sdk.OpenflowRuntimeNodeTypeSmall)).
+		WithTimeout(timeouts).
+		WithDependsOn(deployment.ResourceReference())
+
+	complete := model.OpenflowRuntime("test", newId.DatabaseName(), newId.SchemaName(), newId.Name(), deploymentId.Name(), role.ID().Name(), 3, 2, string(sdk.OpenflowRuntimeNodeTypeSmall)).
+		WithDisplayName(displayName).
+		WithComment(comment).
+		WithTimeout(timeouts).
+		WithDependsOn(deployment.ResourceReference())
+
+	ref := basic.ResourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.OpenflowRuntime),
+		Steps: []resource.TestStep{
+			// Create without optionals
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionCreate),
+					},
+				},
+				Config: accconfig.FromModels(t, deployment, basic),
+				Check: assertThat(
+					t,
+					resourceassert.OpenflowRuntimeResource(t, ref).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasDeploymentString(deploymentId.Name()).
+						HasExecuteAsRoleString(role.ID().Name()).
+						HasMinNodesString("1").
+						HasMaxNodesString("1").
+						HasNodeTypeString(string(sdk.OpenflowRuntimeNodeTypeSmall)).
+						HasExternalAccessIntegrationsEmpty().
+						HasDisplayNameString("").
+						HasCommentString("").
+						HasFullyQualifiedNameString(id.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.0.name", id.Name())),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.0.status", string(sdk.OpenflowRuntimeStatusActive))),
+					assert.Check(resource.TestCheckResourceAttr(ref, "describe_output.#", "1")),
+				),
+			},
+			// Import without optionals
+			{
+				Config:       accconfig.FromModels(t, deployment, basic),
+				ResourceName: ref,
+				ImportState:  true,
+				ImportStateCheck: assertThatImport(
+					t,
+					resourceassert.ImportedOpenflowRuntimeResource(t, helpers.EncodeResourceIdentifier(id)).
+						HasNameString(id.Name()).
+						HasDatabaseString(id.DatabaseName()).
+						HasSchemaString(id.SchemaName()).
+						HasDeploymentString(deploymentId.Name()).
+						HasMinNodesString("1").
+						HasMaxNodesString("1").
+						HasNodeTypeString(string(sdk.OpenflowRuntimeNodeTypeSmall)),
+				),
+			},
+			// Set optionals, scale, and rename
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: accconfig.FromModels(t, deployment, complete),
+				Check: assertThat(
+					t,
+					resourceassert.OpenflowRuntimeResource(t, ref).
+						HasNameString(newId.Name()).
+						HasMinNodesString("2").
+						HasMaxNodesString("3").
+						HasDisplayNameString(displayName).
+						HasCommentString(comment).
+						HasFullyQualifiedNameString(newId.FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.0.name", newId.Name())),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.0.min_nodes", "2")),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.0.max_nodes", "3")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,116 @@
+//go:build account_level_tests
+
+package testacc
+
+import (
+	"testing"
+
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/assert/resourceassert"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/model"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/bettertestspoc/config/providermodel"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/helpers/random"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/acceptance/testenvs"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/internal/snowflakeroles"
+	"github.com/Snowflake-Labs/terraform-provider-snowflake/pkg/sdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAcc_OrganizationAccount_BasicUseCase(t *testing.T) {
+	_ = testenvs.GetOrSkipTest(t, testenvs.TestOrganizationAccountCreate)
+
+	id := random.AccountName()
+	email := random.Email()
+	adminName := random.AdminName()
+	key, _ := random.GenerateRSAPublicKey(t)
+	comment := random.Comment()
+
+	providerModel := providermodel.SnowflakeProvider().WithRole(snowflakeroles.Orgadmin.Name())
+
+	basic := model.OrganizationAccount("test", id, adminName, string(sdk.OrganizationAccountEditionEnterprise), email).
+		WithAdminRsaPublicKey(key)
+
+	withComment := model.OrganizationAccount("test", id, adminName, string(sdk.OrganizationAccountEditionEnterprise), email).
+		WithAdminRsaPublicKey(key).
+		WithComment(comment)
+
+	ref := basic.ResourceReference()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		Steps: []resource.TestStep{
+			// Create without optionals
+			{
+				Config: config.FromModels(t, providerModel, basic),
+				Check: assertThat(
+					t,
+					resourceassert.OrganizationAccountResource(t, ref).
+						HasNameString(id).
+						HasEditionString(string(sdk.OrganizationAccountEditionEnterprise)).
+						HasCommentString("").
+						HasFullyQualifiedNameString(sdk.NewAccountObjectIdentifier(id).FullyQualifiedName()),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.#", "1")),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.0.account_name", id)),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.0.is_organization_account", "true")),
+				),
+			},
+			// Import
+			{
+				Config:             config.FromModels(t, providerModel, basic),
+				ResourceName:       ref,
+				ImportState:        true,
+				ImportStatePersist: true,
+				ImportStateCheck: assertThatImport(
+					t,
+					resourceassert.ImportedOrganizationAccountResource(t, id).
+						HasNameString(id).
+						HasEditionString(string(sdk.OrganizationAccountEditionEnterprise)),
+				),
+			},
+			// No changes after import
+			{
+				Config: config.FromModels(t, providerModel, basic),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Set comment
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, withComment),
+				Check: assertThat(
+					t,
+					resourceassert.OrganizationAccountResource(t, ref).
+						HasCommentString(comment),
+					assert.Check(resource.TestCheckResourceAttr(ref, "show_output.0.comment", comment)),
+				),
+			},
+			// Unset comment
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(ref, plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: config.FromModels(t, providerModel, basic),
+				Check: assertThat(
+					t,
+					resourceassert.OrganizationAccountResource(t, ref).
+						HasCommentString(""),
+				),
+			},
+		},
+	})
+}
@@ -145,3 +145,89 @@ func TestAcc_SecondaryConnection_BasicUseCase(t *testing.T) {
 		},
 	})
 }
+
+func TestAcc_SecondaryConnection_PromotionWithPrimaryAccount(t *testing.T) {
+	if slices.Contains([]testenvs.SnowflakeEnvironment{
+		testenvs.SnowflakeProdEnvironment,
+		testenvs.SnowflakePreProdGovEnvironment,
+	}, testenvs.GetSnowflakeEnvironmentWithProdDefault()) {
+		t.Skip("Missing azure configuration on all testing environments")
+	}
+
+	// create primary connection
+	connection, connectionCleanup := azureTestClient().Connection.Create(t)
+	t.Cleanup(connectionCleanup)
+
+	accountId := testClient().Account.GetAccountIdentifier(t)
+	azureAccountId := azureTestClient().Account.GetAccountIdentifier(t)
+	azureTestClient().Connection.Alter(
+		t, sdk.NewAlterConnectionRequest(connection.ID()).
+			WithEnableConnectionFailover(
+				*sdk.NewEnableConnectionFailoverRequest([]sdk.AccountIdentifier{accountId}),
+			),
+	)
+
+	primaryConnectionAsExternalId := sdk.NewExternalObjectIdentifier(azureAccountId, connection.ID())
+
+	secondaryConnectionModel := model.SecondaryConnection("t", connection.ID().Name(), primaryConnectionAsExternalId.FullyQualifiedName()).
+		WithPrimaryAccount(azureAccountId.Name())
+	promotedConnectionModel := model.SecondaryConnection("t", connection.ID().Name(), primaryConnectionAsExternalId.FullyQualifiedName()).
+		WithPrimaryAccount(accountId.Name())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.SecondaryConnection),
+		Steps: []resource.TestStep{
+			{
+				Config: config.FromModels(t, secondaryConnectionModel),
+				Check: assertThat(
+					t,
+					resourceassert.SecondaryConnectionResource(t, secondaryConnectionModel.ResourceReference()).
+						HasAsReplicaOfIdentifier(primaryConnectionAsExternalId).
+						HasPrimaryAccountString(azureAccountId.Name()).
+						HasIsPrimaryString("false"),
+				),
+			},
+			// promote the connection in the current account without recreating it
+			{
+				Config: config.FromModels(t, promotedConnectionModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(promotedConnectionModel.ResourceReference(), plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: assertThat(
+					t,
+					resourceassert.SecondaryConnectionResource(t, promotedConnectionModel.ResourceReference()).
+						HasAsReplicaOfIdentifier(primaryConnectionAsExternalId).
+						HasPrimaryAccountString(accountId.Name()).
+						HasIsPrimaryString("true"),
+					resourceshowoutputassert.ConnectionShowOutput(t, promotedConnectionModel.ResourceReference()).
+						HasIsPrimary(true),
+				),
+			},
+			// fail back in the other account; the state follows without recreating the resource
+			{
+				PreConfig: func() {
+					azureTestClient().Connection.Alter(t, sdk.NewAlterConnectionRequest(connection.ID()).WithPrimary(true))
+				},
+				Config: config.FromModels(t, secondaryConnectionModel),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(secondaryConnectionModel.ResourceReference(), plancheck.ResourceActionNoop),
+					},
+				},
+				Check: assertThat(
+					t,
+					resourceassert.SecondaryConnectionResource(t, secondaryConnectionModel.ResourceReference()).
+						HasAsReplicaOfIdentifier(primaryConnectionAsExternalId).
+						HasPrimaryAccountString(azureAccountId.Name()).
+						HasIsPrimaryString("false"),
+				),
+			},
+		},
+	})
+}
@@ -1466,6 +1466,105 @@ resource "snowflake_table" "test_table" {
 `, tableId.DatabaseName(), tableId.SchemaName(), tableId.Name(), maskingPolicyId.DatabaseName(), maskingPolicyId.SchemaName(), maskingPolicyId.Name())
 }
 
+func TestAcc_Table_Policies(t *testing.T) {
+	aggregationPolicy, aggregationPolicyCleanup := testClient().AggregationPolicy.CreateAggregationPolicy(t)
+	t.Cleanup(aggregationPolicyCleanup)
+
+	joinPolicy, joinPolicyCleanup := testClient().JoinPolicy.Create(t)
+	t.Cleanup(joinPolicyCleanup)
+
+	projectionPolicy, projectionPolicyCleanup := testClient().ProjectionPolicy.CreateProjectionPolicy(t)
+	t.Cleanup(projectionPolicyCleanup)
+
+	tableId := testClient().Ids.RandomSchemaObjectIdentifier()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.RequireAbove(tfversion.Version1_5_0),
+		},
+		CheckDestroy: CheckDestroy(t, resources.Table),
+		Steps: []resource.TestStep{
+			{
+				Config: tableWithPolicies(tableId, aggregationPolicy, joinPolicy, projectionPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "name", tableId.Name()),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.0.policy_name", aggregationPolicy.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.0.entity_key.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.0.entity_key.0", "column1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "join_policy.#", "1"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "join_policy.0.policy_name", joinPolicy.FullyQualifiedName()),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.1.projection_policy", projectionPolicy.FullyQualifiedName()),
+				),
+			},
+			{
+				Config: tableWithoutPolicies(tableId),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("snowflake_table.test_table", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "aggregation_policy.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "join_policy.#", "0"),
+					resource.TestCheckResourceAttr("snowflake_table.test_table", "column.1.projection_policy", ""),
+				),
+			},
+		},
+	})
+}
+
+func tableWithPolicies(tableId sdk.SchemaObjectIdentifier, aggregationPolicyId sdk.SchemaObjectIdentifier, joinPolicyId sdk.SchemaObjectIdentifier, projectionPolicyId sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "test_table" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+
+	column {
+		name = "column1"
+		type = "VARCHAR(16)"
+	}
+
+	column {
+		name              = "column2"
+		type              = "VARCHAR(16)"
+		projection_policy = %[6]q
+	}
+
+	aggregation_policy {
+		policy_name = %[4]q
+		entity_key  = ["column1"]
+	}
+
+	join_policy {
+		policy_name = %[5]q
+	}
+}
+`, tableId.DatabaseName(), tableId.SchemaName(), tableId.Name(), aggregationPolicyId.FullyQualifiedName(), joinPolicyId.FullyQualifiedName(), projectionPolicyId.FullyQualifiedName())
+}
+
+func tableWithoutPolicies(tableId sdk.SchemaObjectIdentifier) string {
+	return fmt.Sprintf(`
+resource "snowflake_table" "test_table" {
+	database = "%[1]s"
+	schema   = "%[2]s"
+	name     = "%[3]s"
+
+	column {
+		name = "column1"
+		type = "VARCHAR(16)"
+	}
+
+	column {
+		name = "column2"
+		type = "VARCHAR(16)"
+	}
+}
+`, tableId.DatabaseName(), tableId.SchemaName(), tableId.Name())
+}
+
 // proves https://github.com/Snowflake-Labs/terraform-provider-snowflake/issues/2356 issue is fixed.
 func TestAcc_Table_DefaultDataRetentionTime(t *testing.T) {
 	database, databaseCleanup := testClient().Database.CreateDatabaseWithParametersSet(t)